@@ -139,7 +139,7 @@ func getGateway(c echo.Context) error {
 	}
 
 	reqCtx := c.Request().Context()
-	magmadModel, nerr := handlers.LoadMagmadGateway(reqCtx, nid, gid)
+	magmadModel, version, nerr := handlers.LoadMagmadGatewayWithVersion(reqCtx, nid, gid)
 	if nerr != nil {
 		return nerr
 	}
@@ -166,6 +166,7 @@ func getGateway(c echo.Context) error {
 		ret.CarrierWifi = ent.Config.(*cwfModels.GatewayCwfConfigs)
 	}
 
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -185,7 +186,11 @@ func deleteGateway(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := handlers.DeleteMagmadGateway(c.Request().Context(), nid, gid, storage.TKs{{Type: cwf.CwfGatewayType, Key: gid}})
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := handlers.DeleteMagmadGatewayIfMatch(c.Request().Context(), nid, gid, expectedVersion, storage.TKs{{Type: cwf.CwfGatewayType, Key: gid}})
 	if err != nil {
 		return makeErr(err)
 	}
@@ -359,7 +364,7 @@ func createHAPairHandler(c echo.Context) error {
 	if err := haPair.ValidateModel(); err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
-	_, err := configurator.CreateEntity(networkID, haPair.ToEntity(), serdes.Entity)
+	_, err := configurator.CreateEntityWithContext(c.Request().Context(), networkID, haPair.ToEntity(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -417,7 +422,7 @@ func updateHAPairHandler(c echo.Context) error {
 	if !exists {
 		return echo.ErrNotFound
 	}
-	_, err = configurator.UpdateEntity(networkID, mutableHaPair.ToEntityUpdateCriteria(haPairID), serdes.Entity)
+	_, err = configurator.UpdateEntityWithContext(c.Request().Context(), networkID, mutableHaPair.ToEntityUpdateCriteria(haPairID), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -429,7 +434,7 @@ func deleteHAPairHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteEntity(networkID, cwf.CwfHAPairType, haPairID)
+	err := configurator.DeleteEntityWithContext(c.Request().Context(), networkID, cwf.CwfHAPairType, haPairID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -496,6 +501,9 @@ func getNetworkIDAndHaPairID(c echo.Context) (string, string, *echo.HTTPError) {
 }
 
 func makeErr(err error) *echo.HTTPError {
+	if nerr, ok := err.(*echo.HTTPError); ok {
+		return nerr
+	}
 	if err == merrors.ErrNotFound {
 		return echo.ErrNotFound
 	}
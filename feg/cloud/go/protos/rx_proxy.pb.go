@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: feg/protos/rx_proxy.proto
+
+package protos
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protos "magma/orc8r/lib/go/protos"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BearerEventNotification_BearerEvent int32
+
+const (
+	BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION BearerEventNotification_BearerEvent = 0
+	BearerEventNotification_LOSS_OF_BEARER                 BearerEventNotification_BearerEvent = 1
+	BearerEventNotification_RECOVERY_OF_BEARER             BearerEventNotification_BearerEvent = 2
+)
+
+var BearerEventNotification_BearerEvent_name = map[int32]string{
+	0: "SUCCESSFUL_RESOURCE_ALLOCATION",
+	1: "LOSS_OF_BEARER",
+	2: "RECOVERY_OF_BEARER",
+}
+
+var BearerEventNotification_BearerEvent_value = map[string]int32{
+	"SUCCESSFUL_RESOURCE_ALLOCATION": 0,
+	"LOSS_OF_BEARER":                 1,
+	"RECOVERY_OF_BEARER":             2,
+}
+
+func (x BearerEventNotification_BearerEvent) String() string {
+	return proto.EnumName(BearerEventNotification_BearerEvent_name, int32(x))
+}
+
+func (BearerEventNotification_BearerEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_859813244b6dfce4, []int{2, 0}
+}
+
+type IPCANSessionEstablishment struct {
+	Imsi string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// UE IPv4 address of the IP-CAN session
+	UeIpv4               string   `protobuf:"bytes,2,opt,name=ue_ipv4,json=ueIpv4,proto3" json:"ue_ipv4,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPCANSessionEstablishment) Reset()         { *m = IPCANSessionEstablishment{} }
+func (m *IPCANSessionEstablishment) String() string { return proto.CompactTextString(m) }
+func (*IPCANSessionEstablishment) ProtoMessage()    {}
+func (*IPCANSessionEstablishment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_859813244b6dfce4, []int{0}
+}
+
+func (m *IPCANSessionEstablishment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPCANSessionEstablishment.Unmarshal(m, b)
+}
+func (m *IPCANSessionEstablishment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPCANSessionEstablishment.Marshal(b, m, deterministic)
+}
+func (m *IPCANSessionEstablishment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPCANSessionEstablishment.Merge(m, src)
+}
+func (m *IPCANSessionEstablishment) XXX_Size() int {
+	return xxx_messageInfo_IPCANSessionEstablishment.Size(m)
+}
+func (m *IPCANSessionEstablishment) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPCANSessionEstablishment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPCANSessionEstablishment proto.InternalMessageInfo
+
+func (m *IPCANSessionEstablishment) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *IPCANSessionEstablishment) GetUeIpv4() string {
+	if m != nil {
+		return m.UeIpv4
+	}
+	return ""
+}
+
+type IPCANSessionTermination struct {
+	Imsi string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// UE IPv4 address of the IP-CAN session, AF sessions are bound to it by
+	// their Framed-IP-Address. All the AF sessions of the subscriber are
+	// aborted if empty
+	UeIpv4               string   `protobuf:"bytes,2,opt,name=ue_ipv4,json=ueIpv4,proto3" json:"ue_ipv4,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPCANSessionTermination) Reset()         { *m = IPCANSessionTermination{} }
+func (m *IPCANSessionTermination) String() string { return proto.CompactTextString(m) }
+func (*IPCANSessionTermination) ProtoMessage()    {}
+func (*IPCANSessionTermination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_859813244b6dfce4, []int{1}
+}
+
+func (m *IPCANSessionTermination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPCANSessionTermination.Unmarshal(m, b)
+}
+func (m *IPCANSessionTermination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPCANSessionTermination.Marshal(b, m, deterministic)
+}
+func (m *IPCANSessionTermination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPCANSessionTermination.Merge(m, src)
+}
+func (m *IPCANSessionTermination) XXX_Size() int {
+	return xxx_messageInfo_IPCANSessionTermination.Size(m)
+}
+func (m *IPCANSessionTermination) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPCANSessionTermination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPCANSessionTermination proto.InternalMessageInfo
+
+func (m *IPCANSessionTermination) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *IPCANSessionTermination) GetUeIpv4() string {
+	if m != nil {
+		return m.UeIpv4
+	}
+	return ""
+}
+
+type BearerEventNotification struct {
+	Imsi string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// UE IPv4 address of the IP-CAN session, see IPCANSessionTermination
+	UeIpv4               string                              `protobuf:"bytes,2,opt,name=ue_ipv4,json=ueIpv4,proto3" json:"ue_ipv4,omitempty"`
+	Event                BearerEventNotification_BearerEvent `protobuf:"varint,3,opt,name=event,proto3,enum=magma.feg.BearerEventNotification_BearerEvent" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *BearerEventNotification) Reset()         { *m = BearerEventNotification{} }
+func (m *BearerEventNotification) String() string { return proto.CompactTextString(m) }
+func (*BearerEventNotification) ProtoMessage()    {}
+func (*BearerEventNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_859813244b6dfce4, []int{2}
+}
+
+func (m *BearerEventNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BearerEventNotification.Unmarshal(m, b)
+}
+func (m *BearerEventNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BearerEventNotification.Marshal(b, m, deterministic)
+}
+func (m *BearerEventNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BearerEventNotification.Merge(m, src)
+}
+func (m *BearerEventNotification) XXX_Size() int {
+	return xxx_messageInfo_BearerEventNotification.Size(m)
+}
+func (m *BearerEventNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_BearerEventNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BearerEventNotification proto.InternalMessageInfo
+
+func (m *BearerEventNotification) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *BearerEventNotification) GetUeIpv4() string {
+	if m != nil {
+		return m.UeIpv4
+	}
+	return ""
+}
+
+func (m *BearerEventNotification) GetEvent() BearerEventNotification_BearerEvent {
+	if m != nil {
+		return m.Event
+	}
+	return BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION
+}
+
+func init() {
+	proto.RegisterEnum("magma.feg.BearerEventNotification_BearerEvent", BearerEventNotification_BearerEvent_name, BearerEventNotification_BearerEvent_value)
+	proto.RegisterType((*IPCANSessionEstablishment)(nil), "magma.feg.IPCANSessionEstablishment")
+	proto.RegisterType((*IPCANSessionTermination)(nil), "magma.feg.IPCANSessionTermination")
+	proto.RegisterType((*BearerEventNotification)(nil), "magma.feg.BearerEventNotification")
+}
+
+func init() { proto.RegisterFile("feg/protos/rx_proxy.proto", fileDescriptor_859813244b6dfce4) }
+
+var fileDescriptor_859813244b6dfce4 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6b, 0xe2, 0x40,
+	0x18, 0x86, 0x8d, 0xbb, 0xab, 0xf8, 0x2d, 0x88, 0xce, 0xc1, 0x18, 0x17, 0x16, 0x09, 0x7b, 0xf0,
+	0x94, 0x80, 0xeb, 0x61, 0xaf, 0x31, 0x3b, 0x52, 0x21, 0x18, 0x3b, 0x51, 0xa1, 0x85, 0x12, 0x62,
+	0x32, 0xa6, 0x03, 0x26, 0x13, 0x26, 0x51, 0xf4, 0xd6, 0xff, 0xdb, 0x3f, 0x51, 0x8c, 0xb6, 0xa4,
+	0x10, 0x29, 0x9e, 0x92, 0x79, 0xf9, 0xe6, 0x61, 0x78, 0x9f, 0x0f, 0x94, 0x0d, 0x0d, 0xf5, 0x44,
+	0xf0, 0x8c, 0xa7, 0xba, 0x38, 0xb8, 0x89, 0xe0, 0x87, 0xa3, 0x96, 0x9f, 0x51, 0x23, 0xf2, 0xc2,
+	0xc8, 0xd3, 0x36, 0x34, 0xec, 0x29, 0x5c, 0xf8, 0xff, 0xc4, 0xfb, 0x9c, 0xcf, 0xa3, 0x88, 0xc7,
+	0xe7, 0x29, 0xf5, 0x0e, 0x94, 0xe9, 0xdc, 0x34, 0x66, 0x0e, 0x4d, 0x53, 0xc6, 0x63, 0x9c, 0x66,
+	0xde, 0x7a, 0xcb, 0xd2, 0xe7, 0x88, 0xc6, 0x19, 0x42, 0xf0, 0x9d, 0x45, 0x29, 0xeb, 0x4a, 0x7d,
+	0x69, 0xd0, 0x20, 0xf9, 0x3f, 0x92, 0xa1, 0xbe, 0xa3, 0x2e, 0x4b, 0xf6, 0xa3, 0x6e, 0x35, 0x8f,
+	0x6b, 0x3b, 0x3a, 0x4d, 0xf6, 0x23, 0x75, 0x02, 0x72, 0x91, 0xb4, 0xa0, 0x22, 0x62, 0xb1, 0x97,
+	0x31, 0x1e, 0xdf, 0xc6, 0x79, 0x95, 0x40, 0x1e, 0x53, 0x4f, 0x50, 0x81, 0xf7, 0x34, 0xce, 0x66,
+	0x3c, 0x63, 0x1b, 0xe6, 0xdf, 0x0e, 0x42, 0xff, 0xe1, 0x07, 0x3d, 0x11, 0xba, 0xdf, 0xfa, 0xd2,
+	0xa0, 0x39, 0xd4, 0xb4, 0x8f, 0x42, 0xb4, 0x2b, 0xfc, 0x62, 0x4e, 0xce, 0x97, 0xd5, 0x27, 0xf8,
+	0x59, 0x48, 0x91, 0x0a, 0xbf, 0x9d, 0xa5, 0x69, 0x62, 0xc7, 0x99, 0x2c, 0x2d, 0x97, 0x60, 0xc7,
+	0x5e, 0x12, 0x13, 0xbb, 0x86, 0x65, 0xd9, 0xa6, 0xb1, 0x98, 0xda, 0xb3, 0x56, 0x05, 0x21, 0x68,
+	0x5a, 0xb6, 0xe3, 0xb8, 0xf6, 0xc4, 0x1d, 0x63, 0x83, 0x60, 0xd2, 0x92, 0x50, 0x07, 0x10, 0xc1,
+	0xa6, 0xbd, 0xc2, 0xe4, 0xa1, 0x90, 0x57, 0x87, 0x2f, 0x55, 0xa8, 0x93, 0xc3, 0xfc, 0xe4, 0x0d,
+	0x2d, 0x40, 0x2e, 0x75, 0x41, 0x03, 0xf4, 0xa7, 0xf0, 0xf8, 0xab, 0xbe, 0x7a, 0xed, 0xcb, 0x54,
+	0xae, 0x5b, 0x5b, 0x71, 0x16, 0xa8, 0x15, 0x74, 0x0f, 0x9d, 0x32, 0x2f, 0x34, 0x40, 0xea, 0x15,
+	0x68, 0x41, 0x5d, 0x39, 0xd2, 0x82, 0x76, 0x5e, 0xdb, 0xf1, 0x53, 0x33, 0x5f, 0xf7, 0x5b, 0x4a,
+	0x1b, 0xff, 0x7a, 0x54, 0xf2, 0x54, 0x3f, 0xed, 0xb2, 0xbf, 0xe5, 0xbb, 0x40, 0x0f, 0xf9, 0x65,
+	0x59, 0xd7, 0xb5, 0xfc, 0xfb, 0xf7, 0x6d, 0x00, 0xec, 0xfc, 0x38, 0x65, 0xe9, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RxProxyClient is the client API for RxProxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RxProxyClient interface {
+	// IPCANSessionEstablished binds the UE IP address of the session to its
+	// subscriber, for the AF sessions identified by Framed-IP-Address only
+	IPCANSessionEstablished(ctx context.Context, in *IPCANSessionEstablishment, opts ...grpc.CallOption) (*protos.Void, error)
+	// IPCANSessionTerminated aborts the bound AF sessions with an Rx ASR
+	IPCANSessionTerminated(ctx context.Context, in *IPCANSessionTermination, opts ...grpc.CallOption) (*protos.Void, error)
+	// NotifyBearerEvent sends an Rx RAR to the bound AF sessions subscribed to the event
+	NotifyBearerEvent(ctx context.Context, in *BearerEventNotification, opts ...grpc.CallOption) (*protos.Void, error)
+}
+
+type rxProxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRxProxyClient(cc grpc.ClientConnInterface) RxProxyClient {
+	return &rxProxyClient{cc}
+}
+
+func (c *rxProxyClient) IPCANSessionEstablished(ctx context.Context, in *IPCANSessionEstablishment, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.RxProxy/IPCANSessionEstablished", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rxProxyClient) IPCANSessionTerminated(ctx context.Context, in *IPCANSessionTermination, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.RxProxy/IPCANSessionTerminated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rxProxyClient) NotifyBearerEvent(ctx context.Context, in *BearerEventNotification, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.RxProxy/NotifyBearerEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RxProxyServer is the server API for RxProxy service.
+type RxProxyServer interface {
+	// IPCANSessionEstablished binds the UE IP address of the session to its
+	// subscriber, for the AF sessions identified by Framed-IP-Address only
+	IPCANSessionEstablished(context.Context, *IPCANSessionEstablishment) (*protos.Void, error)
+	// IPCANSessionTerminated aborts the bound AF sessions with an Rx ASR
+	IPCANSessionTerminated(context.Context, *IPCANSessionTermination) (*protos.Void, error)
+	// NotifyBearerEvent sends an Rx RAR to the bound AF sessions subscribed to the event
+	NotifyBearerEvent(context.Context, *BearerEventNotification) (*protos.Void, error)
+}
+
+// UnimplementedRxProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedRxProxyServer struct {
+}
+
+func (*UnimplementedRxProxyServer) IPCANSessionEstablished(ctx context.Context, req *IPCANSessionEstablishment) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IPCANSessionEstablished not implemented")
+}
+func (*UnimplementedRxProxyServer) IPCANSessionTerminated(ctx context.Context, req *IPCANSessionTermination) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IPCANSessionTerminated not implemented")
+}
+func (*UnimplementedRxProxyServer) NotifyBearerEvent(ctx context.Context, req *BearerEventNotification) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyBearerEvent not implemented")
+}
+
+func RegisterRxProxyServer(s *grpc.Server, srv RxProxyServer) {
+	s.RegisterService(&_RxProxy_serviceDesc, srv)
+}
+
+func _RxProxy_IPCANSessionEstablished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPCANSessionEstablishment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RxProxyServer).IPCANSessionEstablished(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.RxProxy/IPCANSessionEstablished",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RxProxyServer).IPCANSessionEstablished(ctx, req.(*IPCANSessionEstablishment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RxProxy_IPCANSessionTerminated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPCANSessionTermination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RxProxyServer).IPCANSessionTerminated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.RxProxy/IPCANSessionTerminated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RxProxyServer).IPCANSessionTerminated(ctx, req.(*IPCANSessionTermination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RxProxy_NotifyBearerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BearerEventNotification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RxProxyServer).NotifyBearerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.RxProxy/NotifyBearerEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RxProxyServer).NotifyBearerEvent(ctx, req.(*BearerEventNotification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RxProxy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.feg.RxProxy",
+	HandlerType: (*RxProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IPCANSessionEstablished",
+			Handler:    _RxProxy_IPCANSessionEstablished_Handler,
+		},
+		{
+			MethodName: "IPCANSessionTerminated",
+			Handler:    _RxProxy_IPCANSessionTerminated_Handler,
+		},
+		{
+			MethodName: "NotifyBearerEvent",
+			Handler:    _RxProxy_NotifyBearerEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feg/protos/rx_proxy.proto",
+}
@@ -245,6 +245,65 @@ func (CancelLocationRequest_CancellationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f32b2af5087a8858, []int{4, 0}
 }
 
+// Subscriber-Status AVP (Section 7.3.29)
+type InsertSubscriberDataRequest_SubscriberStatus int32
+
+const (
+	InsertSubscriberDataRequest_SERVICE_GRANTED             InsertSubscriberDataRequest_SubscriberStatus = 0
+	InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING InsertSubscriberDataRequest_SubscriberStatus = 1
+)
+
+var InsertSubscriberDataRequest_SubscriberStatus_name = map[int32]string{
+	0: "SERVICE_GRANTED",
+	1: "OPERATOR_DETERMINED_BARRING",
+}
+
+var InsertSubscriberDataRequest_SubscriberStatus_value = map[string]int32{
+	"SERVICE_GRANTED":             0,
+	"OPERATOR_DETERMINED_BARRING": 1,
+}
+
+func (x InsertSubscriberDataRequest_SubscriberStatus) String() string {
+	return proto.EnumName(InsertSubscriberDataRequest_SubscriberStatus_name, int32(x))
+}
+
+func (InsertSubscriberDataRequest_SubscriberStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{12, 0}
+}
+
+// Flags of the AVPs present in the IDR, for the fields whose default
+// value can't be told apart from an absent AVP
+type InsertSubscriberDataRequest_PresenceFlag int32
+
+const (
+	InsertSubscriberDataRequest_NONE_PRESENT                        InsertSubscriberDataRequest_PresenceFlag = 0
+	InsertSubscriberDataRequest_NETWORK_ACCESS_MODE_PRESENT         InsertSubscriberDataRequest_PresenceFlag = 1
+	InsertSubscriberDataRequest_SUBSCRIBER_STATUS_PRESENT           InsertSubscriberDataRequest_PresenceFlag = 2
+	InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING_PRESENT InsertSubscriberDataRequest_PresenceFlag = 4
+)
+
+var InsertSubscriberDataRequest_PresenceFlag_name = map[int32]string{
+	0: "NONE_PRESENT",
+	1: "NETWORK_ACCESS_MODE_PRESENT",
+	2: "SUBSCRIBER_STATUS_PRESENT",
+	4: "OPERATOR_DETERMINED_BARRING_PRESENT",
+}
+
+var InsertSubscriberDataRequest_PresenceFlag_value = map[string]int32{
+	"NONE_PRESENT":                        0,
+	"NETWORK_ACCESS_MODE_PRESENT":         1,
+	"SUBSCRIBER_STATUS_PRESENT":           2,
+	"OPERATOR_DETERMINED_BARRING_PRESENT": 4,
+}
+
+func (x InsertSubscriberDataRequest_PresenceFlag) String() string {
+	return proto.EnumName(InsertSubscriberDataRequest_PresenceFlag_name, int32(x))
+}
+
+func (InsertSubscriberDataRequest_PresenceFlag) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{12, 1}
+}
+
 // Authentication Information Request (Section 7.2.5)
 type AuthenticationInformationRequest struct {
 	// Subscriber identifier
@@ -1394,12 +1453,284 @@ func (m *FeatureListId1) GetRegionalSubscription() bool {
 	return false
 }
 
+// Insert Subscriber Data Request (Section 7.2.9)
+// Only the parts of the subscription data which changed are set
+type InsertSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// IDR-Flags (Section 7.3.103)
+	IdrFlags uint32 `protobuf:"varint,2,opt,name=idr_flags,json=idrFlags,proto3" json:"idr_flags,omitempty"`
+	Msisdn   []byte `protobuf:"bytes,3,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	// Identifier of the default APN
+	DefaultContextId uint32 `protobuf:"varint,4,opt,name=default_context_id,json=defaultContextId,proto3" json:"default_context_id,omitempty"`
+	// Subscriber authorized aggregate bitrate
+	TotalAmbr *UpdateLocationAnswer_AggregatedMaximumBitrate `protobuf:"bytes,5,opt,name=total_ambr,json=totalAmbr,proto3" json:"total_ambr,omitempty"`
+	// Indicates to wipe other stored APNs
+	AllApnsIncluded bool `protobuf:"varint,6,opt,name=all_apns_included,json=allApnsIncluded,proto3" json:"all_apns_included,omitempty"`
+	// Added or modified APN configurations
+	Apn               []*UpdateLocationAnswer_APNConfiguration     `protobuf:"bytes,7,rep,name=apn,proto3" json:"apn,omitempty"`
+	NetworkAccessMode UpdateLocationAnswer_NetworkAccessMode       `protobuf:"varint,8,opt,name=network_access_mode,json=networkAccessMode,proto3,enum=magma.feg.UpdateLocationAnswer_NetworkAccessMode" json:"network_access_mode,omitempty"`
+	SubscriberStatus  InsertSubscriberDataRequest_SubscriberStatus `protobuf:"varint,9,opt,name=subscriber_status,json=subscriberStatus,proto3,enum=magma.feg.InsertSubscriberDataRequest_SubscriberStatus" json:"subscriber_status,omitempty"`
+	// Operator-Determined-Barring bit mask (Section 7.3.30)
+	OperatorDeterminedBarring uint32 `protobuf:"varint,10,opt,name=operator_determined_barring,json=operatorDeterminedBarring,proto3" json:"operator_determined_barring,omitempty"`
+	// Bit mask of PresenceFlag values
+	PresenceFlags        uint32   `protobuf:"varint,11,opt,name=presence_flags,json=presenceFlags,proto3" json:"presence_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertSubscriberDataRequest) Reset()         { *m = InsertSubscriberDataRequest{} }
+func (m *InsertSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataRequest) ProtoMessage()    {}
+func (*InsertSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{12}
+}
+
+func (m *InsertSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataRequest.Merge(m, src)
+}
+func (m *InsertSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Size(m)
+}
+func (m *InsertSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *InsertSubscriberDataRequest) GetIdrFlags() uint32 {
+	if m != nil {
+		return m.IdrFlags
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetMsisdn() []byte {
+	if m != nil {
+		return m.Msisdn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetDefaultContextId() uint32 {
+	if m != nil {
+		return m.DefaultContextId
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetTotalAmbr() *UpdateLocationAnswer_AggregatedMaximumBitrate {
+	if m != nil {
+		return m.TotalAmbr
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetAllApnsIncluded() bool {
+	if m != nil {
+		return m.AllApnsIncluded
+	}
+	return false
+}
+
+func (m *InsertSubscriberDataRequest) GetApn() []*UpdateLocationAnswer_APNConfiguration {
+	if m != nil {
+		return m.Apn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetNetworkAccessMode() UpdateLocationAnswer_NetworkAccessMode {
+	if m != nil {
+		return m.NetworkAccessMode
+	}
+	return UpdateLocationAnswer_PACKET_AND_CIRCUIT
+}
+
+func (m *InsertSubscriberDataRequest) GetSubscriberStatus() InsertSubscriberDataRequest_SubscriberStatus {
+	if m != nil {
+		return m.SubscriberStatus
+	}
+	return InsertSubscriberDataRequest_SERVICE_GRANTED
+}
+
+func (m *InsertSubscriberDataRequest) GetOperatorDeterminedBarring() uint32 {
+	if m != nil {
+		return m.OperatorDeterminedBarring
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetPresenceFlags() uint32 {
+	if m != nil {
+		return m.PresenceFlags
+	}
+	return 0
+}
+
+// Insert Subscriber Data Answer (Section 7.2.10)
+type InsertSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *InsertSubscriberDataAnswer) Reset()         { *m = InsertSubscriberDataAnswer{} }
+func (m *InsertSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataAnswer) ProtoMessage()    {}
+func (*InsertSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{13}
+}
+
+func (m *InsertSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataAnswer.Merge(m, src)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Size(m)
+}
+func (m *InsertSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+// Delete Subscriber Data Request (Section 7.2.11)
+type DeleteSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// DSR-Flags (Section 7.3.25)
+	DsrFlags uint32 `protobuf:"varint,2,opt,name=dsr_flags,json=dsrFlags,proto3" json:"dsr_flags,omitempty"`
+	// Identifiers of the APN configurations to delete
+	ContextIds           []uint32 `protobuf:"varint,3,rep,packed,name=context_ids,json=contextIds,proto3" json:"context_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscriberDataRequest) Reset()         { *m = DeleteSubscriberDataRequest{} }
+func (m *DeleteSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataRequest) ProtoMessage()    {}
+func (*DeleteSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{14}
+}
+
+func (m *DeleteSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataRequest.Merge(m, src)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Size(m)
+}
+func (m *DeleteSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeleteSubscriberDataRequest) GetDsrFlags() uint32 {
+	if m != nil {
+		return m.DsrFlags
+	}
+	return 0
+}
+
+func (m *DeleteSubscriberDataRequest) GetContextIds() []uint32 {
+	if m != nil {
+		return m.ContextIds
+	}
+	return nil
+}
+
+// Delete Subscriber Data Answer (Section 7.2.12)
+type DeleteSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DeleteSubscriberDataAnswer) Reset()         { *m = DeleteSubscriberDataAnswer{} }
+func (m *DeleteSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataAnswer) ProtoMessage()    {}
+func (*DeleteSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{15}
+}
+
+func (m *DeleteSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.Merge(m, src)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Size(m)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
 func init() {
 	proto.RegisterEnum("magma.feg.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_NetworkAccessMode", UpdateLocationAnswer_NetworkAccessMode_name, UpdateLocationAnswer_NetworkAccessMode_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_APNConfiguration_PDNType", UpdateLocationAnswer_APNConfiguration_PDNType_name, UpdateLocationAnswer_APNConfiguration_PDNType_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_AggregatedMaximumBitrate_BitrateUnitsAMBR", UpdateLocationAnswer_AggregatedMaximumBitrate_BitrateUnitsAMBR_name, UpdateLocationAnswer_AggregatedMaximumBitrate_BitrateUnitsAMBR_value)
 	proto.RegisterEnum("magma.feg.CancelLocationRequest_CancellationType", CancelLocationRequest_CancellationType_name, CancelLocationRequest_CancellationType_value)
+	proto.RegisterEnum("magma.feg.InsertSubscriberDataRequest_SubscriberStatus", InsertSubscriberDataRequest_SubscriberStatus_name, InsertSubscriberDataRequest_SubscriberStatus_value)
+	proto.RegisterEnum("magma.feg.InsertSubscriberDataRequest_PresenceFlag", InsertSubscriberDataRequest_PresenceFlag_name, InsertSubscriberDataRequest_PresenceFlag_value)
 	proto.RegisterType((*AuthenticationInformationRequest)(nil), "magma.feg.AuthenticationInformationRequest")
 	proto.RegisterType((*AuthenticationInformationAnswer)(nil), "magma.feg.AuthenticationInformationAnswer")
 	proto.RegisterType((*AuthenticationInformationAnswer_EUTRANVector)(nil), "magma.feg.AuthenticationInformationAnswer.EUTRANVector")
@@ -1418,144 +1749,169 @@ func init() {
 	proto.RegisterType((*ResetAnswer)(nil), "magma.feg.ResetAnswer")
 	proto.RegisterType((*FeatureListId2)(nil), "magma.feg.FeatureListId2")
 	proto.RegisterType((*FeatureListId1)(nil), "magma.feg.FeatureListId1")
+	proto.RegisterType((*InsertSubscriberDataRequest)(nil), "magma.feg.InsertSubscriberDataRequest")
+	proto.RegisterType((*InsertSubscriberDataAnswer)(nil), "magma.feg.InsertSubscriberDataAnswer")
+	proto.RegisterType((*DeleteSubscriberDataRequest)(nil), "magma.feg.DeleteSubscriberDataRequest")
+	proto.RegisterType((*DeleteSubscriberDataAnswer)(nil), "magma.feg.DeleteSubscriberDataAnswer")
 }
 
 func init() { proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_f32b2af5087a8858) }
 
 var fileDescriptor_f32b2af5087a8858 = []byte{
-	// 2107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdb, 0x73, 0xdb, 0x58,
-	0x19, 0x8f, 0x73, 0xb5, 0xbf, 0xd8, 0xa9, 0x72, 0x9a, 0xd6, 0x4e, 0xda, 0xdd, 0x64, 0x0d, 0x3b,
-	0xcd, 0x64, 0xd9, 0x64, 0x9b, 0x65, 0xc3, 0x65, 0x1f, 0x76, 0x15, 0x4b, 0x6d, 0xd4, 0xd8, 0xb2,
-	0xf6, 0x48, 0x4a, 0x66, 0x0b, 0xec, 0xe1, 0x44, 0x3a, 0x71, 0x35, 0x95, 0x25, 0x57, 0x92, 0xd3,
-	0x84, 0x37, 0x1e, 0xb9, 0xcc, 0xf0, 0x07, 0xc0, 0x0c, 0x03, 0xcc, 0xf0, 0x04, 0xec, 0x03, 0x4f,
-	0xb0, 0xb0, 0x5c, 0xde, 0x79, 0x80, 0x19, 0xfe, 0x08, 0x5e, 0x78, 0xe2, 0x0f, 0x60, 0xce, 0x91,
-	0x94, 0xca, 0x6e, 0x4a, 0x1b, 0xda, 0x27, 0x1f, 0x7d, 0x97, 0xdf, 0xf7, 0x9d, 0xef, 0x2a, 0x19,
-	0x56, 0x8e, 0x59, 0x6f, 0x6b, 0x10, 0x85, 0x49, 0x18, 0x6f, 0xc5, 0x3b, 0x94, 0x0c, 0xa2, 0xf0,
-	0xf4, 0x6c, 0x53, 0x10, 0x50, 0xa5, 0x4f, 0x7b, 0x7d, 0xba, 0x79, 0xcc, 0x7a, 0xcd, 0xbf, 0x4d,
-	0xc1, 0x9a, 0x3c, 0x4c, 0x1e, 0xb0, 0x20, 0xf1, 0x1c, 0x9a, 0x78, 0x61, 0xa0, 0x05, 0xc7, 0x61,
-	0xd4, 0x17, 0x47, 0xcc, 0x1e, 0x0d, 0x59, 0x9c, 0xa0, 0x1b, 0x50, 0x19, 0xc6, 0x2c, 0x22, 0x01,
-	0xed, 0xb3, 0x46, 0x69, 0xad, 0xb4, 0x5e, 0xc1, 0x65, 0x4e, 0xd0, 0x69, 0x9f, 0xa1, 0x37, 0xa0,
-	0x7a, 0xe2, 0xc5, 0x5e, 0xc2, 0x5c, 0x32, 0xf0, 0xfb, 0x41, 0x63, 0x72, 0xad, 0xb4, 0x5e, 0xc5,
-	0xf3, 0x19, 0xcd, 0xf0, 0xfb, 0x01, 0xfa, 0x00, 0x6e, 0x06, 0xc3, 0x3e, 0x89, 0x52, 0x38, 0xe6,
-	0x12, 0x36, 0x4c, 0x22, 0x1a, 0x90, 0x13, 0xe6, 0x24, 0x61, 0x14, 0x37, 0xa6, 0xd6, 0x4a, 0xeb,
-	0x35, 0xbc, 0x1c, 0x0c, 0xfb, 0x38, 0x17, 0x51, 0x85, 0xc4, 0x41, 0x2a, 0x80, 0x3e, 0x84, 0x9b,
-	0x5e, 0xbf, 0xcf, 0x5c, 0x8f, 0x26, 0x8c, 0x44, 0x2c, 0x1e, 0x84, 0x41, 0xcc, 0xc8, 0x20, 0x62,
-	0xc7, 0x2c, 0x8a, 0x98, 0xdb, 0x98, 0x5e, 0x2b, 0xad, 0x97, 0xf1, 0xca, 0xb9, 0x0c, 0xce, 0x44,
-	0x8c, 0x5c, 0x02, 0xad, 0xc2, 0x7c, 0xc4, 0xe2, 0xb3, 0xc0, 0x21, 0x5e, 0x70, 0x1c, 0x36, 0x66,
-	0x84, 0x93, 0x90, 0x92, 0xf8, 0x8d, 0xd1, 0x1e, 0xbc, 0x31, 0xea, 0x63, 0xea, 0x62, 0x8f, 0x15,
-	0x1d, 0x9d, 0x15, 0x8e, 0xbe, 0x56, 0x74, 0xd4, 0xe6, 0x62, 0x77, 0x59, 0xc1, 0xd9, 0xf7, 0xa0,
-	0x5e, 0xd4, 0x2d, 0x9a, 0x9d, 0x13, 0x66, 0x97, 0x86, 0xe7, 0x3a, 0xf8, 0x89, 0x03, 0x0a, 0x2c,
-	0x1e, 0x33, 0x9a, 0x0c, 0x23, 0x46, 0x7c, 0x2f, 0x4e, 0x88, 0xe7, 0x92, 0xed, 0x46, 0x79, 0xad,
-	0xb4, 0x3e, 0xbf, 0xbd, 0xbc, 0x79, 0x9e, 0xb0, 0xcd, 0x3b, 0xa9, 0x4c, 0xdb, 0x8b, 0x13, 0xcd,
-	0xdd, 0xc6, 0x0b, 0xc7, 0x23, 0xcf, 0xcd, 0xcf, 0x66, 0x60, 0xf5, 0x99, 0xf9, 0x94, 0x83, 0xf8,
-	0x31, 0x8b, 0xd0, 0xbb, 0x00, 0x2c, 0x8a, 0xc2, 0x88, 0x38, 0xa1, 0x9b, 0xe6, 0x73, 0x61, 0x7b,
-	0xa9, 0x60, 0x42, 0xe5, 0xcc, 0x56, 0xe8, 0x32, 0x5c, 0x61, 0xf9, 0x11, 0x7d, 0x02, 0x0b, 0x63,
-	0x59, 0x9b, 0x5c, 0x9b, 0x5a, 0x9f, 0xdf, 0xfe, 0x4a, 0x41, 0xf1, 0x39, 0x86, 0x37, 0x55, 0xdb,
-	0xc2, 0xb2, 0x9e, 0xc6, 0x09, 0xd7, 0xd8, 0x48, 0x8a, 0xbf, 0x01, 0xb5, 0xf1, 0xa2, 0xe0, 0xf0,
-	0x3b, 0x97, 0x80, 0x2f, 0xa2, 0x57, 0xc7, 0xc1, 0x47, 0x13, 0x39, 0x7d, 0x69, 0xf0, 0xbb, 0x6a,
-	0x01, 0xbc, 0x57, 0xc8, 0xf7, 0xca, 0xb7, 0xa1, 0x5a, 0xbc, 0x18, 0x42, 0x30, 0x1d, 0xd1, 0xc0,
-	0x15, 0x81, 0xad, 0x62, 0x71, 0xe6, 0xb4, 0xd3, 0x88, 0xc5, 0x59, 0x73, 0x88, 0x33, 0xa7, 0xd1,
-	0x61, 0x12, 0x88, 0xea, 0xaf, 0x62, 0x71, 0x46, 0x4b, 0x30, 0xf3, 0x90, 0xc6, 0x7d, 0x26, 0x2a,
-	0xba, 0x8a, 0xd3, 0x87, 0x95, 0x9f, 0x96, 0x60, 0xfe, 0x55, 0x59, 0xd8, 0x82, 0xab, 0x4e, 0x18,
-	0x1c, 0x7b, 0x2e, 0xbf, 0x2c, 0xf5, 0xbd, 0xe4, 0x8c, 0x3c, 0x64, 0x67, 0x99, 0x3d, 0x34, 0xc6,
-	0xda, 0x67, 0x67, 0xe8, 0x0b, 0x50, 0xf3, 0x82, 0x84, 0xf5, 0xa2, 0x5c, 0x34, 0xed, 0x9d, 0xea,
-	0x39, 0x71, 0x9f, 0x9d, 0xad, 0xa8, 0x30, 0x5f, 0x08, 0xd0, 0xb3, 0x1c, 0x8c, 0x0b, 0x0e, 0xf2,
-	0x33, 0x5a, 0x80, 0xc9, 0x7d, 0x27, 0x73, 0x6f, 0x72, 0xdf, 0x69, 0x7e, 0x77, 0x0a, 0xae, 0xd9,
-	0x03, 0x97, 0x26, 0xac, 0x1d, 0x3a, 0xaf, 0x74, 0x04, 0xbd, 0x03, 0x4b, 0xf1, 0x43, 0x6f, 0x40,
-	0xe2, 0xe1, 0x51, 0xec, 0x44, 0xde, 0x11, 0x8b, 0x88, 0x4b, 0x13, 0x2a, 0x6c, 0x97, 0x31, 0xe2,
-	0x3c, 0xf3, 0x9c, 0xa5, 0xd0, 0x84, 0xa2, 0x37, 0x61, 0xc1, 0x0b, 0x3c, 0x1e, 0x08, 0x42, 0x93,
-	0x84, 0x3a, 0x0f, 0xb2, 0x29, 0x53, 0xcb, 0xa8, 0xb2, 0x20, 0xa2, 0x16, 0xbc, 0xee, 0x0e, 0xa9,
-	0x4f, 0x22, 0xd6, 0xf3, 0xe2, 0x24, 0x12, 0x4e, 0x93, 0xf7, 0x7a, 0xc4, 0x0b, 0x5c, 0x5e, 0x4b,
-	0x61, 0x24, 0xe2, 0x55, 0xc6, 0x37, 0xb8, 0x14, 0x2e, 0x08, 0xbd, 0xd7, 0xd3, 0x72, 0x91, 0x8b,
-	0x7b, 0x7f, 0xf6, 0x92, 0xbd, 0x7f, 0x11, 0xca, 0x6d, 0x31, 0x72, 0xfe, 0x07, 0xca, 0xed, 0x31,
-	0x94, 0xdb, 0xcd, 0x4f, 0x6b, 0xb0, 0x34, 0x9a, 0x83, 0x97, 0x19, 0x1b, 0x5f, 0x02, 0xe4, 0xb2,
-	0x63, 0x3a, 0xf4, 0x13, 0xe2, 0x84, 0x41, 0xc2, 0x4e, 0xb9, 0x5b, 0x22, 0x41, 0x35, 0x2c, 0x65,
-	0x9c, 0x56, 0xca, 0xd0, 0x5c, 0x74, 0x08, 0x90, 0x84, 0x09, 0x8f, 0x78, 0xff, 0x28, 0x12, 0xb9,
-	0x99, 0xdf, 0xfe, 0x6a, 0xc1, 0xc4, 0x45, 0x7e, 0x6d, 0xca, 0xbd, 0x5e, 0xc4, 0x7a, 0x34, 0x61,
-	0x6e, 0x87, 0x9e, 0x7a, 0xfd, 0x61, 0x7f, 0xd7, 0xe3, 0x21, 0x66, 0xb8, 0x22, 0xb0, 0xe4, 0xfe,
-	0x51, 0x84, 0x36, 0x60, 0x91, 0xfa, 0x3e, 0xa1, 0x83, 0x20, 0x26, 0x5e, 0xe0, 0xf8, 0x43, 0xf7,
-	0x7c, 0x6b, 0x5c, 0xa1, 0xbe, 0x2f, 0x0f, 0x82, 0x58, 0xcb, 0xc8, 0x68, 0x17, 0xa6, 0xe8, 0x20,
-	0x68, 0xcc, 0x88, 0x11, 0xf1, 0xce, 0x73, 0xad, 0x1b, 0x7a, 0x8b, 0x37, 0x4d, 0x6f, 0x98, 0xa6,
-	0x15, 0x73, 0x65, 0xb4, 0x07, 0x6b, 0xe7, 0xd7, 0x7e, 0x40, 0xa3, 0x9e, 0x17, 0xf4, 0xc4, 0x81,
-	0x3a, 0x09, 0x8b, 0xbc, 0x38, 0xf1, 0x9c, 0x74, 0x99, 0x54, 0xf0, 0xeb, 0x79, 0x10, 0x32, 0xb1,
-	0xd6, 0xa8, 0x14, 0xba, 0x0e, 0xb3, 0xfd, 0xd8, 0x8b, 0xdd, 0x20, 0x5b, 0x1e, 0xd9, 0x13, 0xa2,
-	0x70, 0x35, 0x60, 0xc9, 0xe3, 0x30, 0x7a, 0x48, 0xa8, 0xe3, 0xb0, 0x38, 0x26, 0x7d, 0x9e, 0x96,
-	0xb2, 0x48, 0xcb, 0xed, 0xe7, 0x79, 0xad, 0xa7, 0xaa, 0xb2, 0xd0, 0xec, 0xf0, 0x9c, 0x2d, 0x06,
-	0xe3, 0x24, 0xa4, 0xc2, 0x2a, 0xaf, 0xea, 0x30, 0xa0, 0x7e, 0xde, 0x37, 0x03, 0x51, 0xde, 0xdf,
-	0x09, 0x03, 0x96, 0x56, 0x41, 0x65, 0x6d, 0x6a, 0xbd, 0x8a, 0x6f, 0xe6, 0x62, 0x66, 0x41, 0xea,
-	0x7e, 0x18, 0x30, 0x51, 0x02, 0x17, 0x16, 0x37, 0xbc, 0x92, 0xe2, 0x9e, 0xbf, 0x64, 0x71, 0xaf,
-	0xfc, 0x68, 0x06, 0xa4, 0xf1, 0x8c, 0xa1, 0xd7, 0x00, 0x0a, 0xb5, 0x59, 0x12, 0xb5, 0x59, 0x71,
-	0xce, 0x8b, 0xf2, 0x2d, 0x58, 0x8c, 0x59, 0x74, 0xe2, 0x39, 0x8c, 0xc4, 0xcc, 0x67, 0x0e, 0xd7,
-	0x11, 0x15, 0x5c, 0xc1, 0x52, 0xc6, 0x30, 0x73, 0x3a, 0xfa, 0x26, 0xcc, 0x3f, 0x0a, 0x63, 0xfe,
-	0xb6, 0x75, 0xec, 0xf9, 0x2c, 0x2b, 0xe1, 0xf7, 0x2f, 0x5b, 0x44, 0x9b, 0x1f, 0x85, 0xa6, 0x91,
-	0x42, 0x60, 0x78, 0x14, 0xc6, 0xd9, 0x19, 0xb5, 0x61, 0x5a, 0x74, 0xc6, 0xf4, 0x4b, 0x76, 0x86,
-	0x40, 0x41, 0xf7, 0x60, 0x6a, 0xe0, 0x06, 0x62, 0x3e, 0x2d, 0xbc, 0x00, 0xd8, 0xb8, 0x8f, 0x86,
-	0xa2, 0x5b, 0x67, 0x03, 0x86, 0x39, 0x08, 0xfa, 0x1a, 0x34, 0x9e, 0x53, 0xe8, 0x75, 0xe7, 0xe2,
-	0x0a, 0x5f, 0xf9, 0xbc, 0x04, 0xf0, 0xe4, 0xbe, 0x68, 0x19, 0xca, 0x8e, 0x4f, 0xe3, 0x38, 0xcf,
-	0xc5, 0x0c, 0x9e, 0x13, 0xcf, 0x9a, 0xcb, 0x47, 0xf2, 0x20, 0xf2, 0x42, 0xb1, 0x89, 0x7c, 0x76,
-	0xc2, 0xfc, 0x6c, 0x90, 0xd4, 0x72, 0x6a, 0x9b, 0x13, 0xd1, 0xbb, 0x70, 0x6d, 0x10, 0x31, 0xd6,
-	0x4f, 0x8b, 0xd5, 0xa1, 0x03, 0x7a, 0xe4, 0xf1, 0x6d, 0x96, 0x0d, 0xfb, 0xa5, 0x27, 0xcc, 0xd6,
-	0x39, 0x8f, 0x5f, 0xa0, 0xa0, 0x74, 0x32, 0xf4, 0x03, 0x16, 0xe5, 0x7a, 0xe9, 0xa0, 0xa8, 0x3f,
-	0xe1, 0x1f, 0x14, 0xd9, 0xcd, 0xf7, 0x61, 0x2e, 0x8b, 0x05, 0x2a, 0xc3, 0xb4, 0x66, 0x1c, 0x7c,
-	0x59, 0x9a, 0xc8, 0x4e, 0x3b, 0x52, 0x09, 0x01, 0xcc, 0x72, 0xda, 0xc1, 0x8e, 0x34, 0x89, 0x24,
-	0xa8, 0xf2, 0x33, 0xe9, 0x62, 0x22, 0xb8, 0x53, 0x2b, 0xff, 0x29, 0x41, 0xe3, 0x59, 0x79, 0x42,
-	0xeb, 0x20, 0xf5, 0xe9, 0x29, 0x39, 0xa2, 0x81, 0xfb, 0xd8, 0x73, 0x93, 0x07, 0x64, 0xe8, 0x67,
-	0xf5, 0xb9, 0xd0, 0xa7, 0xa7, 0xbb, 0x39, 0xd9, 0xf6, 0x9f, 0x96, 0x74, 0xf3, 0xe0, 0x8c, 0x48,
-	0x2a, 0x3e, 0xfa, 0x16, 0x4c, 0x0f, 0x03, 0x2f, 0x11, 0xc1, 0x58, 0xd8, 0xd6, 0xfe, 0xdf, 0x1a,
-	0xda, 0xcc, 0x7e, 0xed, 0xc0, 0x4b, 0x62, 0xb9, 0xb3, 0x8b, 0xb1, 0x80, 0x6d, 0xbe, 0x09, 0xd2,
-	0x38, 0x07, 0xcd, 0xc1, 0xd4, 0xae, 0x61, 0xa6, 0x41, 0xd9, 0xe7, 0xa7, 0x52, 0xf3, 0x1e, 0x2c,
-	0x3e, 0x35, 0x83, 0xd0, 0x75, 0x40, 0x86, 0xdc, 0xda, 0x57, 0x2d, 0x22, 0xeb, 0x0a, 0x69, 0x69,
-	0xb8, 0x65, 0x6b, 0x96, 0x34, 0x81, 0xaa, 0x50, 0xc6, 0xaa, 0xa9, 0xe2, 0x03, 0x55, 0x91, 0x4a,
-	0xe8, 0x0a, 0xcc, 0x77, 0xf5, 0xf6, 0xc7, 0x24, 0x15, 0x95, 0x26, 0x9b, 0xbf, 0x9d, 0x84, 0x6b,
-	0x2d, 0x1a, 0x38, 0xcc, 0xbf, 0xd4, 0x5b, 0xc3, 0x27, 0xb0, 0xe8, 0x08, 0x2d, 0x3f, 0x5d, 0xda,
-	0xc9, 0xd9, 0x80, 0x89, 0x98, 0x8d, 0xce, 0xcf, 0x0b, 0x91, 0x33, 0x6a, 0xaa, 0x29, 0xba, 0x40,
-	0x72, 0xc6, 0x28, 0xcd, 0x9f, 0x94, 0x40, 0x1a, 0x17, 0x43, 0x0d, 0x58, 0xea, 0x74, 0x54, 0x62,
-	0x1b, 0x8a, 0x6c, 0xa9, 0xc4, 0xc0, 0xdd, 0x96, 0xaa, 0xd8, 0x58, 0x95, 0x26, 0xd0, 0x32, 0x5c,
-	0x33, 0xef, 0x9a, 0xfa, 0xd3, 0xac, 0x12, 0xba, 0x01, 0x75, 0xd3, 0xde, 0x35, 0x5b, 0x58, 0x33,
-	0x2c, 0xad, 0xab, 0x93, 0x43, 0xcd, 0xda, 0x53, 0xb0, 0x7c, 0x28, 0xb7, 0xa5, 0x49, 0x8e, 0x38,
-	0xae, 0x42, 0xb4, 0xc3, 0x3b, 0xd2, 0x14, 0xba, 0x09, 0x0d, 0x4d, 0xd7, 0x2c, 0x4d, 0x6e, 0x13,
-	0xd9, 0xb2, 0xe4, 0xd6, 0x5e, 0x01, 0x74, 0xba, 0xb9, 0x0f, 0x4b, 0xa3, 0x57, 0x7b, 0x89, 0x35,
-	0xdf, 0x7c, 0x1b, 0x16, 0x8c, 0x61, 0xd4, 0x63, 0xb6, 0xfa, 0x22, 0xa1, 0x6f, 0x2a, 0x50, 0xcb,
-	0xc4, 0x5f, 0xc6, 0xe8, 0x2d, 0xa8, 0x62, 0x16, 0xb3, 0x24, 0x37, 0x59, 0x87, 0x39, 0x61, 0x52,
-	0x0c, 0x8e, 0xa9, 0xf5, 0x0a, 0x9e, 0xe5, 0x8f, 0x9a, 0xdb, 0xdc, 0x85, 0x79, 0x21, 0xf8, 0x32,
-	0xc6, 0x3e, 0x80, 0x85, 0xd1, 0x0d, 0x85, 0xde, 0x86, 0xab, 0x41, 0x44, 0x68, 0x4c, 0x62, 0xe6,
-	0x84, 0x81, 0x4b, 0xa3, 0x33, 0x12, 0xd1, 0x44, 0xe0, 0x95, 0xb1, 0x14, 0x44, 0x72, 0x6c, 0xe6,
-	0x0c, 0x4c, 0x93, 0xa6, 0x3a, 0x06, 0x70, 0x9b, 0xcf, 0xa9, 0x0b, 0xf7, 0x6b, 0x06, 0xb1, 0x74,
-	0xd1, 0x56, 0xdd, 0xf8, 0xf7, 0x34, 0x54, 0xce, 0x1d, 0x44, 0x35, 0xa8, 0xd8, 0xba, 0xa2, 0xde,
-	0xd1, 0x74, 0x55, 0x91, 0x26, 0xd0, 0x35, 0x90, 0x3a, 0x76, 0xdb, 0xd2, 0x08, 0xee, 0xda, 0xba,
-	0x42, 0x64, 0xdb, 0xda, 0x93, 0xfe, 0x35, 0x87, 0xaa, 0x30, 0x67, 0xda, 0xad, 0x96, 0x6a, 0x9a,
-	0xd2, 0xdf, 0xaf, 0xa0, 0x25, 0xb8, 0xd2, 0xd6, 0x3a, 0x9a, 0xa5, 0x2a, 0x24, 0xa7, 0xfe, 0xe3,
-	0x0a, 0xaa, 0x03, 0x6a, 0x75, 0x3b, 0x1d, 0xde, 0x78, 0xb6, 0x6e, 0xda, 0x46, 0x17, 0x5b, 0xaa,
-	0x22, 0xfd, 0xae, 0x8e, 0xae, 0xc3, 0xa2, 0xad, 0xcb, 0xbb, 0x6d, 0x95, 0x58, 0x5d, 0xa2, 0xa8,
-	0x6d, 0xed, 0x40, 0xc5, 0xd2, 0xef, 0xeb, 0xdc, 0x16, 0x56, 0xe5, 0x76, 0x87, 0xe8, 0x5d, 0x8b,
-	0x64, 0xcd, 0xf9, 0x59, 0x1d, 0xd5, 0xa0, 0x6c, 0x75, 0xbb, 0x64, 0xd7, 0x36, 0x3f, 0x96, 0xfe,
-	0x50, 0x47, 0x08, 0x6a, 0xed, 0x6e, 0xd7, 0x20, 0x8a, 0x6a, 0xa9, 0x2d, 0x8e, 0xf8, 0xc7, 0x3a,
-	0x6a, 0xc0, 0x55, 0xac, 0x2a, 0x1a, 0x56, 0x5b, 0x16, 0xd1, 0x74, 0x45, 0x6b, 0xc9, 0xbc, 0xaa,
-	0xa5, 0xcf, 0xeb, 0xe8, 0x26, 0xd4, 0x65, 0xc3, 0x68, 0x67, 0x94, 0xd4, 0x91, 0xcc, 0x93, 0x3f,
-	0x09, 0x8b, 0x9a, 0x7e, 0x20, 0xb7, 0x35, 0x65, 0x8f, 0x28, 0x98, 0xec, 0x6a, 0x96, 0x29, 0xfd,
-	0xb9, 0x48, 0x26, 0xf2, 0x81, 0x91, 0x92, 0xff, 0x52, 0x47, 0x8b, 0x50, 0xb5, 0xf5, 0x7d, 0xbd,
-	0x7b, 0xa8, 0x13, 0x43, 0x55, 0xb1, 0xf4, 0xd7, 0x14, 0xde, 0xb6, 0xf6, 0x54, 0xdd, 0xca, 0x2d,
-	0x60, 0xf5, 0x5e, 0xea, 0xd6, 0xcf, 0x56, 0xb9, 0x42, 0xd7, 0xb6, 0x48, 0xf7, 0x0e, 0x31, 0x0d,
-	0xb9, 0xa5, 0x4a, 0x3f, 0x5f, 0xe5, 0xde, 0xab, 0x6d, 0xb5, 0x25, 0x44, 0xdb, 0x5d, 0xd3, 0x92,
-	0x7e, 0xb1, 0x8a, 0x6e, 0xc0, 0x75, 0x0e, 0xd2, 0xc5, 0xda, 0xfd, 0x31, 0x8c, 0x1f, 0xdc, 0x12,
-	0x46, 0x4d, 0x15, 0x93, 0xcc, 0xb2, 0xf4, 0xbd, 0x5b, 0x3c, 0xb0, 0xb9, 0x1f, 0xa6, 0x6a, 0x9a,
-	0x5c, 0x43, 0x53, 0xa4, 0xef, 0xdf, 0x42, 0xaf, 0x41, 0x23, 0x67, 0xa8, 0x86, 0x49, 0x8a, 0x1d,
-	0x2e, 0xfd, 0x6a, 0x83, 0xa7, 0x09, 0xcb, 0x96, 0x88, 0xae, 0xdc, 0x6e, 0x77, 0x0f, 0x55, 0x45,
-	0xfa, 0xf5, 0x86, 0x88, 0x5d, 0x57, 0xee, 0x68, 0xfa, 0xdd, 0x11, 0xce, 0x0f, 0x6f, 0xf1, 0x3c,
-	0xa9, 0x1f, 0xd9, 0x9a, 0xd1, 0x51, 0x75, 0xeb, 0xdc, 0xfe, 0x6f, 0x84, 0x86, 0xad, 0xef, 0xa7,
-	0xe6, 0xf1, 0x41, 0xaa, 0xa8, 0xa8, 0xd2, 0xa7, 0x1b, 0xe8, 0x8b, 0xb0, 0x3a, 0x16, 0x0e, 0x45,
-	0xb6, 0x64, 0x62, 0xeb, 0xf2, 0x81, 0xac, 0xb5, 0x79, 0xca, 0xa5, 0x7f, 0xae, 0x6d, 0xff, 0x78,
-	0x12, 0xca, 0xe6, 0x0e, 0x35, 0xa2, 0xf0, 0xf4, 0x0c, 0x9d, 0xc0, 0xf2, 0x33, 0x3f, 0x94, 0xd1,
-	0x5b, 0x2f, 0xf2, 0x39, 0x9d, 0x35, 0xeb, 0xca, 0xc6, 0x8b, 0x7f, 0x7b, 0x37, 0x27, 0x90, 0x0d,
-	0x0b, 0xa3, 0xdb, 0x09, 0xad, 0x3d, 0x73, 0x71, 0xe5, 0x16, 0x56, 0x9f, 0xb3, 0xda, 0x9a, 0x13,
-	0xe8, 0x43, 0x98, 0xcb, 0xe6, 0x10, 0x2a, 0xbe, 0x44, 0x8e, 0x8e, 0xb2, 0x95, 0xc6, 0xd3, 0xac,
-	0x1c, 0x61, 0xfb, 0x97, 0x25, 0x58, 0x34, 0x77, 0xe8, 0x5d, 0x9a, 0xb0, 0xc7, 0xf4, 0xcc, 0x4c,
-	0x5f, 0x07, 0xb9, 0xbb, 0xa3, 0xb3, 0x75, 0xc4, 0xdd, 0x0b, 0x37, 0xca, 0x88, 0xbb, 0x17, 0x0d,
-	0xe6, 0xe6, 0x04, 0xfa, 0x3a, 0xcc, 0x88, 0x39, 0x86, 0xea, 0x05, 0xd9, 0xe2, 0x08, 0x5c, 0xb9,
-	0x3e, 0xce, 0xc8, 0x75, 0x77, 0x6f, 0xdc, 0x5f, 0x16, 0xac, 0xad, 0x63, 0xd6, 0xdb, 0x72, 0xfc,
-	0x70, 0xe8, 0x6e, 0xf5, 0xc2, 0xec, 0x0f, 0xc2, 0xa3, 0x59, 0xf1, 0xfb, 0xee, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xa2, 0x55, 0x1b, 0xbe, 0x35, 0x14, 0x00, 0x00,
+	// 2433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x73, 0xe3, 0x56,
+	0x15, 0x8f, 0xf3, 0xed, 0x13, 0x3b, 0xab, 0xdc, 0xcd, 0xae, 0x1d, 0x67, 0xb7, 0x49, 0x5d, 0x96,
+	0xcd, 0x6c, 0x69, 0xb6, 0x9b, 0xd2, 0xa5, 0xd0, 0x19, 0x5a, 0xd9, 0xd2, 0x6e, 0xd4, 0xd8, 0xb2,
+	0x7b, 0x25, 0x25, 0xd3, 0x02, 0xbd, 0xdc, 0x48, 0x37, 0x5e, 0xcd, 0xca, 0x92, 0x2b, 0xc9, 0xbb,
+	0x09, 0x6f, 0x3c, 0x16, 0x98, 0xe1, 0x0f, 0x80, 0x19, 0x06, 0x78, 0x05, 0xfa, 0xc0, 0x13, 0x14,
+	0xca, 0xc7, 0x3b, 0x0f, 0x30, 0xc3, 0x1f, 0xc1, 0x0c, 0xc3, 0x13, 0x0f, 0x3c, 0x32, 0xf7, 0x4a,
+	0x76, 0x64, 0xaf, 0x77, 0xb3, 0x69, 0x0a, 0x4f, 0xbe, 0x3a, 0xe7, 0x77, 0x3e, 0xee, 0x39, 0xe7,
+	0x9e, 0x7b, 0x24, 0x43, 0xe5, 0x88, 0x75, 0x6e, 0xf7, 0xc2, 0x20, 0x0e, 0xa2, 0xdb, 0xd1, 0x5d,
+	0x4a, 0x7a, 0x61, 0x70, 0x7c, 0xb2, 0x2d, 0x08, 0x28, 0xdf, 0xa5, 0x9d, 0x2e, 0xdd, 0x3e, 0x62,
+	0x9d, 0xea, 0x5f, 0x66, 0x60, 0x53, 0xee, 0xc7, 0x0f, 0x98, 0x1f, 0xbb, 0x36, 0x8d, 0xdd, 0xc0,
+	0xd7, 0xfc, 0xa3, 0x20, 0xec, 0x8a, 0x25, 0x66, 0x1f, 0xf6, 0x59, 0x14, 0xa3, 0x75, 0xc8, 0xf7,
+	0x23, 0x16, 0x12, 0x9f, 0x76, 0x59, 0x39, 0xb7, 0x99, 0xdb, 0xca, 0xe3, 0x45, 0x4e, 0xd0, 0x69,
+	0x97, 0xa1, 0x17, 0xa1, 0xf0, 0xc8, 0x8d, 0xdc, 0x98, 0x39, 0xa4, 0xe7, 0x75, 0xfd, 0xf2, 0xf4,
+	0x66, 0x6e, 0xab, 0x80, 0x97, 0x52, 0x5a, 0xdb, 0xeb, 0xfa, 0xe8, 0x2d, 0xb8, 0xe6, 0xf7, 0xbb,
+	0x24, 0x4c, 0xd4, 0x31, 0x87, 0xb0, 0x7e, 0x1c, 0x52, 0x9f, 0x3c, 0x62, 0x76, 0x1c, 0x84, 0x51,
+	0x79, 0x66, 0x33, 0xb7, 0x55, 0xc4, 0x6b, 0x7e, 0xbf, 0x8b, 0x07, 0x10, 0x55, 0x20, 0xf6, 0x13,
+	0x00, 0x7a, 0x1b, 0xae, 0xb9, 0xdd, 0x2e, 0x73, 0x5c, 0x1a, 0x33, 0x12, 0xb2, 0xa8, 0x17, 0xf8,
+	0x11, 0x23, 0xbd, 0x90, 0x1d, 0xb1, 0x30, 0x64, 0x4e, 0x79, 0x76, 0x33, 0xb7, 0xb5, 0x88, 0x2b,
+	0x43, 0x0c, 0x4e, 0x21, 0xed, 0x01, 0x02, 0x6d, 0xc0, 0x52, 0xc8, 0xa2, 0x13, 0xdf, 0x26, 0xae,
+	0x7f, 0x14, 0x94, 0xe7, 0x84, 0x93, 0x90, 0x90, 0xf8, 0x8e, 0xd1, 0x2e, 0xbc, 0x38, 0xea, 0x63,
+	0xe2, 0x62, 0x87, 0x65, 0x1d, 0x9d, 0x17, 0x8e, 0x5e, 0xcf, 0x3a, 0x6a, 0x71, 0xd8, 0x7d, 0x96,
+	0x71, 0xf6, 0x75, 0x28, 0x65, 0x65, 0xb3, 0x66, 0x17, 0x84, 0xd9, 0xd5, 0xfe, 0x50, 0x06, 0x9f,
+	0x3a, 0xa0, 0xc0, 0xca, 0x11, 0xa3, 0x71, 0x3f, 0x64, 0xc4, 0x73, 0xa3, 0x98, 0xb8, 0x0e, 0xd9,
+	0x29, 0x2f, 0x6e, 0xe6, 0xb6, 0x96, 0x76, 0xd6, 0xb6, 0x87, 0x09, 0xdb, 0xbe, 0x97, 0x60, 0x1a,
+	0x6e, 0x14, 0x6b, 0xce, 0x0e, 0x5e, 0x3e, 0x1a, 0x79, 0xae, 0x7e, 0x32, 0x07, 0x1b, 0x4f, 0xcd,
+	0xa7, 0xec, 0x47, 0x8f, 0x59, 0x88, 0x5e, 0x03, 0x60, 0x61, 0x18, 0x84, 0xc4, 0x0e, 0x9c, 0x24,
+	0x9f, 0xcb, 0x3b, 0xab, 0x19, 0x13, 0x2a, 0x67, 0xd6, 0x03, 0x87, 0xe1, 0x3c, 0x1b, 0x2c, 0xd1,
+	0x07, 0xb0, 0x3c, 0x96, 0xb5, 0xe9, 0xcd, 0x99, 0xad, 0xa5, 0x9d, 0xaf, 0x64, 0x04, 0xcf, 0x30,
+	0xbc, 0xad, 0x5a, 0x26, 0x96, 0xf5, 0x24, 0x4e, 0xb8, 0xc8, 0x46, 0x52, 0xfc, 0x0d, 0x28, 0x8e,
+	0x17, 0x05, 0x57, 0x7f, 0xf7, 0x1c, 0xea, 0xb3, 0xda, 0x0b, 0xe3, 0xca, 0x47, 0x13, 0x39, 0x7b,
+	0x6e, 0xe5, 0xf7, 0xd5, 0x8c, 0xf2, 0x4e, 0x26, 0xdf, 0x95, 0x6f, 0x43, 0x21, 0xbb, 0x31, 0x84,
+	0x60, 0x36, 0xa4, 0xbe, 0x23, 0x02, 0x5b, 0xc0, 0x62, 0xcd, 0x69, 0xc7, 0x21, 0x8b, 0xd2, 0xc3,
+	0x21, 0xd6, 0x9c, 0x46, 0xfb, 0xb1, 0x2f, 0xaa, 0xbf, 0x80, 0xc5, 0x1a, 0xad, 0xc2, 0xdc, 0x43,
+	0x1a, 0x75, 0x99, 0xa8, 0xe8, 0x02, 0x4e, 0x1e, 0x2a, 0x3f, 0xc9, 0xc1, 0xd2, 0xe7, 0x65, 0xe1,
+	0x36, 0x5c, 0xb6, 0x03, 0xff, 0xc8, 0x75, 0xf8, 0x66, 0xa9, 0xe7, 0xc6, 0x27, 0xe4, 0x21, 0x3b,
+	0x49, 0xed, 0xa1, 0x31, 0xd6, 0x1e, 0x3b, 0x41, 0x2f, 0x41, 0xd1, 0xf5, 0x63, 0xd6, 0x09, 0x07,
+	0xd0, 0xe4, 0xec, 0x14, 0x86, 0xc4, 0x3d, 0x76, 0x52, 0x51, 0x61, 0x29, 0x13, 0xa0, 0xa7, 0x39,
+	0x18, 0x65, 0x1c, 0xe4, 0x6b, 0xb4, 0x0c, 0xd3, 0x7b, 0x76, 0xea, 0xde, 0xf4, 0x9e, 0x5d, 0xfd,
+	0xee, 0x0c, 0x5c, 0xb1, 0x7a, 0x0e, 0x8d, 0x59, 0x23, 0xb0, 0x3f, 0xd7, 0x16, 0xf4, 0x2a, 0xac,
+	0x46, 0x0f, 0xdd, 0x1e, 0x89, 0xfa, 0x87, 0x91, 0x1d, 0xba, 0x87, 0x2c, 0x24, 0x0e, 0x8d, 0xa9,
+	0xb0, 0xbd, 0x88, 0x11, 0xe7, 0x19, 0x43, 0x96, 0x42, 0x63, 0x8a, 0x6e, 0xc0, 0xb2, 0xeb, 0xbb,
+	0x3c, 0x10, 0x84, 0xc6, 0x31, 0xb5, 0x1f, 0xa4, 0x5d, 0xa6, 0x98, 0x52, 0x65, 0x41, 0x44, 0x75,
+	0x78, 0xc1, 0xe9, 0x53, 0x8f, 0x84, 0xac, 0xe3, 0x46, 0x71, 0x28, 0x9c, 0x26, 0xaf, 0x77, 0x88,
+	0xeb, 0x3b, 0xbc, 0x96, 0x82, 0x50, 0xc4, 0x6b, 0x11, 0xaf, 0x73, 0x14, 0xce, 0x80, 0x5e, 0xef,
+	0x68, 0x03, 0xc8, 0xe4, 0xb3, 0x3f, 0x7f, 0xce, 0xb3, 0x3f, 0x49, 0xcb, 0x9d, 0xf2, 0xc2, 0xb3,
+	0xb5, 0xdc, 0x19, 0xd3, 0x72, 0xa7, 0xfa, 0x71, 0x11, 0x56, 0x47, 0x73, 0x70, 0x91, 0xb6, 0xf1,
+	0x25, 0x40, 0x0e, 0x3b, 0xa2, 0x7d, 0x2f, 0x26, 0x76, 0xe0, 0xc7, 0xec, 0x98, 0xbb, 0x25, 0x12,
+	0x54, 0xc4, 0x52, 0xca, 0xa9, 0x27, 0x0c, 0xcd, 0x41, 0x07, 0x00, 0x71, 0x10, 0xf3, 0x88, 0x77,
+	0x0f, 0x43, 0x91, 0x9b, 0xa5, 0x9d, 0x37, 0x32, 0x26, 0x26, 0xf9, 0xb5, 0x2d, 0x77, 0x3a, 0x21,
+	0xeb, 0xd0, 0x98, 0x39, 0x4d, 0x7a, 0xec, 0x76, 0xfb, 0xdd, 0x9a, 0xcb, 0x43, 0xcc, 0x70, 0x5e,
+	0xe8, 0x92, 0xbb, 0x87, 0x21, 0xba, 0x05, 0x2b, 0xd4, 0xf3, 0x08, 0xed, 0xf9, 0x11, 0x71, 0x7d,
+	0xdb, 0xeb, 0x3b, 0xc3, 0x5b, 0xe3, 0x12, 0xf5, 0x3c, 0xb9, 0xe7, 0x47, 0x5a, 0x4a, 0x46, 0x35,
+	0x98, 0xa1, 0x3d, 0xbf, 0x3c, 0x27, 0x5a, 0xc4, 0xab, 0x67, 0x5a, 0x6f, 0xeb, 0x75, 0x7e, 0x68,
+	0x3a, 0xfd, 0x24, 0xad, 0x98, 0x0b, 0xa3, 0x5d, 0xd8, 0x1c, 0x6e, 0xfb, 0x01, 0x0d, 0x3b, 0xae,
+	0xdf, 0x11, 0x0b, 0x6a, 0xc7, 0x2c, 0x74, 0xa3, 0xd8, 0xb5, 0x93, 0xcb, 0x24, 0x8f, 0x5f, 0x18,
+	0x04, 0x21, 0x85, 0xd5, 0x47, 0x51, 0xe8, 0x2a, 0xcc, 0x77, 0x23, 0x37, 0x72, 0xfc, 0xf4, 0xf2,
+	0x48, 0x9f, 0x10, 0x85, 0xcb, 0x3e, 0x8b, 0x1f, 0x07, 0xe1, 0x43, 0x42, 0x6d, 0x9b, 0x45, 0x11,
+	0xe9, 0xf2, 0xb4, 0x2c, 0x8a, 0xb4, 0xdc, 0x39, 0xcb, 0x6b, 0x3d, 0x11, 0x95, 0x85, 0x64, 0x93,
+	0xe7, 0x6c, 0xc5, 0x1f, 0x27, 0x21, 0x15, 0x36, 0x78, 0x55, 0x07, 0x3e, 0xf5, 0x06, 0xe7, 0xa6,
+	0x27, 0xca, 0xfb, 0x3b, 0x81, 0xcf, 0x92, 0x2a, 0xc8, 0x6f, 0xce, 0x6c, 0x15, 0xf0, 0xb5, 0x01,
+	0xcc, 0xc8, 0xa0, 0xde, 0x0f, 0x7c, 0x26, 0x4a, 0x60, 0x62, 0x71, 0xc3, 0xe7, 0x52, 0xdc, 0x4b,
+	0xe7, 0x2c, 0xee, 0xca, 0x0f, 0xe7, 0x40, 0x1a, 0xcf, 0x18, 0xba, 0x0e, 0x90, 0xa9, 0xcd, 0x9c,
+	0xa8, 0xcd, 0xbc, 0x3d, 0x2c, 0xca, 0x97, 0x61, 0x25, 0x62, 0xe1, 0x23, 0xd7, 0x66, 0x24, 0x62,
+	0x1e, 0xb3, 0xb9, 0x8c, 0xa8, 0xe0, 0x3c, 0x96, 0x52, 0x86, 0x31, 0xa0, 0xa3, 0x6f, 0xc2, 0xd2,
+	0x87, 0x41, 0xc4, 0xa7, 0xad, 0x23, 0xd7, 0x63, 0x69, 0x09, 0xbf, 0x79, 0xde, 0x22, 0xda, 0x7e,
+	0x37, 0x30, 0xda, 0x89, 0x0a, 0x0c, 0x1f, 0x06, 0x51, 0xba, 0x46, 0x0d, 0x98, 0x15, 0x27, 0x63,
+	0xf6, 0x82, 0x27, 0x43, 0x68, 0x41, 0xef, 0xc0, 0x4c, 0xcf, 0xf1, 0x45, 0x7f, 0x5a, 0xde, 0x79,
+	0xe3, 0xdc, 0x3e, 0xb6, 0x15, 0xdd, 0x3c, 0xe9, 0x31, 0xcc, 0x95, 0xa0, 0xaf, 0x42, 0xf9, 0x8c,
+	0x42, 0x2f, 0xd9, 0x93, 0x2b, 0xbc, 0xf2, 0x69, 0x0e, 0xe0, 0x74, 0xbf, 0x68, 0x0d, 0x16, 0x6d,
+	0x8f, 0x46, 0xd1, 0x20, 0x17, 0x73, 0x78, 0x41, 0x3c, 0x6b, 0x0e, 0x6f, 0xc9, 0xbd, 0xd0, 0x0d,
+	0xc4, 0x4d, 0xe4, 0xb1, 0x47, 0xcc, 0x4b, 0x1b, 0x49, 0x71, 0x40, 0x6d, 0x70, 0x22, 0x7a, 0x0d,
+	0xae, 0xf4, 0x42, 0xc6, 0xba, 0x49, 0xb1, 0xda, 0xb4, 0x47, 0x0f, 0x5d, 0x7e, 0x9b, 0xa5, 0xcd,
+	0x7e, 0xf5, 0x94, 0x59, 0x1f, 0xf2, 0xf8, 0x06, 0x32, 0x42, 0x8f, 0xfa, 0x9e, 0xcf, 0xc2, 0x81,
+	0x5c, 0xd2, 0x28, 0x4a, 0xa7, 0xfc, 0xfd, 0x2c, 0xbb, 0xfa, 0x26, 0x2c, 0xa4, 0xb1, 0x40, 0x8b,
+	0x30, 0xab, 0xb5, 0xf7, 0xbf, 0x2c, 0x4d, 0xa5, 0xab, 0xbb, 0x52, 0x0e, 0x01, 0xcc, 0x73, 0xda,
+	0xfe, 0x5d, 0x69, 0x1a, 0x49, 0x50, 0xe0, 0x6b, 0xd2, 0xc2, 0x44, 0x70, 0x67, 0x2a, 0xff, 0xce,
+	0x41, 0xf9, 0x69, 0x79, 0x42, 0x5b, 0x20, 0x75, 0xe9, 0x31, 0x39, 0xa4, 0xbe, 0xf3, 0xd8, 0x75,
+	0xe2, 0x07, 0xa4, 0xef, 0xa5, 0xf5, 0xb9, 0xdc, 0xa5, 0xc7, 0xb5, 0x01, 0xd9, 0xf2, 0x9e, 0x44,
+	0x3a, 0x83, 0xe0, 0x8c, 0x20, 0x15, 0x0f, 0x7d, 0x0b, 0x66, 0xfb, 0xbe, 0x1b, 0x8b, 0x60, 0x2c,
+	0xef, 0x68, 0x9f, 0xb5, 0x86, 0xb6, 0xd3, 0x5f, 0xcb, 0x77, 0xe3, 0x48, 0x6e, 0xd6, 0x30, 0x16,
+	0x6a, 0xab, 0x37, 0x40, 0x1a, 0xe7, 0xa0, 0x05, 0x98, 0xa9, 0xb5, 0x8d, 0x24, 0x28, 0x7b, 0x7c,
+	0x95, 0xab, 0xbe, 0x03, 0x2b, 0x4f, 0xf4, 0x20, 0x74, 0x15, 0x50, 0x5b, 0xae, 0xef, 0xa9, 0x26,
+	0x91, 0x75, 0x85, 0xd4, 0x35, 0x5c, 0xb7, 0x34, 0x53, 0x9a, 0x42, 0x05, 0x58, 0xc4, 0xaa, 0xa1,
+	0xe2, 0x7d, 0x55, 0x91, 0x72, 0xe8, 0x12, 0x2c, 0xb5, 0xf4, 0xc6, 0x7b, 0x24, 0x81, 0x4a, 0xd3,
+	0xd5, 0x5f, 0x4f, 0xc3, 0x95, 0x3a, 0xf5, 0x6d, 0xe6, 0x9d, 0x6b, 0x6a, 0xf8, 0x00, 0x56, 0x6c,
+	0x21, 0xe5, 0x09, 0x19, 0x12, 0x9f, 0xf4, 0x58, 0x79, 0xfa, 0x89, 0xfe, 0x39, 0x51, 0xf3, 0x76,
+	0x3d, 0x23, 0x29, 0x4e, 0x81, 0x64, 0x8f, 0x51, 0xaa, 0x3f, 0xce, 0x81, 0x34, 0x0e, 0x43, 0x65,
+	0x58, 0x6d, 0x36, 0x55, 0x62, 0xb5, 0x15, 0xd9, 0x54, 0x49, 0x1b, 0xb7, 0xea, 0xaa, 0x62, 0x61,
+	0x55, 0x9a, 0x42, 0x6b, 0x70, 0xc5, 0xb8, 0x6f, 0xe8, 0x4f, 0xb2, 0x72, 0x68, 0x1d, 0x4a, 0x86,
+	0x55, 0x33, 0xea, 0x58, 0x6b, 0x9b, 0x5a, 0x4b, 0x27, 0x07, 0x9a, 0xb9, 0xab, 0x60, 0xf9, 0x40,
+	0x6e, 0x48, 0xd3, 0x5c, 0xe3, 0xb8, 0x08, 0xd1, 0x0e, 0xee, 0x49, 0x33, 0xe8, 0x1a, 0x94, 0x35,
+	0x5d, 0x33, 0x35, 0xb9, 0x41, 0x64, 0xd3, 0x94, 0xeb, 0xbb, 0x19, 0xa5, 0xb3, 0xd5, 0x3d, 0x58,
+	0x1d, 0xdd, 0xda, 0x05, 0xae, 0xf9, 0xea, 0x2b, 0xb0, 0xdc, 0xee, 0x87, 0x1d, 0x66, 0xa9, 0xcf,
+	0x13, 0xfa, 0xaa, 0x02, 0xc5, 0x14, 0x7e, 0x11, 0xa3, 0x37, 0xa1, 0x80, 0x59, 0xc4, 0xe2, 0x81,
+	0xc9, 0x12, 0x2c, 0x08, 0x93, 0xa2, 0x71, 0xcc, 0x6c, 0xe5, 0xf1, 0x3c, 0x7f, 0xd4, 0x9c, 0x6a,
+	0x0d, 0x96, 0x04, 0xf0, 0x22, 0xc6, 0xde, 0x82, 0xe5, 0xd1, 0x1b, 0x0a, 0xbd, 0x02, 0x97, 0xfd,
+	0x90, 0xd0, 0x88, 0x44, 0xcc, 0x0e, 0x7c, 0x87, 0x86, 0x27, 0x24, 0xa4, 0xb1, 0xd0, 0xb7, 0x88,
+	0x25, 0x3f, 0x94, 0x23, 0x63, 0xc0, 0xc0, 0x34, 0xae, 0xaa, 0x63, 0x0a, 0xee, 0xf0, 0x3e, 0x35,
+	0xf1, 0x7e, 0x4d, 0x55, 0xac, 0x4e, 0xba, 0x55, 0xab, 0xff, 0x9c, 0x87, 0x75, 0xcd, 0x8f, 0x58,
+	0x18, 0x8f, 0xce, 0xab, 0xcf, 0x55, 0xf2, 0xeb, 0x90, 0x77, 0x9d, 0x90, 0x1c, 0x79, 0xb4, 0x13,
+	0xa5, 0xed, 0x61, 0xd1, 0x75, 0xc2, 0x7b, 0xfc, 0x39, 0x33, 0x69, 0xcc, 0x8c, 0x4c, 0x1a, 0x93,
+	0x47, 0xb8, 0xd9, 0xe7, 0x1a, 0xe1, 0xe6, 0xfe, 0xc7, 0x23, 0xdc, 0xfc, 0x33, 0x47, 0xb8, 0x85,
+	0x8b, 0x8c, 0x70, 0xff, 0x87, 0x01, 0xcb, 0x81, 0x95, 0xcc, 0xfb, 0x48, 0x14, 0xd3, 0xb8, 0x1f,
+	0x95, 0xf3, 0xc2, 0x40, 0xf6, 0xb5, 0xfa, 0x19, 0xe9, 0xde, 0x3e, 0xa5, 0x1a, 0x42, 0x1c, 0x4b,
+	0xd1, 0x18, 0x05, 0x7d, 0x1d, 0xd6, 0x83, 0x1e, 0x0b, 0x69, 0x1c, 0x84, 0xc4, 0x61, 0x31, 0x0b,
+	0xbb, 0xae, 0xcf, 0x1c, 0x72, 0x48, 0xc3, 0xd0, 0xf5, 0x3b, 0x62, 0x12, 0x2b, 0xe2, 0xb5, 0x01,
+	0x44, 0x19, 0x22, 0x6a, 0x09, 0x20, 0xb9, 0x75, 0x59, 0xc4, 0x7c, 0x9b, 0xa5, 0x95, 0xb3, 0x34,
+	0xb8, 0x75, 0x13, 0xaa, 0x28, 0x9f, 0xea, 0x2e, 0x48, 0xe3, 0xce, 0xa0, 0xcb, 0x70, 0x89, 0xb7,
+	0x6d, 0xad, 0xae, 0x92, 0xfb, 0x58, 0xd6, 0x4d, 0x55, 0x91, 0xa6, 0xd0, 0x06, 0xac, 0xb7, 0xda,
+	0x2a, 0x96, 0xcd, 0x16, 0x26, 0x8a, 0x6a, 0xaa, 0xb8, 0xa9, 0xe9, 0xaa, 0x42, 0x6a, 0x32, 0xc6,
+	0x9a, 0x7e, 0x5f, 0xca, 0x55, 0x3f, 0xca, 0x41, 0xa1, 0x9d, 0xd1, 0xcd, 0x6f, 0x4d, 0xbd, 0xa5,
+	0xf3, 0x06, 0xa7, 0x1a, 0xaa, 0x6e, 0x26, 0x3a, 0x74, 0xd5, 0x3c, 0x68, 0xe1, 0x3d, 0x22, 0xd7,
+	0xeb, 0xaa, 0x61, 0x90, 0x66, 0x4b, 0x39, 0x05, 0xe4, 0xd0, 0x75, 0x58, 0x4b, 0x5b, 0x66, 0x4d,
+	0xc5, 0xc4, 0x30, 0x65, 0xd3, 0x32, 0x86, 0xec, 0x69, 0x74, 0x13, 0x5e, 0x7a, 0x86, 0x0f, 0x43,
+	0xe0, 0x6c, 0xf5, 0x5d, 0xa8, 0x4c, 0x0a, 0xff, 0x45, 0x3a, 0xc9, 0x31, 0xac, 0x2b, 0xcc, 0x63,
+	0x31, 0xfb, 0x6c, 0x07, 0xd8, 0x89, 0xc6, 0x0e, 0xb0, 0x13, 0xa5, 0x07, 0x78, 0x03, 0x96, 0x4e,
+	0x0f, 0x68, 0xf2, 0x01, 0xa5, 0x88, 0x61, 0x38, 0xc8, 0x46, 0x7c, 0x33, 0x93, 0x2c, 0x5f, 0x60,
+	0x33, 0xb7, 0xfe, 0x35, 0x0b, 0xf9, 0x21, 0x03, 0x15, 0x21, 0x6f, 0xe9, 0x8a, 0x7a, 0x8f, 0x07,
+	0x53, 0x9a, 0x42, 0x57, 0x40, 0x6a, 0x5a, 0x0d, 0x53, 0x23, 0xb8, 0x65, 0xe9, 0x0a, 0x91, 0x2d,
+	0x73, 0x57, 0xfa, 0xc7, 0x02, 0x2a, 0xc0, 0x82, 0x61, 0x89, 0xac, 0x49, 0x7f, 0xbd, 0x84, 0x56,
+	0xe1, 0x52, 0x43, 0x6b, 0x6a, 0xa6, 0xaa, 0x90, 0x01, 0xf5, 0x6f, 0x97, 0x50, 0x09, 0x50, 0xbd,
+	0xd5, 0x6c, 0xf2, 0x39, 0xc0, 0xd2, 0x0d, 0xab, 0xdd, 0xc2, 0xbc, 0x78, 0x7e, 0x53, 0x42, 0x57,
+	0x61, 0xc5, 0xd2, 0xe5, 0x5a, 0x43, 0x25, 0x66, 0x8b, 0x28, 0x6a, 0x43, 0xdb, 0x57, 0xb1, 0xf4,
+	0xdb, 0x12, 0xb7, 0x85, 0x55, 0xb9, 0xd1, 0x24, 0x7a, 0xcb, 0x24, 0xe9, 0xac, 0xf0, 0x49, 0x09,
+	0x15, 0x61, 0xd1, 0x6c, 0xb5, 0x48, 0xcd, 0x32, 0xde, 0x93, 0x7e, 0x57, 0x42, 0x08, 0x8a, 0x8d,
+	0x56, 0xab, 0x2d, 0x72, 0x5e, 0xe7, 0x1a, 0x7f, 0x5f, 0x42, 0x65, 0xb8, 0x8c, 0x55, 0x45, 0xc3,
+	0x6a, 0xdd, 0x24, 0x9a, 0xae, 0x68, 0x75, 0x99, 0x5f, 0xb2, 0xd2, 0xa7, 0x25, 0x74, 0x0d, 0x4a,
+	0x72, 0xbb, 0xdd, 0x48, 0x29, 0x89, 0x23, 0xa9, 0x27, 0x7f, 0x10, 0x16, 0x35, 0x7d, 0x5f, 0x6e,
+	0x68, 0xca, 0x2e, 0x51, 0x30, 0xa9, 0x69, 0xa6, 0x21, 0xfd, 0x31, 0x4b, 0x26, 0xf2, 0x7e, 0x3b,
+	0x21, 0xff, 0xa9, 0x84, 0x56, 0xa0, 0x60, 0xe9, 0x7b, 0x7a, 0xeb, 0x40, 0x27, 0x6d, 0x55, 0xc5,
+	0xd2, 0x9f, 0x13, 0xf5, 0x96, 0xb9, 0xab, 0xea, 0xe6, 0xc0, 0x02, 0x56, 0xdf, 0x49, 0xdc, 0xfa,
+	0xe9, 0x06, 0x17, 0x68, 0x59, 0x26, 0x69, 0xdd, 0x23, 0x46, 0x5b, 0xae, 0xab, 0xd2, 0xcf, 0x36,
+	0xb8, 0xf7, 0x6a, 0x43, 0xad, 0x0b, 0x68, 0xa3, 0x65, 0x98, 0xd2, 0xcf, 0x37, 0xd0, 0x3a, 0x5c,
+	0xe5, 0x4a, 0x5a, 0x58, 0x7b, 0x7f, 0x4c, 0xc7, 0xf7, 0x6f, 0x0a, 0xa3, 0x86, 0x8a, 0x49, 0x6a,
+	0x59, 0xfa, 0xe8, 0x26, 0x0f, 0xec, 0xc0, 0x0f, 0x43, 0x35, 0x0c, 0x2e, 0xa1, 0x29, 0xd2, 0xf7,
+	0x6e, 0xa2, 0xeb, 0x50, 0x1e, 0x30, 0xd4, 0xb6, 0x41, 0xb2, 0x03, 0x87, 0xf4, 0x8b, 0x5b, 0x3c,
+	0x4d, 0x58, 0x36, 0x45, 0x74, 0xe5, 0x46, 0xa3, 0x75, 0xa0, 0x2a, 0xd2, 0x2f, 0x6f, 0x89, 0xd8,
+	0xb5, 0xe4, 0x26, 0x3f, 0x33, 0x59, 0xce, 0x0f, 0x6e, 0xf2, 0x3c, 0xa9, 0xef, 0x5a, 0x5a, 0xbb,
+	0xa9, 0xea, 0xe6, 0xd0, 0xfe, 0xaf, 0x84, 0x84, 0xa5, 0xef, 0x25, 0xe6, 0xf1, 0x7e, 0x22, 0xa8,
+	0xa8, 0xd2, 0xc7, 0xb7, 0xd0, 0x17, 0x60, 0x63, 0x2c, 0x1c, 0x8a, 0x6c, 0xca, 0xc4, 0xd2, 0xe5,
+	0x7d, 0x59, 0x6b, 0xf0, 0x94, 0x4b, 0x7f, 0xdf, 0xdc, 0xf9, 0xd1, 0x34, 0x2c, 0x1a, 0x77, 0x69,
+	0x9b, 0x7f, 0xce, 0x46, 0x8f, 0x60, 0xed, 0xa9, 0xdf, 0xed, 0xd0, 0xcb, 0xcf, 0xf3, 0x75, 0x2f,
+	0x3d, 0x75, 0x95, 0x5b, 0xcf, 0xff, 0x29, 0xb0, 0x3a, 0x85, 0x2c, 0x58, 0x1e, 0xed, 0xfa, 0x68,
+	0xf3, 0xa9, 0x17, 0xc2, 0xc0, 0xc2, 0xc6, 0x19, 0x57, 0x46, 0x75, 0x0a, 0xbd, 0x0d, 0x0b, 0xe9,
+	0x58, 0x84, 0xb2, 0xef, 0xb4, 0xa3, 0x93, 0x55, 0xa5, 0xfc, 0x24, 0x6b, 0xa0, 0x61, 0xe7, 0x3f,
+	0xd3, 0xb0, 0x62, 0xdc, 0xa5, 0xf7, 0x69, 0xcc, 0x1e, 0xd3, 0x13, 0x23, 0x79, 0x3b, 0xe5, 0xee,
+	0x8e, 0x8e, 0x7a, 0x23, 0xee, 0x4e, 0x1c, 0x70, 0x2b, 0x1b, 0x4f, 0x45, 0x0c, 0xdd, 0xfd, 0x1a,
+	0xcc, 0x89, 0xb1, 0x0a, 0x95, 0x32, 0xd8, 0xec, 0x44, 0x56, 0xb9, 0x3a, 0xce, 0x18, 0xca, 0x76,
+	0x60, 0x75, 0x52, 0x5f, 0x45, 0x5f, 0x7c, 0xbe, 0x7b, 0xaf, 0x72, 0xe3, 0x0c, 0x5c, 0xd6, 0xd0,
+	0xa4, 0x9e, 0x37, 0x62, 0xe8, 0x19, 0xed, 0xb8, 0x72, 0xe3, 0x0c, 0xdc, 0xc0, 0x50, 0x6d, 0xfd,
+	0xfd, 0x35, 0x81, 0xbc, 0xcd, 0xff, 0x78, 0xb1, 0xbd, 0xa0, 0xef, 0xdc, 0xee, 0x04, 0xe9, 0x3f,
+	0x30, 0x87, 0xf3, 0xe2, 0xf7, 0xb5, 0xff, 0x0e, 0x00, 0x40, 0x96, 0x09, 0xf2, 0x96, 0x19, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1724,6 +2080,10 @@ type S6AGatewayServiceClient interface {
 	CancelLocation(ctx context.Context, in *CancelLocationRequest, opts ...grpc.CallOption) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error)
 }
 
 type s6AGatewayServiceClient struct {
@@ -1752,12 +2112,34 @@ func (c *s6AGatewayServiceClient) Reset(ctx context.Context, in *ResetRequest, o
 	return out, nil
 }
 
+func (c *s6AGatewayServiceClient) InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error) {
+	out := new(InsertSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s6AGatewayServiceClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error) {
+	out := new(DeleteSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AGatewayServiceServer is the server API for S6AGatewayService service.
 type S6AGatewayServiceServer interface {
 	// Cancel-Location (Code 317)
 	CancelLocation(context.Context, *CancelLocationRequest) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(context.Context, *ResetRequest) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(context.Context, *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error)
 }
 
 // UnimplementedS6AGatewayServiceServer can be embedded to have forward compatible implementations.
@@ -1770,6 +2152,12 @@ func (*UnimplementedS6AGatewayServiceServer) CancelLocation(ctx context.Context,
 func (*UnimplementedS6AGatewayServiceServer) Reset(ctx context.Context, req *ResetRequest) (*ResetAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (*UnimplementedS6AGatewayServiceServer) InsertSubscriberData(ctx context.Context, req *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSubscriberData not implemented")
+}
+func (*UnimplementedS6AGatewayServiceServer) DeleteSubscriberData(ctx context.Context, req *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriberData not implemented")
+}
 
 func RegisterS6AGatewayServiceServer(s *grpc.Server, srv S6AGatewayServiceServer) {
 	s.RegisterService(&_S6AGatewayService_serviceDesc, srv)
@@ -1811,6 +2199,42 @@ func _S6AGatewayService_Reset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AGatewayService_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, req.(*InsertSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S6AGatewayService_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aGatewayService",
 	HandlerType: (*S6AGatewayServiceServer)(nil),
@@ -1823,6 +2247,14 @@ var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _S6AGatewayService_Reset_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _S6AGatewayService_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _S6AGatewayService_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
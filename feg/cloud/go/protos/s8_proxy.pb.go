@@ -1427,6 +1427,633 @@ func (m *GtpError) GetMsg() string {
 	return ""
 }
 
+// Modify Bearer Request is sent by AGW on handovers or when the user plane of
+// a bearer changes (3GPP TS 29.274 7.2.7)
+type ModifyBearerRequestPgw struct {
+	PgwAddrs string `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	// s8_proxy will use value in its config
+	Imsi                 string                   `protobuf:"bytes,2,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	CAgwTeid             uint32                   `protobuf:"varint,3,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	CPgwTeid             uint32                   `protobuf:"varint,4,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	ServingNetwork       *ServingNetwork          `protobuf:"bytes,5,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Uli                  *UserLocationInformation `protobuf:"bytes,6,opt,name=uli,proto3" json:"uli,omitempty"`
+	RatType              RATType                  `protobuf:"varint,7,opt,name=rat_type,json=ratType,proto3,enum=magma.feg.RATType" json:"rat_type,omitempty"`
+	BearerContext        *BearerContext           `protobuf:"bytes,8,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	TimeZone             *TimeZone                `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ModifyBearerRequestPgw) Reset()         { *m = ModifyBearerRequestPgw{} }
+func (m *ModifyBearerRequestPgw) String() string { return proto.CompactTextString(m) }
+func (*ModifyBearerRequestPgw) ProtoMessage()    {}
+func (*ModifyBearerRequestPgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{19}
+}
+
+func (m *ModifyBearerRequestPgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyBearerRequestPgw.Unmarshal(m, b)
+}
+func (m *ModifyBearerRequestPgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyBearerRequestPgw.Marshal(b, m, deterministic)
+}
+func (m *ModifyBearerRequestPgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyBearerRequestPgw.Merge(m, src)
+}
+func (m *ModifyBearerRequestPgw) XXX_Size() int {
+	return xxx_messageInfo_ModifyBearerRequestPgw.Size(m)
+}
+func (m *ModifyBearerRequestPgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyBearerRequestPgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyBearerRequestPgw proto.InternalMessageInfo
+
+func (m *ModifyBearerRequestPgw) GetPgwAddrs() string {
+	if m != nil {
+		return m.PgwAddrs
+	}
+	return ""
+}
+
+func (m *ModifyBearerRequestPgw) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *ModifyBearerRequestPgw) GetCAgwTeid() uint32 {
+	if m != nil {
+		return m.CAgwTeid
+	}
+	return 0
+}
+
+func (m *ModifyBearerRequestPgw) GetCPgwTeid() uint32 {
+	if m != nil {
+		return m.CPgwTeid
+	}
+	return 0
+}
+
+func (m *ModifyBearerRequestPgw) GetServingNetwork() *ServingNetwork {
+	if m != nil {
+		return m.ServingNetwork
+	}
+	return nil
+}
+
+func (m *ModifyBearerRequestPgw) GetUli() *UserLocationInformation {
+	if m != nil {
+		return m.Uli
+	}
+	return nil
+}
+
+func (m *ModifyBearerRequestPgw) GetRatType() RATType {
+	if m != nil {
+		return m.RatType
+	}
+	return RATType_RESERVED
+}
+
+func (m *ModifyBearerRequestPgw) GetBearerContext() *BearerContext {
+	if m != nil {
+		return m.BearerContext
+	}
+	return nil
+}
+
+func (m *ModifyBearerRequestPgw) GetTimeZone() *TimeZone {
+	if m != nil {
+		return m.TimeZone
+	}
+	return nil
+}
+
+type ModifyBearerResponsePgw struct {
+	CAgwTeid                     uint32                        `protobuf:"varint,1,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	BearerContext                *BearerContext                `protobuf:"bytes,2,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,3,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	GtpError                     *GtpError                     `protobuf:"bytes,4,opt,name=gtp_error,json=gtpError,proto3" json:"gtp_error,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *ModifyBearerResponsePgw) Reset()         { *m = ModifyBearerResponsePgw{} }
+func (m *ModifyBearerResponsePgw) String() string { return proto.CompactTextString(m) }
+func (*ModifyBearerResponsePgw) ProtoMessage()    {}
+func (*ModifyBearerResponsePgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{20}
+}
+
+func (m *ModifyBearerResponsePgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyBearerResponsePgw.Unmarshal(m, b)
+}
+func (m *ModifyBearerResponsePgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyBearerResponsePgw.Marshal(b, m, deterministic)
+}
+func (m *ModifyBearerResponsePgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyBearerResponsePgw.Merge(m, src)
+}
+func (m *ModifyBearerResponsePgw) XXX_Size() int {
+	return xxx_messageInfo_ModifyBearerResponsePgw.Size(m)
+}
+func (m *ModifyBearerResponsePgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyBearerResponsePgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyBearerResponsePgw proto.InternalMessageInfo
+
+func (m *ModifyBearerResponsePgw) GetCAgwTeid() uint32 {
+	if m != nil {
+		return m.CAgwTeid
+	}
+	return 0
+}
+
+func (m *ModifyBearerResponsePgw) GetBearerContext() *BearerContext {
+	if m != nil {
+		return m.BearerContext
+	}
+	return nil
+}
+
+func (m *ModifyBearerResponsePgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if m != nil {
+		return m.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+func (m *ModifyBearerResponsePgw) GetGtpError() *GtpError {
+	if m != nil {
+		return m.GtpError
+	}
+	return nil
+}
+
+// Delete Bearer Command is sent by AGW to request the PGW to release a
+// dedicated bearer. The PGW answers with a Delete Bearer Request
+// (3GPP TS 29.274 7.2.17.1)
+type DeleteBearerCommandPgw struct {
+	PgwAddrs             string                   `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	Imsi                 string                   `protobuf:"bytes,2,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	CAgwTeid             uint32                   `protobuf:"varint,3,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	CPgwTeid             uint32                   `protobuf:"varint,4,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	BearerId             uint32                   `protobuf:"varint,5,opt,name=bearer_id,json=bearerId,proto3" json:"bearer_id,omitempty"`
+	ServingNetwork       *ServingNetwork          `protobuf:"bytes,6,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Uli                  *UserLocationInformation `protobuf:"bytes,7,opt,name=uli,proto3" json:"uli,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DeleteBearerCommandPgw) Reset()         { *m = DeleteBearerCommandPgw{} }
+func (m *DeleteBearerCommandPgw) String() string { return proto.CompactTextString(m) }
+func (*DeleteBearerCommandPgw) ProtoMessage()    {}
+func (*DeleteBearerCommandPgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{21}
+}
+
+func (m *DeleteBearerCommandPgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBearerCommandPgw.Unmarshal(m, b)
+}
+func (m *DeleteBearerCommandPgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBearerCommandPgw.Marshal(b, m, deterministic)
+}
+func (m *DeleteBearerCommandPgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBearerCommandPgw.Merge(m, src)
+}
+func (m *DeleteBearerCommandPgw) XXX_Size() int {
+	return xxx_messageInfo_DeleteBearerCommandPgw.Size(m)
+}
+func (m *DeleteBearerCommandPgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBearerCommandPgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBearerCommandPgw proto.InternalMessageInfo
+
+func (m *DeleteBearerCommandPgw) GetPgwAddrs() string {
+	if m != nil {
+		return m.PgwAddrs
+	}
+	return ""
+}
+
+func (m *DeleteBearerCommandPgw) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *DeleteBearerCommandPgw) GetCAgwTeid() uint32 {
+	if m != nil {
+		return m.CAgwTeid
+	}
+	return 0
+}
+
+func (m *DeleteBearerCommandPgw) GetCPgwTeid() uint32 {
+	if m != nil {
+		return m.CPgwTeid
+	}
+	return 0
+}
+
+func (m *DeleteBearerCommandPgw) GetBearerId() uint32 {
+	if m != nil {
+		return m.BearerId
+	}
+	return 0
+}
+
+func (m *DeleteBearerCommandPgw) GetServingNetwork() *ServingNetwork {
+	if m != nil {
+		return m.ServingNetwork
+	}
+	return nil
+}
+
+func (m *DeleteBearerCommandPgw) GetUli() *UserLocationInformation {
+	if m != nil {
+		return m.Uli
+	}
+	return nil
+}
+
+type DeleteBearerCommandResponsePgw struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteBearerCommandResponsePgw) Reset()         { *m = DeleteBearerCommandResponsePgw{} }
+func (m *DeleteBearerCommandResponsePgw) String() string { return proto.CompactTextString(m) }
+func (*DeleteBearerCommandResponsePgw) ProtoMessage()    {}
+func (*DeleteBearerCommandResponsePgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{22}
+}
+
+func (m *DeleteBearerCommandResponsePgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBearerCommandResponsePgw.Unmarshal(m, b)
+}
+func (m *DeleteBearerCommandResponsePgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBearerCommandResponsePgw.Marshal(b, m, deterministic)
+}
+func (m *DeleteBearerCommandResponsePgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBearerCommandResponsePgw.Merge(m, src)
+}
+func (m *DeleteBearerCommandResponsePgw) XXX_Size() int {
+	return xxx_messageInfo_DeleteBearerCommandResponsePgw.Size(m)
+}
+func (m *DeleteBearerCommandResponsePgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBearerCommandResponsePgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBearerCommandResponsePgw proto.InternalMessageInfo
+
+// Update Bearer Request is sent by PGW to modify the QoS or TFT of a
+// bearer (3GPP TS 29.274 7.2.15)
+type UpdateBearerRequestPgw struct {
+	CAgwTeid                     uint32                        `protobuf:"varint,1,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	BearerContext                *BearerContext                `protobuf:"bytes,2,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ApnAmbr                      *Ambr                         `protobuf:"bytes,3,opt,name=apn_ambr,json=apnAmbr,proto3" json:"apn_ambr,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,4,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *UpdateBearerRequestPgw) Reset()         { *m = UpdateBearerRequestPgw{} }
+func (m *UpdateBearerRequestPgw) String() string { return proto.CompactTextString(m) }
+func (*UpdateBearerRequestPgw) ProtoMessage()    {}
+func (*UpdateBearerRequestPgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{23}
+}
+
+func (m *UpdateBearerRequestPgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateBearerRequestPgw.Unmarshal(m, b)
+}
+func (m *UpdateBearerRequestPgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateBearerRequestPgw.Marshal(b, m, deterministic)
+}
+func (m *UpdateBearerRequestPgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBearerRequestPgw.Merge(m, src)
+}
+func (m *UpdateBearerRequestPgw) XXX_Size() int {
+	return xxx_messageInfo_UpdateBearerRequestPgw.Size(m)
+}
+func (m *UpdateBearerRequestPgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBearerRequestPgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBearerRequestPgw proto.InternalMessageInfo
+
+func (m *UpdateBearerRequestPgw) GetCAgwTeid() uint32 {
+	if m != nil {
+		return m.CAgwTeid
+	}
+	return 0
+}
+
+func (m *UpdateBearerRequestPgw) GetBearerContext() *BearerContext {
+	if m != nil {
+		return m.BearerContext
+	}
+	return nil
+}
+
+func (m *UpdateBearerRequestPgw) GetApnAmbr() *Ambr {
+	if m != nil {
+		return m.ApnAmbr
+	}
+	return nil
+}
+
+func (m *UpdateBearerRequestPgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if m != nil {
+		return m.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+type UpdateBearerResponsePgw struct {
+	PgwAddrs                     string                        `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	CPgwTeid                     uint32                        `protobuf:"varint,2,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	ServingNetwork               *ServingNetwork               `protobuf:"bytes,3,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Cause                        uint32                        `protobuf:"varint,4,opt,name=cause,proto3" json:"cause,omitempty"`
+	BearerContext                *BearerContext                `protobuf:"bytes,5,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,6,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	TimeZone                     *TimeZone                     `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Uli                          *UserLocationInformation      `protobuf:"bytes,8,opt,name=uli,proto3" json:"uli,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *UpdateBearerResponsePgw) Reset()         { *m = UpdateBearerResponsePgw{} }
+func (m *UpdateBearerResponsePgw) String() string { return proto.CompactTextString(m) }
+func (*UpdateBearerResponsePgw) ProtoMessage()    {}
+func (*UpdateBearerResponsePgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{24}
+}
+
+func (m *UpdateBearerResponsePgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateBearerResponsePgw.Unmarshal(m, b)
+}
+func (m *UpdateBearerResponsePgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateBearerResponsePgw.Marshal(b, m, deterministic)
+}
+func (m *UpdateBearerResponsePgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBearerResponsePgw.Merge(m, src)
+}
+func (m *UpdateBearerResponsePgw) XXX_Size() int {
+	return xxx_messageInfo_UpdateBearerResponsePgw.Size(m)
+}
+func (m *UpdateBearerResponsePgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBearerResponsePgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBearerResponsePgw proto.InternalMessageInfo
+
+func (m *UpdateBearerResponsePgw) GetPgwAddrs() string {
+	if m != nil {
+		return m.PgwAddrs
+	}
+	return ""
+}
+
+func (m *UpdateBearerResponsePgw) GetCPgwTeid() uint32 {
+	if m != nil {
+		return m.CPgwTeid
+	}
+	return 0
+}
+
+func (m *UpdateBearerResponsePgw) GetServingNetwork() *ServingNetwork {
+	if m != nil {
+		return m.ServingNetwork
+	}
+	return nil
+}
+
+func (m *UpdateBearerResponsePgw) GetCause() uint32 {
+	if m != nil {
+		return m.Cause
+	}
+	return 0
+}
+
+func (m *UpdateBearerResponsePgw) GetBearerContext() *BearerContext {
+	if m != nil {
+		return m.BearerContext
+	}
+	return nil
+}
+
+func (m *UpdateBearerResponsePgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if m != nil {
+		return m.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+func (m *UpdateBearerResponsePgw) GetTimeZone() *TimeZone {
+	if m != nil {
+		return m.TimeZone
+	}
+	return nil
+}
+
+func (m *UpdateBearerResponsePgw) GetUli() *UserLocationInformation {
+	if m != nil {
+		return m.Uli
+	}
+	return nil
+}
+
+// Delete Bearer Request is sent by PGW to release a dedicated bearer or,
+// when only linked_bearer_id is set, the whole PDN connection
+// (3GPP TS 29.274 7.2.9.2)
+type DeleteBearerRequestPgw struct {
+	CAgwTeid                     uint32                        `protobuf:"varint,1,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	LinkedBearerId               uint32                        `protobuf:"varint,2,opt,name=linked_bearer_id,json=linkedBearerId,proto3" json:"linked_bearer_id,omitempty"`
+	EpsBearerId                  uint32                        `protobuf:"varint,3,opt,name=eps_bearer_id,json=epsBearerId,proto3" json:"eps_bearer_id,omitempty"`
+	Cause                        uint32                        `protobuf:"varint,4,opt,name=cause,proto3" json:"cause,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,5,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *DeleteBearerRequestPgw) Reset()         { *m = DeleteBearerRequestPgw{} }
+func (m *DeleteBearerRequestPgw) String() string { return proto.CompactTextString(m) }
+func (*DeleteBearerRequestPgw) ProtoMessage()    {}
+func (*DeleteBearerRequestPgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{25}
+}
+
+func (m *DeleteBearerRequestPgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBearerRequestPgw.Unmarshal(m, b)
+}
+func (m *DeleteBearerRequestPgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBearerRequestPgw.Marshal(b, m, deterministic)
+}
+func (m *DeleteBearerRequestPgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBearerRequestPgw.Merge(m, src)
+}
+func (m *DeleteBearerRequestPgw) XXX_Size() int {
+	return xxx_messageInfo_DeleteBearerRequestPgw.Size(m)
+}
+func (m *DeleteBearerRequestPgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBearerRequestPgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBearerRequestPgw proto.InternalMessageInfo
+
+func (m *DeleteBearerRequestPgw) GetCAgwTeid() uint32 {
+	if m != nil {
+		return m.CAgwTeid
+	}
+	return 0
+}
+
+func (m *DeleteBearerRequestPgw) GetLinkedBearerId() uint32 {
+	if m != nil {
+		return m.LinkedBearerId
+	}
+	return 0
+}
+
+func (m *DeleteBearerRequestPgw) GetEpsBearerId() uint32 {
+	if m != nil {
+		return m.EpsBearerId
+	}
+	return 0
+}
+
+func (m *DeleteBearerRequestPgw) GetCause() uint32 {
+	if m != nil {
+		return m.Cause
+	}
+	return 0
+}
+
+func (m *DeleteBearerRequestPgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if m != nil {
+		return m.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+type DeleteBearerResponsePgw struct {
+	PgwAddrs                     string                        `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	CPgwTeid                     uint32                        `protobuf:"varint,2,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	ServingNetwork               *ServingNetwork               `protobuf:"bytes,3,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Cause                        uint32                        `protobuf:"varint,4,opt,name=cause,proto3" json:"cause,omitempty"`
+	LinkedBearerId               uint32                        `protobuf:"varint,5,opt,name=linked_bearer_id,json=linkedBearerId,proto3" json:"linked_bearer_id,omitempty"`
+	EpsBearerId                  uint32                        `protobuf:"varint,6,opt,name=eps_bearer_id,json=epsBearerId,proto3" json:"eps_bearer_id,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,7,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	TimeZone                     *TimeZone                     `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Uli                          *UserLocationInformation      `protobuf:"bytes,9,opt,name=uli,proto3" json:"uli,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *DeleteBearerResponsePgw) Reset()         { *m = DeleteBearerResponsePgw{} }
+func (m *DeleteBearerResponsePgw) String() string { return proto.CompactTextString(m) }
+func (*DeleteBearerResponsePgw) ProtoMessage()    {}
+func (*DeleteBearerResponsePgw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a775e17ac280154, []int{26}
+}
+
+func (m *DeleteBearerResponsePgw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBearerResponsePgw.Unmarshal(m, b)
+}
+func (m *DeleteBearerResponsePgw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBearerResponsePgw.Marshal(b, m, deterministic)
+}
+func (m *DeleteBearerResponsePgw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBearerResponsePgw.Merge(m, src)
+}
+func (m *DeleteBearerResponsePgw) XXX_Size() int {
+	return xxx_messageInfo_DeleteBearerResponsePgw.Size(m)
+}
+func (m *DeleteBearerResponsePgw) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBearerResponsePgw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBearerResponsePgw proto.InternalMessageInfo
+
+func (m *DeleteBearerResponsePgw) GetPgwAddrs() string {
+	if m != nil {
+		return m.PgwAddrs
+	}
+	return ""
+}
+
+func (m *DeleteBearerResponsePgw) GetCPgwTeid() uint32 {
+	if m != nil {
+		return m.CPgwTeid
+	}
+	return 0
+}
+
+func (m *DeleteBearerResponsePgw) GetServingNetwork() *ServingNetwork {
+	if m != nil {
+		return m.ServingNetwork
+	}
+	return nil
+}
+
+func (m *DeleteBearerResponsePgw) GetCause() uint32 {
+	if m != nil {
+		return m.Cause
+	}
+	return 0
+}
+
+func (m *DeleteBearerResponsePgw) GetLinkedBearerId() uint32 {
+	if m != nil {
+		return m.LinkedBearerId
+	}
+	return 0
+}
+
+func (m *DeleteBearerResponsePgw) GetEpsBearerId() uint32 {
+	if m != nil {
+		return m.EpsBearerId
+	}
+	return 0
+}
+
+func (m *DeleteBearerResponsePgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if m != nil {
+		return m.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+func (m *DeleteBearerResponsePgw) GetTimeZone() *TimeZone {
+	if m != nil {
+		return m.TimeZone
+	}
+	return nil
+}
+
+func (m *DeleteBearerResponsePgw) GetUli() *UserLocationInformation {
+	if m != nil {
+		return m.Uli
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.feg.PDNType", PDNType_name, PDNType_value)
 	proto.RegisterEnum("magma.feg.RATType", RATType_name, RATType_value)
@@ -1450,121 +2077,147 @@ func init() {
 	proto.RegisterType((*EchoRequest)(nil), "magma.feg.EchoRequest")
 	proto.RegisterType((*EchoResponse)(nil), "magma.feg.EchoResponse")
 	proto.RegisterType((*GtpError)(nil), "magma.feg.GtpError")
+	proto.RegisterType((*ModifyBearerRequestPgw)(nil), "magma.feg.ModifyBearerRequestPgw")
+	proto.RegisterType((*ModifyBearerResponsePgw)(nil), "magma.feg.ModifyBearerResponsePgw")
+	proto.RegisterType((*DeleteBearerCommandPgw)(nil), "magma.feg.DeleteBearerCommandPgw")
+	proto.RegisterType((*DeleteBearerCommandResponsePgw)(nil), "magma.feg.DeleteBearerCommandResponsePgw")
+	proto.RegisterType((*UpdateBearerRequestPgw)(nil), "magma.feg.UpdateBearerRequestPgw")
+	proto.RegisterType((*UpdateBearerResponsePgw)(nil), "magma.feg.UpdateBearerResponsePgw")
+	proto.RegisterType((*DeleteBearerRequestPgw)(nil), "magma.feg.DeleteBearerRequestPgw")
+	proto.RegisterType((*DeleteBearerResponsePgw)(nil), "magma.feg.DeleteBearerResponsePgw")
 }
 
 func init() { proto.RegisterFile("feg/protos/s8_proxy.proto", fileDescriptor_8a775e17ac280154) }
 
 var fileDescriptor_8a775e17ac280154 = []byte{
-	// 1743 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6e, 0xdb, 0xca,
-	0x15, 0x36, 0xf5, 0xaf, 0x63, 0x4b, 0x66, 0xe6, 0xba, 0x31, 0xe3, 0x1b, 0x34, 0x0e, 0xd3, 0xdb,
-	0xb8, 0x29, 0x6a, 0xbb, 0xbe, 0x41, 0x70, 0x5b, 0xe0, 0xa2, 0x95, 0x1d, 0x25, 0x35, 0xe0, 0x28,
-	0x2a, 0x25, 0xfb, 0x02, 0xd9, 0x10, 0x23, 0x72, 0xc4, 0x0c, 0x42, 0x91, 0xf4, 0x0c, 0xe5, 0x9f,
-	0x02, 0x05, 0xba, 0x2d, 0xd0, 0x4d, 0xf7, 0x05, 0xfa, 0x0c, 0x5d, 0xf6, 0x01, 0xfa, 0x0c, 0xdd,
-	0xb5, 0xaf, 0x52, 0xcc, 0x0f, 0x25, 0xd2, 0xb6, 0xe2, 0xb8, 0xf0, 0xca, 0x67, 0xbe, 0xf3, 0xcd,
-	0x70, 0xe6, 0xcc, 0x77, 0xce, 0x19, 0x19, 0x1e, 0x8d, 0x49, 0xb0, 0x93, 0xb0, 0x38, 0x8d, 0xf9,
-	0x0e, 0xff, 0xce, 0x4d, 0x58, 0x7c, 0x71, 0xb9, 0x2d, 0xc7, 0xa8, 0x39, 0xc1, 0xc1, 0x04, 0x6f,
-	0x8f, 0x49, 0xb0, 0xf1, 0x24, 0x4c, 0x49, 0xc6, 0x8a, 0x31, 0xdd, 0xe1, 0x49, 0x70, 0xee, 0xf2,
-	0x14, 0xa7, 0x44, 0x71, 0xed, 0xff, 0xd4, 0x60, 0xfd, 0x80, 0x11, 0x9c, 0x92, 0x01, 0xe1, 0x9c,
-	0xc6, 0x91, 0x43, 0x4e, 0xa7, 0x84, 0xa7, 0xfd, 0xe0, 0x1c, 0x6d, 0x40, 0x23, 0x09, 0xce, 0x3b,
-	0xbe, 0xcf, 0xb8, 0x65, 0x6c, 0x1a, 0x5b, 0x4d, 0x67, 0x36, 0x46, 0x08, 0x2a, 0x74, 0xc2, 0xa9,
-	0x55, 0x92, 0xb8, 0xb4, 0xd1, 0x43, 0xa8, 0x4d, 0x38, 0xe5, 0x7e, 0x64, 0x95, 0x25, 0xaa, 0x47,
-	0xc8, 0x84, 0xf2, 0x84, 0x50, 0xab, 0x22, 0x41, 0x61, 0xa2, 0x7d, 0x58, 0xe5, 0x84, 0x9d, 0xd1,
-	0x28, 0x70, 0x23, 0x92, 0x9e, 0xc7, 0xec, 0x93, 0x55, 0xdd, 0x34, 0xb6, 0x96, 0xf7, 0x1e, 0x6d,
-	0xcf, 0xf6, 0xbe, 0x3d, 0x50, 0x8c, 0x9e, 0x22, 0x38, 0x6d, 0x5e, 0x18, 0xa3, 0x97, 0x50, 0x9e,
-	0x86, 0xd4, 0xaa, 0xc9, 0x79, 0x76, 0x6e, 0xde, 0x31, 0x27, 0xec, 0x28, 0xf6, 0x70, 0x4a, 0xe3,
-	0xe8, 0x30, 0x1a, 0xc7, 0x6c, 0x22, 0x4d, 0x47, 0xd0, 0xd1, 0x2f, 0xa0, 0xc1, 0x70, 0xea, 0xa6,
-	0x97, 0x09, 0xb1, 0xea, 0x9b, 0xc6, 0x56, 0x7b, 0x0f, 0xe5, 0xa6, 0x3a, 0x9d, 0xe1, 0xf0, 0x32,
-	0x21, 0x4e, 0x9d, 0xe1, 0x54, 0x18, 0x82, 0x9e, 0xf8, 0x91, 0xa2, 0x37, 0xae, 0xd1, 0xfb, 0xaf,
-	0x7b, 0x8a, 0x9e, 0xf8, 0x91, 0xa4, 0xff, 0x12, 0xca, 0x09, 0xc6, 0x56, 0x53, 0xee, 0xe9, 0x49,
-	0x9e, 0xe9, 0x47, 0x22, 0x6e, 0x84, 0xf3, 0x4e, 0x18, 0xea, 0xbd, 0x39, 0x82, 0x2b, 0x82, 0x83,
-	0x93, 0xc8, 0x02, 0x15, 0x1c, 0x9c, 0x44, 0xe8, 0x19, 0x54, 0xf0, 0x64, 0xc4, 0xac, 0x65, 0xb9,
-	0xca, 0x6a, 0x6e, 0x95, 0xce, 0x64, 0xc4, 0x1c, 0xe9, 0x44, 0x07, 0xd0, 0xe6, 0x24, 0x24, 0x9e,
-	0x58, 0xc8, 0x9d, 0xc4, 0x3e, 0xb1, 0x56, 0xe4, 0xf6, 0x1e, 0x17, 0x02, 0xa8, 0x09, 0xef, 0x62,
-	0x9f, 0xc8, 0x8d, 0xb6, 0x78, 0x1e, 0x42, 0xbf, 0x81, 0xf6, 0x88, 0x60, 0x46, 0x98, 0xeb, 0xc5,
-	0x51, 0x4a, 0x2e, 0x52, 0xab, 0x25, 0xbf, 0x69, 0xe5, 0x16, 0xd9, 0x97, 0x84, 0x03, 0xe5, 0x77,
-	0x5a, 0xa3, 0xfc, 0x10, 0x3d, 0x06, 0xf0, 0x5c, 0x1c, 0x9c, 0xbb, 0x29, 0xa1, 0xbe, 0xd5, 0xde,
-	0x34, 0xb6, 0x5a, 0x4e, 0xc3, 0xeb, 0x04, 0xe7, 0x43, 0x42, 0x7d, 0x34, 0x81, 0x1f, 0x4b, 0x91,
-	0x79, 0x71, 0x28, 0x3e, 0x30, 0xa6, 0xc1, 0x94, 0xc9, 0x93, 0xbb, 0x71, 0x22, 0xfe, 0x70, 0x6b,
-	0x55, 0x7e, 0xee, 0x79, 0x3e, 0x50, 0x7a, 0xc2, 0x41, 0x9e, 0xff, 0x5e, 0xd1, 0x9d, 0xc7, 0xc9,
-	0x67, 0xbc, 0xe8, 0x39, 0xac, 0xd2, 0xc8, 0xa7, 0x2a, 0xb8, 0xee, 0x38, 0xc4, 0x81, 0x65, 0x6e,
-	0x1a, 0x5b, 0x2b, 0x4e, 0x7b, 0x0e, 0xbf, 0x09, 0x71, 0x80, 0x7e, 0x05, 0x96, 0xf7, 0x11, 0xb3,
-	0x40, 0xc8, 0x4f, 0x18, 0xd8, 0x4b, 0x09, 0xa3, 0x3c, 0xa5, 0x1e, 0xb7, 0x1e, 0xc8, 0x7b, 0x58,
-	0xcf, 0xfc, 0x07, 0x45, 0x37, 0xda, 0x85, 0x66, 0x4a, 0x27, 0xc4, 0xfd, 0x43, 0x1c, 0x11, 0x0b,
-	0xc9, 0xdd, 0x7f, 0x95, 0xdb, 0xfd, 0x90, 0x4e, 0xc8, 0x87, 0x38, 0x22, 0x4e, 0x23, 0xd5, 0x96,
-	0xfd, 0x0f, 0x03, 0xd6, 0x17, 0x28, 0x52, 0xdc, 0x7d, 0x88, 0x3d, 0x99, 0x5b, 0x2d, 0x47, 0x98,
-	0xa8, 0x0d, 0x25, 0x4f, 0x25, 0x55, 0xcb, 0x29, 0x79, 0x54, 0x30, 0x38, 0xf6, 0x64, 0x3e, 0xb5,
-	0x1c, 0x61, 0x0a, 0x84, 0x61, 0x4f, 0x26, 0x53, 0xcb, 0x11, 0xa6, 0x40, 0x52, 0xec, 0xc9, 0x04,
-	0x6a, 0x39, 0xc2, 0x14, 0x08, 0xf1, 0x54, 0x6a, 0xb4, 0x1c, 0x61, 0xa2, 0x35, 0xa8, 0xbe, 0x23,
-	0xbd, 0x11, 0x95, 0x9a, 0x6f, 0x39, 0x6a, 0x20, 0x12, 0xb6, 0xab, 0xe0, 0x86, 0x84, 0xf5, 0xc8,
-	0x7e, 0x09, 0xed, 0x62, 0xf2, 0xc9, 0x14, 0xf6, 0x3c, 0x5d, 0x05, 0x84, 0x29, 0x91, 0xc8, 0xd3,
-	0xf9, 0x2f, 0x4c, 0xfb, 0xef, 0x06, 0x3c, 0xee, 0xdf, 0x72, 0x41, 0x4a, 0x06, 0x6e, 0x76, 0x8f,
-	0xfa, 0xe8, 0x6d, 0x05, 0x67, 0x93, 0xd1, 0x09, 0xfc, 0x48, 0x32, 0xdc, 0x58, 0x29, 0x13, 0xd3,
-	0x88, 0x30, 0x97, 0xfa, 0x56, 0x69, 0xb3, 0xbc, 0xb5, 0xbc, 0xf7, 0x2c, 0xaf, 0x17, 0x2f, 0xce,
-	0xa6, 0xbd, 0x97, 0xb2, 0x94, 0xdc, 0x43, 0xdf, 0x41, 0x72, 0x85, 0x02, 0x66, 0xbf, 0x01, 0x6b,
-	0x11, 0x5f, 0x44, 0x9e, 0xfa, 0x7a, 0x3f, 0x25, 0xea, 0x8b, 0xe2, 0x27, 0x93, 0x22, 0x4a, 0xb9,
-	0x3c, 0xe4, 0x8a, 0x33, 0x1b, 0xdb, 0xff, 0x35, 0xa0, 0x55, 0xc8, 0x8b, 0x6b, 0xb3, 0x7f, 0x0d,
-	0xe6, 0x94, 0x13, 0xe6, 0x26, 0x21, 0x8e, 0x88, 0x3b, 0x96, 0xe9, 0x51, 0x92, 0x72, 0x31, 0x73,
-	0x9b, 0x7f, 0x23, 0x70, 0xa7, 0x2d, 0x98, 0x7d, 0x41, 0x94, 0x63, 0xf4, 0x73, 0x28, 0x9f, 0xc6,
-	0x5c, 0xde, 0x79, 0xb1, 0x20, 0xfe, 0x3e, 0xe6, 0x85, 0x7a, 0x76, 0x1a, 0x73, 0xf4, 0x04, 0x96,
-	0x67, 0x5a, 0xa6, 0xbe, 0x96, 0x05, 0x64, 0xd0, 0xa1, 0x2f, 0xca, 0x64, 0x3a, 0x4e, 0x75, 0x79,
-	0xcd, 0xca, 0x64, 0x98, 0x92, 0xed, 0x18, 0xd3, 0xed, 0x21, 0xc3, 0xe3, 0x31, 0xf5, 0xde, 0x84,
-	0xf1, 0xf9, 0x90, 0x4c, 0x92, 0x10, 0xa7, 0xc4, 0x11, 0x74, 0xfb, 0xaf, 0x25, 0x68, 0x17, 0x3f,
-	0x27, 0x2e, 0x3c, 0xf1, 0x68, 0x26, 0xd6, 0xc4, 0xa3, 0xe8, 0x1b, 0x68, 0x27, 0x8c, 0xc6, 0x8c,
-	0xa6, 0x97, 0x6e, 0x48, 0xce, 0x48, 0xa8, 0x85, 0xdb, 0xca, 0xd0, 0x23, 0x01, 0xa2, 0x6f, 0xc5,
-	0x6d, 0x12, 0x32, 0x91, 0x2a, 0x70, 0x3d, 0x9c, 0xe0, 0x11, 0x0d, 0x69, 0x7a, 0xa9, 0x55, 0xbd,
-	0x36, 0x77, 0x1e, 0xcc, 0x7c, 0x22, 0x47, 0x73, 0x93, 0xce, 0xa6, 0x61, 0x44, 0x58, 0x36, 0x4f,
-	0x1d, 0x72, 0x7d, 0xee, 0x3f, 0xc9, 0xbb, 0xc5, 0x46, 0x4f, 0x3d, 0x9a, 0xe5, 0xc3, 0xa9, 0x47,
-	0xd1, 0x53, 0x28, 0x07, 0x23, 0xa6, 0x5b, 0xc5, 0xb5, 0x82, 0x2a, 0x7c, 0x82, 0x22, 0x6a, 0x6e,
-	0x7d, 0x01, 0x65, 0x32, 0x62, 0xf6, 0x2e, 0x54, 0xc4, 0x00, 0x7d, 0x05, 0xd5, 0x11, 0x73, 0xa7,
-	0x4a, 0xbc, 0x15, 0xa7, 0x32, 0x62, 0xc7, 0xa1, 0x06, 0x7d, 0x15, 0x02, 0x09, 0xbe, 0x0e, 0xed,
-	0x3f, 0xc2, 0xda, 0x4d, 0x85, 0x1f, 0x3d, 0x85, 0x15, 0x9a, 0x9c, 0xbd, 0x74, 0xb1, 0xf2, 0xe8,
-	0xb4, 0x5a, 0x16, 0x98, 0x26, 0x6b, 0xca, 0xab, 0x19, 0xa5, 0x34, 0xa3, 0xbc, 0xca, 0x28, 0x4f,
-	0x40, 0x0e, 0xdd, 0x84, 0x91, 0x31, 0xbd, 0xd0, 0xd1, 0x04, 0x01, 0xf5, 0x25, 0x62, 0x63, 0x68,
-	0x64, 0x05, 0x09, 0x3d, 0x83, 0x96, 0x4f, 0xc2, 0x14, 0xbb, 0x9c, 0x78, 0x71, 0xe4, 0xab, 0x6f,
-	0x56, 0x9d, 0x15, 0x09, 0x0e, 0x14, 0x86, 0x76, 0x61, 0xcd, 0xc7, 0x97, 0x21, 0x0d, 0x3e, 0xa6,
-	0x2e, 0xc7, 0xb2, 0x3d, 0x8b, 0x3a, 0xa6, 0xaf, 0x15, 0x65, 0xbe, 0x81, 0x74, 0x89, 0xa5, 0x6d,
-	0x0c, 0x55, 0x25, 0xda, 0xfb, 0x39, 0x12, 0x82, 0x8a, 0x4c, 0x15, 0x75, 0x16, 0x69, 0xdb, 0xff,
-	0x2e, 0x83, 0x75, 0xe5, 0x85, 0xc2, 0x93, 0x38, 0xe2, 0x44, 0x3c, 0x51, 0xf2, 0xfd, 0xd9, 0xf8,
-	0xe2, 0xfe, 0x5c, 0xba, 0x43, 0x7f, 0x7e, 0x0e, 0xab, 0x38, 0x89, 0x5c, 0x46, 0x78, 0xca, 0xa8,
-	0x6c, 0x9d, 0x7a, 0x77, 0x6d, 0x9c, 0x88, 0xad, 0x64, 0xe8, 0x95, 0x5e, 0x58, 0xb9, 0xd2, 0x0b,
-	0x77, 0x61, 0xd9, 0x73, 0xc5, 0xe3, 0x4b, 0xd5, 0x82, 0xea, 0x82, 0x5a, 0xd0, 0xf4, 0xfa, 0xc1,
-	0xb9, 0x8a, 0xe8, 0xf5, 0xe6, 0x5c, 0xbb, 0x5b, 0x73, 0xbe, 0xbd, 0xfd, 0xd6, 0xef, 0xb3, 0xfd,
-	0xee, 0x42, 0x33, 0x48, 0x13, 0x97, 0x30, 0x16, 0x33, 0xd9, 0x4f, 0x8a, 0xad, 0xf1, 0x6d, 0x9a,
-	0x74, 0x85, 0xcb, 0x69, 0x04, 0xda, 0xb2, 0xff, 0x56, 0x82, 0xf5, 0xd7, 0x24, 0x24, 0xf7, 0xf1,
-	0xf6, 0xfc, 0x1a, 0x9a, 0x3a, 0x5a, 0x33, 0xf9, 0x34, 0x14, 0x70, 0xe8, 0xdf, 0x72, 0x35, 0xd2,
-	0x9b, 0x64, 0xde, 0xaa, 0xf6, 0xf6, 0xb5, 0xf7, 0x86, 0xa7, 0x6a, 0xed, 0xff, 0x7c, 0xaa, 0xd6,
-	0xef, 0xf4, 0x54, 0xb5, 0x8f, 0xc0, 0xba, 0x12, 0x9d, 0xb9, 0xee, 0x0b, 0xc1, 0x36, 0xbe, 0x24,
-	0xd8, 0xff, 0x2c, 0xc1, 0x43, 0x95, 0x46, 0x4a, 0x34, 0xb9, 0x58, 0x17, 0xc3, 0x63, 0x5c, 0x09,
-	0xcf, 0x16, 0x98, 0x21, 0x8d, 0x3e, 0x11, 0xdf, 0x9d, 0x07, 0x58, 0x15, 0x84, 0xb6, 0xc2, 0xf7,
-	0xb3, 0x30, 0xdf, 0x2e, 0xb8, 0xf2, 0x7d, 0x0a, 0xee, 0x7a, 0x82, 0x54, 0xee, 0x96, 0x20, 0x37,
-	0x3c, 0x18, 0xab, 0x37, 0x3d, 0x18, 0xed, 0x7f, 0x95, 0xb3, 0x1f, 0x49, 0x59, 0xec, 0xe6, 0x37,
-	0xf1, 0x39, 0xa1, 0x16, 0x95, 0x55, 0xba, 0x5d, 0x59, 0xe5, 0xbb, 0x2a, 0x6b, 0x0d, 0xaa, 0x1e,
-	0x9e, 0x72, 0xa2, 0x45, 0xad, 0x06, 0x37, 0x44, 0xa6, 0x7a, 0xdf, 0xa5, 0xa3, 0x76, 0xcf, 0xa5,
-	0x63, 0xfe, 0xaa, 0xae, 0x7f, 0xc1, 0xab, 0x3a, 0xcb, 0xa8, 0xc6, 0xdd, 0x32, 0xea, 0x7b, 0x58,
-	0xee, 0x7a, 0x1f, 0x63, 0x2d, 0xfd, 0xbb, 0xd6, 0x18, 0xbb, 0x0d, 0x2b, 0x6a, 0xba, 0xba, 0x7d,
-	0x7b, 0x0f, 0x1a, 0x59, 0xa2, 0xcd, 0x2f, 0xc2, 0xc8, 0x5f, 0x84, 0x78, 0x24, 0xf3, 0x60, 0xf6,
-	0x48, 0xe6, 0xc1, 0x8b, 0xdf, 0x42, 0x5d, 0x77, 0x25, 0x04, 0x50, 0x3b, 0xee, 0x1d, 0x0f, 0xba,
-	0xaf, 0xcd, 0x25, 0xd4, 0x80, 0xca, 0x61, 0xff, 0xe4, 0xa5, 0x69, 0x68, 0xeb, 0x95, 0x59, 0x12,
-	0x7e, 0x81, 0x9d, 0xbc, 0x32, 0xcb, 0xa8, 0x09, 0xd5, 0x5e, 0x1c, 0x1d, 0xf6, 0xcd, 0xea, 0x8b,
-	0x3f, 0x1b, 0x50, 0xd7, 0xbf, 0x53, 0xd1, 0x0a, 0x34, 0x9c, 0xee, 0xa0, 0xeb, 0x9c, 0xc8, 0x45,
-	0x9a, 0x50, 0x3d, 0x1e, 0x3a, 0x9d, 0x9e, 0x69, 0x08, 0xf3, 0x6d, 0x57, 0x98, 0x25, 0xb1, 0xe0,
-	0x0f, 0x47, 0x9d, 0x9e, 0x59, 0x46, 0x75, 0x28, 0xbf, 0xed, 0xf4, 0xcc, 0x8a, 0x80, 0x7e, 0x37,
-	0xe8, 0x77, 0xcc, 0xaa, 0xf8, 0x46, 0x57, 0xcd, 0xa9, 0xa1, 0x65, 0xa8, 0x9f, 0x1c, 0x3a, 0xc3,
-	0xe3, 0xce, 0x91, 0x59, 0x47, 0x0f, 0xa0, 0xa5, 0x1c, 0x6e, 0x6f, 0xdf, 0x3d, 0x7c, 0x3f, 0x34,
-	0x1b, 0x62, 0xcd, 0xa3, 0x61, 0xd7, 0x7d, 0x67, 0x36, 0x51, 0x0d, 0x4a, 0x3d, 0xc7, 0x84, 0x17,
-	0x7f, 0x31, 0xe0, 0xc1, 0xb5, 0x5f, 0x99, 0xe8, 0xa7, 0x60, 0x77, 0xfa, 0x3d, 0xf1, 0xc8, 0x3f,
-	0xa3, 0x3e, 0xf1, 0x5d, 0x3e, 0x1d, 0x71, 0x8f, 0x51, 0xfd, 0x94, 0x23, 0x8c, 0x8e, 0x29, 0xf1,
-	0xcd, 0x25, 0xf4, 0x13, 0xd8, 0x9c, 0x70, 0x57, 0x50, 0x0b, 0x8c, 0x28, 0x4e, 0xe7, 0x2c, 0x03,
-	0xfd, 0x0c, 0xbe, 0xd1, 0xe9, 0x71, 0x0b, 0xb5, 0xb4, 0xf7, 0xa7, 0x12, 0xd4, 0x07, 0xdf, 0xf5,
-	0x59, 0x7c, 0x71, 0x89, 0x3e, 0x40, 0xab, 0xf0, 0x6a, 0x40, 0x79, 0x95, 0x2c, 0xf8, 0x8f, 0xc7,
-	0xc6, 0xb3, 0xc5, 0x9c, 0x59, 0xc6, 0xdb, 0x4b, 0x62, 0xed, 0x42, 0x65, 0x2e, 0xac, 0xbd, 0xa0,
-	0xa3, 0x15, 0xd6, 0x5e, 0x54, 0xd7, 0xed, 0x25, 0xf4, 0x3d, 0x34, 0x06, 0x24, 0xf2, 0x85, 0xd0,
-	0xd0, 0xc3, 0xdc, 0x94, 0x9c, 0x70, 0x37, 0xd6, 0xaf, 0xe1, 0x5a, 0x91, 0x4b, 0x7b, 0x9f, 0xc0,
-	0xd4, 0x11, 0x50, 0xa0, 0x4f, 0x18, 0xfa, 0x01, 0x56, 0xf2, 0xd5, 0x0b, 0x3d, 0xbd, 0x76, 0xca,
-	0xab, 0x2d, 0x61, 0xc3, 0x5e, 0x48, 0xc9, 0xed, 0x75, 0xff, 0xeb, 0x0f, 0x8f, 0x24, 0x6d, 0x67,
-	0x4c, 0x82, 0x1d, 0x2f, 0x8c, 0xa7, 0xfe, 0x4e, 0x10, 0xeb, 0x7f, 0x37, 0x8d, 0x6a, 0xf2, 0xef,
-	0xb7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xed, 0xf7, 0x2e, 0x45, 0xa9, 0x12, 0x00, 0x00,
+	// 2019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x6e, 0xdb, 0xc8,
+	0xf5, 0x8f, 0x48, 0x7d, 0x1e, 0x5b, 0x0a, 0x33, 0xc9, 0xdf, 0x66, 0xbc, 0xc1, 0xc6, 0x61, 0xfe,
+	0xdb, 0x78, 0x53, 0xd4, 0x76, 0xbd, 0x41, 0xb0, 0x2d, 0xb0, 0x68, 0x65, 0xc7, 0x49, 0x0d, 0x38,
+	0x8a, 0x4a, 0xcb, 0x0e, 0x90, 0x1b, 0x62, 0x44, 0x8e, 0x98, 0xc1, 0x52, 0x24, 0x3d, 0x43, 0xdb,
+	0x71, 0x81, 0x3e, 0xc0, 0x02, 0xbd, 0x69, 0xef, 0x8a, 0xb6, 0x68, 0x5f, 0xa1, 0x97, 0xbd, 0xeb,
+	0x4d, 0x5f, 0xa0, 0x37, 0xbd, 0x6b, 0x5f, 0xa5, 0x98, 0x19, 0x52, 0x22, 0x2d, 0xc9, 0xb6, 0x5a,
+	0xef, 0xb6, 0x0b, 0xf4, 0x4a, 0x33, 0xe7, 0xfc, 0x66, 0x38, 0x3c, 0xe7, 0x77, 0x3e, 0x86, 0x82,
+	0xfb, 0x03, 0xe2, 0x6f, 0xc4, 0x2c, 0x4a, 0x22, 0xbe, 0xc1, 0x3f, 0x77, 0x62, 0x16, 0x7d, 0x38,
+	0x5f, 0x97, 0x73, 0xd4, 0x18, 0x62, 0x7f, 0x88, 0xd7, 0x07, 0xc4, 0x5f, 0x79, 0x18, 0x24, 0x24,
+	0x43, 0x45, 0x98, 0x6e, 0xf0, 0xd8, 0x3f, 0x73, 0x78, 0x82, 0x13, 0xa2, 0xb0, 0xd6, 0xdf, 0xab,
+	0xb0, 0xbc, 0xc3, 0x08, 0x4e, 0xc8, 0x01, 0xe1, 0x9c, 0x46, 0xa1, 0x4d, 0x8e, 0x4f, 0x08, 0x4f,
+	0xba, 0xfe, 0x19, 0x5a, 0x81, 0x7a, 0xec, 0x9f, 0xb5, 0x3d, 0x8f, 0x71, 0xb3, 0xb4, 0x5a, 0x5a,
+	0x6b, 0xd8, 0xa3, 0x39, 0x42, 0x50, 0xa6, 0x43, 0x4e, 0x4d, 0x4d, 0xca, 0xe5, 0x18, 0x2d, 0x41,
+	0x75, 0xc8, 0x29, 0xf7, 0x42, 0x53, 0x97, 0xd2, 0x74, 0x86, 0x0c, 0xd0, 0x87, 0x84, 0x9a, 0x65,
+	0x29, 0x14, 0x43, 0xb4, 0x0d, 0xb7, 0x39, 0x61, 0xa7, 0x34, 0xf4, 0x9d, 0x90, 0x24, 0x67, 0x11,
+	0xfb, 0xd2, 0xac, 0xac, 0x96, 0xd6, 0x16, 0xb6, 0xee, 0xaf, 0x8f, 0xce, 0xbe, 0x7e, 0xa0, 0x10,
+	0x1d, 0x05, 0xb0, 0x5b, 0xbc, 0x30, 0x47, 0xcf, 0x40, 0x3f, 0x09, 0xa8, 0x59, 0x95, 0xeb, 0xac,
+	0xdc, 0xba, 0x43, 0x4e, 0xd8, 0x7e, 0xe4, 0xe2, 0x84, 0x46, 0xe1, 0x5e, 0x38, 0x88, 0xd8, 0x50,
+	0x0e, 0x6d, 0x01, 0x47, 0xdf, 0x83, 0x3a, 0xc3, 0x89, 0x93, 0x9c, 0xc7, 0xc4, 0xac, 0xad, 0x96,
+	0xd6, 0x5a, 0x5b, 0x28, 0xb7, 0xd4, 0x6e, 0xf7, 0x7a, 0xe7, 0x31, 0xb1, 0x6b, 0x0c, 0x27, 0x62,
+	0x20, 0xe0, 0xb1, 0x17, 0x2a, 0x78, 0x7d, 0x02, 0xde, 0x7d, 0xd1, 0x51, 0xf0, 0xd8, 0x0b, 0x25,
+	0xfc, 0xfb, 0xa0, 0xc7, 0x18, 0x9b, 0x0d, 0x79, 0xa6, 0x87, 0x79, 0xa4, 0x17, 0x0a, 0xbb, 0x11,
+	0xce, 0xdb, 0x41, 0x90, 0x9e, 0xcd, 0x16, 0x58, 0x61, 0x1c, 0x1c, 0x87, 0x26, 0x28, 0xe3, 0xe0,
+	0x38, 0x44, 0x8f, 0xa1, 0x8c, 0x87, 0x7d, 0x66, 0x2e, 0xc8, 0x5d, 0x6e, 0xe7, 0x76, 0x69, 0x0f,
+	0xfb, 0xcc, 0x96, 0x4a, 0xb4, 0x03, 0x2d, 0x4e, 0x02, 0xe2, 0x8a, 0x8d, 0x9c, 0x61, 0xe4, 0x11,
+	0x73, 0x51, 0x1e, 0xef, 0x41, 0xc1, 0x80, 0x29, 0xe0, 0x75, 0xe4, 0x11, 0x79, 0xd0, 0x26, 0xcf,
+	0x8b, 0xd0, 0x8f, 0xa0, 0xd5, 0x27, 0x98, 0x11, 0xe6, 0xb8, 0x51, 0x98, 0x90, 0x0f, 0x89, 0xd9,
+	0x94, 0xcf, 0x34, 0x73, 0x9b, 0x6c, 0x4b, 0xc0, 0x8e, 0xd2, 0xdb, 0xcd, 0x7e, 0x7e, 0x8a, 0x1e,
+	0x00, 0xb8, 0x0e, 0xf6, 0xcf, 0x9c, 0x84, 0x50, 0xcf, 0x6c, 0xad, 0x96, 0xd6, 0x9a, 0x76, 0xdd,
+	0x6d, 0xfb, 0x67, 0x3d, 0x42, 0x3d, 0x34, 0x84, 0x8f, 0x25, 0xc9, 0xdc, 0x28, 0x10, 0x0f, 0x18,
+	0x50, 0xff, 0x84, 0xc9, 0x37, 0x77, 0xa2, 0x58, 0xfc, 0x70, 0xf3, 0xb6, 0x7c, 0xdc, 0x93, 0xbc,
+	0xa1, 0xd2, 0x05, 0x3b, 0x79, 0xfc, 0x1b, 0x05, 0xb7, 0x1f, 0xc4, 0x97, 0x68, 0xd1, 0x13, 0xb8,
+	0x4d, 0x43, 0x8f, 0x2a, 0xe3, 0x3a, 0x83, 0x00, 0xfb, 0xa6, 0xb1, 0x5a, 0x5a, 0x5b, 0xb4, 0x5b,
+	0x63, 0xf1, 0xcb, 0x00, 0xfb, 0xe8, 0x07, 0x60, 0xba, 0xef, 0x31, 0xf3, 0x05, 0xfd, 0xc4, 0x00,
+	0xbb, 0x09, 0x61, 0x94, 0x27, 0xd4, 0xe5, 0xe6, 0x1d, 0xe9, 0x87, 0xe5, 0x4c, 0xbf, 0x53, 0x54,
+	0xa3, 0x4d, 0x68, 0x24, 0x74, 0x48, 0x9c, 0x9f, 0x45, 0x21, 0x31, 0x91, 0x3c, 0xfd, 0xdd, 0xdc,
+	0xe9, 0x7b, 0x74, 0x48, 0xde, 0x45, 0x21, 0xb1, 0xeb, 0x49, 0x3a, 0xb2, 0xfe, 0x58, 0x82, 0xe5,
+	0x19, 0x8c, 0x14, 0xbe, 0x0f, 0xb0, 0x2b, 0x63, 0xab, 0x69, 0x8b, 0x21, 0x6a, 0x81, 0xe6, 0xaa,
+	0xa0, 0x6a, 0xda, 0x9a, 0x4b, 0x05, 0x82, 0x63, 0x57, 0xc6, 0x53, 0xd3, 0x16, 0x43, 0x21, 0x61,
+	0xd8, 0x95, 0xc1, 0xd4, 0xb4, 0x75, 0xa6, 0x24, 0x09, 0x76, 0x65, 0x00, 0x35, 0x6d, 0x3d, 0x51,
+	0x12, 0xe2, 0xaa, 0xd0, 0x68, 0xda, 0x62, 0x88, 0xee, 0x41, 0xe5, 0x35, 0xe9, 0xf4, 0xa9, 0xe4,
+	0x7c, 0xd3, 0x56, 0x13, 0x11, 0xb0, 0xbb, 0x4a, 0x5c, 0x97, 0xe2, 0x74, 0x66, 0x3d, 0x83, 0x56,
+	0x31, 0xf8, 0x64, 0x08, 0xbb, 0x6e, 0x9a, 0x05, 0xc4, 0x50, 0x4a, 0x42, 0x37, 0x8d, 0x7f, 0x31,
+	0xb4, 0x7e, 0x5f, 0x82, 0x07, 0xdd, 0x2b, 0x1c, 0xa4, 0x68, 0xe0, 0x64, 0x7e, 0x4c, 0x5f, 0xbd,
+	0xa5, 0xc4, 0xd9, 0x62, 0x74, 0x04, 0xff, 0x27, 0x11, 0x4e, 0xa4, 0x98, 0x89, 0x69, 0x48, 0x98,
+	0x43, 0x3d, 0x53, 0x5b, 0xd5, 0xd7, 0x16, 0xb6, 0x1e, 0xe7, 0xf9, 0xe2, 0x46, 0xd9, 0xb2, 0x37,
+	0x6c, 0x27, 0xc3, 0xee, 0x79, 0x36, 0x92, 0x3b, 0x14, 0x64, 0xd6, 0x4b, 0x30, 0x67, 0xe1, 0x85,
+	0xe5, 0xa9, 0x97, 0x9e, 0x47, 0xa3, 0x9e, 0x48, 0x7e, 0x32, 0x28, 0xc2, 0x84, 0xcb, 0x97, 0x5c,
+	0xb4, 0x47, 0x73, 0xeb, 0x1f, 0x25, 0x68, 0x16, 0xe2, 0x62, 0x62, 0xf5, 0x0f, 0xc1, 0x38, 0xe1,
+	0x84, 0x39, 0x71, 0x80, 0x43, 0xe2, 0x0c, 0x64, 0x78, 0x68, 0x92, 0x2e, 0x46, 0xee, 0xf0, 0x2f,
+	0x85, 0xdc, 0x6e, 0x09, 0x64, 0x57, 0x00, 0xe5, 0x1c, 0x7d, 0x17, 0xf4, 0xe3, 0x88, 0x9b, 0xfa,
+	0x44, 0x42, 0xfc, 0x69, 0xc4, 0x0b, 0xf9, 0xec, 0x38, 0xe2, 0xe8, 0x21, 0x2c, 0x8c, 0xb8, 0x4c,
+	0xbd, 0x94, 0x16, 0x90, 0x89, 0xf6, 0x3c, 0x91, 0x26, 0x93, 0x41, 0x62, 0x56, 0x0a, 0x69, 0x32,
+	0x48, 0xc8, 0x7a, 0x84, 0xe9, 0x7a, 0x8f, 0xe1, 0xc1, 0x80, 0xba, 0x2f, 0x83, 0xe8, 0xac, 0x47,
+	0x86, 0x71, 0x80, 0x13, 0x62, 0x0b, 0xb8, 0xf5, 0x4b, 0x0d, 0x5a, 0xc5, 0xc7, 0x09, 0x87, 0xc7,
+	0x2e, 0xcd, 0xc8, 0x1a, 0xbb, 0x14, 0x7d, 0x02, 0xad, 0x98, 0xd1, 0x88, 0xd1, 0xe4, 0xdc, 0x09,
+	0xc8, 0x29, 0x09, 0x52, 0xe2, 0x36, 0x33, 0xe9, 0xbe, 0x10, 0xa2, 0xcf, 0x84, 0x37, 0x09, 0x19,
+	0x4a, 0x16, 0x38, 0x2e, 0x8e, 0x71, 0x9f, 0x06, 0x34, 0x39, 0x4f, 0x59, 0x7d, 0x6f, 0xac, 0xdc,
+	0x19, 0xe9, 0x44, 0x8c, 0xe6, 0x16, 0x9d, 0x9e, 0x04, 0x21, 0x61, 0xd9, 0x3a, 0xf5, 0x92, 0xcb,
+	0x63, 0xfd, 0x51, 0x5e, 0x2d, 0x0e, 0x7a, 0xec, 0xd2, 0x2c, 0x1e, 0x8e, 0x5d, 0x8a, 0x1e, 0x81,
+	0xee, 0xf7, 0x99, 0x59, 0x9d, 0x9e, 0x50, 0x85, 0x4e, 0x40, 0x44, 0xce, 0xad, 0xcd, 0x80, 0x0c,
+	0xfb, 0xcc, 0xda, 0x84, 0xb2, 0x98, 0xa0, 0xbb, 0x50, 0xe9, 0x33, 0xe7, 0x44, 0x91, 0xb7, 0x6c,
+	0x97, 0xfb, 0xec, 0x30, 0x48, 0x85, 0x9e, 0x32, 0x81, 0x14, 0xbe, 0x08, 0xac, 0x9f, 0xc3, 0xbd,
+	0x69, 0x89, 0x1f, 0x3d, 0x82, 0x45, 0x1a, 0x9f, 0x3e, 0x73, 0xb0, 0xd2, 0xa4, 0x61, 0xb5, 0x20,
+	0x64, 0x29, 0x38, 0x85, 0x3c, 0x1f, 0x41, 0xb4, 0x11, 0xe4, 0x79, 0x06, 0x79, 0x08, 0x72, 0xea,
+	0xc4, 0x8c, 0x0c, 0xe8, 0x87, 0xd4, 0x9a, 0x20, 0x44, 0x5d, 0x29, 0xb1, 0x30, 0xd4, 0xb3, 0x84,
+	0x84, 0x1e, 0x43, 0xd3, 0x23, 0x41, 0x82, 0x1d, 0x4e, 0xdc, 0x28, 0xf4, 0xd4, 0x33, 0x2b, 0xf6,
+	0xa2, 0x14, 0x1e, 0x28, 0x19, 0xda, 0x84, 0x7b, 0x1e, 0x3e, 0x0f, 0xa8, 0xff, 0x3e, 0x71, 0x38,
+	0x96, 0xe5, 0x59, 0xe4, 0xb1, 0xd4, 0xad, 0x28, 0xd3, 0x1d, 0x48, 0x95, 0xd8, 0xda, 0xc2, 0x50,
+	0x51, 0xa4, 0xbd, 0x99, 0x57, 0x42, 0x50, 0x96, 0xa1, 0xa2, 0xde, 0x45, 0x8e, 0xad, 0xbf, 0xe9,
+	0x60, 0x5e, 0xe8, 0x50, 0x78, 0x1c, 0x85, 0x9c, 0x88, 0x16, 0x25, 0x5f, 0x9f, 0x4b, 0xd7, 0xae,
+	0xcf, 0xda, 0x1c, 0xf5, 0xf9, 0x09, 0xdc, 0xc6, 0x71, 0xe8, 0x30, 0xc2, 0x13, 0x46, 0x65, 0xe9,
+	0x4c, 0x4f, 0xd7, 0xc2, 0x71, 0x68, 0x8f, 0xa5, 0x17, 0x6a, 0x61, 0xf9, 0x42, 0x2d, 0xdc, 0x84,
+	0x05, 0xd7, 0x11, 0xcd, 0x97, 0xca, 0x05, 0x95, 0x19, 0xb9, 0xa0, 0xe1, 0x76, 0xfd, 0x33, 0x39,
+	0x9c, 0x52, 0x9c, 0xab, 0xf3, 0x15, 0xe7, 0xab, 0xcb, 0x6f, 0xed, 0x26, 0xcb, 0xef, 0x26, 0x34,
+	0xfc, 0x24, 0x76, 0x08, 0x63, 0x11, 0x33, 0xeb, 0x13, 0xa5, 0xf1, 0x55, 0x12, 0xef, 0x0a, 0x95,
+	0x5d, 0xf7, 0xd3, 0x91, 0xf5, 0x5b, 0x0d, 0x96, 0x5f, 0x90, 0x80, 0xdc, 0x44, 0xef, 0xf9, 0x11,
+	0x34, 0x52, 0x6b, 0x8d, 0xe8, 0x53, 0x57, 0x82, 0x3d, 0xef, 0x0a, 0xd7, 0x48, 0x6d, 0x9c, 0x69,
+	0x2b, 0xa9, 0xb6, 0x9b, 0x6a, 0xa7, 0xb4, 0xaa, 0xd5, 0x7f, 0xb1, 0x55, 0xad, 0xcd, 0xd5, 0xaa,
+	0x5a, 0xfb, 0x60, 0x5e, 0xb0, 0xce, 0x98, 0xf7, 0x05, 0x63, 0x97, 0xae, 0x63, 0xec, 0x3f, 0x69,
+	0xb0, 0xa4, 0xc2, 0x48, 0x91, 0x26, 0x67, 0xeb, 0xa2, 0x79, 0x4a, 0x17, 0xcc, 0xb3, 0x06, 0x46,
+	0x40, 0xc3, 0x2f, 0x89, 0xe7, 0x8c, 0x0d, 0xac, 0x12, 0x42, 0x4b, 0xc9, 0xb7, 0x33, 0x33, 0x5f,
+	0x4d, 0x38, 0xfd, 0x26, 0x09, 0x37, 0x19, 0x20, 0xe5, 0xf9, 0x02, 0x64, 0x4a, 0xc3, 0x58, 0x99,
+	0xd6, 0x30, 0x5a, 0x7f, 0xd1, 0xb3, 0x4b, 0x52, 0x66, 0xbb, 0xb1, 0x27, 0x2e, 0x23, 0x6a, 0x91,
+	0x59, 0xda, 0xd5, 0xcc, 0xd2, 0xe7, 0x65, 0xd6, 0x3d, 0xa8, 0xb8, 0xf8, 0x84, 0x93, 0x94, 0xd4,
+	0x6a, 0x32, 0xc5, 0x32, 0x95, 0x9b, 0x4e, 0x1d, 0xd5, 0x1b, 0x4e, 0x1d, 0xe3, 0xae, 0xba, 0x76,
+	0x8d, 0xae, 0x3a, 0x8b, 0xa8, 0xfa, 0x7c, 0x11, 0xf5, 0x05, 0x2c, 0xec, 0xba, 0xef, 0xa3, 0x94,
+	0xfa, 0xf3, 0xe6, 0x18, 0xab, 0x05, 0x8b, 0x6a, 0xb9, 0xf2, 0xbe, 0xb5, 0x05, 0xf5, 0x2c, 0xd0,
+	0xc6, 0x8e, 0x28, 0xe5, 0x1d, 0x21, 0x9a, 0x64, 0xee, 0x8f, 0x9a, 0x64, 0xee, 0x5b, 0x7f, 0xd0,
+	0x61, 0xe9, 0x75, 0xe4, 0xd1, 0xc1, 0xf9, 0x44, 0x18, 0xce, 0x9b, 0xf2, 0x8a, 0x61, 0xab, 0x5f,
+	0x9a, 0xd5, 0xca, 0x57, 0x73, 0xef, 0xbf, 0xf3, 0x02, 0x3e, 0x49, 0xe5, 0xfa, 0x7c, 0x54, 0x2e,
+	0x70, 0xab, 0x71, 0x9d, 0x1b, 0xdb, 0xaf, 0x35, 0x58, 0x2e, 0xba, 0x68, 0x1c, 0xed, 0x97, 0xa7,
+	0xca, 0xc9, 0xc3, 0x6a, 0x37, 0x1d, 0x77, 0xfa, 0xd7, 0x56, 0xb2, 0xcb, 0xd7, 0xa9, 0x22, 0xbf,
+	0xd1, 0x60, 0x49, 0x15, 0xa5, 0xec, 0x3d, 0x86, 0x43, 0x1c, 0x7a, 0xdf, 0x34, 0x7d, 0x0b, 0xd5,
+	0xbe, 0x72, 0xa1, 0xda, 0xff, 0xe7, 0x2a, 0xf6, 0x2a, 0x7c, 0x3c, 0xc5, 0x38, 0x39, 0xfe, 0x58,
+	0xbf, 0xd2, 0x60, 0xe9, 0x30, 0xf6, 0xe6, 0xaf, 0xc2, 0xff, 0x36, 0xb5, 0x9e, 0x42, 0x5d, 0xf4,
+	0xb1, 0xf2, 0xcb, 0x92, 0x3e, 0xfd, 0x96, 0x53, 0xc3, 0x71, 0x28, 0x06, 0xd7, 0xa0, 0x61, 0xf9,
+	0x06, 0x69, 0x28, 0xcb, 0x6b, 0xd1, 0x28, 0xff, 0x2b, 0xaf, 0xdf, 0xc6, 0xf2, 0xfa, 0xd5, 0x85,
+	0xe4, 0xf0, 0x35, 0xb4, 0x98, 0x16, 0x34, 0x49, 0xcc, 0x9d, 0x8b, 0xad, 0xfe, 0x02, 0x89, 0xf9,
+	0x08, 0x33, 0xdd, 0x69, 0x57, 0xdb, 0xbc, 0x72, 0x93, 0x9c, 0xfe, 0xab, 0x9e, 0xdd, 0x6d, 0xbe,
+	0x1d, 0x9c, 0x9e, 0xe6, 0x82, 0xca, 0xf5, 0x5c, 0x50, 0x9d, 0x74, 0xc1, 0x37, 0x7f, 0xf5, 0x1c,
+	0x13, 0xbc, 0x3e, 0x07, 0xc1, 0x1b, 0x73, 0x11, 0xfc, 0xe9, 0x8f, 0xa1, 0x96, 0x7e, 0x52, 0x40,
+	0x00, 0xd5, 0xc3, 0xce, 0xe1, 0xc1, 0xee, 0x0b, 0xe3, 0x16, 0xaa, 0x43, 0x79, 0xaf, 0x7b, 0xf4,
+	0xcc, 0x28, 0xa5, 0xa3, 0xe7, 0x86, 0x26, 0xf4, 0x42, 0x76, 0xf4, 0xdc, 0xd0, 0x51, 0x03, 0x2a,
+	0x9d, 0x28, 0xdc, 0xeb, 0x1a, 0x95, 0xa7, 0x5f, 0x95, 0xa0, 0x96, 0xf6, 0x38, 0x68, 0x11, 0xea,
+	0xf6, 0xee, 0xc1, 0xae, 0x7d, 0x24, 0x37, 0x69, 0x40, 0xe5, 0xb0, 0x67, 0xb7, 0x3b, 0x46, 0x49,
+	0x0c, 0x5f, 0xed, 0x8a, 0xa1, 0x26, 0x36, 0x7c, 0xbb, 0xdf, 0xee, 0x18, 0x3a, 0xaa, 0x81, 0xfe,
+	0xaa, 0xdd, 0x31, 0xca, 0x42, 0xf4, 0x93, 0x83, 0x6e, 0xdb, 0xa8, 0x88, 0x67, 0xec, 0xaa, 0x35,
+	0x55, 0xb4, 0x00, 0xb5, 0xa3, 0x3d, 0xbb, 0x77, 0xd8, 0xde, 0x37, 0x6a, 0xe8, 0x0e, 0x34, 0x95,
+	0xc2, 0xe9, 0x6c, 0x3b, 0x7b, 0x6f, 0x7a, 0x46, 0x5d, 0xec, 0xb9, 0xdf, 0xdb, 0x75, 0x5e, 0x1b,
+	0x0d, 0x54, 0x05, 0xad, 0x63, 0x1b, 0xf0, 0xf4, 0x17, 0x25, 0xb8, 0x33, 0xf1, 0x17, 0x01, 0xfa,
+	0x0e, 0x58, 0xed, 0x6e, 0x47, 0x7c, 0xa1, 0x3d, 0xa5, 0x1e, 0xf1, 0x1c, 0x7e, 0xd2, 0xe7, 0x2e,
+	0xa3, 0xe9, 0x77, 0x38, 0xc2, 0xe8, 0x80, 0x12, 0xcf, 0xb8, 0x85, 0xfe, 0x1f, 0x56, 0x87, 0xdc,
+	0x11, 0xd0, 0x02, 0x22, 0x8c, 0x92, 0x31, 0xaa, 0x84, 0x3e, 0x85, 0x4f, 0x52, 0xa2, 0x5e, 0x01,
+	0xd5, 0xb6, 0xfe, 0xac, 0x43, 0xed, 0xe0, 0xf3, 0xae, 0xf8, 0x1f, 0x0b, 0xbd, 0x83, 0x66, 0xe1,
+	0x93, 0x0f, 0xca, 0xbb, 0x68, 0xc6, 0xdf, 0x55, 0x2b, 0x8f, 0x67, 0x63, 0xc6, 0x05, 0xf8, 0x96,
+	0xd8, 0xbb, 0x70, 0xad, 0x2e, 0xec, 0x3d, 0xe3, 0x73, 0xc4, 0xca, 0xe3, 0xd9, 0x98, 0xfc, 0xde,
+	0x5f, 0x40, 0xfd, 0x80, 0x84, 0x9e, 0xb8, 0x25, 0xa0, 0xa5, 0xdc, 0x92, 0xdc, 0xad, 0x63, 0x65,
+	0x79, 0x42, 0x9e, 0x5e, 0x27, 0x6e, 0xa1, 0xb7, 0xb0, 0x98, 0x6f, 0x3c, 0xd1, 0xa3, 0x1c, 0x74,
+	0xfa, 0xa5, 0x61, 0xc5, 0x9a, 0x09, 0xc9, 0x9f, 0x8b, 0xc0, 0xdd, 0x29, 0x8d, 0x49, 0x61, 0xff,
+	0xe9, 0x5d, 0xdd, 0xca, 0xa7, 0x97, 0x43, 0x0a, 0x8f, 0xd9, 0xfa, 0x9d, 0x06, 0x46, 0xea, 0x42,
+	0xa5, 0xf0, 0x08, 0x13, 0x2f, 0x95, 0xbf, 0x3b, 0x17, 0x1e, 0x3a, 0xfd, 0x83, 0xc4, 0x8a, 0x35,
+	0x13, 0x92, 0x7f, 0xa9, 0xb7, 0xb0, 0x98, 0xef, 0x1a, 0x0a, 0x1b, 0x4f, 0xef, 0xb1, 0x56, 0xac,
+	0x99, 0x90, 0x0b, 0x1b, 0xe7, 0x5f, 0x75, 0xa6, 0x99, 0x66, 0x6c, 0x3c, 0x23, 0xed, 0x5b, 0xb7,
+	0xb6, 0x3f, 0x7a, 0x77, 0x5f, 0xc2, 0x36, 0xc4, 0x7f, 0xb7, 0x6e, 0x10, 0x9d, 0x78, 0x1b, 0x7e,
+	0x94, 0xfe, 0x3d, 0xdb, 0xaf, 0xca, 0xdf, 0xcf, 0xfe, 0x39, 0x00, 0xc6, 0xfb, 0x52, 0xbc, 0xd9,
+	0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1582,6 +2235,8 @@ type S8ProxyClient interface {
 	CreateSession(ctx context.Context, in *CreateSessionRequestPgw, opts ...grpc.CallOption) (*CreateSessionResponsePgw, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequestPgw, opts ...grpc.CallOption) (*DeleteSessionResponsePgw, error)
 	SendEcho(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
+	ModifyBearer(ctx context.Context, in *ModifyBearerRequestPgw, opts ...grpc.CallOption) (*ModifyBearerResponsePgw, error)
+	DeleteBearerCommand(ctx context.Context, in *DeleteBearerCommandPgw, opts ...grpc.CallOption) (*DeleteBearerCommandResponsePgw, error)
 }
 
 type s8ProxyClient struct {
@@ -1619,11 +2274,31 @@ func (c *s8ProxyClient) SendEcho(ctx context.Context, in *EchoRequest, opts ...g
 	return out, nil
 }
 
+func (c *s8ProxyClient) ModifyBearer(ctx context.Context, in *ModifyBearerRequestPgw, opts ...grpc.CallOption) (*ModifyBearerResponsePgw, error) {
+	out := new(ModifyBearerResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/ModifyBearer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s8ProxyClient) DeleteBearerCommand(ctx context.Context, in *DeleteBearerCommandPgw, opts ...grpc.CallOption) (*DeleteBearerCommandResponsePgw, error) {
+	out := new(DeleteBearerCommandResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/DeleteBearerCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S8ProxyServer is the server API for S8Proxy service.
 type S8ProxyServer interface {
 	CreateSession(context.Context, *CreateSessionRequestPgw) (*CreateSessionResponsePgw, error)
 	DeleteSession(context.Context, *DeleteSessionRequestPgw) (*DeleteSessionResponsePgw, error)
 	SendEcho(context.Context, *EchoRequest) (*EchoResponse, error)
+	ModifyBearer(context.Context, *ModifyBearerRequestPgw) (*ModifyBearerResponsePgw, error)
+	DeleteBearerCommand(context.Context, *DeleteBearerCommandPgw) (*DeleteBearerCommandResponsePgw, error)
 }
 
 // UnimplementedS8ProxyServer can be embedded to have forward compatible implementations.
@@ -1639,6 +2314,12 @@ func (*UnimplementedS8ProxyServer) DeleteSession(ctx context.Context, req *Delet
 func (*UnimplementedS8ProxyServer) SendEcho(ctx context.Context, req *EchoRequest) (*EchoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEcho not implemented")
 }
+func (*UnimplementedS8ProxyServer) ModifyBearer(ctx context.Context, req *ModifyBearerRequestPgw) (*ModifyBearerResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBearer not implemented")
+}
+func (*UnimplementedS8ProxyServer) DeleteBearerCommand(ctx context.Context, req *DeleteBearerCommandPgw) (*DeleteBearerCommandResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBearerCommand not implemented")
+}
 
 func RegisterS8ProxyServer(s *grpc.Server, srv S8ProxyServer) {
 	s.RegisterService(&_S8Proxy_serviceDesc, srv)
@@ -1698,6 +2379,42 @@ func _S8Proxy_SendEcho_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S8Proxy_ModifyBearer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBearerRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyServer).ModifyBearer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8Proxy/ModifyBearer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyServer).ModifyBearer(ctx, req.(*ModifyBearerRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S8Proxy_DeleteBearerCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBearerCommandPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyServer).DeleteBearerCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8Proxy/DeleteBearerCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyServer).DeleteBearerCommand(ctx, req.(*DeleteBearerCommandPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S8Proxy",
 	HandlerType: (*S8ProxyServer)(nil),
@@ -1714,6 +2431,14 @@ var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEcho",
 			Handler:    _S8Proxy_SendEcho_Handler,
 		},
+		{
+			MethodName: "ModifyBearer",
+			Handler:    _S8Proxy_ModifyBearer_Handler,
+		},
+		{
+			MethodName: "DeleteBearerCommand",
+			Handler:    _S8Proxy_DeleteBearerCommand_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s8_proxy.proto",
@@ -1724,6 +2449,8 @@ var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type S8ProxyResponderClient interface {
 	CreateBearer(ctx context.Context, in *CreateBearerRequestPgw, opts ...grpc.CallOption) (*CreateBearerResponsePgw, error)
+	UpdateBearer(ctx context.Context, in *UpdateBearerRequestPgw, opts ...grpc.CallOption) (*UpdateBearerResponsePgw, error)
+	DeleteBearer(ctx context.Context, in *DeleteBearerRequestPgw, opts ...grpc.CallOption) (*DeleteBearerResponsePgw, error)
 }
 
 type s8ProxyResponderClient struct {
@@ -1743,9 +2470,29 @@ func (c *s8ProxyResponderClient) CreateBearer(ctx context.Context, in *CreateBea
 	return out, nil
 }
 
+func (c *s8ProxyResponderClient) UpdateBearer(ctx context.Context, in *UpdateBearerRequestPgw, opts ...grpc.CallOption) (*UpdateBearerResponsePgw, error) {
+	out := new(UpdateBearerResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8ProxyResponder/UpdateBearer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s8ProxyResponderClient) DeleteBearer(ctx context.Context, in *DeleteBearerRequestPgw, opts ...grpc.CallOption) (*DeleteBearerResponsePgw, error) {
+	out := new(DeleteBearerResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8ProxyResponder/DeleteBearer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S8ProxyResponderServer is the server API for S8ProxyResponder service.
 type S8ProxyResponderServer interface {
 	CreateBearer(context.Context, *CreateBearerRequestPgw) (*CreateBearerResponsePgw, error)
+	UpdateBearer(context.Context, *UpdateBearerRequestPgw) (*UpdateBearerResponsePgw, error)
+	DeleteBearer(context.Context, *DeleteBearerRequestPgw) (*DeleteBearerResponsePgw, error)
 }
 
 // UnimplementedS8ProxyResponderServer can be embedded to have forward compatible implementations.
@@ -1755,6 +2502,12 @@ type UnimplementedS8ProxyResponderServer struct {
 func (*UnimplementedS8ProxyResponderServer) CreateBearer(ctx context.Context, req *CreateBearerRequestPgw) (*CreateBearerResponsePgw, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBearer not implemented")
 }
+func (*UnimplementedS8ProxyResponderServer) UpdateBearer(ctx context.Context, req *UpdateBearerRequestPgw) (*UpdateBearerResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBearer not implemented")
+}
+func (*UnimplementedS8ProxyResponderServer) DeleteBearer(ctx context.Context, req *DeleteBearerRequestPgw) (*DeleteBearerResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBearer not implemented")
+}
 
 func RegisterS8ProxyResponderServer(s *grpc.Server, srv S8ProxyResponderServer) {
 	s.RegisterService(&_S8ProxyResponder_serviceDesc, srv)
@@ -1778,6 +2531,42 @@ func _S8ProxyResponder_CreateBearer_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S8ProxyResponder_UpdateBearer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBearerRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyResponderServer).UpdateBearer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8ProxyResponder/UpdateBearer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyResponderServer).UpdateBearer(ctx, req.(*UpdateBearerRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S8ProxyResponder_DeleteBearer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBearerRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyResponderServer).DeleteBearer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8ProxyResponder/DeleteBearer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyResponderServer).DeleteBearer(ctx, req.(*DeleteBearerRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S8ProxyResponder_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S8ProxyResponder",
 	HandlerType: (*S8ProxyResponderServer)(nil),
@@ -1786,6 +2575,14 @@ var _S8ProxyResponder_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBearer",
 			Handler:    _S8ProxyResponder_CreateBearer_Handler,
 		},
+		{
+			MethodName: "UpdateBearer",
+			Handler:    _S8ProxyResponder_UpdateBearer_Handler,
+		},
+		{
+			MethodName: "DeleteBearer",
+			Handler:    _S8ProxyResponder_DeleteBearer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s8_proxy.proto",
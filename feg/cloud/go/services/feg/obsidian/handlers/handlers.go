@@ -116,7 +116,7 @@ func getGateway(c echo.Context) error {
 		return nerr
 	}
 
-	magmadModel, nerr := handlers.LoadMagmadGateway(c.Request().Context(), nid, gid)
+	magmadModel, version, nerr := handlers.LoadMagmadGatewayWithVersion(c.Request().Context(), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
@@ -140,6 +140,7 @@ func getGateway(c echo.Context) error {
 		Magmad:      magmadModel.Magmad,
 		Federation:  ent.Config.(*fegModels.GatewayFederationConfigs),
 	}
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -159,7 +160,11 @@ func deleteGateway(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := handlers.DeleteMagmadGateway(c.Request().Context(), nid, gid, storage.TKs{{Type: feg.FegGatewayType, Key: gid}})
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := handlers.DeleteMagmadGatewayIfMatch(c.Request().Context(), nid, gid, expectedVersion, storage.TKs{{Type: feg.FegGatewayType, Key: gid}})
 	if err != nil {
 		return makeErr(err)
 	}
@@ -249,6 +254,9 @@ func getHealthStatusHandler(c echo.Context) error {
 }
 
 func makeErr(err error) *echo.HTTPError {
+	if nerr, ok := err.(*echo.HTTPError); ok {
+		return nerr
+	}
 	if err == merrors.ErrNotFound {
 		return echo.ErrNotFound
 	}
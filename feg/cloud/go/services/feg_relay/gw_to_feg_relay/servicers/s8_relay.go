@@ -61,6 +61,24 @@ func (s S8RelayRouter) DeleteSession(c context.Context, req *protos.DeleteSessio
 	return client.DeleteSession(ctx, req)
 }
 
+func (s S8RelayRouter) ModifyBearer(c context.Context, req *protos.ModifyBearerRequestPgw) (*protos.ModifyBearerResponsePgw, error) {
+	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.ModifyBearer(ctx, req)
+}
+
+func (s S8RelayRouter) DeleteBearerCommand(c context.Context, req *protos.DeleteBearerCommandPgw) (*protos.DeleteBearerCommandResponsePgw, error) {
+	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.DeleteBearerCommand(ctx, req)
+}
+
 func (s S8RelayRouter) SendEcho(c context.Context, req *protos.EchoRequest) (*protos.EchoResponse, error) {
 	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
 	if err != nil {
@@ -22,6 +22,7 @@ replace (
 )
 
 require (
+	github.com/alicebob/miniredis v2.5.0+incompatible
 	github.com/emakeev/milenage v1.0.0
 	github.com/emakeev/snowflake v0.0.0-20200206205012-767080b052fe
 	github.com/envoyproxy/go-control-plane v0.9.4
@@ -28,6 +28,7 @@ const (
 	CONTROL_PROXY    = "CONTROL_PROXY"
 	S6A_PROXY        = "S6A_PROXY"
 	S8_PROXY         = "S8_PROXY"
+	RX_PROXY         = "RX_PROXY"
 	SESSION_PROXY    = "SESSION_PROXY"
 	SWX_PROXY        = "SWX_PROXY"
 	HLR_PROXY        = "HLR_PROXY"
@@ -39,6 +40,7 @@ const (
 	EAP              = "EAP"
 	EAP_SIM          = "EAP_SIM"
 	EAP_AKA          = "EAP_AKA"
+	EAP_AKA_PRIME    = "EAP_AKA_PRIME"
 	RADIUSD          = "RADIUSD"
 	RADIUS           = "RADIUS"
 	REDIS            = "REDIS"
@@ -93,11 +95,13 @@ func init() {
 	addLocalService(AAA_SERVER, 9109)
 	addLocalService(EAP_SIM, 9118)
 	addLocalService(EAP_AKA, 9123)
+	addLocalService(EAP_AKA_PRIME, 9124)
 	addLocalService(SWX_PROXY, 9110)
 	addLocalService(RADIUSD, 9115)
 	addLocalService(HLR_PROXY, 9116)
 	addLocalService(PIPELINED, 9117)
 	addLocalService(ENVOY_CONTROLLER, 9118)
+	addLocalService(RX_PROXY, 9125)
 
 	addLocalService(MOCK_OCS, 9201)
 	addLocalService(MOCK_PCRF, 9202)
@@ -31,6 +31,8 @@ import (
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
 	_ "magma/feg/gateway/services/eap/providers/aka/servicers/handlers"
+	aka_prime_servicers "magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	_ "magma/feg/gateway/services/eap/providers/aka_prime/servicers/handlers"
 	eap_test "magma/feg/gateway/services/eap/test"
 	"magma/orc8r/cloud/go/test_utils"
 )
@@ -118,7 +120,8 @@ func TestEAPPeerNak(t *testing.T) {
 		eap.ResponseCode, 236,
 		append([]byte{eap.MethodIdentity}, []byte("6001010000000091@wlan.mnc001.mcc001.3gppnetwork.org")...))
 	permIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x17, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
-	akaPrimeNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 50}
+	akaPrimePermIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x32, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
+	ttlsNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 21}
 	akaAkaPrimeNak := []byte{0x02, 236, 0x00, 0x07, 0x03, 50, 23}
 
 	eapSrv, eapLis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA)
@@ -130,6 +133,15 @@ func TestEAPPeerNak(t *testing.T) {
 	eapp.RegisterEapServiceServer(eapSrv.GrpcServer, servicer)
 	go eapSrv.RunTest(eapLis)
 
+	akaPrimeSrv, akaPrimeLis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA_PRIME)
+	akaPrimeServicer, err := aka_prime_servicers.NewEapAkaPrimeService(nil)
+	if err != nil {
+		t.Fatalf("failed to create EAP AKA' Service: %v", err)
+		return
+	}
+	eapp.RegisterEapServiceServer(akaPrimeSrv.GrpcServer, akaPrimeServicer)
+	go akaPrimeSrv.RunTest(akaPrimeLis)
+
 	rtrSrv, rtrLis := test_utils.NewTestService(t, registry.ModuleName, registry.AAA_SERVER)
 	protos.RegisterAuthenticatorServer(rtrSrv.GrpcServer, &testAuthenticator{supportedMethods: eap_client.SupportedTypes()})
 	go rtrSrv.RunTest(rtrLis)
@@ -143,19 +155,20 @@ func TestEAPPeerNak(t *testing.T) {
 	if !reflect.DeepEqual(peap.GetPayload(), permIdReq) {
 		t.Fatalf("Unexpected Identity Responsen\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
 	}
-	peap, err = aaa_client.Handle(&protos.Eap{Payload: akaPrimeNak, Ctx: peap.Ctx})
+	peap, err = aaa_client.Handle(&protos.Eap{Payload: ttlsNak, Ctx: peap.Ctx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
 	if !reflect.DeepEqual(peap.GetPayload(), failureEAP) {
-		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
+		t.Fatalf("Unexpected TTLS Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
 	}
 	peap, err = aaa_client.Handle(&protos.Eap{Payload: akaAkaPrimeNak, Ctx: eapCtx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
-	if !reflect.DeepEqual(peap.GetPayload(), permIdReq) {
-		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
+	if !reflect.DeepEqual(peap.GetPayload(), akaPrimePermIdReq) {
+		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v",
+			peap.GetPayload(), akaPrimePermIdReq)
 	}
 }
 
@@ -70,7 +70,8 @@ func TestLinkedEAPPeerNak(t *testing.T) {
 		eap.ResponseCode, 236,
 		append([]byte{eap.MethodIdentity}, []byte("6001010000000091@wlan.mnc001.mcc001.3gppnetwork.org")...))
 	permIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x17, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
-	akaPrimeNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 50}
+	akaPrimePermIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x32, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
+	ttlsNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 21}
 	akaAkaPrimeNak := []byte{0x02, 236, 0x00, 0x07, 0x03, 50, 23}
 
 	rtrSrv, rtrLis := test_utils.NewTestService(t, registry.ModuleName, registry.AAA_SERVER)
@@ -86,18 +87,19 @@ func TestLinkedEAPPeerNak(t *testing.T) {
 	if !reflect.DeepEqual([]byte(peap.GetPayload()), permIdReq) {
 		t.Fatalf("Unexpected Identity Responsen\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
 	}
-	peap, err = aaa_client.Handle(&protos.Eap{Payload: akaPrimeNak, Ctx: peap.Ctx})
+	peap, err = aaa_client.Handle(&protos.Eap{Payload: ttlsNak, Ctx: peap.Ctx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
 	if !reflect.DeepEqual([]byte(peap.GetPayload()), failureEAP) {
-		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
+		t.Fatalf("Unexpected TTLS Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
 	}
 	peap, err = aaa_client.Handle(&protos.Eap{Payload: akaAkaPrimeNak, Ctx: eapCtx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
-	if !reflect.DeepEqual([]byte(peap.GetPayload()), permIdReq) {
-		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
+	if !reflect.DeepEqual([]byte(peap.GetPayload()), akaPrimePermIdReq) {
+		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v",
+			peap.GetPayload(), akaPrimePermIdReq)
 	}
 }
@@ -22,7 +22,9 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/object_store"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/aaa/servicers"
 	"magma/feg/gateway/services/aaa/store"
@@ -37,18 +39,18 @@ const (
 	Version                        = "0.1"
 	AccountingReportingEnabledFlag = "acct_reporting_enabled"
 	AccountingReportingEnabledEnv  = "AAA_ACCT_REPORTING_ENABLED"
+	RedisSessionStoreFlag          = "redis_session_store"
+	RedisSessionStoreEnv           = "AAA_REDIS_SESSION_STORE"
 )
 
 var (
 	_ = flag.Bool(AccountingReportingEnabledFlag, false, "Enable base accounting reports")
+	_ = flag.Bool(RedisSessionStoreFlag, false, "Store AAA sessions in redis")
 )
 
 func main() {
 	flag.Parse() // for glog
 
-	// Create a shared Session Table
-	sessions := store.NewMemorySessionTable()
-
 	// Create the EAP AKA Provider service
 	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.AAA_SERVER)
 	if err != nil {
@@ -63,7 +65,34 @@ func main() {
 	aaaConfigs.AcctReportingEnabled = utils.GetBoolValueOrEnv(
 		AccountingReportingEnabledFlag, AccountingReportingEnabledEnv, aaaConfigs.AcctReportingEnabled)
 
+	// Create a shared Session Table
+	var sessions aaa.SessionTable
+	// Sessions timed out by other (i.e. crashed) instances of a redis session table are ended by the
+	// accounting service, once it's created
+	var endTimedOutSession aaa.TimeoutNotifier
+	acctCreated := make(chan struct{})
+	if utils.GetBoolValueOrEnv(RedisSessionStoreFlag, RedisSessionStoreEnv, false) {
+		redisClient, err := object_store.NewRedisClient()
+		if err != nil {
+			glog.Fatalf("Error creating redis client for AAA session table: %s", err)
+		}
+		redisClientImpl, ok := redisClient.(*object_store.RedisClientImpl)
+		if !ok {
+			glog.Fatalf("Unsupported redis client type %T for AAA session table", redisClient)
+		}
+		sessions = store.NewRedisSessionTable(redisClientImpl.RawClient, func(s aaa.Session) error {
+			<-acctCreated
+			return endTimedOutSession(s)
+		})
+	} else {
+		sessions = store.NewMemorySessionTable()
+	}
+
 	acct, _ := servicers.NewAccountingService(sessions, proto.Clone(aaaConfigs).(*mconfig.AAAConfig))
+	endTimedOutSession = func(s aaa.Session) error {
+		return acct.EndTimedOutSession(s.GetCtx())
+	}
+	close(acctCreated)
 	protos.RegisterAccountingServer(srv.GrpcServer, acct)
 	lteprotos.RegisterAbortSessionResponderServer(srv.GrpcServer, acct)
 	fegprotos.RegisterSwxGatewayServiceServer(srv.GrpcServer, acct)
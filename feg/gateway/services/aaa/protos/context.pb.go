@@ -48,6 +48,8 @@ type Context struct {
 	AuthSessionId string `protobuf:"bytes,9,opt,name=auth_session_id,json=authSessionId,proto3" json:"auth_session_id,omitempty"`
 	AcctSessionId string `protobuf:"bytes,10,opt,name=acct_session_id,json=acctSessionId,proto3" json:"acct_session_id,omitempty"`
 	CreatedTimeMs uint64 `protobuf:"varint,11,opt,name=created_time_ms,json=createdTimeMs,proto3" json:"created_time_ms,omitempty"`
+	// fast re-authentication context of an authenticated EAP-AKA/SIM session
+	Reauth *ReauthContext `protobuf:"bytes,12,opt,name=reauth,proto3" json:"reauth,omitempty"`
 }
 
 func (x *Context) Reset() {
@@ -159,6 +161,13 @@ func (x *Context) GetCreatedTimeMs() uint64 {
 	return 0
 }
 
+func (x *Context) GetReauth() *ReauthContext {
+	if x != nil {
+		return x.Reauth
+	}
+	return nil
+}
+
 type Void struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -197,11 +206,103 @@ func (*Void) Descriptor() ([]byte, []int) {
 	return file_context_proto_rawDescGZIP(), []int{1}
 }
 
+// reauth_context holds the keys & counter needed for EAP-AKA/SIM fast re-authentication,
+// see RFC 4187 section 5 & RFC 4186 section 5
+type ReauthContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the reauth identity issued to the peer in the last AT_NEXT_REAUTH_ID
+	ReauthId string `protobuf:"bytes,1,opt,name=reauth_id,json=reauthId,proto3" json:"reauth_id,omitempty"`
+	// the value of AT_COUNTER used in the last successful (re)authentication
+	Counter uint32 `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	Mk      []byte `protobuf:"bytes,3,opt,name=mk,proto3" json:"mk,omitempty"`
+	KAut    []byte `protobuf:"bytes,4,opt,name=k_aut,json=kAut,proto3" json:"k_aut,omitempty"`
+	KEncr   []byte `protobuf:"bytes,5,opt,name=k_encr,json=kEncr,proto3" json:"k_encr,omitempty"`
+	// the EAP method (AKA or SIM) which issued the reauth identity
+	EapType uint32 `protobuf:"varint,6,opt,name=eap_type,json=eapType,proto3" json:"eap_type,omitempty"`
+}
+
+func (x *ReauthContext) Reset() {
+	*x = ReauthContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_context_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReauthContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthContext) ProtoMessage() {}
+
+func (x *ReauthContext) ProtoReflect() protoreflect.Message {
+	mi := &file_context_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthContext.ProtoReflect.Descriptor instead.
+func (*ReauthContext) Descriptor() ([]byte, []int) {
+	return file_context_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReauthContext) GetReauthId() string {
+	if x != nil {
+		return x.ReauthId
+	}
+	return ""
+}
+
+func (x *ReauthContext) GetCounter() uint32 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *ReauthContext) GetMk() []byte {
+	if x != nil {
+		return x.Mk
+	}
+	return nil
+}
+
+func (x *ReauthContext) GetKAut() []byte {
+	if x != nil {
+		return x.KAut
+	}
+	return nil
+}
+
+func (x *ReauthContext) GetKEncr() []byte {
+	if x != nil {
+		return x.KEncr
+	}
+	return nil
+}
+
+func (x *ReauthContext) GetEapType() uint32 {
+	if x != nil {
+		return x.EapType
+	}
+	return 0
+}
+
 var File_context_proto protoreflect.FileDescriptor
 
 var file_context_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0a, 0x61, 0x61, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x07,
+	0x0a, 0x61, 0x61, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x07,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
 	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x02,
@@ -221,11 +322,24 @@ var file_context_proto_rawDesc = []byte{
 	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x74, 0x53, 0x65, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x06,
-	0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f,
-	0x66, 0x65, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x61, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x32,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x75, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x61, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x06, 0x72, 0x65, 0x61, 0x75,
+	0x74, 0x68, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x72,
+	0x65, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6d, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x6d, 0x6b, 0x12, 0x13, 0x0a, 0x05, 0x6b, 0x5f, 0x61, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x41, 0x75, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x5f, 0x65,
+	0x6e, 0x63, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6b, 0x45, 0x6e, 0x63, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x65, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x61, 0x61, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -240,17 +354,19 @@ func file_context_proto_rawDescGZIP() []byte {
 	return file_context_proto_rawDescData
 }
 
-var file_context_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_context_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_context_proto_goTypes = []interface{}{
-	(*Context)(nil), // 0: aaa.protos.context
-	(*Void)(nil),    // 1: aaa.protos.Void
+	(*Context)(nil),       // 0: aaa.protos.context
+	(*Void)(nil),          // 1: aaa.protos.Void
+	(*ReauthContext)(nil), // 2: aaa.protos.reauth_context
 }
 var file_context_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: aaa.protos.context.reauth:type_name -> aaa.protos.reauth_context
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_context_proto_init() }
@@ -283,6 +399,18 @@ func file_context_proto_init() {
 				return nil
 			}
 		}
+		file_context_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReauthContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -290,7 +418,7 @@ func file_context_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_context_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -79,8 +79,11 @@ func (srv *accountingService) Start(ctx context.Context, aaaCtx *protos.Context)
 	if srv.config.GetAccountingEnabled() && !srv.config.GetCreateSessionOnAuth() {
 		csResp, err = srv.CreateSession(ctx, aaaCtx)
 		if err == nil {
-			s.Lock()
-			s.GetCtx().AcctSessionId = csResp.GetSessionId()
+			if lockErr := aaa.LockSession(s); lockErr != nil {
+				err = Errorf(codes.Unavailable, "Accounting Start: failed to lock session %s: %v", sid, lockErr)
+			} else {
+				s.GetCtx().AcctSessionId = csResp.GetSessionId()
+			}
 			s.Unlock()
 		}
 	} else {
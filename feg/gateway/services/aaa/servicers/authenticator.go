@@ -21,6 +21,7 @@ import (
 
 	"github.com/emakeev/snowflake"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 
 	"magma/feg/cloud/go/protos/mconfig"
@@ -75,6 +76,7 @@ func NewEapAuthenticator(
 // EAP result
 // NOTE: Identity Request is handled by APs & does not involve EAP Authenticator's support
 func (srv *eapAuth) HandleIdentity(ctx context.Context, in *protos.EapIdentity) (*protos.Eap, error) {
+	srv.addReauthContext(in.GetCtx(), eap.Packet(in.GetPayload()))
 	resp, err := client.HandleIdentityResponse(uint8(in.GetMethod()), &protos.Eap{Payload: in.Payload, Ctx: in.Ctx})
 	if err != nil && resp != nil && len(resp.GetPayload()) > 0 {
 		errMsg := fmt.Sprintf("EAP HandleIdentity Error for Identity '%s', APN '%s': %v", resp.GetCtx().GetIdentity(), resp.GetCtx().GetApn(), err)
@@ -164,6 +166,40 @@ func (srv *eapAuth) Handle(ctx context.Context, in *protos.Eap) (*protos.Eap, er
 	return resp, nil
 }
 
+// addReauthContext finds the authenticated session the given fast re-authentication identity was issued for
+// and adds the session's re-authentication context to the EAP context, so the EAP provider can skip full
+// authentication
+func (srv *eapAuth) addReauthContext(eapCtx *protos.Context, p eap.Packet) {
+	if srv.sessions == nil || eapCtx == nil || eapCtx.GetReauth() != nil {
+		return
+	}
+	identity := string(p.TypeData())
+	if !eap.IsReauthIdentity(identity) {
+		return
+	}
+	_, imsi, err := eap.DecodeTemporaryIdentity(identity)
+	if err != nil {
+		glog.V(1).Infof("cannot decode reauth identity '%s': %v", identity, err)
+		return
+	}
+	s := srv.sessions.GetSessionByImsi(imsi)
+	if s == nil {
+		glog.V(1).Infof("no session found for reauth identity '%s'", identity)
+		return
+	}
+	s.Lock()
+	if sctx := s.GetCtx(); sctx.GetReauth().GetReauthId() == identity {
+		eapCtx.Reauth = proto.Clone(sctx.GetReauth()).(*protos.ReauthContext)
+		if len(eapCtx.GetMsisdn()) == 0 {
+			eapCtx.Msisdn = sctx.GetMsisdn()
+		}
+		if len(eapCtx.GetAuthSessionId()) == 0 {
+			eapCtx.AuthSessionId = sctx.GetAuthSessionId()
+		}
+	}
+	s.Unlock()
+}
+
 // SupportedMethods returns sorted list (ascending, by type) of registered EAP Provider Methods
 func (srv *eapAuth) SupportedMethods(ctx context.Context, in *protos.Void) (*protos.EapMethodList, error) {
 	return &protos.EapMethodList{Methods: srv.supportedMethods}, nil
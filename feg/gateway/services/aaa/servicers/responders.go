@@ -37,6 +37,9 @@ const (
 	MinIMSILen = 10
 	MaxIMSILen = 16
 	ImsiPrefix = "IMSI"
+
+	// odbAllPacketOrientedServicesBarred is bit 0 of Operator-Determined-Barring (3GPP TS 29.272 7.3.30)
+	odbAllPacketOrientedServicesBarred = 1
 )
 
 // AbortSession is a method of AbortSessionResponder service.
@@ -264,6 +267,44 @@ func (srv *accountingService) Reset(_ context.Context, req *fegprotos.ResetReque
 	return res, nil
 }
 
+// InsertSubscriberData fulfills S6a's IDR, disconnects UE from AAA if all packet oriented services are barred
+func (srv *accountingService) InsertSubscriberData(
+	_ context.Context, req *fegprotos.InsertSubscriberDataRequest) (*fegprotos.InsertSubscriberDataAnswer, error) {
+
+	res := &fegprotos.InsertSubscriberDataAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil IDR Request")
+	}
+	imsi := req.GetUserName()
+	if len(imsi) < MinIMSILen {
+		return res, Errorf(codes.InvalidArgument, "Invalid IDR IMSI: %s", imsi)
+	}
+	if req.GetSubscriberStatus() == fegprotos.InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING &&
+		req.GetOperatorDeterminedBarring()&odbAllPacketOrientedServicesBarred != 0 {
+		res.ErrorCode = srv.s6aDisconnectUser(imsi)
+		return res, nil
+	}
+	// AAA doesn't keep subscription data, nothing else to update
+	res.ErrorCode = fegprotos.ErrorCode_SUCCESS
+	return res, nil
+}
+
+// DeleteSubscriberData fulfills S6a's DSR, AAA doesn't keep subscription data so it's always acknowledged
+func (srv *accountingService) DeleteSubscriberData(
+	_ context.Context, req *fegprotos.DeleteSubscriberDataRequest) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+
+	res := &fegprotos.DeleteSubscriberDataAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil DSR Request")
+	}
+	imsi := req.GetUserName()
+	if len(imsi) < MinIMSILen {
+		return res, Errorf(codes.InvalidArgument, "Invalid DSR IMSI: %s", imsi)
+	}
+	res.ErrorCode = fegprotos.ErrorCode_SUCCESS
+	return res, nil
+}
+
 func (srv *accountingService) s6aDisconnectUser(imsi string) fegprotos.ErrorCode {
 	imsi = strings.TrimPrefix(imsi, ImsiPrefix)
 	sid := srv.sessions.FindSession(imsi)
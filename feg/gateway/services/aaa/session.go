@@ -51,6 +51,23 @@ type Session interface {
 	StopTimeout() bool
 }
 
+// LockErrorer is implemented by sessions which Lock may fail to acquire, such as sessions guarded by
+// distributed locks. LockErr returns the error of the last Lock call, a session that failed to lock
+// must still be Unlocked but its context modifications are not stored.
+type LockErrorer interface {
+	LockErr() error
+}
+
+// LockSession locks the session and returns an error if the session's lock could not be acquired.
+// The session must be Unlocked in either case.
+func LockSession(s Session) error {
+	s.Lock()
+	if le, ok := s.(LockErrorer); ok {
+		return le.LockErr()
+	}
+	return nil
+}
+
 // TimeoutNotifier is a callback function to be called on session timeout
 type TimeoutNotifier func(Session) error
 
@@ -0,0 +1,477 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	gwredis "magma/gateway/redis"
+)
+
+const (
+	// RedisKeyPrefix is the namespace of all AAA session table keys
+	RedisKeyPrefix = "aaa:"
+
+	// redisSessionExpirationGrace is added to the redis expiration of session keys, it guarantees that
+	// the timeout notifier is called before redis drops the session
+	redisSessionExpirationGrace = time.Minute
+	// redisTimeoutTakeoverDelay is how long a timeout may be overdue before any instance claims it, it
+	// leaves time for the instance which set the timeout to claim it with its own notifier. Timeouts of
+	// dead instances are claimed by the live ones, which end the sessions with their default notifier.
+	redisTimeoutTakeoverDelay = time.Second * 10
+	redisTimeoutPollInterval  = time.Millisecond * 50
+	redisSessionLockTTL       = time.Second * 10
+	redisSessionLockWait      = time.Second * 3
+)
+
+// timeoutScript atomically claims a due session timeout & removes the session, it returns the removed
+// session's serialized context or nil if the timeout was not due, already claimed or the session is gone
+var timeoutScript = redis.NewScript(`
+local score = redis.call("zscore", KEYS[1], ARGV[1])
+if not score or tonumber(score) > tonumber(ARGV[2]) then
+	return false
+end
+redis.call("zrem", KEYS[1], ARGV[1])
+local data = redis.call("get", KEYS[2])
+if not data then
+	return false
+end
+redis.call("del", KEYS[2])
+return data`)
+
+// persistScript updates an existing session's context preserving the key's expiration
+var persistScript = redis.NewScript(`
+local ttl = redis.call("pttl", KEYS[1])
+if ttl == -2 then
+	return 0
+end
+redis.call("set", KEYS[1], ARGV[1])
+if ttl > 0 then
+	redis.call("pexpire", KEYS[1], ttl)
+end
+return 1`)
+
+// delIfEqualScript removes the key only if its value matches the given one
+var delIfEqualScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
+// redisSession - Session backed by a redis session table entry, the session's context is a local copy
+// which is reloaded from redis on Lock and written back on Unlock. If the distributed lock cannot be
+// acquired, the local copy is not written back.
+type redisSession struct {
+	*protos.Context
+	owner   *redisSessionTable
+	sid     string
+	lock    *gwredis.Lock
+	lockErr error
+	mu      sync.Mutex
+}
+
+// Lock - locks the Session's mutex
+func (s *redisSession) Lock() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.lockErr = s.lock.Lock(redisSessionLockWait); s.lockErr != nil {
+		glog.Errorf("failed to lock session %s: %v", s.sid, s.lockErr)
+		return
+	}
+	pc, err := s.owner.getCtx(s.sid)
+	if err != nil {
+		glog.Errorf("failed to reload session %s: %v", s.sid, err)
+	} else if pc != nil {
+		s.Context = pc
+	}
+}
+
+// Unlock - unlocks the Session's mutex
+func (s *redisSession) Unlock() {
+	if s == nil {
+		return
+	}
+	if s.lockErr != nil {
+		s.lockErr = nil
+		s.mu.Unlock()
+		return
+	}
+	if s.Context != nil {
+		if data, err := proto.Marshal(s.Context); err != nil {
+			glog.Errorf("failed to serialize session %s: %v", s.sid, err)
+		} else if err = persistScript.Run(s.owner.client, []string{sessionKey(s.sid)}, data).Err(); err != nil {
+			glog.Errorf("failed to store session %s: %v", s.sid, err)
+		}
+	}
+	if err := s.lock.Unlock(); err != nil {
+		glog.Warningf("failed to unlock session %s: %v", s.sid, err)
+	}
+	s.mu.Unlock()
+}
+
+// LockErr returns the error of the last Lock call, it must be called on a Locked session
+func (s *redisSession) LockErr() error {
+	if s != nil {
+		return s.lockErr
+	}
+	return nil
+}
+
+// GetCtx returns AAA Session Context
+func (s *redisSession) GetCtx() *protos.Context {
+	if s != nil {
+		return s.Context
+	}
+	return nil
+}
+
+// SetCtx sets AAA Session Context - must be called on a Locked session
+func (s *redisSession) SetCtx(pc *protos.Context) {
+	if s != nil {
+		s.Context = pc
+	}
+}
+
+// StopTimeout - stops the session's timeout if possible, returns if the timeout was successfully stopped
+func (s *redisSession) StopTimeout() bool {
+	if s != nil {
+		return s.owner.stopTimeout(s.sid)
+	}
+	return false
+}
+
+// redisSessionTable - SessionTable stored in redis. It can be shared by multiple AAA server instances:
+// sessions are locked with distributed locks and session timeouts are tracked in a sorted set polled by all
+// instances. Notifiers are local to the instance which set the timeout, so an instance claims the expired
+// sessions it holds a notifier for and calls the notifier of the claimed session. Timeouts overdue by more
+// than the takeover delay are claimed by any instance, the sessions are ended with its default notifier.
+type redisSessionTable struct {
+	client          *redis.Client
+	defaultNotifier aaa.TimeoutNotifier // Notifier of the sessions with timeouts set by other instances
+
+	notifiersMu sync.Mutex
+	notifiers   map[string]aaa.TimeoutNotifier // Timeout notifiers by SID, registered by this instance
+}
+
+// NewRedisSessionTable - returns a new session table stored in redis using the given client, the notifier
+// is called for the overdue sessions with timeouts set by other (i.e. crashed) instances
+func NewRedisSessionTable(client *redis.Client, notifier aaa.TimeoutNotifier) aaa.SessionTable {
+	st := &redisSessionTable{
+		client:          client,
+		defaultNotifier: notifier,
+		notifiers:       map[string]aaa.TimeoutNotifier{},
+	}
+	go st.pollTimeouts()
+	return st
+}
+
+// AddSession - adds a new session to the table & returns the newly created session pointer.
+// If a session with the same ID already is in the table - returns "Session with SID: XYZ already exist" as well as the
+// existing session.
+func (st *redisSessionTable) AddSession(
+	pc *protos.Context, tout time.Duration, notifier aaa.TimeoutNotifier, overwrite ...bool) (aaa.Session, error) {
+
+	if st == nil {
+		return nil, fmt.Errorf("Nil SessionTable")
+	}
+	if pc == nil {
+		return nil, fmt.Errorf("Nil Session Context")
+	}
+	sid := strings.TrimSpace(pc.SessionId)
+	if len(sid) == 0 {
+		return nil, fmt.Errorf("Empty Session Id")
+	}
+	if tout < aaa.MinimalSessionTimeout {
+		tout = aaa.MinimalSessionTimeout
+	}
+	data, err := proto.Marshal(pc)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to serialize session %s: %v", sid, err)
+	}
+	imsi := pc.GetImsi()
+	msisdn := pc.GetMsisdn()
+	expiration := tout + redisSessionExpirationGrace
+
+	// Handle the case of old session with the same radius session ID
+	isExistingSession := false
+	if len(overwrite) > 0 && overwrite[0] {
+		var getSet *redis.StringCmd
+		_, err = st.client.TxPipelined(func(pipe redis.Pipeliner) error {
+			getSet = pipe.GetSet(sessionKey(sid), data)
+			pipe.PExpire(sessionKey(sid), expiration)
+			return nil
+		})
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("Failed to store session %s: %v", sid, err)
+		}
+		if oldData, err := getSet.Bytes(); err == nil {
+			oldCtx := &protos.Context{}
+			if err = proto.Unmarshal(oldData, oldCtx); err == nil {
+				isExistingSession = true
+				oldImsi := oldCtx.GetImsi()
+				glog.Warningf("Session with SID: %s already exist, will overwrite. Old IMSI: %s, New IMSI: %s",
+					sid, oldImsi, imsi)
+				if oldImsi != imsi {
+					isExistingSession = false
+					delIfEqualScript.Run(st.client, []string{imsiKey(oldImsi)}, sid)
+					updateSessionMetricsForRemovedSession(oldCtx.GetApn(), oldImsi, sid, msisdn)
+				}
+			}
+		}
+	} else {
+		added, err := st.client.SetNX(sessionKey(sid), data, expiration).Result()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to store session %s: %v", sid, err)
+		}
+		if !added {
+			return st.GetSession(sid), fmt.Errorf("Session with SID: %s already exist", sid)
+		}
+	}
+	// Handle the case of old session with the same IMSI and different radius session ID (roaming?)
+	oldSessionId, err := st.client.GetSet(imsiKey(imsi), sid).Result()
+	if err != nil && err != redis.Nil {
+		glog.Errorf("failed to update IMSI %s index for session %s: %v", imsi, sid, err)
+	}
+	st.client.PExpire(imsiKey(imsi), expiration)
+	if len(oldSessionId) > 0 && oldSessionId != sid {
+		if oldCtx, _ := st.getCtx(oldSessionId); oldCtx != nil {
+			st.stopTimeout(oldSessionId)
+			updateSessionMetricsForRemovedSession(oldCtx.GetApn(), imsi, oldSessionId, msisdn)
+			glog.Infof("old session with SID: %s found for IMSI: %s, will remove", oldSessionId, imsi)
+		}
+	}
+	glog.V(1).Infof("setting timeout of %f seconds for session: %s", tout.Seconds(), sid)
+	st.setTimeout(sid, tout, notifier)
+	if !isExistingSession {
+		updateSessionMetricsForNewSession(pc.GetApn(), imsi, sid, msisdn)
+	}
+	return st.newSession(sid, pc), nil
+}
+
+// GetSession returns session corresponding to the given sid or nil if not found
+func (st *redisSessionTable) GetSession(sid string) aaa.Session {
+	if st != nil && len(sid) > 0 {
+		pc, err := st.getCtx(sid)
+		if err != nil {
+			glog.Errorf("failed to get session %s: %v", sid, err)
+		}
+		if pc != nil {
+			return st.newSession(sid, pc)
+		}
+	}
+	return nil
+}
+
+// FindSession returns session ID corresponding to the given IMSI (empty string if not found)
+func (st *redisSessionTable) FindSession(imsi string) (sid string) {
+	if st != nil {
+		var err error
+		sid, err = st.client.Get(imsiKey(imsi)).Result()
+		if err != nil && err != redis.Nil {
+			glog.Errorf("failed to find session for IMSI %s: %v", imsi, err)
+		}
+	}
+	return sid
+}
+
+// GetSessionByImsi returns session corresponding to the given IMSI or nil if not found
+func (st *redisSessionTable) GetSessionByImsi(imsi string) aaa.Session {
+	return st.GetSession(st.FindSession(imsi))
+}
+
+// RemoveSession - removes the session with the given SID and returns it, returns nil if not found
+func (st *redisSessionTable) RemoveSession(sid string) aaa.Session {
+	if st == nil || len(sid) == 0 {
+		return nil
+	}
+	var getCmd *redis.StringCmd
+	_, err := st.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(sessionKey(sid))
+		pipe.Del(sessionKey(sid))
+		pipe.ZRem(timeoutsKey(), sid)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		glog.Errorf("failed to remove session %s: %v", sid, err)
+		return nil
+	}
+	st.removeNotifier(sid)
+	data, err := getCmd.Bytes()
+	if err != nil {
+		return nil
+	}
+	pc := &protos.Context{}
+	if err = proto.Unmarshal(data, pc); err != nil {
+		glog.Errorf("failed to deserialize removed session %s: %v", sid, err)
+		return nil
+	}
+	delIfEqualScript.Run(st.client, []string{imsiKey(pc.GetImsi())}, sid)
+	updateSessionMetricsForRemovedSession(pc.GetApn(), pc.GetImsi(), sid, pc.GetMsisdn())
+	return st.newSession(sid, pc)
+}
+
+// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
+func (st *redisSessionTable) SetTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	if tout <= 0 || st == nil || len(sid) == 0 {
+		return false
+	}
+	pc, err := st.getCtx(sid)
+	if err != nil || pc == nil {
+		return false
+	}
+	expiration := tout + redisSessionExpirationGrace
+	st.client.PExpire(sessionKey(sid), expiration)
+	st.client.PExpire(imsiKey(pc.GetImsi()), expiration)
+	return st.setTimeout(sid, tout, notifier)
+}
+
+func (st *redisSessionTable) newSession(sid string, pc *protos.Context) *redisSession {
+	return &redisSession{
+		Context: pc,
+		owner:   st,
+		sid:     sid,
+		lock:    gwredis.NewLock(st.client, lockKey(sid), redisSessionLockTTL),
+	}
+}
+
+// getCtx returns the stored session context or nil if the session is not found
+func (st *redisSessionTable) getCtx(sid string) (*protos.Context, error) {
+	data, err := st.client.Get(sessionKey(sid)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pc := &protos.Context{}
+	return pc, proto.Unmarshal(data, pc)
+}
+
+func (st *redisSessionTable) setTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	deadline := time.Now().Add(tout).UnixNano() / int64(time.Millisecond)
+	err := st.client.ZAdd(timeoutsKey(), redis.Z{Score: float64(deadline), Member: sid}).Err()
+	if err != nil {
+		glog.Errorf("failed to set timeout for session %s: %v", sid, err)
+		return false
+	}
+	st.notifiersMu.Lock()
+	st.notifiers[sid] = notifier
+	st.notifiersMu.Unlock()
+	return true
+}
+
+func (st *redisSessionTable) stopTimeout(sid string) bool {
+	st.removeNotifier(sid)
+	removed, err := st.client.ZRem(timeoutsKey(), sid).Result()
+	return err == nil && removed > 0
+}
+
+// removeNotifier removes & returns the session's notifier and if this instance set the session's timeout
+func (st *redisSessionTable) removeNotifier(sid string) (aaa.TimeoutNotifier, bool) {
+	st.notifiersMu.Lock()
+	defer st.notifiersMu.Unlock()
+	notifier, found := st.notifiers[sid]
+	delete(st.notifiers, sid)
+	return notifier, found
+}
+
+// hasNotifier returns if this instance set the session's timeout
+func (st *redisSessionTable) hasNotifier(sid string) bool {
+	st.notifiersMu.Lock()
+	defer st.notifiersMu.Unlock()
+	_, found := st.notifiers[sid]
+	return found
+}
+
+func (st *redisSessionTable) pollTimeouts() {
+	for {
+		time.Sleep(redisTimeoutPollInterval)
+		now := time.Now().UnixNano() / int64(time.Millisecond)
+		timeouts, err := st.client.ZRangeByScoreWithScores(
+			timeoutsKey(), redis.ZRangeBy{Min: "-inf", Max: fmt.Sprint(now)}).Result()
+		if err != nil {
+			glog.Errorf("failed to get expired sessions: %v", err)
+			continue
+		}
+		takeover := float64(now - int64(redisTimeoutTakeoverDelay/time.Millisecond))
+		for _, timeout := range timeouts {
+			sid, ok := timeout.Member.(string)
+			if !ok {
+				continue
+			}
+			// sessions with timeouts set by other instances are left to them until the takeover delay,
+			// the instance may be gone
+			if st.hasNotifier(sid) || timeout.Score <= takeover {
+				st.timeoutSession(sid, now)
+			}
+		}
+	}
+}
+
+func (st *redisSessionTable) timeoutSession(sid string, now int64) {
+	data, err := timeoutScript.Run(st.client, []string{timeoutsKey(), sessionKey(sid)}, sid, now).String()
+	if err != nil {
+		if err != redis.Nil {
+			glog.Errorf("failed to time out session %s: %v", sid, err)
+		}
+		return
+	}
+	notifier, found := st.removeNotifier(sid)
+	if !found {
+		notifier = st.defaultNotifier
+	}
+	pc := &protos.Context{}
+	if err = proto.Unmarshal([]byte(data), pc); err != nil {
+		glog.Errorf("failed to deserialize timed out session %s: %v", sid, err)
+		return
+	}
+	delIfEqualScript.Run(st.client, []string{imsiKey(pc.GetImsi())}, sid)
+
+	var notifyResult error
+	if notifier != nil {
+		notifyResult = notifier(st.newSession(sid, pc))
+	}
+	glog.Infof(
+		"Timed out session '%s' for SessionId: %s; IMSI: %s; Identity: %s; MAC: %s; IP: %s; notify result: %v",
+		sid, pc.GetSessionId(), pc.GetImsi(), pc.GetIdentity(), pc.GetMacAddr(), pc.GetIpAddr(), notifyResult)
+
+	updateSessionMetricsForTimedOutSession(pc.GetApn(), pc.GetImsi(), pc.GetSessionId(), pc.GetMsisdn())
+}
+
+func sessionKey(sid string) string {
+	return RedisKeyPrefix + "session:" + sid
+}
+
+func imsiKey(imsi string) string {
+	return RedisKeyPrefix + "imsi:" + imsi
+}
+
+func lockKey(sid string) string {
+	return RedisKeyPrefix + "lock:" + sid
+}
+
+func timeoutsKey() string {
+	return RedisKeyPrefix + "timeouts"
+}
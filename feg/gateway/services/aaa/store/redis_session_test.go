@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/store"
+)
+
+func TestRedisSessionTable(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+
+	// two AAA server instances sharing the same redis
+	st1 := store.NewRedisSessionTable(redis.NewClient(&redis.Options{Addr: mockRedis.Addr()}), nil)
+	st2 := store.NewRedisSessionTable(redis.NewClient(&redis.Options{Addr: mockRedis.Addr()}), nil)
+
+	sid := aaa.CreateSessionId()
+	imsi := strconv.FormatUint(rand.Uint64(), 10)[:15]
+	s, err := st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+
+	_, err = st2.AddSession(&protos.Context{SessionId: sid, Imsi: "001010000000001"}, time.Minute, nil)
+	assert.Error(t, err)
+	assert.Equal(t, sid, st2.FindSession(imsi))
+	s2 := st2.GetSessionByImsi(imsi)
+	assert.NotNil(t, s2)
+	assert.Equal(t, imsi, s2.GetCtx().GetImsi())
+
+	// context updates are visible to the other instance
+	s.Lock()
+	s.GetCtx().AcctSessionId = "acct1"
+	s.Unlock()
+	s2.Lock()
+	assert.Equal(t, "acct1", s2.GetCtx().GetAcctSessionId())
+	s2.GetCtx().Apn = "apn1"
+	s2.Unlock()
+	assert.Equal(t, "apn1", st1.GetSession(sid).GetCtx().GetApn())
+
+	// distributed session lock
+	var counter int64
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(st aaa.SessionTable) {
+			defer wg.Done()
+			ls := st.GetSession(sid)
+			ls.Lock()
+			v := atomic.LoadInt64(&counter)
+			time.Sleep(time.Millisecond)
+			atomic.StoreInt64(&counter, v+1)
+			ls.Unlock()
+		}([]aaa.SessionTable{st1, st2}[i%2])
+	}
+	wg.Wait()
+	assert.Equal(t, int64(10), atomic.LoadInt64(&counter))
+
+	// overwrite with a different IMSI
+	newImsi := strconv.FormatUint(rand.Uint64(), 10)[:15]
+	_, err = st2.AddSession(&protos.Context{SessionId: sid, Imsi: newImsi}, time.Minute, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "", st1.FindSession(imsi))
+	assert.Equal(t, sid, st1.FindSession(newImsi))
+
+	// remove
+	removed := st1.RemoveSession(sid)
+	assert.NotNil(t, removed)
+	assert.Equal(t, newImsi, removed.GetCtx().GetImsi())
+	assert.Nil(t, st2.GetSession(sid))
+	assert.Equal(t, "", st2.FindSession(newImsi))
+	assert.Nil(t, st2.RemoveSession(sid))
+	assert.False(t, st2.SetTimeout(sid, time.Millisecond*10, nil))
+
+	// timeout notifier is called once, by one of the instances
+	var done1, done2 callbackDone
+	_, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, (&done1).timeoutCallback)
+	assert.NoError(t, err)
+	assert.True(t, st2.SetTimeout(sid, time.Millisecond*20, (&done2).timeoutCallback))
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, int32(1), atomic.LoadInt32((*int32)(&done1))+atomic.LoadInt32((*int32)(&done2)))
+	assert.Nil(t, st1.GetSession(sid))
+	assert.Equal(t, "", st1.FindSession(imsi))
+
+	// stopped timeout
+	atomic.StoreInt32((*int32)(&done1), 0)
+	s, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Millisecond*20, (&done1).timeoutCallback)
+	assert.NoError(t, err)
+	assert.True(t, s.StopTimeout())
+	assert.False(t, s.StopTimeout())
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, int32(0), atomic.LoadInt32((*int32)(&done1)))
+	assert.NotNil(t, st2.GetSession(sid))
+
+	// only the instance which set the timeout claims the session
+	assert.NotNil(t, st2.RemoveSession(sid))
+	atomic.StoreInt32((*int32)(&done2), 0)
+	sid2 := aaa.CreateSessionId()
+	_, err = st2.AddSession(&protos.Context{SessionId: sid2, Imsi: newImsi}, time.Minute, (&done2).timeoutCallback)
+	assert.NoError(t, err)
+	_, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Millisecond*20, (&done1).timeoutCallback)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, int32(1), atomic.LoadInt32((*int32)(&done1)))
+	assert.Equal(t, int32(0), atomic.LoadInt32((*int32)(&done2)))
+	assert.Nil(t, st2.GetSession(sid))
+}
+
+func TestRedisSessionTable_Takeover(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+
+	// the instance which created the session is gone
+	var done1, done2 callbackDone
+	client1 := redis.NewClient(&redis.Options{Addr: mockRedis.Addr()})
+	st1 := store.NewRedisSessionTable(client1, nil)
+	sid := aaa.CreateSessionId()
+	imsi := "001010000000001"
+	_, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, (&done1).timeoutCallback)
+	assert.NoError(t, err)
+	assert.NoError(t, client1.Close())
+
+	st2 := store.NewRedisSessionTable(
+		redis.NewClient(&redis.Options{Addr: mockRedis.Addr()}), (&done2).timeoutCallback)
+	timeoutsKey := store.RedisKeyPrefix + "timeouts"
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+
+	// a recently expired timeout is left to the instance which set it
+	_, err = mockRedis.ZAdd(timeoutsKey, float64(now-time.Second.Milliseconds()), sid)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, int32(0), atomic.LoadInt32((*int32)(&done2)))
+	assert.NotNil(t, st2.GetSession(sid))
+
+	// an overdue timeout is claimed by the live instance, which ends the session with its default notifier
+	_, err = mockRedis.ZAdd(timeoutsKey, float64(now-(time.Second*30).Milliseconds()), sid)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, int32(1), atomic.LoadInt32((*int32)(&done2)))
+	assert.Equal(t, int32(0), atomic.LoadInt32((*int32)(&done1)))
+	assert.Nil(t, st2.GetSession(sid))
+	assert.Equal(t, "", st2.FindSession(imsi))
+}
+
+func TestRedisSessionTable_LockFailure(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+
+	st := store.NewRedisSessionTable(redis.NewClient(&redis.Options{Addr: mockRedis.Addr()}), nil)
+	sid := aaa.CreateSessionId()
+	s, err := st.AddSession(&protos.Context{SessionId: sid, Imsi: "001010000000001"}, time.Minute, nil)
+	assert.NoError(t, err)
+
+	// the session is locked by another instance
+	assert.NoError(t, mockRedis.Set(store.RedisKeyPrefix+"lock:"+sid, "other"))
+	assert.Error(t, aaa.LockSession(s))
+	s.GetCtx().AcctSessionId = "acct1"
+	s.Unlock()
+	assert.Equal(t, "", st.GetSession(sid).GetCtx().GetAcctSessionId())
+	assert.True(t, mockRedis.Exists(store.RedisKeyPrefix+"lock:"+sid))
+
+	mockRedis.Del(store.RedisKeyPrefix + "lock:" + sid)
+	assert.NoError(t, aaa.LockSession(s))
+	s.GetCtx().AcctSessionId = "acct1"
+	s.Unlock()
+	assert.Equal(t, "acct1", st.GetSession(sid).GetCtx().GetAcctSessionId())
+}
@@ -129,3 +129,33 @@ func TestAttributeScanner(t *testing.T) {
 		t.Fatalf("EAP Mismatch 2\nexpected: %v\n     got: %v", []byte(testEAP), p)
 	}
 }
+
+func TestNewAttributeLength(t *testing.T) {
+	// The attribute length byte is in multiples of 4 bytes, RFC 4187 8.1
+	for dataLen, expected := range map[int]uint8{0: 1, 1: 1, 2: 1, 3: 2, 6: 2, 18: 5, 136: 35} {
+		a := NewAttribute(1, make([]byte, dataLen))
+		if a.AttrLen() != expected {
+			t.Fatalf("Invalid Attr length byte for %d data bytes: expected %d got %d", dataLen, expected, a.AttrLen())
+		}
+		if a.Len() != int(a.AttrLen())*4 {
+			t.Fatalf("Attr length byte %d doesn't match serialized length %d", a.AttrLen(), a.Len())
+		}
+	}
+	// Attributes built by NewAttribute must scan back from an EAP packet
+	p := NewPacket(1, 2, []byte{23, 1, 0, 0})
+	p, err := p.Append(NewAttribute(1, make([]byte, 18)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner, err := NewAttributeScanner(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attr, err := scanner.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr.Type() != 1 || attr.Len() != 20 {
+		t.Fatalf("EAP Attr Mismatch: %s", attr)
+	}
+}
@@ -95,12 +95,13 @@ type AkaState int16
 
 const (
 	// Processing/handling States
-	StateNone          AkaState = iota
-	StateCreated                // newly created
-	StateIdentity               // Valid permanent identity received
-	StateChallenge              // Auth Challenge was returned to UE
-	StateAuthenticated          // UE is successfully authenticated
-	StateRedirected             // UE is redirected to another Auth method (SIM, AKA', etc.)
+	StateNone             AkaState = iota
+	StateCreated                   // newly created
+	StateIdentity                  // Valid permanent identity received
+	StateChallenge                 // Auth Challenge was returned to UE
+	StateAuthenticated             // UE is successfully authenticated
+	StateRedirected                // UE is redirected to another Auth method (SIM, AKA', etc.)
+	StateReauthentication          // Fast Re-authentication Request was returned to UE
 )
 
 const (
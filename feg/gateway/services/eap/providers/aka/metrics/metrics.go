@@ -69,6 +69,14 @@ var (
 		Name: "failed_resync_requests_total",
 		Help: "Total number of failed calls to AKA Resync Handler",
 	})
+	ReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "reauth_requests_total",
+		Help: "Total number of AKA Fast Re-authentications started",
+	})
+	FailedReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "failed_reauth_requests_total",
+		Help: "Total number of failed AKA Fast Re-authentications",
+	})
 	S6aRequests = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "s6a_requests_total",
 		Help: "Total number of s6a Proxy RPC Requiests sent",
@@ -131,5 +139,6 @@ func init() {
 	prometheus.MustRegister(Requests, FailedRequests, FailureNotifications,
 		SwxFailures, SessionTimeouts, IdentityRequests, FailedIdentityRequests,
 		ChallengeRequests, FailedChallengeRequests, ResyncRequests, FailedResyncRequests,
+		ReauthRequests, FailedReauthRequests,
 		PeerAuthReject, PeerClientError, PeerNotification, PeerFailures, SWxLatency, AuthLatency)
 }
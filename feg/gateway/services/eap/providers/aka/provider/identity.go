@@ -16,7 +16,7 @@ package provider
 
 import "regexp"
 
-var akaRe = regexp.MustCompile(`^0\d{6,15}@\w(?:\w|\.|-)*\w$`)
+var akaRe = regexp.MustCompile(`^(?:0\d{6,15}|[24][\w-]{16,})@\w(?:\w|\.|-)*\w$`)
 
 // WillHandleIdentity returns true if the provider 1) recognizes the given Identity and 2) can hendle authentication
 // for this type of identity.
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package aka implements EAP-AKA EAP Method
+package aka
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+
+	"magma/feg/gateway/services/eap"
+)
+
+const (
+	IV_LEN      = aes.BlockSize
+	NONCE_S_LEN = 16
+)
+
+// NewIdentityAttr returns a new identity attribute (AT_IDENTITY, AT_NEXT_PSEUDONYM or AT_NEXT_REAUTH_ID)
+// with the given identity, see https://tools.ietf.org/html/rfc4187#section-10.11
+func NewIdentityAttr(typ eap.AttrType, identity string) eap.Attribute {
+	l := len(identity)
+	return eap.NewAttribute(typ, append([]byte{byte(l >> 8), byte(l)}, identity...))
+}
+
+// ParseIdentityAttr returns the identity carried by AT_IDENTITY, AT_NEXT_PSEUDONYM or AT_NEXT_REAUTH_ID attribute
+func ParseIdentityAttr(a eap.Attribute) (string, error) {
+	val := a.Value()
+	if len(val) < 2 {
+		return "", fmt.Errorf("identity attribute %d is too short: %d", a.Type(), a.Len())
+	}
+	actualLen := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen > len(val) {
+		return "", fmt.Errorf(
+			"corrupt identity attribute %d: actual len %d > data len %d", a.Type(), actualLen-2, len(val))
+	}
+	return string(val[2:actualLen]), nil
+}
+
+// NewTemporaryIdentityAttrs returns AT_NEXT_PSEUDONYM & AT_NEXT_REAUTH_ID attributes carrying newly generated
+// temporary identities for the given IMSI as well as the new fast re-authentication identity
+func NewTemporaryIdentityAttrs(pseudonymPrefix, reauthIdPrefix byte, imsi, realm string) ([]eap.Attribute, string, error) {
+	pseudonym, err := eap.NewTemporaryIdentity(pseudonymPrefix, imsi, realm)
+	if err != nil {
+		return nil, "", err
+	}
+	reauthId, err := eap.NewTemporaryIdentity(reauthIdPrefix, imsi, realm)
+	if err != nil {
+		return nil, "", err
+	}
+	return []eap.Attribute{
+		NewIdentityAttr(AT_NEXT_PSEUDONYM, pseudonym),
+		NewIdentityAttr(AT_NEXT_REAUTH_ID, reauthId),
+	}, reauthId, nil
+}
+
+// AppendEncrData encrypts given attributes with K_encr & appends the resulting AT_IV & AT_ENCR_DATA attributes
+// to the EAP packet, see https://tools.ietf.org/html/rfc4187#section-10.12
+func AppendEncrData(p eap.Packet, K_encr []byte, attrs ...eap.Attribute) (eap.Packet, error) {
+	var plain []byte
+	for _, a := range attrs {
+		plain = append(plain, a.Marshaled()...)
+	}
+	if pad := (aes.BlockSize - len(plain)%aes.BlockSize) % aes.BlockSize; pad > 0 {
+		plain = append(plain, eap.NewAttribute(AT_PADDING, make([]byte, pad-2)).Marshaled()...)
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return p, err
+	}
+	iv := make([]byte, IV_LEN)
+	if _, err = io.ReadFull(rand.Reader, iv); err != nil {
+		return p, err
+	}
+	encr := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encr, plain)
+
+	p, err = p.Append(eap.NewAttribute(AT_IV, append([]byte{0, 0}, iv...)))
+	if err != nil {
+		return p, err
+	}
+	return p.Append(eap.NewAttribute(AT_ENCR_DATA, append([]byte{0, 0}, encr...)))
+}
+
+// DecryptEncrData decrypts AT_ENCR_DATA attribute value using AT_IV value & K_encr
+// and returns the decrypted attributes
+func DecryptEncrData(K_encr []byte, atIv, atEncrData eap.Attribute) ([]eap.Attribute, error) {
+	if atIv == nil || atEncrData == nil {
+		return nil, fmt.Errorf("missing AT_IV or AT_ENCR_DATA")
+	}
+	iv, encr := atIv.Value(), atEncrData.Value()
+	if len(iv) != IV_LEN+2 {
+		return nil, fmt.Errorf("invalid AT_IV length: %d", len(iv))
+	}
+	if len(encr) < aes.BlockSize+2 || (len(encr)-2)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid AT_ENCR_DATA length: %d", len(encr))
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(encr)-2)
+	cipher.NewCBCDecrypter(block, iv[2:]).CryptBlocks(plain, encr[2:])
+
+	var res []eap.Attribute
+	for len(plain) > 0 {
+		if len(plain) < 2 || plain[1] == 0 || int(plain[1])<<2 > len(plain) {
+			return nil, fmt.Errorf("corrupt encrypted attribute data")
+		}
+		l := int(plain[1]) << 2
+		if eap.AttrType(plain[0]) != AT_PADDING {
+			res = append(res, eap.NewRawAttribute(plain[:l]))
+		}
+		plain = plain[l:]
+	}
+	return res, nil
+}
+
+// MakeReauthKeys returns MSK & EMSK for fast re-authentication,
+// XKEY' = SHA1(Identity|counter|NONCE_S| MK), see https://tools.ietf.org/html/rfc4187#section-7
+func MakeReauthKeys(identity []byte, counter uint16, nonceS, MK []byte) (MSK, EMSK []byte) {
+	d := sha1.New()
+	d.Write(identity)
+	d.Write([]byte{byte(counter >> 8), byte(counter)})
+	d.Write(nonceS)
+	d.Write(MK)
+	x := XSum(d.Sum(nil))
+	return x[:64], x[64:128]
+}
+
+// NewReauthReq returns a new, signed EAP-Request/Reauthentication packet for AKA or SIM (method) along with
+// the NONCE_S it carries, see https://tools.ietf.org/html/rfc4187#section-9.7
+func NewReauthReq(
+	method, identifier uint8, counter uint16, nextReauthId string, K_encr, K_aut []byte) (eap.Packet, []byte, error) {
+
+	nonceS := make([]byte, NONCE_S_LEN)
+	if _, err := io.ReadFull(rand.Reader, nonceS); err != nil {
+		return nil, nil, err
+	}
+	attrs := []eap.Attribute{
+		eap.NewAttribute(AT_COUNTER, []byte{byte(counter >> 8), byte(counter)}),
+		eap.NewAttribute(AT_NONCE_S, append([]byte{0, 0}, nonceS...)),
+	}
+	if len(nextReauthId) > 0 {
+		attrs = append(attrs, NewIdentityAttr(AT_NEXT_REAUTH_ID, nextReauthId))
+	}
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{method, byte(SubtypeReauthentication), 0, 0})
+	p, err := AppendEncrData(p, K_encr, attrs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err = AppendMac(p, K_aut)
+	return p, nonceS, err
+}
+
+// ParseReauthResp verifies EAP-Response/Reauthentication MAC, decrypts its AT_ENCR_DATA & returns the
+// AT_COUNTER value and true if the peer included AT_COUNTER_TOO_SMALL,
+// see https://tools.ietf.org/html/rfc4187#section-9.8
+func ParseReauthResp(req eap.Packet, nonceS, K_encr, K_aut []byte) (counter uint16, tooSmall bool, err error) {
+	p := make([]byte, len(req))
+	copy(p, req)
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		return 0, false, err
+	}
+	var a, atMac, atIv, atEncrData eap.Attribute
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case AT_MAC:
+			atMac = a
+		case AT_IV:
+			atIv = a
+		case AT_ENCR_DATA:
+			atEncrData = a
+		}
+	}
+	if err != io.EOF {
+		return 0, false, err
+	}
+	if atMac == nil || atMac.Len() < ATT_HDR_LEN+MAC_LEN {
+		return 0, false, fmt.Errorf("missing or malformed AT_MAC")
+	}
+	macBytes := atMac.Marshaled()
+	ueMac := make([]byte, len(macBytes)-ATT_HDR_LEN)
+	copy(ueMac, macBytes[ATT_HDR_LEN:])
+	for i := ATT_HDR_LEN; i < len(macBytes); i++ {
+		macBytes[i] = 0
+	}
+	if mac := GenMac(append(p, nonceS...), K_aut); !reflect.DeepEqual(ueMac, mac) {
+		return 0, false, fmt.Errorf("invalid AT_MAC")
+	}
+	attrs, err := DecryptEncrData(K_encr, atIv, atEncrData)
+	if err != nil {
+		return 0, false, err
+	}
+	var hasCounter bool
+	for _, a = range attrs {
+		switch a.Type() {
+		case AT_COUNTER:
+			if len(a.Value()) < 2 {
+				return 0, false, fmt.Errorf("malformed AT_COUNTER")
+			}
+			counter, hasCounter = binary.BigEndian.Uint16(a.Value()), true
+		case AT_COUNTER_TOO_SMALL:
+			tooSmall = true
+		}
+	}
+	if !hasCounter {
+		return 0, false, fmt.Errorf("missing AT_COUNTER")
+	}
+	return counter, tooSmall, nil
+}
@@ -158,6 +158,7 @@ attrLoop:
 	ctx.AuthSessionId = uc.AuthSessionId
 	ctx.Msk = uc.MSK
 	ctx.Identity = uc.Identity
+	ctx.Reauth = newReauthContext(uc)
 	uc.SetState(aka.StateAuthenticated)
 
 	// Keep session & User Ctx around for some time after authentication and then clean them up
@@ -15,6 +15,7 @@ limitations under the License.
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -61,6 +62,13 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 		// Find first valid AT_IDENTITY attribute to get UE IMSI
 		if a.Type() == aka.AT_IDENTITY {
 			identity, imsi, err := getIMSIIdentity(a)
+			if err == errUnknownPseudonym {
+				// the pseudonym cannot be mapped to a permanent identity, request the permanent identity
+				glog.Warningf("AKA AT_IDENTITY '%s' is an unknown pseudonym", identity)
+				s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+				success = true
+				return aka.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), nil
+			}
 			if err == nil {
 				if imsi[0] != '0' {
 					glog.Warningf("AKA AT_IDENTITY '%s' (IMSI: %s) is non-permanent type", identity, imsi)
@@ -110,6 +118,8 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
 }
 
+var errUnknownPseudonym = errors.New("unknown pseudonym")
+
 // see https://tools.ietf.org/html/rfc4187#section-4.1.1.4
 func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
 	if a.Type() != aka.AT_IDENTITY {
@@ -131,5 +141,15 @@ func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
 	} else {
 		imsi = aka.IMSI(fullIdentity)
 	}
+	if len(imsi) > 0 && imsi[0] == eap.AkaPseudonymPrefix {
+		// pseudonym, see https://tools.ietf.org/html/rfc4187#section-4.1.1.7
+		_, permanentImsi, err := eap.DecodeTemporaryIdentity(fullIdentity)
+		if err != nil {
+			glog.V(1).Infof("AKA pseudonym '%s' decoding error: %v", fullIdentity, err)
+			return fullIdentity, "", errUnknownPseudonym
+		}
+		// return the IMSI in the permanent identity format
+		imsi = aka.IMSI("0" + permanentImsi)
+	}
 	return fullIdentity, imsi, imsi.Validate()
 }
@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cp "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const testTempIdKey = "000102030405060708090a0b0c0d0e0f"
+
+func TestAkaFastReauthentication(t *testing.T) {
+	os.Setenv("USE_REMOTE_SWX_PROXY", "false")
+	os.Setenv(eap.TempIdentityKeyEnv, testTempIdKey)
+	defer os.Unsetenv(eap.TempIdentityKeyEnv)
+
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	var service testSwxProxy
+	cp.RegisterSwxProxyServer(srv.GrpcServer, service)
+	go srv.RunTest(lis)
+
+	akaSrv, _ := servicers.NewEapAkaService(nil)
+
+	// Full authentication, the challenge must carry encrypted next pseudonym & reauth identity
+	eapCtx := &protos.Context{}
+	p, err := identityResponse(akaSrv, eapCtx, eap.Packet(testEapIdentityResp))
+	require.NoError(t, err)
+	_, uc, ok := akaSrv.FindSession(eapCtx.SessionId)
+	require.True(t, ok)
+	kEncr, kAut, mk := uc.K_encr, uc.K_aut, uc.MK
+	uc.Unlock()
+
+	attrs := decryptedAttributes(t, p, kEncr)
+	require.Len(t, attrs, 2)
+	assert.Equal(t, aka.AT_NEXT_PSEUDONYM, attrs[0].Type())
+	assert.Equal(t, aka.AT_NEXT_REAUTH_ID, attrs[1].Type())
+	pseudonym, err := aka.ParseIdentityAttr(attrs[0])
+	require.NoError(t, err)
+	prefix, imsi, err := eap.DecodeTemporaryIdentity(pseudonym)
+	require.NoError(t, err)
+	assert.Equal(t, eap.AkaPseudonymPrefix, prefix)
+	assert.Equal(t, "001010000000055", imsi)
+	assert.Equal(t, "wlan.mnc001.mcc001.3gppnetwork.org", eap.IdentityRealm(pseudonym))
+	reauthId, err := aka.ParseIdentityAttr(attrs[1])
+	require.NoError(t, err)
+
+	p, err = challengeResponse(akaSrv, eapCtx, eap.Packet(testEapChallengeResp))
+	require.NoError(t, err)
+	assert.Equal(t, successEAP, []byte(p))
+	require.NotNil(t, eapCtx.GetReauth())
+	assert.Equal(t, reauthId, eapCtx.GetReauth().GetReauthId())
+	assert.Equal(t, uint32(1), eapCtx.GetReauth().GetCounter())
+	assert.Equal(t, mk, eapCtx.GetReauth().GetMk())
+
+	// Unknown reauth identity -> full authentication ID request
+	unknownCtx := &protos.Context{SessionId: "unknown_reauth"}
+	p, err = eapIdentityResponse(akaSrv, unknownCtx, newEapIdentityResp(3, reauthId))
+	require.NoError(t, err)
+	assert.Equal(t, []byte(aka.NewIdentityReq(4, aka.AT_FULLAUTH_ID_REQ)), []byte(p))
+
+	// Fast re-authentication
+	reauthCtx := &protos.Context{SessionId: "reauth_session", Reauth: eapCtx.GetReauth()}
+	p, err = eapIdentityResponse(akaSrv, reauthCtx, newEapIdentityResp(3, reauthId))
+	require.NoError(t, err)
+	assert.Equal(t, uint8(4), p.Identifier())
+	assert.Equal(t, byte(aka.SubtypeReauthentication), p[eap.EapSubtype])
+	verifyMac(t, p, kAut)
+
+	var (
+		counter      uint16
+		nonceS       []byte
+		nextReauthId string
+	)
+	for _, a := range decryptedAttributes(t, p, kEncr) {
+		switch a.Type() {
+		case aka.AT_COUNTER:
+			counter = uint16(a.Value()[0])<<8 | uint16(a.Value()[1])
+		case aka.AT_NONCE_S:
+			nonceS = a.Value()[2:]
+		case aka.AT_NEXT_REAUTH_ID:
+			nextReauthId, err = aka.ParseIdentityAttr(a)
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, uint16(2), counter)
+	assert.Len(t, nonceS, aka.NONCE_S_LEN)
+	assert.NotEmpty(t, nextReauthId)
+	assert.NotEqual(t, reauthId, nextReauthId)
+
+	// Invalid counter
+	p, err = reauthResponse(akaSrv, reauthCtx, newReauthResp(t, p.Identifier(), counter+1, false, nonceS, kEncr, kAut))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(aka.SubtypeNotification), p[eap.EapSubtype])
+	assert.Empty(t, reauthCtx.GetMsk())
+
+	p, err = eapIdentityResponse(akaSrv, reauthCtx, newEapIdentityResp(5, reauthId))
+	require.NoError(t, err)
+	for _, a := range decryptedAttributes(t, p, kEncr) {
+		switch a.Type() {
+		case aka.AT_NONCE_S:
+			nonceS = a.Value()[2:]
+		case aka.AT_NEXT_REAUTH_ID:
+			nextReauthId, _ = aka.ParseIdentityAttr(a)
+		}
+	}
+	p, err = reauthResponse(akaSrv, reauthCtx, newReauthResp(t, p.Identifier(), counter, false, nonceS, kEncr, kAut))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{eap.SuccessCode, 6, 0, 4}, []byte(p))
+	assert.Equal(t, "001010000000055", reauthCtx.GetImsi())
+	expectedMsk, _ := aka.MakeReauthKeys([]byte(reauthId), counter, nonceS, mk)
+	assert.Equal(t, expectedMsk, reauthCtx.GetMsk())
+	require.NotNil(t, reauthCtx.GetReauth())
+	assert.Equal(t, nextReauthId, reauthCtx.GetReauth().GetReauthId())
+	assert.Equal(t, uint32(counter), reauthCtx.GetReauth().GetCounter())
+
+	// AT_COUNTER_TOO_SMALL -> fall back to full authentication
+	p, err = eapIdentityResponse(akaSrv, reauthCtx, newEapIdentityResp(7, nextReauthId))
+	require.NoError(t, err)
+	for _, a := range decryptedAttributes(t, p, kEncr) {
+		switch a.Type() {
+		case aka.AT_COUNTER:
+			counter = uint16(a.Value()[0])<<8 | uint16(a.Value()[1])
+		case aka.AT_NONCE_S:
+			nonceS = a.Value()[2:]
+		}
+	}
+	assert.Equal(t, uint16(3), counter)
+	p, err = reauthResponse(akaSrv, reauthCtx, newReauthResp(t, p.Identifier(), counter, true, nonceS, kEncr, kAut))
+	require.NoError(t, err)
+	assert.Equal(t, byte(aka.SubtypeChallenge), p[eap.EapSubtype])
+	assert.Nil(t, reauthCtx.GetReauth())
+}
+
+func TestAkaPseudonymIdentity(t *testing.T) {
+	os.Setenv(eap.TempIdentityKeyEnv, testTempIdKey)
+	defer os.Unsetenv(eap.TempIdentityKeyEnv)
+
+	pseudonym, err := eap.NewTemporaryIdentity(eap.AkaPseudonymPrefix, "001010000000055", "wlan.mnc001.mcc001.3gppnetwork.org")
+	require.NoError(t, err)
+	identity, imsi, err := getIMSIIdentity(aka.NewIdentityAttr(aka.AT_IDENTITY, pseudonym))
+	assert.NoError(t, err)
+	assert.Equal(t, pseudonym, identity)
+	assert.Equal(t, aka.IMSI("0001010000000055"), imsi)
+
+	// Pseudonym in EAP-Response/Identity -> full authentication ID request
+	akaSrv, _ := servicers.NewEapAkaService(nil)
+	p, err := eapIdentityResponse(akaSrv, &protos.Context{}, newEapIdentityResp(1, pseudonym))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(aka.NewIdentityReq(2, aka.AT_FULLAUTH_ID_REQ)), []byte(p))
+
+	// Unknown pseudonym
+	_, _, err = getIMSIIdentity(aka.NewIdentityAttr(aka.AT_IDENTITY, "2unknownpseudonym0123456789@wlan.org"))
+	assert.Equal(t, errUnknownPseudonym, err)
+}
+
+func newEapIdentityResp(identifier uint8, identity string) eap.Packet {
+	return eap.NewPacket(eap.ResponseCode, identifier, append([]byte{eap.MethodIdentity}, identity...))
+}
+
+func newReauthResp(
+	t *testing.T, identifier uint8, counter uint16, tooSmall bool, nonceS, kEncr, kAut []byte) eap.Packet {
+
+	attrs := []eap.Attribute{eap.NewAttribute(aka.AT_COUNTER, []byte{byte(counter >> 8), byte(counter)})}
+	if tooSmall {
+		attrs = append(attrs, eap.NewAttribute(aka.AT_COUNTER_TOO_SMALL, []byte{0, 0}))
+	}
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{aka.TYPE, byte(aka.SubtypeReauthentication), 0, 0})
+	p, err := aka.AppendEncrData(p, kEncr, attrs...)
+	require.NoError(t, err)
+	macOffset := len(p) + aka.ATT_HDR_LEN
+	p, err = p.Append(eap.NewAttribute(aka.AT_MAC, make([]byte, aka.MAC_LEN+2)))
+	require.NoError(t, err)
+	copy(p[macOffset:], aka.GenMac(append(p, nonceS...), kAut))
+	return p
+}
+
+func decryptedAttributes(t *testing.T, p eap.Packet, kEncr []byte) []eap.Attribute {
+	scanner, err := eap.NewAttributeScanner(p)
+	require.NoError(t, err)
+	var atIv, atEncrData eap.Attribute
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case aka.AT_IV:
+			atIv = a
+		case aka.AT_ENCR_DATA:
+			atEncrData = a
+		}
+	}
+	attrs, err := aka.DecryptEncrData(kEncr, atIv, atEncrData)
+	require.NoError(t, err)
+	return attrs
+}
+
+func verifyMac(t *testing.T, p eap.Packet, kAut []byte) {
+	p = append(eap.Packet{}, p...)
+	scanner, err := eap.NewAttributeScanner(p)
+	require.NoError(t, err)
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		if a.Type() == aka.AT_MAC {
+			mac := append([]byte{}, a.Value()[2:]...)
+			copy(a.Value()[2:], make([]byte, aka.MAC_LEN))
+			assert.Equal(t, aka.GenMac(p, kAut), mac)
+			return
+		}
+	}
+	t.Fatal("Missing AT_MAC")
+}
@@ -178,8 +178,29 @@ func createChallengeRequest(
 	// Set AT_AUTN
 	copy(p[atAutnOffset:], authRes.autn)
 
+	lockedCtx.MK = aka.MK([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
+	lockedCtx.K_encr, lockedCtx.K_aut, lockedCtx.MSK, _ =
+		aka.MakeAKAKeys([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
+
+	if eap.TemporaryIdentitiesEnabled() {
+		// Issue new pseudonym & fast re-authentication identity in encrypted AT_ENCR_DATA
+		tempIdAttrs, reauthId, err := aka.NewTemporaryIdentityAttrs(
+			eap.AkaPseudonymPrefix, eap.AkaReauthIdPrefix, string(lockedCtx.Imsi), eap.IdentityRealm(lockedCtx.Identity))
+		if err == nil {
+			var tp eap.Packet
+			tp, err = aka.AppendEncrData(
+				eap.NewPacket(eap.RequestCode, identifier, p[eap.EapMsgMethodType:atMacOffset-aka.ATT_HDR_LEN]),
+				lockedCtx.K_encr,
+				tempIdAttrs...)
+			if err == nil {
+				lockedCtx.NextReauthId = reauthId
+				return aka.AppendMac(tp, lockedCtx.K_aut)
+			}
+		}
+		glog.Errorf("failed to generate temporary identities for IMSI %s: %v", lockedCtx.Imsi, err)
+	}
+
 	// Calculate AT_MAC
-	_, lockedCtx.K_aut, lockedCtx.MSK, _ = aka.MakeAKAKeys([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
 	mac := aka.GenMac(p, lockedCtx.K_aut)
 	// Set AT_MAC
 	copy(p[atMacOffset:], mac)
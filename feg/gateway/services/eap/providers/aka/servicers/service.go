@@ -42,10 +42,16 @@ type UserCtx struct {
 	Identifier uint8
 	Rand,
 	K_aut,
+	K_encr,
+	MK,
 	MSK,
 	Xres []byte
 	SessionId     string
 	AuthSessionId string
+	// Fast Re-authentication state
+	NonceS       []byte
+	Counter      uint16
+	NextReauthId string
 }
 
 type SessionCtx struct {
@@ -28,8 +28,9 @@ import (
 type Handler func(srvr *EapAkaSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error)
 
 var akaHandlers struct {
-	rwl sync.RWMutex
-	hm  map[aka.Subtype]Handler
+	rwl             sync.RWMutex
+	hm              map[aka.Subtype]Handler
+	identityHandler Handler
 }
 
 func AddHandler(st aka.Subtype, h Handler) {
@@ -49,6 +50,20 @@ func AddHandler(st aka.Subtype, h Handler) {
 	akaHandlers.rwl.Unlock()
 }
 
+// SetIdentityHandler registers handler for EAP-Response/Identity packets
+func SetIdentityHandler(h Handler) {
+	akaHandlers.rwl.Lock()
+	akaHandlers.identityHandler = h
+	akaHandlers.rwl.Unlock()
+}
+
+// GetIdentityHandler returns registered EAP-Response/Identity handler or nil if not set
+func GetIdentityHandler() Handler {
+	akaHandlers.rwl.RLock()
+	defer akaHandlers.rwl.RUnlock()
+	return akaHandlers.identityHandler
+}
+
 func GetHandler(st aka.Subtype) Handler {
 	akaHandlers.rwl.RLock()
 	defer akaHandlers.rwl.RUnlock()
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package aka_prime implements EAP-AKA' provider (RFC 9048)
+package aka_prime
+
+import (
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const (
+	TYPE           = uint8(protos.EapType_AKAPrime)
+	MIN_PACKET_LEN = eap.EapSubtype
+
+	EapAkaPrimeServiceName = "eap_aka_prime"
+
+	// NetworkNameEnv is the environment variable which can be used to overwrite the default AT_KDF_INPUT network name
+	NetworkNameEnv = "EAP_AKA_PRIME_NETWORK_NAME"
+	// DefaultNetworkName is the Access Network Identity for WLAN access, see 3GPP TS 24.302, section 8.1.1.1
+	DefaultNetworkName = "WLAN"
+)
+
+const (
+	// Attributes shared with EAP-AKA
+	AT_RAND              = aka.AT_RAND
+	AT_AUTN              = aka.AT_AUTN
+	AT_RES               = aka.AT_RES
+	AT_AUTS              = aka.AT_AUTS
+	AT_PERMANENT_ID_REQ  = aka.AT_PERMANENT_ID_REQ
+	AT_MAC               = aka.AT_MAC
+	AT_NOTIFICATION      = aka.AT_NOTIFICATION
+	AT_ANY_ID_REQ        = aka.AT_ANY_ID_REQ
+	AT_IDENTITY          = aka.AT_IDENTITY
+	AT_FULLAUTH_ID_REQ   = aka.AT_FULLAUTH_ID_REQ
+	AT_CLIENT_ERROR_CODE = aka.AT_CLIENT_ERROR_CODE
+	AT_CHECKCODE         = aka.AT_CHECKCODE
+	AT_RESULT_IND        = aka.AT_RESULT_IND
+
+	// AKA' specific Attributes, all other attributes are shared with EAP-AKA
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+	AT_BIDDING   eap.AttrType = 136
+)
+
+const (
+	// KDF_AKA_PRIME is the only Key Derivation Function currently defined for EAP-AKA' (RFC 9048, section 3.2)
+	KDF_AKA_PRIME uint16 = 1
+)
+
+const (
+	// Permanent & Pseudonym identity prefixes, see RFC 9048, section 3.1
+	PermanentIdPrefix = '6'
+	PseudonymIdPrefix = '7'
+	ReauthIdPrefix    = '8'
+)
+
+const (
+	ATT_HDR_LEN = aka.ATT_HDR_LEN
+	MAC_LEN     = aka.MAC_LEN
+	CK_LEN      = 16
+	IK_LEN      = 16
+
+	K_ENCR_LEN = 16
+	K_AUT_LEN  = 32
+	K_RE_LEN   = 32
+	MSK_LEN    = 64
+	EMSK_LEN   = 64
+
+	AT_KDF_ATTR_LEN = ATT_HDR_LEN
+)
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka_prime
+
+import (
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// NewIdentityReq returns a new EAP-Request/AKA'-Identity packet with the given ID request attribute
+func NewIdentityReq(identifier uint8, attr eap.AttrType) eap.Packet {
+	return []byte{
+		eap.RequestCode,
+		identifier,
+		0, 12, // EAP Len
+		TYPE,
+		byte(aka.SubtypeIdentity),
+		0, 0,
+		byte(attr),
+		1,
+		0, 0} // padding
+}
+
+// NewKdfInputAttr returns AT_KDF_INPUT attribute for the given network name (RFC 9048, section 3.1)
+func NewKdfInputAttr(networkName string) eap.Attribute {
+	nameLen := len(networkName)
+	val := make([]byte, 2, 2+nameLen)
+	val[0], val[1] = byte(nameLen>>8), byte(nameLen)
+	val = append(val, networkName...)
+	return eap.NewAttribute(AT_KDF_INPUT, val)
+}
+
+// NewKdfAttr returns AT_KDF attribute for the given KDF (RFC 9048, section 3.2)
+func NewKdfAttr(kdf uint16) eap.Attribute {
+	return eap.NewAttribute(AT_KDF, []byte{byte(kdf >> 8), byte(kdf)})
+}
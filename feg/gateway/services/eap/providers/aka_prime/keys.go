@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka_prime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"magma/feg/gateway/services/eap"
+)
+
+const (
+	fcCKIKPrime = 0x20 // FC value for CK' & IK' derivation, see 3GPP TS 33.402, Annex A.2
+	sqnXorAkLen = 6
+	mkLen       = K_ENCR_LEN + K_AUT_LEN + K_RE_LEN + MSK_LEN + EMSK_LEN
+	akaPrimeStr = "EAP-AKA'"
+)
+
+// MakeCKIKPrime derives CK' & IK' from CK, IK, AUTN & Access Network Identity (network name),
+// see 3GPP TS 33.402, Annex A.2 & RFC 9048, section 3.3
+func MakeCKIKPrime(CK, IK, autn []byte, networkName string) (CKPrime, IKPrime []byte, err error) {
+	if len(CK) != CK_LEN || len(IK) != IK_LEN {
+		return nil, nil, fmt.Errorf("invalid CK (%d) or IK (%d) length", len(CK), len(IK))
+	}
+	if len(autn) < sqnXorAkLen {
+		return nil, nil, fmt.Errorf("AUTN is too short: %d", len(autn))
+	}
+	nameLen := len(networkName)
+	// S = FC | P0 | L0 | P1 | L1, P0 = Access Network Identity, P1 = SQN xor AK
+	s := make([]byte, 0, 1+nameLen+2+sqnXorAkLen+2)
+	s = append(s, fcCKIKPrime)
+	s = append(s, networkName...)
+	s = append(s, byte(nameLen>>8), byte(nameLen))
+	s = append(s, autn[:sqnXorAkLen]...)
+	s = append(s, 0, sqnXorAkLen)
+
+	key := make([]byte, 0, CK_LEN+IK_LEN)
+	key = append(append(key, CK...), IK...)
+	res := HmacSha256(s, key)
+	return res[:CK_LEN], res[CK_LEN:], nil
+}
+
+// MakeAKAPrimeKeys returns generated K_encr, K_aut, K_re, MSK & EMSK keys for AKA' Authentication
+// (RFC 9048, section 3.3): MK = PRF'(IK'|CK',"EAP-AKA'"|Identity)
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := make([]byte, 0, len(IKPrime)+len(CKPrime))
+	key = append(append(key, IKPrime...), CKPrime...)
+	mk := PRFPrime(key, append([]byte(akaPrimeStr), identity...), mkLen)
+	K_encr, mk = mk[:K_ENCR_LEN], mk[K_ENCR_LEN:]
+	K_aut, mk = mk[:K_AUT_LEN], mk[K_AUT_LEN:]
+	K_re, mk = mk[:K_RE_LEN], mk[K_RE_LEN:]
+	MSK, EMSK = mk[:MSK_LEN], mk[MSK_LEN:]
+	return
+}
+
+// PRFPrime implements PRF' function (RFC 9048, section 3.4.1) & returns the first n bytes of its output:
+//
+//	PRF'(K,S) = T1 | T2 | T3 | T4 | ...
+//	T1 = HMAC-SHA-256 (K, S | 0x01)
+//	T2 = HMAC-SHA-256 (K, T1 | S | 0x02)
+//	...
+func PRFPrime(key, s []byte, n int) []byte {
+	res := make([]byte, 0, n+sha256.Size)
+	var t []byte
+	for i := 1; len(res) < n; i++ {
+		h := hmac.New(sha256.New, key)
+		h.Write(t)
+		h.Write(s)
+		h.Write([]byte{byte(i)})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:n]
+}
+
+// HmacSha256 - SHA256 based HMAC
+func HmacSha256(data, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// GenMac calculates AKA' MAC given data & K_aut, AT_MAC uses HMAC-SHA-256-128 (RFC 9048, section 3.4.1)
+func GenMac(data, K_aut []byte) []byte {
+	return HmacSha256(data, K_aut)[:MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(AT_MAC, append([]byte{0, 0}, make([]byte, MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(p, K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka_prime
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 5448, Appendix C, Test Case 1
+const (
+	tcIdentity    = "0555444333222111"
+	tcNetworkName = "WLAN"
+	tcCK          = "5349fbe098649f948f5d2e973a81c00f"
+	tcIK          = "9744871ad32bf9bbd1dd5ce54e3e2e5a"
+	tcAutn        = "bb52e91c747ac3ab2a5c23d15ee351d5"
+
+	tcCKPrime = "0093962d0dd84aa5684b045c9edffa04"
+	tcIKPrime = "ccfc230ca74fcc96c0a5d61164f5a76c"
+	tcKencr   = "766fa0a6c317174b812d52fbcd11a179"
+	tcKaut    = "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea"
+	tcKre     = "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a"
+	tcMSK     = "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+		"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a"
+	tcEMSK = "f861703cd775590e16c7679ea3874ada866311de290764d760cf76df647ea01c" +
+		"313f69924bdd7650ca9bac141ea075c4ef9e8029c0e290cdbad5638b63bc23fb"
+)
+
+func TestAkaPrimeKeys(t *testing.T) {
+	ck, _ := hex.DecodeString(tcCK)
+	ik, _ := hex.DecodeString(tcIK)
+	autn, _ := hex.DecodeString(tcAutn)
+
+	ckPrime, ikPrime, err := MakeCKIKPrime(ck, ik, autn, tcNetworkName)
+	assert.NoError(t, err)
+	assert.Equal(t, tcCKPrime, hex.EncodeToString(ckPrime))
+	assert.Equal(t, tcIKPrime, hex.EncodeToString(ikPrime))
+
+	K_encr, K_aut, K_re, MSK, EMSK := MakeAKAPrimeKeys([]byte(tcIdentity), ikPrime, ckPrime)
+	assert.Equal(t, tcKencr, hex.EncodeToString(K_encr))
+	assert.Equal(t, tcKaut, hex.EncodeToString(K_aut))
+	assert.Equal(t, tcKre, hex.EncodeToString(K_re))
+	assert.Equal(t, tcMSK, hex.EncodeToString(MSK))
+	assert.Equal(t, tcEMSK, hex.EncodeToString(EMSK))
+
+	_, _, err = MakeCKIKPrime(ck[:8], ik, autn, tcNetworkName)
+	assert.Error(t, err)
+}
+
+func TestKdfAttributes(t *testing.T) {
+	a := NewKdfAttr(KDF_AKA_PRIME)
+	assert.Equal(t, []byte{byte(AT_KDF), 1, 0, 1}, a.Marshaled())
+
+	a = NewKdfInputAttr(tcNetworkName)
+	assert.Equal(t, []byte{byte(AT_KDF_INPUT), 2, 0, 4, 'W', 'L', 'A', 'N'}, a.Marshaled())
+
+	a = NewKdfInputAttr("WLAN1")
+	assert.Equal(t, []byte{byte(AT_KDF_INPUT), 3, 0, 5, 'W', 'L', 'A', 'N', '1', 0, 0, 0}, a.Marshaled())
+}
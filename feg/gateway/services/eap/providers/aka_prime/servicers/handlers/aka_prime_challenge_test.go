@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cp "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const (
+	testIdentity = "6001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"
+	testRand     = "\x01\x23\x45\x67\x89\xab\xcd\xef\x01\x23\x45\x67\x89\xab\xcd\xef"
+	testAutn     = "\x54\xab\x64\x4a\x90\x51\xb9\xb9\x5e\x85\xc1\x22\x3e\x0e\xf1\x4c"
+	testXres     = "\x29\x5c\x00\xea\xe3\x88\x93\x0d"
+	testCK       = "\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93"
+	testIK       = "\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98"
+)
+
+type testSwxProxy struct{}
+
+// Authenticate returns a static EAP-AKA' vector
+func (s testSwxProxy) Authenticate(
+	ctx context.Context,
+	req *cp.AuthenticationRequest,
+) (*cp.AuthenticationAnswer, error) {
+	return &cp.AuthenticationAnswer{
+		UserName:  req.GetUserName(),
+		SessionId: "test_session_id",
+		SipAuthVectors: []*cp.AuthenticationAnswer_SIPAuthVector{
+			{
+				AuthenticationScheme: req.AuthenticationScheme,
+				RandAutn:             []byte(testRand + testAutn),
+				Xres:                 []byte(testXres),
+				ConfidentialityKey:   []byte(testCK),
+				IntegrityKey:         []byte(testIK),
+			},
+		},
+	}, nil
+}
+
+// Register returns an empty SAA
+func (s testSwxProxy) Register(
+	ctx context.Context,
+	req *cp.RegistrationRequest,
+) (*cp.RegistrationAnswer, error) {
+	return &cp.RegistrationAnswer{}, nil
+}
+
+// Deregister returns an empty SAA
+func (s testSwxProxy) Deregister(
+	ctx context.Context,
+	req *cp.RegistrationRequest,
+) (*cp.RegistrationAnswer, error) {
+	return &cp.RegistrationAnswer{}, nil
+}
+
+var swxProxyOnce sync.Once
+
+func startTestSwxProxy(t *testing.T) {
+	swxProxyOnce.Do(func() {
+		os.Setenv("USE_REMOTE_SWX_PROXY", "false")
+		srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+		cp.RegisterSwxProxyServer(srv.GrpcServer, testSwxProxy{})
+		go srv.RunTest(lis)
+	})
+}
+
+func TestAkaPrimeChallenge(t *testing.T) {
+	startTestSwxProxy(t)
+	akaPrimeSrv, err := servicers.NewEapAkaPrimeService(nil)
+	assert.NoError(t, err)
+
+	ctx := &protos.Context{SessionId: eap.CreateSessionId()}
+	p, err := identityResponse(akaPrimeSrv, ctx, newIdentityResp(t, 1, testIdentity))
+	assert.NoError(t, err)
+	assert.Equal(t, "001010000000055", ctx.Imsi)
+
+	K_aut, MSK := verifyChallengeReq(t, p, 2)
+
+	// Peer response: AT_RES | AT_MAC
+	resp := eap.NewPacket(eap.ResponseCode, p.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	resp, err = resp.Append(eap.NewAttribute(aka_prime.AT_RES, append([]byte{0, 64}, testXres...)))
+	assert.NoError(t, err)
+	resp, err = aka_prime.AppendMac(resp, K_aut)
+	assert.NoError(t, err)
+
+	res, err := akaPrimeSrv.HandleImpl(&protos.Eap{Payload: resp, Ctx: ctx})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{eap.SuccessCode, p.Identifier(), 0, 4}, res.GetPayload())
+	assert.Equal(t, MSK, res.GetCtx().GetMsk())
+	assert.Equal(t, testIdentity, res.GetCtx().GetIdentity())
+	assert.Equal(t, "test_session_id", res.GetCtx().GetAuthSessionId())
+}
+
+func TestAkaPrimeKdfNegotiation(t *testing.T) {
+	startTestSwxProxy(t)
+	akaPrimeSrv, err := servicers.NewEapAkaPrimeService(nil)
+	assert.NoError(t, err)
+
+	ctx := &protos.Context{SessionId: eap.CreateSessionId()}
+	p, err := identityResponse(akaPrimeSrv, ctx, newIdentityResp(t, 5, testIdentity))
+	assert.NoError(t, err)
+	K_aut, _ := verifyChallengeReq(t, p, 6)
+
+	// Peer rejects offered KDF & proposes a different one
+	resp := eap.NewPacket(eap.ResponseCode, p.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	resp, err = resp.Append(aka_prime.NewKdfAttr(2))
+	assert.NoError(t, err)
+	resp, err = aka_prime.AppendMac(resp, K_aut)
+	assert.NoError(t, err)
+
+	res, err := akaPrimeSrv.HandleImpl(&protos.Eap{Payload: resp, Ctx: ctx})
+	assert.NoError(t, err)
+	rp := eap.Packet(res.GetPayload())
+	assert.Equal(t, eap.RequestCode, int(rp[eap.EapMsgCode]))
+	assert.Equal(t, aka_prime.TYPE, rp.Type())
+	assert.Equal(t, byte(aka.SubtypeNotification), rp[eap.EapSubtype])
+	assert.Empty(t, res.GetCtx().GetMsk())
+
+	// Non-permanent identity must be rejected
+	ctx = &protos.Context{SessionId: eap.CreateSessionId()}
+	p, err = identityResponse(
+		akaPrimeSrv, ctx, newIdentityResp(t, 7, "7001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(aka.SubtypeNotification), p[eap.EapSubtype])
+}
+
+func newIdentityResp(t *testing.T, identifier uint8, identity string) eap.Packet {
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{aka_prime.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	p, err := p.Append(eap.NewAttribute(
+		aka_prime.AT_IDENTITY, append([]byte{0, byte(len(identity))}, identity...)))
+	assert.NoError(t, err)
+	return p
+}
+
+// verifyChallengeReq verifies AKA' Challenge attributes & MAC and returns K_aut & MSK the peer would derive
+func verifyChallengeReq(t *testing.T, p eap.Packet, identifier uint8) (K_aut, MSK []byte) {
+	assert.Equal(t, identifier, p.Identifier())
+	assert.Equal(t, aka_prime.TYPE, p.Type())
+	assert.Equal(t, byte(aka.SubtypeChallenge), p[eap.EapSubtype])
+
+	attrs := map[eap.AttrType]eap.Attribute{}
+	pc := make([]byte, len(p))
+	copy(pc, p)
+	scanner, err := eap.NewAttributeScanner(pc)
+	assert.NoError(t, err)
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		attrs[a.Type()] = a
+	}
+	assert.Equal(t, append([]byte{0, 0}, testRand...), attrs[aka_prime.AT_RAND].Value())
+	assert.Equal(t, append([]byte{0, 0}, testAutn...), attrs[aka_prime.AT_AUTN].Value())
+	assert.Equal(t, []byte{0, byte(aka_prime.KDF_AKA_PRIME)}, attrs[aka_prime.AT_KDF].Value())
+	assert.Equal(t, aka_prime.NewKdfInputAttr(aka_prime.DefaultNetworkName).Marshaled(),
+		attrs[aka_prime.AT_KDF_INPUT].Marshaled())
+
+	ckPrime, ikPrime, err := aka_prime.MakeCKIKPrime([]byte(testCK), []byte(testIK), []byte(testAutn), "WLAN")
+	assert.NoError(t, err)
+	_, K_aut, _, MSK, _ = aka_prime.MakeAKAPrimeKeys([]byte(testIdentity), ikPrime, ckPrime)
+
+	atMac := attrs[aka_prime.AT_MAC].Marshaled()
+	if !assert.Len(t, atMac, aka_prime.ATT_HDR_LEN+aka_prime.MAC_LEN) {
+		t.FailNow()
+	}
+	mac := make([]byte, aka_prime.MAC_LEN)
+	copy(mac, atMac[aka_prime.ATT_HDR_LEN:])
+	for i := aka_prime.ATT_HDR_LEN; i < len(atMac); i++ {
+		atMac[i] = 0
+	}
+	if !reflect.DeepEqual(mac, aka_prime.GenMac(pc, K_aut)) {
+		t.Fatalf("Invalid AKA' Challenge MAC: %x", mac)
+	}
+	return K_aut, MSK
+}
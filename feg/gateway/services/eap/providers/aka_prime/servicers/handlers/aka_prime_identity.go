@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provided AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/aka_prime/metrics"
+	"magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeIdentity, identityResponse)
+}
+
+// identityResponse implements handler for AKA' Identity Response, see https://tools.ietf.org/html/rfc9048#section-3
+func identityResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.IdentityRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedIdentityRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		glog.Warningf("Missing Session ID for EAP: %x; Generated new SID: %s", req, ctx.SessionId)
+	}
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Aborted, err.Error())
+	}
+	var a eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		// Find first valid AT_IDENTITY attribute to get UE IMSI
+		if a.Type() == aka_prime.AT_IDENTITY {
+			identity, imsi, err := getIMSIIdentity(a)
+			if err == nil {
+				if !s.CheckPlmnId(imsi) {
+					s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+					return aka_prime.EapErrorResPacket(
+						identifier,
+						aka.NOTIFICATION_FAILURE,
+						codes.PermissionDenied,
+						"PLMN ID of IMSI: %s is not permitted", imsi)
+				}
+				ctx.Imsi = string(imsi)                  // set IMSI
+				uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+				state, t := uc.State()
+				if state > aka.StateCreated {
+					glog.Errorf(
+						"EAP AKA' IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
+						state, t, imsi, uc.Identity)
+				}
+				uc.Identity = identity
+				uc.SetState(aka.StateIdentity)
+				p, err := createChallengeRequest(s, uc, identifier, nil)
+				if success = err == nil; success {
+					// Update state
+					uc.SetState(aka.StateChallenge)
+					s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+				} else {
+					s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+				}
+				return p, err
+			}
+			glog.Warningf("Invalid AKA' AT_IDENTITY: %v", err)
+		}
+	}
+	s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+	if err != nil && err != io.EOF {
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	return aka_prime.EapErrorResPacket(
+		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
+}
+
+// getIMSIIdentity returns full identity & IMSI of AKA' permanent identity (prefixed with '6'),
+// see https://tools.ietf.org/html/rfc9048#section-3.1
+func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
+	if a.Type() != aka_prime.AT_IDENTITY {
+		return "", "", fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
+	}
+	if a.Len() <= 4 {
+		return "", "", fmt.Errorf("AT_IDENTITY is too short: %d", a.Len())
+	}
+	val := a.Value()
+	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen2 > len(val) {
+		return "", "", fmt.Errorf("Corrupt AT_IDENTITY Attribute: actual len %d > data len %d", actualLen2-2, len(val))
+	}
+	fullIdentity := string(val[2:actualLen2])
+	userName := fullIdentity
+	if atIdx := strings.Index(fullIdentity, "@"); atIdx > 0 {
+		userName = fullIdentity[:atIdx]
+	}
+	if len(userName) == 0 || userName[0] != aka_prime.PermanentIdPrefix {
+		return fullIdentity, "", fmt.Errorf("AKA' AT_IDENTITY '%s' is not a permanent identity", fullIdentity)
+	}
+	imsi := aka.IMSI(userName[1:])
+	return fullIdentity, imsi, imsi.Validate()
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/aka_prime/metrics"
+	"magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+type tgppAuthResult struct {
+	rand, autn, xres, ck, ik []byte
+	sid                      string
+	profile                  *swx_protos.AuthenticationAnswer_UserProfile
+}
+
+func getSwxVector(imsi string, resyncInfo []byte) (*tgppAuthResult, error) {
+	metrics.SwxRequests.Inc()
+	swxStartTime := time.Now()
+
+	ans, err := swx_proxy.Authenticate(
+		&swx_protos.AuthenticationRequest{
+			UserName:             imsi,
+			SipNumAuthVectors:    1,
+			AuthenticationScheme: swx_protos.AuthenticationScheme_EAP_AKA_PRIME,
+			ResyncInfo:           resyncInfo,
+			RetrieveUserProfile:  true,
+		})
+
+	metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+	if err != nil {
+		metrics.SwxFailures.Inc()
+		errCode := codes.Internal
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			errCode = se.GRPCStatus().Code()
+		}
+		return nil, status.Errorf(errCode, "%v; IMSI: %s", err, imsi)
+	}
+	if ans == nil {
+		return nil, status.Error(codes.Internal, "Error: Nil SWx Response")
+	}
+	if len(ans.SipAuthVectors) == 0 {
+		return nil, status.Errorf(codes.Internal, "Error: Missing/empty SWx Auth Vector: %+v", *ans)
+	}
+	av := ans.SipAuthVectors[0] // Use first vector for now
+	ra := av.GetRandAutn()
+	if len(ra) < aka.RandAutnLen {
+		return nil, status.Errorf(codes.Internal,
+			"Invalid SWx RandAutn len (%d, expected: %d) in Response: %+v", len(ra), aka.RandAutnLen, *ans)
+	}
+	return &tgppAuthResult{
+		rand:    ra[:aka.RAND_LEN],
+		autn:    ra[aka.RAND_LEN:aka.RandAutnLen],
+		xres:    av.GetXres(),
+		ck:      av.GetConfidentialityKey(),
+		ik:      av.GetIntegrityKey(),
+		sid:     ans.GetSessionId(),
+		profile: ans.GetUserProfile(),
+	}, nil
+}
+
+// createChallengeRequest gets a new SWx vector for the user & builds EAP-Request/AKA'-Challenge,
+// see https://tools.ietf.org/html/rfc9048#section-3
+func createChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	resyncInfo []byte) (eap.Packet, error) {
+
+	authRes, err := getSwxVector(string(lockedCtx.Imsi), resyncInfo)
+	if err == nil {
+		var p eap.Packet
+		if p, err = buildChallengeRequest(s, lockedCtx, identifier+1, authRes); err == nil {
+			return p, nil
+		}
+	}
+	var (
+		code codes.Code
+		msg  string
+	)
+	if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		code = se.GRPCStatus().Code()
+		msg = se.GRPCStatus().Message()
+	} else {
+		code = codes.Internal
+		msg = err.Error()
+	}
+	glog.Errorf("AKA' RPC [%s] %s", code, msg)
+	return aka_prime.NewAKAPrimeNotificationReq(identifier, aka.NOTIFICATION_FAILURE), nil
+}
+
+func buildChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	authRes *tgppAuthResult) (eap.Packet, error) {
+
+	ckPrime, ikPrime, err := aka_prime.MakeCKIKPrime(authRes.ck, authRes.ik, authRes.autn, s.NetworkName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "CK'/IK' derivation error: %v", err)
+	}
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	for _, a := range []eap.Attribute{
+		eap.NewAttribute(aka_prime.AT_RAND, append([]byte{0, 0}, authRes.rand...)),
+		eap.NewAttribute(aka_prime.AT_AUTN, append([]byte{0, 0}, authRes.autn...)),
+		aka_prime.NewKdfAttr(aka_prime.KDF_AKA_PRIME),
+		aka_prime.NewKdfInputAttr(s.NetworkName()),
+	} {
+		if p, err = p.Append(a); err != nil {
+			return nil, status.Errorf(codes.Internal, "Challenge Request Attribute %d error: %v", a.Type(), err)
+		}
+	}
+	_, K_aut, _, MSK, _ := aka_prime.MakeAKAPrimeKeys([]byte(lockedCtx.Identity), ikPrime, ckPrime)
+	p, err = aka_prime.AppendMac(p, K_aut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Challenge Request AT_MAC error: %v", err)
+	}
+	lockedCtx.Identifier = identifier
+	lockedCtx.Rand = authRes.rand
+	lockedCtx.Xres = authRes.xres
+	lockedCtx.AuthSessionId = authRes.sid
+	lockedCtx.Profile = authRes.profile
+	lockedCtx.K_aut, lockedCtx.MSK = K_aut, MSK
+	return p, nil
+}
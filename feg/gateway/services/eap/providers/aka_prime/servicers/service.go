@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package servicers implements EAP-AKA' GRPC service
+package servicers
+
+import (
+	"os"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+)
+
+// UserCtx is EAP-AKA' User CTX, it's shared with EAP-AKA
+type UserCtx = servicers.UserCtx
+
+// EapAkaPrimeSrv is EAP-AKA' service, it reuses session & user CTX management of EAP-AKA service
+type EapAkaPrimeSrv struct {
+	*servicers.EapAkaSrv
+
+	// Access Network Identity used in AT_KDF_INPUT & CK'/IK' derivation - Read Only
+	networkName string
+}
+
+// NewEapAkaPrimeService creates new Aka' Service 'object'
+// EAP-AKA' uses the same timeouts & PLMN ID filter configuration as EAP-AKA
+func NewEapAkaPrimeService(config *mconfig.EapAkaConfig) (*EapAkaPrimeSrv, error) {
+	akaSrv, err := servicers.NewEapAkaService(config)
+	if err != nil {
+		return nil, err
+	}
+	service := &EapAkaPrimeSrv{EapAkaSrv: akaSrv, networkName: aka_prime.DefaultNetworkName}
+	if networkName, isset := os.LookupEnv(aka_prime.NetworkNameEnv); isset && len(networkName) > 0 {
+		service.networkName = networkName
+	}
+	glog.Infof("EAP-AKA': Using SWx Auth Vectors with Network Name: '%s'", service.networkName)
+	return service, nil
+}
+
+// NetworkName returns Access Network Identity used by the service
+func (s *EapAkaPrimeSrv) NetworkName() string {
+	if s != nil {
+		return s.networkName
+	}
+	return aka_prime.DefaultNetworkName
+}
@@ -16,10 +16,12 @@ package registry
 
 import (
 	aka_provider "magma/feg/gateway/services/eap/providers/aka/provider"
+	aka_prime_provider "magma/feg/gateway/services/eap/providers/aka_prime/provider"
 	sim_provider "magma/feg/gateway/services/eap/providers/sim/provider"
 )
 
 func init() {
 	Register(aka_provider.New())
 	Register(sim_provider.New())
+	Register(aka_prime_provider.New())
 }
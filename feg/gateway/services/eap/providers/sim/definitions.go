@@ -52,7 +52,11 @@ const (
 	AT_COUNTER
 	AT_COUNTER_TOO_SMALL
 	AT_NONCE_S
-	AT_CLIENT_ERROR_CODE // 22
+	AT_CLIENT_ERROR_CODE              // 22
+	AT_IV                eap.AttrType = 129
+	AT_ENCR_DATA         eap.AttrType = 130
+	AT_NEXT_PSEUDONYM    eap.AttrType = 132
+	AT_NEXT_REAUTH_ID    eap.AttrType = 133
 )
 
 const (
@@ -81,12 +85,13 @@ type SimState int16
 
 const (
 	// Processing/handling States
-	StateNone          SimState = iota
-	StateCreated                // newly created
-	StateIdentity               // Valid permanent identity received
-	StateChallenge              // Auth Challenge was returned to UE
-	StateAuthenticated          // UE is successfully authenticated
-	StateRedirected             // UE is redirected to another Auth method, cache this state to prevent redirection loop
+	StateNone             SimState = iota
+	StateCreated                   // newly created
+	StateIdentity                  // Valid permanent identity received
+	StateChallenge                 // Auth Challenge was returned to UE
+	StateAuthenticated             // UE is successfully authenticated
+	StateRedirected                // UE is redirected to another Auth method, cache this state to prevent redirection loop
+	StateReauthentication          // Fast Re-authentication Request was returned to UE
 )
 
 const (
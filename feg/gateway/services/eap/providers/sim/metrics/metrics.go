@@ -61,6 +61,14 @@ var (
 		Name: "eap_sim_failed_challenge_requests_total",
 		Help: "Total number of failed calls to SIM Challenge Handler",
 	})
+	ReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_sim_reauth_requests_total",
+		Help: "Total number of SIM Fast Re-authentications started",
+	})
+	FailedReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_sim_failed_reauth_requests_total",
+		Help: "Total number of failed SIM Fast Re-authentications",
+	})
 	ResyncRequests = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "eap_sim_resync_requests_total",
 		Help: "Total number of calls to SIM Resync Handler",
@@ -131,5 +139,6 @@ func init() {
 	prometheus.MustRegister(Requests, FailedRequests, FailureNotifications,
 		SwxFailures, SessionTimeouts, StartRequests, FailedStartRequests,
 		ChallengeRequests, FailedChallengeRequests, ResyncRequests, FailedResyncRequests,
+		ReauthRequests, FailedReauthRequests,
 		PeerAuthReject, PeerClientError, PeerNotification, PeerFailures, SWxLatency, AuthLatency)
 }
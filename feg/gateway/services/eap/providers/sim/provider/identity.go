@@ -16,7 +16,7 @@ package provider
 
 import "regexp"
 
-var simRe = regexp.MustCompile(`^1\d{6,15}@\w(?:\w|\.|-)*\w$`)
+var simRe = regexp.MustCompile(`^(?:1\d{6,15}|[35][\w-]{16,})@\w(?:\w|\.|-)*\w$`)
 
 // WillHandleIdentity returns true if the provider 1) recognizes the given Identity and 2) can hendle authentication
 // for this type of identity.
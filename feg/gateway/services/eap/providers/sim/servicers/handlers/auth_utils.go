@@ -161,7 +161,8 @@ func createChallengeRequest(
 	lockedCtx.Identifier = identifier
 	lockedCtx.Rand = authRes.rand[:]
 	lockedCtx.Sres = authRes.sres[:]
-	_, lockedCtx.K_aut, lockedCtx.MSK, _ =
+	lockedCtx.MK = sim.MK([]byte(lockedCtx.Identity), nonce, versionList, selectedVersion, authRes.Kc[:])
+	lockedCtx.K_encr, lockedCtx.K_aut, lockedCtx.MSK, _ =
 		sim.MakeKeys([]byte(lockedCtx.Identity), nonce, versionList, selectedVersion, authRes.Kc[:])
 
 	// Clone EAP Challenge packet
@@ -173,6 +174,29 @@ func createChallengeRequest(
 	for i, offset := 0, atRandOffset; i < sim.GsmTripletsNumber; i, offset = i+1, offset+sim.RAND_LEN {
 		copy(p[offset:], authRes.rand[i])
 	}
+	if eap.TemporaryIdentitiesEnabled() {
+		// Issue new pseudonym & fast re-authentication identity in encrypted AT_ENCR_DATA
+		tempIdAttrs, reauthId, err := aka.NewTemporaryIdentityAttrs(
+			eap.SimPseudonymPrefix, eap.SimReauthIdPrefix, string(lockedCtx.Imsi), eap.IdentityRealm(lockedCtx.Identity))
+		if err == nil {
+			var tp eap.Packet
+			tp, err = aka.AppendEncrData(
+				eap.NewPacket(eap.RequestCode, identifier, p[eap.EapMsgMethodType:atMacOffset-sim.ATT_HDR_LEN]),
+				lockedCtx.K_encr,
+				tempIdAttrs...)
+			if err == nil {
+				tpMacOffset := len(tp) + sim.ATT_HDR_LEN
+				tp, err = tp.Append(eap.NewAttribute(sim.AT_MAC, make([]byte, sim.MAC_LEN+2)))
+				if err == nil {
+					lockedCtx.NextReauthId = reauthId
+					copy(tp[tpMacOffset:], sim.GenMac(tp, nonce, lockedCtx.K_aut))
+					return tp, nil
+				}
+			}
+		}
+		glog.Errorf("failed to generate temporary identities for IMSI %s: %v", lockedCtx.Imsi, err)
+	}
+
 	// Calculate AT_MAC
 	mac := sim.GenMac(p, nonce, lockedCtx.K_aut)
 	// Set AT_MAC
@@ -130,6 +130,7 @@ func challengeResponse(s *servicers.EapSimSrv, ctx *protos.Context, req eap.Pack
 	ctx.Msk = uc.MSK
 	ctx.Identity = uc.Identity
 	ctx.AuthSessionId = uc.AuthSessionId
+	ctx.Reauth = newReauthContext(uc)
 	uc.SetState(sim.StateAuthenticated)
 
 	// Keep session & User Ctx around for some time after authentication and then clean them up
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provided SIM Response handlers for supported SIM subtypes
+package handlers
+
+import (
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/cloud/go/protos"
+	aaa_protos "magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+)
+
+func init() {
+	servicers.SetIdentityHandler(eapIdentityResponse)
+	servicers.AddHandler(sim.SubtypeReauthentication, reauthResponse)
+}
+
+// eapIdentityResponse handles EAP-Response/Identity. If the identity is a fast re-authentication identity
+// matching the re-authentication context of the user's session, eapIdentityResponse starts fast
+// re-authentication (see https://tools.ietf.org/html/rfc4186#section-5), otherwise it starts full
+// authentication
+func eapIdentityResponse(s *servicers.EapSimSrv, ctx *aaa_protos.Context, req eap.Packet) (eap.Packet, error) {
+	identifier := req.Identifier()
+	identity := string(req.TypeData())
+	if !eap.IsReauthIdentity(identity) && !eap.IsPseudonym(identity) {
+		return sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), nil
+	}
+	rc := ctx.GetReauth()
+	if identity[0] != eap.SimReauthIdPrefix || rc.GetReauthId() != identity || rc.GetEapType() != uint32(sim.TYPE) {
+		// pseudonym or unknown reauth identity, the peer may use a pseudonym, but not a reauth identity
+		return sim.NewStartReq(identifier+1, sim.AT_FULLAUTH_ID_REQ), nil
+	}
+	_, imsi, err := eap.DecodeTemporaryIdentity(identity)
+	if err != nil {
+		glog.Warningf("SIM reauth identity '%s' decoding error: %v", identity, err)
+		return sim.NewStartReq(identifier+1, sim.AT_FULLAUTH_ID_REQ), nil
+	}
+	if !s.CheckPlmnId(sim.IMSI(imsi)) {
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.PermissionDenied, "PLMN ID of IMSI: %s is not permitted", imsi)
+	}
+	metrics.ReauthRequests.Inc()
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+	}
+	ctx.Imsi = imsi
+	uc := s.InitSession(ctx.SessionId, sim.IMSI(imsi)) // we have Locked User Ctx after this call
+	uc.Identity = identity
+	uc.Identifier = identifier + 1
+	uc.MK, uc.K_aut, uc.K_encr = rc.GetMk(), rc.GetKAut(), rc.GetKEncr()
+	uc.Counter = uint16(rc.GetCounter()) + 1
+	uc.AuthSessionId = ctx.GetAuthSessionId()
+	if len(ctx.GetMsisdn()) > 0 {
+		uc.Profile = &protos.AuthenticationAnswer_UserProfile{Msisdn: ctx.GetMsisdn()}
+	}
+	uc.NextReauthId, err = eap.NewTemporaryIdentity(eap.SimReauthIdPrefix, imsi, eap.IdentityRealm(identity))
+	if err != nil {
+		glog.Errorf("failed to generate next reauth identity for IMSI %s: %v", imsi, err)
+	}
+	p, nonceS, err := aka.NewReauthReq(sim.TYPE, uc.Identifier, uc.Counter, uc.NextReauthId, uc.K_encr, uc.K_aut)
+	if err != nil {
+		metrics.FailedReauthRequests.Inc()
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, err.Error())
+	}
+	uc.NonceS = nonceS
+	uc.MSK, _ = aka.MakeReauthKeys([]byte(identity), uc.Counter, nonceS, uc.MK)
+	uc.SetState(sim.StateReauthentication)
+	s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+	return p, nil
+}
+
+// reauthResponse implements handler for SIM Re-authentication Response,
+// see https://tools.ietf.org/html/rfc4186#section-9.8 for details
+func reauthResponse(s *servicers.EapSimSrv, ctx *aaa_protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	defer func() {
+		if !success {
+			metrics.FailedReauthRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok || uc == nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", sessionId)
+	}
+	if state, _ := uc.State(); state != sim.StateReauthentication {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"SIM Reauthentication Response: Unexpected user state: %d for IMSI: %s, Session: %s", state, imsi, sessionId)
+	}
+	counter, tooSmall, err := aka.ParseReauthResp(req, uc.NonceS, uc.K_encr, uc.K_aut)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Unauthenticated,
+			"Invalid Reauthentication Response for Session ID: %s; IMSI: %s: %v", sessionId, imsi, err)
+	}
+	if counter != uc.Counter {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacketWithMac(identifier, sim.NOTIFICATION_FAILURE, uc.K_aut, codes.Unauthenticated,
+			"Invalid AT_COUNTER %d (expected: %d) for Session ID: %s; IMSI: %s", counter, uc.Counter, sessionId, imsi)
+	}
+	if tooSmall {
+		// The peer rejected the counter, fall back to full authentication, see RFC 4186, section 5.5
+		glog.Warningf("AT_COUNTER_TOO_SMALL for Session ID: %s; IMSI: %s, starting full authentication",
+			sessionId, imsi)
+		success = true
+		ctx.Reauth = nil
+		uc.SetState(sim.StateCreated)
+		s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+		return sim.NewStartReq(identifier+1, sim.AT_FULLAUTH_ID_REQ), nil
+	}
+
+	// All good, set IMSI, MSK & Identity for farther use by Radius and return SuccessCode
+	success = true
+	ctx.Imsi = string(imsi)
+	if uc.Profile != nil {
+		ctx.Msisdn = uc.Profile.Msisdn
+	}
+	ctx.AuthSessionId = uc.AuthSessionId
+	ctx.Msk = uc.MSK
+	ctx.Identity = uc.Identity
+	ctx.Reauth = newReauthContext(uc)
+	uc.SetState(sim.StateAuthenticated)
+
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return []byte{eap.SuccessCode, identifier, 0, 4}, nil
+}
+
+// newReauthContext returns the re-authentication context to be kept in the AAA session for the next
+// fast re-authentication or nil if no re-authentication identity was issued to the peer
+func newReauthContext(lockedCtx *servicers.UserCtx) *aaa_protos.ReauthContext {
+	if len(lockedCtx.NextReauthId) == 0 {
+		return nil
+	}
+	counter := lockedCtx.Counter
+	if counter == 0 {
+		counter = 1 // counter is initialized to one in full authentication
+	}
+	return &aaa_protos.ReauthContext{
+		ReauthId: lockedCtx.NextReauthId,
+		Counter:  uint32(counter),
+		Mk:       lockedCtx.MK,
+		KAut:     lockedCtx.K_aut,
+		KEncr:    lockedCtx.K_encr,
+		EapType:  uint32(sim.TYPE),
+	}
+}
@@ -15,6 +15,7 @@ limitations under the License.
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -67,6 +68,13 @@ func startResponse(s *servicers.EapSimSrv, ctx *protos.Context, req eap.Packet)
 		// Find first valid AT_IDENTITY attribute to get UE IMSI
 		case sim.AT_IDENTITY:
 			identity, imsi, err = getIMSIIdentity(a)
+			if err == errUnknownPseudonym {
+				// the pseudonym cannot be mapped to a permanent identity, request the permanent identity
+				glog.Warningf("SIM AT_IDENTITY '%s' is an unknown pseudonym", identity)
+				s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+				success = true
+				return sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), nil
+			}
 			if err != nil {
 				return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
 			}
@@ -168,6 +176,8 @@ func startResponse(s *servicers.EapSimSrv, ctx *protos.Context, req eap.Packet)
 	return p, err
 }
 
+var errUnknownPseudonym = errors.New("unknown pseudonym")
+
 // see https://tools.ietf.org/html/rfc4187#section-4.1.1.4
 func getIMSIIdentity(a eap.Attribute) (string, sim.IMSI, error) {
 	if a.Type() != sim.AT_IDENTITY {
@@ -189,5 +199,15 @@ func getIMSIIdentity(a eap.Attribute) (string, sim.IMSI, error) {
 	} else {
 		imsi = sim.IMSI(fullIdentity)
 	}
+	if len(imsi) > 0 && imsi[0] == eap.SimPseudonymPrefix {
+		// pseudonym, see https://tools.ietf.org/html/rfc4186#section-4.2.1.7
+		_, permanentImsi, err := eap.DecodeTemporaryIdentity(fullIdentity)
+		if err != nil {
+			glog.V(1).Infof("SIM pseudonym '%s' decoding error: %v", fullIdentity, err)
+			return fullIdentity, "", errUnknownPseudonym
+		}
+		// return the IMSI in the permanent identity format
+		imsi = sim.IMSI("1" + permanentImsi)
+	}
 	return fullIdentity, imsi, imsi.Validate()
 }
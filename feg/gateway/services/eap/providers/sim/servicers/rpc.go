@@ -60,7 +60,13 @@ func (s *EapSimSrv) HandleImpl(req *protos.Eap) (*protos.Eap, error) {
 	identifier := p.Identifier()
 	method := p.Type()
 	if method == eap.MethodIdentity {
-		return &protos.Eap{Payload: sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), Ctx: eapCtx}, nil
+		h := GetIdentityHandler()
+		if h == nil {
+			return &protos.Eap{Payload: sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), Ctx: eapCtx}, nil
+		}
+		rp, err := h(s, eapCtx, p)
+		failure = err != nil
+		return &protos.Eap{Payload: rp, Ctx: eapCtx}, err
 	}
 	if method != sim.TYPE {
 		return sim.EapErrorRes(
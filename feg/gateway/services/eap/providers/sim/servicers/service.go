@@ -43,9 +43,15 @@ type UserCtx struct {
 	Rand       [][]byte
 	Sres       [][]byte
 	K_aut,
+	K_encr,
+	MK,
 	MSK []byte
 	SessionId     string
 	AuthSessionId string
+	// Fast Re-authentication state
+	NonceS       []byte
+	Counter      uint16
+	NextReauthId string
 }
 
 type SessionCtx struct {
@@ -28,8 +28,9 @@ import (
 type Handler func(srvr *EapSimSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error)
 
 var simHandlers struct {
-	rwl sync.RWMutex
-	hm  map[sim.Subtype]Handler
+	rwl             sync.RWMutex
+	hm              map[sim.Subtype]Handler
+	identityHandler Handler
 }
 
 func AddHandler(st sim.Subtype, h Handler) {
@@ -49,6 +50,20 @@ func AddHandler(st sim.Subtype, h Handler) {
 	simHandlers.rwl.Unlock()
 }
 
+// SetIdentityHandler registers handler for EAP-Response/Identity packets
+func SetIdentityHandler(h Handler) {
+	simHandlers.rwl.Lock()
+	simHandlers.identityHandler = h
+	simHandlers.rwl.Unlock()
+}
+
+// GetIdentityHandler returns registered EAP-Response/Identity handler or nil if not set
+func GetIdentityHandler() Handler {
+	simHandlers.rwl.RLock()
+	defer simHandlers.rwl.RUnlock()
+	return simHandlers.identityHandler
+}
+
 func GetHandler(st sim.Subtype) Handler {
 	simHandlers.rwl.RLock()
 	defer simHandlers.rwl.RUnlock()
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eap
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Temporary identity (pseudonym & fast re-authentication identity) leading digits, see 3GPP TS 23.003, 19.3.2
+const (
+	AkaPseudonymPrefix byte = '2'
+	SimPseudonymPrefix byte = '3'
+	AkaReauthIdPrefix  byte = '4'
+	SimReauthIdPrefix  byte = '5'
+
+	// TempIdentityKeyEnv is the environment variable holding hex encoded AES key used to encrypt IMSIs into
+	// temporary identities. The key must be the same for all services decoding the temporary identities
+	// (EAP providers & AAA server)
+	TempIdentityKeyEnv = "EAP_TEMP_ID_KEY"
+)
+
+var tempIdentityCipher struct {
+	sync.Mutex
+	keyStr string
+	aead   cipher.AEAD
+}
+
+func getTempIdentityCipher() (cipher.AEAD, error) {
+	keyStr := os.Getenv(TempIdentityKeyEnv)
+	tempIdentityCipher.Lock()
+	defer tempIdentityCipher.Unlock()
+	if tempIdentityCipher.aead != nil && tempIdentityCipher.keyStr == keyStr {
+		return tempIdentityCipher.aead, nil
+	}
+	tempIdentityCipher.keyStr, tempIdentityCipher.aead = keyStr, nil
+	if len(keyStr) == 0 {
+		return nil, fmt.Errorf("temporary identities are disabled, %s is not set", TempIdentityKeyEnv)
+	}
+	key, err := hex.DecodeString(keyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value: %v", TempIdentityKeyEnv, err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value: %v", TempIdentityKeyEnv, err)
+	}
+	tempIdentityCipher.aead, err = cipher.NewGCM(block)
+	return tempIdentityCipher.aead, err
+}
+
+// TemporaryIdentitiesEnabled returns true if a valid temporary identity key is configured
+// and EAP providers should issue pseudonyms & fast re-authentication identities
+func TemporaryIdentitiesEnabled() bool {
+	_, err := getTempIdentityCipher()
+	return err == nil
+}
+
+// NewTemporaryIdentity returns a new temporary identity (pseudonym or fast re-authentication identity) for given IMSI.
+// The returned identity is <prefix><encrypted IMSI>[@realm], every call returns a different identity
+func NewTemporaryIdentity(prefix byte, imsi, realm string) (string, error) {
+	aead, err := getTempIdentityCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(imsi)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	token := aead.Seal(nonce, nonce, []byte(imsi), []byte{prefix})
+	identity := string(prefix) + base64.RawURLEncoding.EncodeToString(token)
+	if len(realm) > 0 {
+		identity += "@" + realm
+	}
+	return identity, nil
+}
+
+// DecodeTemporaryIdentity returns the temporary identity prefix & IMSI the identity was created for
+func DecodeTemporaryIdentity(identity string) (prefix byte, imsi string, err error) {
+	if atIdx := strings.Index(identity, "@"); atIdx >= 0 {
+		identity = identity[:atIdx]
+	}
+	if len(identity) < 2 {
+		return 0, "", fmt.Errorf("temporary identity '%s' is too short", identity)
+	}
+	prefix = identity[0]
+	token, err := base64.RawURLEncoding.DecodeString(identity[1:])
+	if err != nil {
+		return prefix, "", fmt.Errorf("malformed temporary identity '%s': %v", identity, err)
+	}
+	aead, err := getTempIdentityCipher()
+	if err != nil {
+		return prefix, "", err
+	}
+	if len(token) <= aead.NonceSize() {
+		return prefix, "", fmt.Errorf("temporary identity '%s' is too short", identity)
+	}
+	imsiBytes, err := aead.Open(nil, token[:aead.NonceSize()], token[aead.NonceSize():], []byte{prefix})
+	if err != nil {
+		return prefix, "", fmt.Errorf("invalid temporary identity '%s': %v", identity, err)
+	}
+	return prefix, string(imsiBytes), nil
+}
+
+// IsReauthIdentity returns true if the given identity has a fast re-authentication identity prefix
+func IsReauthIdentity(identity string) bool {
+	return len(identity) > 1 && (identity[0] == AkaReauthIdPrefix || identity[0] == SimReauthIdPrefix)
+}
+
+// IsPseudonym returns true if the given identity has a pseudonym prefix
+func IsPseudonym(identity string) bool {
+	return len(identity) > 1 && (identity[0] == AkaPseudonymPrefix || identity[0] == SimPseudonymPrefix)
+}
+
+// IdentityRealm returns realm part of the given NAI or an empty string if the NAI has no realm
+func IdentityRealm(identity string) string {
+	if atIdx := strings.Index(identity, "@"); atIdx >= 0 {
+		return identity[atIdx+1:]
+	}
+	return ""
+}
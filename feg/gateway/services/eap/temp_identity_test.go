@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eap
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemporaryIdentity(t *testing.T) {
+	os.Unsetenv(TempIdentityKeyEnv)
+	assert.False(t, TemporaryIdentitiesEnabled())
+	_, err := NewTemporaryIdentity(AkaPseudonymPrefix, "001010000000055", "")
+	assert.Error(t, err)
+
+	os.Setenv(TempIdentityKeyEnv, "not hex")
+	assert.False(t, TemporaryIdentitiesEnabled())
+
+	os.Setenv(TempIdentityKeyEnv, "000102030405060708090a0b0c0d0e0f")
+	defer os.Unsetenv(TempIdentityKeyEnv)
+	assert.True(t, TemporaryIdentitiesEnabled())
+
+	realm := "wlan.mnc001.mcc001.3gppnetwork.org"
+	id1, err := NewTemporaryIdentity(AkaReauthIdPrefix, "001010000000055", realm)
+	assert.NoError(t, err)
+	id2, err := NewTemporaryIdentity(AkaReauthIdPrefix, "001010000000055", realm)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id1, id2)
+	assert.True(t, strings.HasSuffix(id1, "@"+realm))
+	assert.Equal(t, realm, IdentityRealm(id1))
+	assert.True(t, IsReauthIdentity(id1))
+	assert.False(t, IsPseudonym(id1))
+
+	prefix, imsi, err := DecodeTemporaryIdentity(id1)
+	assert.NoError(t, err)
+	assert.Equal(t, AkaReauthIdPrefix, prefix)
+	assert.Equal(t, "001010000000055", imsi)
+
+	// prefix is authenticated, a reauth identity cannot be turned into a pseudonym
+	_, _, err = DecodeTemporaryIdentity(string(AkaPseudonymPrefix) + id1[1:])
+	assert.Error(t, err)
+
+	pseudonym, err := NewTemporaryIdentity(SimPseudonymPrefix, "001010000000056", "")
+	assert.NoError(t, err)
+	assert.True(t, IsPseudonym(pseudonym))
+	assert.Empty(t, IdentityRealm(pseudonym))
+	prefix, imsi, err = DecodeTemporaryIdentity(pseudonym)
+	assert.NoError(t, err)
+	assert.Equal(t, SimPseudonymPrefix, prefix)
+	assert.Equal(t, "001010000000056", imsi)
+
+	// different key
+	os.Setenv(TempIdentityKeyEnv, "0f0e0d0c0b0a09080706050403020100")
+	_, _, err = DecodeTemporaryIdentity(pseudonym)
+	assert.Error(t, err)
+
+	_, _, err = DecodeTemporaryIdentity("0001010000000055@" + realm)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rx_proxy provides a client API for notifying the Rx Proxy of IP-CAN session events
+package rx_proxy
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/registry"
+
+	"github.com/golang/glog"
+)
+
+const (
+	EnableRxEnv  = "ENABLE_RX"
+	EnableRxFlag = "enable_rx"
+)
+
+var (
+	_ = flag.Bool(EnableRxFlag, false, "Enable IP-CAN session event notifications to the Rx Proxy")
+)
+
+// IsRxEnabled returns true if session proxy should notify the Rx Proxy of IP-CAN session events
+func IsRxEnabled() bool {
+	return diameter.GetBoolValueOrEnv(EnableRxFlag, EnableRxEnv, false)
+}
+
+// Notifier notifies the Rx Proxy of the IP-CAN session events the AF sessions are bound to
+type Notifier struct{}
+
+// IPCANSessionEstablished notifies the Rx Proxy of the IP-CAN session's establishment
+func (Notifier) IPCANSessionEstablished(req *protos.IPCANSessionEstablishment) error {
+	if req == nil {
+		return errors.New("Invalid IPCANSessionEstablishment")
+	}
+	cli, err := getRxProxyClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.IPCANSessionEstablished(context.Background(), req)
+	return err
+}
+
+// IPCANSessionTerminated notifies the Rx Proxy of the IP-CAN session's termination
+func (Notifier) IPCANSessionTerminated(req *protos.IPCANSessionTermination) error {
+	if req == nil {
+		return errors.New("Invalid IPCANSessionTermination")
+	}
+	cli, err := getRxProxyClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.IPCANSessionTerminated(context.Background(), req)
+	return err
+}
+
+// NotifyBearerEvent notifies the Rx Proxy of a bearer event of the IP-CAN session
+func (Notifier) NotifyBearerEvent(req *protos.BearerEventNotification) error {
+	if req == nil {
+		return errors.New("Invalid BearerEventNotification")
+	}
+	cli, err := getRxProxyClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.NotifyBearerEvent(context.Background(), req)
+	return err
+}
+
+func getRxProxyClient() (protos.RxProxyClient, error) {
+	conn, err := registry.GetConnection(registry.RX_PROXY)
+	if err != nil {
+		errMsg := fmt.Sprintf("Rx Proxy client initialization error: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return protos.NewRxProxyClient(conn), nil
+}
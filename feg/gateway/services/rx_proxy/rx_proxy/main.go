@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Rx Proxy is a service terminating the Rx interface of IMS application functions
+// (P-CSCF). AF media component requests are converted into dynamic PCC rules
+// and pushed to the subscriber's sessions through the Gx reauth path. IP-CAN session
+// termination & bearer events reported by session proxy are relayed to the AF with ASR/RAR.
+package main
+
+import (
+	"flag"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/policydb"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/rx_proxy/servicers"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+	"magma/orc8r/lib/go/service"
+
+	"github.com/golang/glog"
+)
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.RX_PROXY)
+	if err != nil {
+		glog.Fatalf("Error creating Rx Proxy service: %s", err)
+	}
+
+	cloudReg := registry.Get()
+	policyDBClient, err := policydb.NewRedisPolicyDBClient(cloudReg)
+	if err != nil {
+		glog.Fatalf("Error connecting to redis store: %s", err)
+	}
+
+	rxServer := servicers.NewRxProxyServer(
+		servicers.GetRxProxyConfig(),
+		gx.GetSubscriberReAuthRelay(cloudReg, policyDBClient),
+	)
+	lis, err := rxServer.StartListener()
+	if err != nil {
+		glog.Fatalf("Unable to start Rx listener: %s", err)
+	}
+	go func() {
+		glog.V(2).Infof("Starting Rx server at %s", lis.Addr().String())
+		glog.Errorf(rxServer.Start(lis).Error()) // blocks
+	}()
+	// session proxy notifies the AF sessions' IP-CAN session events over gRPC
+	protos.RegisterRxProxyServer(srv.GrpcServer, rxServer)
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		glog.Fatalf("Error running Rx Proxy service: %s", err)
+	}
+}
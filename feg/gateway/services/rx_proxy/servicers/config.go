@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"strconv"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+)
+
+// Rx Environment Variables
+const (
+	RxProxyServiceName = "rx_proxy"
+
+	RxAddrEnv           = "RX_ADDR"
+	RxNetworkEnv        = "RX_NETWORK"
+	RxDiamHostEnv       = "RX_DIAM_HOST"
+	RxDiamRealmEnv      = "RX_DIAM_REALM"
+	RxDiamProductEnv    = "RX_DIAM_PRODUCT"
+	RxRulePrecedenceEnv = "RX_RULE_PRECEDENCE"
+
+	DefaultRxAddr           = ":3869"
+	DefaultRxRulePrecedence = 10
+)
+
+// RxProxyConfig holds the diameter identity & listening address of the Rx server and the
+// precedence of the dynamic rules it installs
+type RxProxyConfig struct {
+	ClientConfig   *diameter.DiameterClientConfig
+	ServerConfig   *diameter.DiameterServerConfig
+	RulePrecedence uint32
+}
+
+// GetRxProxyConfig returns the Rx server configuration read from the environment
+func GetRxProxyConfig() *RxProxyConfig {
+	precedence := uint32(DefaultRxRulePrecedence)
+	precedenceStr := diameter.GetValueOrEnv("", RxRulePrecedenceEnv, "")
+	if len(precedenceStr) > 0 {
+		value, err := strconv.ParseUint(precedenceStr, 10, 32)
+		if err != nil {
+			glog.Errorf("Invalid %s value '%s', using default: %d", RxRulePrecedenceEnv, precedenceStr, precedence)
+		} else {
+			precedence = uint32(value)
+		}
+	}
+	return &RxProxyConfig{
+		ClientConfig: &diameter.DiameterClientConfig{
+			Host:        diameter.GetValueOrEnv("", RxDiamHostEnv, diameter.DiamHost),
+			Realm:       diameter.GetValueOrEnv("", RxDiamRealmEnv, diameter.DiamRealm),
+			ProductName: diameter.GetValueOrEnv("", RxDiamProductEnv, diameter.DiamProductName),
+			AppID:       RxApplicationID,
+		},
+		ServerConfig: &diameter.DiameterServerConfig{
+			DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+				Addr:     diameter.GetValueOrEnv("", RxAddrEnv, DefaultRxAddr),
+				Protocol: diameter.GetValueOrEnv("", RxNetworkEnv, "tcp"),
+			},
+		},
+		RulePrecedence: precedence,
+	}
+}
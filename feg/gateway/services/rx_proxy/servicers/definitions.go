@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+)
+
+// Experimental-Result-Code values used in AAA & STA (3GPP TS 29.214 section 5.5)
+const (
+	DiameterInvalidServiceInformation     = 5061
+	DiameterRequestedServiceNotAuthorized = 5063
+	DiameterIPCANSessionNotAvailable      = 5065
+)
+
+type RxRequestType uint32
+
+const (
+	RxInitialRequest RxRequestType = 0
+	RxUpdateRequest  RxRequestType = 1
+)
+
+type MediaType uint32
+
+const (
+	MediaTypeAudio       MediaType = 0
+	MediaTypeVideo       MediaType = 1
+	MediaTypeData        MediaType = 2
+	MediaTypeApplication MediaType = 3
+	MediaTypeControl     MediaType = 4
+	MediaTypeText        MediaType = 5
+	MediaTypeMessage     MediaType = 6
+	MediaTypeOther       MediaType = 0xFFFFFFFF
+)
+
+type FlowStatus uint32
+
+const (
+	FlowStatusEnabledUplink   FlowStatus = 0
+	FlowStatusEnabledDownlink FlowStatus = 1
+	FlowStatusEnabled         FlowStatus = 2
+	FlowStatusDisabled        FlowStatus = 3
+	FlowStatusRemoved         FlowStatus = 4
+)
+
+type SpecificAction uint32
+
+const (
+	ChargingCorrelationExchange               SpecificAction = 1
+	IndicationOfLossOfBearer                  SpecificAction = 2
+	IndicationOfRecoveryOfBearer              SpecificAction = 3
+	IndicationOfReleaseOfBearer               SpecificAction = 4
+	IndicationOfSuccessfulResourcesAllocation SpecificAction = 8
+	IndicationOfFailedResourcesAllocation     SpecificAction = 9
+)
+
+// bearerEventActions maps the bearer events reported by session proxy to the specific actions
+// notified to the AF
+var bearerEventActions = map[protos.BearerEventNotification_BearerEvent]SpecificAction{
+	protos.BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION: IndicationOfSuccessfulResourcesAllocation,
+	protos.BearerEventNotification_LOSS_OF_BEARER:                 IndicationOfLossOfBearer,
+	protos.BearerEventNotification_RECOVERY_OF_BEARER:             IndicationOfRecoveryOfBearer,
+}
+
+type AbortCause uint32
+
+const (
+	AbortCauseBearerReleased              AbortCause = 0
+	AbortCauseInsufficientServerResources AbortCause = 1
+	AbortCauseInsufficientBearerResources AbortCause = 2
+)
+
+type SubscriptionID struct {
+	IDType credit_control.SubscriptionIDType `avp:"Subscription-Id-Type"`
+	IDData string                            `avp:"Subscription-Id-Data"`
+}
+
+// MediaSubComponent describes a single IP flow of a media component
+type MediaSubComponent struct {
+	FlowNumber       uint32      `avp:"Flow-Number"`
+	FlowDescriptions []string    `avp:"Flow-Description"`
+	FlowStatus       *FlowStatus `avp:"Flow-Status"`
+	MaxReqBwUL       *uint32     `avp:"Max-Requested-Bandwidth-UL"`
+	MaxReqBwDL       *uint32     `avp:"Max-Requested-Bandwidth-DL"`
+}
+
+// MediaComponentDescription describes a media component (e.g. the audio stream) of an AF session
+type MediaComponentDescription struct {
+	MediaComponentNumber uint32               `avp:"Media-Component-Number"`
+	MediaSubComponents   []*MediaSubComponent `avp:"Media-Sub-Component"`
+	AFApplicationID      datatype.OctetString `avp:"AF-Application-Identifier"`
+	MediaType            *MediaType           `avp:"Media-Type"`
+	MaxReqBwUL           *uint32              `avp:"Max-Requested-Bandwidth-UL"`
+	MaxReqBwDL           *uint32              `avp:"Max-Requested-Bandwidth-DL"`
+	FlowStatus           *FlowStatus          `avp:"Flow-Status"`
+}
+
+// <AA-Request> ::= < Diameter Header: 265, REQ, PXY >
+//
+//	 < Session-Id >
+//	 { Auth-Application-Id }
+//	 { Origin-Host }
+//	 { Origin-Realm }
+//	 { Destination-Realm }
+//	 [ Destination-Host ]
+//	 [ AF-Application-Identifier ]
+//	*[ Media-Component-Description ]
+//	*[ Specific-Action ]
+//	*[ Subscription-Id ]
+//	 [ Framed-IP-Address ]
+//	 [ Rx-Request-Type ]
+//	 [ Origin-State-Id ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type AARequest struct {
+	SessionID                  string                       `avp:"Session-Id"`
+	OriginHost                 datatype.DiameterIdentity    `avp:"Origin-Host"`
+	OriginRealm                datatype.DiameterIdentity    `avp:"Origin-Realm"`
+	AFApplicationID            datatype.OctetString         `avp:"AF-Application-Identifier"`
+	MediaComponentDescriptions []*MediaComponentDescription `avp:"Media-Component-Description"`
+	SpecificActions            []SpecificAction             `avp:"Specific-Action"`
+	SubscriptionIDs            []*SubscriptionID            `avp:"Subscription-Id"`
+	FramedIPAddress            datatype.OctetString         `avp:"Framed-IP-Address"`
+	RxRequestType              RxRequestType                `avp:"Rx-Request-Type"`
+}
+
+// <Session-Termination-Request> ::= < Diameter Header: 275, REQ, PXY >
+//
+//	< Session-Id >
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Realm }
+//	{ Auth-Application-Id }
+//	{ Termination-Cause }
+type SessionTerminationRequest struct {
+	SessionID        string                    `avp:"Session-Id"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	TerminationCause uint32                    `avp:"Termination-Cause"`
+}
+
+// AnswerResult holds the result of an AF request or of a request sent to the AF
+type AnswerResult struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+}
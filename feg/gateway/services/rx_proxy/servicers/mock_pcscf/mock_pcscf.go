@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock_pcscf implements a mock P-CSCF driving the Rx interface: it sends AAR & STR
+// requests & records the RAR & ASR requests received from the PCRF
+package mock_pcscf
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/servicers"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+)
+
+const answerTimeout = 5 * time.Second
+
+// AAR describes an AA-Request to send
+type AAR struct {
+	SessionID       string
+	IMSI            string
+	FramedIP        net.IP
+	Type            servicers.RxRequestType
+	MediaComponents []*servicers.MediaComponentDescription
+	SpecificActions []servicers.SpecificAction
+}
+
+// Answer holds the result of an AAR or STR
+type Answer struct {
+	SessionID              string
+	ResultCode             uint32
+	ExperimentalResultCode uint32
+}
+
+// ServerRequest is a RAR or ASR received from the PCRF
+type ServerRequest struct {
+	Command         uint32
+	SessionID       string
+	SpecificActions []servicers.SpecificAction
+	AbortCause      *servicers.AbortCause
+}
+
+type answerMessage struct {
+	SessionID          string `avp:"Session-Id"`
+	ResultCode         uint32 `avp:"Result-Code"`
+	ExperimentalResult struct {
+		ExperimentalResultCode uint32 `avp:"Experimental-Result-Code"`
+	} `avp:"Experimental-Result"`
+}
+
+type serverRequestMessage struct {
+	SessionID       string                     `avp:"Session-Id"`
+	SpecificActions []servicers.SpecificAction `avp:"Specific-Action"`
+	AbortCause      *servicers.AbortCause      `avp:"Abort-Cause"`
+}
+
+type answerKey struct {
+	command   uint32
+	sessionID string
+}
+
+// MockPCSCF is an Rx client acting as a P-CSCF
+type MockPCSCF struct {
+	client    *diameter.Client
+	serverCfg *diameter.DiameterServerConfig
+	requests  chan *ServerRequest
+}
+
+// NewMockPCSCF creates a P-CSCF connected to the given Rx server. RARs & ASRs received are
+// answered with DIAMETER_SUCCESS & can be retrieved with WaitForRequest
+func NewMockPCSCF(clientCfg *diameter.DiameterClientConfig, serverCfg *diameter.DiameterServerConfig) *MockPCSCF {
+	pcscf := &MockPCSCF{
+		client:    diameter.NewClient(clientCfg),
+		serverCfg: serverCfg,
+		requests:  make(chan *ServerRequest, 16),
+	}
+	pcscf.client.RegisterAnswerHandlerForAppID(diam.AA, servicers.RxApplicationID, getAnswerHandler(diam.AA))
+	pcscf.client.RegisterAnswerHandlerForAppID(
+		diam.SessionTermination, servicers.RxApplicationID, getAnswerHandler(diam.SessionTermination))
+	pcscf.client.RegisterRequestHandlerForAppID(diam.ReAuth, servicers.RxApplicationID, pcscf.getRequestHandler())
+	pcscf.client.RegisterRequestHandlerForAppID(diam.AbortSession, servicers.RxApplicationID, pcscf.getRequestHandler())
+	return pcscf
+}
+
+// SendAAR sends an AA-Request & waits for the answer
+func (pcscf *MockPCSCF) SendAAR(aar *AAR) (*Answer, error) {
+	m := newRequest(diam.AA, aar.SessionID)
+	if len(aar.IMSI) > 0 {
+		m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(credit_control.EndUserIMSI)),
+				diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(aar.IMSI)),
+			},
+		})
+	}
+	if ip := aar.FramedIP.To4(); ip != nil {
+		m.NewAVP(avp.FramedIPAddress, avp.Mbit, 0, datatype.OctetString(ip))
+	}
+	m.NewAVP(servicers.RxRequestTypeAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(aar.Type))
+	for _, component := range aar.MediaComponents {
+		m.AddAVP(toMediaComponentDescriptionAVP(component))
+	}
+	for _, action := range aar.SpecificActions {
+		m.NewAVP(servicers.SpecificActionAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(action))
+	}
+	return pcscf.sendRequest(m, answerKey{command: diam.AA, sessionID: aar.SessionID})
+}
+
+// SendSTR sends a Session-Termination-Request for the AF session & waits for the answer
+func (pcscf *MockPCSCF) SendSTR(sessionID string) (*Answer, error) {
+	m := newRequest(diam.SessionTermination, sessionID)
+	// Termination-Cause: DIAMETER_LOGOUT
+	m.NewAVP(avp.TerminationCause, avp.Mbit, 0, datatype.Enumerated(1))
+	return pcscf.sendRequest(m, answerKey{command: diam.SessionTermination, sessionID: sessionID})
+}
+
+// WaitForRequest returns the next RAR or ASR received from the PCRF
+func (pcscf *MockPCSCF) WaitForRequest(timeout time.Duration) (*ServerRequest, error) {
+	select {
+	case request := <-pcscf.requests:
+		return request, nil
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("No request received from PCRF")
+	}
+}
+
+func (pcscf *MockPCSCF) sendRequest(m *diam.Message, key answerKey) (*Answer, error) {
+	done := make(chan interface{}, 1)
+	glog.V(2).Infof("Sending Rx request:\n%s\n", m)
+	err := pcscf.client.SendRequest(pcscf.serverCfg, done, m, key)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case answer := <-done:
+		return answer.(*Answer), nil
+	case <-time.After(answerTimeout):
+		pcscf.client.IgnoreAnswer(key)
+		return nil, fmt.Errorf("No answer received for session %s", key.sessionID)
+	}
+}
+
+func (pcscf *MockPCSCF) getRequestHandler() diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received Rx request:\n%s\n", m)
+		var request serverRequestMessage
+		if err := m.Unmarshal(&request); err != nil {
+			glog.Errorf("Received unparseable Rx request: %s", err)
+			return
+		}
+		a := m.Answer(diam.Success)
+		a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(request.SessionID)))
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(pcscf.client.OriginHost()))
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(pcscf.client.OriginRealm()))
+		if _, err := a.WriteTo(c); err != nil {
+			glog.Errorf("Failed to answer Rx request: %s", err)
+		}
+		pcscf.requests <- &ServerRequest{
+			Command:         m.Header.CommandCode,
+			SessionID:       request.SessionID,
+			SpecificActions: request.SpecificActions,
+			AbortCause:      request.AbortCause,
+		}
+	}
+}
+
+func getAnswerHandler(command uint32) diameter.AnswerHandler {
+	return func(message *diam.Message) diameter.KeyAndAnswer {
+		var answer answerMessage
+		if err := message.Unmarshal(&answer); err != nil {
+			glog.Errorf("Received unparseable Rx answer: %s", err)
+			return diameter.KeyAndAnswer{}
+		}
+		return diameter.KeyAndAnswer{
+			Key: answerKey{command: command, sessionID: answer.SessionID},
+			Answer: &Answer{
+				SessionID:              answer.SessionID,
+				ResultCode:             answer.ResultCode,
+				ExperimentalResultCode: answer.ExperimentalResult.ExperimentalResultCode,
+			},
+		}
+	}
+}
+
+func newRequest(command uint32, sessionID string) *diam.Message {
+	m := diameter.NewProxiableRequest(command, servicers.RxApplicationID, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(servicers.RxApplicationID))
+	return m
+}
+
+func toMediaComponentDescriptionAVP(component *servicers.MediaComponentDescription) *diam.AVP {
+	avps := []*diam.AVP{
+		diam.NewAVP(servicers.MediaComponentNumberAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(component.MediaComponentNumber)),
+	}
+	for _, subComponent := range component.MediaSubComponents {
+		subAVPs := []*diam.AVP{
+			diam.NewAVP(servicers.FlowNumberAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+				datatype.Unsigned32(subComponent.FlowNumber)),
+		}
+		for _, description := range subComponent.FlowDescriptions {
+			subAVPs = append(subAVPs, diam.NewAVP(servicers.FlowDescriptionAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+				datatype.IPFilterRule(description)))
+		}
+		subAVPs = appendFlowAVPs(subAVPs, subComponent.FlowStatus, subComponent.MaxReqBwUL, subComponent.MaxReqBwDL)
+		avps = append(avps, diam.NewAVP(servicers.MediaSubComponentAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			&diam.GroupedAVP{AVP: subAVPs}))
+	}
+	if component.MediaType != nil {
+		avps = append(avps, diam.NewAVP(servicers.MediaTypeAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Enumerated(*component.MediaType)))
+	}
+	avps = appendFlowAVPs(avps, component.FlowStatus, component.MaxReqBwUL, component.MaxReqBwDL)
+	return diam.NewAVP(servicers.MediaComponentDescriptionAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+		&diam.GroupedAVP{AVP: avps})
+}
+
+func appendFlowAVPs(avps []*diam.AVP, status *servicers.FlowStatus, maxReqBwUL, maxReqBwDL *uint32) []*diam.AVP {
+	if status != nil {
+		avps = append(avps, diam.NewAVP(servicers.FlowStatusAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Enumerated(*status)))
+	}
+	if maxReqBwUL != nil {
+		avps = append(avps, diam.NewAVP(servicers.MaxRequestedBandwidthULAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(*maxReqBwUL)))
+	}
+	if maxReqBwDL != nil {
+		avps = append(avps, diam.NewAVP(servicers.MaxRequestedBandwidthDLAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(*maxReqBwDL)))
+	}
+	return avps
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+)
+
+// QoS Class Identifiers used for IMS media (3GPP TS 29.213 section 6.3)
+const (
+	conversationalVoiceQCI = 1
+	conversationalVideoQCI = 2
+	defaultMediaQCI        = 9
+)
+
+// ToRuleDefinitions converts the media components of an AF session into dynamic PCC rules,
+// one rule per media sub component. Removed or disabled flows are not converted
+func ToRuleDefinitions(
+	afSessionID string,
+	components []*MediaComponentDescription,
+	precedence uint32,
+) []*gx.RuleDefinition {
+	rules := []*gx.RuleDefinition{}
+	for _, component := range components {
+		for _, subComponent := range component.MediaSubComponents {
+			flowDescriptions := getEnabledFlowDescriptions(component, subComponent)
+			if len(flowDescriptions) == 0 {
+				continue
+			}
+			rules = append(rules, &gx.RuleDefinition{
+				RuleName:         GetRuleName(afSessionID, component.MediaComponentNumber, subComponent.FlowNumber),
+				Precedence:       precedence,
+				FlowDescriptions: flowDescriptions,
+				Qos:              getQosInformation(component, subComponent),
+			})
+		}
+	}
+	return rules
+}
+
+// GetRuleName returns the name of the dynamic rule installed for a media sub component of an
+// AF session
+func GetRuleName(afSessionID string, mediaComponentNumber, flowNumber uint32) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(afSessionID))
+	return fmt.Sprintf("rx-%08x-%d-%d", hash.Sum32(), mediaComponentNumber, flowNumber)
+}
+
+// GetRuleNames returns the names of the given rules
+func GetRuleNames(rules []*gx.RuleDefinition) []string {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.RuleName)
+	}
+	return names
+}
+
+// GetIMSIFromSubscriptionIDs returns the IMSI (with IMSI prefix) found in the subscription IDs
+// or an empty string if there is none
+func GetIMSIFromSubscriptionIDs(subscriptionIDs []*SubscriptionID) string {
+	for _, subID := range subscriptionIDs {
+		if subID.IDType == credit_control.EndUserIMSI && len(subID.IDData) > 0 {
+			return withIMSIPrefix(subID.IDData)
+		}
+	}
+	return ""
+}
+
+func withIMSIPrefix(imsi string) string {
+	return "IMSI" + strings.TrimPrefix(imsi, "IMSI")
+}
+
+// getEnabledFlowDescriptions returns the flow descriptions of the sub component enabled by its
+// flow status, the sub component status overrides the media component one. Rx flow
+// descriptions use "in" for uplink & "out" for downlink flows
+func getEnabledFlowDescriptions(component *MediaComponentDescription, subComponent *MediaSubComponent) []string {
+	status := FlowStatusEnabled
+	if subComponent.FlowStatus != nil {
+		status = *subComponent.FlowStatus
+	} else if component.FlowStatus != nil {
+		status = *component.FlowStatus
+	}
+	descriptions := []string{}
+	for _, description := range subComponent.FlowDescriptions {
+		switch {
+		case status == FlowStatusEnabled,
+			status == FlowStatusEnabledUplink && strings.HasPrefix(description, "permit in"),
+			status == FlowStatusEnabledDownlink && strings.HasPrefix(description, "permit out"):
+			descriptions = append(descriptions, description)
+		}
+	}
+	return descriptions
+}
+
+// getQosInformation derives the QoS of a media sub component, sub component bandwidths override
+// the media component ones. Voice & video flows get a guaranteed bitrate equal to the requested one
+func getQosInformation(component *MediaComponentDescription, subComponent *MediaSubComponent) *gx.QosInformation {
+	maxReqBwUL, maxReqBwDL := component.MaxReqBwUL, component.MaxReqBwDL
+	if subComponent.MaxReqBwUL != nil {
+		maxReqBwUL = subComponent.MaxReqBwUL
+	}
+	if subComponent.MaxReqBwDL != nil {
+		maxReqBwDL = subComponent.MaxReqBwDL
+	}
+	qci := uint32(defaultMediaQCI)
+	if component.MediaType != nil {
+		switch *component.MediaType {
+		case MediaTypeAudio:
+			qci = conversationalVoiceQCI
+		case MediaTypeVideo:
+			qci = conversationalVideoQCI
+		}
+	}
+	qos := &gx.QosInformation{
+		MaxReqBwUL: maxReqBwUL,
+		MaxReqBwDL: maxReqBwDL,
+		Qci:        &qci,
+	}
+	if qci != defaultMediaQCI {
+		qos.GbrUL = maxReqBwUL
+		qos.GbrDL = maxReqBwDL
+	}
+	return qos
+}
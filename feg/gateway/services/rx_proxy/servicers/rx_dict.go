@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"bytes"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+)
+
+// Rx application & AVPs (3GPP TS 29.214), go-diameter does not define the Rx application
+const (
+	RxApplicationID = 16777236
+
+	AbortCauseAVP                = 500
+	AFApplicationIdentifierAVP   = 504
+	FlowDescriptionAVP           = 507
+	FlowNumberAVP                = 509
+	FlowStatusAVP                = 511
+	SpecificActionAVP            = 513
+	MaxRequestedBandwidthDLAVP   = 515
+	MaxRequestedBandwidthULAVP   = 516
+	MediaComponentDescriptionAVP = 517
+	MediaComponentNumberAVP      = 518
+	MediaSubComponentAVP         = 519
+	MediaTypeAVP                 = 520
+	RxRequestTypeAVP             = 533
+)
+
+// rxDictExtension defines AAR/AAA & the Rx AVPs needed to describe media components, see
+// 3GPP TS 29.214 sections 5.6 & 5.3. RAR, ASR & STR use the base protocol commands.
+// Subscription-Id & Framed-IP-Address AVPs are only defined for other applications in the default
+// dictionary and are redefined here, so they can be parsed in Rx messages
+const rxDictExtension = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+    <application id="16777236" type="auth" name="Diameter Rx">
+        <vendor id="10415" name="TGPP"/>
+
+        <command code="265" short="AA" name="AA">
+            <request>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Auth-Application-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Destination-Realm" required="true" max="1"/>
+                <rule avp="Destination-Host" required="false" max="1"/>
+                <rule avp="AF-Application-Identifier" required="false" max="1"/>
+                <rule avp="Media-Component-Description" required="false"/>
+                <rule avp="Specific-Action" required="false"/>
+                <rule avp="Subscription-Id" required="false"/>
+                <rule avp="Framed-IP-Address" required="false" max="1"/>
+                <rule avp="Rx-Request-Type" required="false" max="1"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="Proxy-Info" required="false"/>
+                <rule avp="Route-Record" required="false"/>
+            </request>
+            <answer>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Auth-Application-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Result-Code" required="false" max="1"/>
+                <rule avp="Experimental-Result" required="false" max="1"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="Error-Message" required="false" max="1"/>
+                <rule avp="Error-Reporting-Host" required="false" max="1"/>
+                <rule avp="Failed-AVP" required="false" max="1"/>
+                <rule avp="Proxy-Info" required="false"/>
+            </answer>
+        </command>
+
+        <avp name="Abort-Cause" code="500" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="BEARER_RELEASED"/>
+                <item code="1" name="INSUFFICIENT_SERVER_RESOURCES"/>
+                <item code="2" name="INSUFFICIENT_BEARER_RESOURCES"/>
+            </data>
+        </avp>
+        <avp name="AF-Application-Identifier" code="504" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="OctetString"/>
+        </avp>
+        <avp name="Flow-Description" code="507" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="IPFilterRule"/>
+        </avp>
+        <avp name="Flow-Number" code="509" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+        <avp name="Flow-Status" code="511" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="ENABLED-UPLINK"/>
+                <item code="1" name="ENABLED-DOWNLINK"/>
+                <item code="2" name="ENABLED"/>
+                <item code="3" name="DISABLED"/>
+                <item code="4" name="REMOVED"/>
+            </data>
+        </avp>
+        <avp name="Specific-Action" code="513" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="1" name="CHARGING_CORRELATION_EXCHANGE"/>
+                <item code="2" name="INDICATION_OF_LOSS_OF_BEARER"/>
+                <item code="3" name="INDICATION_OF_RECOVERY_OF_BEARER"/>
+                <item code="4" name="INDICATION_OF_RELEASE_OF_BEARER"/>
+                <item code="6" name="IP-CAN_CHANGE"/>
+                <item code="7" name="INDICATION_OF_OUT_OF_CREDIT"/>
+                <item code="8" name="INDICATION_OF_SUCCESSFUL_RESOURCES_ALLOCATION"/>
+                <item code="9" name="INDICATION_OF_FAILED_RESOURCES_ALLOCATION"/>
+            </data>
+        </avp>
+        <avp name="Max-Requested-Bandwidth-DL" code="515" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+        <avp name="Max-Requested-Bandwidth-UL" code="516" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+        <avp name="Media-Component-Description" code="517" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="Media-Component-Number" required="true" max="1"/>
+                <rule avp="Media-Sub-Component" required="false"/>
+                <rule avp="AF-Application-Identifier" required="false" max="1"/>
+                <rule avp="Media-Type" required="false" max="1"/>
+                <rule avp="Max-Requested-Bandwidth-UL" required="false" max="1"/>
+                <rule avp="Max-Requested-Bandwidth-DL" required="false" max="1"/>
+                <rule avp="Flow-Status" required="false" max="1"/>
+            </data>
+        </avp>
+        <avp name="Media-Component-Number" code="518" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+        <avp name="Media-Sub-Component" code="519" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="Flow-Number" required="true" max="1"/>
+                <rule avp="Flow-Description" required="false" max="2"/>
+                <rule avp="Flow-Status" required="false" max="1"/>
+                <rule avp="Max-Requested-Bandwidth-UL" required="false" max="1"/>
+                <rule avp="Max-Requested-Bandwidth-DL" required="false" max="1"/>
+            </data>
+        </avp>
+        <avp name="Media-Type" code="520" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="AUDIO"/>
+                <item code="1" name="VIDEO"/>
+                <item code="2" name="DATA"/>
+                <item code="3" name="APPLICATION"/>
+                <item code="4" name="CONTROL"/>
+                <item code="5" name="TEXT"/>
+                <item code="6" name="MESSAGE"/>
+            </data>
+        </avp>
+        <avp name="Rx-Request-Type" code="533" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="INITIAL_REQUEST"/>
+                <item code="1" name="UPDATE_REQUEST"/>
+            </data>
+        </avp>
+
+        <avp name="Framed-IP-Address" code="8" must="M" may="-" must-not="V" may-encrypt="Y">
+            <data type="OctetString"/>
+        </avp>
+        <avp name="Subscription-Id" code="443" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="Grouped">
+                <rule avp="Subscription-Id-Type" required="true" max="1"/>
+                <rule avp="Subscription-Id-Data" required="true" max="1"/>
+            </data>
+        </avp>
+        <avp name="Subscription-Id-Data" code="444" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="UTF8String"/>
+        </avp>
+        <avp name="Subscription-Id-Type" code="450" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="Enumerated">
+                <item code="0" name="END_USER_E164"/>
+                <item code="1" name="END_USER_IMSI"/>
+                <item code="2" name="END_USER_SIP_URI"/>
+                <item code="3" name="END_USER_NAI"/>
+                <item code="4" name="END_USER_PRIVATE"/>
+            </data>
+        </avp>
+    </application>
+</diameter>`
+
+func init() {
+	err := dict.Default.Load(bytes.NewReader([]byte(rxDictExtension)))
+	if err != nil {
+		panic(err)
+	}
+}
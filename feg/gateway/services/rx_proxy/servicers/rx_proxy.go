@@ -0,0 +1,423 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+	orcprotos "magma/orc8r/lib/go/protos"
+)
+
+// RxProxyServer terminates the Rx interface of IMS application functions (P-CSCF).
+// Media components requested by the AF are converted into dynamic PCC rules which are
+// pushed to the subscriber's sessions through the Gx policy reauth path. The AF is notified
+// of the IP-CAN session's termination & bearer events reported by session proxy over gRPC
+type RxProxyServer struct {
+	config *RxProxyConfig
+	relay  gx.SubscriberReAuthRelay
+	mux    *sm.StateMachine
+	lock   sync.Mutex
+	// AF sessions by Rx session ID
+	sessions map[string]*afSession
+	// IMSIs of the established IP-CAN sessions by UE IPv4 address
+	ueIMSIs map[string]string
+}
+
+// afSession tracks the rules installed for an AF session & the AF connection to send RAR/ASR on
+type afSession struct {
+	sessionID string
+	imsi      string
+	// framedIP is the UE IP address the AF session is bound to, empty if the AF didn't provide it
+	framedIP        string
+	ruleNames       []string
+	specificActions []SpecificAction
+	connection      diam.Conn
+}
+
+// NewRxProxyServer creates an Rx server relaying rule changes with the given relay
+func NewRxProxyServer(config *RxProxyConfig, relay gx.SubscriberReAuthRelay) *RxProxyServer {
+	srv := &RxProxyServer{
+		config:   config,
+		relay:    relay,
+		sessions: map[string]*afSession{},
+		ueIMSIs:  map[string]string{},
+	}
+	srv.mux = sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(config.ClientConfig.Host),
+		OriginRealm:      datatype.DiameterIdentity(config.ClientConfig.Realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(config.ClientConfig.ProductName),
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	srv.mux.HandleIdx(diam.CommandIndex{AppID: RxApplicationID, Code: diam.AA, Request: true}, srv.getAARHandler())
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: RxApplicationID, Code: diam.SessionTermination, Request: true}, srv.getSTRHandler())
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: RxApplicationID, Code: diam.ReAuth, Request: false}, getAnswerHandler("RAA"))
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: RxApplicationID, Code: diam.AbortSession, Request: false}, getAnswerHandler("ASA"))
+	go logErrors(srv.mux.ErrorReports())
+	return srv
+}
+
+// Start begins the server and blocks, listening to the network
+// Output: error if the server could not be started
+func (srv *RxProxyServer) Start(lis net.Listener) error {
+	server := &diam.Server{
+		Network: srv.config.ServerConfig.Protocol,
+		Addr:    srv.config.ServerConfig.Addr,
+		Handler: srv.mux,
+		Dict:    nil,
+	}
+	return server.Serve(lis)
+}
+
+// StartListener starts a listener based on the server configuration
+func (srv *RxProxyServer) StartListener() (net.Listener, error) {
+	return diam.Listen(srv.config.ServerConfig.Protocol, srv.config.ServerConfig.Addr)
+}
+
+// IPCANSessionEstablished binds the UE IP address of the established IP-CAN session to its
+// subscriber, so AF sessions identified by their Framed-IP-Address only can be bound to it
+func (srv *RxProxyServer) IPCANSessionEstablished(
+	ctx context.Context, req *protos.IPCANSessionEstablishment) (*orcprotos.Void, error) {
+	if req == nil || len(req.GetImsi()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing IMSI of the established IP-CAN session")
+	}
+	if len(req.GetUeIpv4()) == 0 {
+		return &orcprotos.Void{}, nil
+	}
+	srv.lock.Lock()
+	srv.ueIMSIs[req.GetUeIpv4()] = withIMSIPrefix(req.GetImsi())
+	srv.lock.Unlock()
+	return &orcprotos.Void{}, nil
+}
+
+// IPCANSessionTerminated aborts the AF sessions bound to the terminated IP-CAN session
+func (srv *RxProxyServer) IPCANSessionTerminated(
+	ctx context.Context, req *protos.IPCANSessionTermination) (*orcprotos.Void, error) {
+	if req == nil || len(req.GetImsi()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing IMSI of the terminated IP-CAN session")
+	}
+	srv.lock.Lock()
+	if srv.ueIMSIs[req.GetUeIpv4()] == withIMSIPrefix(req.GetImsi()) {
+		delete(srv.ueIMSIs, req.GetUeIpv4())
+	}
+	srv.lock.Unlock()
+	err := srv.AbortSubscriberSessions(req.GetImsi(), req.GetUeIpv4(), AbortCauseBearerReleased)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &orcprotos.Void{}, nil
+}
+
+// NotifyBearerEvent notifies the AF sessions bound to the IP-CAN session of a bearer event
+// they subscribed to
+func (srv *RxProxyServer) NotifyBearerEvent(
+	ctx context.Context, req *protos.BearerEventNotification) (*orcprotos.Void, error) {
+	if req == nil || len(req.GetImsi()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing IMSI of the bearer event")
+	}
+	action, found := bearerEventActions[req.GetEvent()]
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported bearer event %s", req.GetEvent())
+	}
+	if err := srv.NotifySpecificAction(req.GetImsi(), req.GetUeIpv4(), action); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &orcprotos.Void{}, nil
+}
+
+// NotifySpecificAction sends a RAR with the given specific action to the AF sessions bound to the
+// subscriber's IP-CAN session which subscribed to it, ie. on loss of the voice bearer. All the
+// sessions of the subscriber are notified if ueIPv4 is empty
+// Output: error if a session subscribed but the RAR could not be sent
+func (srv *RxProxyServer) NotifySpecificAction(imsi, ueIPv4 string, action SpecificAction) error {
+	for _, session := range srv.getSubscriberSessions(imsi, ueIPv4) {
+		if !session.isSubscribedTo(action) {
+			continue
+		}
+		m := srv.newRequest(diam.ReAuth, session)
+		// Re-Auth-Request-Type: AUTHORIZE_ONLY
+		m.NewAVP(avp.ReAuthRequestType, avp.Mbit, 0, datatype.Enumerated(0))
+		m.NewAVP(SpecificActionAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(action))
+		glog.V(2).Infof("Sending Rx RAR to %s\n%s", session.connection.RemoteAddr(), m)
+		if _, err := m.WriteTo(session.connection); err != nil {
+			return fmt.Errorf("Failed to send Rx RAR for session %s: %s", session.sessionID, err)
+		}
+	}
+	return nil
+}
+
+// AbortSubscriberSessions sends an ASR to the AF sessions bound to the subscriber's IP-CAN
+// session once it's terminated, to all the sessions of the subscriber if ueIPv4 is empty.
+// The AF is expected to terminate the sessions with an STR
+// Output: error if an ASR could not be sent
+func (srv *RxProxyServer) AbortSubscriberSessions(imsi, ueIPv4 string, cause AbortCause) error {
+	for _, session := range srv.getSubscriberSessions(imsi, ueIPv4) {
+		m := srv.newRequest(diam.AbortSession, session)
+		m.NewAVP(AbortCauseAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(cause))
+		glog.V(2).Infof("Sending Rx ASR to %s\n%s", session.connection.RemoteAddr(), m)
+		if _, err := m.WriteTo(session.connection); err != nil {
+			return fmt.Errorf("Failed to send Rx ASR for session %s: %s", session.sessionID, err)
+		}
+	}
+	return nil
+}
+
+// getAARHandler returns a handler installing the rules of the AAR media components & removing the
+// rules of the components no longer present, for both initial & update requests
+func (srv *RxProxyServer) getAARHandler() diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received Rx AAR message\n%s\n", m)
+		var aar AARequest
+		if err := m.Unmarshal(&aar); err != nil {
+			glog.Errorf("Failed to unmarshal Rx AAR: %s", err)
+			srv.sendAnswer(c, m, aar.SessionID, diam.UnableToComply, 0)
+			return
+		}
+		srv.lock.Lock()
+		existing, found := srv.sessions[aar.SessionID]
+		srv.lock.Unlock()
+
+		session := &afSession{sessionID: aar.SessionID, specificActions: aar.SpecificActions, connection: c}
+		var previousRules []string
+		if found {
+			previousRules = existing.ruleNames
+		}
+		if aar.RxRequestType == RxUpdateRequest {
+			if !found {
+				srv.sendAnswer(c, m, aar.SessionID, diam.UnknownSessionID, 0)
+				return
+			}
+			session.imsi, session.framedIP = existing.imsi, existing.framedIP
+			if len(aar.SpecificActions) == 0 {
+				session.specificActions = existing.specificActions
+			}
+		} else {
+			session.imsi = GetIMSIFromSubscriptionIDs(aar.SubscriptionIDs)
+			if len(aar.FramedIPAddress) == net.IPv4len {
+				session.framedIP = net.IP(aar.FramedIPAddress).String()
+			}
+			if len(session.imsi) == 0 && len(session.framedIP) > 0 {
+				srv.lock.Lock()
+				session.imsi = srv.ueIMSIs[session.framedIP]
+				srv.lock.Unlock()
+			}
+		}
+		if len(session.imsi) == 0 {
+			// sessions can only be bound to the IP-CAN session by IMSI
+			glog.Errorf("No IMSI in Rx AAR or IP-CAN session of its Framed-IP-Address for session %s", aar.SessionID)
+			srv.sendAnswer(c, m, aar.SessionID, 0, DiameterIPCANSessionNotAvailable)
+			return
+		}
+
+		rules := ToRuleDefinitions(aar.SessionID, aar.MediaComponentDescriptions, srv.config.RulePrecedence)
+		session.ruleNames = GetRuleNames(rules)
+		reAuthAnswer := srv.updateRules(session, rules, getRemovedRules(previousRules, session.ruleNames))
+		if reAuthAnswer != nil && reAuthAnswer.ResultCode != diam.Success {
+			glog.Errorf("Failed to install Rx rules of session %s for %s: result code %d",
+				aar.SessionID, session.imsi, reAuthAnswer.ResultCode)
+			srv.sendAnswer(c, m, aar.SessionID, 0, DiameterIPCANSessionNotAvailable)
+			return
+		}
+		srv.lock.Lock()
+		srv.sessions[aar.SessionID] = session
+		srv.lock.Unlock()
+		srv.sendAnswer(c, m, aar.SessionID, diam.Success, 0)
+
+		// successful allocations are notified once the PCEF reports the bearers' setup, rules
+		// the PCEF failed to install are reported in the RAA
+		if reAuthAnswer != nil && len(reAuthAnswer.RuleReports) > 0 {
+			go func() {
+				err := srv.NotifySpecificAction(session.imsi, session.framedIP, IndicationOfFailedResourcesAllocation)
+				if err != nil {
+					glog.Error(err)
+				}
+			}()
+		}
+	}
+}
+
+// getSTRHandler returns a handler removing the rules of the terminated AF session
+func (srv *RxProxyServer) getSTRHandler() diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received Rx STR message\n%s\n", m)
+		var str SessionTerminationRequest
+		if err := m.Unmarshal(&str); err != nil {
+			glog.Errorf("Failed to unmarshal Rx STR: %s", err)
+			srv.sendAnswer(c, m, str.SessionID, diam.UnableToComply, 0)
+			return
+		}
+		srv.lock.Lock()
+		session, found := srv.sessions[str.SessionID]
+		delete(srv.sessions, str.SessionID)
+		srv.lock.Unlock()
+		if !found {
+			srv.sendAnswer(c, m, str.SessionID, diam.UnknownSessionID, 0)
+			return
+		}
+		reAuthAnswer := srv.updateRules(session, nil, session.ruleNames)
+		if reAuthAnswer != nil && reAuthAnswer.ResultCode != diam.Success {
+			// the rules are removed with the IP-CAN session anyway
+			glog.Warningf("Failed to remove Rx rules of session %s for %s: result code %d",
+				session.sessionID, session.imsi, reAuthAnswer.ResultCode)
+		}
+		srv.sendAnswer(c, m, str.SessionID, diam.Success, 0)
+	}
+}
+
+// updateRules relays the rule changes of an AF session through Gx. The successful resource
+// allocation event trigger is provisioned with the rules if the AF subscribed to it. It returns
+// nil if there are no changes to relay
+func (srv *RxProxyServer) updateRules(
+	session *afSession,
+	rulesToInstall []*gx.RuleDefinition,
+	rulesToRemove []string,
+) *gx.PolicyReAuthAnswer {
+	if len(rulesToInstall) == 0 && len(rulesToRemove) == 0 {
+		return nil
+	}
+	request := &gx.PolicyReAuthRequest{SessionID: session.sessionID}
+	if len(rulesToInstall) > 0 {
+		request.RulesToInstall = []*gx.RuleInstallAVP{{RuleDefinitions: rulesToInstall}}
+		if session.isSubscribedTo(IndicationOfSuccessfulResourcesAllocation) {
+			request.EventTriggers = []gx.EventTrigger{gx.SuccessfulResourceAllocation}
+		}
+	}
+	if len(rulesToRemove) > 0 {
+		request.RulesToRemove = []*gx.RuleRemoveAVP{{RuleNames: rulesToRemove}}
+	}
+	return srv.relay(session.imsi, request)
+}
+
+// getSubscriberSessions returns the AF sessions of the subscriber bound to the UE IP address,
+// sessions without a framed IP address are bound to all the IP-CAN sessions of the subscriber
+func (srv *RxProxyServer) getSubscriberSessions(imsi, ueIPv4 string) []*afSession {
+	imsi = withIMSIPrefix(imsi)
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	sessions := []*afSession{}
+	for _, session := range srv.sessions {
+		if session.imsi != imsi {
+			continue
+		}
+		if len(ueIPv4) == 0 || len(session.framedIP) == 0 || session.framedIP == ueIPv4 {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+func (srv *RxProxyServer) newRequest(command uint32, session *afSession) *diam.Message {
+	settings := srv.mux.Settings()
+	m := diameter.NewProxiableRequest(command, RxApplicationID, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(session.sessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+	if meta, ok := smpeer.FromContext(session.connection.Context()); ok {
+		m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+		m.NewAVP(avp.DestinationHost, avp.Mbit, 0, meta.OriginHost)
+	}
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(RxApplicationID))
+	return m
+}
+
+// sendAnswer answers the AF request with either a result code or an experimental result code
+func (srv *RxProxyServer) sendAnswer(
+	conn diam.Conn,
+	message *diam.Message,
+	sessionID string,
+	resultCode, experimentalResultCode uint32,
+) {
+	a := message.Answer(resultCode)
+	// SessionID must be the first AVP
+	a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID)))
+	a.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(RxApplicationID))
+	a.NewAVP(avp.OriginHost, avp.Mbit, 0, srv.mux.Settings().OriginHost)
+	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, srv.mux.Settings().OriginRealm)
+	if experimentalResultCode != 0 {
+		a.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(experimentalResultCode)),
+			},
+		})
+	}
+	glog.V(2).Infof("Sending Rx answer message\n%s\n", a)
+	if _, err := a.WriteTo(conn); err != nil {
+		glog.Errorf("Failed to write Rx answer to %s: %s\n%s\n", conn.RemoteAddr(), err, a)
+	}
+}
+
+func (session *afSession) isSubscribedTo(action SpecificAction) bool {
+	for _, subscribed := range session.specificActions {
+		if subscribed == action {
+			return true
+		}
+	}
+	return false
+}
+
+// getRemovedRules returns the previous rules not present in the current ones
+func getRemovedRules(previous, current []string) []string {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, name := range current {
+		currentSet[name] = struct{}{}
+	}
+	removed := []string{}
+	for _, name := range previous {
+		if _, ok := currentSet[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	return removed
+}
+
+func getAnswerHandler(name string) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var answer AnswerResult
+		if err := m.Unmarshal(&answer); err != nil {
+			glog.Errorf("Received unparseable Rx %s: %s", name, err)
+			return
+		}
+		if answer.ResultCode != diam.Success {
+			glog.Warningf("Received Rx %s for session %s with result code %d", name, answer.SessionID, answer.ResultCode)
+		}
+	}
+}
+
+// logErrors logs errors received during transmission
+func logErrors(ec <-chan *diam.ErrorReport) {
+	for err := range ec {
+		glog.Errorf("Rx transmit error: %s", err)
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/servicers"
+	"magma/feg/gateway/services/rx_proxy/servicers/mock_pcscf"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+)
+
+const (
+	testIMSI      = "001010000000001"
+	testSessionID = "pcscf.ims.test;1234;1"
+	testUEIP      = "192.168.128.12"
+)
+
+type reAuthRecorder struct {
+	sync.Mutex
+	imsis      []string
+	requests   []*gx.PolicyReAuthRequest
+	resultCode uint32
+}
+
+func (r *reAuthRecorder) relay(imsi string, request *gx.PolicyReAuthRequest) *gx.PolicyReAuthAnswer {
+	r.Lock()
+	defer r.Unlock()
+	r.imsis = append(r.imsis, imsi)
+	r.requests = append(r.requests, request)
+	return &gx.PolicyReAuthAnswer{SessionID: request.SessionID, ResultCode: r.resultCode}
+}
+
+func (r *reAuthRecorder) last() (string, *gx.PolicyReAuthRequest) {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.requests) == 0 {
+		return "", nil
+	}
+	return r.imsis[len(r.imsis)-1], r.requests[len(r.requests)-1]
+}
+
+func TestRxProxy(t *testing.T) {
+	recorder := &reAuthRecorder{resultCode: diam.Success}
+	serverCfg, pcscf := startRxProxy(t, recorder)
+	rxServer := serverCfg.server
+
+	// initial AAR with audio & video components
+	audio, video := servicers.MediaTypeAudio, servicers.MediaTypeVideo
+	aar := &mock_pcscf.AAR{
+		SessionID: testSessionID,
+		IMSI:      testIMSI,
+		FramedIP:  net.ParseIP(testUEIP),
+		Type:      servicers.RxInitialRequest,
+		MediaComponents: []*servicers.MediaComponentDescription{
+			{
+				MediaComponentNumber: 1,
+				MediaType:            &audio,
+				MaxReqBwUL:           swag.Uint32(64000),
+				MaxReqBwDL:           swag.Uint32(64000),
+				MediaSubComponents: []*servicers.MediaSubComponent{{
+					FlowNumber: 1,
+					FlowDescriptions: []string{
+						"permit out 17 from 10.0.0.1 5000 to 192.168.128.12 6000",
+						"permit in 17 from 192.168.128.12 6000 to 10.0.0.1 5000",
+					},
+				}},
+			},
+			{
+				MediaComponentNumber: 2,
+				MediaType:            &video,
+				MediaSubComponents: []*servicers.MediaSubComponent{{
+					FlowNumber:       1,
+					FlowDescriptions: []string{"permit out 17 from 10.0.0.1 5002 to 192.168.128.12 6002"},
+					MaxReqBwDL:       swag.Uint32(512000),
+				}},
+			},
+		},
+		SpecificActions: []servicers.SpecificAction{
+			servicers.IndicationOfSuccessfulResourcesAllocation,
+			servicers.IndicationOfLossOfBearer,
+		},
+	}
+	answer, err := pcscf.SendAAR(aar)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+
+	imsi, rar := recorder.last()
+	assert.Equal(t, "IMSI"+testIMSI, imsi)
+	assert.Len(t, rar.RulesToInstall, 1)
+	assert.Empty(t, rar.RulesToRemove)
+	assert.Equal(t, []gx.EventTrigger{gx.SuccessfulResourceAllocation}, rar.EventTriggers)
+	rules := rar.RulesToInstall[0].RuleDefinitions
+	assert.Len(t, rules, 2)
+	audioRule, videoRule := rules[0], rules[1]
+	assert.Equal(t, servicers.GetRuleName(testSessionID, 1, 1), audioRule.RuleName)
+	assert.Equal(t, aar.MediaComponents[0].MediaSubComponents[0].FlowDescriptions, audioRule.FlowDescriptions)
+	assert.Equal(t, uint32(1), *audioRule.Qos.Qci)
+	assert.Equal(t, uint32(64000), *audioRule.Qos.GbrUL)
+	assert.Equal(t, uint32(servicers.DefaultRxRulePrecedence), audioRule.Precedence)
+	assert.Equal(t, uint32(2), *videoRule.Qos.Qci)
+	assert.Equal(t, uint32(512000), *videoRule.Qos.MaxReqBwDL)
+	assert.Nil(t, videoRule.Qos.MaxReqBwUL)
+
+	// the AF subscribed to successful resource allocation notifications, they are sent once
+	// the bearers are set up
+	_, err = pcscf.WaitForRequest(100 * time.Millisecond)
+	assert.Error(t, err)
+	_, err = rxServer.NotifyBearerEvent(context.Background(), &protos.BearerEventNotification{
+		Imsi:   "IMSI" + testIMSI,
+		UeIpv4: testUEIP,
+		Event:  protos.BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION,
+	})
+	assert.NoError(t, err)
+	request, err := pcscf.WaitForRequest(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.ReAuth), request.Command)
+	assert.Equal(t, testSessionID, request.SessionID)
+	assert.Equal(t, []servicers.SpecificAction{servicers.IndicationOfSuccessfulResourcesAllocation}, request.SpecificActions)
+
+	// update AAR removing the video component
+	answer, err = pcscf.SendAAR(&mock_pcscf.AAR{
+		SessionID:       testSessionID,
+		Type:            servicers.RxUpdateRequest,
+		MediaComponents: aar.MediaComponents[:1],
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+	_, rar = recorder.last()
+	assert.Equal(t, []string{audioRule.RuleName}, servicers.GetRuleNames(rar.RulesToInstall[0].RuleDefinitions))
+	assert.Equal(t, []*gx.RuleRemoveAVP{{RuleNames: []string{videoRule.RuleName}}}, rar.RulesToRemove)
+
+	// bearer loss is notified, not subscribed events aren't
+	_, err = rxServer.NotifyBearerEvent(context.Background(), &protos.BearerEventNotification{
+		Imsi:  testIMSI,
+		Event: protos.BearerEventNotification_RECOVERY_OF_BEARER,
+	})
+	assert.NoError(t, err)
+	_, err = rxServer.NotifyBearerEvent(context.Background(), &protos.BearerEventNotification{
+		Imsi:  testIMSI,
+		Event: protos.BearerEventNotification_LOSS_OF_BEARER,
+	})
+	assert.NoError(t, err)
+	request, err = pcscf.WaitForRequest(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, []servicers.SpecificAction{servicers.IndicationOfLossOfBearer}, request.SpecificActions)
+
+	// ASR is sent once the IP-CAN session the AF session is bound to is terminated
+	_, err = rxServer.IPCANSessionTerminated(
+		context.Background(), &protos.IPCANSessionTermination{Imsi: "IMSI" + testIMSI, UeIpv4: "192.168.128.13"})
+	assert.NoError(t, err)
+	_, err = pcscf.WaitForRequest(100 * time.Millisecond)
+	assert.Error(t, err)
+	_, err = rxServer.IPCANSessionTerminated(
+		context.Background(), &protos.IPCANSessionTermination{Imsi: "IMSI" + testIMSI, UeIpv4: testUEIP})
+	assert.NoError(t, err)
+	request, err = pcscf.WaitForRequest(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.AbortSession), request.Command)
+	assert.Equal(t, servicers.AbortCauseBearerReleased, *request.AbortCause)
+
+	// STR removes the remaining rules
+	answer, err = pcscf.SendSTR(testSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+	_, rar = recorder.last()
+	assert.Empty(t, rar.RulesToInstall)
+	assert.Empty(t, rar.EventTriggers)
+	assert.Equal(t, []*gx.RuleRemoveAVP{{RuleNames: []string{audioRule.RuleName}}}, rar.RulesToRemove)
+
+	answer, err = pcscf.SendSTR(testSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), answer.ResultCode)
+}
+
+func TestRxProxyIPCANSessionNotAvailable(t *testing.T) {
+	recorder := &reAuthRecorder{resultCode: diam.UnableToDeliver}
+	_, pcscf := startRxProxy(t, recorder)
+	components := []*servicers.MediaComponentDescription{{
+		MediaComponentNumber: 1,
+		MediaSubComponents: []*servicers.MediaSubComponent{{
+			FlowNumber:       1,
+			FlowDescriptions: []string{"permit out 17 from 10.0.0.1 5000 to 192.168.128.12 6000"},
+		}},
+	}}
+
+	// no IMSI to bind the AF session to
+	answer, err := pcscf.SendAAR(&mock_pcscf.AAR{SessionID: testSessionID, MediaComponents: components})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), answer.ResultCode)
+	assert.Equal(t, uint32(servicers.DiameterIPCANSessionNotAvailable), answer.ExperimentalResultCode)
+	_, rar := recorder.last()
+	assert.Nil(t, rar)
+
+	// rules can't be delivered to the subscriber's gateway
+	answer, err = pcscf.SendAAR(&mock_pcscf.AAR{SessionID: testSessionID, IMSI: testIMSI, MediaComponents: components})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(servicers.DiameterIPCANSessionNotAvailable), answer.ExperimentalResultCode)
+
+	// update of an unknown session
+	answer, err = pcscf.SendAAR(&mock_pcscf.AAR{SessionID: testSessionID, Type: servicers.RxUpdateRequest})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), answer.ResultCode)
+}
+
+func TestRxProxyFramedIPBinding(t *testing.T) {
+	recorder := &reAuthRecorder{resultCode: diam.Success}
+	serverCfg, pcscf := startRxProxy(t, recorder)
+	rxServer := serverCfg.server
+	aar := &mock_pcscf.AAR{
+		SessionID: testSessionID,
+		FramedIP:  net.ParseIP(testUEIP),
+		MediaComponents: []*servicers.MediaComponentDescription{{
+			MediaComponentNumber: 1,
+			MediaSubComponents: []*servicers.MediaSubComponent{{
+				FlowNumber:       1,
+				FlowDescriptions: []string{"permit out 17 from 10.0.0.1 5000 to 192.168.128.12 6000"},
+			}},
+		}},
+	}
+
+	// no IP-CAN session of the framed IP address
+	answer, err := pcscf.SendAAR(aar)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(servicers.DiameterIPCANSessionNotAvailable), answer.ExperimentalResultCode)
+
+	// the IMSI of the AF session is resolved from its framed IP address
+	_, err = rxServer.IPCANSessionEstablished(
+		context.Background(), &protos.IPCANSessionEstablishment{Imsi: testIMSI, UeIpv4: testUEIP})
+	assert.NoError(t, err)
+	answer, err = pcscf.SendAAR(aar)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+	imsi, rar := recorder.last()
+	assert.Equal(t, "IMSI"+testIMSI, imsi)
+	assert.Len(t, rar.RulesToInstall, 1)
+	// the AF didn't subscribe to successful resource allocation notifications
+	assert.Empty(t, rar.EventTriggers)
+
+	// the framed IP address isn't bound once the IP-CAN session is terminated
+	_, err = rxServer.IPCANSessionTerminated(
+		context.Background(), &protos.IPCANSessionTermination{Imsi: testIMSI, UeIpv4: testUEIP})
+	assert.NoError(t, err)
+	aar.SessionID = "pcscf.ims.test;1234;2"
+	answer, err = pcscf.SendAAR(aar)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(servicers.DiameterIPCANSessionNotAvailable), answer.ExperimentalResultCode)
+}
+
+func TestToRuleDefinitions(t *testing.T) {
+	enabledUplink, removed := servicers.FlowStatusEnabledUplink, servicers.FlowStatusRemoved
+	flows := []string{
+		"permit out 17 from 10.0.0.1 5000 to 192.168.128.12 6000",
+		"permit in 17 from 192.168.128.12 6000 to 10.0.0.1 5000",
+	}
+	rules := servicers.ToRuleDefinitions(testSessionID, []*servicers.MediaComponentDescription{
+		{
+			MediaComponentNumber: 1,
+			FlowStatus:           &enabledUplink,
+			MaxReqBwUL:           swag.Uint32(1000),
+			MediaSubComponents: []*servicers.MediaSubComponent{
+				{FlowNumber: 1, FlowDescriptions: flows},
+				{FlowNumber: 2, FlowDescriptions: flows, FlowStatus: &removed},
+			},
+		},
+		{MediaComponentNumber: 2, FlowStatus: &removed, MediaSubComponents: []*servicers.MediaSubComponent{
+			{FlowNumber: 1, FlowDescriptions: flows},
+		}},
+	}, 5)
+	assert.Len(t, rules, 1)
+	assert.Equal(t, servicers.GetRuleName(testSessionID, 1, 1), rules[0].RuleName)
+	assert.Equal(t, flows[1:], rules[0].FlowDescriptions)
+	assert.Equal(t, uint32(5), rules[0].Precedence)
+	assert.Equal(t, uint32(9), *rules[0].Qos.Qci)
+	assert.Equal(t, uint32(1000), *rules[0].Qos.MaxReqBwUL)
+	assert.Nil(t, rules[0].Qos.GbrUL)
+	assert.NotEqual(t, rules[0].RuleName, servicers.GetRuleName("pcscf.ims.test;1234;2", 1, 1))
+}
+
+type rxTestServer struct {
+	config *servicers.RxProxyConfig
+	server *servicers.RxProxyServer
+}
+
+func startRxProxy(t *testing.T, recorder *reAuthRecorder) (*rxTestServer, *mock_pcscf.MockPCSCF) {
+	config := &servicers.RxProxyConfig{
+		ClientConfig: &diameter.DiameterClientConfig{
+			Host:        "pcrf.magma.com",
+			Realm:       "magma.com",
+			ProductName: "rx_proxy",
+			AppID:       servicers.RxApplicationID,
+		},
+		ServerConfig: &diameter.DiameterServerConfig{
+			DiameterServerConnConfig: diameter.DiameterServerConnConfig{Addr: "127.0.0.1:0", Protocol: "tcp"},
+		},
+		RulePrecedence: servicers.DefaultRxRulePrecedence,
+	}
+	server := servicers.NewRxProxyServer(config, recorder.relay)
+	lis, err := server.StartListener()
+	assert.NoError(t, err)
+	config.ServerConfig.Addr = lis.Addr().String()
+	go server.Start(lis)
+
+	pcscf := mock_pcscf.NewMockPCSCF(&diameter.DiameterClientConfig{
+		Host:        "pcscf.ims.test",
+		Realm:       "ims.test",
+		ProductName: "mock_pcscf",
+		AppID:       servicers.RxApplicationID,
+	}, config.ServerConfig)
+	return &rxTestServer{config: config, server: server}, pcscf
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR, PUR messages over diameter connection.
+// It also handles DSR, sends sync rpc request to gateway, then returns a DSA over diameter connection.
+package servicers
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+)
+
+// S6a DSR
+func handleDSR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received S6a DSR message:\n%s\n", m)
+		var code uint32 //result-code
+		var dsr DSR
+		err := m.Unmarshal(&dsr)
+		if err != nil {
+			glog.Errorf("DSR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			code, err = forwardDSRToGateway(&dsr)
+			if err != nil {
+				glog.Errorf("Failed to forward DSR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendDSA(c, m, code, &dsr, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send DSA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent DSA\n")
+		}
+	}
+}
+
+func forwardDSRToGateway(dsr *DSR) (uint32, error) {
+	res, err := s6a_proxy.GWS6AProxyDeleteSubscriberData(getProtoDSR(dsr))
+	if err != nil {
+		if res != nil {
+			return mapProtoToSubscriberDataResult(res.ErrorCode), err
+		}
+		return diam.UnableToDeliver, err
+	}
+	return mapProtoToSubscriberDataResult(res.ErrorCode), nil
+}
+
+// getProtoDSR converts DSR into its RPC representation
+func getProtoDSR(dsr *DSR) *protos.DeleteSubscriberDataRequest {
+	return &protos.DeleteSubscriberDataRequest{
+		UserName:   dsr.UserName,
+		DsrFlags:   dsr.DSRFlags,
+		ContextIds: dsr.ContextIdentifiers,
+	}
+}
+
+func (s *s6aProxy) sendDSA(c diam.Conn, m *diam.Message, code uint32, dsr *DSR, retries uint) error {
+	ans := newSubscriberDataAnswer(m, code)
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(dsr.SessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(dsr.AuthSessionState))
+	ans.NewAVP(DSAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(0))
+	s.addDiamOriginAVPs(ans)
+	glog.V(2).Infof("Sending S6a DSA message\n%s\n", ans)
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
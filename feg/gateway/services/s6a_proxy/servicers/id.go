@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR, PUR messages over diameter connection.
+// It also handles IDR, sends sync rpc request to gateway, then returns an IDA over diameter connection.
+package servicers
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+)
+
+// S6a IDR
+func handleIDR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received S6a IDR message:\n%s\n", m)
+		var code uint32 //result-code
+		var idr IDR
+		err := m.Unmarshal(&idr)
+		if err != nil {
+			glog.Errorf("IDR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			code, err = forwardIDRToGateway(&idr)
+			if err != nil {
+				glog.Errorf("Failed to forward IDR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendIDA(c, m, code, &idr, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send IDA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent IDA\n")
+		}
+	}
+}
+
+func forwardIDRToGateway(idr *IDR) (uint32, error) {
+	res, err := s6a_proxy.GWS6AProxyInsertSubscriberData(getProtoIDR(idr))
+	if err != nil {
+		if res != nil {
+			return mapProtoToSubscriberDataResult(res.ErrorCode), err
+		}
+		return diam.UnableToDeliver, err
+	}
+	return mapProtoToSubscriberDataResult(res.ErrorCode), nil
+}
+
+// mapProtoToSubscriberDataResult maps the gateway's IDR or DSR error code to the IDA/DSA result code.
+// Unlike for CLR, an unknown user isn't a success: it's reported as DIAMETER_ERROR_USER_UNKNOWN
+func mapProtoToSubscriberDataResult(protoErr protos.ErrorCode) uint32 {
+	if protoErr == protos.ErrorCode_USER_UNKNOWN {
+		return uint32(protos.ErrorCode_USER_UNKNOWN)
+	}
+	return uint32(mapProtoToDiamResult(protoErr))
+}
+
+// newSubscriberDataAnswer creates the IDA/DSA for the request. DIAMETER_ERROR_USER_UNKNOWN is a 3GPP
+// error, so it's sent as an Experimental-Result rather than a Result-Code
+func newSubscriberDataAnswer(m *diam.Message, code uint32) *diam.Message {
+	if code != uint32(protos.ErrorCode_USER_UNKNOWN) {
+		return m.Answer(code)
+	}
+	ans := m.Answer(0)
+	ans.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+			diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(code)),
+		},
+	})
+	return ans
+}
+
+// getProtoIDR converts IDR into its RPC representation, only AVPs present in the IDR's Subscription-Data
+// are set in the returned request
+func getProtoIDR(idr *IDR) *protos.InsertSubscriberDataRequest {
+	subData := &idr.SubscriptionData
+	res := &protos.InsertSubscriberDataRequest{
+		UserName:         idr.UserName,
+		IdrFlags:         idr.IDRFlags,
+		DefaultContextId: subData.APNConfigurationProfile.ContextIdentifier,
+		Apn:              getProtoAPNConfigs(subData.APNConfigurationProfile.APNConfigs),
+	}
+	// Proto3 scalars have no presence, so present AVPs are flagged
+	if subData.NetworkAccessMode != nil {
+		res.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(*subData.NetworkAccessMode)
+		res.PresenceFlags |= uint32(protos.InsertSubscriberDataRequest_NETWORK_ACCESS_MODE_PRESENT)
+	}
+	if subData.SubscriberStatus != nil {
+		res.SubscriberStatus = protos.InsertSubscriberDataRequest_SubscriberStatus(*subData.SubscriberStatus)
+		res.PresenceFlags |= uint32(protos.InsertSubscriberDataRequest_SUBSCRIBER_STATUS_PRESENT)
+	}
+	if subData.OperatorDeterminedBarring != nil {
+		res.OperatorDeterminedBarring = *subData.OperatorDeterminedBarring
+		res.PresenceFlags |= uint32(protos.InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING_PRESENT)
+	}
+	if len(subData.MSISDN) > 0 {
+		res.Msisdn = subData.MSISDN.Serialize()
+	}
+	if subData.AMBR != (AMBR{}) {
+		res.TotalAmbr = subData.AMBR.getProtoAmbr()
+	}
+	if len(res.Apn) > 0 {
+		res.AllApnsIncluded = subData.APNConfigurationProfile.AllAPNConfigurationsIncludedIndicator == 0
+	}
+	return res
+}
+
+func (s *s6aProxy) sendIDA(c diam.Conn, m *diam.Message, code uint32, idr *IDR, retries uint) error {
+	ans := newSubscriberDataAnswer(m, code)
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(idr.SessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(idr.AuthSessionState))
+	ans.NewAVP(IDAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(0))
+	s.addDiamOriginAVPs(ans)
+	glog.V(2).Infof("Sending S6a IDA message\n%s\n", ans)
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+)
+
+func TestGetProtoIDR(t *testing.T) {
+	m := newTestS6aRequest(t, InsertSubscriberData)
+	m.NewAVP(IDRFlags, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1))
+	m.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriberStatus, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(1)),
+			diam.NewAVP(avp.OperatorDeterminedBarring, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1)),
+			diam.NewAVP(avp.AMBR, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.MaxRequestedBandwidthUL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(500)),
+					diam.NewAVP(avp.MaxRequestedBandwidthDL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1000)),
+				},
+			}),
+			diam.NewAVP(avp.APNConfigurationProfile, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(2)),
+					diam.NewAVP(avp.AllAPNConfigurationsIncludedIndicator, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(1)),
+					diam.NewAVP(avp.APNConfiguration, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+						AVP: []*diam.AVP{
+							diam.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(2)),
+							diam.NewAVP(avp.PDNType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(0)),
+							diam.NewAVP(avp.ServiceSelection, avp.Mbit, 0, datatype.UTF8String("ims")),
+						},
+					}),
+				},
+			}),
+		},
+	})
+	var idr IDR
+	assert.NoError(t, readTestS6aRequest(t, m).Unmarshal(&idr))
+	req := getProtoIDR(&idr)
+
+	assert.Equal(t, "001010000000001", req.GetUserName())
+	assert.Equal(t, uint32(1), req.GetIdrFlags())
+	assert.Empty(t, req.GetMsisdn())
+	assert.Equal(t, protos.InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING, req.GetSubscriberStatus())
+	assert.Equal(t, uint32(1), req.GetOperatorDeterminedBarring())
+	assert.Equal(t,
+		uint32(protos.InsertSubscriberDataRequest_SUBSCRIBER_STATUS_PRESENT|protos.InsertSubscriberDataRequest_OPERATOR_DETERMINED_BARRING_PRESENT),
+		req.GetPresenceFlags())
+	assert.Equal(t, uint32(500), req.GetTotalAmbr().GetMaxBandwidthUl())
+	assert.Equal(t, uint32(1000), req.GetTotalAmbr().GetMaxBandwidthDl())
+	assert.Equal(t, uint32(2), req.GetDefaultContextId())
+	assert.False(t, req.GetAllApnsIncluded())
+	assert.Equal(t, 1, len(req.GetApn()))
+	assert.Equal(t, uint32(2), req.GetApn()[0].GetContextId())
+	assert.Equal(t, "ims", req.GetApn()[0].GetServiceSelection())
+
+	// IDR without APN or AMBR changes
+	m = newTestS6aRequest(t, InsertSubscriberData)
+	m.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString("12345")),
+		},
+	})
+	idr = IDR{}
+	assert.NoError(t, readTestS6aRequest(t, m).Unmarshal(&idr))
+	req = getProtoIDR(&idr)
+	assert.Equal(t, []byte("12345"), req.GetMsisdn())
+	assert.Nil(t, req.GetTotalAmbr())
+	assert.False(t, req.GetAllApnsIncluded())
+	assert.Empty(t, req.GetApn())
+	// Absent Subscriber-Status isn't forwarded as SERVICE_GRANTED
+	assert.Equal(t, uint32(0), req.GetPresenceFlags())
+
+	// Explicit SERVICE_GRANTED & network access mode
+	m = newTestS6aRequest(t, InsertSubscriberData)
+	m.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriberStatus, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(0)),
+			diam.NewAVP(avp.NetworkAccessMode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(2)),
+		},
+	})
+	idr = IDR{}
+	assert.NoError(t, readTestS6aRequest(t, m).Unmarshal(&idr))
+	req = getProtoIDR(&idr)
+	assert.Equal(t, protos.InsertSubscriberDataRequest_SERVICE_GRANTED, req.GetSubscriberStatus())
+	assert.Equal(t, protos.UpdateLocationAnswer_ONLY_PACKET, req.GetNetworkAccessMode())
+	assert.Equal(t,
+		uint32(protos.InsertSubscriberDataRequest_SUBSCRIBER_STATUS_PRESENT|protos.InsertSubscriberDataRequest_NETWORK_ACCESS_MODE_PRESENT),
+		req.GetPresenceFlags())
+}
+
+func TestSubscriberDataAnswer(t *testing.T) {
+	m := newTestS6aRequest(t, InsertSubscriberData)
+
+	code := mapProtoToSubscriberDataResult(protos.ErrorCode_SUCCESS)
+	assert.Equal(t, uint32(diam.Success), code)
+	var ida IDA
+	assert.NoError(t, newSubscriberDataAnswer(m, code).Unmarshal(&ida))
+	assert.Equal(t, uint32(diam.Success), ida.ResultCode)
+
+	// Unknown users are reported with an experimental result
+	code = mapProtoToSubscriberDataResult(protos.ErrorCode_USER_UNKNOWN)
+	assert.Equal(t, uint32(5001), code)
+	ida = IDA{}
+	assert.NoError(t, newSubscriberDataAnswer(m, code).Unmarshal(&ida))
+	assert.Equal(t, uint32(0), ida.ResultCode)
+	assert.Equal(t, uint32(5001), ida.ExperimentalResult.ExperimentalResultCode)
+	assert.Equal(t, uint32(diameter.Vendor3GPP), ida.ExperimentalResult.VendorId)
+}
+
+func TestGetProtoDSR(t *testing.T) {
+	m := newTestS6aRequest(t, DeleteSubscriberData)
+	m.NewAVP(DSRFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1<<3))
+	m.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(3))
+	m.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(4))
+
+	var dsr DSR
+	assert.NoError(t, readTestS6aRequest(t, m).Unmarshal(&dsr))
+	req := getProtoDSR(&dsr)
+	assert.Equal(t, "001010000000001", req.GetUserName())
+	assert.Equal(t, uint32(1<<3), req.GetDsrFlags())
+	assert.Equal(t, []uint32{3, 4}, req.GetContextIds())
+}
+
+func newTestS6aRequest(t *testing.T, code uint32) *diam.Message {
+	m := diameter.NewProxiableRequest(code, diam.TGPP_S6A_APP_ID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("hss;1234"))
+	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("hss.openair4G.eur"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("openair4G.eur"))
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity("magma.openair4G.eur"))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity("openair4G.eur"))
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String("001010000000001"))
+	return m
+}
+
+// readTestS6aRequest serializes & parses back the request to make sure it's decodable with the extended dictionary
+func readTestS6aRequest(t *testing.T, m *diam.Message) *diam.Message {
+	b, err := m.Serialize()
+	assert.NoError(t, err)
+	res, err := diam.ReadMessage(bytes.NewReader(b), dict.Default)
+	assert.NoError(t, err)
+	return res
+}
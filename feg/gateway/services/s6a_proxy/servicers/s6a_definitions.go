@@ -185,6 +185,7 @@ type SubscriptionData struct {
 	MSISDN                        datatype.OctetString    `avp:"MSISDN"`
 	AccessRestrictionData         uint32                  `avp:"Access-Restriction-Data"`
 	SubscriberStatus              int32                   `avp:"Subscriber-Status"`
+	OperatorDeterminedBarring     uint32                  `avp:"Operator-Determined-Barring"`
 	NetworkAccessMode             int32                   `avp:"Network-Access-Mode"`
 	AMBR                          AMBR                    `avp:"AMBR"`
 	APNConfigurationProfile       APNConfigurationProfile `avp:"APN-Configuration-Profile"`
@@ -193,6 +194,17 @@ type SubscriptionData struct {
 	RegionalSubscriptionZoneCode  []datatype.OctetString  `avp:"Regional-Subscription-Zone-Code"`
 }
 
+// IDRSubscriptionData is the Subscription-Data of an IDR, which only includes the changed AVPs.
+// Scalar AVPs whose default value is meaningful are pointers, so absent AVPs can be told apart.
+type IDRSubscriptionData struct {
+	MSISDN                    datatype.OctetString    `avp:"MSISDN"`
+	SubscriberStatus          *int32                  `avp:"Subscriber-Status"`
+	OperatorDeterminedBarring *uint32                 `avp:"Operator-Determined-Barring"`
+	NetworkAccessMode         *int32                  `avp:"Network-Access-Mode"`
+	AMBR                      AMBR                    `avp:"AMBR"`
+	APNConfigurationProfile   APNConfigurationProfile `avp:"APN-Configuration-Profile"`
+}
+
 type ULA struct {
 	SessionID          string                    `avp:"Session-Id"`
 	ULAFlags           uint32                    `avp:"ULA-Flags"`
@@ -216,6 +228,86 @@ type CLR struct {
 	UserName         string                    `avp:"User-Name"`
 }
 
+// IDR is Go representation of Insert-Subscriber-Data-Request message
+//
+//	< Insert-Subscriber-Data-Request> ::= < Diameter Header: 319, REQ, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Host }
+//	{ Destination-Realm }
+//	{ User-Name }
+//	*[ Supported-Features ]
+//	{ Subscription-Data }
+//	[ IDR-Flags ]
+//	*[ AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type IDR struct {
+	SessionID        string                    `avp:"Session-Id"`
+	AuthSessionState int32                     `avp:"Auth-Session-State"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost  datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName         string                    `avp:"User-Name"`
+	SubscriptionData IDRSubscriptionData       `avp:"Subscription-Data"`
+	IDRFlags         uint32                    `avp:"IDR-Flags"`
+}
+
+// IDA is Go representation of Insert-Subscriber-Data-Answer message
+type IDA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	IDAFlags           uint32                    `avp:"IDA-Flags"`
+}
+
+// DSR is Go representation of Delete-Subscriber-Data-Request message
+//
+//	< Delete-Subscriber-Data-Request > ::= < Diameter Header: 320, REQ, PXY, 16777251 >
+//	< Session-Id >
+//	[ Vendor-Specific-Application-Id ]
+//	{ Auth-Session-State }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Host }
+//	{ Destination-Realm }
+//	{ User-Name }
+//	*[ Supported-Features ]
+//	{ DSR-Flags }
+//	*[ Context-Identifier ]
+//	*[ AVP ]
+//	*[ Proxy-Info ]
+//	*[ Route-Record ]
+type DSR struct {
+	SessionID          string                    `avp:"Session-Id"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost    datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm   datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName           string                    `avp:"User-Name"`
+	DSRFlags           uint32                    `avp:"DSR-Flags"`
+	ContextIdentifiers []uint32                  `avp:"Context-Identifier"`
+}
+
+// DSA is Go representation of Delete-Subscriber-Data-Answer message
+type DSA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DSAFlags           uint32                    `avp:"DSA-Flags"`
+}
+
 // Definitions for PU
 //
 // PUR is Go representation of Purge-UE-Request message
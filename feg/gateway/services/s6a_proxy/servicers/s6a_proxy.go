@@ -153,6 +153,14 @@ func NewS6aProxy(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Reset, Request: true},
 		handleRSR(proxy))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: InsertSubscriberData, Request: true},
+		handleIDR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: DeleteSubscriberData, Request: true},
+		handleDSR(proxy))
+
 	return proxy, nil
 }
 
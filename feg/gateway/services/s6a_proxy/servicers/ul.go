@@ -186,23 +186,7 @@ func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*proto
 					for i, code := range ula.SubscriptionData.RegionalSubscriptionZoneCode {
 						res.RegionalSubscriptionZoneCode[i] = code.Serialize()
 					}
-					for _, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
-						res.Apn = append(
-							res.Apn,
-							&protos.UpdateLocationAnswer_APNConfiguration{
-								ContextId:        apnCfg.ContextIdentifier,
-								Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
-								ServiceSelection: apnCfg.ServiceSelection,
-								QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
-									ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
-									PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
-									PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
-									PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
-								},
-								Ambr:                    apnCfg.AMBR.getProtoAmbr(),
-								ChargingCharacteristics: apnCfg.TgppChargingCharacteristics,
-							})
-					}
+					res.Apn = getProtoAPNConfigs(ula.SubscriptionData.APNConfigurationProfile.APNConfigs)
 					return res, err
 				} else {
 					err = Errorf(codes.Internal, "Invalid Response Type: %T, ULA expected.", resp)
@@ -253,6 +237,29 @@ func getFeatureListID2(supportedFeatures []SupportedFeatures) *protos.FeatureLis
 	return protoFeatureList
 }
 
+// getProtoAPNConfigs converts APN-Configuration AVPs into their RPC representation
+func getProtoAPNConfigs(apnConfigs []APNConfiguration) []*protos.UpdateLocationAnswer_APNConfiguration {
+	var res []*protos.UpdateLocationAnswer_APNConfiguration
+	for _, apnCfg := range apnConfigs {
+		res = append(
+			res,
+			&protos.UpdateLocationAnswer_APNConfiguration{
+				ContextId:        apnCfg.ContextIdentifier,
+				Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
+				ServiceSelection: apnCfg.ServiceSelection,
+				QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
+					ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
+					PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
+					PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
+					PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
+				},
+				Ambr:                    apnCfg.AMBR.getProtoAmbr(),
+				ChargingCharacteristics: apnCfg.TgppChargingCharacteristics,
+			})
+	}
+	return res
+}
+
 func (ambr *AMBR) getProtoAmbr() *protos.UpdateLocationAnswer_AggregatedMaximumBitrate {
 	if ambr.ExtendMaxRequestedBwDL != 0 && ambr.ExtendMaxRequestedBwUL != 0 {
 		return &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
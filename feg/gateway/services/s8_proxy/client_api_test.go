@@ -51,6 +51,35 @@ func TestS8ProxyClient(t *testing.T) {
 
 	t.Logf("Create Session: %#+v", *csRes)
 
+	//------------------------
+	//---- Modify Bearer ----
+	mbReq := &protos.ModifyBearerRequestPgw{
+		PgwAddrs: actualPgwAddress,
+		Imsi:     IMSI1,
+		CAgwTeid: AGWTeidC,
+		CPgwTeid: csRes.CPgwFteid.Teid,
+		ServingNetwork: &protos.ServingNetwork{
+			Mcc: "222",
+			Mnc: "333",
+		},
+		Uli: &protos.UserLocationInformation{
+			Tac: 5,
+			Eci: 6,
+		},
+		RatType: protos.RATType_EUTRAN,
+		BearerContext: &protos.BearerContext{
+			Id: BEARER,
+			UserPlaneFteid: &protos.Fteid{
+				Ipv4Address: "127.0.0.11",
+				Teid:        11,
+			},
+		},
+	}
+	mbRes, err := s8_proxy.ModifyBearer(mbReq)
+	assert.NoError(t, err)
+	assert.Nil(t, mbRes.GetGtpError())
+	assert.Equal(t, uint32(BEARER), mbRes.GetBearerContext().GetId())
+
 	//------------------------
 	//---- Delete session ----
 	dsReq := &protos.DeleteSessionRequestPgw{
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/golang/glog"
 	"github.com/wmnsk/go-gtp/gtpv2"
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
@@ -25,9 +26,13 @@ func addS8GtpHandlers(s8p *S8Proxy) {
 	// echo hanlders added by gtp_client. Use echoChannel for errors
 	s8p.gtpClient.AddHandlers(
 		map[uint8]gtpv2.HandlerFunc{
-			message.MsgTypeCreateSessionResponse: s8p.createSessionResponseHandler(),
-			message.MsgTypeDeleteSessionResponse: s8p.deleteSessionResponseHandler(),
-			message.MsgTypeCreateBearerRequest:   s8p.createBearerRequestHandler(),
+			message.MsgTypeCreateSessionResponse:         s8p.createSessionResponseHandler(),
+			message.MsgTypeDeleteSessionResponse:         s8p.deleteSessionResponseHandler(),
+			message.MsgTypeCreateBearerRequest:           s8p.createBearerRequestHandler(),
+			message.MsgTypeModifyBearerResponse:          s8p.modifyBearerResponseHandler(),
+			message.MsgTypeUpdateBearerRequest:           s8p.updateBearerRequestHandler(),
+			message.MsgTypeDeleteBearerRequest:           s8p.deleteBearerRequestHandler(),
+			message.MsgTypeDeleteBearerFailureIndication: s8p.deleteBearerFailureIndicationHandler(),
 		})
 }
 
@@ -69,3 +74,74 @@ func (s *S8Proxy) createBearerRequestHandler() gtpv2.HandlerFunc {
 		return nil
 	}
 }
+
+func (s *S8Proxy) modifyBearerResponseHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		mbRes, err := parseModifyBearerResponse(msg)
+		return s.gtpClient.PassMessage(msg.TEID(), senderAddr, msg, mbRes, err)
+	}
+}
+
+func (s *S8Proxy) updateBearerRequestHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		ubReq, gtpErr, err := parseUpdateBearerRequest(msg)
+		if err != nil {
+			return err
+		}
+		if gtpErr != nil {
+			return fmt.Errorf(gtpErr.Msg)
+		}
+		ubRes, err := GWS8ProxyUpdateBearerRequest(ubReq)
+		if err != nil {
+			return fmt.Errorf("Failed while UpdateBearerRequest to feg relay: %s", err)
+		}
+
+		ubResMsg, err := buildUpdateBearerResMsg(msg.Sequence(), ubRes)
+		if err != nil {
+			return err
+		}
+		return c.RespondTo(senderAddr, msg, ubResMsg)
+	}
+}
+
+func (s *S8Proxy) deleteBearerRequestHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		dbReq, gtpErr, err := parseDeleteBearerRequest(msg)
+		if err != nil {
+			return err
+		}
+		if gtpErr != nil {
+			return fmt.Errorf(gtpErr.Msg)
+		}
+		dbRes, err := GWS8ProxyDeleteBearerRequest(dbReq)
+		if err != nil {
+			return fmt.Errorf("Failed while DeleteBearerRequest to feg relay: %s", err)
+		}
+
+		dbResMsg, err := buildDeleteBearerResMsg(msg.Sequence(), dbRes)
+		if err != nil {
+			return err
+		}
+		return c.RespondTo(senderAddr, msg, dbResMsg)
+	}
+}
+
+// deleteBearerFailureIndicationHandler handles the PGW rejection of a Delete Bearer Command.
+// Nobody waits for this message, so it is just logged
+func (s *S8Proxy) deleteBearerFailureIndicationHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		dbFailure := msg.(*message.DeleteBearerFailureIndication)
+		if causeIE := dbFailure.Cause; causeIE != nil {
+			gtpErr, err := handleCause(causeIE, msg)
+			if err != nil {
+				return err
+			}
+			if gtpErr != nil {
+				glog.Errorf("Delete Bearer Command for TEID %d rejected by PGW: %s", msg.TEID(), gtpErr.Msg)
+				return nil
+			}
+		}
+		glog.Warningf("Received Delete Bearer Failure Indication for TEID %d without error cause", msg.TEID())
+		return nil
+	}
+}
@@ -39,6 +39,30 @@ func GWS8ProxyCreateBearerRequest(in *protos.CreateBearerRequestPgw) (*protos.Cr
 	return client.CreateBearer(context.Background(), in)
 }
 
+// GWS8ProxyUpdateBearerRequest forwards Update Bearer Request to FegRelay and
+// FegRelay then to AGW
+func GWS8ProxyUpdateBearerRequest(in *protos.UpdateBearerRequestPgw) (*protos.UpdateBearerResponsePgw, error) {
+	conn, err := getCloudConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS8ProxyResponderClient(conn)
+	return client.UpdateBearer(context.Background(), in)
+}
+
+// GWS8ProxyDeleteBearerRequest forwards Delete Bearer Request to FegRelay and
+// FegRelay then to AGW
+func GWS8ProxyDeleteBearerRequest(in *protos.DeleteBearerRequestPgw) (*protos.DeleteBearerResponsePgw, error) {
+	conn, err := getCloudConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS8ProxyResponderClient(conn)
+	return client.DeleteBearer(context.Background(), in)
+}
+
 func getCloudConn() (*grpc.ClientConn, error) {
 	conn, err := registry.Get().GetCloudConnection(feg_relay.ServiceName)
 	if err != nil {
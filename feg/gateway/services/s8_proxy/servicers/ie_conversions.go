@@ -139,6 +139,112 @@ func buildCreateBearerResWithErrorCauseMsg(cause uint32, cPgwTeid uint32, seq ui
 		cPgwTeid, seq, ie.NewCause(uint8(cause), 0, 0, 0, nil))
 }
 
+func buildModifyBearerRequestMsg(cPgwUDPAddr *net.UDPAddr, req *protos.ModifyBearerRequestPgw) (message.Message, error) {
+	// TODO: look for a better way to find the local ip (avoid pinging on each request)
+	// (obtain the IP that is going to send the packet first)
+	ip, err := gtp.GetLocalOutboundIP(cPgwUDPAddr)
+	if err != nil {
+		return nil, err
+	}
+	// Control plane TEID
+	cFegFTeid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPC, req.CAgwTeid, ip.String(), "").WithInstance(0)
+
+	// New user plane TEID (ip belongs to pipelined GTP-U interface)
+	uAgwFTeidReq := req.BearerContext.GetUserPlaneFteid()
+	uAgwFTeid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPU,
+		uAgwFTeidReq.Teid, uAgwFTeidReq.Ipv4Address, uAgwFTeidReq.Ipv6Address).WithInstance(1)
+
+	// bearer
+	bearer := ie.NewBearerContext(ie.NewEPSBearerID(uint8(req.BearerContext.Id)), uAgwFTeid)
+
+	ies := []*ie.IE{
+		cFegFTeid,
+		bearer,
+		getUserLocationIndication(req.ServingNetwork, req.Uli),
+		ie.NewServingNetwork(req.ServingNetwork.Mcc, req.ServingNetwork.Mnc),
+		getRatType(req.RatType),
+	}
+	if req.TimeZone != nil {
+		offset := time.Duration(req.TimeZone.DeltaSeconds) * time.Second
+		ies = append(ies, ie.NewUETimeZone(offset, uint8(req.TimeZone.DaylightSavingTime)))
+	}
+	return message.NewModifyBearerRequest(req.CPgwTeid, 0, ies...), nil
+}
+
+func buildDeleteBearerCommandMsg(cPgwUDPAddr *net.UDPAddr, req *protos.DeleteBearerCommandPgw) (message.Message, error) {
+	ip, err := gtp.GetLocalOutboundIP(cPgwUDPAddr)
+	if err != nil {
+		return nil, err
+	}
+	// Control plane TEID
+	cFegFTeid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPC, req.CAgwTeid, ip.String(), "").WithInstance(0)
+
+	ies := []*ie.IE{
+		ie.NewBearerContext(ie.NewEPSBearerID(uint8(req.BearerId))),
+		getUserLocationIndication(req.ServingNetwork, req.Uli),
+		cFegFTeid,
+	}
+	return message.NewDeleteBearerCommand(req.CPgwTeid, 0, ies...), nil
+}
+
+func buildUpdateBearerResMsg(seq uint32, res *protos.UpdateBearerResponsePgw) (message.Message, error) {
+	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
+		return message.NewUpdateBearerResponse(
+			res.CPgwTeid, seq, ie.NewCause(uint8(res.Cause), 0, 0, 0, nil)), nil
+	}
+	if res.BearerContext == nil {
+		return nil, fmt.Errorf("UpdateBearerResponse could not be sent. Missing Bearer Contex")
+	}
+
+	bearer := ie.NewBearerContext(
+		ie.NewEPSBearerID(uint8(res.BearerContext.Id)),
+		ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil))
+
+	ies := []*ie.IE{
+		ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+		bearer,
+		getProtocolConfigurationOptions(res.ProtocolConfigurationOptions),
+	}
+	ies = append(ies, getOptionalLocationIEs(res.ServingNetwork, res.Uli, res.TimeZone)...)
+	return message.NewUpdateBearerResponse(res.CPgwTeid, seq, ies...), nil
+}
+
+func buildDeleteBearerResMsg(seq uint32, res *protos.DeleteBearerResponsePgw) (message.Message, error) {
+	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
+		return message.NewDeleteBearerResponse(
+			res.CPgwTeid, seq, ie.NewCause(uint8(res.Cause), 0, 0, 0, nil)), nil
+	}
+
+	ies := []*ie.IE{ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)}
+	switch {
+	case res.EpsBearerId != 0:
+		ies = append(ies, ie.NewBearerContext(
+			ie.NewEPSBearerID(uint8(res.EpsBearerId)),
+			ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)))
+	case res.LinkedBearerId != 0:
+		ies = append(ies, ie.NewEPSBearerID(uint8(res.LinkedBearerId)))
+	default:
+		return nil, fmt.Errorf("DeleteBearerResponse could not be sent. Missing Bearer Id")
+	}
+	ies = append(ies, getProtocolConfigurationOptions(res.ProtocolConfigurationOptions))
+	ies = append(ies, getOptionalLocationIEs(res.ServingNetwork, res.Uli, res.TimeZone)...)
+	return message.NewDeleteBearerResponse(res.CPgwTeid, seq, ies...), nil
+}
+
+// getOptionalLocationIEs returns ULI and UE Time Zone IEs if their values are present
+func getOptionalLocationIEs(
+	servingNetwork *protos.ServingNetwork, uli *protos.UserLocationInformation, timeZone *protos.TimeZone) []*ie.IE {
+	var ies []*ie.IE
+	if servingNetwork != nil && uli != nil {
+		ies = append(ies, getUserLocationIndication(servingNetwork, uli))
+	}
+	if timeZone != nil {
+		offset := time.Duration(timeZone.DeltaSeconds) * time.Second
+		ies = append(ies, ie.NewUETimeZone(offset, uint8(timeZone.DaylightSavingTime)))
+	}
+	return ies
+}
+
 func getPDNAddressAllocation(req *protos.CreateSessionRequestPgw) *ie.IE {
 	var (
 		res        *ie.IE
@@ -28,6 +28,10 @@ type TestS8ProxyResponderServer struct {
 	protos.S8ProxyResponderServer
 	ReceivedCreateBearerRequest *protos.CreateBearerRequestPgw
 	DefaultCreateBearerRes      *protos.CreateBearerResponsePgw
+	ReceivedUpdateBearerRequest *protos.UpdateBearerRequestPgw
+	DefaultUpdateBearerRes      *protos.UpdateBearerResponsePgw
+	ReceivedDeleteBearerRequest *protos.DeleteBearerRequestPgw
+	DefaultDeleteBearerRes      *protos.DeleteBearerResponsePgw
 	ListAddr                    string
 }
 
@@ -45,6 +49,26 @@ func (ts *TestS8ProxyResponderServer) CreateBearer(
 	return ts.DefaultCreateBearerRes, nil
 }
 
+func (ts *TestS8ProxyResponderServer) UpdateBearer(
+	ctx context.Context,
+	ubReq *protos.UpdateBearerRequestPgw) (*protos.UpdateBearerResponsePgw, error) {
+	ts.ReceivedUpdateBearerRequest = ubReq
+	if ubReq == nil || ubReq.BearerContext == nil || ubReq.CAgwTeid == 0 {
+		return nil, fmt.Errorf("Update Bearer Request missing Bearer Context or TEID")
+	}
+	return ts.DefaultUpdateBearerRes, nil
+}
+
+func (ts *TestS8ProxyResponderServer) DeleteBearer(
+	ctx context.Context,
+	dbReq *protos.DeleteBearerRequestPgw) (*protos.DeleteBearerResponsePgw, error) {
+	ts.ReceivedDeleteBearerRequest = dbReq
+	if dbReq == nil || (dbReq.EpsBearerId == 0 && dbReq.LinkedBearerId == 0) || dbReq.CAgwTeid == 0 {
+		return nil, fmt.Errorf("Delete Bearer Request missing Bearer Id or TEID")
+	}
+	return ts.DefaultDeleteBearerRes, nil
+}
+
 // StartFegRelayTestService starts a grpc test service
 func StartFegRelayTestService(t *testing.T) (*TestS8ProxyResponderServer, string) {
 	labels := map[string]string{}
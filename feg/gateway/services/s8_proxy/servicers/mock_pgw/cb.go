@@ -73,6 +73,13 @@ func (mPgw *MockPgw) CreateBearerRequest(req CreateBearerRequest) (*message.Crea
 		return nil, errors.New(errMsg)
 	}
 	fmt.Printf("mockPGW received GreateBearerResponse: %s\n", cbRspFromSGW.String())
+
+	// keep track of the dedicated bearer so it can be updated or deleted later
+	if cbRspFromSGW.Cause != nil && cbRspFromSGW.Cause.MustCause() == gtpv2.CauseRequestAccepted {
+		session.AddBearer(
+			fmt.Sprintf("dedicated_%d", req.DedicatedBearereID),
+			gtpv2.NewBearer(req.DedicatedBearereID, "", &gtpv2.QoSProfile{QCI: req.QosQCI}))
+	}
 	return cbRspFromSGW, nil
 }
 
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_pgw
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+type DeleteBearerRequest struct {
+	Imsi     string
+	BearerID uint8
+}
+
+// DeleteBearerRequest sends a Delete Bearer Request to SGW to release a dedicated bearer
+// and waits for its Delete Bearer Response
+func (mPgw *MockPgw) DeleteBearerRequest(req DeleteBearerRequest) (*message.DeleteBearerResponse, error) {
+	session, err := mPgw.GetSessionByIMSI(req.Imsi)
+	if err != nil {
+		return nil, err
+	}
+	dbReqMsg, err := buildDeleteBearerRequestMsg(session, req.BearerID)
+	if err != nil {
+		return nil, err
+	}
+	incomingMsg, err := mPgw.sendAndWaitForSGW(session, dbReqMsg)
+	if err != nil {
+		fmt.Printf("mockPgw couldn't process received DeleteBearerResponse: %s\n", err)
+		return nil, err
+	}
+	return handleDeleteBearerResponse(session, req.BearerID, incomingMsg)
+}
+
+// getHandleDeleteBearerCommand handles a Delete Bearer Command sending back the triggered
+// Delete Bearer Request, or a Delete Bearer Failure Indication if the bearer does not exist
+func (mPgw *MockPgw) getHandleDeleteBearerCommand() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a DeleteBearerCommand")
+		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
+		if err != nil {
+			dbf := message.NewDeleteBearerFailureIndication(
+				0, 0,
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+			)
+			if err := c.RespondTo(sgwAddr, msg, dbf); err != nil {
+				return err
+			}
+			return err
+		}
+
+		dbCmdFromSGW := msg.(*message.DeleteBearerCommand)
+		if dbCmdFromSGW.BearerContexts == nil {
+			return &gtpv2.RequiredIEMissingError{Type: ie.BearerContext}
+		}
+		var ebi uint8
+		for _, childIE := range dbCmdFromSGW.BearerContexts.ChildIEs {
+			if childIE.Type == ie.EPSBearerID {
+				ebi, err = childIE.EPSBearerID()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		dbReqMsg, err := buildDeleteBearerRequestMsg(session, ebi)
+		if err != nil {
+			sgwTeidC, _ := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+			dbf := message.NewDeleteBearerFailureIndication(
+				sgwTeidC, 0,
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+				ie.NewBearerContext(
+					ie.NewEPSBearerID(ebi),
+					ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil)),
+			)
+			return c.RespondTo(sgwAddr, msg, dbf)
+		}
+
+		// Delete Bearer Request triggered by the command uses the same sequence number
+		if err := c.RespondTo(sgwAddr, msg, dbReqMsg); err != nil {
+			return err
+		}
+		incomingMsg, err := session.WaitMessage(msg.Sequence(), mPgw.GtpTimeout)
+		if err != nil {
+			fmt.Printf("mockPgw couldn't process received DeleteBearerResponse: %s\n", err)
+			return err
+		}
+		_, err = handleDeleteBearerResponse(session, ebi, incomingMsg)
+		return err
+	}
+}
+
+// getHandleDeleteBearerRequest just handle Delete Bearer Response and return it back to
+// DeleteBearerRequest function so it can return its result
+func (mPgw *MockPgw) getHandleDeleteBearerRequest() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a DeleteBearerResponse")
+		return mPgw.passMessageToSession(c, sgwAddr, msg)
+	}
+}
+
+func buildDeleteBearerRequestMsg(session *gtpv2.Session, ebi uint8) (message.Message, error) {
+	if _, err := session.LookupBearerByEBI(ebi); err != nil {
+		return nil, err
+	}
+	sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+	if err != nil {
+		err = errors.Wrap(err, "Error, couldnt find teid con Delete Bearer Request")
+		return nil, err
+	}
+	return message.NewDeleteBearerRequest(sgwTeidC, 0,
+		ie.NewEPSBearerID(ebi).WithInstance(1),
+		ie.NewCause(gtpv2.CauseReactivationRequested, 0, 0, 0, nil),
+	), nil
+}
+
+// handleDeleteBearerResponse removes the bearer from the session if SGW accepted the deletion
+func handleDeleteBearerResponse(
+	session *gtpv2.Session, ebi uint8, incomingMsg message.Message) (*message.DeleteBearerResponse, error) {
+	dbRspFromSGW, ok := incomingMsg.(*message.DeleteBearerResponse)
+	if !ok {
+		errMsg := "mockPgw couldn't parse DeleteBearerResponse"
+		fmt.Println(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	fmt.Printf("mockPGW received DeleteBearerResponse: %s\n", dbRspFromSGW.String())
+	if dbRspFromSGW.Cause != nil && dbRspFromSGW.Cause.MustCause() == gtpv2.CauseRequestAccepted {
+		session.RemoveBearerByEBI(ebi)
+	}
+	return dbRspFromSGW, nil
+}
@@ -22,32 +22,58 @@ import (
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
 
-// TODO
 func (mPgw *MockPgw) getHandleModifyBearerRequest() gtpv2.HandlerFunc {
 	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a ModifyBearerRequest")
 		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
 		if err != nil {
-			dsr := message.NewDeleteSessionResponse(
+			mbr := message.NewModifyBearerResponse(
 				0, 0,
-				ie.NewCause(gtpv2.CauseIMSIIMEINotKnown, 0, 0, 0, nil),
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
 			)
-			if err := c.RespondTo(sgwAddr, msg, dsr); err != nil {
+			if err := c.RespondTo(sgwAddr, msg, mbr); err != nil {
 				return err
 			}
 			return err
 		}
-		bearer := session.GetDefaultBearer()
 
-		mbReqFromMME := msg.(*message.ModifyBearerRequest)
-		if brCtxIE := mbReqFromMME.BearerContextsToBeModified; brCtxIE != nil {
+		mbReqFromSGW := msg.(*message.ModifyBearerRequest)
+		if sgwTEID := mbReqFromSGW.SenderFTEIDC; sgwTEID != nil {
+			teid, err := sgwTEID.TEID()
+			if err != nil {
+				return err
+			}
+			session.AddTEID(gtpv2.IFTypeS5S8SGWGTPC, teid)
+		}
+		if uliIE := mbReqFromSGW.ULI; uliIE != nil {
+			mPgw.LastULI, err = uliIE.UserLocationInformation()
+			if err != nil {
+				return err
+			}
+		}
+
+		sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+		if err != nil {
+			return err
+		}
+
+		bearer := session.GetDefaultBearer()
+		if brCtxIE := mbReqFromSGW.BearerContextsToBeModified; brCtxIE != nil {
 			for _, childIE := range brCtxIE.ChildIEs {
 				switch childIE.Type {
-				case ie.Indication:
-					// TODO:
-					// do nothing in this example.
-					// S-GW should change its behavior based on indication flags like;
-					//  - pass Modify Bearer Request to P-GW if handover is indicated.
-					//  - XXX...
+				case ie.EPSBearerID:
+					ebi, err := childIE.EPSBearerID()
+					if err != nil {
+						return err
+					}
+					bearer, err = session.LookupBearerByEBI(ebi)
+					if err != nil {
+						mbr := message.NewModifyBearerResponse(
+							sgwTeidC, 0,
+							ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+						)
+						return c.RespondTo(sgwAddr, msg, mbr)
+					}
 				case ie.FullyQualifiedTEID:
 					if err := handleFTEIDU(childIE, session, bearer); err != nil {
 						return err
@@ -55,6 +81,19 @@ func (mPgw *MockPgw) getHandleModifyBearerRequest() gtpv2.HandlerFunc {
 				}
 			}
 		}
+
+		mbr := message.NewModifyBearerResponse(
+			sgwTeidC, 0,
+			ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			ie.NewBearerContext(
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewEPSBearerID(bearer.EBI),
+			),
+		)
+		if err := c.RespondTo(sgwAddr, msg, mbr); err != nil {
+			return err
+		}
+		fmt.Printf("mock PGW modified bearer %d for: %s\n", bearer.EBI, session.IMSI)
 		return nil
 	}
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_pgw
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+type UpdateBearerRequest struct {
+	Imsi      string
+	BearerID  uint8
+	QosQCI    uint8
+	ApnAmbrUl uint32
+	ApnAmbrDl uint32
+}
+
+// UpdateBearerRequest sends an Update Bearer Request to SGW to change the QoS of an existing bearer
+// and waits for its Update Bearer Response
+func (mPgw *MockPgw) UpdateBearerRequest(req UpdateBearerRequest) (*message.UpdateBearerResponse, error) {
+	session, err := mPgw.GetSessionByIMSI(req.Imsi)
+	if err != nil {
+		return nil, err
+	}
+	bearer, err := session.LookupBearerByEBI(req.BearerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+	if err != nil {
+		err = errors.Wrap(err, "Error, couldnt find teid con Update Bearer Request")
+		return nil, err
+	}
+
+	ubReqMsg := message.NewUpdateBearerRequest(sgwTeidC, 0,
+		ie.NewBearerContext(
+			ie.NewEPSBearerID(req.BearerID),
+			ie.NewBearerQoS(1, 0, 1, req.QosQCI, 0x1111111111, 0x2222222222, 0x1111111111, 0x2222222222),
+		),
+		ie.NewAggregateMaximumBitRate(req.ApnAmbrUl, req.ApnAmbrDl),
+	)
+
+	incomingMsg, err := mPgw.sendAndWaitForSGW(session, ubReqMsg)
+	if err != nil {
+		fmt.Printf("mockPgw couldn't process received UpdateBearerResponse: %s\n", err)
+		return nil, err
+	}
+	ubRspFromSGW, ok := incomingMsg.(*message.UpdateBearerResponse)
+	if !ok {
+		errMsg := "mockPgw couldn't parse UpdateBearerResponse"
+		fmt.Println(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	fmt.Printf("mockPGW received UpdateBearerResponse: %s\n", ubRspFromSGW.String())
+
+	if ubRspFromSGW.Cause != nil && ubRspFromSGW.Cause.MustCause() == gtpv2.CauseRequestAccepted {
+		bearer.QCI = req.QosQCI
+	}
+	return ubRspFromSGW, nil
+}
+
+// getHandleUpdateBearerRequest just handle Update Bearer Response and return it back to
+// UpdateBearerRequest function so it can return its result
+func (mPgw *MockPgw) getHandleUpdateBearerRequest() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a UpdateBearerResponse")
+		return mPgw.passMessageToSession(c, sgwAddr, msg)
+	}
+}
+
+// sendAndWaitForSGW sends a PGW initiated request to SGW and waits for its response
+func (mPgw *MockPgw) sendAndWaitForSGW(session *gtpv2.Session, msg message.Message) (message.Message, error) {
+	sequence, err := mPgw.SendMessageTo(msg, session.PeerAddr())
+	if err != nil {
+		return nil, err
+	}
+	return session.WaitMessage(sequence, mPgw.GtpTimeout)
+}
+
+// passMessageToSession passes a response received from SGW to the session waiting for it
+func (mPgw *MockPgw) passMessageToSession(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+	session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
+	if err != nil {
+		return fmt.Errorf("Mock PGW could not handle %s: %s", msg.MessageTypeName(), err)
+	}
+	if err = gtpv2.PassMessageTo(session, msg, mPgw.GtpTimeout); err != nil {
+		return fmt.Errorf("Mock PGW could not pass the %s %s", msg.MessageTypeName(), err)
+	}
+	return nil
+}
@@ -171,6 +171,149 @@ func parseCreateBearerRequest(msg message.Message) (*protos.CreateBearerRequestP
 	return cbRes, nil, nil
 }
 
+// parseModifyBearerResponse parses a gtp message into a ModifyBearerResponsePgw. In case
+// there is an error it returns the cause of error
+func parseModifyBearerResponse(msg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	mbResGtp := msg.(*message.ModifyBearerResponse)
+	glog.V(2).Infof("Received Modify Bearer Response (gtp):\n%s", mbResGtp.String())
+
+	mbRes := &protos.ModifyBearerResponsePgw{}
+	var err error
+	// check Cause value first.
+	if causeIE := mbResGtp.Cause; causeIE != nil {
+		mbRes.GtpError, err = handleCause(causeIE, msg)
+		if err != nil || mbRes.GtpError != nil {
+			// return either GtpError or err
+			return mbRes, err
+		}
+		// If we get here, the message will be processed
+	} else {
+		mbRes.GtpError = errorIeMissing(ie.Cause)
+		return mbRes, nil
+	}
+
+	mbRes.CAgwTeid = msg.TEID()
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := mbResGtp.PCO; pgwPcoIE != nil {
+		mbRes.ProtocolConfigurationOptions, err = handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, err
+		}
+	}
+
+	// Bearer Contexts modified are conditional
+	// TODO: handle more than one bearer
+	if brCtxIE := mbResGtp.BearerContextsModified; brCtxIE != nil {
+		mbRes.BearerContext, mbRes.GtpError, err = handleBearerCtx(brCtxIE)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mbRes, nil
+}
+
+func parseUpdateBearerRequest(msg message.Message) (*protos.UpdateBearerRequestPgw, *protos.GtpError, error) {
+	ubReqGtp := msg.(*message.UpdateBearerRequest)
+	glog.V(2).Infof("Received Update Bearer Request (gtp):\n%s", ubReqGtp.String())
+
+	ubReq := &protos.UpdateBearerRequestPgw{}
+
+	// cgw control plane teid
+	if !ubReqGtp.HasTEID() {
+		return nil, errorIeMissing(ie.FullyQualifiedTEID), nil
+	}
+	ubReq.CAgwTeid = ubReqGtp.TEID()
+
+	// TODO: handle more than one bearer
+	if brCtxIE := ubReqGtp.BearerContexts; brCtxIE != nil {
+		bearerContext, gtpError, err := handleBearerCtx(brCtxIE)
+		if err != nil || gtpError != nil {
+			return nil, gtpError, err
+		}
+		ubReq.BearerContext = bearerContext
+	} else {
+		return nil, errorIeMissing(ie.BearerContext), nil
+	}
+
+	if ambrIE := ubReqGtp.APNAMBR; ambrIE != nil {
+		ambr, err := ambrIE.AggregateMaximumBitRate()
+		if err != nil {
+			return nil, nil, err
+		}
+		ubReq.ApnAmbr = &protos.Ambr{
+			BrUl: uint64(ambr.APNAMBRForUplink),
+			BrDl: uint64(ambr.APNAMBRForDownlink),
+		}
+	} else {
+		return nil, errorIeMissing(ie.AggregateMaximumBitRate), nil
+	}
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := ubReqGtp.PCO; pgwPcoIE != nil {
+		pco, err := handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, nil, err
+		}
+		ubReq.ProtocolConfigurationOptions = pco
+	}
+	return ubReq, nil, nil
+}
+
+func parseDeleteBearerRequest(msg message.Message) (*protos.DeleteBearerRequestPgw, *protos.GtpError, error) {
+	dbReqGtp := msg.(*message.DeleteBearerRequest)
+	glog.V(2).Infof("Received Delete Bearer Request (gtp):\n%s", dbReqGtp.String())
+
+	dbReq := &protos.DeleteBearerRequestPgw{}
+
+	// cgw control plane teid
+	if !dbReqGtp.HasTEID() {
+		return nil, errorIeMissing(ie.FullyQualifiedTEID), nil
+	}
+	dbReq.CAgwTeid = dbReqGtp.TEID()
+
+	// either the linked bearer (whole PDN connection) or the bearer to be deleted must be present
+	// TODO: handle more than one bearer
+	if linkedEBI := dbReqGtp.LinkedEBI; linkedEBI != nil {
+		ebi, err := linkedEBI.EPSBearerID()
+		if err != nil {
+			return nil, nil, err
+		}
+		dbReq.LinkedBearerId = uint32(ebi)
+	}
+	if bearerEBI := dbReqGtp.EBI; bearerEBI != nil {
+		ebi, err := bearerEBI.EPSBearerID()
+		if err != nil {
+			return nil, nil, err
+		}
+		dbReq.EpsBearerId = uint32(ebi)
+	}
+	if dbReq.LinkedBearerId == 0 && dbReq.EpsBearerId == 0 {
+		return nil, errorIeMissing(ie.EPSBearerID), nil
+	}
+
+	if causeIE := dbReqGtp.Cause; causeIE != nil {
+		cause, err := causeIE.Cause()
+		if err != nil {
+			return nil, nil, err
+		}
+		dbReq.Cause = uint32(cause)
+	}
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := dbReqGtp.PCO; pgwPcoIE != nil {
+		pco, err := handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, nil, err
+		}
+		dbReq.ProtocolConfigurationOptions = pco
+	}
+	return dbReq, nil, nil
+}
+
 func handleCause(causeIE *ie.IE, msg message.Message) (*protos.GtpError, error) {
 	cause, err := causeIE.Cause()
 	if err != nil {
@@ -131,6 +131,66 @@ func (s *S8Proxy) DeleteSession(ctx context.Context, req *protos.DeleteSessionRe
 	return cdRes, nil
 }
 
+func (s *S8Proxy) ModifyBearer(ctx context.Context, req *protos.ModifyBearerRequestPgw) (*protos.ModifyBearerResponsePgw, error) {
+	err := validateModifyBearerRequest(req)
+	if err != nil {
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s:, couldn't validate request: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
+	if err != nil {
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	mbReqMsg, err := buildModifyBearerRequestMsg(cPgwUDPAddr, req)
+	if err != nil {
+		err = fmt.Errorf("Modify Bearer failed to build IEs for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+
+	mbRes, err := s.sendAndReceiveModifyBearer(req, cPgwUDPAddr, mbReqMsg)
+	if err != nil {
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s:, %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	return mbRes, nil
+}
+
+// DeleteBearerCommand sends a Delete Bearer Command to PGW. The bearer will be deleted once PGW
+// sends the Delete Bearer Request back, which is relayed to AGW by deleteBearerRequestHandler
+func (s *S8Proxy) DeleteBearerCommand(
+	ctx context.Context, req *protos.DeleteBearerCommandPgw) (*protos.DeleteBearerCommandResponsePgw, error) {
+	err := validateDeleteBearerCommand(req)
+	if err != nil {
+		err = fmt.Errorf("Delete Bearer Command failed for IMSI %s:, couldn't validate request: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
+	if err != nil {
+		err = fmt.Errorf("Delete Bearer Command failed for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	dbCmdMsg, err := buildDeleteBearerCommandMsg(cPgwUDPAddr, req)
+	if err != nil {
+		err = fmt.Errorf("Delete Bearer Command failed to build IEs for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	err = s.sendDeleteBearerCommand(req, cPgwUDPAddr, dbCmdMsg)
+	if err != nil {
+		err = fmt.Errorf("Delete Bearer Command failed for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	return &protos.DeleteBearerCommandResponsePgw{}, nil
+}
+
 func (s *S8Proxy) SendEcho(_ context.Context, req *protos.EchoRequest) (*protos.EchoResponse, error) {
 	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
 	if err != nil {
@@ -173,3 +233,18 @@ func validateDeleteSessionRequest(dsr *protos.DeleteSessionRequestPgw) error {
 	}
 	return nil
 }
+
+func validateModifyBearerRequest(mbr *protos.ModifyBearerRequestPgw) error {
+	if mbr.Imsi == "" || mbr.BearerContext == nil || mbr.BearerContext.UserPlaneFteid == nil ||
+		mbr.BearerContext.Id == 0 || mbr.Uli == nil || mbr.ServingNetwork == nil {
+		return fmt.Errorf("ModifyBearerRequest missing fields %+v", mbr)
+	}
+	return nil
+}
+
+func validateDeleteBearerCommand(dbc *protos.DeleteBearerCommandPgw) error {
+	if dbc.Imsi == "" || dbc.BearerId == 0 || dbc.Uli == nil || dbc.ServingNetwork == nil {
+		return fmt.Errorf("DeleteBearerCommand missing fields %+v", dbc)
+	}
+	return nil
+}
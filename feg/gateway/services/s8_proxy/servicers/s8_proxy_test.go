@@ -18,6 +18,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -48,6 +49,20 @@ const (
 	PAA             = "10.0.0.10"
 )
 
+var (
+	fegRelayOnce    sync.Once
+	fegRelayTestSrv *mock_feg_relay.TestS8ProxyResponderServer
+	fegRelayTestDir string
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if fegRelayTestDir != "" {
+		os.RemoveAll(fegRelayTestDir)
+	}
+	os.Exit(code)
+}
+
 func TestS8proxyCreateAndDeleteSession(t *testing.T) {
 	// set up client ans server
 	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
@@ -638,8 +653,7 @@ func TestCreateBearerRequest(t *testing.T) {
 
 	test_utils.NewTestService(t, registry.ModuleName, registry.S8_PROXY)
 
-	fegRelayTestSrv, dir := mock_feg_relay.StartFegRelayTestService(t)
-	defer os.RemoveAll(dir)
+	fegRelayTestSrv := getFegRelayTestService(t)
 
 	// force PGW to return specific control plane PGW TEID
 	PgwTEIDc := uint32(111)
@@ -708,6 +722,191 @@ func TestCreateBearerRequest(t *testing.T) {
 	assert.Equal(t, uint32(pgwCreateBearerRequest.BiFilterProtocolId), tftContents.ProtocolIdentifierNextheader)
 }
 
+func TestS8proxyModifyBearer(t *testing.T) {
+	// set up client ans server
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	// force PGW to return specific control plane PGW TEID
+	PgwTEIDc := uint32(111)
+	mockPgw.CreateSessionOptions.PgwTEIDc = PgwTEIDc
+
+	// ------------------------
+	// ---- Create Session ----
+	csReq := getDefaultCreateSessionRequest(mockPgw.LocalAddr().String())
+	csRes, err := s8p.CreateSession(context.Background(), csReq)
+	require.NoError(t, err)
+	require.Nil(t, csRes.GtpError)
+
+	// ------------------------
+	// ---- Modify Bearer ----
+	mbReq := getModifyBearerRequest(mockPgw.LocalAddr().String(), csRes.CPgwFteid.Teid, BEARER)
+	mbRes, err := s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.NotEmpty(t, mbRes)
+	require.Nil(t, mbRes.GtpError)
+	assert.Equal(t, csReq.CAgwTeid, mbRes.CAgwTeid)
+	require.NotNil(t, mbRes.BearerContext)
+	assert.Equal(t, uint32(BEARER), mbRes.BearerContext.Id)
+
+	// check PGW received the new user plane TEID and location
+	session, err := mockPgw.GetSessionByIMSI(IMSI1)
+	require.NoError(t, err)
+	sgwTeidU, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPU)
+	require.NoError(t, err)
+	assert.Equal(t, mbReq.BearerContext.UserPlaneFteid.Teid, sgwTeidU)
+	assert.Equal(t, uint16(mbReq.Uli.Tac), mockPgw.LastULI.TAI.TAC)
+
+	// ------------------------
+	// ---- Modify unknown Bearer ----
+	mbReq = getModifyBearerRequest(mockPgw.LocalAddr().String(), csRes.CPgwFteid.Teid, 15)
+	mbRes, err = s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.NotNil(t, mbRes.GtpError)
+	assert.Equal(t, gtpv2.CauseContextNotFound, uint8(mbRes.GtpError.Cause))
+
+	// ------------------------
+	// ---- Modify Bearer with missing parameters ----
+	mbReq.BearerContext = nil
+	_, err = s8p.ModifyBearer(context.Background(), mbReq)
+	assert.Error(t, err)
+}
+
+func TestUpdateBearerRequest(t *testing.T) {
+	// set up client ans server
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	test_utils.NewTestService(t, registry.ModuleName, registry.S8_PROXY)
+
+	fegRelayTestSrv := getFegRelayTestService(t)
+
+	csReq := createSessionAndDedicatedBearer(t, s8p, mockPgw, fegRelayTestSrv)
+
+	// load the response on Feg Relay test service
+	fegRelayTestSrv.DefaultUpdateBearerRes =
+		&protos.UpdateBearerResponsePgw{
+			CPgwTeid:       uint32(111),
+			ServingNetwork: &protos.ServingNetwork{Mcc: "011", Mnc: "99"},
+			Cause:          uint32(gtpv2.CauseRequestAccepted),
+			BearerContext:  &protos.BearerContext{Id: DEDICATEDBEARER},
+			TimeZone:       &protos.TimeZone{DeltaSeconds: 1, DaylightSavingTime: 1},
+			Uli:            &protos.UserLocationInformation{Rac: 1, Tac: 1},
+		}
+
+	// Send UpdateBearerRequest from PGW to S8_proxy
+	pgwUpdateBearerRequest := mock_pgw.UpdateBearerRequest{
+		Imsi:      IMSI1,
+		BearerID:  DEDICATEDBEARER,
+		QosQCI:    3,
+		ApnAmbrUl: 1000,
+		ApnAmbrDl: 2000,
+	}
+	ubRes, err := mockPgw.UpdateBearerRequest(pgwUpdateBearerRequest)
+	require.NoError(t, err)
+	require.NotEmpty(t, ubRes)
+	assert.Equal(t, gtpv2.CauseRequestAccepted, ubRes.Cause.MustCause())
+
+	// check values received by AGW
+	ubReqReceived := fegRelayTestSrv.ReceivedUpdateBearerRequest
+	require.NotEmpty(t, ubReqReceived)
+	require.NotEmpty(t, ubReqReceived.BearerContext)
+	require.NotEmpty(t, ubReqReceived.BearerContext.Qos)
+	require.NotEmpty(t, ubReqReceived.ApnAmbr)
+	assert.Equal(t, csReq.CAgwTeid, ubReqReceived.CAgwTeid)
+	assert.Equal(t, uint32(DEDICATEDBEARER), ubReqReceived.BearerContext.Id)
+	assert.Equal(t, uint32(pgwUpdateBearerRequest.QosQCI), ubReqReceived.BearerContext.Qos.Qci)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.ApnAmbrUl), ubReqReceived.ApnAmbr.BrUl)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.ApnAmbrDl), ubReqReceived.ApnAmbr.BrDl)
+
+	// check PGW applied the new QoS
+	session, err := mockPgw.GetSessionByIMSI(IMSI1)
+	require.NoError(t, err)
+	bearer, err := session.LookupBearerByEBI(DEDICATEDBEARER)
+	require.NoError(t, err)
+	assert.Equal(t, pgwUpdateBearerRequest.QosQCI, bearer.QCI)
+}
+
+func TestDeleteBearerRequest(t *testing.T) {
+	// set up client ans server
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	test_utils.NewTestService(t, registry.ModuleName, registry.S8_PROXY)
+
+	fegRelayTestSrv := getFegRelayTestService(t)
+
+	csReq := createSessionAndDedicatedBearer(t, s8p, mockPgw, fegRelayTestSrv)
+
+	// load the response on Feg Relay test service
+	fegRelayTestSrv.DefaultDeleteBearerRes = getDeleteBearerResponse()
+
+	// Send DeleteBearerRequest from PGW to S8_proxy
+	dbRes, err := mockPgw.DeleteBearerRequest(
+		mock_pgw.DeleteBearerRequest{Imsi: IMSI1, BearerID: DEDICATEDBEARER})
+	require.NoError(t, err)
+	require.NotEmpty(t, dbRes)
+	assert.Equal(t, gtpv2.CauseRequestAccepted, dbRes.Cause.MustCause())
+
+	// check values received by AGW
+	dbReqReceived := fegRelayTestSrv.ReceivedDeleteBearerRequest
+	require.NotEmpty(t, dbReqReceived)
+	assert.Equal(t, csReq.CAgwTeid, dbReqReceived.CAgwTeid)
+	assert.Equal(t, uint32(DEDICATEDBEARER), dbReqReceived.EpsBearerId)
+
+	// check PGW removed the bearer
+	session, err := mockPgw.GetSessionByIMSI(IMSI1)
+	require.NoError(t, err)
+	_, err = session.LookupBearerByEBI(DEDICATEDBEARER)
+	assert.Error(t, err)
+}
+
+func TestDeleteBearerCommand(t *testing.T) {
+	// set up client ans server
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	test_utils.NewTestService(t, registry.ModuleName, registry.S8_PROXY)
+
+	fegRelayTestSrv := getFegRelayTestService(t)
+
+	csReq := createSessionAndDedicatedBearer(t, s8p, mockPgw, fegRelayTestSrv)
+
+	// load the response on Feg Relay test service
+	fegRelayTestSrv.DefaultDeleteBearerRes = getDeleteBearerResponse()
+
+	// Send DeleteBearerCommand from S8_proxy, PGW will trigger a DeleteBearerRequest
+	dbCmd := &protos.DeleteBearerCommandPgw{
+		PgwAddrs:       mockPgw.LocalAddr().String(),
+		Imsi:           IMSI1,
+		CAgwTeid:       csReq.CAgwTeid,
+		CPgwTeid:       uint32(111),
+		BearerId:       DEDICATEDBEARER,
+		ServingNetwork: csReq.ServingNetwork,
+		Uli:            csReq.Uli,
+	}
+	_, err := s8p.DeleteBearerCommand(context.Background(), dbCmd)
+	require.NoError(t, err)
+
+	// check PGW removed the bearer once the triggered DeleteBearerRequest was answered
+	session, err := mockPgw.GetSessionByIMSI(IMSI1)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := session.LookupBearerByEBI(DEDICATEDBEARER)
+		return err != nil
+	}, 2*time.Second, 50*time.Millisecond)
+
+	dbReqReceived := fegRelayTestSrv.ReceivedDeleteBearerRequest
+	require.NotEmpty(t, dbReqReceived)
+	assert.Equal(t, csReq.CAgwTeid, dbReqReceived.CAgwTeid)
+	assert.Equal(t, uint32(DEDICATEDBEARER), dbReqReceived.EpsBearerId)
+
+	// Delete Bearer Command with missing parameters
+	dbCmd.BearerId = 0
+	_, err = s8p.DeleteBearerCommand(context.Background(), dbCmd)
+	assert.Error(t, err)
+}
+
 func TestS8proxyEcho(t *testing.T) {
 	s8p, mockPgw := startSgwAndPgw(t, 100*time.Second)
 	defer mockPgw.Close()
@@ -946,6 +1145,85 @@ func getDeleteSessionRequest(pgwAddrs string, cPgwTeid uint32) *protos.DeleteSes
 	return res
 }
 
+// createSessionAndDedicatedBearer creates a session on PGW and a dedicated bearer initiated by PGW
+func createSessionAndDedicatedBearer(t *testing.T, s8p *S8Proxy, mockPgw *mock_pgw.MockPgw,
+	fegRelayTestSrv *mock_feg_relay.TestS8ProxyResponderServer) *protos.CreateSessionRequestPgw {
+	// force PGW to return specific control plane PGW TEID
+	mockPgw.CreateSessionOptions.PgwTEIDc = uint32(111)
+
+	csReq := getDefaultCreateSessionRequest(mockPgw.LocalAddr().String())
+	csRes, err := s8p.CreateSession(context.Background(), csReq)
+	require.NoError(t, err)
+	require.Nil(t, csRes.GtpError)
+
+	fegRelayTestSrv.DefaultCreateBearerRes =
+		&protos.CreateBearerResponsePgw{
+			CPgwTeid:                     uint32(111),
+			ServingNetwork:               csReq.ServingNetwork,
+			Cause:                        uint32(gtpv2.CauseRequestAccepted),
+			BearerContext:                csReq.BearerContext,
+			ProtocolConfigurationOptions: csReq.ProtocolConfigurationOptions,
+			TimeZone:                     csReq.TimeZone,
+			Uli:                          csReq.Uli,
+		}
+	cbRes, err := mockPgw.CreateBearerRequest(
+		mock_pgw.CreateBearerRequest{
+			Imsi:               IMSI1,
+			DedicatedBearereID: DEDICATEDBEARER,
+			QosQCI:             7,
+			ChargingID:         99,
+			BiFilterProtocolId: 4,
+			BiFilterPort:       8888,
+		})
+	require.NoError(t, err)
+	require.Equal(t, gtpv2.CauseRequestAccepted, cbRes.Cause.MustCause())
+	return csReq
+}
+
+func getModifyBearerRequest(pgwAddrs string, cPgwTeid uint32, bearerId uint32) *protos.ModifyBearerRequestPgw {
+	return &protos.ModifyBearerRequestPgw{
+		PgwAddrs: pgwAddrs,
+		Imsi:     IMSI1,
+		CAgwTeid: AGWTeidC,
+		CPgwTeid: cPgwTeid,
+		ServingNetwork: &protos.ServingNetwork{
+			Mcc: "222",
+			Mnc: "333",
+		},
+		Uli: &protos.UserLocationInformation{
+			Tac: 10,
+			Eci: 11,
+		},
+		RatType: protos.RATType_EUTRAN,
+		BearerContext: &protos.BearerContext{
+			Id: bearerId,
+			UserPlaneFteid: &protos.Fteid{
+				Ipv4Address: "127.0.0.11",
+				Teid:        AGWTeidU + 1,
+			},
+		},
+	}
+}
+
+func getDeleteBearerResponse() *protos.DeleteBearerResponsePgw {
+	return &protos.DeleteBearerResponsePgw{
+		CPgwTeid:       uint32(111),
+		ServingNetwork: &protos.ServingNetwork{Mcc: "011", Mnc: "99"},
+		Cause:          uint32(gtpv2.CauseRequestAccepted),
+		EpsBearerId:    DEDICATEDBEARER,
+		Uli:            &protos.UserLocationInformation{Rac: 1, Tac: 1},
+	}
+}
+
+// getFegRelayTestService starts the Feg Relay test service only once since control_proxy
+// config is cached and can't be changed for the rest of the tests
+func getFegRelayTestService(t *testing.T) *mock_feg_relay.TestS8ProxyResponderServer {
+	fegRelayOnce.Do(func() {
+		fegRelayTestSrv, fegRelayTestDir = mock_feg_relay.StartFegRelayTestService(t)
+	})
+	return fegRelayTestSrv
+}
+
 func getDefaultConfig(pgwActualAddrs string, gtpTimeout time.Duration) *S8ProxyConfig {
 	return &S8ProxyConfig{
 		GtpTimeout: gtpTimeout,
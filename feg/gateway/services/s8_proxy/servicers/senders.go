@@ -77,3 +77,36 @@ func (s *S8Proxy) sendAndReceiveDeleteSession(req *protos.DeleteSessionRequestPg
 	glog.V(2).Infof("Delete Session Response (grpc):\n%s", dsRes.String())
 	return dsRes, err
 }
+
+// sendAndReceiveModifyBearer sends modify bearer request GTP-C message to PGW and
+// waits for its answers.
+// Returns a GRPC message translated from the GTP-C modify bearer response
+func (s *S8Proxy) sendAndReceiveModifyBearer(req *protos.ModifyBearerRequestPgw,
+	cPgwUDPAddr *net.UDPAddr,
+	mbReqMsg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	glog.V(2).Infof("Send Modify Bearer Request (grpc) to %s:\n%s", cPgwUDPAddr, req.String())
+	glog.V(2).Infof("Send Modify Bearer Request (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), mbReqMsg.(*message.ModifyBearerRequest).String())
+	grpcMessage, err := s.gtpClient.SendMessageAndExtractGrpc(req.Imsi, req.CAgwTeid, cPgwUDPAddr, mbReqMsg)
+	if err != nil {
+		return nil, fmt.Errorf("no response message to ModifyBearerRequest: %s", err)
+	}
+	mbRes, ok := grpcMessage.(*protos.ModifyBearerResponsePgw)
+	if !ok {
+		return nil, fmt.Errorf("Wrong response type (no ModifyBearerResponse), maybe received out of order response message: %s", err)
+	}
+	glog.V(2).Infof("Modify Bearer Response (grpc):\n%s", mbRes.String())
+	return mbRes, nil
+}
+
+// sendDeleteBearerCommand sends delete bearer command GTP-C message to PGW. There is no response
+// to this command, PGW will trigger a Delete Bearer Request (or a Delete Bearer Failure Indication)
+func (s *S8Proxy) sendDeleteBearerCommand(req *protos.DeleteBearerCommandPgw,
+	cPgwUDPAddr *net.UDPAddr,
+	dbCmdMsg message.Message) error {
+	glog.V(2).Infof("Send Delete Bearer Command (grpc) to %s:\n%s", cPgwUDPAddr, req.String())
+	glog.V(2).Infof("Send Delete Bearer Command (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), dbCmdMsg.(*message.DeleteBearerCommand).String())
+	_, err := s.gtpClient.SendMessageTo(dbCmdMsg, cPgwUDPAddr)
+	return err
+}
@@ -43,6 +43,7 @@ const (
 	None RequestKeyNamespace = iota
 	Gx
 	Gy
+	Sy
 )
 
 type SubscriptionIDType uint8
@@ -41,6 +41,8 @@ const (
 	RevalidationTimeout      EventTrigger = 17
 	UsageReportTrigger       EventTrigger = 33
 	PCRF91UsageReportTrigger EventTrigger = 26
+
+	SuccessfulResourceAllocation EventTrigger = 22
 )
 
 // CreditControlRequest represents a call over gx
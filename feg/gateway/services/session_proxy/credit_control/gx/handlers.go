@@ -61,6 +61,9 @@ func ccaHandler(message *diam.Message) diameter.KeyAndAnswer {
 
 type PolicyReAuthHandler func(request *PolicyReAuthRequest) *PolicyReAuthAnswer
 
+// SubscriberReAuthRelay relays a policy reauth request to all the sessions of a subscriber
+type SubscriberReAuthRelay func(imsi string, request *PolicyReAuthRequest) *PolicyReAuthAnswer
+
 // Factory function for a RAR message handler which relays to the corresponding
 // gateway.
 func GetGxReAuthHandler(cloudRegistry service_registry.GatewayRegistry, policyDBClient policydb.PolicyDBClient) PolicyReAuthHandler {
@@ -75,25 +78,48 @@ func GetGxReAuthHandler(cloudRegistry service_registry.GatewayRegistry, policyDB
 			}
 		}
 
-		client, err := relay.GetSessionProxyResponderClient(cloudRegistry)
-		if err != nil {
-			glog.Error(err)
-			return &PolicyReAuthAnswer{
-				SessionID:  request.SessionID,
-				ResultCode: diam.UnableToDeliver,
-			}
+		return RelayPolicyReAuth(cloudRegistry, policyDBClient, imsi, sid, request)
+	}
+}
+
+// GetSubscriberReAuthRelay returns a function relaying policy reauth requests to all the sessions of
+// a subscriber. It is used by other interfaces (such as Rx) to push policy changes through the Gx path
+func GetSubscriberReAuthRelay(
+	cloudRegistry service_registry.GatewayRegistry,
+	policyDBClient policydb.PolicyDBClient,
+) SubscriberReAuthRelay {
+	return func(imsi string, request *PolicyReAuthRequest) *PolicyReAuthAnswer {
+		// an empty session ID applies the request to all the sessions of the subscriber
+		return RelayPolicyReAuth(cloudRegistry, policyDBClient, imsi, "", request)
+	}
+}
+
+// RelayPolicyReAuth converts the reauth request and relays it to the gateway serving the session
+// identified by imsi & sid
+func RelayPolicyReAuth(
+	cloudRegistry service_registry.GatewayRegistry,
+	policyDBClient policydb.PolicyDBClient,
+	imsi, sid string,
+	request *PolicyReAuthRequest,
+) *PolicyReAuthAnswer {
+	client, err := relay.GetSessionProxyResponderClient(cloudRegistry)
+	if err != nil {
+		glog.Error(err)
+		return &PolicyReAuthAnswer{
+			SessionID:  request.SessionID,
+			ResultCode: diam.UnableToDeliver,
 		}
-		defer client.Close()
+	}
+	defer client.Close()
 
-		gwReq := request.ToProto(imsi, sid, policyDBClient)
-		ans, err := client.PolicyReAuth(context.Background(), gwReq)
-		if err != nil {
-			glog.Errorf("Error relaying Gx reauth request to gateway: %s", err)
-			return &PolicyReAuthAnswer{
-				SessionID:  request.SessionID,
-				ResultCode: diam.UnableToDeliver,
-			}
+	gwReq := request.ToProto(imsi, sid, policyDBClient)
+	ans, err := client.PolicyReAuth(context.Background(), gwReq)
+	if err != nil {
+		glog.Errorf("Error relaying Gx reauth request to gateway: %s", err)
+		return &PolicyReAuthAnswer{
+			SessionID:  request.SessionID,
+			ResultCode: diam.UnableToDeliver,
 		}
-		return (&PolicyReAuthAnswer{}).FromProto(request.SessionID, ans)
 	}
+	return (&PolicyReAuthAnswer{}).FromProto(request.SessionID, ans)
 }
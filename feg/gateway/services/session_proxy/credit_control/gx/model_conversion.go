@@ -262,6 +262,8 @@ func GetEventTriggersRelatedInfo(
 		case RevalidationTimeout:
 			protoRevalidationTime = ConvertToProtoTimestamp(revalidationTime)
 			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger(eventTrigger))
+		case SuccessfulResourceAllocation:
+			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger_SUCCESSFUL_RESOURCE_ALLOCATION)
 		default:
 			protoEventTriggers = append(protoEventTriggers, protos.EventTrigger_UNSUPPORTED)
 		}
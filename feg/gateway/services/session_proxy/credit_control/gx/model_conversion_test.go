@@ -67,7 +67,8 @@ func TestReAuthRequest_ToProto(t *testing.T) {
 			{RuleNames: []string{"install3"}, RuleBaseNames: []string{}},
 			{RuleNames: []string{}, RuleBaseNames: []string{"baseInstall2", "baseInstall3"}},
 		},
-		EventTriggers:    []gx.EventTrigger{gx.UsageReportTrigger, gx.RevalidationTimeout},
+		EventTriggers: []gx.EventTrigger{
+			gx.UsageReportTrigger, gx.RevalidationTimeout, gx.SuccessfulResourceAllocation},
 		RevalidationTime: &currentTime,
 		UsageMonitors: []*gx.UsageMonitoringInfo{
 			{
@@ -144,6 +145,7 @@ func TestReAuthRequest_ToProto(t *testing.T) {
 		EventTriggers: []protos.EventTrigger{
 			protos.EventTrigger_UNSUPPORTED,
 			protos.EventTrigger_REVALIDATION_TIMEOUT,
+			protos.EventTrigger_SUCCESSFUL_RESOURCE_ALLOCATION,
 		},
 		RevalidationTime: protoTimestamp,
 		UsageMonitoringCredits: []*protos.UsageMonitoringCredit{
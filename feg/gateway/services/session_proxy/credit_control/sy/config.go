@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+
+	"magma/feg/gateway/diameter"
+)
+
+// Sy Environment Variables
+const (
+	EnableSyEnv           = "ENABLE_SY"
+	SyOCSAddrEnv          = "SY_OCS_ADDR"
+	SyNetworkEnv          = "SY_NETWORK"
+	SyLocalAddrEnv        = "SY_LOCAL_ADDR"
+	SyOCSHostEnv          = "SY_OCS_HOST"
+	SyOCSRealmEnv         = "SY_OCS_REALM"
+	SyDiamHostEnv         = "SY_DIAM_HOST"
+	SyDiamRealmEnv        = "SY_DIAM_REALM"
+	SyDiamProductEnv      = "SY_DIAM_PRODUCT"
+	SyDisableDestHostEnv  = "SY_DISABLE_DEST_HOST"
+	SyPolicyCounterIDsEnv = "SY_POLICY_COUNTER_IDS"
+
+	EnableSyFlag = "enable_sy"
+
+	DefaultSyOCSAddr = "127.0.0.1:3870"
+)
+
+var (
+	_ = flag.Bool(EnableSyFlag, false, "Enable Sy spending limit reporting")
+)
+
+// IsSyEnabled returns true if session proxy should subscribe to OCS policy counters over Sy
+func IsSyEnabled() bool {
+	return diameter.GetBoolValueOrEnv(EnableSyFlag, EnableSyEnv, false)
+}
+
+// GetSyServerConfiguration returns the server configuration for the Sy OCS
+func GetSyServerConfiguration() *diameter.DiameterServerConfig {
+	return &diameter.DiameterServerConfig{
+		DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:      diameter.GetValueOrEnv("", SyOCSAddrEnv, DefaultSyOCSAddr),
+			Protocol:  diameter.GetValueOrEnv("", SyNetworkEnv, "tcp"),
+			LocalAddr: diameter.GetValueOrEnv("", SyLocalAddrEnv, ""),
+		},
+		DestHost:        diameter.GetValueOrEnv("", SyOCSHostEnv, ""),
+		DestRealm:       diameter.GetValueOrEnv("", SyOCSRealmEnv, ""),
+		DisableDestHost: diameter.GetBoolValueOrEnv("", SyDisableDestHostEnv, false),
+	}
+}
+
+// GetSyClientConfiguration returns the client diameter configuration for Sy
+func GetSyClientConfiguration() *diameter.DiameterClientConfig {
+	return &diameter.DiameterClientConfig{
+		Host:             diameter.GetValueOrEnv("", SyDiamHostEnv, diameter.DiamHost),
+		Realm:            diameter.GetValueOrEnv("", SyDiamRealmEnv, diameter.DiamRealm),
+		ProductName:      diameter.GetValueOrEnv("", SyDiamProductEnv, diameter.DiamProductName),
+		AppID:            diam.DIAMETER_SY_APP_ID,
+		WatchdogInterval: diameter.DefaultWatchdogIntervalSeconds,
+		RetryCount:       1,
+	}
+}
+
+// GetPolicyCounterIDs returns the list of policy counters to subscribe to in the initial SLR,
+// if empty the OCS reports all counters available for the subscriber
+func GetPolicyCounterIDs() []string {
+	ids := []string{}
+	for _, id := range strings.Split(diameter.GetValueOrEnv("", SyPolicyCounterIDsEnv, ""), ",") {
+		if id = strings.TrimSpace(id); len(id) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
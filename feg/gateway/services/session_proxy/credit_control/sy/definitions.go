@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Spending Limit (Sy) constants and structs to be used in sending/receiving messages
+package sy
+
+import (
+	"time"
+)
+
+// SLRequestType is the SL-Request-Type AVP value, see 3GPP TS 29.219 section 5.3.6
+type SLRequestType uint32
+
+const (
+	// InitialRequest is sent on session establishment and subscribes to policy counter status changes
+	InitialRequest SLRequestType = 0
+	// IntermediateRequest modifies the set of policy counters the session is subscribed to
+	IntermediateRequest SLRequestType = 1
+)
+
+type SpendingLimitRequest struct {
+	SessionID string
+	IMSI      string
+	Type      SLRequestType
+	// PolicyCounterIDs lists counters to subscribe to, if empty the OCS reports all
+	// counters available for the subscriber
+	PolicyCounterIDs []string
+}
+
+type SpendingLimitAnswer struct {
+	SessionID      string
+	ResultCode     uint32
+	OriginHost     string
+	PolicyCounters []*PolicyCounterStatusReport
+}
+
+type SessionTerminationRequest struct {
+	SessionID string
+	IMSI      string
+}
+
+type SessionTerminationAnswer struct {
+	SessionID  string
+	ResultCode uint32
+	OriginHost string
+}
+
+// PolicyCounterStatusReport is the Policy-Counter-Status-Report grouped AVP
+type PolicyCounterStatusReport struct {
+	PolicyCounterID string                             `avp:"Policy-Counter-Identifier"`
+	Status          string                             `avp:"Policy-Counter-Status"`
+	PendingStatuses []*PendingPolicyCounterInformation `avp:"Pending-Policy-Counter-Information"`
+}
+
+// PendingPolicyCounterInformation is a counter status which becomes effective at ChangeTime
+type PendingPolicyCounterInformation struct {
+	Status     string     `avp:"Policy-Counter-Status"`
+	ChangeTime *time.Time `avp:"Pending-Policy-Counter-Change-Time"`
+}
+
+// SLADiameterMessage is a Spending-Limit-Answer received from the OCS
+type SLADiameterMessage struct {
+	SessionID      string                       `avp:"Session-Id"`
+	ResultCode     uint32                       `avp:"Result-Code"`
+	OriginHost     string                       `avp:"Origin-Host"`
+	PolicyCounters []*PolicyCounterStatusReport `avp:"Policy-Counter-Status-Report"`
+}
+
+// STADiameterMessage is a Session-Termination-Answer received from the OCS
+type STADiameterMessage struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+	OriginHost string `avp:"Origin-Host"`
+}
+
+// SpendingStatusNotificationRequest is sent by the OCS when the status of a
+// subscribed policy counter changes
+type SpendingStatusNotificationRequest struct {
+	SessionID      string                       `avp:"Session-Id"`
+	PolicyCounters []*PolicyCounterStatusReport `avp:"Policy-Counter-Status-Report"`
+}
+
+// SpendingStatusNotificationAnswer is sent back to the OCS after the notification
+// was relayed to the gateway
+type SpendingStatusNotificationAnswer struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy
+
+import (
+	"context"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/relay"
+	"magma/gateway/service_registry"
+	"magma/lte/cloud/go/protos"
+)
+
+// GetSyNotificationHandler returns the default handler for SNR messages, it relays
+// policy counter status changes to the gateway as a policy reauth request which installs
+// the static rules of the new counter statuses and removes the rules of their other statuses
+func GetSyNotificationHandler(
+	cloudRegistry service_registry.GatewayRegistry, rules PolicyCounterRules) SpendingStatusNotificationHandler {
+	return func(request *SpendingStatusNotificationRequest) *SpendingStatusNotificationAnswer {
+		sid := diameter.DecodeSessionID(request.SessionID)
+		imsi, err := protos.GetIMSIwithPrefixFromSessionId(sid)
+		if err != nil {
+			glog.Errorf("Error retrieving IMSI from session ID %s: %s", request.SessionID, err)
+			return &SpendingStatusNotificationAnswer{
+				SessionID:  request.SessionID,
+				ResultCode: diam.UnknownSessionID,
+			}
+		}
+		client, err := relay.GetSessionProxyResponderClient(cloudRegistry)
+		if err != nil {
+			glog.Error(err)
+			return &SpendingStatusNotificationAnswer{
+				SessionID:  request.SessionID,
+				ResultCode: diam.UnableToDeliver,
+			}
+		}
+		defer client.Close()
+
+		_, err = client.PolicyReAuth(context.Background(), &protos.PolicyReAuthRequest{
+			SessionId:      sid,
+			Imsi:           imsi,
+			RulesToRemove:  rules.RulesToRemove(request.PolicyCounters),
+			RulesToInstall: rules.RulesToInstall(request.PolicyCounters),
+			PolicyCounters: PolicyCountersToProto(request.PolicyCounters),
+		})
+		if err != nil {
+			glog.Errorf("Error relaying Sy spending status notification to gateway: %s", err)
+			return &SpendingStatusNotificationAnswer{
+				SessionID:  request.SessionID,
+				ResultCode: diam.UnableToDeliver,
+			}
+		}
+		return &SpendingStatusNotificationAnswer{
+			SessionID:  request.SessionID,
+			ResultCode: diam.Success,
+		}
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy
+
+import (
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+
+	"magma/lte/cloud/go/protos"
+)
+
+// ToProto converts the Policy-Counter-Status-Report AVP to its session proto representation
+func (report *PolicyCounterStatusReport) ToProto() *protos.PolicyCounterStatus {
+	status := &protos.PolicyCounterStatus{
+		PolicyCounterId: report.PolicyCounterID,
+		Status:          report.Status,
+		PendingStatuses: make([]*protos.PendingPolicyCounterStatus, 0, len(report.PendingStatuses)),
+	}
+	for _, pending := range report.PendingStatuses {
+		pendingStatus := &protos.PendingPolicyCounterStatus{Status: pending.Status}
+		if pending.ChangeTime != nil {
+			changeTime, err := ptypes.TimestampProto(*pending.ChangeTime)
+			if err != nil {
+				glog.Errorf("Invalid change time of pending policy counter %s status: %v", report.PolicyCounterID, err)
+				continue
+			}
+			pendingStatus.ChangeTime = changeTime
+		}
+		status.PendingStatuses = append(status.PendingStatuses, pendingStatus)
+	}
+	return status
+}
+
+// PolicyCountersToProto converts a list of Policy-Counter-Status-Report AVPs to session protos
+func PolicyCountersToProto(reports []*PolicyCounterStatusReport) []*protos.PolicyCounterStatus {
+	if len(reports) == 0 {
+		return nil
+	}
+	statuses := make([]*protos.PolicyCounterStatus, 0, len(reports))
+	for _, report := range reports {
+		if report != nil {
+			statuses = append(statuses, report.ToProto())
+		}
+	}
+	return statuses
+}
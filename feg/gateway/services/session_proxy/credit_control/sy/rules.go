@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"magma/feg/gateway/diameter"
+	"magma/lte/cloud/go/protos"
+)
+
+// SyPolicyCounterRulesEnv configures the static rules enforced for policy counter statuses,
+// as a comma separated list of <policy counter ID>:<status>=<rule ID>[;<rule ID>...] entries
+const SyPolicyCounterRulesEnv = "SY_POLICY_COUNTER_RULES"
+
+// PolicyCounterRules maps the statuses of policy counters to the static rules installed
+// while a counter has the status, by policy counter ID and status
+type PolicyCounterRules map[string]map[string][]string
+
+// GetPolicyCounterRules returns the policy counter status rules configured in the environment
+func GetPolicyCounterRules() PolicyCounterRules {
+	rules, err := ParsePolicyCounterRules(diameter.GetValueOrEnv("", SyPolicyCounterRulesEnv, ""))
+	if err != nil {
+		glog.Errorf("Invalid %s: %v", SyPolicyCounterRulesEnv, err)
+	}
+	return rules
+}
+
+// ParsePolicyCounterRules parses a comma separated list of
+// <policy counter ID>:<status>=<rule ID>[;<rule ID>...] entries, invalid entries are skipped
+func ParsePolicyCounterRules(str string) (PolicyCounterRules, error) {
+	rules := PolicyCounterRules{}
+	var invalid []string
+	for _, entry := range strings.Split(str, ",") {
+		if entry = strings.TrimSpace(entry); len(entry) == 0 {
+			continue
+		}
+		eq := strings.Index(entry, "=")
+		colon := strings.Index(entry, ":")
+		if eq < 0 || colon <= 0 || colon > eq {
+			invalid = append(invalid, entry)
+			continue
+		}
+		counterID, status := strings.TrimSpace(entry[:colon]), strings.TrimSpace(entry[colon+1:eq])
+		if len(counterID) == 0 || len(status) == 0 {
+			invalid = append(invalid, entry)
+			continue
+		}
+		if rules[counterID] == nil {
+			rules[counterID] = map[string][]string{}
+		}
+		for _, ruleID := range strings.Split(entry[eq+1:], ";") {
+			if ruleID = strings.TrimSpace(ruleID); len(ruleID) > 0 {
+				rules[counterID][status] = append(rules[counterID][status], ruleID)
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return rules, fmt.Errorf("invalid policy counter rule entries: %s", strings.Join(invalid, ", "))
+	}
+	return rules, nil
+}
+
+// RulesToInstall returns the static rules of the reported policy counter statuses. Rules of a
+// pending status are activated at the status' change time, when the rules of the preceding
+// status are deactivated.
+func (rules PolicyCounterRules) RulesToInstall(reports []*PolicyCounterStatusReport) []*protos.StaticRuleInstall {
+	var installs []*protos.StaticRuleInstall
+	for _, report := range reports {
+		statusRules := rules[report.PolicyCounterID]
+		if statusRules == nil {
+			continue
+		}
+		timeline := getStatusTimeline(report)
+		// a rule enforced by consecutive statuses is installed once
+		installsByRule := map[string]*protos.StaticRuleInstall{}
+		for i, period := range timeline {
+			var until *timestamp.Timestamp
+			if i+1 < len(timeline) {
+				until = timeline[i+1].from
+			}
+			for _, ruleID := range statusRules[period.status] {
+				// the deactivation time of the preceding period is this period's start
+				install, found := installsByRule[ruleID]
+				if found && install.DeactivationTime != nil && install.DeactivationTime == period.from {
+					install.DeactivationTime = until
+					continue
+				}
+				install = &protos.StaticRuleInstall{RuleId: ruleID, ActivationTime: period.from, DeactivationTime: until}
+				installsByRule[ruleID] = install
+				installs = append(installs, install)
+			}
+		}
+	}
+	return installs
+}
+
+// RulesToRemove returns the static rules of the reported policy counters which are not enforced
+// by their reported statuses
+func (rules PolicyCounterRules) RulesToRemove(reports []*PolicyCounterStatusReport) []string {
+	installed := map[string]bool{}
+	for _, install := range rules.RulesToInstall(reports) {
+		installed[install.RuleId] = true
+	}
+	removed := map[string]bool{}
+	for _, report := range reports {
+		for _, ruleIDs := range rules[report.PolicyCounterID] {
+			for _, ruleID := range ruleIDs {
+				if !installed[ruleID] {
+					removed[ruleID] = true
+				}
+			}
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	ret := make([]string, 0, len(removed))
+	for ruleID := range removed {
+		ret = append(ret, ruleID)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+type statusPeriod struct {
+	status string
+	// from is nil for the current status
+	from *timestamp.Timestamp
+}
+
+// getStatusTimeline returns the current and pending statuses of a policy counter, ordered
+// by their change time
+func getStatusTimeline(report *PolicyCounterStatusReport) []statusPeriod {
+	pending := make([]*PendingPolicyCounterInformation, 0, len(report.PendingStatuses))
+	for _, p := range report.PendingStatuses {
+		if p != nil && p.ChangeTime != nil {
+			pending = append(pending, p)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].ChangeTime.Before(*pending[j].ChangeTime) })
+
+	timeline := []statusPeriod{{status: report.Status}}
+	for _, p := range pending {
+		from, err := ptypes.TimestampProto(*p.ChangeTime)
+		if err != nil {
+			glog.Errorf("Invalid change time of pending policy counter %s status: %v", report.PolicyCounterID, err)
+			continue
+		}
+		timeline = append(timeline, statusPeriod{status: p.Status, from: from})
+	}
+	return timeline
+}
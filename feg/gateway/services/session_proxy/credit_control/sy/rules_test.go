@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
+	"magma/lte/cloud/go/protos"
+)
+
+func TestParsePolicyCounterRules(t *testing.T) {
+	rules, err := sy.ParsePolicyCounterRules("daily-quota:valid=full-speed, daily-quota:exhausted=throttle;notify,monthly-quota:exhausted=block")
+	assert.NoError(t, err)
+	assert.Equal(t, sy.PolicyCounterRules{
+		"daily-quota":   {"valid": {"full-speed"}, "exhausted": {"throttle", "notify"}},
+		"monthly-quota": {"exhausted": {"block"}},
+	}, rules)
+
+	rules, err = sy.ParsePolicyCounterRules("daily-quota=throttle,:valid=full-speed,daily-quota:exhausted=throttle")
+	assert.EqualError(t, err, "invalid policy counter rule entries: daily-quota=throttle, :valid=full-speed")
+	assert.Equal(t, sy.PolicyCounterRules{"daily-quota": {"exhausted": {"throttle"}}}, rules)
+
+	rules, err = sy.ParsePolicyCounterRules("")
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestPolicyCounterRules(t *testing.T) {
+	rules := sy.PolicyCounterRules{
+		"daily-quota": {"valid": {"full-speed", "video"}, "near-exhausted": {"video"}, "exhausted": {"throttle"}},
+	}
+	nearExhausted, exhausted := time.Unix(1600000000, 0), time.Unix(1600003600, 0)
+	reports := []*sy.PolicyCounterStatusReport{
+		{
+			PolicyCounterID: "daily-quota",
+			Status:          "valid",
+			PendingStatuses: []*sy.PendingPolicyCounterInformation{
+				{Status: "exhausted", ChangeTime: &exhausted},
+				{Status: "near-exhausted", ChangeTime: &nearExhausted},
+			},
+		},
+		{PolicyCounterID: "monthly-quota", Status: "exhausted"},
+	}
+
+	installs := rules.RulesToInstall(reports)
+	assert.Len(t, installs, 3)
+	assert.Equal(t, "full-speed", installs[0].RuleId)
+	assert.Nil(t, installs[0].ActivationTime)
+	assert.Equal(t, nearExhausted.Unix(), installs[0].DeactivationTime.GetSeconds())
+	// video is enforced by both the valid and near-exhausted statuses
+	assert.Equal(t, "video", installs[1].RuleId)
+	assert.Nil(t, installs[1].ActivationTime)
+	assert.Equal(t, exhausted.Unix(), installs[1].DeactivationTime.GetSeconds())
+	assert.Equal(t, "throttle", installs[2].RuleId)
+	assert.Equal(t, exhausted.Unix(), installs[2].ActivationTime.GetSeconds())
+	assert.Nil(t, installs[2].DeactivationTime)
+	assert.Empty(t, rules.RulesToRemove(reports))
+
+	reports = []*sy.PolicyCounterStatusReport{{PolicyCounterID: "daily-quota", Status: "exhausted"}}
+	assert.Equal(t, []*protos.StaticRuleInstall{{RuleId: "throttle"}}, rules.RulesToInstall(reports))
+	assert.Equal(t, []string{"full-speed", "video"}, rules.RulesToRemove(reports))
+
+	assert.Empty(t, sy.PolicyCounterRules(nil).RulesToInstall(reports))
+	assert.Empty(t, sy.PolicyCounterRules(nil).RulesToRemove(reports))
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// SyClient is a client to send Spending Limit Request messages over diameter
+// And receive Spending Limit Answer & Spending Status Notification messages from the OCS
+package sy
+
+import (
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/metrics"
+)
+
+// Sy request keys use the command code instead of the request number, Sy has no
+// request numbers & SLR/STR for the same session are tracked separately
+const (
+	slrRequestKeyNumber = uint32(diam.SpendingLimit)
+	strRequestKeyNumber = uint32(diam.SessionTermination)
+)
+
+type SpendingLimitClient interface {
+	SendSpendingLimitRequest(
+		server *diameter.DiameterServerConfig,
+		done chan interface{},
+		request *SpendingLimitRequest,
+	) error
+	SendSessionTerminationRequest(
+		server *diameter.DiameterServerConfig,
+		done chan interface{},
+		request *SessionTerminationRequest,
+	) error
+	IgnoreAnswer(sessionID string)
+	EnableConnections() error
+	DisableConnections(period time.Duration)
+}
+
+// SpendingStatusNotificationHandler defines a function that responds to a SNR message with an SNA
+type SpendingStatusNotificationHandler func(request *SpendingStatusNotificationRequest) *SpendingStatusNotificationAnswer
+
+// SyClient holds the relevant state for sending and receiving diameter calls
+// over Sy
+type SyClient struct {
+	diamClient *diameter.Client
+	serverCfg  *diameter.DiameterServerConfig
+}
+
+// NewConnectedSyClient constructs a new SyClient using the given diameter client
+func NewConnectedSyClient(
+	diamClient *diameter.Client,
+	serverCfg *diameter.DiameterServerConfig,
+	notificationHandler SpendingStatusNotificationHandler,
+) *SyClient {
+	diamClient.RegisterAnswerHandlerForAppID(diam.SpendingLimit, diam.DIAMETER_SY_APP_ID, slaHandler)
+	diamClient.RegisterAnswerHandlerForAppID(diam.SessionTermination, diam.DIAMETER_SY_APP_ID, staHandler)
+	if notificationHandler != nil {
+		registerNotificationHandler(notificationHandler, diamClient)
+	}
+	return &SyClient{
+		diamClient: diamClient,
+		serverCfg:  serverCfg,
+	}
+}
+
+// NewSyClient constructs a new SyClient with the magma diameter settings
+func NewSyClient(
+	clientCfg *diameter.DiameterClientConfig,
+	serverCfg *diameter.DiameterServerConfig,
+	notificationHandler SpendingStatusNotificationHandler,
+) *SyClient {
+	diamClient := diameter.NewClient(clientCfg)
+	diamClient.BeginConnection(serverCfg)
+	return NewConnectedSyClient(diamClient, serverCfg, notificationHandler)
+}
+
+// SendSpendingLimitRequest sends a Spending Limit Request to the given server
+// and sends the *SpendingLimitAnswer on done once received
+// Output: error if server connection failed
+func (syClient *SyClient) SendSpendingLimitRequest(
+	server *diameter.DiameterServerConfig,
+	done chan interface{},
+	request *SpendingLimitRequest,
+) error {
+	m := syClient.newSyRequest(diam.SpendingLimit, request.SessionID)
+	m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(credit_control.EndUserIMSI)),
+			diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(request.IMSI)),
+		},
+	})
+	m.NewAVP(SLRequestTypeAVP, avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Type))
+	for _, counterID := range request.PolicyCounterIDs {
+		m.NewAVP(PolicyCounterIdentifierAVP, avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(counterID))
+	}
+	glog.V(2).Infof("Sending Sy SLR message:\n%s\n", m)
+	key := credit_control.GetRequestKey(credit_control.Sy, request.SessionID, slrRequestKeyNumber)
+	return syClient.diamClient.SendRequest(server, done, m, key)
+}
+
+// SendSessionTerminationRequest sends a Session Termination Request to the given server
+// to unsubscribe the session from all policy counter status notifications
+// Output: error if server connection failed
+func (syClient *SyClient) SendSessionTerminationRequest(
+	server *diameter.DiameterServerConfig,
+	done chan interface{},
+	request *SessionTerminationRequest,
+) error {
+	m := syClient.newSyRequest(diam.SessionTermination, request.SessionID)
+	// Termination-Cause: DIAMETER_LOGOUT
+	m.NewAVP(avp.TerminationCause, avp.Mbit, 0, datatype.Enumerated(1))
+	glog.V(2).Infof("Sending Sy STR message:\n%s\n", m)
+	key := credit_control.GetRequestKey(credit_control.Sy, request.SessionID, strRequestKeyNumber)
+	return syClient.diamClient.SendRequest(server, done, m, key)
+}
+
+// IgnoreAnswer removes tracked SLR & STR requests of the given session in the request
+// manager to ensure the request mapping does not leak
+func (syClient *SyClient) IgnoreAnswer(sessionID string) {
+	syClient.diamClient.IgnoreAnswer(credit_control.GetRequestKey(credit_control.Sy, sessionID, slrRequestKeyNumber))
+	syClient.diamClient.IgnoreAnswer(credit_control.GetRequestKey(credit_control.Sy, sessionID, strRequestKeyNumber))
+}
+
+func (syClient *SyClient) EnableConnections() error {
+	syClient.diamClient.EnableConnectionCreation()
+	return syClient.diamClient.BeginConnection(syClient.serverCfg)
+}
+
+func (syClient *SyClient) DisableConnections(period time.Duration) {
+	syClient.diamClient.DisableConnectionCreation(period)
+}
+
+// GetSpendingLimitAnswer returns a *SpendingLimitAnswer from the given interface channel
+func GetSpendingLimitAnswer(done <-chan interface{}) *SpendingLimitAnswer {
+	answer := <-done
+	return answer.(*SpendingLimitAnswer)
+}
+
+// GetSessionTerminationAnswer returns a *SessionTerminationAnswer from the given interface channel
+func GetSessionTerminationAnswer(done <-chan interface{}) *SessionTerminationAnswer {
+	answer := <-done
+	return answer.(*SessionTerminationAnswer)
+}
+
+// newSyRequest creates a base Sy request message with the common AVPs for the given command
+func (syClient *SyClient) newSyRequest(command uint32, sessionID string) *diam.Message {
+	m := diameter.NewProxiableRequest(command, diam.DIAMETER_SY_APP_ID, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0,
+		datatype.UTF8String(diameter.EncodeSessionID(syClient.diamClient.OriginHost(), sessionID)))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.DIAMETER_SY_APP_ID))
+	return m
+}
+
+// slaHandler parses a Spending Limit Answer & returns the `KeyAndAnswer` packed inside it
+func slaHandler(message *diam.Message) diameter.KeyAndAnswer {
+	glog.V(2).Infof("Received Sy SLA message:\n%s\n", message)
+	var sla SLADiameterMessage
+	if err := message.Unmarshal(&sla); err != nil {
+		metrics.SyUnparseableMsg.Inc()
+		glog.Errorf("Received unparseable SLA over Sy: %s", err)
+		return diameter.KeyAndAnswer{}
+	}
+	sid := diameter.DecodeSessionID(sla.SessionID)
+	return diameter.KeyAndAnswer{
+		Key: credit_control.GetRequestKey(credit_control.Sy, sid, slrRequestKeyNumber),
+		Answer: &SpendingLimitAnswer{
+			SessionID:      sid,
+			ResultCode:     sla.ResultCode,
+			OriginHost:     sla.OriginHost,
+			PolicyCounters: sla.PolicyCounters,
+		},
+	}
+}
+
+// staHandler parses a Session Termination Answer & returns the `KeyAndAnswer` packed inside it
+func staHandler(message *diam.Message) diameter.KeyAndAnswer {
+	glog.V(2).Infof("Received Sy STA message:\n%s\n", message)
+	var sta STADiameterMessage
+	if err := message.Unmarshal(&sta); err != nil {
+		metrics.SyUnparseableMsg.Inc()
+		glog.Errorf("Received unparseable STA over Sy: %s", err)
+		return diameter.KeyAndAnswer{}
+	}
+	sid := diameter.DecodeSessionID(sta.SessionID)
+	return diameter.KeyAndAnswer{
+		Key: credit_control.GetRequestKey(credit_control.Sy, sid, strRequestKeyNumber),
+		Answer: &SessionTerminationAnswer{
+			SessionID:  sid,
+			ResultCode: sta.ResultCode,
+			OriginHost: sta.OriginHost,
+		},
+	}
+}
+
+// registerNotificationHandler adds a handler to the client for responding to SNR
+// messages received from the OCS
+func registerNotificationHandler(handler SpendingStatusNotificationHandler, diamClient *diameter.Client) {
+	reqHandler := func(conn diam.Conn, message *diam.Message) {
+		glog.V(2).Infof("Received Sy SNR message:\n%s\n", message)
+		snr := &SpendingStatusNotificationRequest{}
+		if err := message.Unmarshal(snr); err != nil {
+			metrics.SyUnparseableMsg.Inc()
+			glog.Errorf("Received unparseable SNR over Sy %s\n%s", message, err)
+			return
+		}
+		go func() {
+			sna := handler(snr)
+			snaMsg := message.Answer(sna.ResultCode)
+			snaMsg.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sna.SessionID)))
+			snaMsg = diamClient.AddOriginAVPsToMessage(snaMsg)
+			glog.V(2).Infof("Sending (responding) Sy SNA message:\n%s\n", snaMsg)
+			_, err := snaMsg.WriteToWithRetry(conn, diamClient.Retries())
+			if err != nil {
+				glog.Errorf(
+					"Sy SNA Write Failed for %s->%s, SessionID: %s - %v",
+					conn.LocalAddr(), conn.RemoteAddr(), snr.SessionID, err)
+				conn.Close() // close connection on error
+			}
+		}()
+	}
+	diamClient.RegisterRequestHandlerForAppID(SpendingStatusNotification, diam.DIAMETER_SY_APP_ID, reqHandler)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy_test
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
+	"magma/feg/gateway/services/testcore/ocs/mock_ocs"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	testIMSI  = "000000000000001"
+	testIMSI2 = "000000000000002"
+)
+
+// TestSyClient tests SLR, SNR & STR exchanges using a mock OCS
+func TestSyClient(t *testing.T) {
+	serverConfig := diameter.DiameterServerConfig{
+		DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:     "127.0.0.1:0",
+			Protocol: "tcp",
+		},
+	}
+	clientConfig := &diameter.DiameterClientConfig{
+		Host:        "test.test.com",
+		Realm:       "test.com",
+		ProductName: "sy_test",
+		AppID:       diam.DIAMETER_SY_APP_ID,
+	}
+	ocs := startServer(clientConfig, &serverConfig)
+	_, err := ocs.CreateAccount(context.Background(), &protos.SubscriberID{Id: testIMSI})
+	assert.NoError(t, err)
+	assert.NoError(t, ocs.SetPolicyCounterStatus(testIMSI, "daily-quota", "valid"))
+	assert.NoError(t, ocs.SetPolicyCounterStatus(testIMSI, "monthly-quota", "valid"))
+
+	notifications := make(chan *sy.SpendingStatusNotificationRequest, 1)
+	syClient := sy.NewSyClient(clientConfig, &serverConfig,
+		func(request *sy.SpendingStatusNotificationRequest) *sy.SpendingStatusNotificationAnswer {
+			notifications <- request
+			return &sy.SpendingStatusNotificationAnswer{SessionID: request.SessionID, ResultCode: diam.Success}
+		})
+
+	// initial SLR for a single counter
+	done := make(chan interface{}, 10)
+	slr := &sy.SpendingLimitRequest{
+		SessionID:        "IMSI000000000000001-1234",
+		IMSI:             testIMSI,
+		Type:             sy.InitialRequest,
+		PolicyCounterIDs: []string{"daily-quota"},
+	}
+	assert.NoError(t, syClient.SendSpendingLimitRequest(&serverConfig, done, slr))
+	sla := sy.GetSpendingLimitAnswer(done)
+	assert.Equal(t, slr.SessionID, sla.SessionID)
+	assert.Equal(t, uint32(diam.Success), sla.ResultCode)
+	assert.Len(t, sla.PolicyCounters, 1)
+	assert.Equal(t, "daily-quota", sla.PolicyCounters[0].PolicyCounterID)
+	assert.Equal(t, "valid", sla.PolicyCounters[0].Status)
+
+	// status change of the subscribed counter is notified
+	sna, err := ocs.NotifyPolicyCounterStatus(testIMSI, "daily-quota", "exhausted")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), sna.ResultCode)
+	select {
+	case snr := <-notifications:
+		assert.Equal(t, slr.SessionID, diameter.DecodeSessionID(snr.SessionID))
+		assert.Len(t, snr.PolicyCounters, 1)
+		assert.Equal(t, &protos.PolicyCounterStatus{
+			PolicyCounterId: "daily-quota",
+			Status:          "exhausted",
+			PendingStatuses: []*protos.PendingPolicyCounterStatus{},
+		}, snr.PolicyCounters[0].ToProto())
+	case <-time.After(time.Second):
+		assert.Fail(t, "SNR was not received")
+	}
+	// not subscribed counter
+	_, err = ocs.NotifyPolicyCounterStatus(testIMSI, "monthly-quota", "exhausted")
+	assert.Error(t, err)
+
+	// SLR for unknown subscriber
+	assert.NoError(t, syClient.SendSpendingLimitRequest(&serverConfig, done, &sy.SpendingLimitRequest{
+		SessionID: "IMSI000000000000002-1234",
+		IMSI:      testIMSI2,
+		Type:      sy.InitialRequest,
+	}))
+	sla = sy.GetSpendingLimitAnswer(done)
+	assert.Equal(t, uint32(mock_ocs.DiameterErrorUserUnknown), sla.ResultCode)
+
+	// STR unsubscribes the session
+	str := &sy.SessionTerminationRequest{SessionID: slr.SessionID, IMSI: testIMSI}
+	assert.NoError(t, syClient.SendSessionTerminationRequest(&serverConfig, done, str))
+	sta := sy.GetSessionTerminationAnswer(done)
+	assert.Equal(t, slr.SessionID, sta.SessionID)
+	assert.Equal(t, uint32(diam.Success), sta.ResultCode)
+	_, err = ocs.NotifyPolicyCounterStatus(testIMSI, "daily-quota", "valid")
+	assert.Error(t, err)
+}
+
+func TestPolicyCountersToProto(t *testing.T) {
+	changeTime := time.Unix(1600000000, 0)
+	statuses := sy.PolicyCountersToProto([]*sy.PolicyCounterStatusReport{
+		{
+			PolicyCounterID: "daily-quota",
+			Status:          "valid",
+			PendingStatuses: []*sy.PendingPolicyCounterInformation{{Status: "exhausted", ChangeTime: &changeTime}},
+		},
+	})
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, "daily-quota", statuses[0].GetPolicyCounterId())
+	assert.Equal(t, "valid", statuses[0].GetStatus())
+	assert.Len(t, statuses[0].GetPendingStatuses(), 1)
+	assert.Equal(t, "exhausted", statuses[0].GetPendingStatuses()[0].GetStatus())
+	assert.Equal(t, int64(1600000000), statuses[0].GetPendingStatuses()[0].GetChangeTime().GetSeconds())
+
+	assert.Nil(t, sy.PolicyCountersToProto(nil))
+}
+
+func startServer(client *diameter.DiameterClientConfig, server *diameter.DiameterServerConfig) *mock_ocs.OCSDiamServer {
+	serverStarted := make(chan struct{})
+	var ocs *mock_ocs.OCSDiamServer
+	go func() {
+		log.Printf("Starting server")
+		ocs = mock_ocs.NewOCSDiamServer(client, &mock_ocs.OCSConfig{ServerConfig: server})
+		lis, err := ocs.StartListener()
+		if err != nil {
+			log.Fatalf("Could not start listener, %s", err.Error())
+			return
+		}
+		server.Addr = lis.Addr().String()
+		log.Printf("Server Addr: %v", server.Addr)
+		serverStarted <- struct{}{}
+		err = ocs.Start(lis)
+		if err != nil {
+			log.Fatalf("Could not start server, %s", err.Error())
+			return
+		}
+	}()
+	<-serverStarted
+	time.Sleep(time.Millisecond)
+	return ocs
+}
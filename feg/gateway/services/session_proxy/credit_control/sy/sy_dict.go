@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sy
+
+import (
+	"bytes"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+)
+
+// Sy commands & AVPs, go-diameter defines Sy SLR/SLA but does not load them in its default dictionary
+// (3GPP TS 29.219)
+const (
+	SpendingStatusNotification = 8388636
+
+	PolicyCounterIdentifierAVP         = 2901
+	PolicyCounterStatusAVP             = 2902
+	PolicyCounterStatusReportAVP       = 2903
+	SLRequestTypeAVP                   = 2904
+	PendingPolicyCounterInformationAVP = 2905
+	PendingPolicyCounterChangeTimeAVP  = 2906
+	SNRequestTypeAVP                   = 2907
+)
+
+// syDictExtension defines SLR/SLA, SNR/SNA & policy counter AVPs, see 3GPP TS 29.219 sections 5.3 & 5.6.
+// Subscription-Id AVPs are only defined for Credit Control app in the default dictionary and
+// are redefined here, so they can be parsed in Sy messages
+const syDictExtension = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+    <application id="16777302" type="auth" name="Diameter Sy">
+        <vendor id="10415" name="TGPP"/>
+
+        <command code="8388635" short="SL" name="Spending-Limit">
+            <request>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Auth-Application-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Destination-Realm" required="true" max="1"/>
+                <rule avp="Destination-Host" required="false" max="1"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="SL-Request-Type" required="true" max="1"/>
+                <rule avp="Subscription-Id" required="false"/>
+                <rule avp="Policy-Counter-Identifier" required="false"/>
+                <rule avp="Proxy-Info" required="false"/>
+                <rule avp="Route-Record" required="false"/>
+            </request>
+            <answer>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Result-Code" required="false" max="1"/>
+                <rule avp="Experimental-Result" required="false" max="1"/>
+                <rule avp="Policy-Counter-Status-Report" required="false"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="Error-Message" required="false" max="1"/>
+                <rule avp="Error-Reporting-Host" required="false" max="1"/>
+                <rule avp="Failed-AVP" required="false" max="1"/>
+                <rule avp="Proxy-Info" required="false"/>
+            </answer>
+        </command>
+
+        <command code="8388636" short="SN" name="Spending-Status-Notification">
+            <request>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Auth-Application-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Destination-Realm" required="true" max="1"/>
+                <rule avp="Destination-Host" required="true" max="1"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="Policy-Counter-Status-Report" required="false"/>
+                <rule avp="SN-Request-Type" required="false" max="1"/>
+                <rule avp="Proxy-Info" required="false"/>
+                <rule avp="Route-Record" required="false"/>
+            </request>
+            <answer>
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Result-Code" required="false" max="1"/>
+                <rule avp="Experimental-Result" required="false" max="1"/>
+                <rule avp="Origin-State-Id" required="false" max="1"/>
+                <rule avp="Error-Message" required="false" max="1"/>
+                <rule avp="Error-Reporting-Host" required="false" max="1"/>
+                <rule avp="Failed-AVP" required="false" max="1"/>
+                <rule avp="Proxy-Info" required="false"/>
+            </answer>
+        </command>
+
+        <avp name="Policy-Counter-Identifier" code="2901" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="UTF8String"/>
+        </avp>
+        <avp name="Policy-Counter-Status" code="2902" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="UTF8String"/>
+        </avp>
+        <avp name="Policy-Counter-Status-Report" code="2903" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="Policy-Counter-Identifier" required="true" max="1"/>
+                <rule avp="Policy-Counter-Status" required="true" max="1"/>
+                <rule avp="Pending-Policy-Counter-Information" required="false"/>
+            </data>
+        </avp>
+        <avp name="SL-Request-Type" code="2904" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="INITIAL_REQUEST"/>
+                <item code="1" name="INTERMEDIATE_REQUEST"/>
+            </data>
+        </avp>
+        <avp name="Pending-Policy-Counter-Information" code="2905" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="Policy-Counter-Status" required="true" max="1"/>
+                <rule avp="Pending-Policy-Counter-Change-Time" required="true" max="1"/>
+            </data>
+        </avp>
+        <avp name="Pending-Policy-Counter-Change-Time" code="2906" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="Time"/>
+        </avp>
+        <avp name="SN-Request-Type" code="2907" must="V" may="P" must-not="M" may-encrypt="Y" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+
+        <avp name="Subscription-Id" code="443" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="Grouped">
+                <rule avp="Subscription-Id-Type" required="true" max="1"/>
+                <rule avp="Subscription-Id-Data" required="true" max="1"/>
+            </data>
+        </avp>
+        <avp name="Subscription-Id-Data" code="444" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="UTF8String"/>
+        </avp>
+        <avp name="Subscription-Id-Type" code="450" must="M" may="P" must-not="V" may-encrypt="Y">
+            <data type="Enumerated">
+                <item code="0" name="END_USER_E164"/>
+                <item code="1" name="END_USER_IMSI"/>
+                <item code="2" name="END_USER_SIP_URI"/>
+                <item code="3" name="END_USER_NAI"/>
+                <item code="4" name="END_USER_PRIVATE"/>
+            </data>
+        </avp>
+    </application>
+</diameter>`
+
+func init() {
+	err := dict.Default.Load(bytes.NewReader([]byte(syDictExtension)))
+	if err != nil {
+		panic(err)
+	}
+}
@@ -133,6 +133,31 @@ var (
 		Name: "gy_failures_since_last_success",
 		Help: "The total number of gy request failures since the last successful request completed",
 	})
+
+	OcsSlrInitialRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ocs_slr_initial_requests_total",
+		Help: "Total number of initial SLR requests sent to OCS",
+	})
+	OcsSlrInitialSendFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ocs_slr_initial_send_failures_total",
+		Help: "Total number of initial SLR requests that failed to send to OCS",
+	})
+	OcsSyStrRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ocs_sy_str_requests_total",
+		Help: "Total number of Sy STR requests sent to OCS",
+	})
+	OcsSyStrSendFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ocs_sy_str_send_failures_total",
+		Help: "Total number of Sy STR requests that failed to send to OCS",
+	})
+	SyUnparseableMsg = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sy_unparseable_msg_total",
+		Help: "Total number of sy messages received that cannot be parsed",
+	})
+	SyTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sy_timeouts_total",
+		Help: "Total number of sy timeouts",
+	})
 )
 
 type SessionHealthTracker struct {
@@ -165,7 +190,9 @@ func init() {
 		PcrfCcrTerminateRequests, PcrfCcrTerminateSendFailures, OcsCcrInitRequests, OcsCcrInitSendFailures,
 		OcsCcrUpdateRequests, OcsCcrUpdateSendFailures, OcsCcrTerminateRequests, OcsCcrTerminateSendFailures,
 		GxUnparseableMsg, GyUnparseableMsg, GxTimeouts, GyTimeouts, GxResultCodes, GyResultCodes,
-		GxSuccessTimestamp, GxFailuresSinceLastSuccess, GySuccessTimestamp, GyFailuresSinceLastSuccess)
+		GxSuccessTimestamp, GxFailuresSinceLastSuccess, GySuccessTimestamp, GyFailuresSinceLastSuccess,
+		OcsSlrInitialRequests, OcsSlrInitialSendFailures, OcsSyStrRequests, OcsSyStrSendFailures,
+		SyUnparseableMsg, SyTimeouts)
 }
 
 func NewSessionHealthTracker() *SessionHealthTracker {
@@ -381,3 +408,18 @@ func ReportTerminateGySession(err error) {
 		OcsCcrTerminateRequests.Inc()
 	}
 }
+
+func ReportCreateSySession(err error) {
+	if err != nil {
+		OcsSlrInitialSendFailures.Inc()
+	}
+	OcsSlrInitialRequests.Inc()
+}
+
+func ReportTerminateSySession(err error) {
+	if err != nil {
+		OcsSyStrSendFailures.Inc()
+	} else {
+		OcsSyStrRequests.Inc()
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// AFNotifier notifies the application functions bound to IP-CAN sessions (over Rx) of the
+// sessions' establishment, termination & bearer events
+type AFNotifier interface {
+	IPCANSessionEstablished(req *fegprotos.IPCANSessionEstablishment) error
+	IPCANSessionTerminated(req *fegprotos.IPCANSessionTermination) error
+	NotifyBearerEvent(req *fegprotos.BearerEventNotification) error
+}
+
+// bearerEvents maps the bearer event triggers reported by the gateway to the AF bearer events
+var bearerEvents = map[protos.EventTrigger]fegprotos.BearerEventNotification_BearerEvent{
+	protos.EventTrigger_SUCCESSFUL_RESOURCE_ALLOCATION: fegprotos.BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION,
+}
+
+// notifyIPCANSessionEstablished binds the UE IP address of the created session to its subscriber
+// for the AF sessions identified by their framed IP address
+func (srv *CentralSessionController) notifyIPCANSessionEstablished(request *protos.CreateSessionRequest) {
+	if srv.afNotifier == nil {
+		return
+	}
+	err := srv.afNotifier.IPCANSessionEstablished(&fegprotos.IPCANSessionEstablishment{
+		Imsi:   request.GetCommonContext().GetSid().GetId(),
+		UeIpv4: request.GetCommonContext().GetUeIpv4(),
+	})
+	if err != nil {
+		glog.Errorf("Failed to notify IP-CAN session %s establishment to AF: %s", request.GetSessionId(), err)
+	}
+}
+
+// notifyIPCANSessionTerminated notifies the AF sessions bound to the terminated session
+func (srv *CentralSessionController) notifyIPCANSessionTerminated(request *protos.SessionTerminateRequest) {
+	if srv.afNotifier == nil {
+		return
+	}
+	err := srv.afNotifier.IPCANSessionTerminated(&fegprotos.IPCANSessionTermination{
+		Imsi:   request.GetCommonContext().GetSid().GetId(),
+		UeIpv4: request.GetCommonContext().GetUeIpv4(),
+	})
+	if err != nil {
+		glog.Errorf("Failed to notify IP-CAN session %s termination to AF: %s", request.GetSessionId(), err)
+	}
+}
+
+// notifyBearerEvents notifies the AF sessions bound to the updated sessions of the bearer events
+// reported in the usage monitoring updates
+func (srv *CentralSessionController) notifyBearerEvents(updates []*protos.UsageMonitoringUpdateRequest) {
+	if srv.afNotifier == nil {
+		return
+	}
+	for _, update := range updates {
+		event, found := bearerEvents[update.GetEventTrigger()]
+		if !found {
+			continue
+		}
+		err := srv.afNotifier.NotifyBearerEvent(&fegprotos.BearerEventNotification{
+			Imsi:   update.GetSid(),
+			UeIpv4: update.GetUeIpv4(),
+			Event:  event,
+		})
+		if err != nil {
+			glog.Errorf("Failed to notify %s of session %s to AF: %s", event, update.GetSessionId(), err)
+		}
+	}
+}
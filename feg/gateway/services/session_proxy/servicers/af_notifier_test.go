@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/multiplex"
+	"magma/feg/gateway/policydb"
+	mockPolicyDB "magma/feg/gateway/policydb/mocks"
+	"magma/feg/gateway/services/session_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type afNotifierRecorder struct {
+	sync.Mutex
+	establishments []*fegprotos.IPCANSessionEstablishment
+	terminations   []*fegprotos.IPCANSessionTermination
+	bearerEvents   []*fegprotos.BearerEventNotification
+}
+
+func (r *afNotifierRecorder) IPCANSessionEstablished(req *fegprotos.IPCANSessionEstablishment) error {
+	r.Lock()
+	defer r.Unlock()
+	r.establishments = append(r.establishments, req)
+	return nil
+}
+
+func (r *afNotifierRecorder) IPCANSessionTerminated(req *fegprotos.IPCANSessionTermination) error {
+	r.Lock()
+	defer r.Unlock()
+	r.terminations = append(r.terminations, req)
+	return nil
+}
+
+func (r *afNotifierRecorder) NotifyBearerEvent(req *fegprotos.BearerEventNotification) error {
+	r.Lock()
+	defer r.Unlock()
+	r.bearerEvents = append(r.bearerEvents, req)
+	return nil
+}
+
+func TestSessionEventsNotifiedToAF(t *testing.T) {
+	mockConfig := getTestConfig()
+	mockControlParams := getMockControllerParams(mockConfig)
+	mockMux := getMockMultiplexor(NUMBER_SERVERS)
+	idx, err := mockMux.GetIndex(multiplex.NewContext().WithIMSI(IMSI1))
+	assert.NoError(t, err)
+	recorder := &afNotifierRecorder{}
+	mockControlParams[idx].AFNotifier = recorder
+	mockControlParams[idx].Config.DisableGx = true
+	mockControlParams[idx].Config.DisableGy = true
+	mockPolicyDBClient := &mockPolicyDB.PolicyDBClient{}
+	// no omnipresent rules
+	mockPolicyDBClient.On("GetOmnipresentRules").Return([]string{}, []string{}).Once()
+	mockPolicyDBClient.On("GetChargingKeysForRules", mock.Anything, mock.Anything).Return(
+		[]policydb.ChargingKey{}, nil).Once()
+	srv := servicers.NewCentralSessionControllers(mockControlParams, mockPolicyDBClient, mockMux)
+	ctx := context.Background()
+
+	_, err = srv.CreateSession(ctx, &protos.CreateSessionRequest{
+		SessionId: genSessionID(IMSI1),
+		CommonContext: &protos.CommonSessionContext{
+			Sid:    &protos.SubscriberID{Id: IMSI1},
+			UeIpv4: "192.168.128.12",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*fegprotos.IPCANSessionEstablishment{{Imsi: IMSI1, UeIpv4: "192.168.128.12"}}, recorder.establishments)
+
+	// only bearer event triggers reported by the gateway are notified
+	_, err = srv.UpdateSession(ctx, &protos.UpdateSessionRequest{
+		UsageMonitors: []*protos.UsageMonitoringUpdateRequest{
+			{Sid: IMSI1, SessionId: genSessionID(IMSI1), UeIpv4: "192.168.128.12", EventTrigger: protos.EventTrigger_SUCCESSFUL_RESOURCE_ALLOCATION},
+			{Sid: IMSI1, SessionId: genSessionID(IMSI1), UeIpv4: "192.168.128.12", EventTrigger: protos.EventTrigger_REVALIDATION_TIMEOUT},
+			{Sid: IMSI1, SessionId: genSessionID(IMSI1), UeIpv4: "192.168.128.12", EventTrigger: protos.EventTrigger_LOSS_OF_BEARER},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*fegprotos.BearerEventNotification{{
+		Imsi:   IMSI1,
+		UeIpv4: "192.168.128.12",
+		Event:  fegprotos.BearerEventNotification_SUCCESSFUL_RESOURCE_ALLOCATION,
+	}}, recorder.bearerEvents)
+
+	_, err = srv.TerminateSession(ctx, &protos.SessionTerminateRequest{
+		SessionId:     genSessionID(IMSI1),
+		CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}, UeIpv4: "192.168.128.12"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*fegprotos.IPCANSessionTermination{{Imsi: IMSI1, UeIpv4: "192.168.128.12"}}, recorder.terminations)
+}
@@ -23,6 +23,7 @@ import (
 	"magma/feg/gateway/policydb"
 	"magma/feg/gateway/services/session_proxy/credit_control/gx"
 	"magma/feg/gateway/services/session_proxy/credit_control/gy"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
 	"magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/errors"
 	orcprotos "magma/orc8r/lib/go/protos"
@@ -58,9 +59,11 @@ type CentralSessionControllers struct {
 }
 
 type ControllerParam struct {
-	CreditClient gy.CreditClient
-	PolicyClient gx.PolicyClient
-	Config       *SessionControllerConfig
+	CreditClient        gy.CreditClient
+	PolicyClient        gx.PolicyClient
+	SpendingLimitClient sy.SpendingLimitClient
+	AFNotifier          AFNotifier
+	Config              *SessionControllerConfig
 }
 
 // NewCentralSessionControllers creates centralControllers which is a slice of centralController.
@@ -73,7 +76,8 @@ func NewCentralSessionControllers(
 	totalLen := len(controlParam)
 	controllers := make([]*CentralSessionController, 0, totalLen)
 	for _, cp := range controlParam {
-		singleController := NewCentralSessionController(cp.CreditClient, cp.PolicyClient, dbClient, cp.Config)
+		singleController := NewCentralSessionController(
+			cp.CreditClient, cp.PolicyClient, cp.SpendingLimitClient, cp.AFNotifier, dbClient, cp.Config)
 		controllers = append(controllers, singleController)
 	}
 	return &CentralSessionControllers{
@@ -92,7 +96,8 @@ func NewCentralSessionControllerDefaultMultiplexWithHealth(
 ) (CentralSessionControllerServerWithHealth, error) {
 	if len(controlParam) == 1 {
 		cp := controlParam[0]
-		return NewCentralSessionController(cp.CreditClient, cp.PolicyClient, dbClient, cp.Config), nil
+		return NewCentralSessionController(
+			cp.CreditClient, cp.PolicyClient, cp.SpendingLimitClient, cp.AFNotifier, dbClient, cp.Config), nil
 	}
 	mux, err := multiplex.NewStaticMultiplexByIMSI(len(controlParam))
 	if err != nil {
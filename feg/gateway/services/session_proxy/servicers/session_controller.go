@@ -25,6 +25,7 @@ import (
 	"magma/feg/gateway/services/session_proxy/credit_control"
 	"magma/feg/gateway/services/session_proxy/credit_control/gx"
 	"magma/feg/gateway/services/session_proxy/credit_control/gy"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
 	"magma/feg/gateway/services/session_proxy/metrics"
 	"magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/errors"
@@ -37,11 +38,13 @@ import (
 // CentralSessionController acts as the gRPC server for accepting calls from
 // gateways to start new UE sessions and retrieve traffic policy and credits.
 type CentralSessionController struct {
-	creditClient  gy.CreditClient
-	policyClient  gx.PolicyClient
-	dbClient      policydb.PolicyDBClient
-	cfg           *SessionControllerConfig
-	healthTracker *metrics.SessionHealthTracker
+	creditClient        gy.CreditClient
+	policyClient        gx.PolicyClient
+	spendingLimitClient sy.SpendingLimitClient // optional, nil if Sy is disabled
+	afNotifier          AFNotifier             // optional, nil if Rx is disabled
+	dbClient            policydb.PolicyDBClient
+	cfg                 *SessionControllerConfig
+	healthTracker       *metrics.SessionHealthTracker
 }
 
 // SessionControllerConfig stores all the needed configuration for running
@@ -59,6 +62,12 @@ type SessionControllerConfig struct {
 	UseGyForAuthOnly bool
 	DisableGx        bool
 	DisableGy        bool
+	// SyConfig is the Sy OCS server, used only if the controller has a spending limit client
+	SyConfig *diameter.DiameterServerConfig
+	// PolicyCounterIDs are the policy counters to subscribe to over Sy, all if empty
+	PolicyCounterIDs []string
+	// PolicyCounterRules are the static rules installed for the policy counter statuses
+	PolicyCounterRules sy.PolicyCounterRules
 }
 
 // NewCentralSessionController constructs a CentralSessionController
@@ -66,15 +75,19 @@ type SessionControllerConfig struct {
 func NewCentralSessionController(
 	creditClient gy.CreditClient,
 	policyClient gx.PolicyClient,
+	spendingLimitClient sy.SpendingLimitClient,
+	afNotifier AFNotifier,
 	dbClient policydb.PolicyDBClient,
 	cfg *SessionControllerConfig,
 ) *CentralSessionController {
 	return &CentralSessionController{
-		creditClient:  creditClient,
-		policyClient:  policyClient,
-		dbClient:      dbClient,
-		cfg:           cfg,
-		healthTracker: metrics.NewSessionHealthTracker(),
+		creditClient:        creditClient,
+		policyClient:        policyClient,
+		spendingLimitClient: spendingLimitClient,
+		afNotifier:          afNotifier,
+		dbClient:            dbClient,
+		cfg:                 cfg,
+		healthTracker:       metrics.NewSessionHealthTracker(),
 	}
 }
 
@@ -113,8 +126,13 @@ func (srv *CentralSessionController) CreateSession(
 	// Gy: only send Gy if it is Enabled and flag Online is true (1)
 	if !srv.cfg.DisableGy {
 		if srv.cfg.UseGyForAuthOnly {
-			return srv.handleUseGyForAuthOnly(
+			resp, err := srv.handleUseGyForAuthOnly(
 				imsi, request, staticRuleInstalls, dynamicRuleInstalls, gxCCAInit)
+			if err == nil {
+				srv.addInitialPolicyCounters(imsi, request.SessionId, resp)
+				srv.notifyIPCANSessionEstablished(request)
+			}
+			return resp, err
 		}
 		if !gx.Int32ToBoolean(gxCCAInit.Online) {
 			glog.V(2).Info("Online AVP (1009) is 0. Not sending Gy CCI-R")
@@ -132,7 +150,7 @@ func (srv *CentralSessionController) CreateSession(
 	}
 	usageMonitors := getUsageMonitorsFromCCA_I(imsi, request.SessionId, gyOriginHost, gxCCAInit)
 
-	return &protos.CreateSessionResponse{
+	resp := &protos.CreateSessionResponse{
 		Credits:          credits,
 		StaticRules:      staticRuleInstalls,
 		DynamicRules:     dynamicRuleInstalls,
@@ -143,7 +161,10 @@ func (srv *CentralSessionController) CreateSession(
 		RevalidationTime: revalidationTime,
 		Online:           gx.Int32ToBoolean(gxCCAInit.Online),
 		Offline:          gx.Int32ToBoolean(gxCCAInit.Offline),
-	}, nil
+	}
+	srv.addInitialPolicyCounters(imsi, request.SessionId, resp)
+	srv.notifyIPCANSessionEstablished(request)
+	return resp, nil
 }
 
 func (srv *CentralSessionController) handleUseGyForAuthOnly(
@@ -206,7 +227,7 @@ func (srv *CentralSessionController) UpdateSession(
 ) (*protos.UpdateSessionResponse, error) {
 	// Then send out updates
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 
 	var (
 		gxUpdateResponses []*protos.UsageMonitoringUpdateResponse
@@ -250,6 +271,10 @@ func (srv *CentralSessionController) UpdateSession(
 			}
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		srv.notifyBearerEvents(request.UsageMonitors)
+	}()
 	wg.Wait()
 
 	// Update destination hosts in all results with common SIDs
@@ -279,7 +304,7 @@ func (srv *CentralSessionController) TerminateSession(
 	request *protos.SessionTerminateRequest,
 ) (*protos.SessionTerminateResponse, error) {
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(4)
 	go func() {
 		defer wg.Done()
 		if srv.cfg.DisableGx {
@@ -305,6 +330,24 @@ func (srv *CentralSessionController) TerminateSession(
 			return
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		// only sessions with a successful SLR have a Sy session to terminate, their context has the OCS host
+		if srv.spendingLimitClient == nil || len(request.GetTgppCtx().GetSyDestHost()) == 0 {
+			return
+		}
+		imsi := credit_control.RemoveIMSIPrefix(request.GetCommonContext().GetSid().GetId())
+		_, err := srv.sendTerminationSyRequest(imsi, request.SessionId)
+		metrics.ReportTerminateSySession(err)
+		if err != nil {
+			glog.Errorf("Error sending sy termination: %s", err)
+			return
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		srv.notifyIPCANSessionTerminated(request)
+	}()
 	wg.Wait()
 	// in the event of any errors on Gx or Gy, the session should regardless be
 	// terminated, so there are no errors sent back
@@ -329,6 +372,9 @@ func (srv *CentralSessionController) Disable(
 	if !srv.cfg.DisableGy {
 		srv.creditClient.DisableConnections(time.Duration(req.DisablePeriodSecs) * time.Second)
 	}
+	if srv.spendingLimitClient != nil {
+		srv.spendingLimitClient.DisableConnections(time.Duration(req.DisablePeriodSecs) * time.Second)
+	}
 	return &orcprotos.Void{}, nil
 }
 
@@ -352,6 +398,12 @@ func (srv *CentralSessionController) Enable(
 			multiError.Add(fmt.Errorf("An error occurred while enabling connections; creditClient err: %s", err))
 		}
 	}
+	if srv.spendingLimitClient != nil {
+		err := srv.spendingLimitClient.EnableConnections()
+		if err != nil {
+			multiError.Add(fmt.Errorf("An error occurred while enabling connections; spendingLimitClient err: %s", err))
+		}
+	}
 	return &orcprotos.Void{}, multiError.AsError()
 }
 
@@ -1152,9 +1152,9 @@ func getMockControllerParams(mockConfig []*servicers.SessionControllerConfig) []
 	controlParams := make([]*servicers.ControllerParam, 0, NUMBER_SERVERS)
 	for i := 0; i < NUMBER_SERVERS; i++ {
 		cp := &servicers.ControllerParam{
-			&mockGy.CreditClient{},
-			&mockGx.PolicyClient{},
-			mockConfig[i],
+			CreditClient: &mockGy.CreditClient{},
+			PolicyClient: &mockGx.PolicyClient{},
+			Config:       mockConfig[i],
 		}
 		controlParams = append(controlParams, cp)
 	}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
+	"magma/feg/gateway/services/session_proxy/metrics"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// addInitialPolicyCounters subscribes the session to policy counter status notifications over Sy,
+// adds the current statuses of the counters and the static rules of the statuses to the response.
+// The OCS host is set in the response's 3GPP context, which the gateway stores with the session, so
+// the subscription is terminated with the session. Sy failures do not prevent session creation, they
+// are logged and no policy counters are added
+func (srv *CentralSessionController) addInitialPolicyCounters(imsi, sessionID string, resp *protos.CreateSessionResponse) {
+	if srv.spendingLimitClient == nil {
+		return
+	}
+	request := &sy.SpendingLimitRequest{
+		SessionID:        sessionID,
+		IMSI:             imsi,
+		Type:             sy.InitialRequest,
+		PolicyCounterIDs: srv.cfg.PolicyCounterIDs,
+	}
+	answer, err := srv.sendSpendingLimitRequest(request)
+	metrics.ReportCreateSySession(err)
+	if err != nil {
+		glog.Errorf("Failed to send initial Sy spending limit request: %s", err)
+		return
+	}
+	if resp.TgppCtx == nil {
+		resp.TgppCtx = &protos.TgppContext{}
+	}
+	resp.TgppCtx.SyDestHost = answer.OriginHost
+	resp.PolicyCounters = sy.PolicyCountersToProto(answer.PolicyCounters)
+	resp.StaticRules = append(resp.StaticRules, srv.cfg.PolicyCounterRules.RulesToInstall(answer.PolicyCounters)...)
+}
+
+// sendSpendingLimitRequest sends a SLR message through the sy client
+// and waits for a response based on the grpc server's set timeout
+func (srv *CentralSessionController) sendSpendingLimitRequest(request *sy.SpendingLimitRequest) (*sy.SpendingLimitAnswer, error) {
+	done := make(chan interface{}, 1)
+	err := srv.spendingLimitClient.SendSpendingLimitRequest(srv.cfg.SyConfig, done, request)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case resp := <-done:
+		answer := resp.(*sy.SpendingLimitAnswer)
+		if answer.ResultCode != diameter.SuccessCode {
+			return nil, fmt.Errorf("Received unsuccessful result code from OCS over Sy: %d for session: %s, IMSI: %s",
+				answer.ResultCode, request.SessionID, request.IMSI)
+		}
+		return answer, nil
+	case <-time.After(srv.cfg.RequestTimeout):
+		metrics.SyTimeouts.Inc()
+		srv.spendingLimitClient.IgnoreAnswer(request.SessionID)
+		return nil, fmt.Errorf("Did not receive Sy SLA for session: %s, IMSI: %s", request.SessionID, request.IMSI)
+	}
+}
+
+// sendTerminationSyRequest unsubscribes the session from all policy counter status notifications
+func (srv *CentralSessionController) sendTerminationSyRequest(imsi, sessionID string) (*sy.SessionTerminationAnswer, error) {
+	done := make(chan interface{}, 1)
+	request := &sy.SessionTerminationRequest{SessionID: sessionID, IMSI: imsi}
+	err := srv.spendingLimitClient.SendSessionTerminationRequest(srv.cfg.SyConfig, done, request)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case resp := <-done:
+		answer := resp.(*sy.SessionTerminationAnswer)
+		if answer.ResultCode != diameter.SuccessCode {
+			return nil, fmt.Errorf("Received unsuccessful Sy STA result code from OCS: %d for session: %s, IMSI: %s",
+				answer.ResultCode, sessionID, imsi)
+		}
+		return answer, nil
+	case <-time.After(srv.cfg.RequestTimeout):
+		metrics.SyTimeouts.Inc()
+		srv.spendingLimitClient.IgnoreAnswer(sessionID)
+		return nil, fmt.Errorf("Did not receive Sy STA for session: %s, IMSI: %s", sessionID, imsi)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/multiplex"
+	"magma/feg/gateway/policydb"
+	mockPolicyDB "magma/feg/gateway/policydb/mocks"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
+	mockSy "magma/feg/gateway/services/session_proxy/credit_control/sy/mocks"
+	"magma/feg/gateway/services/session_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSessionCreateWithPolicyCounters(t *testing.T) {
+	// Set up mocks
+	mockConfig := getTestConfig()
+	mockControlParams := getMockControllerParams(mockConfig)
+	mockPolicyDBClient := &mockPolicyDB.PolicyDBClient{}
+	mockMux := getMockMultiplexor(NUMBER_SERVERS)
+
+	idx, err := mockMux.GetIndex(multiplex.NewContext().WithIMSI(IMSI1))
+	assert.NoError(t, err)
+	mocksSy := &mockSy.SpendingLimitClient{}
+	mockControlParams[idx].SpendingLimitClient = mocksSy
+	mockControlParams[idx].Config.DisableGx = true
+	mockControlParams[idx].Config.DisableGy = true
+	mockControlParams[idx].Config.PolicyCounterRules = sy.PolicyCounterRules{
+		"daily-quota": {"exhausted": {"throttle"}, "valid": {"full-speed"}},
+	}
+
+	mockPolicyDBClient.On("GetOmnipresentRules").Return([]string{}, []string{})
+	mockPolicyDBClient.On("GetChargingKeysForRules", mock.Anything, mock.Anything).Return([]policydb.ChargingKey{}, nil)
+	// the first session is subscribed, the SLR of the second one fails
+	mocksSy.On("SendSpendingLimitRequest", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		done := args.Get(1).(chan interface{})
+		request := args.Get(2).(*sy.SpendingLimitRequest)
+		done <- &sy.SpendingLimitAnswer{
+			SessionID:      request.SessionID,
+			ResultCode:     diameter.SuccessCode,
+			OriginHost:     "ocs.sy",
+			PolicyCounters: []*sy.PolicyCounterStatusReport{{PolicyCounterID: "daily-quota", Status: "exhausted"}},
+		}
+	}).Once()
+	mocksSy.On("SendSpendingLimitRequest", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		done := args.Get(1).(chan interface{})
+		request := args.Get(2).(*sy.SpendingLimitRequest)
+		done <- &sy.SpendingLimitAnswer{SessionID: request.SessionID, ResultCode: diam.UnableToComply}
+	}).Once()
+	mocksSy.On("SendSessionTerminationRequest", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		done := args.Get(1).(chan interface{})
+		request := args.Get(2).(*sy.SessionTerminationRequest)
+		done <- &sy.SessionTerminationAnswer{SessionID: request.SessionID, ResultCode: diameter.SuccessCode}
+	}).Once()
+
+	srv := servicers.NewCentralSessionControllers(mockControlParams, mockPolicyDBClient, mockMux)
+	ctx := context.Background()
+	// 3GPP contexts of the sessions, stored by the gateway
+	tgppCtxs := map[string]*protos.TgppContext{}
+	for _, sessionID := range []string{"IMSI00101-1", "IMSI00101-2"} {
+		response, err := srv.CreateSession(ctx, &protos.CreateSessionRequest{
+			SessionId:      sessionID,
+			RequestedUnits: initialRequestedUnits,
+			CommonContext:  &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}},
+		})
+		assert.NoError(t, err)
+		tgppCtxs[sessionID] = response.TgppCtx
+		if sessionID == "IMSI00101-1" {
+			assert.Len(t, response.PolicyCounters, 1)
+			assert.Equal(t, []*protos.StaticRuleInstall{{RuleId: "throttle"}}, response.StaticRules)
+			assert.Equal(t, "ocs.sy", response.TgppCtx.GetSyDestHost())
+		} else {
+			assert.Empty(t, response.PolicyCounters)
+			assert.Empty(t, response.StaticRules)
+			assert.Empty(t, response.TgppCtx.GetSyDestHost())
+		}
+	}
+
+	// only the session with a successful SLR is terminated over Sy, the Sy session is known from the
+	// stored 3GPP context, ie. after a restart of the session proxy
+	srv = servicers.NewCentralSessionControllers(mockControlParams, mockPolicyDBClient, mockMux)
+	for _, sessionID := range []string{"IMSI00101-1", "IMSI00101-2"} {
+		_, err = srv.TerminateSession(ctx, &protos.SessionTerminateRequest{
+			SessionId:     sessionID,
+			CreditUsages:  []*protos.CreditUsage{createUsage(1, protos.CreditUsage_TERMINATED)},
+			CommonContext: &protos.CommonSessionContext{Sid: &protos.SubscriberID{Id: IMSI1}},
+			TgppCtx:       tgppCtxs[sessionID],
+		})
+		assert.NoError(t, err)
+	}
+	mocksSy.AssertExpectations(t)
+	mocksSy.AssertNumberOfCalls(t, "SendSessionTerminationRequest", 1)
+}
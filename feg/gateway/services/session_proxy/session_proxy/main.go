@@ -28,9 +28,11 @@ import (
 	"magma/feg/gateway/diameter"
 	"magma/feg/gateway/policydb"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/rx_proxy"
 	"magma/feg/gateway/services/session_proxy/credit_control"
 	"magma/feg/gateway/services/session_proxy/credit_control/gx"
 	"magma/feg/gateway/services/session_proxy/credit_control/gy"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
 	"magma/feg/gateway/services/session_proxy/servicers"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/service"
@@ -120,17 +122,41 @@ func generateClientsConfsAndDiameterConnection() (
 	glog.Info("------ Create diameter connections ------")
 	totalLen := len(OCSConfs)
 	controllerParms := make([]*servicers.ControllerParam, 0, totalLen)
+
+	// Sy connection is optional & shared by all the controllers
+	var (
+		syClient sy.SpendingLimitClient
+		syConf   *diameter.DiameterServerConfig
+		syRules  sy.PolicyCounterRules
+	)
+	if sy.IsSyEnabled() {
+		syConf = sy.GetSyServerConfiguration()
+		glog.Infof("Using Sy connection: %+v", syConf.DiameterServerConnConfig)
+		syRules = sy.GetPolicyCounterRules()
+		syClient = sy.NewSyClient(sy.GetSyClientConfiguration(), syConf, sy.GetSyNotificationHandler(cloudReg, syRules))
+	}
+	// AF sessions are notified of the IP-CAN session events through the Rx proxy if enabled
+	var afNotifier servicers.AFNotifier
+	if rx_proxy.IsRxEnabled() {
+		glog.Info("Notifying IP-CAN session events to Rx proxy")
+		afNotifier = rx_proxy.Notifier{}
+	}
 	for i := 0; i < totalLen; i++ {
 		controlParam := &servicers.ControllerParam{}
 		// Fill in general parameters for controler i
 		controlParam.Config = &servicers.SessionControllerConfig{
-			OCSConfig:        OCSConfs[i],
-			PCRFConfig:       PCRFConfs[i],
-			RequestTimeout:   3 * time.Second,
-			UseGyForAuthOnly: util.IsTruthyEnv(gy.UseGyForAuthOnlyEnv),
-			DisableGx:        gxGlobalConf.DisableGx,
-			DisableGy:        gyGlobalConf.DisableGy,
+			OCSConfig:          OCSConfs[i],
+			PCRFConfig:         PCRFConfs[i],
+			RequestTimeout:     3 * time.Second,
+			UseGyForAuthOnly:   util.IsTruthyEnv(gy.UseGyForAuthOnlyEnv),
+			DisableGx:          gxGlobalConf.DisableGx,
+			DisableGy:          gyGlobalConf.DisableGy,
+			SyConfig:           syConf,
+			PolicyCounterIDs:   sy.GetPolicyCounterIDs(),
+			PolicyCounterRules: syRules,
 		}
+		controlParam.SpendingLimitClient = syClient
+		controlParam.AFNotifier = afNotifier
 		// Fill in gx and gy config for controller i
 		if OCSConfsCopy[i].DiameterServerConnConfig == PCRFConfsCopy[i].DiameterServerConnConfig &&
 			OCSConfsCopy[i] != PCRFConfsCopy[i] {
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+)
+
+// DSR-Flags bits (3GPP TS 29.272 Table 7.3.25/1)
+const (
+	dsrCompleteAPNConfigurationProfileWithdrawal = 1 << 1
+	dsrPDNSubscriptionContextsWithdrawal         = 1 << 3
+)
+
+// DeleteSubscriberData sends a DSR to the MME serving the subscriber to withdraw the APN
+// configurations with given context IDs or, if none are given, the complete APN configuration profile
+// & waits for the MME's DSA
+func (srv *HomeSubscriberServer) DeleteSubscriberData(imsi string, contextIDs []uint32) error {
+	return srv.sendS6aRequest(imsi, "DSR", func(sessionID string) *diam.Message {
+		return srv.createDSR(sessionID, imsi, contextIDs)
+	})
+}
+
+// createDSR creates a Delete Subscriber Data Request with provided SessionID (sid)
+// and userName to be sent over diameter to the MME
+func (srv *HomeSubscriberServer) createDSR(sessionID, userName string, contextIDs []uint32) *diam.Message {
+	msg := srv.newS6aRequest(s6a.DeleteSubscriberData, sessionID, userName)
+	if len(contextIDs) == 0 {
+		msg.NewAVP(s6a.DSRFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(dsrCompleteAPNConfigurationProfileWithdrawal))
+		return msg
+	}
+	msg.NewAVP(s6a.DSRFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+		datatype.Unsigned32(dsrPDNSubscriptionContextsWithdrawal))
+	for _, contextID := range contextIDs {
+		msg.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(contextID))
+	}
+	return msg
+}
+
+func handleDSA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var dsa s6a.DSA
+		err := m.Unmarshal(&dsa)
+		if err != nil {
+			glog.Errorf("DSA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(dsa.SessionID)
+		if ch != nil {
+			ch <- &s6aAnswer{
+				resultCode:             dsa.ResultCode,
+				experimentalResultCode: dsa.ExperimentalResult.ExperimentalResultCode,
+			}
+		} else {
+			glog.Errorf("DSA SessionID %s not found. Message: %s, Remote: %s", dsa.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
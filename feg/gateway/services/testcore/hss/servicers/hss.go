@@ -15,6 +15,7 @@ package servicers
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/emakeev/milenage"
@@ -26,6 +27,7 @@ import (
 
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/protos"
@@ -47,6 +49,10 @@ type HomeSubscriberServer struct {
 	requestTracker *diameter.RequestTracker
 	clientMapping  map[string]string
 
+	// mmeHosts maps IMSIs to the Origin-Host of the MME which last updated their location
+	mmeHosts     map[string]string
+	mmeHostsLock sync.RWMutex
+
 	// authSqnInd is an index used in the array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.2.2.
 	// SQN consists of two parts (SQN = SEQ||IND).
 	AuthSqnInd uint64
@@ -66,6 +72,7 @@ func NewHomeSubscriberServer(store storage.SubscriberStore, config *mconfig.HSSC
 		requestTracker: diameter.NewRequestTracker(),
 		connMan:        diameter.NewConnectionManager(),
 		clientMapping:  map[string]string{},
+		mmeHosts:       map[string]string{},
 	}, nil
 }
 
@@ -141,6 +148,12 @@ func (srv *HomeSubscriberServer) Start(started chan string) error {
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: false},
 		handleRTA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.InsertSubscriberData, Request: false},
+		handleIDA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.DeleteSubscriberData, Request: false},
+		handleDSA(srv))
 
 	clientCfg := diameter.DiameterClientConfig{}
 	clientCfg.FillInDefaults()
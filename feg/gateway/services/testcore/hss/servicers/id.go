@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InsertSubscriberData sends the subscriber's current subscription data to the MME serving the
+// subscriber in an IDR & waits for the MME's IDA
+func (srv *HomeSubscriberServer) InsertSubscriberData(sub *protos.SubscriberData) error {
+	imsi := sub.GetSid().GetId()
+	profile, err := srv.getSubscriptionProfile(sub)
+	if err != nil {
+		return fmt.Errorf("InsertSubscriberData error: %s", err)
+	}
+	return srv.sendS6aRequest(imsi, "IDR", func(sessionID string) *diam.Message {
+		return srv.createIDR(sessionID, imsi, profile)
+	})
+}
+
+// createIDR creates an Insert Subscriber Data Request with provided SessionID (sid)
+// and userName to be sent over diameter to the MME
+func (srv *HomeSubscriberServer) createIDR(
+	sessionID, userName string, profile *mconfig.HSSConfig_SubscriptionProfile) *diam.Message {
+
+	msg := srv.newS6aRequest(s6a.InsertSubscriberData, sessionID, userName)
+	msg.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, getSubscriptionData(profile))
+	return msg
+}
+
+func handleIDA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var ida s6a.IDA
+		err := m.Unmarshal(&ida)
+		if err != nil {
+			glog.Errorf("IDA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(ida.SessionID)
+		if ch != nil {
+			ch <- &s6aAnswer{
+				resultCode:             ida.ResultCode,
+				experimentalResultCode: ida.ExperimentalResult.ExperimentalResultCode,
+			}
+		} else {
+			glog.Errorf("IDA SessionID %s not found. Message: %s, Remote: %s", ida.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
+
+// s6aAnswer holds the result of an HSS initiated S6a request
+type s6aAnswer struct {
+	resultCode             uint32
+	experimentalResultCode uint32
+}
+
+// sendS6aRequest sends an HSS initiated S6a request created by createRequest to the MME serving the
+// subscriber & waits for the MME's answer
+func (srv *HomeSubscriberServer) sendS6aRequest(
+	imsi, reqName string, createRequest func(sessionID string) *diam.Message) error {
+
+	mmeHost, ok := srv.getMMEHost(imsi)
+	if !ok {
+		return fmt.Errorf("No MME found for subscriber: %s. Cannot send %s", imsi, reqName)
+	}
+	mmeCfg, err := srv.genPeerConfig(mmeHost)
+	if err != nil {
+		return fmt.Errorf("%s error: %s", reqName, err)
+	}
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("s6a")
+
+	ch := make(chan interface{})
+	srv.requestTracker.RegisterRequest(sid, ch)
+	// if request hasn't been removed by end of transaction, remove it
+	defer srv.requestTracker.DeregisterRequest(sid)
+
+	err = srv.sendDiameterMsg(createRequest(sid), mmeCfg, maxDiamRetries)
+	if err != nil {
+		return err
+	}
+	select {
+	case resp, open := <-ch:
+		if !open {
+			err = status.Errorf(codes.Aborted, "%s for Session ID: %s is canceled", reqName, sid)
+			glog.Error(err)
+			return err
+		}
+		ans, ok := resp.(*s6aAnswer)
+		if !ok {
+			err = status.Errorf(codes.Internal, "Invalid Response Type: %T for %s", resp, reqName)
+			glog.Error(err)
+			return err
+		}
+		if err = diameter.TranslateDiamResultCode(ans.resultCode); err != nil {
+			return err
+		}
+		return diameter.TranslateDiamResultCode(ans.experimentalResultCode)
+
+	case <-time.After(time.Second * timeoutSeconds):
+		err = status.Errorf(codes.DeadlineExceeded, "%s Timed Out for Session ID: %s", reqName, sid)
+		glog.Error(err)
+		return err
+	}
+}
+
+// newS6aRequest creates an HSS initiated S6a request with the AVPs common to all such requests
+// Destination AVPs are added by the connection to the MME
+func (srv *HomeSubscriberServer) newS6aRequest(code uint32, sessionID, userName string) *diam.Message {
+	msg := diameter.NewProxiableRequest(code, diam.TGPP_S6A_APP_ID, dict.Default)
+	msg.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	msg.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_S6A_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	// Set origin host and realm to server's host and realm since the request is sent from HSS
+	msg.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestHost))
+	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestRealm))
+	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
+	return msg
+}
+
+func (srv *HomeSubscriberServer) setMMEHost(imsi, mmeHost string) {
+	srv.mmeHostsLock.Lock()
+	srv.mmeHosts[imsi] = mmeHost
+	srv.mmeHostsLock.Unlock()
+}
+
+func (srv *HomeSubscriberServer) getMMEHost(imsi string) (string, bool) {
+	srv.mmeHostsLock.RLock()
+	defer srv.mmeHostsLock.RUnlock()
+	mmeHost, ok := srv.mmeHosts[imsi]
+	return mmeHost, ok
+}
@@ -78,7 +78,7 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConvertAuthErrorToFailureMessage(err, msg, mar.SessionID, srv.Config.Server), err
 	}
 
-	if mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA {
+	if mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA && mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA_PRIME {
 		err = fmt.Errorf("Unsupported SIP authentication scheme: %s", mar.AuthData.AuthScheme)
 		return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
 	}
@@ -96,20 +96,21 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		}
 	}
 
-	return srv.NewSuccessfulMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), vectors), nil
+	return srv.NewSuccessfulMAA(
+		msg, mar.SessionID, datatype.UTF8String(mar.UserName), datatype.UTF8String(mar.AuthData.AuthScheme), vectors), nil
 }
 
 // NewSuccessfulMAA outputs a successful multimedia authentication answer (MAA) to reply to an
 // multimedia authentication request (MAR) message. It populates the MAA with all of the mandatory fields
-// and adds the authentication vectors. See 3GPP TS 29.273 table 8.1.2.1.1/5.
-func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, vectors []*milenage.SIPAuthVector) *diam.Message {
+// and adds the authentication vectors for the requested authentication scheme. See 3GPP TS 29.273 table 8.1.2.1.1/5.
+func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, authScheme datatype.UTF8String, vectors []*milenage.SIPAuthVector) *diam.Message {
 	maa := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_SWX_APP_ID)
 	for itemNumber, vector := range vectors {
 		authenticate := append(vector.Rand[:], vector.Autn[:]...)
 		maa.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 			AVP: []*diam.AVP{
 				diam.NewAVP(avp.SIPItemNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(itemNumber)),
-				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(swx.SipAuthScheme_EAP_AKA)),
+				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, authScheme),
 				diam.NewAVP(avp.SIPAuthenticate, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(authenticate)),
 				diam.NewAVP(avp.SIPAuthorization, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.Xres[:])),
 				diam.NewAVP(avp.ConfidentialityKey, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.ConfidentialityKey[:])),
@@ -110,6 +110,31 @@ func TestNewMAA_MultipleVectors(t *testing.T) {
 	checkSIPAuthVectors(t, maa, 3)
 }
 
+func TestNewMAA_EapAkaPrime(t *testing.T) {
+	server := test_utils.NewTestHomeSubscriberServer(t)
+	mar := createBaseMAR()
+	mar.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String("sub1"))
+	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
+	mar.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1))
+	mar.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String("EAP-AKA'")),
+		},
+	})
+	response, err := hss.NewMAA(server, mar)
+	assert.NoError(t, err)
+
+	var maa definitions.MAA
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(maa.ResultCode))
+	assert.Equal(t, 1, len(maa.SIPAuthDataItems))
+	for _, vector := range maa.SIPAuthDataItems {
+		assert.Equal(t, definitions.SipAuthScheme_EAP_AKA_PRIME, vector.AuthScheme)
+		assert.Equal(t, crypto.RandChallengeBytes+crypto.AutnBytes, len(vector.Authenticate))
+	}
+}
+
 func TestNewMAA_MissingAVP(t *testing.T) {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	hss "magma/feg/gateway/services/testcore/hss/servicers"
+	"magma/feg/gateway/services/testcore/hss/servicers/test_utils"
+	lteprotos "magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertSubscriberData_Successful(t *testing.T) {
+	idrs := make(chan *s6a.IDR, 1)
+	server, conn := connectTestMME(t, func(conn diam.Conn, msg *diam.Message) {
+		var idr s6a.IDR
+		assert.NoError(t, msg.Unmarshal(&idr))
+		idrs <- &idr
+		sendTestMMEAnswer(t, conn, msg, idr.SessionID, diam.Success)
+	})
+	defer conn.Close()
+
+	sub, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	err = server.InsertSubscriberData(sub)
+	assert.NoError(t, err)
+
+	var idr *s6a.IDR
+	select {
+	case idr = <-idrs:
+	case <-time.After(time.Second):
+		assert.FailNow(t, "MME timed out before receiving an IDR")
+	}
+	assert.Equal(t, "sub1", idr.UserName)
+	assert.Equal(t, datatype.DiameterIdentity("magma.com"), idr.OriginHost)
+	assert.Equal(t, datatype.DiameterIdentity("magma.com"), idr.DestinationHost)
+	assert.Equal(t, datatype.DiameterIdentity("magma.com"), idr.DestinationRealm)
+	assert.Equal(t, datatype.OctetString("12345"), idr.SubscriptionData.MSISDN)
+	assert.Equal(t, uint32(test_utils.DefaultMaxUlBitRate), idr.SubscriptionData.AMBR.MaxRequestedBandwidthUL)
+	assert.Equal(t, uint32(test_utils.DefaultMaxDlBitRate), idr.SubscriptionData.AMBR.MaxRequestedBandwidthDL)
+	assert.Equal(t, 1, len(idr.SubscriptionData.APNConfigurationProfile.APNConfigs))
+	assert.Equal(t, "oai.ipv4", idr.SubscriptionData.APNConfigurationProfile.APNConfigs[0].ServiceSelection)
+}
+
+func TestInsertSubscriberData_Rejected(t *testing.T) {
+	server, conn := connectTestMME(t, func(conn diam.Conn, msg *diam.Message) {
+		var idr s6a.IDR
+		assert.NoError(t, msg.Unmarshal(&idr))
+		sendTestMMEAnswer(t, conn, msg, idr.SessionID, diam.UnableToComply)
+	})
+	defer conn.Close()
+
+	sub, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	err = server.InsertSubscriberData(sub)
+	assert.EqualError(t, err, "rpc error: code = Code(5012) desc = Diameter Error: 5012 (BASE_DIAMETER)")
+}
+
+func TestInsertSubscriberData_NoMME(t *testing.T) {
+	server := test_utils.NewTestHomeSubscriberServer(t)
+	sub, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	err = server.InsertSubscriberData(sub)
+	assert.EqualError(t, err, "No MME found for subscriber: sub1. Cannot send IDR")
+}
+
+func TestDeleteSubscriberData_Successful(t *testing.T) {
+	dsrs := make(chan *s6a.DSR, 2)
+	server, conn := connectTestMME(t, func(conn diam.Conn, msg *diam.Message) {
+		var dsr s6a.DSR
+		assert.NoError(t, msg.Unmarshal(&dsr))
+		dsrs <- &dsr
+		sendTestMMEAnswer(t, conn, msg, dsr.SessionID, diam.Success)
+	})
+	defer conn.Close()
+
+	err := server.DeleteSubscriberData("sub1", []uint32{1, 2})
+	assert.NoError(t, err)
+	dsr := receiveTestDSR(t, dsrs)
+	assert.Equal(t, "sub1", dsr.UserName)
+	assert.Equal(t, uint32(1<<3), dsr.DSRFlags)
+	assert.Equal(t, []uint32{1, 2}, dsr.ContextIdentifiers)
+
+	err = server.DeleteSubscriberData("sub1", nil)
+	assert.NoError(t, err)
+	dsr = receiveTestDSR(t, dsrs)
+	assert.Equal(t, uint32(1<<1), dsr.DSRFlags)
+	assert.Empty(t, dsr.ContextIdentifiers)
+}
+
+func TestDeleteSubscriberData_NoMME(t *testing.T) {
+	server := test_utils.NewTestHomeSubscriberServer(t)
+	err := server.DeleteSubscriberData("sub1", nil)
+	assert.EqualError(t, err, "No MME found for subscriber: sub1. Cannot send DSR")
+}
+
+// connectTestMME starts a test HSS & connects a test MME client to it. The MME updates the location of
+// 'sub1', so the HSS can send subsequent IDRs & DSRs for the subscriber to the MME's requestHandler
+func connectTestMME(t *testing.T, requestHandler diam.HandlerFunc) (*hss.HomeSubscriberServer, diam.Conn) {
+	server := getTestHSSDiameterServer(t)
+
+	ulas := make(chan *diam.Message, 1)
+	clientMux := sm.New(&sm.Settings{
+		OriginHost:       "magma.com",
+		OriginRealm:      "magma.com",
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      "magma",
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	clientMux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.UpdateLocation, Request: false},
+		diam.HandlerFunc(func(conn diam.Conn, msg *diam.Message) { ulas <- msg }))
+	clientMux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.InsertSubscriberData, Request: true},
+		requestHandler)
+	clientMux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.DeleteSubscriberData, Request: true},
+		requestHandler)
+
+	client := &sm.Client{
+		Handler: clientMux,
+		SupportedVendorID: []*diam.AVP{
+			diam.NewAVP(avp.SupportedVendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+		VendorSpecificApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_S6A_APP_ID)),
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				},
+			}),
+		},
+	}
+	serverCfg := server.Config.Server
+	conn, err := client.DialNetwork(serverCfg.Protocol, serverCfg.Address)
+	assert.NoError(t, err)
+
+	_, err = createULR("sub1").WriteTo(conn)
+	assert.NoError(t, err)
+	select {
+	case ula := <-ulas:
+		var ulaMsg s6a.ULA
+		assert.NoError(t, ula.Unmarshal(&ulaMsg))
+		assert.Equal(t, diam.Success, int(ulaMsg.ResultCode))
+	case <-time.After(time.Second):
+		assert.Fail(t, "service timed out before receiving a ULA")
+	}
+	return server, conn
+}
+
+func sendTestMMEAnswer(t *testing.T, conn diam.Conn, msg *diam.Message, sessionID string, resultCode uint32) {
+	ans := msg.Answer(resultCode)
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	ans.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	ans.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	_, err := ans.WriteTo(conn)
+	assert.NoError(t, err)
+}
+
+func receiveTestDSR(t *testing.T, dsrs chan *s6a.DSR) *s6a.DSR {
+	select {
+	case dsr := <-dsrs:
+		return dsr
+	case <-time.After(time.Second):
+		assert.FailNow(t, "MME timed out before receiving a DSR")
+		return nil
+	}
+}
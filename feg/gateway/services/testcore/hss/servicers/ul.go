@@ -22,6 +22,7 @@ import (
 	"magma/feg/gateway/diameter"
 	"magma/feg/gateway/services/s6a_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
+	lteprotos "magma/lte/cloud/go/protos"
 
 	"github.com/fiorix/go-diameter/v4/diam"
 	"github.com/fiorix/go-diameter/v4/diam/avp"
@@ -65,15 +66,10 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE)), err
 	}
 
-	profile, ok := srv.Config.SubProfiles[subscriber.SubProfile]
-	if !ok || profile == nil {
-		profile = srv.Config.DefaultSubProfile
-		if profile == nil {
-			answer := ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION))
-			return answer, fmt.Errorf("unknown subscriber profile: %s and default profile was not initialized", subscriber.SubProfile)
-		} else {
-			glog.V(2).Infof("Subscriber profile '%s' not found, using default profile instead", subscriber.SubProfile)
-		}
+	profile, err := srv.getSubscriptionProfile(subscriber)
+	if err != nil {
+		answer := ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION))
+		return answer, err
 	}
 
 	if !isRATTypeAllowed(uint32(ulr.RATType)) {
@@ -81,6 +77,7 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return answer, fmt.Errorf("RAT-Type not allowed: %v", uint32(ulr.RATType))
 	}
 
+	srv.setMMEHost(string(ulr.UserName), string(ulr.OriginHost))
 	return srv.NewSuccessfulULA(msg, ulr.SessionID, profile), nil
 }
 
@@ -90,7 +87,28 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID datatype.UTF8String, profile *mconfig.HSSConfig_SubscriptionProfile) *diam.Message {
 	ula := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID)
 	ula.NewAVP(avp.ULAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ulaFlags))
-	ula.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+	ula.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, getSubscriptionData(profile))
+	return ula
+}
+
+// getSubscriptionProfile returns the subscriber's profile or the default profile if the subscriber's one is not found
+func (srv *HomeSubscriberServer) getSubscriptionProfile(
+	subscriber *lteprotos.SubscriberData) (*mconfig.HSSConfig_SubscriptionProfile, error) {
+
+	profile, ok := srv.Config.SubProfiles[subscriber.SubProfile]
+	if !ok || profile == nil {
+		profile = srv.Config.DefaultSubProfile
+		if profile == nil {
+			return nil, fmt.Errorf("unknown subscriber profile: %s and default profile was not initialized", subscriber.SubProfile)
+		}
+		glog.V(2).Infof("Subscriber profile '%s' not found, using default profile instead", subscriber.SubProfile)
+	}
+	return profile, nil
+}
+
+// getSubscriptionData returns the Subscription-Data AVP contents for the given subscriber profile
+func getSubscriptionData(profile *mconfig.HSSConfig_SubscriptionProfile) *diam.GroupedAVP {
+	return &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(msisdn)),
 			diam.NewAVP(avp.AccessRestrictionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(accessRestrictionData)),
@@ -134,8 +152,7 @@ func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID d
 				},
 			}),
 		},
-	})
-	return ula
+	}
 }
 
 // ValidateULR returns an error if the message is missing any mandatory AVPs.
@@ -50,6 +50,8 @@ type SubscriberSessionState struct {
 type SubscriberAccount struct {
 	ChargingCredit map[uint32]*CreditBucket // map of charging key to credit bucket
 	CurrentState   *SubscriberSessionState
+	PolicyCounters map[string]string // map of Sy policy counter ID to its status
+	SyState        *SySessionState
 }
 
 type FinalUnitIndication struct {
@@ -125,6 +127,7 @@ func (srv *OCSDiamServer) Start(lis net.Listener) error {
 		FirmwareRevision: 1,
 	})
 	srv.mux.Handle(diam.CCR, getCCRHandler(srv))
+	srv.registerSyHandlers()
 	serverConfig := srv.ocsConfig.ServerConfig
 	server := &diam.Server{
 		Network: serverConfig.Protocol,
@@ -161,6 +164,7 @@ func (srv *OCSDiamServer) CreateAccount(
 ) (*orcprotos.Void, error) {
 	srv.accounts[subscriberID.Id] = &SubscriberAccount{
 		ChargingCredit: make(map[uint32]*CreditBucket),
+		PolicyCounters: make(map[string]string),
 	}
 	glog.V(2).Infof("New account %s added", subscriberID.Id)
 	return &orcprotos.Void{}, nil
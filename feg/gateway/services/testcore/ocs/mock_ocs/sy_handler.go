@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_ocs
+
+import (
+	"fmt"
+	"time"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/credit_control/sy"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+)
+
+// DiameterErrorUserUnknown is returned in SLA when the subscriber is not known to the OCS
+const DiameterErrorUserUnknown = 5030
+
+// SySessionState tracks the Sy session of a subscriber & the policy counters it subscribed to
+type SySessionState struct {
+	SubscriberSessionState
+	// PolicyCounterIDs the session subscribed to, all account counters if empty
+	PolicyCounterIDs []string
+}
+
+type slrMessage struct {
+	SessionID        datatype.UTF8String       `avp:"Session-Id"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationRealm datatype.DiameterIdentity `avp:"Destination-Realm"`
+	DestinationHost  datatype.DiameterIdentity `avp:"Destination-Host"`
+	RequestType      datatype.Enumerated       `avp:"SL-Request-Type"`
+	SubscriptionIDs  []*subscriptionID         `avp:"Subscription-Id"`
+	PolicyCounterIDs []datatype.UTF8String     `avp:"Policy-Counter-Identifier"`
+}
+
+type syStrMessage struct {
+	SessionID        datatype.UTF8String       `avp:"Session-Id"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationRealm datatype.DiameterIdentity `avp:"Destination-Realm"`
+	DestinationHost  datatype.DiameterIdentity `avp:"Destination-Host"`
+}
+
+// registerSyHandlers adds Sy SLR & STR handlers to the server's state machine
+func (srv *OCSDiamServer) registerSyHandlers() {
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.DIAMETER_SY_APP_ID, Code: diam.SpendingLimit, Request: true},
+		getSLRHandler(srv))
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.DIAMETER_SY_APP_ID, Code: diam.SessionTermination, Request: true},
+		getSySTRHandler(srv))
+}
+
+// getSLRHandler returns a handler to be called when the server receives a SLR
+func getSLRHandler(srv *OCSDiamServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received Sy SLR message\n%s\n", m)
+		var slr slrMessage
+		if err := m.Unmarshal(&slr); err != nil {
+			glog.Errorf("Failed to unmarshal SLR %s", err)
+			return
+		}
+		imsi := getIMSIFromSubscriptionIDs(slr.SubscriptionIDs)
+		account, found := srv.accounts[imsi]
+		if len(imsi) == 0 || !found {
+			glog.Errorf("Sy account not found for IMSI '%s'", imsi)
+			srv.sendSyAnswer(c, m, slr.SessionID, slr.OriginHost, slr.OriginRealm, DiameterErrorUserUnknown)
+			return
+		}
+		counterIDs := make([]string, 0, len(slr.PolicyCounterIDs))
+		for _, id := range slr.PolicyCounterIDs {
+			counterIDs = append(counterIDs, string(id))
+		}
+		account.SyState = &SySessionState{
+			SubscriberSessionState: SubscriberSessionState{Connection: c, SessionID: string(slr.SessionID)},
+			PolicyCounterIDs:       counterIDs,
+		}
+		srv.sendSyAnswer(c, m, slr.SessionID, slr.OriginHost, slr.OriginRealm, diam.Success,
+			getPolicyCounterStatusReports(account, counterIDs)...)
+	}
+}
+
+// getSySTRHandler returns a handler to be called when the server receives a Sy STR
+func getSySTRHandler(srv *OCSDiamServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("Received Sy STR message\n%s\n", m)
+		var str syStrMessage
+		if err := m.Unmarshal(&str); err != nil {
+			glog.Errorf("Failed to unmarshal Sy STR %s", err)
+			return
+		}
+		resultCode := uint32(diam.UnknownSessionID)
+		for _, account := range srv.accounts {
+			if account.SyState != nil && account.SyState.SessionID == string(str.SessionID) {
+				account.SyState = nil
+				resultCode = diam.Success
+				break
+			}
+		}
+		srv.sendSyAnswer(c, m, str.SessionID, str.OriginHost, str.OriginRealm, resultCode)
+	}
+}
+
+// SetPolicyCounterStatus sets the status of a subscriber's policy counter, the status
+// is reported in subsequent SLAs
+func (srv *OCSDiamServer) SetPolicyCounterStatus(imsi, counterID, status string) error {
+	account, ok := srv.accounts[imsi]
+	if !ok {
+		return fmt.Errorf("Could not find imsi %s", imsi)
+	}
+	if account.PolicyCounters == nil {
+		account.PolicyCounters = map[string]string{}
+	}
+	account.PolicyCounters[counterID] = status
+	return nil
+}
+
+// NotifyPolicyCounterStatus sets the status of a subscriber's policy counter and sends
+// a Spending Status Notification to the subscriber's Sy session. It waits for the SNA
+func (srv *OCSDiamServer) NotifyPolicyCounterStatus(
+	imsi, counterID, status string,
+) (*sy.SpendingStatusNotificationAnswer, error) {
+	err := srv.SetPolicyCounterStatus(imsi, counterID, status)
+	if err != nil {
+		return nil, err
+	}
+	state := srv.accounts[imsi].SyState
+	if state == nil {
+		return nil, fmt.Errorf("Sy session unknown for imsi %s", imsi)
+	}
+	if !state.isSubscribedTo(counterID) {
+		return nil, fmt.Errorf("Sy session of imsi %s is not subscribed to policy counter %s", imsi, counterID)
+	}
+	done := make(chan *sy.SpendingStatusNotificationAnswer, 1)
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.DIAMETER_SY_APP_ID, Code: sy.SpendingStatusNotification, Request: false},
+		handleSNA(done))
+	err = sendSNR(&state.SubscriberSessionState, counterID, status, srv.mux.Settings())
+	if err != nil {
+		glog.Errorf("Error sending SNR for IMSI=%v, counter=%v: %v", imsi, counterID, err)
+		return nil, err
+	}
+	select {
+	case sna := <-done:
+		return sna, nil
+	case <-time.After(10 * time.Second):
+		return nil, fmt.Errorf("No SNA received")
+	}
+}
+
+func (state *SySessionState) isSubscribedTo(counterID string) bool {
+	if len(state.PolicyCounterIDs) == 0 {
+		return true
+	}
+	for _, id := range state.PolicyCounterIDs {
+		if id == counterID {
+			return true
+		}
+	}
+	return false
+}
+
+func sendSNR(state *SubscriberSessionState, counterID, status string, cfg *sm.Settings) error {
+	meta, ok := smpeer.FromContext(state.Connection.Context())
+	if !ok {
+		return fmt.Errorf("peer metadata unavailable")
+	}
+	m := diameter.NewProxiableRequest(sy.SpendingStatusNotification, diam.DIAMETER_SY_APP_ID, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(state.SessionID))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.DIAMETER_SY_APP_ID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cfg.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cfg.OriginRealm)
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, meta.OriginHost)
+	m.AddAVP(toPolicyCounterStatusReportAVP(counterID, status))
+	glog.V(2).Infof("Sending SNR to %s\n%s", state.Connection.RemoteAddr(), m)
+	_, err := m.WriteTo(state.Connection)
+	return err
+}
+
+func handleSNA(done chan *sy.SpendingStatusNotificationAnswer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var sna sy.SpendingStatusNotificationAnswer
+		if err := m.Unmarshal(&sna); err != nil {
+			glog.Errorf("Received unparseable SNA over Sy %s", m)
+			return
+		}
+		done <- &sna
+	}
+}
+
+func (srv *OCSDiamServer) sendSyAnswer(
+	conn diam.Conn,
+	message *diam.Message,
+	sessionID datatype.UTF8String,
+	peerHost, peerRealm datatype.DiameterIdentity,
+	resultCode uint32,
+	additionalAVPs ...*diam.AVP,
+) {
+	a := message.Answer(resultCode)
+	// SessionID must be the first AVP
+	a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, sessionID))
+	a.NewAVP(avp.OriginHost, avp.Mbit, 0, srv.mux.Settings().OriginHost)
+	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, srv.mux.Settings().OriginRealm)
+	a.NewAVP(avp.DestinationRealm, avp.Mbit, 0, peerRealm)
+	a.NewAVP(avp.DestinationHost, avp.Mbit, 0, peerHost)
+	for _, additionalAVP := range additionalAVPs {
+		a.AddAVP(additionalAVP)
+	}
+	glog.V(2).Infof("Sending Sy answer message\n%s\n", a)
+	_, err := a.WriteTo(conn)
+	if err != nil {
+		glog.Errorf("Failed to write message to %s: %s\n%s\n", conn.RemoteAddr(), err, a)
+	}
+}
+
+// getPolicyCounterStatusReports returns status reports for the given counters, or for all the
+// account counters if none are given. Unknown counters are not reported
+func getPolicyCounterStatusReports(account *SubscriberAccount, counterIDs []string) []*diam.AVP {
+	reports := []*diam.AVP{}
+	if len(counterIDs) == 0 {
+		for id, status := range account.PolicyCounters {
+			reports = append(reports, toPolicyCounterStatusReportAVP(id, status))
+		}
+		return reports
+	}
+	for _, id := range counterIDs {
+		if status, ok := account.PolicyCounters[id]; ok {
+			reports = append(reports, toPolicyCounterStatusReportAVP(id, status))
+		}
+	}
+	return reports
+}
+
+func toPolicyCounterStatusReportAVP(counterID, status string) *diam.AVP {
+	return diam.NewAVP(sy.PolicyCounterStatusReportAVP, avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(sy.PolicyCounterIdentifierAVP, avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(counterID)),
+			diam.NewAVP(sy.PolicyCounterStatusAVP, avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(status)),
+		},
+	})
+}
+
+func getIMSIFromSubscriptionIDs(subscriptionIDs []*subscriptionID) string {
+	for _, subID := range subscriptionIDs {
+		if subID.IDType == credit_control.EndUserIMSI {
+			return subID.IDData
+		}
+	}
+	return ""
+}
@@ -2495,9 +2495,11 @@ type PolicyReAuthRequest struct {
 	RevalidationTime       *timestamp.Timestamp     `protobuf:"bytes,9,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
 	UsageMonitoringCredits []*UsageMonitoringCredit `protobuf:"bytes,10,rep,name=usage_monitoring_credits,json=usageMonitoringCredits,proto3" json:"usage_monitoring_credits,omitempty"`
 	QosInfo                *QoSInformation          `protobuf:"bytes,11,opt,name=qos_info,json=qosInfo,proto3" json:"qos_info,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+	// Policy counter status changes notified by the OCS over Sy
+	PolicyCounters       []*PolicyCounterStatus `protobuf:"bytes,12,rep,name=policy_counters,json=policyCounters,proto3" json:"policy_counters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *PolicyReAuthRequest) Reset()         { *m = PolicyReAuthRequest{} }
@@ -2588,6 +2590,13 @@ func (m *PolicyReAuthRequest) GetQosInfo() *QoSInformation {
 	return nil
 }
 
+func (m *PolicyReAuthRequest) GetPolicyCounters() []*PolicyCounterStatus {
+	if m != nil {
+		return m.PolicyCounters
+	}
+	return nil
+}
+
 type QoSInformation struct {
 	BearerId             string   `protobuf:"bytes,1,opt,name=bearer_id,json=bearerId,proto3" json:"bearer_id,omitempty"`
 	Qci                  QCI      `protobuf:"varint,2,opt,name=qci,proto3,enum=magma.lte.QCI" json:"qci,omitempty"`
@@ -3958,8 +3967,11 @@ func (m *QosInformationRequest) GetBrUnit() QosInformationRequest_BitrateUnitsAM
 
 // TgppContext is a session specific 3GPP context session proxy may meed session manager to persist
 type TgppContext struct {
-	GxDestHost           string   `protobuf:"bytes,1,opt,name=gx_dest_host,json=gxDestHost,proto3" json:"gx_dest_host,omitempty"`
-	GyDestHost           string   `protobuf:"bytes,2,opt,name=gy_dest_host,json=gyDestHost,proto3" json:"gy_dest_host,omitempty"`
+	GxDestHost string `protobuf:"bytes,1,opt,name=gx_dest_host,json=gxDestHost,proto3" json:"gx_dest_host,omitempty"`
+	GyDestHost string `protobuf:"bytes,2,opt,name=gy_dest_host,json=gyDestHost,proto3" json:"gy_dest_host,omitempty"`
+	// Origin host of the Sy OCS, set if the session is subscribed to policy
+	// counter status notifications
+	SyDestHost           string   `protobuf:"bytes,3,opt,name=sy_dest_host,json=syDestHost,proto3" json:"sy_dest_host,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4004,6 +4016,13 @@ func (m *TgppContext) GetGyDestHost() string {
 	return ""
 }
 
+func (m *TgppContext) GetSyDestHost() string {
+	if m != nil {
+		return m.SyDestHost
+	}
+	return ""
+}
+
 type CreateSessionRequest struct {
 	AccessTimezone       *Timezone             `protobuf:"bytes,1,opt,name=access_timezone,json=accessTimezone,proto3" json:"access_timezone,omitempty"`
 	SessionId            string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -4079,18 +4098,20 @@ type CreateSessionResponse struct {
 	// List of charging credits that should be installed for the session
 	Credits []*CreditUpdateResponse `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
 	// List of usage monitors that should be installed for the session
-	UsageMonitors        []*UsageMonitoringUpdateResponse `protobuf:"bytes,6,rep,name=usage_monitors,json=usageMonitors,proto3" json:"usage_monitors,omitempty"`
-	StaticRules          []*StaticRuleInstall             `protobuf:"bytes,7,rep,name=static_rules,json=staticRules,proto3" json:"static_rules,omitempty"`
-	DynamicRules         []*DynamicRuleInstall            `protobuf:"bytes,8,rep,name=dynamic_rules,json=dynamicRules,proto3" json:"dynamic_rules,omitempty"`
-	SessionId            string                           `protobuf:"bytes,9,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	TgppCtx              *TgppContext                     `protobuf:"bytes,10,opt,name=tgpp_ctx,json=tgppCtx,proto3" json:"tgpp_ctx,omitempty"`
-	EventTriggers        []EventTrigger                   `protobuf:"varint,11,rep,packed,name=event_triggers,json=eventTriggers,proto3,enum=magma.lte.EventTrigger" json:"event_triggers,omitempty"`
-	RevalidationTime     *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
-	Online               bool                             `protobuf:"varint,13,opt,name=online,proto3" json:"online,omitempty"`
-	Offline              bool                             `protobuf:"varint,15,opt,name=offline,proto3" json:"offline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	UsageMonitors    []*UsageMonitoringUpdateResponse `protobuf:"bytes,6,rep,name=usage_monitors,json=usageMonitors,proto3" json:"usage_monitors,omitempty"`
+	StaticRules      []*StaticRuleInstall             `protobuf:"bytes,7,rep,name=static_rules,json=staticRules,proto3" json:"static_rules,omitempty"`
+	DynamicRules     []*DynamicRuleInstall            `protobuf:"bytes,8,rep,name=dynamic_rules,json=dynamicRules,proto3" json:"dynamic_rules,omitempty"`
+	SessionId        string                           `protobuf:"bytes,9,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	TgppCtx          *TgppContext                     `protobuf:"bytes,10,opt,name=tgpp_ctx,json=tgppCtx,proto3" json:"tgpp_ctx,omitempty"`
+	EventTriggers    []EventTrigger                   `protobuf:"varint,11,rep,packed,name=event_triggers,json=eventTriggers,proto3,enum=magma.lte.EventTrigger" json:"event_triggers,omitempty"`
+	RevalidationTime *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
+	Online           bool                             `protobuf:"varint,13,opt,name=online,proto3" json:"online,omitempty"`
+	Offline          bool                             `protobuf:"varint,15,opt,name=offline,proto3" json:"offline,omitempty"`
+	// Policy counter statuses reported by the OCS over Sy
+	PolicyCounters       []*PolicyCounterStatus `protobuf:"bytes,16,rep,name=policy_counters,json=policyCounters,proto3" json:"policy_counters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *CreateSessionResponse) Reset()         { *m = CreateSessionResponse{} }
@@ -4188,6 +4209,13 @@ func (m *CreateSessionResponse) GetOffline() bool {
 	return false
 }
 
+func (m *CreateSessionResponse) GetPolicyCounters() []*PolicyCounterStatus {
+	if m != nil {
+		return m.PolicyCounters
+	}
+	return nil
+}
+
 type StaticRuleInstall struct {
 	RuleId         string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	ActivationTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
@@ -6285,6 +6313,113 @@ func (m *UPFPagingInfo) GetUeIpAddr() string {
 	return ""
 }
 
+// PolicyCounterStatus is the status of an OCS policy counter of a subscriber
+type PolicyCounterStatus struct {
+	// Policy-Counter-Identifier
+	PolicyCounterId string `protobuf:"bytes,1,opt,name=policy_counter_id,json=policyCounterId,proto3" json:"policy_counter_id,omitempty"`
+	// Policy-Counter-Status, the status values are defined by the OCS operator
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Status changes which become effective at a future time
+	PendingStatuses      []*PendingPolicyCounterStatus `protobuf:"bytes,3,rep,name=pending_statuses,json=pendingStatuses,proto3" json:"pending_statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *PolicyCounterStatus) Reset()         { *m = PolicyCounterStatus{} }
+func (m *PolicyCounterStatus) String() string { return proto.CompactTextString(m) }
+func (*PolicyCounterStatus) ProtoMessage()    {}
+func (*PolicyCounterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85add0446af78174, []int{72}
+}
+
+func (m *PolicyCounterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyCounterStatus.Unmarshal(m, b)
+}
+func (m *PolicyCounterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyCounterStatus.Marshal(b, m, deterministic)
+}
+func (m *PolicyCounterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyCounterStatus.Merge(m, src)
+}
+func (m *PolicyCounterStatus) XXX_Size() int {
+	return xxx_messageInfo_PolicyCounterStatus.Size(m)
+}
+func (m *PolicyCounterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyCounterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyCounterStatus proto.InternalMessageInfo
+
+func (m *PolicyCounterStatus) GetPolicyCounterId() string {
+	if m != nil {
+		return m.PolicyCounterId
+	}
+	return ""
+}
+
+func (m *PolicyCounterStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PolicyCounterStatus) GetPendingStatuses() []*PendingPolicyCounterStatus {
+	if m != nil {
+		return m.PendingStatuses
+	}
+	return nil
+}
+
+// PendingPolicyCounterStatus is a policy counter status change scheduled by the OCS
+type PendingPolicyCounterStatus struct {
+	Status               string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ChangeTime           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PendingPolicyCounterStatus) Reset()         { *m = PendingPolicyCounterStatus{} }
+func (m *PendingPolicyCounterStatus) String() string { return proto.CompactTextString(m) }
+func (*PendingPolicyCounterStatus) ProtoMessage()    {}
+func (*PendingPolicyCounterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85add0446af78174, []int{73}
+}
+
+func (m *PendingPolicyCounterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingPolicyCounterStatus.Unmarshal(m, b)
+}
+func (m *PendingPolicyCounterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingPolicyCounterStatus.Marshal(b, m, deterministic)
+}
+func (m *PendingPolicyCounterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPolicyCounterStatus.Merge(m, src)
+}
+func (m *PendingPolicyCounterStatus) XXX_Size() int {
+	return xxx_messageInfo_PendingPolicyCounterStatus.Size(m)
+}
+func (m *PendingPolicyCounterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPolicyCounterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPolicyCounterStatus proto.InternalMessageInfo
+
+func (m *PendingPolicyCounterStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PendingPolicyCounterStatus) GetChangeTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ChangeTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.lte.RATType", RATType_name, RATType_value)
 	proto.RegisterEnum("magma.lte.NotifyUeEvents", NotifyUeEvents_name, NotifyUeEvents_value)
@@ -6387,567 +6522,574 @@ func init() {
 	proto.RegisterType((*UPFSessionState)(nil), "magma.lte.UPFSessionState")
 	proto.RegisterType((*UPFSessionConfigState)(nil), "magma.lte.UPFSessionConfigState")
 	proto.RegisterType((*UPFPagingInfo)(nil), "magma.lte.UPFPagingInfo")
+	proto.RegisterType((*PolicyCounterStatus)(nil), "magma.lte.PolicyCounterStatus")
+	proto.RegisterType((*PendingPolicyCounterStatus)(nil), "magma.lte.PendingPolicyCounterStatus")
 }
 
 func init() { proto.RegisterFile("lte/protos/session_manager.proto", fileDescriptor_85add0446af78174) }
 
 var fileDescriptor_85add0446af78174 = []byte{
-	// 8879 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x4b, 0x8c, 0x1b, 0x49,
-	0x96, 0x98, 0x48, 0xd6, 0x87, 0xf5, 0xf8, 0xa9, 0xac, 0xa8, 0x1f, 0xab, 0x5a, 0x6a, 0xa9, 0xa9,
-	0x56, 0xb7, 0x46, 0x33, 0x5d, 0xea, 0xae, 0x6e, 0xa9, 0x5b, 0xd3, 0x33, 0xd3, 0x66, 0x25, 0x93,
-	0x55, 0x39, 0xe2, 0x4f, 0x91, 0x49, 0xa9, 0xd5, 0x86, 0x37, 0x9c, 0x62, 0x06, 0x4b, 0x69, 0x91,
-	0x99, 0x54, 0x66, 0x52, 0xaa, 0x9a, 0x9b, 0x31, 0x27, 0x1f, 0x7c, 0x30, 0x16, 0x86, 0xb1, 0x17,
-	0xdb, 0xc0, 0xc2, 0x27, 0x03, 0xbe, 0xd8, 0x8b, 0xb5, 0xc7, 0x87, 0xc5, 0x1a, 0x0b, 0xaf, 0x4f,
-	0x86, 0x0f, 0x86, 0x0f, 0x86, 0x61, 0x18, 0x3e, 0xf8, 0x60, 0xf8, 0xb0, 0x37, 0x03, 0x3e, 0x19,
-	0xf1, 0xc9, 0x64, 0x26, 0xc9, 0x2a, 0xa9, 0xd5, 0xbd, 0xc0, 0x00, 0x05, 0x30, 0xe3, 0xbd, 0x17,
-	0xff, 0x17, 0xef, 0xbd, 0x78, 0x11, 0x2f, 0x0a, 0x6e, 0x0c, 0x43, 0x7a, 0x77, 0xec, 0x7b, 0xa1,
-	0x17, 0xdc, 0x0d, 0x68, 0x10, 0x38, 0x9e, 0x4b, 0x46, 0x96, 0x6b, 0x9d, 0x52, 0xff, 0x80, 0x83,
-	0xd1, 0xda, 0xc8, 0x3a, 0x1d, 0x59, 0x07, 0xc3, 0x90, 0xee, 0xef, 0x79, 0x7e, 0xff, 0x2b, 0x3f,
-	0x22, 0xef, 0x7b, 0xa3, 0x91, 0xe7, 0x0a, 0xaa, 0xfd, 0xbd, 0x44, 0x39, 0x63, 0x6f, 0xe8, 0xf4,
-	0xcf, 0xed, 0x67, 0x12, 0x75, 0x2d, 0x59, 0xc5, 0xe4, 0x59, 0xd0, 0xf7, 0x9d, 0x67, 0xd4, 0x8f,
-	0xd1, 0xd7, 0x4f, 0x3d, 0xef, 0x74, 0x28, 0x29, 0x9e, 0x4d, 0x06, 0x77, 0x43, 0x67, 0x44, 0x83,
-	0xd0, 0x1a, 0x8d, 0x05, 0x41, 0xf5, 0x4f, 0xb3, 0x00, 0x78, 0x32, 0xa4, 0x98, 0xf6, 0x3d, 0xdf,
-	0x46, 0x0a, 0xe4, 0x02, 0xc7, 0xae, 0x64, 0x6e, 0x64, 0x6e, 0xaf, 0x61, 0xf6, 0x89, 0x76, 0x61,
-	0xd5, 0x9f, 0x0c, 0x29, 0x71, 0xec, 0x4a, 0x96, 0x43, 0x57, 0x58, 0x52, 0xb7, 0xd1, 0x1e, 0xe4,
-	0x9f, 0x9d, 0x87, 0x34, 0x20, 0xe1, 0x59, 0x25, 0x77, 0x23, 0x73, 0x7b, 0x09, 0xaf, 0xf2, 0xb4,
-	0x79, 0x36, 0x45, 0xf9, 0x67, 0x95, 0xa5, 0x04, 0x0a, 0x9f, 0xb1, 0xe2, 0x26, 0x94, 0x38, 0xe3,
-	0x57, 0x5f, 0x54, 0x96, 0x45, 0x71, 0x13, 0xaa, 0x8f, 0x5f, 0x7d, 0x31, 0x45, 0xdc, 0xaf, 0xac,
-	0x24, 0x10, 0xf7, 0xd1, 0x35, 0x00, 0xdb, 0xf7, 0xc6, 0x63, 0x6a, 0xb3, 0x9a, 0x56, 0x79, 0x71,
-	0x6b, 0x12, 0x62, 0x9e, 0x25, 0xd1, 0xfe, 0x59, 0x25, 0x9f, 0x42, 0xe3, 0x33, 0xf4, 0x01, 0x14,
-	0x79, 0xf3, 0x5f, 0x51, 0x9f, 0x0d, 0x7f, 0x65, 0x8d, 0x13, 0x14, 0x18, 0xec, 0xb1, 0x00, 0x21,
-	0x04, 0x4b, 0x21, 0x75, 0xec, 0x0a, 0xdc, 0xc8, 0xdc, 0x2e, 0x61, 0xfe, 0xcd, 0xb2, 0x0d, 0x1c,
-	0xd7, 0x1a, 0x12, 0x9f, 0x8f, 0x4b, 0xa5, 0x70, 0x23, 0x73, 0x3b, 0x8f, 0x0b, 0x1c, 0x26, 0x86,
-	0xaa, 0xfa, 0x2d, 0xac, 0x4f, 0x07, 0xce, 0xb4, 0x9e, 0x0d, 0x29, 0xba, 0x0b, 0xab, 0x82, 0x3e,
-	0xa8, 0x64, 0x6e, 0xe4, 0x6e, 0x17, 0x0e, 0xb7, 0x0f, 0xe2, 0xf9, 0x3d, 0x98, 0x12, 0xe3, 0x88,
-	0x0a, 0x6d, 0xc1, 0x32, 0x1d, 0x7b, 0xfd, 0xe7, 0x7c, 0x68, 0x97, 0xb0, 0x48, 0x54, 0xff, 0x55,
-	0x06, 0xf6, 0x9a, 0x5e, 0xdf, 0x1a, 0xaa, 0x3e, 0xb5, 0x42, 0x6a, 0x08, 0xce, 0xc1, 0xf4, 0xe5,
-	0x84, 0x06, 0x21, 0x6a, 0x40, 0x59, 0x30, 0x07, 0xe9, 0x7b, 0x6e, 0x48, 0xcf, 0xc2, 0x8a, 0x72,
-	0x23, 0x73, 0xbb, 0x70, 0x78, 0x3d, 0x51, 0x97, 0xca, 0x09, 0x64, 0x46, 0x55, 0x90, 0xe1, 0x92,
-	0xc8, 0x26, 0x93, 0xa8, 0x03, 0x5b, 0xbe, 0x15, 0x92, 0x60, 0x4c, 0xfb, 0xce, 0xc0, 0xe9, 0xc7,
-	0xa5, 0x6d, 0xf0, 0xd2, 0xae, 0x25, 0x5b, 0x6e, 0x85, 0x86, 0xa4, 0x8a, 0xca, 0x42, 0xfe, 0x1c,
-	0xac, 0xfa, 0x4b, 0x58, 0x36, 0xa9, 0x63, 0x07, 0x6c, 0xfa, 0xa9, 0xfb, 0x8c, 0xf0, 0x41, 0xcd,
-	0xf1, 0x41, 0x5d, 0xa5, 0xee, 0x33, 0x86, 0x63, 0x28, 0xeb, 0xf4, 0xb5, 0x40, 0x2d, 0x09, 0x94,
-	0x75, 0xfa, 0x9a, 0xa1, 0xaa, 0xff, 0x3b, 0x0b, 0x5b, 0x8b, 0xda, 0x8d, 0x7e, 0x32, 0xe5, 0xc9,
-	0xc2, 0xe1, 0x6e, 0xa2, 0x5d, 0x46, 0xcc, 0xef, 0x7a, 0x3d, 0x66, 0xd6, 0x88, 0xbb, 0x72, 0x29,
-	0xee, 0x52, 0x20, 0x67, 0x8d, 0x5d, 0x5e, 0xe5, 0x1a, 0x66, 0x9f, 0x68, 0x07, 0x56, 0x46, 0x81,
-	0x13, 0xd8, 0x2e, 0xe7, 0xc3, 0x22, 0x96, 0x29, 0xf4, 0x09, 0xe4, 0xd9, 0xb0, 0x84, 0xe7, 0x63,
-	0xca, 0x19, 0xb1, 0x7c, 0x88, 0x92, 0x43, 0x51, 0x33, 0xcd, 0xf3, 0x31, 0xc5, 0xab, 0xbe, 0x15,
-	0xb2, 0x0f, 0xd4, 0x00, 0x25, 0x18, 0x91, 0x68, 0x71, 0x07, 0xa1, 0x15, 0x52, 0xce, 0xa3, 0xe5,
-	0xc3, 0xab, 0xc9, 0x96, 0xb6, 0x64, 0x9f, 0x1a, 0x46, 0xcb, 0x60, 0x34, 0xb8, 0x1c, 0x8c, 0x24,
-	0x88, 0xa7, 0xd1, 0xcf, 0x00, 0x25, 0xca, 0x49, 0x72, 0x6b, 0x09, 0x2b, 0x31, 0x6d, 0xc4, 0xb2,
-	0x89, 0xc5, 0x02, 0xa9, 0xc5, 0xf2, 0x11, 0x2c, 0xb3, 0xb1, 0x0d, 0x38, 0xc3, 0x16, 0x0e, 0x95,
-	0x44, 0x1b, 0xf8, 0xdc, 0x60, 0x81, 0xae, 0xfe, 0xbf, 0x0c, 0xa0, 0xf9, 0x69, 0x45, 0xdf, 0x40,
-	0x61, 0x18, 0xd2, 0x19, 0x56, 0x48, 0x76, 0xa4, 0x69, 0x6a, 0xe9, 0xd9, 0x39, 0xb9, 0x82, 0x61,
-	0x18, 0xd2, 0xa8, 0x80, 0x23, 0x28, 0xbe, 0x1e, 0x5a, 0x53, 0xd6, 0x44, 0x73, 0xcc, 0xf4, 0xa4,
-	0x59, 0x6b, 0xcf, 0x15, 0x51, 0x60, 0x99, 0xa2, 0x32, 0x4c, 0xd8, 0x1e, 0xdd, 0x3b, 0x4d, 0x8c,
-	0x46, 0x54, 0xd8, 0x26, 0x2f, 0xec, 0xfd, 0x44, 0x61, 0xad, 0x7b, 0xc7, 0xf1, 0xd0, 0x4e, 0x4b,
-	0xdb, 0xe4, 0xd9, 0xd3, 0xe0, 0xa3, 0x35, 0x58, 0x95, 0xe5, 0x54, 0xff, 0x41, 0x06, 0x56, 0xd9,
-	0x68, 0x18, 0x34, 0x8c, 0x17, 0x7f, 0x26, 0xb1, 0xf8, 0xab, 0x50, 0xa2, 0xae, 0xcd, 0xd9, 0x88,
-	0x58, 0xb6, 0xed, 0x4b, 0xc1, 0x57, 0xa0, 0xae, 0xcd, 0x98, 0xa9, 0x66, 0xdb, 0x3e, 0x13, 0x3b,
-	0x8c, 0x96, 0xbc, 0xb2, 0x86, 0x13, 0x2a, 0xb9, 0x7c, 0x8d, 0x41, 0x1e, 0x33, 0x00, 0xba, 0x0b,
-	0x5b, 0xa9, 0x22, 0x48, 0x10, 0xfa, 0x8e, 0x7b, 0x2a, 0x19, 0x70, 0x23, 0x51, 0x92, 0xc1, 0x11,
-	0xd5, 0xdf, 0xe5, 0x60, 0x37, 0x31, 0x21, 0x6d, 0x2f, 0x64, 0x3f, 0x56, 0xc8, 0x66, 0xfb, 0x43,
-	0x28, 0x8f, 0xed, 0x49, 0x3c, 0x1c, 0x71, 0x6b, 0x8b, 0x63, 0x7b, 0x22, 0x7b, 0xa9, 0xdb, 0xe8,
-	0x01, 0x14, 0x7d, 0x21, 0x22, 0x04, 0xf3, 0x66, 0x39, 0x17, 0xee, 0x24, 0x99, 0x57, 0xa0, 0x39,
-	0x03, 0x17, 0xfc, 0x69, 0x02, 0xdd, 0x87, 0x82, 0xd5, 0xef, 0xd3, 0x20, 0x10, 0x39, 0x73, 0x3c,
-	0x67, 0x52, 0x76, 0xd5, 0x38, 0x96, 0x67, 0x04, 0x2b, 0xfe, 0x46, 0x2a, 0x28, 0xc9, 0x86, 0xf1,
-	0xcc, 0x4b, 0x3c, 0xf3, 0x5e, 0x22, 0x73, 0x37, 0x6e, 0x25, 0x2f, 0xa0, 0x3c, 0x4e, 0xa5, 0x51,
-	0x03, 0x36, 0x46, 0xf7, 0x4e, 0x49, 0x30, 0x22, 0x7d, 0x6b, 0x6c, 0x3d, 0x73, 0x86, 0x4e, 0x78,
-	0xce, 0xd7, 0x64, 0xe1, 0x70, 0x7f, 0x76, 0xaa, 0xd5, 0x98, 0x02, 0xaf, 0x8f, 0xee, 0x9d, 0x1a,
-	0xa3, 0x29, 0x80, 0x75, 0x42, 0xb0, 0x4d, 0xdf, 0x9a, 0x04, 0xd1, 0xda, 0xdd, 0x9e, 0x2f, 0x61,
-	0x12, 0x50, 0x0c, 0x9c, 0x92, 0x7f, 0xa3, 0x1a, 0xac, 0xbb, 0x6c, 0xb4, 0xcf, 0xc9, 0x84, 0x12,
-	0xfa, 0x8a, 0xba, 0xa1, 0x5c, 0xc0, 0xc9, 0x3e, 0xf0, 0xf9, 0x38, 0xef, 0x51, 0x8d, 0xe1, 0x03,
-	0x5c, 0x72, 0x93, 0xe9, 0xea, 0xbf, 0xcb, 0x40, 0xc5, 0xa0, 0xa1, 0x31, 0x4a, 0x4e, 0x5b, 0xc4,
-	0xce, 0xf3, 0xf2, 0x3a, 0xf3, 0x4e, 0xf2, 0xfa, 0x0f, 0x60, 0x2f, 0x25, 0xaf, 0xdd, 0x44, 0x5d,
-	0x7c, 0xb2, 0x0b, 0x87, 0xd5, 0xc5, 0x42, 0x3b, 0xd9, 0x2a, 0xbc, 0xeb, 0x2f, 0x46, 0x54, 0xff,
-	0x4d, 0x16, 0x36, 0xe6, 0x96, 0x37, 0x7a, 0x0f, 0xd6, 0x82, 0xf1, 0xe9, 0x6b, 0x21, 0x53, 0x85,
-	0x59, 0x90, 0x67, 0x00, 0x2e, 0x55, 0x11, 0x2c, 0x39, 0x23, 0xea, 0xc8, 0xf5, 0xc1, 0xbf, 0x99,
-	0x68, 0x1a, 0x0f, 0x47, 0x9c, 0x4b, 0xa5, 0x08, 0x66, 0x49, 0xdd, 0x46, 0x37, 0xa0, 0xe8, 0x8c,
-	0x02, 0x87, 0x44, 0x58, 0xb1, 0x14, 0x80, 0xc1, 0xba, 0x82, 0xe2, 0x26, 0x94, 0x26, 0x01, 0xf5,
-	0xc9, 0xd0, 0x93, 0xbd, 0x12, 0x92, 0xb9, 0xc8, 0x80, 0x4d, 0x09, 0x43, 0x5f, 0x43, 0xfe, 0xa5,
-	0x17, 0x10, 0xc7, 0x1d, 0x78, 0x7c, 0x8e, 0x0b, 0x87, 0x37, 0x12, 0xbd, 0x7e, 0xe4, 0x05, 0xba,
-	0x3b, 0xf0, 0xfc, 0x91, 0xe8, 0xac, 0xe0, 0x71, 0xbc, 0xfa, 0x52, 0x80, 0x59, 0x6f, 0x9e, 0x51,
-	0xcb, 0xa7, 0x3e, 0x6b, 0xc0, 0x2a, 0x5f, 0x44, 0x79, 0x01, 0xe0, 0x0b, 0xa8, 0xd2, 0x7f, 0x6e,
-	0xf9, 0xa7, 0x8e, 0x7b, 0x4a, 0xd8, 0x87, 0xd5, 0x0f, 0xa9, 0xef, 0x04, 0xa1, 0xd3, 0x0f, 0xb8,
-	0x5d, 0xb1, 0x86, 0x77, 0x23, 0xbc, 0x9a, 0x46, 0x57, 0x7f, 0x9b, 0x01, 0x34, 0x2f, 0xd8, 0xd0,
-	0x47, 0xb0, 0x3e, 0xb2, 0xfa, 0x42, 0x00, 0x3c, 0x73, 0x5c, 0xcb, 0x3f, 0xe7, 0x43, 0x58, 0xc4,
-	0xa5, 0x91, 0xd5, 0x67, 0x8b, 0xff, 0x88, 0x03, 0x99, 0x56, 0x8c, 0xe8, 0xe4, 0x58, 0xae, 0x4a,
-	0x02, 0x74, 0x07, 0x36, 0x7c, 0xcb, 0x76, 0x26, 0x41, 0x72, 0xf9, 0x8b, 0x81, 0x5d, 0x17, 0x88,
-	0x58, 0x02, 0x54, 0xbf, 0x86, 0xfd, 0x45, 0x66, 0x43, 0x30, 0xf6, 0xdc, 0x80, 0x32, 0x89, 0x35,
-	0x23, 0x41, 0xd6, 0xf0, 0x5a, 0x10, 0x67, 0xee, 0xc1, 0x0e, 0xcf, 0xac, 0xb9, 0xf6, 0x8c, 0xc1,
-	0xf1, 0x3d, 0xf4, 0xaf, 0x54, 0xb3, 0xd9, 0x58, 0xcd, 0x56, 0xf7, 0x60, 0x77, 0xae, 0x58, 0xd1,
-	0xa0, 0xea, 0x9f, 0x64, 0x60, 0xa7, 0x37, 0xb6, 0xad, 0x90, 0x9a, 0x13, 0xd7, 0xa5, 0x43, 0xdd,
-	0x0e, 0xde, 0xa1, 0xca, 0xd4, 0x94, 0x66, 0x67, 0xa6, 0xf4, 0x9d, 0x2c, 0x91, 0x99, 0x91, 0x5a,
-	0x9e, 0x1d, 0xa9, 0x3d, 0xd8, 0x9d, 0x6b, 0xb6, 0xec, 0xd2, 0x7f, 0xcb, 0xc0, 0x7e, 0x97, 0x1b,
-	0xe8, 0x47, 0xbc, 0x09, 0x47, 0x8e, 0x6b, 0x3b, 0xee, 0xe9, 0x3b, 0x74, 0xeb, 0x36, 0x28, 0x43,
-	0xc7, 0x7d, 0x41, 0x6d, 0x32, 0xdb, 0xbb, 0xb2, 0x80, 0x1f, 0x45, 0x7d, 0x64, 0xda, 0x81, 0x57,
-	0x49, 0x22, 0x3b, 0x5d, 0xb0, 0x47, 0x51, 0x40, 0xb1, 0xb0, 0xd6, 0x53, 0xc3, 0xb4, 0x34, 0x33,
-	0x4c, 0xb1, 0xd5, 0xb0, 0x7c, 0xb9, 0xd5, 0x70, 0x0d, 0xde, 0x5b, 0xd8, 0x3b, 0xd9, 0xfb, 0x3f,
-	0xce, 0xc2, 0xb6, 0x2a, 0x57, 0x08, 0xa6, 0xb5, 0x49, 0xf8, 0x3c, 0xea, 0xf8, 0xe5, 0xbc, 0xc7,
-	0xac, 0xed, 0x78, 0xe5, 0xbd, 0xa0, 0xe7, 0xb2, 0xa3, 0x85, 0x08, 0xf6, 0x90, 0x9e, 0x47, 0x1b,
-	0x93, 0xdc, 0x74, 0x63, 0xf2, 0x00, 0x96, 0x12, 0x0a, 0xe7, 0x56, 0x52, 0x9a, 0x2e, 0x6a, 0xc3,
-	0x01, 0x57, 0x3e, 0x3c, 0x0b, 0x7a, 0x08, 0x28, 0xa0, 0xfe, 0x2b, 0xa7, 0xcf, 0x86, 0x8b, 0xba,
-	0x4c, 0x08, 0x52, 0x5f, 0x76, 0x3e, 0x65, 0xb6, 0x09, 0x22, 0x3d, 0xa6, 0xc1, 0x1b, 0xc1, 0x2c,
-	0xa8, 0x7a, 0x00, 0x4b, 0x5c, 0x8f, 0x21, 0x28, 0x1b, 0x7a, 0xfb, 0xb8, 0xa9, 0x11, 0x43, 0xc3,
-	0x8f, 0x75, 0x55, 0x53, 0xae, 0x30, 0x98, 0xd6, 0x36, 0x75, 0xcc, 0x60, 0x86, 0xa1, 0x77, 0xda,
-	0x4a, 0xa6, 0x7a, 0x0c, 0x5b, 0xe9, 0x06, 0xd6, 0xdc, 0xe0, 0x35, 0xf5, 0xd1, 0x5d, 0x58, 0xf1,
-	0x69, 0x30, 0x19, 0x0a, 0xfd, 0x50, 0x4e, 0xf1, 0x47, 0xd4, 0x13, 0x86, 0xc6, 0x92, 0xac, 0xfa,
-	0xcf, 0x97, 0x60, 0x53, 0x4c, 0xc7, 0xf7, 0x1a, 0x6c, 0x2e, 0xb4, 0x83, 0x84, 0xd0, 0x0e, 0x1c,
-	0x26, 0xa8, 0x18, 0xf3, 0x04, 0x24, 0xf4, 0x88, 0x4f, 0x47, 0xde, 0x2b, 0x66, 0x04, 0xe4, 0x6e,
-	0xaf, 0xe1, 0x12, 0x07, 0x9b, 0x1e, 0xe6, 0x40, 0x66, 0xed, 0xc6, 0x74, 0x8e, 0x1b, 0x84, 0xd6,
-	0x70, 0x58, 0x59, 0xe1, 0x3b, 0x9d, 0xd4, 0xb0, 0x85, 0x56, 0xe8, 0xf4, 0x39, 0xe3, 0x09, 0x1a,
-	0x5c, 0x96, 0xc5, 0xc8, 0x34, 0x7a, 0x0c, 0x15, 0xfb, 0xdc, 0xb5, 0x46, 0x4e, 0x9f, 0xcc, 0x95,
-	0xb7, 0xca, 0xcb, 0x4b, 0x9a, 0x8c, 0x75, 0x41, 0x9a, 0x2c, 0x70, 0xdb, 0x9e, 0xc2, 0x12, 0xe5,
-	0xfe, 0x0a, 0xca, 0x5c, 0x83, 0x93, 0xd0, 0x77, 0x4e, 0x4f, 0xa9, 0xcf, 0x04, 0x77, 0x6e, 0x66,
-	0x2c, 0xb9, 0xca, 0x36, 0x05, 0x1e, 0x97, 0x68, 0x22, 0x15, 0xa0, 0x63, 0xd8, 0xf0, 0xe9, 0x2b,
-	0x6b, 0xe8, 0xd8, 0x5c, 0x7f, 0x10, 0xb6, 0x5b, 0xe6, 0x46, 0x38, 0xb3, 0x45, 0xc4, 0x56, 0xfa,
-	0x20, 0xda, 0x4a, 0x1f, 0x98, 0xd1, 0x56, 0x1a, 0x2b, 0xc9, 0x4c, 0x0c, 0x8c, 0xbe, 0x83, 0xca,
-	0x24, 0xb0, 0x4e, 0x29, 0x19, 0x79, 0xae, 0x13, 0x7a, 0x3e, 0xd7, 0x29, 0x3e, 0xb5, 0x9d, 0x30,
-	0xa8, 0x00, 0xef, 0x60, 0x52, 0x6b, 0xf5, 0x18, 0x69, 0x2b, 0xa6, 0x54, 0x39, 0x21, 0xde, 0x99,
-	0x2c, 0x02, 0x07, 0xe8, 0x8b, 0x84, 0x06, 0x14, 0x66, 0xfe, 0x5e, 0x4a, 0x03, 0x1a, 0x49, 0x0d,
-	0x18, 0xa9, 0xbe, 0x6a, 0x07, 0xca, 0x69, 0x54, 0x5a, 0x24, 0x48, 0xd5, 0x1e, 0x8b, 0x84, 0x1b,
-	0x90, 0x7b, 0xd9, 0x77, 0xa4, 0x11, 0x59, 0x4e, 0x96, 0xaf, 0xea, 0x98, 0xa1, 0xaa, 0xff, 0x30,
-	0x0f, 0x28, 0xc9, 0x7e, 0x92, 0x8d, 0xdf, 0xc0, 0x7d, 0x53, 0x2e, 0xcf, 0xbe, 0x15, 0x97, 0xa3,
-	0x47, 0x50, 0x1c, 0x58, 0xce, 0x90, 0x6d, 0xef, 0xd9, 0x5c, 0x73, 0xbe, 0x2c, 0x1c, 0x1e, 0x24,
-	0xed, 0xcb, 0xb9, 0x46, 0x1c, 0x34, 0x78, 0x0e, 0xce, 0x1c, 0x9a, 0x1b, 0xfa, 0xe7, 0xb8, 0x30,
-	0x98, 0x42, 0xf6, 0x1d, 0x50, 0x66, 0x09, 0x98, 0x7c, 0x61, 0x92, 0x47, 0x3a, 0x3e, 0x5e, 0xd0,
-	0x73, 0xf4, 0x0d, 0x2c, 0x0b, 0xe3, 0x5e, 0x34, 0xf4, 0x27, 0x6f, 0xae, 0x71, 0xe2, 0x53, 0xd5,
-	0xb3, 0x29, 0x16, 0xf9, 0x7e, 0x9e, 0xfd, 0x2a, 0x53, 0xfd, 0xab, 0x65, 0x28, 0x24, 0x50, 0x08,
-	0x60, 0xa5, 0xd7, 0xee, 0x19, 0x5a, 0x5d, 0xb9, 0x82, 0xb6, 0x61, 0xa3, 0xd7, 0x7e, 0xd8, 0xee,
-	0x3c, 0x69, 0x13, 0xdc, 0x6b, 0x6a, 0xa4, 0x5d, 0x6b, 0x69, 0x4a, 0x06, 0xed, 0x00, 0xc2, 0x35,
-	0x53, 0x6f, 0x1f, 0x93, 0x63, 0xdc, 0xe9, 0x75, 0x89, 0x86, 0x71, 0x07, 0x2b, 0x59, 0x74, 0x15,
-	0x2a, 0x52, 0xb0, 0x10, 0xbd, 0xce, 0xa4, 0x4a, 0x43, 0xd7, 0xb0, 0xc4, 0xe6, 0xd0, 0x2e, 0x6c,
-	0x1e, 0x3f, 0x21, 0x5d, 0x55, 0x6b, 0x90, 0x56, 0xad, 0xd9, 0xe8, 0xb5, 0x55, 0x93, 0x89, 0x9b,
-	0x25, 0x54, 0x81, 0x2d, 0xac, 0x19, 0x9d, 0x1e, 0x56, 0x35, 0x83, 0x34, 0xf5, 0x96, 0x6e, 0xd6,
-	0x38, 0x66, 0x19, 0xed, 0xc3, 0x4e, 0xab, 0xf6, 0x2d, 0x69, 0x63, 0x72, 0xa4, 0xd5, 0xb0, 0x86,
-	0x0d, 0x82, 0xb5, 0x9a, 0x7a, 0xa2, 0xd5, 0x95, 0x95, 0x64, 0xdb, 0x04, 0x92, 0xe8, 0x75, 0x65,
-	0x95, 0x81, 0x5b, 0xba, 0xc1, 0xc4, 0x5c, 0x02, 0x9c, 0x67, 0x4d, 0x8b, 0xc0, 0x8d, 0x66, 0xe7,
-	0x09, 0xd1, 0xdb, 0x8d, 0x0e, 0x6e, 0x89, 0x7a, 0xd6, 0xd0, 0x75, 0x78, 0x2f, 0x6a, 0x01, 0xa9,
-	0x35, 0x9b, 0x1d, 0x95, 0x23, 0x48, 0xa3, 0xa6, 0x37, 0x7b, 0x58, 0x53, 0x80, 0x11, 0xf4, 0xda,
-	0x46, 0x4f, 0x55, 0x35, 0xc3, 0x68, 0xf4, 0x9a, 0xe4, 0x51, 0xc7, 0x20, 0x8f, 0x6b, 0x4d, 0xbd,
-	0x2e, 0x4a, 0x28, 0xa0, 0xf7, 0x61, 0x5f, 0x6f, 0xab, 0x1d, 0x8c, 0x35, 0xd5, 0x9c, 0xaf, 0xa1,
-	0xc8, 0x9a, 0xd5, 0x35, 0x88, 0xd9, 0x21, 0xaa, 0x41, 0x4e, 0x6a, 0xed, 0x7a, 0xe7, 0xb1, 0x86,
-	0x95, 0x12, 0xfa, 0x10, 0x6e, 0x98, 0xf5, 0x06, 0xa9, 0x75, 0xbb, 0x4d, 0x5d, 0x56, 0x3a, 0x37,
-	0x72, 0x65, 0xb4, 0x09, 0xeb, 0xed, 0x4e, 0xd4, 0x9d, 0x46, 0xa7, 0xd7, 0xae, 0x2b, 0xeb, 0x6c,
-	0x38, 0x1b, 0x7a, 0xd3, 0xd4, 0x30, 0xc1, 0x9a, 0x61, 0x62, 0x9d, 0x8f, 0xa6, 0xa1, 0x28, 0x48,
-	0x81, 0x62, 0xad, 0x4d, 0x8e, 0x9f, 0xf0, 0xe6, 0x6b, 0x75, 0x65, 0x03, 0xdd, 0x84, 0xeb, 0x51,
-	0xe7, 0xb1, 0x56, 0xd7, 0x79, 0x1b, 0xd9, 0x44, 0x69, 0x98, 0xd4, 0xea, 0x75, 0xac, 0x19, 0x86,
-	0x82, 0x58, 0x0f, 0xd4, 0x16, 0xd1, 0xda, 0x75, 0xd2, 0x33, 0x34, 0x1c, 0x69, 0x08, 0x52, 0xd7,
-	0xda, 0xba, 0x56, 0x57, 0x36, 0x59, 0x53, 0xd5, 0x16, 0x51, 0x59, 0x01, 0x26, 0x51, 0x3b, 0x6d,
-	0x13, 0x77, 0x9a, 0xa4, 0xdd, 0x31, 0xa3, 0xc6, 0x1f, 0x35, 0x35, 0x65, 0x0b, 0x5d, 0x83, 0x3d,
-	0xb5, 0x45, 0x6a, 0x3d, 0xf3, 0xa4, 0x83, 0xf5, 0xef, 0x44, 0x8f, 0xb0, 0xf6, 0x6b, 0x4d, 0x35,
-	0xb5, 0xba, 0xb2, 0xcd, 0x7a, 0xa2, 0xb6, 0x44, 0x05, 0x72, 0xf2, 0x94, 0x1d, 0xb4, 0x05, 0x8a,
-	0xda, 0x22, 0x92, 0xa3, 0x64, 0xa3, 0x77, 0xd9, 0xdc, 0xe3, 0x4e, 0x8f, 0xc3, 0x38, 0xef, 0x89,
-	0x52, 0xd8, 0x68, 0x56, 0xd0, 0x47, 0x50, 0x8d, 0xf9, 0x52, 0xd2, 0xd4, 0xf8, 0xdc, 0xa4, 0x46,
-	0x7d, 0x8f, 0x8d, 0x7a, 0xbb, 0x43, 0xda, 0x47, 0x7a, 0xa3, 0xd3, 0x22, 0x46, 0xaf, 0xdb, 0xed,
-	0x60, 0x53, 0xd9, 0xaf, 0xfe, 0xe7, 0x0c, 0xac, 0xb2, 0x85, 0xc5, 0x76, 0xd7, 0x87, 0xb0, 0x6d,
-	0x8d, 0xc7, 0xc3, 0x73, 0x32, 0x75, 0x4d, 0x92, 0xd7, 0x8e, 0x4d, 0xf9, 0x3a, 0xcb, 0xe3, 0x4d,
-	0x8e, 0x9c, 0x1a, 0x3f, 0x4f, 0x1c, 0x9b, 0xce, 0xdb, 0x90, 0xe8, 0x1b, 0x28, 0x06, 0x5c, 0xa5,
-	0xa4, 0x44, 0xc0, 0xe5, 0x1a, 0xa7, 0x10, 0xc4, 0xa0, 0x00, 0x1d, 0x41, 0x29, 0xa5, 0x6e, 0x2a,
-	0x4b, 0x6f, 0xa3, 0x63, 0x8a, 0x49, 0x1d, 0x53, 0x7d, 0x02, 0x88, 0x7f, 0x74, 0xa9, 0x3f, 0x6d,
-	0x70, 0xac, 0x4c, 0x33, 0x09, 0x65, 0xfa, 0x09, 0xe4, 0xb9, 0x25, 0x16, 0xd0, 0x50, 0x36, 0x15,
-	0xcd, 0xb8, 0x01, 0x0d, 0x1a, 0x62, 0xee, 0x55, 0x35, 0x68, 0x58, 0x25, 0x50, 0x8c, 0x2c, 0x63,
-	0xde, 0xd8, 0x0e, 0x6c, 0x09, 0x9d, 0x38, 0xa6, 0x7e, 0x62, 0xdc, 0xa4, 0x47, 0xf1, 0xda, 0x4c,
-	0x51, 0xe9, 0xf6, 0x60, 0xe4, 0xcf, 0xc1, 0xaa, 0x9f, 0x41, 0x9e, 0xe9, 0xa4, 0xdf, 0x78, 0x2e,
-	0x45, 0xb7, 0xa0, 0xec, 0x0d, 0x06, 0x01, 0x0d, 0xc9, 0xc8, 0x71, 0x27, 0x21, 0x0d, 0x78, 0xcb,
-	0x97, 0x71, 0x49, 0x40, 0x5b, 0x02, 0x58, 0xfd, 0x06, 0x40, 0x68, 0x9b, 0x9e, 0xeb, 0x84, 0xcc,
-	0x54, 0x76, 0x02, 0xc2, 0x35, 0x9c, 0x9c, 0xb8, 0x55, 0x27, 0x78, 0xcc, 0x92, 0x68, 0x07, 0x56,
-	0x5e, 0x79, 0xc3, 0xc9, 0x88, 0x4a, 0x0f, 0xa6, 0x4c, 0x55, 0xff, 0x5e, 0x06, 0x8a, 0xc7, 0xbe,
-	0xe5, 0x86, 0xd4, 0x66, 0x45, 0x04, 0xe8, 0xa7, 0xb0, 0x1c, 0x7a, 0xa1, 0x35, 0x94, 0xc6, 0x6f,
-	0x72, 0x5f, 0x3e, 0xad, 0x09, 0x0b, 0x1a, 0x74, 0x0b, 0xb2, 0xe1, 0x99, 0xdc, 0xd3, 0x5e, 0x40,
-	0x99, 0x0d, 0xcf, 0x18, 0x99, 0x2f, 0x7c, 0xcf, 0x17, 0x93, 0xf9, 0x67, 0xd5, 0xff, 0x93, 0x81,
-	0x32, 0xa6, 0xb6, 0xe3, 0xd3, 0x7e, 0xc8, 0x2c, 0x3a, 0xea, 0x23, 0x0b, 0xb6, 0x7d, 0x09, 0xe1,
-	0xbb, 0xae, 0xd8, 0xf5, 0x21, 0x4c, 0xaf, 0x4f, 0x52, 0x4a, 0x29, 0x99, 0x33, 0x4e, 0xd6, 0x44,
-	0x2e, 0x6e, 0x54, 0x6e, 0xfa, 0xf3, 0x40, 0x74, 0x1f, 0x76, 0xe3, 0x2a, 0x02, 0x9e, 0x37, 0xaa,
-	0x49, 0xb2, 0x76, 0xdc, 0x02, 0x51, 0xb2, 0xcc, 0x5b, 0xfd, 0x06, 0x36, 0x17, 0xd4, 0x81, 0xf2,
-	0xb0, 0xa4, 0x77, 0x1f, 0x7f, 0xa1, 0x5c, 0x91, 0x5f, 0xf7, 0x95, 0x0c, 0x5a, 0x85, 0x5c, 0x0f,
-	0x37, 0x95, 0x2c, 0x2a, 0xc0, 0xaa, 0xa1, 0x77, 0x49, 0x0f, 0xeb, 0x4a, 0xae, 0xfa, 0x3f, 0x72,
-	0x50, 0x8e, 0x0c, 0x4c, 0x31, 0x12, 0xe8, 0xbe, 0x34, 0x95, 0x85, 0x26, 0xab, 0x2e, 0x30, 0x95,
-	0x05, 0xe1, 0x01, 0x1b, 0xb3, 0x84, 0x9d, 0x7c, 0x13, 0x4a, 0x7c, 0xd6, 0x9d, 0xf0, 0x5c, 0x98,
-	0x42, 0x62, 0x0f, 0x55, 0x8c, 0x80, 0xdc, 0xd4, 0x11, 0xdc, 0xc1, 0x3d, 0xe3, 0xdc, 0x16, 0xe7,
-	0xdc, 0xd1, 0x60, 0x49, 0x74, 0x12, 0x79, 0xd1, 0xad, 0x7e, 0xbc, 0x9f, 0x5f, 0x6c, 0xaa, 0xcb,
-	0xfa, 0x79, 0xb6, 0x1a, 0x27, 0x96, 0xce, 0x76, 0x91, 0x40, 0xbf, 0x80, 0xd2, 0xa9, 0x60, 0x27,
-	0x32, 0x61, 0xfc, 0x24, 0xb7, 0xfe, 0x49, 0xeb, 0x21, 0xc9, 0x6e, 0xb8, 0x78, 0x9a, 0x64, 0xbe,
-	0x23, 0x58, 0x9f, 0x99, 0x0b, 0xbe, 0xf9, 0x4f, 0x1b, 0x4e, 0xe9, 0x89, 0xc6, 0xe5, 0xf4, 0xf4,
-	0xb0, 0x95, 0xe3, 0xd3, 0x20, 0xf4, 0x9d, 0x7e, 0x28, 0x85, 0x48, 0x5e, 0x5a, 0xc8, 0x12, 0x2a,
-	0xc4, 0x44, 0x15, 0xf2, 0xd1, 0x20, 0xa2, 0x35, 0x58, 0x3e, 0x7a, 0x6a, 0x6a, 0x86, 0x72, 0x85,
-	0xcf, 0x90, 0xa6, 0x76, 0xda, 0x75, 0x43, 0xc9, 0x54, 0xbf, 0x81, 0x42, 0xa2, 0xa3, 0xa8, 0x04,
-	0x6b, 0xa6, 0x86, 0x5b, 0x7a, 0xbb, 0x66, 0xb2, 0x3d, 0x43, 0x11, 0xf2, 0x91, 0x1e, 0x51, 0x32,
-	0x4c, 0xa6, 0x47, 0x1a, 0x48, 0x4a, 0x61, 0x25, 0x5b, 0x6d, 0x30, 0x86, 0xe6, 0xc6, 0x7e, 0xd4,
-	0xc3, 0xad, 0xe4, 0xf2, 0x5a, 0x8a, 0xd6, 0x51, 0x39, 0x5e, 0x47, 0x4b, 0x7c, 0xc1, 0x94, 0xe3,
-	0x05, 0xb3, 0xc4, 0x57, 0xc6, 0x1f, 0x2d, 0x41, 0x41, 0x2e, 0x16, 0x66, 0x6a, 0xa6, 0x8e, 0x74,
-	0x32, 0x17, 0x1f, 0xe9, 0x64, 0xd3, 0x47, 0x3a, 0xb3, 0xbb, 0xb7, 0xa5, 0xf9, 0xdd, 0xdb, 0x3d,
-	0xc9, 0x80, 0x82, 0x01, 0x3e, 0x98, 0x5f, 0xab, 0xac, 0xfa, 0x03, 0xb9, 0xab, 0x9e, 0xf2, 0xdf,
-	0x2d, 0x28, 0x27, 0xec, 0x67, 0x56, 0xf6, 0x8a, 0x74, 0x9f, 0xc4, 0x50, 0x56, 0xfa, 0xe2, 0xed,
-	0xdc, 0xea, 0x3b, 0x6d, 0xe7, 0x04, 0xaf, 0xc8, 0xb1, 0x95, 0xbc, 0x96, 0x5f, 0xc0, 0x2b, 0xc9,
-	0xd1, 0x67, 0xbc, 0x92, 0x4c, 0x57, 0xff, 0x3c, 0x03, 0x30, 0xed, 0x0c, 0x9f, 0xe0, 0x13, 0xac,
-	0x19, 0x27, 0x9d, 0x26, 0xb3, 0xfb, 0x56, 0x21, 0xf7, 0xe8, 0x84, 0xcd, 0x6d, 0x19, 0x20, 0x9e,
-	0xf8, 0xba, 0x92, 0x65, 0x73, 0xfd, 0xa8, 0xd7, 0x31, 0x6b, 0x44, 0xfb, 0xf6, 0xa4, 0xd6, 0x33,
-	0x18, 0x30, 0xc7, 0x34, 0x35, 0xb7, 0x85, 0x74, 0xf3, 0x29, 0x31, 0xf5, 0x16, 0x33, 0x5c, 0xbe,
-	0xed, 0xea, 0x58, 0xab, 0x2b, 0x4b, 0x4c, 0xb7, 0x77, 0xcc, 0x13, 0x0d, 0x13, 0x91, 0xcd, 0x7c,
-	0xda, 0xd5, 0x94, 0x65, 0xf4, 0x1e, 0xec, 0x4a, 0x75, 0xcf, 0x18, 0x4e, 0xe7, 0x56, 0x82, 0x7a,
-	0x52, 0x6b, 0x1f, 0x6b, 0xca, 0x8a, 0xe0, 0x27, 0x66, 0x41, 0x10, 0xac, 0x3d, 0xea, 0xf1, 0x72,
-	0x56, 0xd9, 0x36, 0xb5, 0xdb, 0xe9, 0x34, 0x13, 0xf5, 0xe6, 0xab, 0x7f, 0xb1, 0x04, 0x1b, 0x89,
-	0xc9, 0x11, 0xdd, 0x41, 0x3f, 0x83, 0x65, 0xbe, 0x2b, 0x91, 0x62, 0x7c, 0x67, 0xf1, 0x4c, 0x62,
-	0x41, 0x34, 0xb3, 0x17, 0xc8, 0xce, 0xee, 0x05, 0xf8, 0x92, 0x12, 0x1e, 0x6b, 0x77, 0x32, 0x62,
-	0x3a, 0x4e, 0xc8, 0x97, 0x92, 0x84, 0xb6, 0x39, 0x30, 0xed, 0x82, 0x5c, 0xbd, 0xc0, 0x05, 0xb9,
-	0xb6, 0xd8, 0x05, 0x09, 0x97, 0xba, 0x20, 0x0b, 0x6f, 0x76, 0x41, 0x16, 0x17, 0xb8, 0x20, 0x6f,
-	0x42, 0xe9, 0xb9, 0xe5, 0xdb, 0xaf, 0x2d, 0x9f, 0x0a, 0x9f, 0x5d, 0x59, 0x10, 0x45, 0x40, 0xee,
-	0xb8, 0xfb, 0x0c, 0xf2, 0xe1, 0xe9, 0x78, 0x4c, 0xfa, 0xe1, 0x59, 0x65, 0x7d, 0x6e, 0xb0, 0xcc,
-	0xd3, 0xf1, 0x38, 0xf2, 0xf3, 0xae, 0x32, 0x3a, 0x35, 0x3c, 0xfb, 0xbd, 0x3d, 0xd9, 0xbb, 0xd4,
-	0x33, 0x8a, 0x2e, 0xf7, 0x8c, 0xfe, 0x61, 0x0e, 0xb6, 0x24, 0x67, 0x70, 0x0e, 0x8a, 0xdd, 0x91,
-	0x15, 0x58, 0x0d, 0x26, 0xfc, 0x28, 0x21, 0xb2, 0x29, 0x64, 0x32, 0x72, 0xf5, 0x64, 0xa7, 0xae,
-	0x9e, 0x59, 0x09, 0x93, 0x9b, 0x97, 0x30, 0x9f, 0xc1, 0x8a, 0xd8, 0x5f, 0x73, 0xf1, 0x93, 0x5e,
-	0xad, 0x69, 0x25, 0x83, 0x25, 0x21, 0xba, 0x0e, 0x05, 0xb1, 0xc7, 0x24, 0x7d, 0xcf, 0x16, 0x07,
-	0x06, 0x25, 0x0c, 0x02, 0xc4, 0x37, 0x6b, 0x3f, 0xaa, 0x5c, 0x79, 0x00, 0x30, 0x74, 0x46, 0x8e,
-	0x3c, 0x9c, 0x59, 0xe3, 0x82, 0x70, 0x7f, 0x6e, 0xf9, 0x34, 0x19, 0x09, 0x97, 0x80, 0x6b, 0xc3,
-	0xe8, 0x33, 0xc5, 0x4a, 0xf0, 0x76, 0xac, 0x94, 0x5e, 0x79, 0x85, 0x59, 0x17, 0xe6, 0x1f, 0x67,
-	0x00, 0x25, 0x9d, 0x0e, 0x72, 0x75, 0xcf, 0xcb, 0xdb, 0xcc, 0x22, 0x79, 0xfb, 0x29, 0x2c, 0x0f,
-	0xe9, 0x2b, 0x3a, 0x94, 0xf6, 0x44, 0xea, 0x94, 0x26, 0x26, 0x6c, 0x32, 0x0a, 0x2c, 0x08, 0xdf,
-	0xed, 0xae, 0x40, 0xf5, 0x9f, 0x64, 0x61, 0x7b, 0xa1, 0x6b, 0x04, 0x7d, 0x03, 0x2b, 0xd2, 0xa4,
-	0x10, 0x06, 0xdb, 0xc7, 0x6f, 0x72, 0xa6, 0x1c, 0x48, 0xa3, 0x42, 0x66, 0x5b, 0xd0, 0xd3, 0xec,
-	0xa5, 0x3d, 0xcd, 0xbd, 0x6d, 0x4f, 0xe7, 0x0c, 0x95, 0xe5, 0xef, 0x61, 0xa8, 0x54, 0x0f, 0x60,
-	0x45, 0x1a, 0x05, 0x45, 0xc8, 0xb3, 0x6d, 0xa0, 0xde, 0xee, 0x69, 0xc2, 0x7c, 0xa8, 0xeb, 0x06,
-	0xdf, 0x05, 0x66, 0x98, 0x59, 0xd1, 0xe8, 0x60, 0x55, 0x53, 0xb2, 0xd5, 0x7f, 0x9f, 0x83, 0xab,
-	0x33, 0xfd, 0x8d, 0x96, 0x99, 0xf0, 0x05, 0xde, 0x83, 0x95, 0x09, 0x07, 0x48, 0x81, 0x7d, 0xed,
-	0x82, 0x81, 0x92, 0xb9, 0x24, 0xf1, 0x8f, 0x24, 0xb8, 0xe5, 0x42, 0x5e, 0x4a, 0x5d, 0x26, 0x59,
-	0x7c, 0xfb, 0x63, 0x4e, 0xa4, 0xae, 0x2c, 0x10, 0xa9, 0xc9, 0xa3, 0xf9, 0xd5, 0x37, 0x1f, 0xcd,
-	0x27, 0x97, 0x4d, 0xfe, 0xed, 0x96, 0xcd, 0x2f, 0xa0, 0x94, 0xf2, 0x1f, 0xca, 0x75, 0x7a, 0xa1,
-	0xfb, 0xb0, 0x98, 0x74, 0x1f, 0x5e, 0x2a, 0x26, 0xe1, 0x72, 0x31, 0xf9, 0x5f, 0x96, 0xe0, 0xda,
-	0x05, 0x13, 0x29, 0xe5, 0xe5, 0x57, 0xb1, 0x80, 0xcb, 0xcc, 0x9d, 0x7a, 0x2d, 0xf6, 0x1f, 0x46,
-	0x72, 0xee, 0x0d, 0x93, 0x39, 0xef, 0x59, 0x4f, 0x88, 0xe6, 0xa5, 0xb4, 0x68, 0x9e, 0xf7, 0xaf,
-	0x2e, 0xff, 0x70, 0xff, 0xea, 0xca, 0x3b, 0xf8, 0x57, 0x67, 0x64, 0xf7, 0xea, 0x9c, 0xec, 0x5e,
-	0xe0, 0xd1, 0xce, 0x2f, 0xf2, 0x68, 0x1b, 0xb0, 0x9b, 0xf4, 0x2d, 0x24, 0x1d, 0xd1, 0x6b, 0x6f,
-	0xe1, 0x66, 0xd8, 0x4a, 0xb8, 0x19, 0xde, 0xce, 0xbd, 0x0d, 0x3f, 0xc0, 0xbd, 0x9d, 0xe4, 0xe8,
-	0xc2, 0x5b, 0x71, 0x74, 0xf5, 0xb7, 0x39, 0xd8, 0x5e, 0x78, 0x28, 0x8a, 0xde, 0x87, 0x82, 0x35,
-	0x76, 0x89, 0x35, 0x7a, 0xe6, 0x13, 0x7b, 0x28, 0xaf, 0x14, 0xac, 0x59, 0x63, 0xb7, 0x36, 0x7a,
-	0xe6, 0xd7, 0x87, 0x29, 0xfc, 0x64, 0x28, 0xcf, 0x64, 0x22, 0x7c, 0x8f, 0x6d, 0xd2, 0xcb, 0x63,
-	0xdf, 0xf1, 0x7c, 0xb6, 0x39, 0x9c, 0x0a, 0xc9, 0x12, 0x2e, 0x45, 0x50, 0x2e, 0x17, 0xd1, 0xe7,
-	0xb0, 0x3d, 0xf6, 0x29, 0x1d, 0x8d, 0xf9, 0x84, 0x27, 0x8e, 0xf8, 0xc5, 0x36, 0x61, 0x6b, 0x8a,
-	0x4c, 0x9c, 0xe5, 0x3f, 0x80, 0x4a, 0x22, 0xd3, 0xab, 0xc9, 0xd0, 0xa5, 0x7e, 0xf2, 0x6a, 0x40,
-	0x09, 0xef, 0x4e, 0xf1, 0x8f, 0x93, 0x68, 0x66, 0xe3, 0xbd, 0xf4, 0x02, 0xd2, 0x1f, 0x5a, 0x41,
-	0xc0, 0xf8, 0x5d, 0xaa, 0xf5, 0x97, 0x5e, 0xa0, 0x32, 0x90, 0x6e, 0xa3, 0x5f, 0xc3, 0x2a, 0xeb,
-	0x92, 0xeb, 0x44, 0x07, 0xfd, 0x9f, 0xbd, 0xe9, 0x00, 0xf9, 0xe0, 0xc8, 0x09, 0x7d, 0x2b, 0xa4,
-	0x5c, 0x48, 0xd7, 0x5a, 0x47, 0x18, 0xaf, 0x3c, 0xf3, 0x59, 0xa2, 0x7a, 0x0b, 0x94, 0x59, 0x1c,
-	0xb3, 0xef, 0x8f, 0xba, 0x86, 0xd8, 0xa9, 0x3f, 0x64, 0x5f, 0x99, 0xea, 0x23, 0x28, 0x24, 0x66,
-	0x87, 0xb5, 0xf1, 0xf4, 0x8c, 0xd8, 0x4c, 0x7e, 0x3e, 0xf7, 0x82, 0x50, 0x7a, 0x8f, 0xe0, 0xf4,
-	0xac, 0x4e, 0x83, 0xf0, 0xc4, 0x0b, 0x04, 0xc5, 0x79, 0x82, 0x22, 0x2b, 0x29, 0xce, 0x23, 0x8a,
-	0xea, 0x7f, 0xca, 0x72, 0xc3, 0x6a, 0xfe, 0x7e, 0xd8, 0x2f, 0x60, 0x3d, 0xba, 0xcc, 0x21, 0xbd,
-	0x3e, 0x52, 0x62, 0x6c, 0x26, 0x79, 0x45, 0xa2, 0x70, 0x59, 0x5e, 0xe7, 0x88, 0x1c, 0x44, 0x6f,
-	0x10, 0x16, 0x0b, 0x76, 0x47, 0xb9, 0xef, 0xb9, 0x3b, 0xfa, 0xfd, 0xbd, 0xc0, 0xf6, 0x27, 0x4b,
-	0xb0, 0xbd, 0xf8, 0xec, 0xfc, 0x01, 0xac, 0x46, 0xa7, 0x37, 0xc2, 0x0d, 0x77, 0x7d, 0x7e, 0xe3,
-	0x93, 0x12, 0xd7, 0x38, 0xa2, 0x47, 0x1d, 0x28, 0xa7, 0x4e, 0x82, 0x02, 0x79, 0x60, 0x76, 0xfb,
-	0x62, 0xf9, 0x3d, 0x53, 0x54, 0x29, 0x79, 0x0e, 0x14, 0xcc, 0x79, 0x43, 0x57, 0x7f, 0xb0, 0x37,
-	0x34, 0xff, 0xbd, 0xbd, 0xa1, 0x33, 0x6c, 0xb2, 0x36, 0xcb, 0x26, 0xef, 0x60, 0xb1, 0xce, 0xab,
-	0x96, 0xc2, 0x0f, 0x57, 0x2d, 0xc5, 0x77, 0x50, 0x2d, 0x3b, 0xb0, 0xe2, 0xb9, 0x43, 0xc7, 0xa5,
-	0x95, 0x12, 0x57, 0x7e, 0x32, 0xc5, 0xb4, 0xa2, 0x37, 0x18, 0x70, 0xc4, 0xba, 0xd0, 0x8a, 0x32,
-	0x59, 0xfd, 0x5d, 0x06, 0x36, 0xe6, 0xc6, 0x3c, 0x79, 0x71, 0x36, 0x93, 0xba, 0x38, 0xab, 0xb2,
-	0x05, 0x1a, 0x3a, 0xaf, 0x12, 0xed, 0xcc, 0xbe, 0xb1, 0x9d, 0xe5, 0x69, 0x16, 0xde, 0xca, 0x63,
-	0xd8, 0xb0, 0xe9, 0x6c, 0x31, 0xb9, 0x37, 0x77, 0x37, 0x99, 0x89, 0x81, 0xab, 0xff, 0x35, 0x03,
-	0x68, 0x7e, 0xba, 0xd1, 0x7d, 0x28, 0x24, 0x6e, 0x15, 0x2c, 0xf0, 0xda, 0x76, 0xe3, 0xdb, 0x05,
-	0x18, 0xa6, 0x37, 0x0d, 0x7e, 0xcf, 0x3a, 0xf7, 0x8f, 0x33, 0xb0, 0x25, 0x56, 0xd3, 0x8c, 0x90,
-	0xbc, 0x0f, 0xab, 0xc2, 0xd4, 0x8d, 0x16, 0xf4, 0xd5, 0xc5, 0x9e, 0x0c, 0xb9, 0x14, 0x23, 0x62,
-	0xd4, 0x9e, 0x5b, 0xcd, 0xc2, 0xc3, 0xff, 0xf1, 0x9b, 0x57, 0xb3, 0xb8, 0x8a, 0x94, 0x5e, 0xcc,
-	0xd5, 0x7f, 0x9d, 0x81, 0xed, 0x99, 0x06, 0x4a, 0x91, 0xf3, 0x4b, 0x58, 0xf3, 0xe5, 0xf7, 0x5b,
-	0x0b, 0x9d, 0x69, 0x0e, 0xf4, 0xb7, 0x61, 0x37, 0xd5, 0x50, 0x32, 0x2d, 0x2c, 0xf7, 0x3d, 0xe5,
-	0xcf, 0x76, 0xb2, 0xc9, 0x11, 0x34, 0xa8, 0x3e, 0x84, 0x4a, 0x74, 0x8d, 0x8f, 0xfa, 0x23, 0xc7,
-	0x4d, 0x1a, 0xab, 0xf3, 0xd7, 0xc8, 0x2f, 0xd7, 0x2b, 0xd5, 0xff, 0xb8, 0x04, 0xbb, 0xf3, 0xa5,
-	0x2d, 0xba, 0xcf, 0xf0, 0x16, 0x9b, 0x91, 0xa5, 0x45, 0x9b, 0x91, 0xaf, 0xa1, 0x24, 0x44, 0x32,
-	0xe1, 0xdd, 0x11, 0x96, 0xeb, 0xc5, 0x1e, 0xac, 0x62, 0x7f, 0x9a, 0x08, 0x50, 0x3d, 0xde, 0x2e,
-	0x46, 0xb9, 0x57, 0xe6, 0x64, 0xe6, 0x82, 0xed, 0x54, 0xb4, 0x9b, 0x94, 0xa5, 0xa4, 0x1c, 0x59,
-	0x6b, 0x17, 0x38, 0xb2, 0x60, 0xb1, 0x23, 0xab, 0x70, 0xa9, 0x23, 0xab, 0xf8, 0x66, 0x47, 0x56,
-	0xe9, 0x6d, 0x1c, 0x59, 0xeb, 0x6f, 0x70, 0x64, 0x29, 0xef, 0xea, 0xc8, 0xda, 0x78, 0x27, 0x0d,
-	0xff, 0x03, 0xfc, 0x4e, 0x7f, 0x3f, 0x03, 0x2b, 0x6d, 0xcf, 0xa6, 0x7a, 0x1d, 0xfd, 0x0a, 0x8a,
-	0xae, 0x67, 0x33, 0x41, 0x9c, 0x3c, 0xe3, 0xb9, 0x9a, 0xba, 0xdd, 0xc9, 0x08, 0xe5, 0x8f, 0xb8,
-	0xe5, 0xca, 0x72, 0xe8, 0x36, 0xdf, 0x47, 0xee, 0xc2, 0xaa, 0xcc, 0x1f, 0x45, 0x40, 0x08, 0x64,
-	0xf5, 0x0e, 0xc0, 0x34, 0x8b, 0x38, 0x97, 0x79, 0x15, 0x9f, 0xd0, 0xbc, 0xba, 0xaf, 0x64, 0xd8,
-	0x57, 0xe3, 0x51, 0xbd, 0xad, 0x64, 0xab, 0x67, 0xb0, 0x3e, 0x73, 0x83, 0x55, 0x30, 0xee, 0x60,
-	0x48, 0x99, 0xc8, 0xa2, 0xe4, 0xa5, 0x17, 0x39, 0xc2, 0x4a, 0x53, 0xe8, 0x23, 0x8f, 0xed, 0xb9,
-	0xae, 0x8e, 0x26, 0xc3, 0xd0, 0x21, 0xcf, 0xbd, 0x11, 0xe5, 0x57, 0x8a, 0xef, 0x93, 0xc4, 0xad,
-	0x5b, 0xde, 0xa6, 0x3c, 0xae, 0x70, 0x9a, 0x13, 0x46, 0xa2, 0x8f, 0x5f, 0xdd, 0x9f, 0xde, 0xb9,
-	0xad, 0xfe, 0x79, 0x16, 0xf2, 0x8f, 0xbc, 0x40, 0xe8, 0xed, 0x03, 0xd8, 0x64, 0xd6, 0xb1, 0x54,
-	0x4c, 0xb1, 0x4f, 0x4b, 0x18, 0xff, 0x1b, 0x2f, 0x05, 0x59, 0xc2, 0x6b, 0xa5, 0x40, 0xce, 0x7e,
-	0xe9, 0xcb, 0x3a, 0xd8, 0x27, 0xfa, 0x12, 0x2a, 0x62, 0x99, 0x11, 0x6f, 0x40, 0xc6, 0x56, 0xff,
-	0x05, 0x0d, 0xc9, 0xc0, 0x19, 0x86, 0xd4, 0x0f, 0xe4, 0x06, 0x60, 0x5b, 0xe0, 0x3b, 0x83, 0x2e,
-	0xc7, 0x36, 0x04, 0x12, 0x7d, 0x05, 0x95, 0x14, 0x79, 0xb2, 0xfe, 0xa5, 0x1b, 0xb9, 0xdb, 0xcb,
-	0x78, 0x67, 0x9c, 0xc8, 0x90, 0x68, 0x44, 0xb2, 0xd1, 0x63, 0x9f, 0xf6, 0xa9, 0x4d, 0xdd, 0x3e,
-	0x95, 0x1b, 0x81, 0xa8, 0xd1, 0xdd, 0x18, 0x81, 0x6e, 0x40, 0x21, 0xa0, 0xa7, 0x3e, 0x3d, 0x15,
-	0x9c, 0xbf, 0x22, 0x62, 0x37, 0x12, 0xa0, 0xa8, 0xc4, 0xc1, 0xd0, 0x7b, 0x3d, 0xeb, 0xda, 0x13,
-	0x25, 0x36, 0x86, 0xde, 0xeb, 0xc4, 0x1d, 0xaf, 0x33, 0x58, 0x6f, 0x5a, 0xb6, 0x2b, 0x1d, 0x7d,
-	0x35, 0x9f, 0x5a, 0x6c, 0x31, 0xdb, 0xae, 0x2b, 0xef, 0x7f, 0xcb, 0xdb, 0x33, 0xb6, 0xeb, 0x8a,
-	0xeb, 0xdf, 0x27, 0x50, 0x12, 0xc5, 0xb2, 0xbd, 0x91, 0xc3, 0xed, 0x77, 0x26, 0x2e, 0x6e, 0x26,
-	0x17, 0x8e, 0x6f, 0xf5, 0x5f, 0x38, 0xee, 0x29, 0x2b, 0x4c, 0x97, 0xb4, 0x4d, 0x27, 0x08, 0x71,
-	0xd1, 0x49, 0xa4, 0xaa, 0x7f, 0x9a, 0x81, 0xca, 0x45, 0xa4, 0xe8, 0x4b, 0x28, 0x32, 0x8e, 0x66,
-	0x33, 0xc1, 0x6b, 0xc9, 0x70, 0x8b, 0x29, 0xa9, 0xa5, 0x19, 0x67, 0x76, 0x06, 0xbc, 0x5c, 0x08,
-	0xe3, 0x6f, 0xf4, 0x33, 0x40, 0xd3, 0x49, 0xa4, 0x43, 0x3a, 0xa2, 0x6e, 0x18, 0xf0, 0x46, 0x2e,
-	0x63, 0x25, 0x9a, 0x3e, 0x4d, 0xc2, 0x19, 0x13, 0xb4, 0x54, 0x35, 0xf2, 0x10, 0xb4, 0x54, 0x95,
-	0x43, 0xda, 0x6a, 0xe4, 0xd9, 0x69, 0xb5, 0x39, 0xc4, 0xac, 0xa9, 0xd2, 0xab, 0xc3, 0x3e, 0xab,
-	0x7f, 0x05, 0xb0, 0xb9, 0xe0, 0x7e, 0xfe, 0x5f, 0xff, 0x6d, 0xf6, 0x9f, 0x43, 0x81, 0x55, 0x10,
-	0x9d, 0xb6, 0xe6, 0xde, 0x74, 0xd2, 0x07, 0x63, 0x7b, 0x22, 0x8f, 0x5a, 0xd9, 0xfe, 0x26, 0xde,
-	0xd4, 0x5a, 0x53, 0x17, 0x48, 0xfa, 0x32, 0x78, 0x44, 0x21, 0x08, 0x70, 0xbc, 0x0d, 0x16, 0xb7,
-	0xe4, 0x67, 0x6f, 0xd3, 0x2f, 0xbf, 0xed, 0x6d, 0xfa, 0x48, 0x03, 0xac, 0x24, 0x34, 0x00, 0x82,
-	0xa5, 0xd3, 0x71, 0xe0, 0xc8, 0x63, 0x0f, 0xfe, 0xcd, 0x26, 0x2f, 0x25, 0xda, 0xc5, 0x4d, 0x30,
-	0x71, 0x43, 0x59, 0x49, 0xca, 0x77, 0x7e, 0xe5, 0x79, 0x1b, 0x56, 0xc6, 0xfd, 0xc1, 0xd4, 0x4a,
-	0x5f, 0x1e, 0xf7, 0x07, 0xba, 0x8d, 0x1a, 0xb0, 0x31, 0xb4, 0x6c, 0x97, 0x44, 0x0e, 0x6e, 0xcb,
-	0xa7, 0x96, 0xf4, 0x4d, 0x24, 0xbd, 0x9c, 0x33, 0x5c, 0x8f, 0xd7, 0x87, 0x33, 0xcb, 0xa0, 0x09,
-	0x37, 0x93, 0xb3, 0xc9, 0xf6, 0x19, 0x93, 0x20, 0x75, 0x37, 0x9d, 0x04, 0x93, 0x67, 0x32, 0x7e,
-	0xea, 0xfa, 0x74, 0x8a, 0x0d, 0x4e, 0x98, 0xbc, 0x80, 0x6e, 0x4c, 0x9e, 0x21, 0x1d, 0x10, 0x5b,
-	0x54, 0x01, 0xe5, 0xf2, 0xcf, 0x73, 0xc9, 0xc8, 0xb3, 0x85, 0x15, 0x5f, 0x3e, 0x7c, 0x2f, 0xb9,
-	0x3f, 0x71, 0x5d, 0x23, 0xa2, 0x69, 0x79, 0x36, 0xc5, 0x8a, 0x3d, 0x03, 0x41, 0x3f, 0x85, 0x8d,
-	0xd0, 0xb7, 0xfa, 0x94, 0x30, 0xd6, 0x70, 0x7c, 0xce, 0xca, 0x5c, 0x09, 0xae, 0x61, 0x85, 0x23,
-	0xf0, 0x14, 0x8e, 0x7e, 0x0d, 0x55, 0xc7, 0x0d, 0xe9, 0x29, 0x9f, 0x77, 0x66, 0x59, 0x46, 0xd5,
-	0x5b, 0x67, 0xc4, 0xb6, 0x42, 0x8b, 0xb0, 0x1d, 0xbe, 0x3c, 0xe6, 0x79, 0x3f, 0xa6, 0xec, 0xc6,
-	0x84, 0x2d, 0xeb, 0xac, 0x6e, 0x85, 0x16, 0xb6, 0xc2, 0xc5, 0x41, 0x11, 0xeb, 0xdf, 0x37, 0x28,
-	0xe2, 0x13, 0xc8, 0x07, 0x41, 0x5f, 0x74, 0x5f, 0x99, 0x73, 0x75, 0x1a, 0x41, 0x9f, 0xf7, 0x7a,
-	0x35, 0x10, 0x1f, 0x8b, 0x63, 0x28, 0x36, 0xbe, 0x7f, 0x0c, 0xc5, 0x97, 0x50, 0x61, 0x5d, 0x1e,
-	0xbf, 0x88, 0x85, 0x74, 0x30, 0x19, 0x8f, 0x3d, 0x3f, 0xa4, 0x36, 0x57, 0xb8, 0x25, 0xbc, 0x3d,
-	0xb2, 0xce, 0xba, 0x2f, 0xa4, 0x8c, 0x36, 0x22, 0x24, 0xfa, 0x0a, 0xf6, 0x92, 0x9d, 0xf6, 0xe9,
-	0x4b, 0x62, 0x0d, 0x5f, 0x5b, 0xe7, 0x01, 0xf1, 0x5c, 0x1e, 0xb7, 0x93, 0xc7, 0xdb, 0xd3, 0x2e,
-	0x62, 0xfa, 0xb2, 0xc6, 0xb1, 0x1d, 0x17, 0x7d, 0x00, 0xa5, 0x60, 0xc4, 0x15, 0x9a, 0xcd, 0xf3,
-	0x55, 0xb6, 0x84, 0x2d, 0x13, 0x8c, 0xba, 0xf6, 0xa4, 0xce, 0x48, 0x67, 0x23, 0x3b, 0xb6, 0xdf,
-	0x36, 0xb2, 0x43, 0x85, 0xcd, 0xa9, 0xb3, 0x22, 0xd2, 0x20, 0x41, 0x65, 0x67, 0xce, 0x1b, 0x12,
-	0xa9, 0x47, 0xbc, 0x11, 0xd3, 0xc7, 0x1a, 0xf3, 0x01, 0x94, 0x4f, 0xb9, 0xfa, 0xa7, 0xae, 0x3d,
-	0xf6, 0x1c, 0x37, 0xac, 0xec, 0xf2, 0xfc, 0x68, 0xe6, 0x92, 0xb4, 0x41, 0x43, 0x5c, 0xe2, 0x94,
-	0x9a, 0x24, 0x44, 0xf7, 0xa0, 0x38, 0x19, 0x0f, 0xa6, 0x19, 0x2b, 0x17, 0x66, 0x2c, 0x4c, 0xc6,
-	0x83, 0x38, 0x1b, 0x53, 0x94, 0xbe, 0xd7, 0xa7, 0xf6, 0xc4, 0xa7, 0x24, 0xf4, 0x2d, 0x37, 0x20,
-	0x91, 0x4a, 0xa8, 0xec, 0x71, 0x16, 0xdc, 0x89, 0xf1, 0x26, 0x43, 0x47, 0x3a, 0xa1, 0xfa, 0x2f,
-	0x32, 0xb0, 0x69, 0xd0, 0x70, 0x4e, 0xe4, 0xfe, 0x58, 0x21, 0x28, 0x17, 0x79, 0x5c, 0xb2, 0xef,
-	0xea, 0x71, 0xf9, 0x18, 0x4a, 0xc6, 0x48, 0x26, 0x1e, 0x7b, 0xe2, 0x3e, 0x91, 0x37, 0x09, 0xc7,
-	0x93, 0xc8, 0x27, 0x26, 0x53, 0xd5, 0xd7, 0x50, 0x99, 0x2f, 0x52, 0xca, 0xdb, 0xbf, 0x09, 0xbb,
-	0x9c, 0xf9, 0xd3, 0xd1, 0x62, 0xc4, 0x0f, 0xc6, 0xb2, 0x61, 0x1f, 0x5e, 0x1e, 0x31, 0x26, 0x8a,
-	0xc1, 0x5b, 0x6c, 0x31, 0xa4, 0xbb, 0x1f, 0x8c, 0xab, 0xff, 0x34, 0x03, 0x79, 0xee, 0xf0, 0x74,
-	0x1d, 0x3e, 0x8e, 0xc2, 0x1d, 0xea, 0x46, 0xe7, 0x78, 0xc2, 0x96, 0x4c, 0xfa, 0xe2, 0x23, 0xe2,
-	0xf8, 0x83, 0xaf, 0xef, 0xa2, 0x95, 0x48, 0x55, 0x9b, 0x50, 0x4c, 0x62, 0x11, 0xc0, 0xca, 0xc3,
-	0x67, 0xe3, 0x80, 0x7c, 0xaa, 0x5c, 0x89, 0xbf, 0x3f, 0x53, 0x32, 0xf1, 0xf7, 0x17, 0xe2, 0x8e,
-	0x8f, 0x80, 0xdf, 0x57, 0x72, 0x71, 0xe2, 0xfe, 0x17, 0xca, 0x52, 0xf5, 0x77, 0xab, 0xb0, 0x77,
-	0x61, 0xb7, 0xde, 0x52, 0xdd, 0x2e, 0x12, 0x5a, 0xd9, 0xef, 0x2b, 0xb4, 0x7e, 0x05, 0x1b, 0x42,
-	0x72, 0x53, 0x9b, 0xc4, 0xd2, 0x2b, 0x77, 0xa1, 0xf4, 0x5a, 0x8f, 0x88, 0x25, 0x00, 0x69, 0xb0,
-	0x65, 0x4d, 0xc2, 0xe7, 0x9e, 0xef, 0xfc, 0x26, 0xb5, 0x60, 0xc5, 0x6d, 0xbd, 0x85, 0x0b, 0x16,
-	0x4d, 0x33, 0xc4, 0x2b, 0xb6, 0x06, 0xc8, 0xf6, 0x5e, 0xbb, 0x43, 0xc7, 0x7d, 0x91, 0x98, 0xa9,
-	0xe5, 0xb9, 0x55, 0x1f, 0x4d, 0x01, 0x56, 0x22, 0xf2, 0x78, 0x42, 0x6e, 0x41, 0x39, 0x55, 0x44,
-	0x20, 0xdd, 0xc8, 0xa5, 0x24, 0x65, 0x80, 0x7e, 0x09, 0xca, 0x64, 0x3c, 0x53, 0xcf, 0xea, 0xc5,
-	0xf5, 0x94, 0x05, 0x71, 0x5c, 0xcb, 0x07, 0x4c, 0x3e, 0x24, 0xea, 0xc8, 0x8b, 0x63, 0xed, 0x29,
-	0x15, 0xb7, 0x25, 0x5a, 0x09, 0xd1, 0xb7, 0x76, 0xa9, 0xe8, 0x6b, 0x4d, 0x45, 0x5f, 0x03, 0x6e,
-	0xc4, 0xf2, 0x97, 0xa4, 0xe6, 0xdf, 0xb5, 0xa3, 0x98, 0x31, 0xe0, 0x62, 0xf9, 0xaa, 0x25, 0x25,
-	0xf1, 0x74, 0x72, 0xf5, 0x98, 0x06, 0xfd, 0x02, 0x14, 0x6b, 0x38, 0xf4, 0x5e, 0x27, 0x67, 0xb4,
-	0x70, 0xe1, 0x8c, 0x96, 0x25, 0x6d, 0x34, 0xa1, 0x6d, 0xf8, 0x50, 0xb6, 0xde, 0x73, 0x4f, 0x69,
-	0x28, 0x34, 0x03, 0xb1, 0xc2, 0x90, 0x8e, 0xc6, 0x61, 0xd4, 0x14, 0xcf, 0xe7, 0x0a, 0x3e, 0x8f,
-	0x6f, 0x88, 0xf6, 0x47, 0xa4, 0x98, 0xd6, 0x04, 0xa1, 0x1e, 0xd1, 0xcd, 0x5a, 0x76, 0xa5, 0xef,
-	0x63, 0xd9, 0xcd, 0x0a, 0xe3, 0xf2, 0x0f, 0x17, 0xc6, 0xeb, 0x97, 0x09, 0x63, 0xf4, 0x21, 0xe4,
-	0xd8, 0x9e, 0x4e, 0x99, 0xab, 0x87, 0xed, 0x2d, 0x18, 0xb3, 0x32, 0x74, 0xf5, 0x77, 0x19, 0xd8,
-	0x5b, 0x20, 0xb2, 0xe5, 0xe2, 0xfd, 0xb1, 0x04, 0x77, 0xef, 0x52, 0xc1, 0x7d, 0xf3, 0x52, 0xc1,
-	0x2d, 0xc5, 0xe3, 0x22, 0xf1, 0xfd, 0x17, 0x19, 0x28, 0xf6, 0xba, 0x0d, 0xb6, 0x09, 0x16, 0x51,
-	0xcc, 0xdb, 0xb0, 0xc2, 0x06, 0x39, 0x76, 0xfd, 0x2c, 0x4f, 0xc6, 0xcc, 0xd8, 0x7c, 0x08, 0x8a,
-	0x15, 0x04, 0x5e, 0xdf, 0xb1, 0xc2, 0x38, 0x48, 0x3a, 0x3b, 0x17, 0xcc, 0xdb, 0xeb, 0x36, 0x6a,
-	0x92, 0x2a, 0x0a, 0x8b, 0x3e, 0xb9, 0x82, 0xd7, 0xa7, 0x39, 0x45, 0x1d, 0x5f, 0x43, 0x81, 0xeb,
-	0x63, 0x9f, 0x32, 0xdb, 0x43, 0x9a, 0xf7, 0x95, 0x74, 0x39, 0xac, 0x45, 0x98, 0xe3, 0x4f, 0xae,
-	0x88, 0xbd, 0xbc, 0x48, 0x1d, 0x6d, 0xc2, 0x06, 0x6b, 0x20, 0x2f, 0x60, 0x44, 0x03, 0xee, 0x97,
-	0xa9, 0xfe, 0xcf, 0x2c, 0xec, 0xf5, 0x02, 0xea, 0x77, 0x87, 0x96, 0x4b, 0xf5, 0x2e, 0xa6, 0x81,
-	0x37, 0xf1, 0xfb, 0xd4, 0xe8, 0x3f, 0xa7, 0x23, 0x8b, 0xad, 0xd2, 0x38, 0x8c, 0x37, 0xba, 0xad,
-	0xb2, 0x86, 0x0b, 0x8e, 0x8c, 0xdf, 0x65, 0xd3, 0x24, 0x48, 0xee, 0xcf, 0x5c, 0xf0, 0x64, 0x24,
-	0xf7, 0x23, 0x92, 0x43, 0xd8, 0xe6, 0xf1, 0xc2, 0xbe, 0xe5, 0x9e, 0xd2, 0xe4, 0x2a, 0x14, 0x7b,
-	0xe6, 0x4d, 0x86, 0xc4, 0x0c, 0x97, 0x58, 0x7c, 0x51, 0x8c, 0x31, 0xcf, 0x23, 0xbd, 0x5a, 0x6b,
-	0x31, 0x21, 0xfa, 0x02, 0x76, 0xf8, 0xd8, 0x10, 0x97, 0x86, 0xaf, 0x3d, 0xff, 0x85, 0x38, 0x62,
-	0x8c, 0x76, 0xc6, 0x6b, 0x78, 0x8b, 0x63, 0xdb, 0x02, 0xa9, 0x4b, 0xdc, 0x34, 0x97, 0xe8, 0x24,
-	0x61, 0xd6, 0xac, 0x3f, 0xb0, 0xfa, 0xd1, 0x05, 0x18, 0x91, 0xcb, 0xe0, 0x48, 0x3d, 0xc2, 0x55,
-	0xff, 0x06, 0x94, 0xe3, 0x84, 0xd8, 0xe2, 0x02, 0xac, 0xc8, 0xfb, 0x88, 0xdc, 0xe1, 0xa1, 0x76,
-	0xb0, 0x26, 0xae, 0xa4, 0x36, 0x6b, 0x6d, 0x25, 0x8b, 0xd6, 0xa1, 0xa0, 0x76, 0x49, 0x1c, 0x87,
-	0x90, 0xab, 0xfe, 0xa3, 0x25, 0xd8, 0x5c, 0x30, 0xc3, 0xe8, 0x26, 0x94, 0x38, 0x43, 0xc4, 0x31,
-	0xef, 0xe2, 0xa0, 0x91, 0x9f, 0x71, 0xc4, 0x4f, 0x34, 0x74, 0xa1, 0x20, 0x1b, 0xcd, 0x79, 0x47,
-	0xe8, 0x94, 0xbb, 0x97, 0xf3, 0xce, 0xc1, 0x2c, 0x00, 0x83, 0xe8, 0x1a, 0xaf, 0xf6, 0xd7, 0xcc,
-	0x36, 0xec, 0x7b, 0xaf, 0xa8, 0x2f, 0xae, 0xb6, 0x12, 0xee, 0x42, 0x96, 0x97, 0x87, 0x2e, 0x73,
-	0x32, 0x6f, 0x44, 0xd9, 0x18, 0xc8, 0x60, 0x20, 0xf4, 0x00, 0x0a, 0x03, 0x6a, 0x85, 0x4c, 0x42,
-	0x04, 0x34, 0x94, 0x9a, 0x66, 0x86, 0x23, 0x1b, 0x82, 0x80, 0xc9, 0x17, 0x18, 0xc4, 0xdf, 0x08,
-	0x03, 0x72, 0xc6, 0xc4, 0x97, 0x1c, 0x47, 0x02, 0xce, 0x72, 0xd2, 0xb9, 0xf8, 0x61, 0xca, 0xb9,
-	0x78, 0x01, 0x7b, 0x62, 0xc5, 0x19, 0xcf, 0x30, 0x2c, 0x86, 0xdd, 0x53, 0xb6, 0xc1, 0x19, 0x4c,
-	0x86, 0xc4, 0xa7, 0x43, 0x6a, 0x05, 0x94, 0x8c, 0xa9, 0xef, 0x78, 0xb6, 0x54, 0x4e, 0x97, 0x75,
-	0x6f, 0x3b, 0xca, 0x8a, 0x45, 0xce, 0x2e, 0xcf, 0x58, 0xfd, 0x0e, 0x94, 0xb9, 0x99, 0x2b, 0xc0,
-	0xaa, 0x61, 0xd6, 0xb0, 0xc9, 0x83, 0x59, 0x4a, 0xb0, 0xa6, 0xb7, 0x75, 0x53, 0xe7, 0x57, 0x19,
-	0x33, 0x6c, 0xfa, 0x35, 0xc3, 0xac, 0x1d, 0x35, 0x75, 0xe3, 0x84, 0xdf, 0x6d, 0x2c, 0x42, 0xbe,
-	0xd5, 0xa9, 0xeb, 0x0d, 0x9d, 0x5f, 0x6a, 0x2c, 0xc0, 0x2a, 0xd6, 0x9a, 0x5a, 0xcd, 0xd0, 0x94,
-	0xa5, 0xea, 0xff, 0x5d, 0x86, 0x52, 0x6a, 0x84, 0xd0, 0xd7, 0xb0, 0x1f, 0xab, 0x5f, 0xbe, 0xfd,
-	0x7a, 0x36, 0x19, 0x0c, 0xa8, 0x08, 0xa2, 0x1a, 0x4b, 0x2f, 0xd9, 0x6e, 0x44, 0xc1, 0x36, 0x5e,
-	0x47, 0x11, 0x5e, 0x1d, 0xa3, 0x63, 0xb8, 0x91, 0xce, 0x9c, 0xda, 0x8c, 0xda, 0x74, 0x68, 0x9d,
-	0x4b, 0x7f, 0xd6, 0xb5, 0x64, 0x11, 0xc9, 0xad, 0x68, 0x9d, 0x11, 0xb1, 0x25, 0x6b, 0x0f, 0x13,
-	0x55, 0xdb, 0x13, 0x7f, 0xba, 0x64, 0xf3, 0x78, 0xd3, 0x1e, 0xc6, 0xd5, 0xd6, 0x25, 0x0a, 0xfd,
-	0x04, 0xd8, 0xe6, 0x72, 0xc0, 0x64, 0x6c, 0x10, 0x52, 0x1a, 0xc7, 0xfc, 0xe7, 0xf1, 0xba, 0x84,
-	0x1b, 0x12, 0xcc, 0x64, 0xe5, 0x40, 0x84, 0x9f, 0x2e, 0x73, 0x82, 0xe5, 0x01, 0x0f, 0x3e, 0xbd,
-	0x05, 0xe5, 0xf1, 0xc0, 0x96, 0xcf, 0xc4, 0xf0, 0x4d, 0xab, 0xf0, 0x5f, 0x95, 0xc6, 0x03, 0xbb,
-	0x15, 0x03, 0x99, 0x5e, 0x7a, 0x4e, 0x2d, 0x9b, 0xfa, 0x84, 0xba, 0xbe, 0xd3, 0x7f, 0xce, 0x80,
-	0x44, 0x58, 0x0e, 0x7c, 0x96, 0xf3, 0x78, 0x47, 0xe0, 0xb5, 0x18, 0xdd, 0xe3, 0x58, 0xd4, 0x80,
-	0xeb, 0x51, 0x13, 0xa3, 0x2b, 0xce, 0x6c, 0x60, 0xa8, 0x3b, 0xf0, 0xfc, 0xbe, 0xa8, 0x31, 0x2f,
-	0x86, 0x47, 0x92, 0xe1, 0x29, 0x95, 0x36, 0x25, 0x8a, 0x9e, 0x38, 0xf0, 0x06, 0x64, 0x64, 0xf9,
-	0x2f, 0xa8, 0x2f, 0x9d, 0x81, 0xdc, 0x46, 0xc9, 0xf3, 0x27, 0x0e, 0x3a, 0x83, 0x16, 0xc7, 0x08,
-	0x3f, 0x20, 0xfa, 0x15, 0xbc, 0x37, 0xb6, 0x1d, 0xe2, 0x8d, 0x43, 0x67, 0xe4, 0xfc, 0x46, 0xfa,
-	0x06, 0x9c, 0x53, 0xd7, 0x1a, 0x0e, 0xd9, 0x30, 0x09, 0x73, 0x64, 0x6f, 0x6c, 0x3b, 0x9d, 0x04,
-	0x85, 0x11, 0x13, 0xa0, 0x7b, 0xb0, 0x3b, 0x19, 0x92, 0xd4, 0x94, 0x30, 0x3d, 0xe6, 0x7b, 0x43,
-	0xe9, 0x5e, 0xd8, 0x9a, 0x0c, 0xeb, 0xd3, 0x39, 0x51, 0x05, 0x8e, 0x31, 0xd3, 0xcb, 0x89, 0x17,
-	0x5a, 0xc4, 0x1a, 0x8f, 0x87, 0x11, 0x1b, 0x84, 0x1e, 0x4f, 0x9e, 0x4b, 0xd3, 0x63, 0x97, 0x53,
-	0xd4, 0xa6, 0x04, 0xa6, 0xc7, 0x12, 0xe7, 0xfc, 0x5e, 0x35, 0x5b, 0x10, 0xf2, 0x2c, 0x50, 0x24,
-	0x98, 0xcc, 0x1a, 0xf8, 0xd6, 0x88, 0x12, 0xdf, 0x9b, 0x84, 0xac, 0xed, 0x65, 0x8e, 0x2d, 0x72,
-	0x20, 0x16, 0x30, 0xa6, 0x14, 0xd8, 0x44, 0x72, 0x55, 0xeb, 0x86, 0x81, 0x3c, 0x34, 0x2c, 0x8c,
-	0x07, 0xb6, 0x2a, 0x41, 0xd5, 0xff, 0x9e, 0xe1, 0x9c, 0x3f, 0xd5, 0x56, 0x6f, 0x27, 0x0d, 0x5b,
-	0xb0, 0x31, 0xf4, 0x2c, 0x3b, 0xea, 0xbd, 0xf0, 0xff, 0x08, 0x3d, 0x98, 0xbc, 0x5a, 0xdd, 0xf4,
-	0x2c, 0x5b, 0x0e, 0x42, 0xf2, 0x4a, 0xc3, 0xfa, 0x30, 0x0d, 0x47, 0x4f, 0x61, 0x9b, 0x09, 0xb4,
-	0xf9, 0x22, 0x85, 0x30, 0x4c, 0x5e, 0xd7, 0xef, 0x48, 0xba, 0x05, 0xc5, 0x6e, 0x7a, 0xf3, 0xb8,
-	0xea, 0x18, 0x76, 0x16, 0xb7, 0x82, 0x4d, 0x66, 0xaa, 0xc2, 0x80, 0xbe, 0x8c, 0x8e, 0x6f, 0xc4,
-	0xfe, 0x64, 0x2b, 0x51, 0x96, 0x41, 0x5f, 0xca, 0x53, 0x9c, 0xeb, 0x50, 0xe0, 0xd9, 0x46, 0x34,
-	0xf4, 0x9d, 0xbe, 0x1c, 0x1d, 0x60, 0xa0, 0x16, 0x87, 0x54, 0x3b, 0x50, 0x8a, 0x1a, 0xc9, 0x84,
-	0x9a, 0x78, 0xa8, 0x83, 0x7d, 0x88, 0x0b, 0x1f, 0xf2, 0x96, 0x0b, 0x87, 0xf0, 0x2d, 0xdd, 0x75,
-	0x28, 0x08, 0xf4, 0x34, 0xd6, 0xaf, 0x84, 0x45, 0x0e, 0xae, 0xe7, 0xaa, 0xff, 0x2b, 0x03, 0xfb,
-	0x17, 0x77, 0x1b, 0x1d, 0xc0, 0xe6, 0x74, 0xf0, 0x42, 0x9f, 0x77, 0xc4, 0xf5, 0x22, 0x87, 0x7a,
-	0x3c, 0x26, 0xa1, 0xcf, 0x3a, 0xe1, 0x7a, 0xe8, 0xe7, 0xb0, 0x17, 0xd3, 0xfb, 0xd4, 0x9e, 0x48,
-	0x3f, 0x53, 0xb2, 0x3b, 0xbb, 0x11, 0x01, 0x8e, 0xf0, 0xa2, 0x6f, 0xa8, 0x01, 0x48, 0xc8, 0x71,
-	0xb6, 0xe8, 0xa2, 0x18, 0x8c, 0x05, 0x06, 0x50, 0x6a, 0x00, 0xb0, 0x22, 0xf2, 0x74, 0x06, 0x8f,
-	0x65, 0x0e, 0xc6, 0xd4, 0x83, 0xa1, 0x75, 0x1a, 0x48, 0x93, 0x42, 0x24, 0xaa, 0x7f, 0x37, 0x03,
-	0xeb, 0xbd, 0x6e, 0x23, 0xf5, 0x2a, 0x0d, 0x63, 0xc7, 0x69, 0xe4, 0x56, 0x6c, 0xd6, 0x15, 0xa7,
-	0x40, 0xdd, 0x46, 0x1f, 0xc3, 0xfa, 0xec, 0xbb, 0x35, 0x32, 0x52, 0x3d, 0x48, 0xbf, 0x5a, 0x73,
-	0x03, 0x8a, 0x43, 0xaf, 0x6f, 0x0d, 0xc9, 0x20, 0x19, 0x91, 0x0f, 0x1c, 0xd6, 0xe0, 0x6f, 0x00,
-	0x11, 0xd8, 0x9e, 0x36, 0x41, 0xf5, 0xdc, 0x81, 0x73, 0x2a, 0x1a, 0xd2, 0x10, 0x76, 0x5b, 0xfa,
-	0x9d, 0x9d, 0xcc, 0x9c, 0xbb, 0x72, 0xa6, 0xfd, 0x78, 0x7d, 0x32, 0x1e, 0x24, 0x01, 0x8c, 0x3d,
-	0x7a, 0xdd, 0x46, 0xd7, 0x3a, 0x75, 0xdc, 0x53, 0xce, 0xfc, 0xb3, 0x6d, 0xca, 0xcc, 0xb6, 0x09,
-	0x5d, 0x05, 0xe0, 0x77, 0x16, 0x93, 0xcf, 0x33, 0xe4, 0x27, 0x54, 0x1f, 0x33, 0xd3, 0xee, 0xce,
-	0xe7, 0xb0, 0x2a, 0x2f, 0x1e, 0x32, 0x15, 0x67, 0x1e, 0x77, 0xbb, 0xa4, 0xc9, 0xc3, 0x38, 0x4a,
-	0xb0, 0xc6, 0x53, 0x4f, 0x98, 0x3d, 0x94, 0x61, 0x1a, 0x8f, 0x27, 0xdb, 0x58, 0xc9, 0xde, 0xf9,
-	0x0f, 0x19, 0x28, 0xa7, 0x5f, 0x14, 0x41, 0xd7, 0xe1, 0xbd, 0x6e, 0xbd, 0x17, 0x85, 0x89, 0x13,
-	0xbd, 0x5d, 0x53, 0x4d, 0xfd, 0xb1, 0x46, 0xda, 0x1d, 0x53, 0x6f, 0x3c, 0x55, 0xae, 0xa0, 0x5d,
-	0xd8, 0xec, 0x69, 0x44, 0xaf, 0x37, 0x35, 0xd2, 0xea, 0xd4, 0x63, 0x44, 0x06, 0x6d, 0x81, 0xd2,
-	0xd3, 0x48, 0xb7, 0x76, 0xac, 0xb7, 0x8f, 0x23, 0x68, 0x96, 0x07, 0xf1, 0x69, 0xa4, 0xab, 0x61,
-	0xbd, 0x53, 0xd7, 0x55, 0x82, 0xb5, 0x63, 0x22, 0x4b, 0x4c, 0xe6, 0xce, 0xa1, 0xab, 0x50, 0x49,
-	0xd6, 0x6b, 0x98, 0x35, 0x33, 0xc6, 0x2e, 0xa1, 0x1b, 0x70, 0xb5, 0x17, 0xc7, 0xb3, 0xf3, 0x88,
-	0x01, 0xcd, 0x30, 0x49, 0xa7, 0x2d, 0xab, 0x53, 0x96, 0xef, 0xfc, 0xcb, 0x35, 0x28, 0x26, 0xaf,
-	0x65, 0x30, 0xcd, 0x6f, 0x1c, 0x1b, 0x71, 0xc4, 0xc1, 0x15, 0x54, 0x06, 0x78, 0xd4, 0x31, 0xa2,
-	0x34, 0x8f, 0x7a, 0xc0, 0x35, 0x33, 0x4a, 0x67, 0x79, 0x14, 0x44, 0x23, 0x4e, 0xe7, 0x58, 0x01,
-	0xdd, 0x66, 0x2b, 0x2e, 0x60, 0x09, 0x21, 0x28, 0x37, 0x3b, 0x86, 0x41, 0x3a, 0x0d, 0x19, 0xa5,
-	0xa9, 0x2c, 0xf3, 0x20, 0x59, 0x4d, 0xed, 0x3c, 0xd6, 0xf0, 0xd3, 0x04, 0x7c, 0x05, 0x6d, 0x40,
-	0x49, 0xef, 0x12, 0xb5, 0x16, 0x67, 0x5f, 0x65, 0x23, 0x31, 0xad, 0x9f, 0x68, 0xdf, 0xaa, 0x9a,
-	0x56, 0xe7, 0x31, 0x8d, 0xc9, 0x30, 0x4a, 0xa5, 0x20, 0xda, 0xa5, 0x47, 0xf9, 0x8a, 0xa8, 0x02,
-	0x5b, 0x3c, 0x94, 0x32, 0x0e, 0x58, 0x95, 0x98, 0x92, 0x0c, 0x7c, 0xd4, 0x1e, 0x6b, 0x6d, 0x93,
-	0x98, 0x58, 0x3f, 0x3e, 0xd6, 0xb0, 0xa1, 0x94, 0x59, 0xdd, 0x9d, 0x9e, 0xc9, 0x9a, 0x23, 0xe2,
-	0x38, 0x95, 0x75, 0x1e, 0x66, 0xa9, 0x25, 0x62, 0x5e, 0xa7, 0x38, 0x45, 0x04, 0xe6, 0x4e, 0xc3,
-	0x5c, 0x79, 0x70, 0x47, 0xa7, 0x67, 0x2a, 0x1b, 0x2c, 0x17, 0x9b, 0xea, 0x6e, 0x14, 0x3f, 0x1a,
-	0x45, 0xcd, 0x6a, 0x0a, 0x42, 0x7b, 0xb0, 0x9d, 0xc6, 0x45, 0x76, 0xd4, 0x26, 0xfa, 0x00, 0xae,
-	0xd5, 0xb5, 0x46, 0xad, 0xd7, 0x34, 0x89, 0xd6, 0x35, 0xa2, 0x88, 0xd6, 0xc4, 0xd8, 0x6f, 0x4d,
-	0xa3, 0x57, 0x25, 0x64, 0x1b, 0x55, 0xe1, 0xfd, 0x44, 0xe4, 0xed, 0x82, 0x38, 0x5d, 0x65, 0x87,
-	0x15, 0x1c, 0x23, 0x84, 0x11, 0xa7, 0x46, 0xb1, 0xa7, 0x9c, 0x21, 0x94, 0x5d, 0x1e, 0x81, 0x7b,
-	0xfc, 0x84, 0x98, 0xb8, 0xa6, 0x6a, 0x51, 0xfc, 0xaa, 0x52, 0x91, 0x4c, 0xcb, 0x7a, 0x46, 0xbe,
-	0xeb, 0xb4, 0xb5, 0xa8, 0xda, 0x3d, 0x3e, 0xe9, 0xd3, 0xc1, 0xde, 0xe7, 0xf6, 0xa2, 0x7a, 0x1c,
-	0x03, 0xde, 0x63, 0x75, 0xaa, 0x27, 0x35, 0x7c, 0x2c, 0xc2, 0x58, 0x30, 0xd6, 0x9a, 0xa2, 0x4a,
-	0xed, 0x5b, 0x49, 0x72, 0x95, 0x91, 0xd4, 0xba, 0x6d, 0x52, 0x6b, 0x1d, 0xe1, 0x74, 0xb3, 0xa2,
-	0xc8, 0xe2, 0x6b, 0x3c, 0xb2, 0x98, 0xcd, 0xa1, 0x6a, 0x1c, 0x27, 0x83, 0x57, 0xa3, 0x6a, 0xde,
-	0x67, 0x03, 0xd2, 0x33, 0x6a, 0xc7, 0x8c, 0xb7, 0x79, 0xf8, 0xea, 0x07, 0xe8, 0x2e, 0xfc, 0xf4,
-	0x82, 0x51, 0x5c, 0x58, 0x47, 0x15, 0x7d, 0x06, 0x9f, 0xc4, 0x75, 0x9c, 0x3c, 0x3d, 0xc2, 0x7a,
-	0x9d, 0x18, 0xbd, 0x23, 0x43, 0xc5, 0xfa, 0x91, 0x56, 0x5f, 0x54, 0xeb, 0x4d, 0xf4, 0x39, 0xdc,
-	0x9d, 0xcd, 0xd2, 0x6b, 0x5f, 0x9e, 0xe9, 0x43, 0x36, 0x96, 0xa9, 0x90, 0x5d, 0x89, 0xb8, 0xc5,
-	0xc6, 0x3e, 0x19, 0xe2, 0xcc, 0x6d, 0x72, 0xe5, 0x63, 0x26, 0x17, 0xd2, 0xe0, 0x4e, 0x57, 0xb9,
-	0xcd, 0x88, 0x55, 0x1e, 0x2a, 0xdd, 0x4d, 0x84, 0x4a, 0xdf, 0x41, 0xef, 0xc3, 0x7e, 0x4f, 0xe3,
-	0xac, 0xde, 0x4c, 0x32, 0x97, 0xac, 0xe3, 0xa7, 0x4c, 0x10, 0x9c, 0x68, 0xed, 0xa3, 0x0b, 0x29,
-	0x7e, 0xc6, 0x4a, 0x90, 0x51, 0xc2, 0x6d, 0xcd, 0x7c, 0xd2, 0xc1, 0x0f, 0x79, 0x2f, 0xa2, 0x71,
-	0xfd, 0x04, 0xdd, 0x82, 0x0f, 0x64, 0x78, 0x73, 0xab, 0xd6, 0xae, 0x1d, 0x6b, 0x2d, 0xb6, 0x7a,
-	0x22, 0xb1, 0x13, 0x8d, 0xe6, 0x01, 0x5b, 0xd8, 0xd1, 0xf0, 0x27, 0x38, 0xf7, 0x2e, 0xfa, 0x1a,
-	0xbe, 0x94, 0x2b, 0xb8, 0xd3, 0x20, 0x4c, 0xb2, 0x61, 0xcd, 0xd0, 0xda, 0xaa, 0x46, 0xf4, 0xf6,
-	0xf4, 0x5b, 0x54, 0xc6, 0x17, 0x37, 0xd6, 0x6a, 0x51, 0xdd, 0x9f, 0x32, 0xee, 0x62, 0xe3, 0xcb,
-	0x23, 0x94, 0xb5, 0xba, 0xf2, 0xd9, 0x9d, 0x7f, 0x9b, 0x81, 0xdc, 0x23, 0x55, 0x47, 0x6b, 0xb0,
-	0xfc, 0x48, 0xd5, 0xb9, 0xdf, 0x55, 0x7e, 0x7e, 0x26, 0x2e, 0xd8, 0xb3, 0xcf, 0x43, 0x25, 0x1b,
-	0x7d, 0x7e, 0xae, 0xe4, 0xa2, 0xcf, 0x2f, 0x94, 0xa5, 0xe8, 0xf3, 0x9e, 0xb2, 0x1c, 0x7d, 0xde,
-	0x57, 0x56, 0xa2, 0xcf, 0x2f, 0x95, 0xd5, 0xe8, 0xf3, 0x2b, 0x25, 0x1f, 0x7d, 0x3e, 0x50, 0xd6,
-	0xd8, 0x96, 0x99, 0xd3, 0xde, 0x53, 0x6a, 0xf1, 0xf7, 0x7d, 0xe5, 0x28, 0xfe, 0xfe, 0x52, 0x51,
-	0xa3, 0xef, 0x2f, 0x3f, 0x55, 0x1a, 0xf1, 0xf7, 0x3d, 0xe5, 0x61, 0xfc, 0xfd, 0x40, 0xe9, 0xdc,
-	0xa1, 0x50, 0x4c, 0x3e, 0x95, 0xc0, 0x35, 0x40, 0xb7, 0xce, 0x04, 0xf7, 0x74, 0x0b, 0x26, 0x9e,
-	0x17, 0x10, 0xd0, 0x76, 0xc7, 0x24, 0x6d, 0x4d, 0xab, 0xf3, 0x9d, 0xd9, 0x36, 0x6c, 0x44, 0xa3,
-	0xce, 0xe0, 0x22, 0xe0, 0x3d, 0xcb, 0x85, 0x17, 0x0f, 0x25, 0x8b, 0xa6, 0x22, 0x77, 0xe7, 0x21,
-	0xac, 0xcf, 0x04, 0xa5, 0xb0, 0x56, 0x34, 0x58, 0x1d, 0x4c, 0xae, 0xef, 0x00, 0xd2, 0xdb, 0x22,
-	0x45, 0x7a, 0xed, 0x96, 0x66, 0x6a, 0x98, 0x57, 0xb0, 0x05, 0x4a, 0x0c, 0x8f, 0xa0, 0xd9, 0x3b,
-	0x5f, 0xc1, 0xfa, 0x4c, 0xc4, 0x04, 0xab, 0x32, 0x6a, 0x49, 0x53, 0x7b, 0xac, 0x35, 0xc5, 0x7b,
-	0x29, 0x5d, 0x55, 0x15, 0xfc, 0x2d, 0x60, 0x99, 0x3b, 0x75, 0x28, 0xa7, 0xfd, 0xce, 0x0b, 0x63,
-	0x61, 0x8b, 0x90, 0x67, 0x30, 0x9e, 0xca, 0xf2, 0x65, 0xdd, 0x36, 0x4c, 0xdc, 0x53, 0xcd, 0x1e,
-	0xab, 0x3f, 0x77, 0xe7, 0x01, 0xac, 0x46, 0x5e, 0xc9, 0x32, 0x80, 0x61, 0xa8, 0x42, 0x0f, 0x7e,
-	0x26, 0x14, 0x54, 0x9c, 0x3e, 0x14, 0x0a, 0x2a, 0x4e, 0x7f, 0xae, 0x64, 0xef, 0xfc, 0x59, 0x01,
-	0x60, 0xea, 0x66, 0x65, 0x32, 0xa5, 0xd3, 0xd5, 0x70, 0xcd, 0xec, 0x60, 0x52, 0xd7, 0x44, 0x00,
-	0x9f, 0x56, 0x27, 0x47, 0x35, 0x8c, 0x99, 0x4a, 0xbc, 0xc2, 0xc4, 0xb7, 0xde, 0x36, 0x7a, 0x8d,
-	0x86, 0xae, 0xea, 0x8c, 0xc9, 0xe3, 0xe7, 0x17, 0x94, 0x0c, 0x7f, 0x73, 0x41, 0x3e, 0x16, 0xd0,
-	0x89, 0xa3, 0xf4, 0x49, 0xbd, 0xdd, 0x16, 0x0f, 0x3c, 0x44, 0x80, 0xa4, 0x4a, 0xe6, 0x51, 0x7d,
-	0x39, 0x26, 0x97, 0xb8, 0xcc, 0x60, 0x6a, 0x4b, 0x6b, 0x9b, 0xd1, 0xb2, 0xee, 0xe0, 0x99, 0xf7,
-	0x00, 0x64, 0x88, 0x3f, 0xd7, 0xdd, 0x91, 0xc2, 0x8e, 0xde, 0x08, 0x60, 0x52, 0xa6, 0xab, 0xa9,
-	0x62, 0x17, 0xbe, 0xcc, 0x28, 0x22, 0xd5, 0xde, 0xe9, 0x9a, 0x11, 0x47, 0x4c, 0xd7, 0xc9, 0x0a,
-	0xfa, 0x29, 0x7c, 0x2c, 0xcb, 0xd0, 0xea, 0x64, 0x21, 0x6d, 0x24, 0xb3, 0x94, 0x55, 0x46, 0x3c,
-	0x43, 0x62, 0x6a, 0xad, 0x6e, 0x07, 0xd7, 0xb0, 0xde, 0x7c, 0x4a, 0xa4, 0x6a, 0xec, 0xe0, 0xba,
-	0x86, 0x95, 0xbc, 0xd0, 0x7e, 0xc7, 0xbd, 0x66, 0x8d, 0x0d, 0x22, 0xb7, 0x4c, 0x84, 0xb6, 0x01,
-	0xfe, 0x1e, 0x83, 0x94, 0x20, 0x11, 0x2f, 0x16, 0xa4, 0x22, 0x8d, 0xc9, 0x48, 0xdc, 0x2a, 0xa5,
-	0xc8, 0xba, 0xa1, 0xb7, 0xb9, 0x22, 0x4d, 0x8d, 0x9b, 0x78, 0xea, 0xc1, 0x7c, 0x2a, 0x1e, 0x82,
-	0x30, 0xb4, 0x56, 0x8d, 0x8d, 0x9a, 0x78, 0xf6, 0xc1, 0xe0, 0x62, 0xa3, 0xa6, 0x3e, 0xd4, 0x4c,
-	0x22, 0xde, 0x79, 0x50, 0xca, 0xcc, 0x50, 0x30, 0x9e, 0xb6, 0x4d, 0x56, 0x0b, 0x13, 0x72, 0x9c,
-	0x70, 0x9e, 0x6e, 0x9d, 0xcd, 0x93, 0xec, 0x4c, 0xb3, 0x56, 0x6f, 0xc7, 0xc3, 0xc2, 0x44, 0x8e,
-	0x78, 0x20, 0xa2, 0x6b, 0xea, 0xa4, 0xa5, 0x1b, 0xad, 0x9a, 0xa9, 0x9e, 0x28, 0x1b, 0xac, 0xdc,
-	0xd9, 0xf9, 0x24, 0x8c, 0x57, 0x49, 0xa7, 0xdd, 0x7c, 0xca, 0x35, 0xed, 0x13, 0xad, 0xae, 0xa0,
-	0x8b, 0xe8, 0xee, 0xa7, 0xe9, 0x36, 0x99, 0xa4, 0x4d, 0xd2, 0xd5, 0x3b, 0x9a, 0xc1, 0x27, 0x43,
-	0xfb, 0x56, 0x37, 0x4c, 0x65, 0x0b, 0xdd, 0x83, 0xcf, 0x16, 0xf3, 0x1f, 0x69, 0x74, 0x30, 0x91,
-	0x0c, 0xa0, 0x12, 0xa3, 0xc9, 0x5b, 0xdd, 0xae, 0x73, 0xf6, 0xdb, 0x66, 0x43, 0x9c, 0x9a, 0x7e,
-	0x12, 0x2d, 0x02, 0x65, 0x07, 0x7d, 0x02, 0x3f, 0x79, 0xeb, 0x22, 0x95, 0x5d, 0x26, 0xeb, 0x17,
-	0x73, 0x39, 0x1b, 0xce, 0x9a, 0x24, 0xab, 0x30, 0x51, 0x14, 0x4f, 0x9c, 0xa9, 0x93, 0xc7, 0xb5,
-	0x66, 0x8f, 0xd9, 0x06, 0x0f, 0xe1, 0xb8, 0x55, 0xfb, 0x56, 0x6f, 0xf5, 0x5a, 0xa4, 0x5e, 0x33,
-	0x6b, 0x04, 0x33, 0x19, 0xd6, 0xd5, 0x30, 0x13, 0xfb, 0xac, 0x3a, 0xbe, 0x0c, 0xba, 0xcd, 0x5a,
-	0x9b, 0xc9, 0x3b, 0x53, 0x3b, 0xc6, 0xba, 0xf9, 0x94, 0x74, 0x71, 0xc7, 0x14, 0x2f, 0x58, 0x10,
-	0x9d, 0xe9, 0xb7, 0x0e, 0x69, 0x76, 0x9e, 0x28, 0xfb, 0xe8, 0x63, 0xb8, 0x99, 0x9e, 0x7a, 0xd6,
-	0x04, 0xf3, 0x44, 0xe3, 0xda, 0x45, 0xac, 0x63, 0xc6, 0x76, 0xef, 0xa1, 0x3b, 0xf0, 0xd1, 0xc2,
-	0xd9, 0x9f, 0xa7, 0xbd, 0xca, 0x66, 0x2a, 0x6a, 0x78, 0xab, 0xd6, 0xed, 0x6a, 0xf5, 0xa4, 0xa9,
-	0x10, 0xf3, 0xdd, 0x35, 0x6e, 0x5c, 0xc9, 0xca, 0x6b, 0xcd, 0xe6, 0x53, 0x32, 0x7d, 0xc4, 0xa4,
-	0xa5, 0x19, 0xcc, 0xec, 0x50, 0xde, 0x67, 0x56, 0xcc, 0xb4, 0xac, 0x76, 0x9d, 0x89, 0x95, 0xa7,
-	0xa9, 0x87, 0x36, 0xae, 0xa3, 0x4f, 0xe1, 0x67, 0x92, 0x5e, 0x30, 0x45, 0x9b, 0xdb, 0x41, 0xba,
-	0x61, 0xb2, 0xa9, 0xe8, 0x60, 0x3e, 0xf9, 0x7a, 0xab, 0xdb, 0xe4, 0x3a, 0x55, 0xab, 0x2b, 0x37,
-	0xd0, 0x97, 0xf0, 0xf9, 0x4c, 0x0e, 0x93, 0xa8, 0x9d, 0x56, 0xb7, 0x66, 0xea, 0x47, 0x4d, 0x8d,
-	0x3c, 0xd1, 0xcd, 0x13, 0xde, 0x31, 0x36, 0x6a, 0x1d, 0xb5, 0xd3, 0x14, 0x76, 0xbf, 0xf2, 0x01,
-	0xcb, 0x98, 0x34, 0x3e, 0x34, 0x51, 0xe6, 0x1b, 0x6b, 0xac, 0xb2, 0xf5, 0x1c, 0x47, 0x1b, 0x33,
-	0x0b, 0x41, 0x93, 0xef, 0xab, 0xdc, 0x44, 0x87, 0x70, 0x10, 0xb5, 0xe5, 0x2d, 0x9b, 0xf1, 0x21,
-	0x67, 0xf1, 0x08, 0x26, 0x66, 0x22, 0x29, 0xb9, 0x6e, 0x31, 0xdd, 0xc3, 0x38, 0xa6, 0xd6, 0xc4,
-	0x5a, 0xad, 0xce, 0x86, 0x8b, 0x31, 0x85, 0xf2, 0x11, 0xe3, 0xa8, 0x78, 0x9e, 0x88, 0xb4, 0x6b,
-	0x95, 0xdb, 0x77, 0xfe, 0x28, 0x03, 0x85, 0xc4, 0x75, 0x21, 0x26, 0x62, 0x84, 0xa6, 0x6c, 0xc6,
-	0x26, 0xec, 0x15, 0xd6, 0x03, 0xde, 0x45, 0xc6, 0xb5, 0x89, 0xf5, 0xa5, 0x64, 0xd0, 0x35, 0xd8,
-	0x8b, 0xc8, 0xb5, 0x96, 0x86, 0x8f, 0xb5, 0xb6, 0xfa, 0x34, 0xce, 0x98, 0x65, 0xb3, 0x1c, 0x67,
-	0x9c, 0xe2, 0x93, 0x45, 0xe4, 0x58, 0xe1, 0x0b, 0x2d, 0xe7, 0xa5, 0x3b, 0x0f, 0x00, 0xa6, 0xf7,
-	0x81, 0x58, 0xc7, 0x5a, 0xe4, 0x73, 0xb6, 0x51, 0x94, 0xc6, 0x14, 0xfb, 0x56, 0xae, 0x88, 0x47,
-	0x6a, 0xda, 0x49, 0x8c, 0x92, 0xb9, 0x83, 0x41, 0x99, 0xbd, 0x08, 0xc3, 0xf4, 0xe0, 0x63, 0x0d,
-	0x8b, 0x71, 0x8a, 0x36, 0x8d, 0x6c, 0xe1, 0xb1, 0xa1, 0x8f, 0x11, 0x19, 0x86, 0x68, 0x3f, 0x99,
-	0x47, 0x64, 0xef, 0xfc, 0x65, 0x06, 0x60, 0x7a, 0x69, 0x0c, 0x35, 0xe0, 0xa8, 0xa9, 0x1b, 0x5c,
-	0xd8, 0x99, 0x35, 0x95, 0xf1, 0x78, 0xb3, 0xd3, 0xe6, 0x46, 0xb9, 0xd9, 0x21, 0xcc, 0xa2, 0xe7,
-	0x5b, 0x35, 0x3e, 0x91, 0xac, 0x7d, 0x6a, 0xa7, 0x6d, 0x68, 0x6a, 0x8f, 0xef, 0x33, 0xcd, 0x9a,
-	0x2a, 0x96, 0xb4, 0xa1, 0x5c, 0x41, 0x47, 0xf0, 0xab, 0xb7, 0x2d, 0xe7, 0x82, 0x32, 0x32, 0x4c,
-	0x08, 0x4d, 0xcb, 0xd0, 0x67, 0xca, 0xa8, 0xeb, 0x8d, 0x86, 0x86, 0x19, 0x7b, 0xb2, 0x92, 0x0c,
-	0x25, 0x7b, 0xe7, 0xee, 0x34, 0x3e, 0x49, 0x5c, 0xb2, 0x62, 0xd6, 0xc2, 0x89, 0x73, 0xfa, 0x5c,
-	0x1c, 0xb2, 0xb7, 0x3d, 0x7f, 0x64, 0x0d, 0xe5, 0x91, 0x85, 0xf7, 0x5a, 0xc9, 0xde, 0x21, 0xb0,
-	0x31, 0xf7, 0x4e, 0x2f, 0x33, 0x06, 0x54, 0xac, 0x89, 0x70, 0xfa, 0x4f, 0xc5, 0xeb, 0x0c, 0x3c,
-	0xad, 0x71, 0x4b, 0xb1, 0x08, 0x79, 0xb9, 0xab, 0x3e, 0x14, 0x3b, 0xd9, 0x78, 0xdf, 0xce, 0x2c,
-	0x46, 0xb6, 0xa3, 0x14, 0xbb, 0xb3, 0x3a, 0x33, 0x1b, 0x0f, 0xff, 0x70, 0x09, 0x36, 0xf9, 0x63,
-	0x88, 0xb2, 0x12, 0xe1, 0xce, 0xe5, 0x4f, 0x0e, 0x08, 0xf7, 0x1f, 0x7f, 0x1b, 0x26, 0xb4, 0xc2,
-	0x00, 0xed, 0x2f, 0x7c, 0x38, 0x9a, 0xbf, 0x32, 0xbd, 0xbf, 0x21, 0x71, 0xfc, 0xbd, 0xf0, 0x83,
-	0xc7, 0x9e, 0x63, 0x57, 0xaf, 0xa0, 0x3f, 0x80, 0x52, 0x2a, 0x74, 0x05, 0x7d, 0x98, 0xf2, 0x04,
-	0x5e, 0xf0, 0x98, 0xf4, 0xfe, 0xad, 0x37, 0x50, 0xc9, 0x97, 0xfd, 0xae, 0xa0, 0x1e, 0xc0, 0xf4,
-	0x09, 0x47, 0xf4, 0xc1, 0x6c, 0xb6, 0xb9, 0x57, 0x23, 0xf7, 0xab, 0x97, 0x91, 0xc4, 0xc5, 0xda,
-	0xb0, 0x71, 0xe4, 0xb8, 0xb6, 0x08, 0x26, 0x38, 0x14, 0xcf, 0x0a, 0xa2, 0x5b, 0x73, 0x61, 0x06,
-	0x8b, 0x5e, 0x53, 0xdc, 0xff, 0xe8, 0x4d, 0x64, 0x71, 0x2d, 0xdf, 0xc0, 0xba, 0x41, 0xc3, 0xd4,
-	0x2b, 0x3b, 0xa9, 0xd7, 0x17, 0x13, 0x88, 0xc5, 0xa3, 0xfb, 0x1d, 0xac, 0xcf, 0x3c, 0xf9, 0x98,
-	0x1a, 0x82, 0xc5, 0xaf, 0x58, 0xa6, 0x86, 0xe0, 0xa2, 0x17, 0x23, 0xaf, 0x1c, 0xfe, 0x59, 0x06,
-	0xb6, 0x65, 0x0b, 0xba, 0xbe, 0x77, 0x76, 0x2e, 0x50, 0x36, 0xf5, 0x51, 0x6f, 0xfa, 0x90, 0x8b,
-	0xb0, 0xf7, 0xd1, 0x8d, 0x37, 0xbd, 0x72, 0xb8, 0x7f, 0xfd, 0x42, 0x0a, 0xf1, 0x50, 0x59, 0xf5,
-	0x0a, 0xea, 0x40, 0x31, 0xf9, 0x80, 0x19, 0x7a, 0xff, 0x82, 0x97, 0xcd, 0xa2, 0x22, 0xaf, 0x5d,
-	0xfa, 0xf2, 0x59, 0xf5, 0xca, 0xe1, 0x3f, 0xcb, 0x42, 0x45, 0xa5, 0x6e, 0xe8, 0xc7, 0x9c, 0x2d,
-	0x1d, 0xa5, 0x43, 0xea, 0x23, 0x73, 0x96, 0x31, 0x67, 0xa2, 0x18, 0xe6, 0x79, 0xf2, 0xc6, 0xc5,
-	0x04, 0xf1, 0x8c, 0x9a, 0x50, 0x4a, 0x85, 0x4d, 0xa4, 0x4a, 0x5d, 0x14, 0xf1, 0x91, 0x2a, 0x75,
-	0x61, 0xc4, 0x45, 0xf5, 0x0a, 0xfa, 0x5b, 0xa0, 0xc4, 0xd1, 0x07, 0x51, 0xc1, 0xd5, 0x79, 0x46,
-	0x99, 0x8d, 0x50, 0xd8, 0xbf, 0x79, 0x29, 0x4d, 0x3c, 0xd3, 0x7f, 0x07, 0x76, 0x6a, 0xa3, 0xc1,
-	0x74, 0x33, 0x13, 0x5f, 0x7d, 0x42, 0x5d, 0xd8, 0x32, 0x68, 0x58, 0x1b, 0x0d, 0x66, 0x2e, 0x6e,
-	0xbd, 0x9f, 0x2a, 0x78, 0xee, 0x96, 0xc0, 0x7e, 0xd2, 0xeb, 0x9b, 0xba, 0x48, 0x75, 0xf8, 0xdb,
-	0x2c, 0x7f, 0x92, 0x38, 0x3e, 0xb4, 0x6d, 0x78, 0x7e, 0x7c, 0x86, 0x88, 0x1a, 0x7c, 0x3d, 0xa4,
-	0x4e, 0xee, 0x77, 0xe7, 0x0f, 0xd0, 0x39, 0xe2, 0xe2, 0x2a, 0xf8, 0x2c, 0x6c, 0x89, 0x72, 0x64,
-	0xb3, 0x02, 0xe1, 0xb2, 0x4d, 0xb1, 0xe9, 0x42, 0x7f, 0xee, 0xa5, 0xa5, 0xea, 0xb0, 0x61, 0x50,
-	0xd7, 0x16, 0x4e, 0xda, 0x28, 0x08, 0x64, 0xe6, 0x38, 0x75, 0xea, 0xc1, 0xbd, 0xac, 0xa8, 0xc3,
-	0xbf, 0xcc, 0xc0, 0x8e, 0xb1, 0x78, 0xc8, 0x7b, 0x80, 0xc4, 0x90, 0xa7, 0x9e, 0xda, 0xbe, 0x39,
-	0x33, 0xe0, 0x8b, 0x5e, 0x74, 0xbe, 0xb4, 0xf1, 0x8f, 0xf9, 0x90, 0x18, 0x73, 0x33, 0xf9, 0xe1,
-	0xe5, 0x33, 0x29, 0x4c, 0x80, 0x4b, 0xe6, 0xf3, 0x05, 0x6c, 0x31, 0xf6, 0x60, 0x7f, 0xa9, 0x06,
-	0x1b, 0xbc, 0x1b, 0xc6, 0x8f, 0xda, 0x8d, 0xa3, 0xf7, 0xbe, 0xdb, 0xe3, 0xa8, 0xbb, 0xc3, 0x90,
-	0xde, 0xed, 0x0f, 0xbd, 0x89, 0x7d, 0xf7, 0xd4, 0x93, 0xff, 0x65, 0xe2, 0xd9, 0x0a, 0xff, 0xfd,
-	0xfc, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xea, 0xcd, 0xc4, 0xc1, 0xdd, 0x62, 0x00, 0x00,
+	// 8952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x4b, 0x8c, 0x1c, 0x59,
+	0xb6, 0x90, 0xf3, 0x53, 0xbf, 0x93, 0x9f, 0x8a, 0xba, 0x55, 0xe5, 0xca, 0xaa, 0xb6, 0xdb, 0xd5,
+	0xe1, 0x76, 0xb7, 0xc7, 0x33, 0x6d, 0x77, 0x57, 0xb7, 0xdd, 0xed, 0xe9, 0x99, 0x69, 0xb2, 0x22,
+	0x23, 0xab, 0x62, 0x9c, 0x3f, 0xdf, 0x88, 0xb4, 0xdb, 0x8d, 0x78, 0x41, 0x38, 0x23, 0xb2, 0x1c,
+	0x38, 0x33, 0x23, 0x1d, 0x11, 0x69, 0x57, 0xcd, 0x0e, 0xcd, 0x8a, 0x05, 0x0b, 0xf4, 0x84, 0xd0,
+	0xdb, 0x00, 0xd2, 0x13, 0x2b, 0x24, 0x36, 0x80, 0x1e, 0x0c, 0x8b, 0xa7, 0x87, 0x9e, 0x78, 0xac,
+	0x10, 0x0b, 0xc4, 0x02, 0x21, 0x84, 0x58, 0xb0, 0x40, 0x2c, 0xde, 0x02, 0x09, 0x89, 0x05, 0x42,
+	0xe7, 0xde, 0x1b, 0x91, 0x11, 0x99, 0x59, 0x55, 0x6e, 0x77, 0x23, 0xcd, 0x2a, 0xe3, 0x9e, 0x73,
+	0xee, 0xff, 0xdc, 0x73, 0xce, 0x3d, 0xf7, 0x9e, 0x9b, 0xb0, 0x3f, 0x08, 0x9d, 0x7b, 0x63, 0xdf,
+	0x0b, 0xbd, 0xe0, 0x5e, 0xe0, 0x04, 0x81, 0xeb, 0x8d, 0xcc, 0xa1, 0x35, 0xb2, 0x4e, 0x1c, 0xff,
+	0x2e, 0x03, 0x93, 0xb5, 0xa1, 0x75, 0x32, 0xb4, 0xee, 0x0e, 0x42, 0x67, 0x6f, 0xd7, 0xf3, 0x7b,
+	0x5f, 0xf9, 0x11, 0x79, 0xcf, 0x1b, 0x0e, 0xbd, 0x11, 0xa7, 0xda, 0xdb, 0x4d, 0x94, 0x33, 0xf6,
+	0x06, 0x6e, 0xef, 0xcc, 0x7e, 0x2e, 0x50, 0xd7, 0x93, 0x55, 0x4c, 0x9e, 0x07, 0x3d, 0xdf, 0x7d,
+	0xee, 0xf8, 0x31, 0xfa, 0xc6, 0x89, 0xe7, 0x9d, 0x0c, 0x04, 0xc5, 0xf3, 0x49, 0xff, 0x5e, 0xe8,
+	0x0e, 0x9d, 0x20, 0xb4, 0x86, 0x63, 0x4e, 0x20, 0xff, 0x49, 0x16, 0x80, 0x4e, 0x06, 0x0e, 0x75,
+	0x7a, 0x9e, 0x6f, 0x13, 0x09, 0x72, 0x81, 0x6b, 0x57, 0x32, 0xfb, 0x99, 0xdb, 0x6b, 0x14, 0x3f,
+	0xc9, 0x0e, 0xac, 0xf8, 0x93, 0x81, 0x63, 0xba, 0x76, 0x25, 0xcb, 0xa0, 0xcb, 0x98, 0xd4, 0x6c,
+	0xb2, 0x0b, 0xab, 0xcf, 0xcf, 0x42, 0x27, 0x30, 0xc3, 0xd3, 0x4a, 0x6e, 0x3f, 0x73, 0x3b, 0x4f,
+	0x57, 0x58, 0xda, 0x38, 0x9d, 0xa2, 0xfc, 0xd3, 0x4a, 0x3e, 0x81, 0xa2, 0xa7, 0x58, 0xdc, 0xc4,
+	0x31, 0xdd, 0xf1, 0xeb, 0x2f, 0x2a, 0x4b, 0xbc, 0xb8, 0x89, 0xa3, 0x8d, 0x5f, 0x7f, 0x31, 0x45,
+	0x3c, 0xa8, 0x2c, 0x27, 0x10, 0x0f, 0xc8, 0x75, 0x00, 0xdb, 0xf7, 0xc6, 0x63, 0xc7, 0xc6, 0x9a,
+	0x56, 0x58, 0x71, 0x6b, 0x02, 0x62, 0x9c, 0x26, 0xd1, 0xfe, 0x69, 0x65, 0x35, 0x85, 0xa6, 0xa7,
+	0xe4, 0x03, 0x28, 0xb2, 0xe6, 0xbf, 0x76, 0x7c, 0x1c, 0xfe, 0xca, 0x1a, 0x23, 0x28, 0x20, 0xec,
+	0x09, 0x07, 0x11, 0x02, 0xf9, 0xd0, 0x71, 0xed, 0x0a, 0xec, 0x67, 0x6e, 0x97, 0x28, 0xfb, 0xc6,
+	0x6c, 0x7d, 0x77, 0x64, 0x0d, 0x4c, 0x9f, 0x8d, 0x4b, 0xa5, 0xb0, 0x9f, 0xb9, 0xbd, 0x4a, 0x0b,
+	0x0c, 0xc6, 0x87, 0x4a, 0xfe, 0x16, 0xd6, 0xa7, 0x03, 0x67, 0x58, 0xcf, 0x07, 0x0e, 0xb9, 0x07,
+	0x2b, 0x9c, 0x3e, 0xa8, 0x64, 0xf6, 0x73, 0xb7, 0x0b, 0x07, 0xdb, 0x77, 0xe3, 0xf9, 0xbd, 0x3b,
+	0x25, 0xa6, 0x11, 0x15, 0xd9, 0x82, 0x25, 0x67, 0xec, 0xf5, 0x5e, 0xb0, 0xa1, 0xcd, 0x53, 0x9e,
+	0x90, 0xff, 0x59, 0x06, 0x76, 0x1b, 0x5e, 0xcf, 0x1a, 0x28, 0xbe, 0x63, 0x85, 0x8e, 0xce, 0x39,
+	0x87, 0x3a, 0xaf, 0x26, 0x4e, 0x10, 0x92, 0x3a, 0x94, 0x39, 0x73, 0x98, 0x3d, 0x6f, 0x14, 0x3a,
+	0xa7, 0x61, 0x45, 0xda, 0xcf, 0xdc, 0x2e, 0x1c, 0xdc, 0x48, 0xd4, 0xa5, 0x30, 0x02, 0x91, 0x51,
+	0xe1, 0x64, 0xb4, 0xc4, 0xb3, 0x89, 0x24, 0x69, 0xc3, 0x96, 0x6f, 0x85, 0x66, 0x30, 0x76, 0x7a,
+	0x6e, 0xdf, 0xed, 0xc5, 0xa5, 0x6d, 0xb0, 0xd2, 0xae, 0x27, 0x5b, 0x6e, 0x85, 0xba, 0xa0, 0x8a,
+	0xca, 0x22, 0xfe, 0x1c, 0x4c, 0xfe, 0x25, 0x2c, 0x19, 0x8e, 0x6b, 0x07, 0x38, 0xfd, 0xce, 0xe8,
+	0xb9, 0xc9, 0x06, 0x35, 0xc7, 0x06, 0x75, 0xc5, 0x19, 0x3d, 0x47, 0x1c, 0xa2, 0xac, 0x93, 0x37,
+	0x1c, 0x95, 0xe7, 0x28, 0xeb, 0xe4, 0x0d, 0xa2, 0xe4, 0xff, 0x91, 0x85, 0xad, 0x45, 0xed, 0x26,
+	0x3f, 0x99, 0xf2, 0x64, 0xe1, 0x60, 0x27, 0xd1, 0x2e, 0x3d, 0xe6, 0x77, 0xad, 0x16, 0x33, 0x6b,
+	0xc4, 0x5d, 0xb9, 0x14, 0x77, 0x49, 0x90, 0xb3, 0xc6, 0x23, 0x56, 0xe5, 0x1a, 0xc5, 0x4f, 0x72,
+	0x15, 0x96, 0x87, 0x81, 0x1b, 0xd8, 0x23, 0xc6, 0x87, 0x45, 0x2a, 0x52, 0xe4, 0x13, 0x58, 0xc5,
+	0x61, 0x09, 0xcf, 0xc6, 0x0e, 0x63, 0xc4, 0xf2, 0x01, 0x49, 0x0e, 0x45, 0xd5, 0x30, 0xce, 0xc6,
+	0x0e, 0x5d, 0xf1, 0xad, 0x10, 0x3f, 0x48, 0x1d, 0xa4, 0x60, 0x68, 0x46, 0x8b, 0x3b, 0x08, 0xad,
+	0xd0, 0x61, 0x3c, 0x5a, 0x3e, 0xb8, 0x96, 0x6c, 0x69, 0x53, 0xf4, 0xa9, 0xae, 0x37, 0x75, 0xa4,
+	0xa1, 0xe5, 0x60, 0x28, 0x40, 0x2c, 0x4d, 0x7e, 0x06, 0x24, 0x51, 0x4e, 0x92, 0x5b, 0x4b, 0x54,
+	0x8a, 0x69, 0x23, 0x96, 0x4d, 0x2c, 0x16, 0x48, 0x2d, 0x96, 0x8f, 0x60, 0x09, 0xc7, 0x36, 0x60,
+	0x0c, 0x5b, 0x38, 0x90, 0x12, 0x6d, 0x60, 0x73, 0x43, 0x39, 0x5a, 0xfe, 0x3f, 0x19, 0x20, 0xf3,
+	0xd3, 0x4a, 0xbe, 0x81, 0xc2, 0x20, 0x74, 0x66, 0x58, 0x21, 0xd9, 0x91, 0x86, 0xa1, 0xa6, 0x67,
+	0xe7, 0xf8, 0x0a, 0x85, 0x41, 0xe8, 0x44, 0x05, 0x1c, 0x42, 0xf1, 0xcd, 0xc0, 0x9a, 0xb2, 0x26,
+	0x99, 0x63, 0xa6, 0xa7, 0x8d, 0x6a, 0x6b, 0xae, 0x88, 0x02, 0x66, 0x8a, 0xca, 0x30, 0x60, 0x7b,
+	0x78, 0xff, 0x24, 0x31, 0x1a, 0x51, 0x61, 0x9b, 0xac, 0xb0, 0xf7, 0x13, 0x85, 0x35, 0xef, 0x1f,
+	0xc5, 0x43, 0x3b, 0x2d, 0x6d, 0x93, 0x65, 0x4f, 0x83, 0x0f, 0xd7, 0x60, 0x45, 0x94, 0x23, 0xff,
+	0x9d, 0x0c, 0xac, 0xe0, 0x68, 0xe8, 0x4e, 0x18, 0x2f, 0xfe, 0x4c, 0x62, 0xf1, 0xcb, 0x50, 0x72,
+	0x46, 0x36, 0x63, 0x23, 0xd3, 0xb2, 0x6d, 0x5f, 0x08, 0xbe, 0x82, 0x33, 0xb2, 0x91, 0x99, 0xaa,
+	0xb6, 0xed, 0xa3, 0xd8, 0x41, 0x5a, 0xf3, 0xb5, 0x35, 0x98, 0x38, 0x82, 0xcb, 0xd7, 0x10, 0xf2,
+	0x04, 0x01, 0xe4, 0x1e, 0x6c, 0xa5, 0x8a, 0x30, 0x83, 0xd0, 0x77, 0x47, 0x27, 0x82, 0x01, 0x37,
+	0x12, 0x25, 0xe9, 0x0c, 0x21, 0xff, 0x2e, 0x07, 0x3b, 0x89, 0x09, 0x69, 0x79, 0x21, 0xfe, 0x58,
+	0x21, 0xce, 0xf6, 0x87, 0x50, 0x1e, 0xdb, 0x93, 0x78, 0x38, 0xe2, 0xd6, 0x16, 0xc7, 0xf6, 0x44,
+	0xf4, 0x52, 0xb3, 0xc9, 0x43, 0x28, 0xfa, 0x5c, 0x44, 0x70, 0xe6, 0xcd, 0x32, 0x2e, 0xbc, 0x9a,
+	0x64, 0x5e, 0x8e, 0x66, 0x0c, 0x5c, 0xf0, 0xa7, 0x09, 0xf2, 0x00, 0x0a, 0x56, 0xaf, 0xe7, 0x04,
+	0x01, 0xcf, 0x99, 0x63, 0x39, 0x93, 0xb2, 0xab, 0xca, 0xb0, 0x2c, 0x23, 0x58, 0xf1, 0x37, 0x51,
+	0x40, 0x4a, 0x36, 0x8c, 0x65, 0xce, 0xb3, 0xcc, 0xbb, 0x89, 0xcc, 0x9d, 0xb8, 0x95, 0xac, 0x80,
+	0xf2, 0x38, 0x95, 0x26, 0x75, 0xd8, 0x18, 0xde, 0x3f, 0x31, 0x83, 0xa1, 0xd9, 0xb3, 0xc6, 0xd6,
+	0x73, 0x77, 0xe0, 0x86, 0x67, 0x6c, 0x4d, 0x16, 0x0e, 0xf6, 0x66, 0xa7, 0x5a, 0x89, 0x29, 0xe8,
+	0xfa, 0xf0, 0xfe, 0x89, 0x3e, 0x9c, 0x02, 0xb0, 0x13, 0x9c, 0x6d, 0x7a, 0xd6, 0x24, 0x88, 0xd6,
+	0xee, 0xf6, 0x7c, 0x09, 0x93, 0xc0, 0xa1, 0xc0, 0x28, 0xd9, 0x37, 0xa9, 0xc2, 0xfa, 0x08, 0x47,
+	0xfb, 0xcc, 0x9c, 0x38, 0xa6, 0xf3, 0xda, 0x19, 0x85, 0x95, 0x95, 0xb9, 0x3e, 0xb0, 0xf9, 0x38,
+	0xeb, 0x3a, 0x2a, 0xe2, 0x03, 0x5a, 0x1a, 0x25, 0xd3, 0xf2, 0xbf, 0xce, 0x40, 0x45, 0x77, 0x42,
+	0x7d, 0x98, 0x9c, 0xb6, 0x88, 0x9d, 0xe7, 0xe5, 0x75, 0xe6, 0x9d, 0xe4, 0xf5, 0x1f, 0xc0, 0x6e,
+	0x4a, 0x5e, 0x8f, 0x12, 0x75, 0xb1, 0xc9, 0x2e, 0x1c, 0xc8, 0x8b, 0x85, 0x76, 0xb2, 0x55, 0x74,
+	0xc7, 0x5f, 0x8c, 0x90, 0xff, 0x65, 0x16, 0x36, 0xe6, 0x96, 0x37, 0x79, 0x0f, 0xd6, 0x82, 0xf1,
+	0xc9, 0x1b, 0x2e, 0x53, 0xb9, 0x59, 0xb0, 0x8a, 0x00, 0x26, 0x55, 0x09, 0xe4, 0xdd, 0xa1, 0xe3,
+	0x8a, 0xf5, 0xc1, 0xbe, 0x51, 0x34, 0x8d, 0x07, 0x43, 0xc6, 0xa5, 0x42, 0x04, 0x63, 0x52, 0xb3,
+	0xc9, 0x3e, 0x14, 0xdd, 0x61, 0xe0, 0x9a, 0x11, 0x96, 0x2f, 0x05, 0x40, 0x58, 0x87, 0x53, 0xdc,
+	0x84, 0xd2, 0x24, 0x70, 0x7c, 0x73, 0xe0, 0x89, 0x5e, 0x71, 0xc9, 0x5c, 0x44, 0x60, 0x43, 0xc0,
+	0xc8, 0xd7, 0xb0, 0xfa, 0xca, 0x0b, 0x4c, 0x77, 0xd4, 0xf7, 0xd8, 0x1c, 0x17, 0x0e, 0xf6, 0x13,
+	0xbd, 0x7e, 0xec, 0x05, 0xda, 0xa8, 0xef, 0xf9, 0x43, 0xde, 0x59, 0xce, 0xe3, 0x74, 0xe5, 0x15,
+	0x07, 0x63, 0x6f, 0x9e, 0x3b, 0x96, 0xef, 0xf8, 0xd8, 0x80, 0x15, 0xb6, 0x88, 0x56, 0x39, 0x80,
+	0x2d, 0xa0, 0x4a, 0xef, 0x85, 0xe5, 0x9f, 0xb8, 0xa3, 0x13, 0x13, 0x3f, 0xac, 0x5e, 0xe8, 0xf8,
+	0x6e, 0x10, 0xba, 0xbd, 0x80, 0xd9, 0x15, 0x6b, 0x74, 0x27, 0xc2, 0x2b, 0x69, 0xb4, 0xfc, 0xdb,
+	0x0c, 0x90, 0x79, 0xc1, 0x46, 0x3e, 0x82, 0xf5, 0xa1, 0xd5, 0xe3, 0x02, 0xe0, 0xb9, 0x3b, 0xb2,
+	0xfc, 0x33, 0x36, 0x84, 0x45, 0x5a, 0x1a, 0x5a, 0x3d, 0x5c, 0xfc, 0x87, 0x0c, 0x88, 0x5a, 0x31,
+	0xa2, 0x13, 0x63, 0xb9, 0x22, 0x08, 0xc8, 0x1d, 0xd8, 0xf0, 0x2d, 0xdb, 0x9d, 0x04, 0xc9, 0xe5,
+	0xcf, 0x07, 0x76, 0x9d, 0x23, 0x62, 0x09, 0x20, 0x7f, 0x0d, 0x7b, 0x8b, 0xcc, 0x86, 0x60, 0xec,
+	0x8d, 0x02, 0x07, 0x25, 0xd6, 0x8c, 0x04, 0x59, 0xa3, 0x6b, 0x41, 0x9c, 0xb9, 0x0b, 0x57, 0x59,
+	0x66, 0x75, 0x64, 0xcf, 0x18, 0x1c, 0xdf, 0x43, 0xff, 0x0a, 0x35, 0x9b, 0x8d, 0xd5, 0xac, 0xbc,
+	0x0b, 0x3b, 0x73, 0xc5, 0xf2, 0x06, 0xc9, 0xff, 0x3c, 0x03, 0x57, 0xbb, 0x63, 0xdb, 0x0a, 0x1d,
+	0x63, 0x32, 0x1a, 0x39, 0x03, 0xcd, 0x0e, 0xde, 0xa1, 0xca, 0xd4, 0x94, 0x66, 0x67, 0xa6, 0xf4,
+	0x9d, 0x2c, 0x91, 0x99, 0x91, 0x5a, 0x9a, 0x1d, 0xa9, 0x5d, 0xd8, 0x99, 0x6b, 0xb6, 0xe8, 0xd2,
+	0x7f, 0xce, 0xc0, 0x5e, 0x87, 0x19, 0xe8, 0x87, 0xac, 0x09, 0x87, 0xee, 0xc8, 0x76, 0x47, 0x27,
+	0xef, 0xd0, 0xad, 0xdb, 0x20, 0x0d, 0xdc, 0xd1, 0x4b, 0xc7, 0x36, 0x67, 0x7b, 0x57, 0xe6, 0xf0,
+	0xc3, 0xa8, 0x8f, 0xa8, 0x1d, 0x58, 0x95, 0x66, 0x64, 0xa7, 0x73, 0xf6, 0x28, 0x72, 0x28, 0xe5,
+	0xd6, 0x7a, 0x6a, 0x98, 0xf2, 0x33, 0xc3, 0x14, 0x5b, 0x0d, 0x4b, 0x17, 0x5b, 0x0d, 0xd7, 0xe1,
+	0xbd, 0x85, 0xbd, 0x13, 0xbd, 0xff, 0xe3, 0x2c, 0x6c, 0x2b, 0x62, 0x85, 0x50, 0xa7, 0x3a, 0x09,
+	0x5f, 0x44, 0x1d, 0xbf, 0x98, 0xf7, 0xd0, 0xda, 0x8e, 0x57, 0xde, 0x4b, 0xe7, 0x4c, 0x74, 0xb4,
+	0x10, 0xc1, 0x1e, 0x39, 0x67, 0xd1, 0xc6, 0x24, 0x37, 0xdd, 0x98, 0x3c, 0x84, 0x7c, 0x42, 0xe1,
+	0xdc, 0x4a, 0x4a, 0xd3, 0x45, 0x6d, 0xb8, 0xcb, 0x94, 0x0f, 0xcb, 0x42, 0x1e, 0x01, 0x09, 0x1c,
+	0xff, 0xb5, 0xdb, 0xc3, 0xe1, 0x72, 0x46, 0x28, 0x04, 0x1d, 0xbf, 0xb2, 0x34, 0x67, 0xed, 0xe8,
+	0x9c, 0x48, 0x8b, 0x69, 0xe8, 0x46, 0x30, 0x0b, 0x92, 0xef, 0x42, 0x9e, 0xe9, 0x31, 0x02, 0x65,
+	0x5d, 0x6b, 0x1d, 0x35, 0x54, 0x53, 0x57, 0xe9, 0x13, 0x4d, 0x51, 0xa5, 0x2b, 0x08, 0x53, 0x5b,
+	0x86, 0x46, 0x11, 0xa6, 0xeb, 0x5a, 0xbb, 0x25, 0x65, 0xe4, 0x23, 0xd8, 0x4a, 0x37, 0xb0, 0x3a,
+	0x0a, 0xde, 0x38, 0x3e, 0xb9, 0x07, 0xcb, 0xbe, 0x13, 0x4c, 0x06, 0x5c, 0x3f, 0x94, 0x53, 0xfc,
+	0x11, 0xf5, 0x04, 0xd1, 0x54, 0x90, 0xc9, 0xff, 0x2b, 0x0f, 0x9b, 0x7c, 0x3a, 0xbe, 0xd7, 0x60,
+	0x33, 0xa1, 0x1d, 0x24, 0x84, 0x76, 0xe0, 0xa2, 0xa0, 0x42, 0xe6, 0x09, 0xcc, 0xd0, 0x33, 0x7d,
+	0x67, 0xe8, 0xbd, 0x46, 0x23, 0x20, 0x77, 0x7b, 0x8d, 0x96, 0x18, 0xd8, 0xf0, 0x28, 0x03, 0xa2,
+	0xb5, 0x1b, 0xd3, 0xb9, 0xa3, 0x20, 0xb4, 0x06, 0x83, 0xca, 0xf2, 0x7e, 0x6e, 0x76, 0xd8, 0x42,
+	0x2b, 0x74, 0x7b, 0x8c, 0xf1, 0x38, 0x0d, 0x2d, 0x8b, 0x62, 0x44, 0x9a, 0x3c, 0x81, 0x8a, 0x7d,
+	0x36, 0xb2, 0x86, 0x6e, 0xcf, 0x9c, 0x2b, 0x6f, 0x65, 0x3f, 0x37, 0x63, 0x32, 0xd6, 0x38, 0x69,
+	0xb2, 0xc0, 0x6d, 0x7b, 0x0a, 0x4b, 0x94, 0xfb, 0x2b, 0x28, 0x33, 0x0d, 0x6e, 0x86, 0xbe, 0x7b,
+	0x72, 0xe2, 0xf8, 0x28, 0xb8, 0x73, 0x33, 0x63, 0xc9, 0x54, 0xb6, 0xc1, 0xf1, 0xb4, 0xe4, 0x24,
+	0x52, 0x01, 0x39, 0x82, 0x0d, 0xdf, 0x79, 0x6d, 0x0d, 0x5c, 0x9b, 0xe9, 0x0f, 0x13, 0x77, 0xcb,
+	0x95, 0x35, 0x61, 0x8b, 0xf0, 0xad, 0xf4, 0xdd, 0x68, 0x2b, 0x7d, 0xd7, 0x88, 0xb6, 0xd2, 0x54,
+	0x4a, 0x66, 0x42, 0x30, 0xf9, 0x0e, 0x2a, 0x93, 0xc0, 0x3a, 0x71, 0xcc, 0xa1, 0x37, 0x72, 0x43,
+	0xcf, 0x67, 0x3a, 0xc5, 0x77, 0x6c, 0x37, 0x0c, 0x2a, 0xb0, 0x9f, 0x9b, 0xd1, 0x5a, 0x5d, 0x24,
+	0x6d, 0xc6, 0x94, 0x0a, 0x23, 0xa4, 0x57, 0x27, 0x8b, 0xc0, 0x01, 0xf9, 0x22, 0xa1, 0x01, 0xb9,
+	0x99, 0xbf, 0x9b, 0xd2, 0x80, 0x7a, 0x52, 0x03, 0xc6, 0xaa, 0xef, 0x08, 0xd6, 0x85, 0x98, 0xe8,
+	0x79, 0x93, 0x51, 0x88, 0x63, 0x53, 0xdc, 0xcf, 0xcd, 0xd8, 0xd3, 0x9c, 0x9d, 0x14, 0x4e, 0x80,
+	0xd3, 0x38, 0x09, 0x68, 0x79, 0x9c, 0x04, 0x06, 0x72, 0x1b, 0xca, 0xe9, 0x3a, 0xd2, 0xb2, 0x45,
+	0xd8, 0x08, 0xb1, 0x6c, 0xd9, 0x87, 0xdc, 0xab, 0x9e, 0x2b, 0xac, 0xd1, 0x72, 0xb2, 0xa1, 0x8a,
+	0x46, 0x11, 0x25, 0xff, 0xdd, 0x55, 0x20, 0x49, 0x3e, 0x16, 0xeb, 0xe1, 0x12, 0x36, 0x9e, 0x2e,
+	0x97, 0xec, 0x5b, 0x2d, 0x17, 0xf2, 0x18, 0x8a, 0x7d, 0xcb, 0x1d, 0xa0, 0x9f, 0x00, 0x99, 0x86,
+	0x31, 0x78, 0xe1, 0xe0, 0xee, 0x5c, 0xef, 0x93, 0x8d, 0xb8, 0x5b, 0x67, 0x39, 0x18, 0x97, 0xa9,
+	0xa3, 0xd0, 0x3f, 0xa3, 0x85, 0xfe, 0x14, 0xb2, 0xe7, 0x82, 0x34, 0x4b, 0x80, 0x82, 0x0a, 0x45,
+	0x98, 0xf0, 0xa0, 0xbc, 0x74, 0xce, 0xc8, 0x37, 0xb0, 0xc4, 0x77, 0x09, 0xbc, 0xa1, 0x3f, 0xb9,
+	0xbc, 0xc6, 0x89, 0xef, 0x28, 0x9e, 0xed, 0x50, 0x9e, 0xef, 0xe7, 0xd9, 0xaf, 0x32, 0xf2, 0x5f,
+	0x2e, 0x41, 0x21, 0x81, 0x22, 0x00, 0xcb, 0xdd, 0x56, 0x57, 0x57, 0x6b, 0xd2, 0x15, 0xb2, 0x0d,
+	0x1b, 0xdd, 0xd6, 0xa3, 0x56, 0xfb, 0x69, 0xcb, 0xa4, 0xdd, 0x86, 0x6a, 0xb6, 0xaa, 0x4d, 0x55,
+	0xca, 0x90, 0xab, 0x40, 0x68, 0xd5, 0xd0, 0x5a, 0x47, 0xe6, 0x11, 0x6d, 0x77, 0x3b, 0xa6, 0x4a,
+	0x69, 0x9b, 0x4a, 0x59, 0x72, 0x0d, 0x2a, 0x42, 0x42, 0x99, 0x5a, 0x0d, 0xc5, 0x53, 0x5d, 0x53,
+	0xa9, 0xc0, 0xe6, 0xc8, 0x0e, 0x6c, 0x1e, 0x3d, 0x35, 0x3b, 0x8a, 0x5a, 0x37, 0x9b, 0xd5, 0x46,
+	0xbd, 0xdb, 0x52, 0x0c, 0x94, 0x5b, 0x79, 0x52, 0x81, 0x2d, 0xaa, 0xea, 0xed, 0x2e, 0x55, 0x54,
+	0xdd, 0x6c, 0x68, 0x4d, 0xcd, 0xa8, 0x32, 0xcc, 0x12, 0xd9, 0x83, 0xab, 0xcd, 0xea, 0xb7, 0x66,
+	0x8b, 0x9a, 0x87, 0x6a, 0x95, 0xaa, 0x54, 0x37, 0xa9, 0x5a, 0x55, 0x8e, 0xd5, 0x9a, 0xb4, 0x9c,
+	0x6c, 0x1b, 0x47, 0x9a, 0x5a, 0x4d, 0x5a, 0x41, 0x70, 0x53, 0xd3, 0x51, 0x5e, 0x26, 0xc0, 0xab,
+	0xd8, 0xb4, 0x08, 0x5c, 0x6f, 0xb4, 0x9f, 0x9a, 0x5a, 0xab, 0xde, 0xa6, 0x4d, 0x5e, 0xcf, 0x1a,
+	0xb9, 0x01, 0xef, 0x45, 0x2d, 0x30, 0xab, 0x8d, 0x46, 0x5b, 0x61, 0x08, 0xb3, 0x5e, 0xd5, 0x1a,
+	0x5d, 0xaa, 0x4a, 0x80, 0x04, 0xdd, 0x96, 0xde, 0x55, 0x14, 0x55, 0xd7, 0xeb, 0xdd, 0x86, 0xf9,
+	0xb8, 0xad, 0x9b, 0x4f, 0xaa, 0x0d, 0xad, 0xc6, 0x4b, 0x28, 0x90, 0xf7, 0x61, 0x4f, 0x6b, 0x29,
+	0x6d, 0x4a, 0x55, 0xc5, 0x98, 0xaf, 0xa1, 0x88, 0xcd, 0xea, 0xe8, 0xa6, 0xd1, 0x36, 0x15, 0xdd,
+	0x3c, 0xae, 0xb6, 0x6a, 0xed, 0x27, 0x2a, 0x95, 0x4a, 0xe4, 0x43, 0xd8, 0x37, 0x6a, 0x75, 0xb3,
+	0xda, 0xe9, 0x34, 0x34, 0x51, 0xe9, 0xdc, 0xc8, 0x95, 0xc9, 0x26, 0xac, 0xb7, 0xda, 0x51, 0x77,
+	0xea, 0xed, 0x6e, 0xab, 0x26, 0xad, 0xe3, 0x70, 0xd6, 0xb5, 0x86, 0xa1, 0x52, 0x93, 0xaa, 0xba,
+	0x41, 0x35, 0x36, 0x9a, 0xba, 0x24, 0x11, 0x09, 0x8a, 0xd5, 0x96, 0x79, 0xf4, 0x94, 0x35, 0x5f,
+	0xad, 0x49, 0x1b, 0xe4, 0x26, 0xdc, 0x88, 0x3a, 0x4f, 0xd5, 0x9a, 0xc6, 0xda, 0x88, 0x13, 0xa5,
+	0x52, 0xb3, 0x5a, 0xab, 0x51, 0x55, 0xd7, 0x25, 0x82, 0x3d, 0x50, 0x9a, 0xa6, 0xda, 0xaa, 0x99,
+	0x5d, 0x5d, 0xa5, 0x91, 0xaa, 0x31, 0x6b, 0x6a, 0x4b, 0x53, 0x6b, 0xd2, 0x26, 0x36, 0x55, 0x69,
+	0x9a, 0x0a, 0x16, 0x60, 0x98, 0x4a, 0xbb, 0x65, 0xd0, 0x76, 0xc3, 0x6c, 0xb5, 0x8d, 0xa8, 0xf1,
+	0x87, 0x0d, 0x55, 0xda, 0x22, 0xd7, 0x61, 0x57, 0x69, 0x9a, 0xd5, 0xae, 0x71, 0xdc, 0xa6, 0xda,
+	0x77, 0xbc, 0x47, 0x54, 0xfd, 0xb5, 0xaa, 0x18, 0x6a, 0x4d, 0xda, 0xc6, 0x9e, 0x28, 0x4d, 0x5e,
+	0x81, 0x98, 0x3c, 0xe9, 0x2a, 0xd9, 0x02, 0x49, 0x69, 0x9a, 0x82, 0xa3, 0x44, 0xa3, 0x77, 0x70,
+	0xee, 0x69, 0xbb, 0xcb, 0x60, 0x8c, 0xf7, 0x78, 0x29, 0x38, 0x9a, 0x15, 0xf2, 0x11, 0xc8, 0x31,
+	0x5f, 0x0a, 0x9a, 0x2a, 0x9b, 0x9b, 0xd4, 0xa8, 0xef, 0xe2, 0xa8, 0xb7, 0xda, 0x66, 0xeb, 0x50,
+	0xab, 0xb7, 0x9b, 0xa6, 0xde, 0xed, 0x74, 0xda, 0xd4, 0x90, 0xf6, 0xe4, 0xff, 0x90, 0x81, 0x15,
+	0x5c, 0x58, 0xb8, 0x4d, 0x3f, 0x80, 0x6d, 0x6b, 0x3c, 0x1e, 0x9c, 0x99, 0x53, 0x1f, 0xa7, 0xf9,
+	0xc6, 0xb5, 0x1d, 0xb6, 0xce, 0x56, 0xe9, 0x26, 0x43, 0x4e, 0xad, 0xa8, 0xa7, 0xae, 0xed, 0xcc,
+	0x1b, 0xa3, 0xe4, 0x1b, 0x28, 0x06, 0x4c, 0x37, 0xa5, 0x44, 0xc0, 0xc5, 0xaa, 0xab, 0x10, 0xc4,
+	0xa0, 0x80, 0x1c, 0x42, 0x29, 0xa5, 0xb7, 0x2a, 0xf9, 0xb7, 0x51, 0x56, 0xc5, 0xa4, 0xb2, 0x92,
+	0x9f, 0x02, 0x61, 0x1f, 0x1d, 0xc7, 0x9f, 0x36, 0x38, 0xd6, 0xca, 0x99, 0x84, 0x56, 0x46, 0x57,
+	0x14, 0x9a, 0x74, 0x81, 0x13, 0x8a, 0xa6, 0x92, 0x19, 0x7f, 0xa2, 0xee, 0x84, 0x94, 0xb9, 0x67,
+	0x75, 0x27, 0x94, 0x4d, 0x28, 0x46, 0x26, 0x36, 0x6b, 0x2c, 0x3a, 0xf8, 0xf0, 0xc3, 0x1c, 0x3b,
+	0x7e, 0x62, 0xdc, 0x2a, 0x99, 0xb9, 0x36, 0xcf, 0xb7, 0x87, 0x12, 0x7f, 0x0e, 0x26, 0x7f, 0x06,
+	0xab, 0xa8, 0xdc, 0x7e, 0xe3, 0x8d, 0x1c, 0x72, 0x0b, 0xca, 0x5e, 0xbf, 0x1f, 0x38, 0xa1, 0x39,
+	0x74, 0x47, 0x93, 0xd0, 0x09, 0x58, 0xcb, 0x97, 0x68, 0x89, 0x43, 0x9b, 0x1c, 0x28, 0x7f, 0x03,
+	0xc0, 0xd5, 0x56, 0x77, 0xe4, 0x86, 0x68, 0x73, 0xbb, 0x81, 0xc9, 0x54, 0xa5, 0x98, 0xb8, 0x15,
+	0x37, 0x78, 0x82, 0x49, 0x74, 0xc7, 0xbd, 0xf6, 0x06, 0x93, 0xa1, 0x23, 0x5c, 0xa1, 0x22, 0x25,
+	0xff, 0xad, 0x0c, 0x14, 0x8f, 0x7c, 0x6b, 0x14, 0x3a, 0x36, 0x16, 0x11, 0x90, 0x9f, 0xc2, 0x52,
+	0xe8, 0x85, 0xd6, 0x40, 0x58, 0xd1, 0xc9, 0x0d, 0xfe, 0xb4, 0x26, 0xca, 0x69, 0xc8, 0x2d, 0xc8,
+	0x86, 0xa7, 0x95, 0xec, 0x45, 0x94, 0xd9, 0xf0, 0x14, 0xc9, 0x7c, 0xee, 0xc4, 0x3e, 0x9f, 0xcc,
+	0x3f, 0x95, 0xff, 0x67, 0x06, 0xca, 0xd4, 0xb1, 0x5d, 0xdf, 0xe9, 0x85, 0x68, 0x1a, 0x3a, 0x3e,
+	0xb1, 0x60, 0xdb, 0x17, 0x10, 0xb6, 0x7d, 0x8b, 0x7d, 0x28, 0xdc, 0x86, 0xfb, 0x24, 0xa5, 0x94,
+	0x92, 0x39, 0xe3, 0x64, 0x95, 0xe7, 0x62, 0xd6, 0xe9, 0xa6, 0x3f, 0x0f, 0x24, 0x0f, 0x60, 0x27,
+	0xae, 0x22, 0x60, 0x79, 0xa3, 0x9a, 0x04, 0x6b, 0x6f, 0xfb, 0xa9, 0x92, 0x45, 0x5e, 0xf9, 0x1b,
+	0xd8, 0x5c, 0x50, 0x07, 0x59, 0x85, 0xbc, 0xd6, 0x79, 0xf2, 0x85, 0x74, 0x45, 0x7c, 0x3d, 0x90,
+	0x32, 0x64, 0x05, 0x72, 0x5d, 0xda, 0x90, 0xb2, 0xa4, 0x00, 0x2b, 0xba, 0xd6, 0x31, 0xbb, 0x54,
+	0x93, 0x72, 0xf2, 0x7f, 0xcd, 0x41, 0x39, 0xb2, 0x54, 0xf9, 0x48, 0x90, 0x07, 0xc2, 0xe6, 0xe6,
+	0x9a, 0x4c, 0x5e, 0x60, 0x73, 0x73, 0xc2, 0xbb, 0x38, 0x66, 0x09, 0x83, 0xfb, 0x26, 0x94, 0xd8,
+	0xac, 0xbb, 0xe1, 0x19, 0xb7, 0xa9, 0xf8, 0x66, 0xac, 0x18, 0x01, 0x99, 0xcd, 0xc4, 0xb9, 0x83,
+	0xb9, 0xd8, 0x2b, 0xf9, 0x88, 0x3b, 0xea, 0x98, 0x24, 0xc7, 0x91, 0x3b, 0xde, 0xea, 0xc5, 0x8e,
+	0x81, 0xc5, 0x36, 0xbf, 0xa8, 0x9f, 0x65, 0xab, 0x32, 0x62, 0xe1, 0xb5, 0xe7, 0x09, 0xf2, 0x0b,
+	0x28, 0x9d, 0x70, 0x76, 0x32, 0x27, 0xc8, 0x4f, 0xc2, 0x87, 0x90, 0xb4, 0x1e, 0x92, 0xec, 0x46,
+	0x8b, 0x27, 0x89, 0x14, 0x39, 0x84, 0xf5, 0x99, 0xb9, 0xa8, 0xac, 0xcc, 0x59, 0x60, 0xe9, 0x89,
+	0xa6, 0xe5, 0xf4, 0xf4, 0xe0, 0xca, 0xf1, 0x1d, 0x74, 0x07, 0xf6, 0x42, 0x21, 0x44, 0x56, 0x85,
+	0xa9, 0x2d, 0xa0, 0x5c, 0x4c, 0xc8, 0xb0, 0x1a, 0x0d, 0x22, 0x59, 0x83, 0xa5, 0xc3, 0x67, 0x86,
+	0xaa, 0x4b, 0x57, 0xd8, 0x0c, 0xa9, 0x4a, 0xbb, 0x55, 0xd3, 0xa5, 0x8c, 0xfc, 0x0d, 0x14, 0x12,
+	0x1d, 0x25, 0x25, 0x58, 0x33, 0x54, 0xda, 0xd4, 0x5a, 0x55, 0x03, 0x37, 0x1f, 0x45, 0x58, 0x8d,
+	0xf4, 0x88, 0x94, 0x41, 0x99, 0x1e, 0x69, 0x20, 0x21, 0x85, 0xa5, 0xac, 0x5c, 0x87, 0xb2, 0xd8,
+	0x35, 0x44, 0x3d, 0xdc, 0x4a, 0x2e, 0xaf, 0x7c, 0xb4, 0x8e, 0xca, 0xf1, 0x3a, 0xca, 0xb3, 0x05,
+	0x53, 0x8e, 0x17, 0x4c, 0x9e, 0xad, 0x8c, 0x3f, 0xca, 0x43, 0x41, 0x2c, 0x16, 0xb4, 0x59, 0x53,
+	0x67, 0x43, 0x99, 0xf3, 0xcf, 0x86, 0xb2, 0xe9, 0xb3, 0xa1, 0xd9, 0x6d, 0x60, 0x7e, 0x7e, 0x1b,
+	0x78, 0x5f, 0x30, 0x20, 0x67, 0x80, 0x0f, 0xe6, 0xd7, 0x2a, 0x56, 0x7f, 0x57, 0x6c, 0xcf, 0xa7,
+	0xfc, 0x77, 0x0b, 0xca, 0x09, 0x43, 0x1c, 0xcb, 0x5e, 0x16, 0x7e, 0x98, 0x18, 0x8a, 0xa5, 0x2f,
+	0xde, 0x17, 0xae, 0xbc, 0xd3, 0xbe, 0x90, 0xf3, 0x8a, 0x18, 0x5b, 0xc1, 0x6b, 0xab, 0x0b, 0x78,
+	0x25, 0x39, 0xfa, 0xc8, 0x2b, 0xc9, 0xb4, 0xfc, 0x67, 0x19, 0x80, 0x69, 0x67, 0xd8, 0x04, 0x1f,
+	0x53, 0x55, 0x3f, 0x6e, 0x37, 0xd0, 0xee, 0x5b, 0x81, 0xdc, 0xe3, 0x63, 0x9c, 0xdb, 0x32, 0x40,
+	0x3c, 0xf1, 0x35, 0x29, 0x8b, 0x73, 0xfd, 0xb8, 0xdb, 0x36, 0xaa, 0xa6, 0xfa, 0xed, 0x71, 0xb5,
+	0xab, 0x23, 0x30, 0x87, 0x9a, 0x9a, 0xd9, 0x42, 0x9a, 0xf1, 0xcc, 0x34, 0xb4, 0x26, 0x1a, 0x2e,
+	0xdf, 0x76, 0x34, 0xaa, 0xd6, 0xa4, 0x3c, 0xea, 0xf6, 0xb6, 0x71, 0xac, 0x52, 0x93, 0x67, 0x33,
+	0x9e, 0x75, 0x54, 0x69, 0x89, 0xbc, 0x07, 0x3b, 0x42, 0xdd, 0x23, 0xc3, 0x69, 0xcc, 0x4a, 0x50,
+	0x8e, 0xab, 0xad, 0x23, 0x55, 0x5a, 0xe6, 0xfc, 0x84, 0x16, 0x84, 0x49, 0xd5, 0xc7, 0x5d, 0x56,
+	0xce, 0x0a, 0xee, 0x77, 0x3b, 0xed, 0x76, 0x23, 0x51, 0xef, 0xaa, 0xfc, 0xe7, 0x79, 0xd8, 0x48,
+	0x4c, 0x0e, 0xef, 0x0e, 0xf9, 0x19, 0x2c, 0xb1, 0xed, 0x8d, 0x10, 0xe3, 0x57, 0x17, 0xcf, 0x24,
+	0xe5, 0x44, 0x33, 0x7b, 0x81, 0xec, 0xec, 0x5e, 0xe0, 0x16, 0x44, 0x03, 0x67, 0x8e, 0x26, 0x43,
+	0xd4, 0x71, 0x5c, 0xbe, 0x94, 0x04, 0xb4, 0xc5, 0x80, 0x69, 0x5f, 0xe6, 0xca, 0x39, 0xbe, 0xcc,
+	0xb5, 0xc5, 0xbe, 0x4c, 0xb8, 0xd0, 0x97, 0x59, 0xb8, 0xdc, 0x97, 0x59, 0x5c, 0xe0, 0xcb, 0xbc,
+	0x09, 0xa5, 0x17, 0x96, 0x6f, 0xbf, 0xb1, 0x7c, 0x87, 0x3b, 0xff, 0xca, 0x9c, 0x28, 0x02, 0x32,
+	0x0f, 0xe0, 0x67, 0xb0, 0x1a, 0x9e, 0x8c, 0xc7, 0x66, 0x2f, 0x3c, 0xad, 0xac, 0xcf, 0x0d, 0x96,
+	0x71, 0x32, 0x1e, 0x47, 0x0e, 0xe3, 0x15, 0xa4, 0x53, 0xc2, 0xd3, 0xdf, 0xdb, 0x23, 0xc2, 0x0b,
+	0x5d, 0xac, 0xe4, 0x62, 0x17, 0xeb, 0x1f, 0xe6, 0x60, 0x4b, 0x70, 0x06, 0xe3, 0xa0, 0xd8, 0xaf,
+	0x59, 0x81, 0x95, 0x60, 0xc2, 0xce, 0x24, 0x22, 0x9b, 0x42, 0x24, 0x23, 0x9f, 0x51, 0x76, 0xea,
+	0x33, 0x9a, 0x95, 0x30, 0xb9, 0x79, 0x09, 0xf3, 0x19, 0x2c, 0xf3, 0x8d, 0x7a, 0x25, 0x3f, 0xb7,
+	0x5a, 0xd3, 0x4a, 0x86, 0x0a, 0x42, 0x72, 0x03, 0x0a, 0x7c, 0x8f, 0x69, 0xf6, 0x3c, 0x9b, 0x9f,
+	0x3c, 0x94, 0x28, 0x70, 0x10, 0xdb, 0xac, 0xfd, 0xa8, 0x72, 0xe5, 0x21, 0xc0, 0xc0, 0x1d, 0xba,
+	0xe2, 0x94, 0x67, 0x8d, 0x09, 0xc2, 0xbd, 0xb9, 0xe5, 0xd3, 0x40, 0x12, 0x26, 0x01, 0xd7, 0x06,
+	0xd1, 0x67, 0x8a, 0x95, 0xe0, 0xed, 0x58, 0x29, 0xbd, 0xf2, 0x0a, 0xb3, 0xbe, 0xd0, 0x3f, 0xce,
+	0x00, 0x49, 0x7a, 0x2f, 0xc4, 0xea, 0x9e, 0x97, 0xb7, 0x99, 0x45, 0xf2, 0xf6, 0x53, 0x58, 0x1a,
+	0x38, 0xaf, 0x9d, 0x41, 0x25, 0x3b, 0xd7, 0x8b, 0xa9, 0xdb, 0xa3, 0x81, 0x14, 0x94, 0x13, 0xbe,
+	0xdb, 0xa5, 0x03, 0xf9, 0x1f, 0x64, 0x61, 0x7b, 0xa1, 0x8f, 0x85, 0x7c, 0x03, 0xcb, 0xc2, 0xa4,
+	0xe0, 0x06, 0xdb, 0xc7, 0x97, 0x79, 0x65, 0xee, 0x0a, 0xa3, 0x42, 0x64, 0x5b, 0xd0, 0xd3, 0xec,
+	0x85, 0x3d, 0xcd, 0xbd, 0x6d, 0x4f, 0xe7, 0x0c, 0x95, 0xa5, 0xef, 0x61, 0xa8, 0xc8, 0x77, 0x61,
+	0x59, 0x18, 0x05, 0x45, 0x58, 0xc5, 0x6d, 0xa0, 0xd6, 0xea, 0xaa, 0xdc, 0x7c, 0xa8, 0x69, 0x3a,
+	0xdb, 0x05, 0x66, 0xd0, 0xac, 0xa8, 0xb7, 0xa9, 0xa2, 0x4a, 0x59, 0xf9, 0xdf, 0xe4, 0xe0, 0xda,
+	0x4c, 0x7f, 0xa3, 0x65, 0xc6, 0x9d, 0x8a, 0xf7, 0x61, 0x79, 0xc2, 0x00, 0x95, 0xcc, 0xdc, 0xe2,
+	0x9f, 0x67, 0x00, 0x2a, 0x88, 0x7f, 0x24, 0xc1, 0x2d, 0x16, 0x72, 0x3e, 0x75, 0x2b, 0x65, 0xf1,
+	0x35, 0x92, 0x39, 0x91, 0xba, 0xbc, 0x40, 0xa4, 0x26, 0xcf, 0xf8, 0x57, 0x2e, 0x3f, 0xe3, 0x4f,
+	0x2e, 0x9b, 0xd5, 0xb7, 0x5b, 0x36, 0xbf, 0x80, 0x52, 0xca, 0x11, 0x29, 0xd6, 0xe9, 0xb9, 0x7e,
+	0xc8, 0x62, 0xd2, 0x0f, 0x79, 0xa1, 0x98, 0x84, 0x8b, 0xc5, 0xe4, 0x7f, 0xcc, 0xc3, 0xf5, 0x73,
+	0x26, 0x52, 0xc8, 0xcb, 0xaf, 0x62, 0x01, 0x97, 0x99, 0x3b, 0x3e, 0x5b, 0xec, 0x88, 0x14, 0xf4,
+	0x97, 0x4d, 0xe6, 0xbc, 0x8b, 0x3e, 0x21, 0x9a, 0xf3, 0x69, 0xd1, 0x3c, 0xef, 0xa8, 0x5d, 0xfa,
+	0xe1, 0x8e, 0xda, 0xe5, 0x77, 0x70, 0xd4, 0xce, 0xc8, 0xee, 0x95, 0x39, 0xd9, 0xbd, 0xc0, 0x35,
+	0xbe, 0xba, 0xc8, 0x35, 0xae, 0xc3, 0x4e, 0xd2, 0xb7, 0x90, 0xf4, 0x68, 0xaf, 0xbd, 0x85, 0x9b,
+	0x61, 0x2b, 0xe1, 0x66, 0x78, 0x3b, 0x3f, 0x39, 0xfc, 0x00, 0x3f, 0x79, 0x92, 0xa3, 0x0b, 0x6f,
+	0xc5, 0xd1, 0xf2, 0x6f, 0x73, 0xb0, 0xbd, 0xf0, 0x74, 0x95, 0xbc, 0x0f, 0x05, 0x6b, 0x3c, 0x32,
+	0xad, 0xe1, 0x73, 0xdf, 0xb4, 0x07, 0xe2, 0x6e, 0xc2, 0x9a, 0x35, 0x1e, 0x55, 0x87, 0xcf, 0xfd,
+	0xda, 0x20, 0x85, 0x9f, 0x0c, 0x2a, 0xd9, 0x14, 0xbe, 0x8b, 0x9b, 0xf4, 0xf2, 0xd8, 0x77, 0x3d,
+	0x1f, 0x37, 0x87, 0x53, 0x21, 0x59, 0xa2, 0xa5, 0x08, 0xca, 0xe4, 0x22, 0xf9, 0x1c, 0xb6, 0xc7,
+	0xbe, 0xe3, 0x0c, 0xc7, 0x6c, 0xc2, 0x13, 0x77, 0x05, 0xf8, 0x36, 0x61, 0x6b, 0x8a, 0x4c, 0x5c,
+	0x0a, 0x78, 0x08, 0x95, 0x44, 0xa6, 0xd7, 0x93, 0xc1, 0xc8, 0xf1, 0x93, 0x77, 0x0c, 0x4a, 0x74,
+	0x67, 0x8a, 0x7f, 0x92, 0x44, 0xa3, 0x8d, 0x87, 0x6e, 0xf6, 0xde, 0xc0, 0x0a, 0x02, 0xe4, 0x77,
+	0xa1, 0xd6, 0x5f, 0x79, 0x81, 0x82, 0x20, 0xcd, 0x26, 0xbf, 0x86, 0x15, 0xec, 0xd2, 0xc8, 0x8d,
+	0x6e, 0x0c, 0x7c, 0x76, 0xd9, 0x49, 0xf4, 0xdd, 0x43, 0x37, 0xf4, 0xad, 0xd0, 0x61, 0x42, 0xba,
+	0xda, 0x3c, 0xa4, 0x74, 0xf9, 0xb9, 0x8f, 0x09, 0xf9, 0x16, 0x48, 0xb3, 0x38, 0xb4, 0xef, 0x0f,
+	0x3b, 0x3a, 0xdf, 0xa9, 0x3f, 0xc2, 0xaf, 0x8c, 0x1c, 0x40, 0x21, 0x31, 0x3b, 0xd8, 0xc6, 0x93,
+	0x53, 0xd3, 0x46, 0xf9, 0xf9, 0xc2, 0x0b, 0x42, 0xe1, 0x3d, 0x82, 0x93, 0xd3, 0x9a, 0x13, 0x84,
+	0xc7, 0x5e, 0xc0, 0x29, 0xce, 0x12, 0x14, 0x59, 0x41, 0x71, 0x96, 0xa4, 0x08, 0x92, 0x14, 0x7c,
+	0xfd, 0x42, 0x10, 0x53, 0xc8, 0xff, 0x3e, 0x0b, 0x5b, 0x33, 0x67, 0xca, 0x7c, 0xe6, 0x7f, 0x01,
+	0xeb, 0xd1, 0xbd, 0x11, 0xe1, 0x17, 0x12, 0x32, 0x65, 0x33, 0xc9, 0x4d, 0x02, 0x45, 0xcb, 0x9c,
+	0x36, 0x4a, 0x5f, 0x26, 0x4e, 0x16, 0xec, 0x9f, 0x72, 0xdf, 0x73, 0xff, 0xf4, 0xfb, 0x7b, 0x57,
+	0xee, 0xff, 0xe6, 0x61, 0x7b, 0xf1, 0x31, 0xfd, 0x43, 0x58, 0x89, 0x0e, 0x8a, 0xb8, 0xa3, 0xee,
+	0xc6, 0xfc, 0xd6, 0x28, 0x25, 0xd0, 0x69, 0x44, 0x4f, 0xda, 0x50, 0x4e, 0x1d, 0x3a, 0x05, 0xe2,
+	0x6c, 0xee, 0xf6, 0xf9, 0x12, 0x7e, 0xa6, 0xa8, 0x52, 0xf2, 0xc8, 0x29, 0x98, 0xf3, 0x97, 0xae,
+	0xfc, 0x60, 0x7f, 0xe9, 0xea, 0xf7, 0xf6, 0x97, 0xce, 0xb0, 0xc9, 0xda, 0x2c, 0x9b, 0xbc, 0x83,
+	0x4d, 0x3b, 0xaf, 0x7c, 0x0a, 0x3f, 0x5c, 0xf9, 0x14, 0xdf, 0x41, 0xf9, 0x5c, 0x85, 0x65, 0x6f,
+	0x34, 0x70, 0x47, 0x4e, 0xa5, 0xc4, 0xd4, 0xa3, 0x48, 0xa1, 0xde, 0xf4, 0xfa, 0x7d, 0x86, 0x58,
+	0xe7, 0x7a, 0x53, 0x24, 0x17, 0x9d, 0xe2, 0x49, 0xef, 0x74, 0x8a, 0xf7, 0xbb, 0x0c, 0x6c, 0xcc,
+	0x4d, 0x5e, 0xf2, 0xb2, 0x6f, 0x26, 0x75, 0xd9, 0x57, 0xc1, 0x95, 0x1e, 0xba, 0xaf, 0x13, 0x1d,
+	0xce, 0x5e, 0xda, 0xe1, 0xf2, 0x34, 0x0b, 0xeb, 0xee, 0x11, 0x6c, 0xd8, 0xce, 0x6c, 0x31, 0xb9,
+	0xcb, 0xc7, 0x2d, 0x99, 0x09, 0xc1, 0xf2, 0x7f, 0xca, 0x00, 0x99, 0xe7, 0x1b, 0xbc, 0x01, 0x96,
+	0xb8, 0x09, 0xb1, 0xc0, 0x41, 0xdc, 0x89, 0x6f, 0x44, 0x50, 0x98, 0xde, 0x8e, 0xf8, 0x3d, 0xeb,
+	0xdc, 0xdf, 0xcf, 0xc0, 0x16, 0x5f, 0x96, 0x33, 0xd2, 0xf6, 0x01, 0xac, 0x70, 0xab, 0x3a, 0x92,
+	0x0c, 0xd7, 0x16, 0x3b, 0x4d, 0xc4, 0x9a, 0x8e, 0x88, 0x49, 0x6b, 0x4e, 0x2c, 0xf0, 0xc3, 0x84,
+	0x8f, 0x2f, 0x17, 0x0b, 0xac, 0xe2, 0x19, 0xa9, 0x20, 0xff, 0x8b, 0x0c, 0x6c, 0xcf, 0x34, 0x50,
+	0xc8, 0xae, 0x5f, 0xc2, 0x9a, 0x2f, 0xbe, 0xdf, 0x5a, 0x7a, 0x4d, 0x73, 0x90, 0xbf, 0x0e, 0x3b,
+	0xa9, 0x86, 0x9a, 0xd3, 0xc2, 0x72, 0xdf, 0x53, 0x90, 0x6d, 0x27, 0x9b, 0x1c, 0x41, 0x03, 0xf9,
+	0x11, 0x54, 0x44, 0x9b, 0x0d, 0xc7, 0x1f, 0xba, 0xa3, 0x44, 0x96, 0x05, 0x57, 0xdf, 0x2f, 0x56,
+	0x50, 0xf2, 0xbf, 0xcb, 0xc3, 0xce, 0x7c, 0x69, 0x8b, 0xee, 0x60, 0xbc, 0xc5, 0xbe, 0x27, 0xbf,
+	0x68, 0xdf, 0xf3, 0x35, 0x94, 0xb8, 0x6c, 0x37, 0x59, 0x77, 0xb8, 0x91, 0x7c, 0xbe, 0xb3, 0xac,
+	0xd8, 0x9b, 0x26, 0x02, 0x52, 0x8b, 0x77, 0xa6, 0x51, 0xee, 0xe5, 0x39, 0xe1, 0xbb, 0x60, 0xe7,
+	0x16, 0x6d, 0x5c, 0x45, 0x29, 0x29, 0x9f, 0xd9, 0xda, 0x39, 0x3e, 0x33, 0x58, 0xec, 0x33, 0x2b,
+	0x5c, 0xe8, 0x33, 0x2b, 0x5e, 0xee, 0x33, 0x2b, 0xbd, 0x8d, 0xcf, 0x6c, 0xfd, 0x12, 0x9f, 0x99,
+	0xf4, 0xae, 0x3e, 0xb3, 0x8d, 0x77, 0x32, 0x15, 0x7e, 0x80, 0x8b, 0xeb, 0x6f, 0x67, 0x60, 0xb9,
+	0xe5, 0xd9, 0x8e, 0x56, 0x23, 0xbf, 0x82, 0xe2, 0xc8, 0xb3, 0x51, 0x10, 0x27, 0x8f, 0x93, 0xae,
+	0xa5, 0x6e, 0xa4, 0x22, 0xa1, 0xf8, 0xe1, 0x37, 0x73, 0x31, 0x87, 0x66, 0xe3, 0x37, 0xce, 0x82,
+	0xc8, 0x1f, 0x45, 0x6d, 0x70, 0xa4, 0x7c, 0x07, 0x60, 0x9a, 0x85, 0x1f, 0x01, 0xbd, 0x8e, 0x0f,
+	0x83, 0x5e, 0xe3, 0x61, 0xd0, 0x2a, 0xe4, 0xeb, 0x8f, 0x6b, 0x2d, 0x29, 0x2b, 0x9f, 0xc2, 0xfa,
+	0xcc, 0xad, 0x5b, 0xce, 0xb8, 0xfd, 0x81, 0x83, 0x22, 0xcb, 0x31, 0x5f, 0x79, 0x91, 0xcf, 0xad,
+	0x34, 0x85, 0x3e, 0xf6, 0x70, 0x7b, 0x77, 0x6d, 0x38, 0x19, 0x84, 0xae, 0xf9, 0xc2, 0x1b, 0x3a,
+	0xec, 0x1a, 0xf4, 0x03, 0x33, 0x71, 0x53, 0x98, 0xb5, 0x69, 0x95, 0x56, 0x18, 0xcd, 0x31, 0x92,
+	0xe0, 0xe5, 0xf5, 0xe9, 0x3d, 0x61, 0xf9, 0xcf, 0xb2, 0xb0, 0xfa, 0xd8, 0x0b, 0xb8, 0x01, 0x70,
+	0x17, 0x36, 0xd1, 0x10, 0x17, 0x8a, 0x29, 0x76, 0x9f, 0xf1, 0x7d, 0xc6, 0xc6, 0x2b, 0x4e, 0x96,
+	0x70, 0x90, 0x49, 0x90, 0xb3, 0x5f, 0xf9, 0xa2, 0x0e, 0xfc, 0x24, 0x5f, 0x42, 0x85, 0x2f, 0x33,
+	0xd3, 0xeb, 0x9b, 0x63, 0xab, 0xf7, 0xd2, 0x09, 0xcd, 0xbe, 0x3b, 0x60, 0xea, 0x93, 0xef, 0x35,
+	0xb6, 0x39, 0xbe, 0xdd, 0xef, 0x30, 0x6c, 0x9d, 0x23, 0xc9, 0x57, 0x50, 0x49, 0x91, 0x27, 0xeb,
+	0xc7, 0xa3, 0xdf, 0x25, 0x7a, 0x75, 0x9c, 0xc8, 0x90, 0x68, 0x44, 0xb2, 0xd1, 0x63, 0xdf, 0xe9,
+	0x39, 0xb6, 0x33, 0xea, 0x39, 0x95, 0xa5, 0x54, 0xa3, 0x3b, 0x31, 0x82, 0xec, 0x43, 0x21, 0x70,
+	0x4e, 0x7c, 0xe7, 0x84, 0x73, 0xfe, 0x32, 0x8f, 0x37, 0x49, 0x80, 0xa2, 0x12, 0xfb, 0x03, 0xef,
+	0xcd, 0xac, 0x17, 0x91, 0x97, 0x58, 0x1f, 0x78, 0x6f, 0x12, 0xf7, 0xd2, 0x4e, 0x61, 0xbd, 0x61,
+	0xd9, 0x23, 0xe1, 0x53, 0xac, 0xfa, 0x8e, 0x85, 0x8b, 0xd9, 0x1e, 0x8d, 0xc4, 0x9d, 0x75, 0x71,
+	0x51, 0xc7, 0x1e, 0x8d, 0xf8, 0x95, 0xf5, 0x63, 0x28, 0xf1, 0x62, 0x71, 0x1b, 0xe6, 0xb2, 0xad,
+	0x02, 0x8a, 0x8b, 0x9b, 0xc9, 0x85, 0xe3, 0x5b, 0xbd, 0x97, 0xee, 0xe8, 0x04, 0x0b, 0xd3, 0x04,
+	0x6d, 0xc3, 0x0d, 0x42, 0x5a, 0x74, 0x13, 0x29, 0xf9, 0x4f, 0x32, 0x50, 0x39, 0x8f, 0x94, 0x7c,
+	0x09, 0x45, 0xe4, 0x68, 0x9c, 0x09, 0x56, 0x4b, 0x86, 0x99, 0x5e, 0x49, 0x2d, 0x8d, 0x9c, 0xd9,
+	0xee, 0xb3, 0x72, 0x21, 0x8c, 0xbf, 0x31, 0x42, 0x62, 0x3a, 0x89, 0xce, 0xc0, 0x19, 0x3a, 0xa3,
+	0x30, 0x60, 0x8d, 0x5c, 0xa2, 0x52, 0x34, 0x7d, 0xaa, 0x80, 0x23, 0x13, 0x34, 0x15, 0x25, 0x72,
+	0x46, 0x34, 0x15, 0x85, 0x41, 0x5a, 0x4a, 0xe4, 0x44, 0x6a, 0xb6, 0x18, 0xc4, 0xa8, 0x2a, 0xc2,
+	0x81, 0x84, 0x9f, 0xf2, 0x5f, 0x02, 0x6c, 0x2e, 0x88, 0x29, 0xf8, 0xff, 0x7f, 0x03, 0xff, 0xe7,
+	0x50, 0xc0, 0x0a, 0xa2, 0x83, 0xdd, 0xdc, 0x65, 0x87, 0x8a, 0x30, 0xb6, 0x27, 0xe2, 0x54, 0x17,
+	0x37, 0x4a, 0xf1, 0xfe, 0xd9, 0x9a, 0x7a, 0x5b, 0xd2, 0x17, 0xd8, 0x23, 0x0a, 0x4e, 0x40, 0xe3,
+	0x1d, 0x37, 0xbf, 0xd9, 0x3f, 0x1b, 0x01, 0xb0, 0xf4, 0xb6, 0x11, 0x00, 0x91, 0x06, 0x58, 0x4e,
+	0x68, 0x00, 0x02, 0xf9, 0x93, 0x71, 0xe0, 0x8a, 0x13, 0x16, 0xf6, 0x8d, 0x93, 0x97, 0x12, 0xed,
+	0xfc, 0xf6, 0x1a, 0xbf, 0x55, 0x2d, 0x25, 0xe5, 0x3b, 0xbb, 0xab, 0xb6, 0x0d, 0xcb, 0xe3, 0x5e,
+	0x7f, 0x6a, 0xee, 0x2f, 0x8d, 0x7b, 0x7d, 0xcd, 0xc6, 0x48, 0x81, 0x81, 0x65, 0x8f, 0xcc, 0xc8,
+	0x97, 0x6e, 0xf9, 0x8e, 0x25, 0xdc, 0x20, 0x49, 0x87, 0xea, 0x0c, 0xd7, 0xd3, 0xf5, 0x41, 0x1a,
+	0x40, 0x1a, 0x70, 0x33, 0x39, 0x9b, 0x01, 0xb3, 0x90, 0x53, 0xf7, 0xe9, 0xf1, 0xba, 0x84, 0x88,
+	0xf9, 0xba, 0x31, 0x9d, 0x62, 0x6e, 0x4a, 0x27, 0x2f, 0xcd, 0xeb, 0x93, 0xe7, 0x44, 0x03, 0x82,
+	0x8b, 0x2a, 0x70, 0x98, 0xfc, 0xc3, 0x08, 0x3f, 0x74, 0x24, 0x15, 0xd9, 0x08, 0xbe, 0x97, 0xdc,
+	0xe8, 0x8c, 0x46, 0x7a, 0x44, 0xd3, 0xc4, 0xdb, 0x5d, 0x92, 0x3d, 0x03, 0x21, 0x3f, 0x85, 0x8d,
+	0xd0, 0xb7, 0x7a, 0x8e, 0x89, 0xac, 0xe1, 0xfa, 0x8c, 0x95, 0x99, 0x12, 0x5c, 0xa3, 0x12, 0x43,
+	0xd0, 0x29, 0x9c, 0xfc, 0x1a, 0x64, 0x77, 0x14, 0x3a, 0x27, 0x6c, 0xde, 0xd1, 0xb2, 0x8c, 0xaa,
+	0xb7, 0x4e, 0x4d, 0xdb, 0x0a, 0x2d, 0xd3, 0x47, 0x6f, 0x2d, 0x3f, 0x51, 0x7a, 0x3f, 0xa6, 0xec,
+	0xc4, 0x84, 0x4d, 0xeb, 0xb4, 0x66, 0x85, 0x16, 0xb5, 0xc2, 0xc5, 0x81, 0x1c, 0xeb, 0xdf, 0x37,
+	0x90, 0xe3, 0x13, 0x58, 0x0d, 0x82, 0x1e, 0xef, 0xbe, 0x34, 0xe7, 0x55, 0xd5, 0x83, 0x1e, 0xeb,
+	0xf5, 0x4a, 0xc0, 0x3f, 0x16, 0xc7, 0x7d, 0x6c, 0x7c, 0xff, 0xb8, 0x8f, 0x2f, 0xa1, 0x82, 0x5d,
+	0x1e, 0xbf, 0x8c, 0x85, 0x74, 0x30, 0x19, 0x8f, 0x3d, 0x3f, 0x74, 0x6c, 0xa6, 0x70, 0x4b, 0x74,
+	0x7b, 0x68, 0x9d, 0x76, 0x5e, 0x0a, 0x19, 0xad, 0x47, 0x48, 0xf2, 0x15, 0xec, 0x26, 0x3b, 0xed,
+	0x3b, 0xaf, 0x4c, 0x6b, 0xf0, 0xc6, 0x3a, 0x0b, 0x4c, 0x6f, 0xc4, 0x62, 0x8d, 0x56, 0xe9, 0xf6,
+	0xb4, 0x8b, 0xd4, 0x79, 0x55, 0x65, 0xd8, 0xf6, 0x88, 0x7c, 0x00, 0xa5, 0x60, 0xc8, 0x14, 0x9a,
+	0xcd, 0xf2, 0x55, 0xb6, 0x84, 0xcf, 0x64, 0xd8, 0xb1, 0x27, 0x35, 0x24, 0x9d, 0x8d, 0x46, 0xd9,
+	0x7e, 0xdb, 0x68, 0x14, 0x05, 0x36, 0xa7, 0x5e, 0x8f, 0x48, 0x83, 0x04, 0x95, 0xab, 0x73, 0x6e,
+	0x95, 0x48, 0x3d, 0xd2, 0x8d, 0x98, 0x3e, 0x02, 0x91, 0x87, 0x50, 0x3e, 0x61, 0xea, 0xdf, 0x19,
+	0xd9, 0x63, 0xcf, 0x1d, 0x85, 0x95, 0x1d, 0x96, 0x9f, 0xcc, 0x5c, 0xec, 0xd6, 0x9d, 0x90, 0x96,
+	0x18, 0xa5, 0x2a, 0x08, 0xc9, 0x7d, 0x28, 0x4e, 0xc6, 0xfd, 0x69, 0xc6, 0xca, 0xb9, 0x19, 0x0b,
+	0x93, 0x71, 0x3f, 0xce, 0x86, 0x8a, 0xd2, 0xf7, 0x7a, 0x8e, 0x3d, 0xf1, 0x1d, 0x33, 0xf4, 0xad,
+	0x51, 0x60, 0x46, 0x2a, 0xa1, 0xb2, 0xcb, 0x58, 0xf0, 0x6a, 0x8c, 0x37, 0x10, 0x1d, 0xe9, 0x04,
+	0xf9, 0x9f, 0x64, 0x60, 0x13, 0x63, 0x67, 0x66, 0x45, 0xee, 0x8f, 0x15, 0x36, 0x73, 0x9e, 0xeb,
+	0x26, 0xfb, 0xae, 0xae, 0x9b, 0x8f, 0xa1, 0xa4, 0x0f, 0x45, 0xe2, 0x89, 0xc7, 0xaf, 0x2e, 0x79,
+	0x93, 0x70, 0x3c, 0x89, 0xdc, 0x6f, 0x22, 0x25, 0xbf, 0x81, 0xca, 0x7c, 0x91, 0x42, 0xde, 0xfe,
+	0x55, 0xd8, 0x61, 0xcc, 0x9f, 0x8e, 0x70, 0x33, 0xfd, 0x60, 0x2c, 0x1a, 0xf6, 0xe1, 0xc5, 0x51,
+	0x6e, 0xbc, 0x18, 0xba, 0x85, 0x8b, 0x21, 0xdd, 0xfd, 0x60, 0x2c, 0xff, 0xc3, 0x0c, 0xac, 0x32,
+	0xdf, 0xea, 0xc8, 0x65, 0xe3, 0xc8, 0x3d, 0xaf, 0xa3, 0xe8, 0xc8, 0x90, 0xdb, 0x92, 0x49, 0xb7,
+	0x7f, 0x44, 0x1c, 0x7f, 0xb0, 0xf5, 0x5d, 0xb4, 0x12, 0x29, 0xb9, 0x01, 0xc5, 0x24, 0x16, 0x2f,
+	0xa0, 0x3e, 0x7a, 0x3e, 0x0e, 0xcc, 0x4f, 0xa5, 0x2b, 0xf1, 0xf7, 0x67, 0x52, 0x26, 0xfe, 0xfe,
+	0x82, 0x5f, 0x27, 0xe2, 0xf0, 0x07, 0x52, 0x2e, 0x4e, 0x3c, 0xf8, 0x42, 0xca, 0xcb, 0xbf, 0x5b,
+	0x81, 0xdd, 0x73, 0xbb, 0xf5, 0x96, 0xea, 0x76, 0x91, 0xd0, 0xca, 0x7e, 0x5f, 0xa1, 0xf5, 0x2b,
+	0xd8, 0xe0, 0x92, 0xdb, 0xb1, 0xcd, 0x58, 0x7a, 0xe5, 0xce, 0x95, 0x5e, 0xeb, 0x11, 0xb1, 0x00,
+	0x10, 0x15, 0xb6, 0xac, 0x49, 0xf8, 0xc2, 0xf3, 0xdd, 0xdf, 0xa4, 0x16, 0x2c, 0xbf, 0x18, 0xb8,
+	0x70, 0xc1, 0x92, 0x69, 0x86, 0x08, 0x46, 0xaa, 0x40, 0x6c, 0xef, 0x0d, 0x7a, 0x7f, 0x5e, 0x26,
+	0x66, 0x6a, 0x69, 0x6e, 0xd5, 0x47, 0x53, 0x40, 0xa5, 0x88, 0x3c, 0x9e, 0x90, 0x5b, 0x50, 0x4e,
+	0x15, 0x11, 0x08, 0x8f, 0x75, 0x29, 0x49, 0x19, 0x90, 0x5f, 0x82, 0x34, 0x19, 0xcf, 0xd4, 0xb3,
+	0x72, 0x7e, 0x3d, 0x65, 0x4e, 0x1c, 0xd7, 0xf2, 0x01, 0x14, 0x39, 0x24, 0x71, 0xa5, 0xa5, 0x44,
+	0x0b, 0x1c, 0xc6, 0x6b, 0x78, 0x00, 0x85, 0x66, 0x42, 0xf4, 0xad, 0x5d, 0x28, 0xfa, 0x9a, 0x53,
+	0xd1, 0x57, 0x87, 0xfd, 0x58, 0xfe, 0x9a, 0xa9, 0xf9, 0x1f, 0xd9, 0x51, 0x9c, 0x1b, 0x30, 0xb1,
+	0x7c, 0xcd, 0x12, 0x92, 0x78, 0x3a, 0xb9, 0x5a, 0x4c, 0x43, 0x7e, 0x01, 0x92, 0x35, 0x18, 0x78,
+	0x6f, 0x92, 0x33, 0x5a, 0x38, 0x77, 0x46, 0xcb, 0x82, 0x36, 0x9a, 0xd0, 0x16, 0x7c, 0x28, 0x5a,
+	0xef, 0x8d, 0x4e, 0x9c, 0x90, 0x6b, 0x06, 0xd3, 0x0a, 0x43, 0x3c, 0x24, 0x88, 0x9a, 0xe2, 0xf9,
+	0x4c, 0xc1, 0xaf, 0xd2, 0x7d, 0xde, 0xfe, 0x88, 0x94, 0x3a, 0x55, 0x4e, 0xa8, 0x45, 0x74, 0xb3,
+	0x96, 0x5d, 0xe9, 0xfb, 0x58, 0x76, 0xb3, 0xc2, 0xb8, 0xfc, 0xc3, 0x85, 0xf1, 0xfa, 0x45, 0xc2,
+	0x98, 0x7c, 0x08, 0x39, 0xdc, 0xd3, 0x49, 0x73, 0xf5, 0xe0, 0xde, 0x02, 0x99, 0x15, 0xd1, 0xe8,
+	0x3b, 0xdc, 0x5d, 0x20, 0xb2, 0xc5, 0xe2, 0xfd, 0xb1, 0x04, 0x77, 0xf7, 0x42, 0xc1, 0x7d, 0xf3,
+	0x42, 0xc1, 0x2d, 0xc4, 0xe3, 0x22, 0xf1, 0xfd, 0xe7, 0x19, 0x28, 0x76, 0x3b, 0x75, 0xdc, 0x04,
+	0xf3, 0xc8, 0xeb, 0x6d, 0x3c, 0xd9, 0xee, 0x4f, 0x5d, 0x9e, 0x4b, 0x93, 0x31, 0x1a, 0x9b, 0x8f,
+	0x40, 0xb2, 0x82, 0xc0, 0xeb, 0xb9, 0x56, 0x18, 0x07, 0x76, 0x67, 0xe7, 0x02, 0x90, 0xbb, 0x9d,
+	0x7a, 0x55, 0x50, 0x45, 0xa1, 0xdc, 0xc7, 0x57, 0xe8, 0xfa, 0x34, 0x27, 0xaf, 0xe3, 0x6b, 0x28,
+	0x30, 0x7d, 0xec, 0x3b, 0x68, 0x7b, 0x08, 0xf3, 0xbe, 0x92, 0x2e, 0x07, 0x5b, 0x44, 0x19, 0x1e,
+	0x63, 0xaa, 0x47, 0x71, 0xea, 0x70, 0x13, 0x36, 0xb0, 0x81, 0xac, 0x80, 0xa1, 0x13, 0x30, 0xbf,
+	0x8c, 0xfc, 0xdf, 0xb2, 0xb0, 0xdb, 0x0d, 0x1c, 0xbf, 0x33, 0xb0, 0x46, 0x8e, 0xd6, 0xa1, 0x4e,
+	0xe0, 0x4d, 0xfc, 0x9e, 0xa3, 0xf7, 0x5e, 0x38, 0x43, 0x0b, 0x57, 0x69, 0x1c, 0x7a, 0x1c, 0x5d,
+	0x8c, 0x59, 0xa3, 0x05, 0x57, 0xc4, 0x1c, 0xe3, 0x34, 0x71, 0x92, 0x07, 0x33, 0x77, 0x49, 0x91,
+	0xe4, 0x41, 0x44, 0x72, 0x00, 0xdb, 0x2c, 0xc6, 0xd9, 0xb7, 0x46, 0x27, 0x4e, 0x72, 0x15, 0xf2,
+	0x3d, 0xf3, 0x26, 0x22, 0x29, 0xe2, 0x12, 0x8b, 0x2f, 0x8a, 0x8b, 0x66, 0x79, 0x2a, 0xf9, 0x69,
+	0x5c, 0x34, 0x23, 0x24, 0x5f, 0xc0, 0x55, 0x36, 0x36, 0xe6, 0xc8, 0x09, 0xdf, 0x78, 0xfe, 0x4b,
+	0x7e, 0x9a, 0x19, 0xed, 0x8c, 0xd7, 0xe8, 0x16, 0xc3, 0xb6, 0x38, 0x52, 0x13, 0xb8, 0x69, 0x2e,
+	0xde, 0x49, 0x13, 0xad, 0x59, 0xbf, 0x6f, 0xf5, 0xa2, 0xbb, 0x36, 0x3c, 0x97, 0xce, 0x90, 0x5a,
+	0x84, 0x93, 0xff, 0x0a, 0x94, 0xe3, 0x04, 0xdf, 0xe2, 0x02, 0x2c, 0x8b, 0xab, 0x8f, 0xcc, 0xe1,
+	0xa1, 0xb4, 0xa9, 0xca, 0x6f, 0xbf, 0x36, 0xaa, 0x2d, 0x29, 0x4b, 0xd6, 0xa1, 0xa0, 0x74, 0xcc,
+	0x38, 0xe4, 0x21, 0x27, 0xff, 0xbd, 0x3c, 0x6c, 0x2e, 0x98, 0x61, 0xf4, 0x41, 0x31, 0x86, 0x88,
+	0xe3, 0xf4, 0xf9, 0x99, 0x26, 0x3b, 0x2c, 0x89, 0x9f, 0x95, 0xe8, 0x40, 0x41, 0x34, 0x9a, 0xf1,
+	0x0e, 0xd7, 0x29, 0xf7, 0x2e, 0xe6, 0x9d, 0xbb, 0xb3, 0x00, 0x0a, 0xbc, 0x6b, 0xac, 0xda, 0x5f,
+	0xa3, 0x6d, 0xd8, 0xf3, 0x5e, 0x3b, 0x3e, 0xbf, 0x45, 0x6b, 0x32, 0x17, 0x72, 0x25, 0x7f, 0xa9,
+	0x93, 0x79, 0x23, 0xca, 0x86, 0x20, 0x1d, 0x41, 0xe4, 0x21, 0x14, 0xfa, 0x8e, 0x15, 0xa2, 0x84,
+	0x08, 0x9c, 0xb0, 0xb2, 0xb4, 0x88, 0x23, 0xeb, 0x9c, 0x00, 0xe5, 0x0b, 0xf4, 0xe3, 0x6f, 0x42,
+	0x81, 0xb8, 0x63, 0xf4, 0xcd, 0xf2, 0xc9, 0x08, 0x18, 0xcb, 0x09, 0xe7, 0x62, 0xd2, 0x6c, 0x39,
+	0x97, 0x3d, 0xa9, 0xe4, 0x8e, 0xd3, 0x10, 0x42, 0x61, 0xe7, 0x04, 0x37, 0x38, 0xfd, 0xc9, 0xc0,
+	0xf4, 0x9d, 0x81, 0x63, 0x05, 0x0e, 0x5e, 0x5b, 0x77, 0x3d, 0xbb, 0xb2, 0x72, 0x69, 0xf7, 0xb6,
+	0xa3, 0xac, 0x94, 0xe7, 0xec, 0xb0, 0x8c, 0xf2, 0x77, 0x20, 0xcd, 0xcd, 0x1c, 0x5e, 0x9f, 0x35,
+	0xaa, 0xd4, 0x60, 0x71, 0x33, 0x25, 0x58, 0xd3, 0x5a, 0x9a, 0xa1, 0xb1, 0x5b, 0x93, 0x19, 0x9c,
+	0x7e, 0x55, 0x37, 0xaa, 0x87, 0x0d, 0x4d, 0x3f, 0x66, 0xd7, 0x28, 0x8b, 0xb0, 0xda, 0x6c, 0xd7,
+	0x30, 0xc8, 0xa3, 0xc6, 0xed, 0x17, 0xaa, 0x36, 0xd4, 0xaa, 0xae, 0x4a, 0x79, 0xf9, 0x7f, 0x2f,
+	0x41, 0x29, 0x35, 0x42, 0xe4, 0x6b, 0xd8, 0x8b, 0xd5, 0x2f, 0xdb, 0x7e, 0x3d, 0x9f, 0xf4, 0xfb,
+	0x0e, 0x0f, 0xfc, 0x1a, 0x0b, 0x2f, 0xd9, 0x4e, 0x44, 0x81, 0x1b, 0xaf, 0xc3, 0x08, 0xaf, 0x8c,
+	0xc9, 0x11, 0xec, 0xa7, 0x33, 0xa7, 0x36, 0xa3, 0xb6, 0x33, 0xb0, 0xce, 0x84, 0x3f, 0xeb, 0x7a,
+	0xb2, 0x88, 0xe4, 0x56, 0xb4, 0x86, 0x44, 0xb8, 0x64, 0xed, 0x41, 0xa2, 0x6a, 0x7b, 0xe2, 0x4f,
+	0x97, 0xec, 0x2a, 0xdd, 0xb4, 0x07, 0x71, 0xb5, 0x35, 0x81, 0x22, 0x3f, 0x01, 0xdc, 0x5c, 0xf6,
+	0x51, 0xc6, 0x06, 0xa1, 0xe3, 0xc4, 0xef, 0x14, 0xac, 0xd2, 0x75, 0x01, 0xd7, 0x05, 0x18, 0x65,
+	0x65, 0x9f, 0x87, 0xcc, 0x2e, 0x31, 0x82, 0xa5, 0x3e, 0x0b, 0x98, 0xc5, 0x13, 0xfc, 0xbe, 0x2d,
+	0x9e, 0xb6, 0x61, 0x9b, 0x56, 0xee, 0xbf, 0x2a, 0x8d, 0xfb, 0x76, 0x33, 0x06, 0xa2, 0x5e, 0x7a,
+	0xe1, 0x58, 0xb6, 0xe3, 0x9b, 0xce, 0xc8, 0x77, 0x7b, 0x2f, 0x10, 0x68, 0x72, 0xcb, 0x81, 0xcd,
+	0xf2, 0x2a, 0xbd, 0xca, 0xf1, 0x6a, 0x8c, 0xee, 0x32, 0x2c, 0xa9, 0xc3, 0x8d, 0xa8, 0x89, 0xd1,
+	0x6d, 0x6a, 0x1c, 0x18, 0x07, 0x0f, 0xd8, 0x7b, 0xbc, 0xc6, 0x55, 0x3e, 0x3c, 0x82, 0x8c, 0x4e,
+	0xa9, 0xd4, 0x29, 0x51, 0xf4, 0x2c, 0x83, 0xd7, 0x37, 0x87, 0x96, 0xff, 0xd2, 0xf1, 0x85, 0x33,
+	0x90, 0xd9, 0x28, 0xab, 0xec, 0x59, 0x86, 0x76, 0xbf, 0xc9, 0x30, 0xdc, 0x0f, 0x48, 0x7e, 0x05,
+	0xef, 0x8d, 0x6d, 0xd7, 0xf4, 0xc6, 0xa1, 0x3b, 0x74, 0x7f, 0x23, 0x7c, 0x03, 0xee, 0xc9, 0xc8,
+	0x1a, 0x0c, 0x70, 0x98, 0xb8, 0x39, 0xb2, 0x3b, 0xb6, 0xdd, 0x76, 0x82, 0x42, 0x8f, 0x09, 0xc8,
+	0x7d, 0xd8, 0x99, 0x0c, 0xcc, 0xd4, 0x94, 0xa0, 0x1e, 0xf3, 0xbd, 0x81, 0x70, 0x2f, 0x6c, 0x4d,
+	0x06, 0xb5, 0xe9, 0x9c, 0x28, 0x1c, 0x87, 0xcc, 0xf4, 0x6a, 0xe2, 0x85, 0x96, 0x89, 0x71, 0x2d,
+	0x11, 0x1b, 0x84, 0x1e, 0x4b, 0x9e, 0x09, 0xd3, 0x63, 0x87, 0x51, 0x54, 0xa7, 0x04, 0x86, 0x87,
+	0x89, 0x33, 0x76, 0x85, 0x1b, 0x17, 0x84, 0x38, 0x54, 0xe4, 0x09, 0x94, 0x59, 0x7d, 0xdf, 0x1a,
+	0x3a, 0xa6, 0xef, 0x4d, 0x42, 0x6c, 0x7b, 0x99, 0x61, 0x8b, 0x0c, 0x48, 0x39, 0x0c, 0x95, 0x02,
+	0x4e, 0x24, 0x53, 0xb5, 0xe8, 0x5d, 0xe3, 0xa7, 0x8f, 0x85, 0x71, 0xdf, 0x56, 0x04, 0x48, 0xfe,
+	0x2f, 0x19, 0x28, 0xa5, 0xb4, 0xd5, 0xdb, 0x49, 0xc3, 0x26, 0x6c, 0x0c, 0x3c, 0xcb, 0x8e, 0x7a,
+	0xcf, 0xfd, 0x3f, 0x5c, 0x0f, 0x26, 0x6f, 0x71, 0x37, 0x3c, 0xcb, 0x16, 0x83, 0x90, 0xbc, 0x3d,
+	0xb1, 0x3e, 0x48, 0xc3, 0xc9, 0x33, 0xd8, 0x46, 0x81, 0x36, 0x5f, 0x24, 0x17, 0x86, 0xc9, 0xc8,
+	0x80, 0xb6, 0xa0, 0x5b, 0x50, 0xec, 0xa6, 0x37, 0x8f, 0x93, 0xc7, 0x18, 0x08, 0xbf, 0x88, 0x1c,
+	0x27, 0x33, 0x55, 0x61, 0xe0, 0xbc, 0x8a, 0x8e, 0x6f, 0xf8, 0xfe, 0x64, 0x2b, 0x51, 0x96, 0xee,
+	0xbc, 0x12, 0xa7, 0x38, 0x37, 0xa0, 0xc0, 0xb2, 0x0d, 0x1d, 0xbc, 0xde, 0x2f, 0x46, 0x07, 0x10,
+	0xd4, 0x64, 0x10, 0xb9, 0x0d, 0xa5, 0xa8, 0x91, 0x28, 0xd4, 0xf8, 0xe3, 0x22, 0xf8, 0xc1, 0xef,
+	0x96, 0x64, 0x84, 0x12, 0x45, 0x08, 0xdb, 0xd2, 0xdd, 0x80, 0x02, 0x47, 0x4f, 0xc3, 0x0a, 0x4b,
+	0x94, 0xe7, 0x60, 0x7a, 0x4e, 0xfe, 0xef, 0x19, 0xd8, 0x3b, 0xbf, 0xdb, 0xe8, 0x49, 0x9e, 0x0e,
+	0x5e, 0xe8, 0xb3, 0x8e, 0x8c, 0xbc, 0xc8, 0xa1, 0x1e, 0x8f, 0x49, 0xe8, 0x63, 0x27, 0x46, 0x1e,
+	0xf9, 0x39, 0xec, 0xc6, 0xf4, 0xbe, 0x63, 0x4f, 0x84, 0x9f, 0x29, 0xd9, 0x9d, 0x9d, 0x88, 0x80,
+	0x46, 0x78, 0xde, 0x37, 0x52, 0x07, 0xc2, 0xe5, 0x38, 0x2e, 0xba, 0x28, 0xdc, 0x63, 0x81, 0x01,
+	0x94, 0x1a, 0x00, 0x2a, 0xf1, 0x3c, 0xed, 0xfe, 0x13, 0x91, 0x03, 0x99, 0xba, 0x3f, 0xb0, 0x4e,
+	0x02, 0x61, 0x52, 0xf0, 0x84, 0xfc, 0x37, 0x33, 0xb0, 0xde, 0xed, 0xd4, 0x53, 0x2f, 0xe9, 0x20,
+	0x3b, 0x4e, 0x83, 0xc4, 0x62, 0xb3, 0xae, 0x38, 0x05, 0x6a, 0x36, 0xf9, 0x18, 0xd6, 0x67, 0xdf,
+	0xda, 0x11, 0xd1, 0xf5, 0x41, 0xfa, 0xa5, 0x9d, 0x7d, 0x28, 0xa2, 0xcf, 0x72, 0x60, 0xf6, 0x93,
+	0xaf, 0x08, 0x00, 0x83, 0xd5, 0xd9, 0xbb, 0x45, 0x26, 0x6c, 0x4f, 0x9b, 0xa0, 0x78, 0xa3, 0xbe,
+	0x7b, 0xc2, 0x1b, 0x52, 0xe7, 0x76, 0x5b, 0xfa, 0x6d, 0xa0, 0xcc, 0x9c, 0xbb, 0x72, 0xa6, 0xfd,
+	0x74, 0x7d, 0x32, 0xee, 0x27, 0x01, 0xc8, 0x1e, 0xdd, 0x4e, 0xbd, 0x63, 0xe1, 0x91, 0x11, 0x63,
+	0xfe, 0xd9, 0x36, 0x65, 0x66, 0xdb, 0x44, 0xae, 0x01, 0xb0, 0xeb, 0x91, 0xc9, 0x27, 0x25, 0x56,
+	0xf1, 0x86, 0x24, 0x9a, 0x76, 0xf2, 0x3f, 0xce, 0x44, 0x81, 0xe3, 0xa9, 0x3b, 0x02, 0xf8, 0xd6,
+	0x44, 0xfa, 0x72, 0xc1, 0x74, 0xf4, 0xd6, 0x53, 0xd7, 0x07, 0x34, 0xe6, 0xf4, 0xe0, 0x7e, 0xd3,
+	0xe8, 0x7c, 0x89, 0xa7, 0x48, 0x07, 0xa4, 0xb1, 0xc3, 0x9e, 0x05, 0x10, 0x7e, 0xd5, 0xf8, 0xf0,
+	0x36, 0xb9, 0x26, 0x3b, 0x9c, 0x64, 0x41, 0x23, 0xe8, 0xba, 0xc8, 0xae, 0x8b, 0xdc, 0xf2, 0x2b,
+	0xd8, 0x3b, 0x9f, 0x3c, 0xd1, 0x8e, 0x4c, 0xaa, 0x1d, 0x5f, 0x03, 0xde, 0xea, 0x46, 0xbb, 0xf5,
+	0x2d, 0xcf, 0xf3, 0x81, 0x93, 0x23, 0xe0, 0xce, 0xe7, 0xb0, 0x22, 0x2e, 0x81, 0xa2, 0x0d, 0x60,
+	0x1c, 0x75, 0x3a, 0x66, 0x83, 0x85, 0xd4, 0x60, 0x00, 0x06, 0xa6, 0xf0, 0xad, 0x0f, 0x29, 0x83,
+	0x26, 0x01, 0x4b, 0xb6, 0xa8, 0x94, 0xbd, 0xf3, 0x6f, 0x33, 0x50, 0x4e, 0x3f, 0x13, 0x83, 0xf1,
+	0xa8, 0x9d, 0x5a, 0x37, 0x8a, 0xfd, 0x37, 0xb5, 0x56, 0x55, 0x31, 0xb4, 0x27, 0x2a, 0xc6, 0x63,
+	0x6a, 0xf5, 0x67, 0xd2, 0x15, 0x8c, 0x0e, 0xed, 0x62, 0x14, 0x6e, 0x43, 0x35, 0x9b, 0xed, 0x5a,
+	0x8c, 0xc8, 0x60, 0x40, 0x46, 0x57, 0x35, 0x3b, 0xd5, 0x23, 0x8c, 0xbe, 0x10, 0xd0, 0x2c, 0x0b,
+	0xa8, 0x54, 0xcd, 0x8e, 0x4a, 0xb5, 0x76, 0x4d, 0x53, 0x4c, 0xaa, 0x1e, 0x99, 0xa2, 0xc4, 0x64,
+	0xee, 0x1c, 0x86, 0xd1, 0x26, 0xeb, 0xd5, 0x8d, 0xaa, 0x11, 0x63, 0xf3, 0x64, 0x1f, 0xae, 0x75,
+	0xe3, 0x47, 0x0a, 0x58, 0xf4, 0x86, 0xaa, 0x1b, 0x66, 0xbb, 0x25, 0xaa, 0x93, 0x96, 0xee, 0xfc,
+	0xd3, 0x35, 0x28, 0x26, 0x2f, 0xc0, 0xa0, 0x69, 0xa4, 0x1f, 0xe9, 0x71, 0xf4, 0xc7, 0x15, 0x8c,
+	0x38, 0xc1, 0xe0, 0x5a, 0x91, 0x66, 0x11, 0x28, 0xb4, 0x6a, 0x44, 0xe9, 0x2c, 0xa6, 0x8d, 0x7a,
+	0x9c, 0xce, 0x61, 0x01, 0x9d, 0x46, 0x33, 0x2e, 0x20, 0x8f, 0x91, 0x22, 0x8d, 0xb6, 0xae, 0x9b,
+	0xed, 0xba, 0x88, 0x98, 0x95, 0x96, 0x58, 0xc0, 0xb2, 0xaa, 0x60, 0xcc, 0xed, 0xb3, 0x04, 0x7c,
+	0x99, 0x6c, 0x40, 0x49, 0xeb, 0x98, 0x4a, 0x35, 0xce, 0xbe, 0x82, 0x23, 0x31, 0xad, 0xdf, 0x54,
+	0xbf, 0x55, 0x54, 0xb5, 0xc6, 0xe2, 0x4b, 0x93, 0x21, 0xad, 0x52, 0x81, 0xb7, 0x4b, 0x8b, 0xf2,
+	0x15, 0x31, 0x88, 0x99, 0x85, 0xb5, 0xc6, 0xc1, 0xc3, 0x02, 0x53, 0x12, 0x41, 0xa8, 0xea, 0x13,
+	0xb5, 0x65, 0x98, 0x06, 0xd5, 0x8e, 0x8e, 0x54, 0xaa, 0x4b, 0x65, 0xac, 0xbb, 0xdd, 0x35, 0xb0,
+	0x39, 0x3c, 0xa6, 0x56, 0x5a, 0x67, 0x21, 0xaf, 0x6a, 0x22, 0xfe, 0x78, 0x8a, 0x93, 0x78, 0x90,
+	0xf4, 0x34, 0xe4, 0x98, 0x05, 0xda, 0xb4, 0xbb, 0x86, 0xb4, 0x81, 0xb9, 0x70, 0xaa, 0x3b, 0x51,
+	0x2c, 0x6f, 0x14, 0xc1, 0xac, 0x4a, 0x84, 0xec, 0xc2, 0x76, 0x1a, 0x17, 0x19, 0x9a, 0x9b, 0xe4,
+	0x03, 0xb8, 0x5e, 0x53, 0xeb, 0xd5, 0x6e, 0xc3, 0x30, 0xd5, 0x8e, 0x2e, 0xc6, 0xc4, 0x4c, 0x8c,
+	0xfd, 0xd6, 0x34, 0x92, 0x58, 0x40, 0xb6, 0x89, 0x0c, 0xef, 0x27, 0xa2, 0xa0, 0x17, 0xc4, 0x4c,
+	0x4b, 0x57, 0xb1, 0xe0, 0x18, 0xc1, 0xad, 0x5c, 0x25, 0x8a, 0x03, 0x66, 0x0c, 0x21, 0xed, 0xb0,
+	0x68, 0xe8, 0xa3, 0xa7, 0xa6, 0x41, 0xab, 0x8a, 0x1a, 0xc5, 0x12, 0x4b, 0x15, 0xc1, 0xb4, 0xd8,
+	0x33, 0xf3, 0xbb, 0x76, 0x4b, 0x8d, 0xaa, 0xdd, 0x65, 0x93, 0x3e, 0x1d, 0xec, 0x3d, 0x66, 0x50,
+	0x2b, 0x47, 0x31, 0xe0, 0x3d, 0xac, 0x53, 0x39, 0xae, 0xd2, 0x23, 0x1e, 0x52, 0x44, 0xa9, 0xda,
+	0xe0, 0x55, 0xaa, 0xdf, 0x0a, 0x92, 0x6b, 0x48, 0x52, 0xed, 0xb4, 0x4c, 0xbc, 0xff, 0x98, 0x6e,
+	0x56, 0x14, 0xe5, 0x7d, 0x9d, 0x45, 0x79, 0xe3, 0x1c, 0x2a, 0xfa, 0x51, 0x32, 0x90, 0x38, 0xaa,
+	0xe6, 0x7d, 0x1c, 0x90, 0xae, 0x5e, 0x3d, 0x42, 0xde, 0x66, 0xa1, 0xc4, 0x1f, 0x90, 0x7b, 0xf0,
+	0xd3, 0x73, 0x46, 0x71, 0x61, 0x1d, 0x32, 0xf9, 0x0c, 0x3e, 0x89, 0xeb, 0x38, 0x7e, 0x76, 0x48,
+	0xb5, 0x9a, 0xa9, 0x77, 0x0f, 0x75, 0x85, 0x6a, 0x87, 0x6a, 0x6d, 0x51, 0xad, 0x37, 0xc9, 0xe7,
+	0x70, 0x6f, 0x36, 0x4b, 0xb7, 0x75, 0x71, 0xa6, 0x0f, 0x71, 0x2c, 0x53, 0xe1, 0xd3, 0x02, 0x71,
+	0x0b, 0xc7, 0x3e, 0x19, 0x6e, 0xce, 0x36, 0x2d, 0xd2, 0xc7, 0x28, 0x17, 0xd2, 0xe0, 0x76, 0x47,
+	0xba, 0x8d, 0xc4, 0x0a, 0x0b, 0x5b, 0xef, 0x24, 0xc2, 0xd6, 0xef, 0x60, 0xac, 0x78, 0x57, 0x65,
+	0xac, 0xde, 0x48, 0x32, 0x97, 0xa8, 0xe3, 0xa7, 0x28, 0x08, 0x8e, 0xd5, 0xd6, 0xe1, 0xb9, 0x14,
+	0x3f, 0xc3, 0x12, 0x44, 0xc4, 0x76, 0x4b, 0x35, 0x9e, 0xb6, 0xe9, 0x23, 0xd6, 0x8b, 0x68, 0x5c,
+	0x3f, 0x21, 0xb7, 0xe0, 0x03, 0x11, 0x6a, 0xde, 0xac, 0xb6, 0xaa, 0x47, 0x6a, 0x13, 0x57, 0x4f,
+	0x24, 0x76, 0xa2, 0xd1, 0xbc, 0x8b, 0x0b, 0x3b, 0x1a, 0xfe, 0x04, 0xe7, 0xde, 0x23, 0x5f, 0xc3,
+	0x97, 0xfc, 0x1b, 0xd7, 0x10, 0x4a, 0x36, 0xaa, 0xea, 0x6a, 0x0b, 0xdf, 0x25, 0x68, 0x4d, 0xbf,
+	0x79, 0x65, 0x6c, 0x71, 0x53, 0xb5, 0x1a, 0xd5, 0xfd, 0x29, 0x72, 0x57, 0xb7, 0x25, 0xa2, 0xc5,
+	0xd5, 0x9a, 0xf4, 0xd9, 0x9d, 0x7f, 0x95, 0x81, 0xdc, 0x63, 0x45, 0xc3, 0xb0, 0x86, 0xc7, 0x8a,
+	0xc6, 0x1c, 0xd3, 0xe2, 0xf3, 0x33, 0x29, 0x13, 0x7d, 0x1e, 0x48, 0xd9, 0xe8, 0xf3, 0x73, 0x29,
+	0x17, 0x7d, 0x7e, 0x21, 0xe5, 0xa3, 0xcf, 0xfb, 0xd2, 0x52, 0xf4, 0xf9, 0x40, 0x5a, 0x8e, 0x3e,
+	0xbf, 0x94, 0x56, 0xa2, 0xcf, 0xaf, 0xa4, 0xd5, 0xe8, 0xf3, 0xa1, 0xb4, 0x86, 0x3e, 0x05, 0x46,
+	0x7b, 0x5f, 0xaa, 0xc6, 0xdf, 0x0f, 0xa4, 0xc3, 0xf8, 0xfb, 0x4b, 0x49, 0x89, 0xbe, 0xbf, 0xfc,
+	0x54, 0xaa, 0xc7, 0xdf, 0xf7, 0xa5, 0x47, 0xf1, 0xf7, 0x43, 0xa9, 0x7d, 0xc7, 0x81, 0x62, 0xf2,
+	0xd9, 0x0a, 0xa6, 0x01, 0x3a, 0x35, 0x14, 0xdc, 0xd3, 0x3d, 0x2a, 0x7f, 0xea, 0x81, 0x43, 0x31,
+	0xa6, 0xbf, 0xa5, 0xaa, 0x35, 0xb6, 0x75, 0xdd, 0x86, 0x8d, 0x68, 0xd4, 0x11, 0xce, 0x1f, 0x1f,
+	0xc8, 0x32, 0xe1, 0xc5, 0xc2, 0xfa, 0xa2, 0xa9, 0xc8, 0xdd, 0x79, 0x04, 0xeb, 0x33, 0x01, 0x42,
+	0xd8, 0x8a, 0x3a, 0xd6, 0x81, 0x72, 0xfd, 0x2a, 0x10, 0xad, 0xc5, 0x53, 0x66, 0xb7, 0xd5, 0x54,
+	0x0d, 0x95, 0xb2, 0x0a, 0xb6, 0x40, 0x8a, 0xe1, 0x11, 0x34, 0x7b, 0xe7, 0x2b, 0x58, 0x9f, 0x89,
+	0x5e, 0xc1, 0x2a, 0xa3, 0x96, 0x34, 0xd4, 0x27, 0x6a, 0x83, 0x3f, 0x82, 0xd3, 0x51, 0x14, 0xce,
+	0xdf, 0x1c, 0x96, 0xb9, 0x53, 0x83, 0x72, 0xda, 0x31, 0xbf, 0x30, 0x2e, 0xb9, 0x08, 0xab, 0x08,
+	0x63, 0xa9, 0x2c, 0x5b, 0xd6, 0x2d, 0xdd, 0xa0, 0x5d, 0xc5, 0xe8, 0x62, 0xfd, 0xb9, 0x3b, 0x0f,
+	0x61, 0x25, 0x72, 0xdb, 0x96, 0x01, 0x74, 0x5d, 0xe1, 0x7a, 0xf0, 0x33, 0xe9, 0x4a, 0x2a, 0x7d,
+	0x20, 0x65, 0x52, 0xe9, 0xcf, 0xa5, 0xec, 0x9d, 0x3f, 0x2d, 0x00, 0x4c, 0xfd, 0xd0, 0x28, 0x53,
+	0xda, 0x1d, 0x95, 0x56, 0x8d, 0x36, 0x35, 0x6b, 0x2a, 0x0f, 0xa6, 0x54, 0x6b, 0xe6, 0x61, 0x95,
+	0x52, 0x54, 0x89, 0x57, 0x50, 0x7c, 0x6b, 0x2d, 0xbd, 0x5b, 0xaf, 0x6b, 0x8a, 0x86, 0x4c, 0x1e,
+	0x3f, 0x85, 0x21, 0x65, 0x10, 0x17, 0x3d, 0xdc, 0xd0, 0x8e, 0x5f, 0x4c, 0x30, 0x6b, 0xad, 0x16,
+	0x7f, 0x6c, 0x23, 0x02, 0x24, 0x55, 0x32, 0x8b, 0xb0, 0xcc, 0xa1, 0x5c, 0x62, 0x32, 0x03, 0xd5,
+	0x16, 0xbe, 0x27, 0x11, 0x69, 0x14, 0x3a, 0xf3, 0x36, 0x83, 0x78, 0x6e, 0x81, 0xe9, 0xee, 0x48,
+	0x61, 0x47, 0xef, 0x35, 0xa0, 0x94, 0xe9, 0xa8, 0x0a, 0x77, 0x53, 0x2c, 0x21, 0x45, 0xa4, 0xda,
+	0xdb, 0x1d, 0x23, 0xe2, 0x88, 0xe9, 0x3a, 0x59, 0x26, 0x3f, 0x85, 0x8f, 0x45, 0x19, 0x6a, 0xcd,
+	0x5c, 0x48, 0x1b, 0xc9, 0x2c, 0x69, 0x05, 0x89, 0x67, 0x48, 0x0c, 0xb5, 0xd9, 0x69, 0xd3, 0x2a,
+	0xd5, 0x1a, 0xcf, 0x4c, 0xa1, 0x1a, 0xdb, 0xb4, 0xa6, 0x52, 0x69, 0x95, 0x6b, 0xbf, 0xa3, 0x6e,
+	0xa3, 0x8a, 0x83, 0xc8, 0x2c, 0x13, 0xae, 0x6d, 0x80, 0xbd, 0x8d, 0x21, 0x24, 0x48, 0xc4, 0x8b,
+	0x05, 0xa1, 0x48, 0x63, 0x32, 0x33, 0x6e, 0x95, 0x54, 0xc4, 0x6e, 0x68, 0x2d, 0xa6, 0x48, 0x53,
+	0xe3, 0xc6, 0x9f, 0xdd, 0x30, 0x9e, 0xf1, 0x47, 0x39, 0x74, 0xb5, 0x59, 0xc5, 0x51, 0xe3, 0x4f,
+	0x70, 0xe8, 0x4c, 0x6c, 0x54, 0x95, 0x47, 0xaa, 0x61, 0xf2, 0x37, 0x37, 0xa4, 0x32, 0x1a, 0x0a,
+	0xfa, 0xb3, 0x96, 0x81, 0xb5, 0xa0, 0x90, 0x63, 0x84, 0xf3, 0x74, 0xeb, 0x38, 0x4f, 0xa2, 0x33,
+	0x8d, 0x6a, 0xad, 0x15, 0x0f, 0x0b, 0x8a, 0x1c, 0xfe, 0x58, 0x47, 0xc7, 0xd0, 0xcc, 0xa6, 0xa6,
+	0x37, 0xab, 0x86, 0x72, 0x2c, 0x6d, 0x60, 0xb9, 0xb3, 0xf3, 0x69, 0x22, 0xaf, 0x9a, 0xed, 0x56,
+	0xe3, 0x19, 0xd3, 0xb4, 0x4f, 0xd5, 0x9a, 0x44, 0xce, 0xa3, 0x7b, 0x90, 0xa6, 0xdb, 0x44, 0x49,
+	0x9b, 0xa4, 0xab, 0xb5, 0x55, 0x9d, 0x4d, 0x86, 0xfa, 0xad, 0xa6, 0x1b, 0xd2, 0x16, 0xb9, 0x0f,
+	0x9f, 0x2d, 0xe6, 0x3f, 0xb3, 0xde, 0xa6, 0xa6, 0x60, 0x00, 0xc5, 0xd4, 0x1b, 0xac, 0xd5, 0xad,
+	0x1a, 0x63, 0xbf, 0x6d, 0x1c, 0xe2, 0xd4, 0xf4, 0x9b, 0xd1, 0x22, 0x90, 0xae, 0x92, 0x4f, 0xe0,
+	0x27, 0x6f, 0x5d, 0xa4, 0xb4, 0x83, 0xb2, 0x7e, 0x31, 0x97, 0xe3, 0x70, 0x56, 0x05, 0x59, 0x05,
+	0x45, 0x51, 0x3c, 0x71, 0x86, 0x86, 0xcf, 0xaf, 0x74, 0xd1, 0x36, 0x78, 0x04, 0x47, 0xcd, 0xea,
+	0xb7, 0x5a, 0xb3, 0xdb, 0x34, 0x6b, 0x55, 0xa3, 0x8a, 0xef, 0x88, 0x30, 0x4b, 0x16, 0xc5, 0x3e,
+	0x56, 0xc7, 0x96, 0x41, 0xa7, 0x51, 0x6d, 0xa1, 0xbc, 0x33, 0xd4, 0x23, 0x8a, 0x61, 0xca, 0x1d,
+	0xda, 0x36, 0xf8, 0x6b, 0x22, 0xa6, 0x86, 0xfa, 0xad, 0x6d, 0x36, 0xda, 0x4f, 0xa5, 0x3d, 0xf2,
+	0x31, 0xdc, 0x4c, 0x4f, 0x3d, 0x36, 0xc1, 0x38, 0x56, 0x99, 0x76, 0xe1, 0xeb, 0x18, 0xd9, 0xee,
+	0x3d, 0x72, 0x07, 0x3e, 0x5a, 0x38, 0xfb, 0xf3, 0xb4, 0xd7, 0x70, 0xa6, 0xa2, 0x86, 0x37, 0xab,
+	0x9d, 0x8e, 0x5a, 0x4b, 0x9a, 0x0a, 0x31, 0xdf, 0x5d, 0x67, 0xc6, 0x95, 0xa8, 0xbc, 0xda, 0x68,
+	0x3c, 0x33, 0xa7, 0x0f, 0xca, 0x34, 0x55, 0x1d, 0xcd, 0x0e, 0xe9, 0x7d, 0xb4, 0x62, 0xa6, 0x65,
+	0xb5, 0x6a, 0x28, 0x56, 0x9e, 0xa5, 0x1e, 0x3d, 0xb9, 0x41, 0x3e, 0x85, 0x9f, 0x09, 0x7a, 0xce,
+	0x14, 0x2d, 0x66, 0x07, 0x69, 0xba, 0x81, 0x53, 0xd1, 0xa6, 0x6c, 0xf2, 0xb5, 0x66, 0xa7, 0xc1,
+	0x74, 0xaa, 0x5a, 0x93, 0xf6, 0xc9, 0x97, 0xf0, 0xf9, 0x4c, 0x0e, 0x7c, 0xe1, 0xa5, 0xd9, 0xa9,
+	0x1a, 0xda, 0x61, 0x43, 0x35, 0x9f, 0x6a, 0xc6, 0x31, 0xeb, 0x18, 0x8e, 0x5a, 0x5b, 0x69, 0x37,
+	0xb8, 0xdd, 0x2f, 0x7d, 0x80, 0x19, 0x13, 0x75, 0x9b, 0x2a, 0x2f, 0xf3, 0xd2, 0x1a, 0x65, 0x5c,
+	0xcf, 0x71, 0xe4, 0x37, 0x5a, 0x08, 0xaa, 0x78, 0xeb, 0xe6, 0x26, 0x39, 0x80, 0xbb, 0x51, 0x5b,
+	0xde, 0xb2, 0x19, 0x1f, 0x32, 0x16, 0x8f, 0x60, 0x7c, 0x26, 0x92, 0x92, 0xeb, 0x16, 0xea, 0x1e,
+	0xe4, 0x98, 0x6a, 0x83, 0xaa, 0xd5, 0x1a, 0x0e, 0x17, 0x32, 0x85, 0xf4, 0x11, 0x72, 0x54, 0x3c,
+	0x4f, 0xa6, 0xb0, 0x6b, 0xa5, 0xdb, 0x77, 0xfe, 0x28, 0x03, 0x85, 0xc4, 0x7d, 0x2a, 0x14, 0x31,
+	0x5c, 0x53, 0x36, 0x62, 0x13, 0xf6, 0x0a, 0xf6, 0x80, 0x75, 0x11, 0xb9, 0x36, 0xb1, 0xbe, 0xa4,
+	0x0c, 0x3e, 0x81, 0x13, 0x91, 0xab, 0x4d, 0x95, 0x1e, 0xa9, 0x2d, 0xe5, 0x59, 0x9c, 0x31, 0x8b,
+	0xb3, 0x1c, 0x67, 0x9c, 0xe2, 0x93, 0x45, 0xe4, 0xb0, 0xf0, 0x85, 0x96, 0x73, 0xfe, 0xce, 0x43,
+	0x80, 0xe9, 0x85, 0x29, 0xec, 0x58, 0xd3, 0xfc, 0x1c, 0x37, 0x8a, 0xc2, 0x98, 0xc2, 0x6f, 0xe9,
+	0x0a, 0x7f, 0x30, 0xa8, 0x95, 0xc4, 0x48, 0x99, 0x3b, 0x14, 0xa4, 0xd9, 0x9b, 0x42, 0xa8, 0x07,
+	0x9f, 0xa8, 0x94, 0x8f, 0x53, 0xb4, 0x69, 0xc4, 0x85, 0x87, 0x43, 0x1f, 0x23, 0x32, 0x88, 0x68,
+	0x3d, 0x9d, 0x47, 0x64, 0xef, 0xfc, 0x45, 0x06, 0x60, 0x7a, 0xab, 0x8e, 0xd4, 0x01, 0x7d, 0xdc,
+	0x4c, 0xd8, 0x19, 0x55, 0x05, 0x79, 0xbc, 0xd1, 0x6e, 0x31, 0xa3, 0xdc, 0x68, 0x9b, 0x68, 0xd1,
+	0xb3, 0xad, 0x1a, 0x9b, 0x48, 0x6c, 0x9f, 0xd2, 0x6e, 0xe9, 0xaa, 0xd2, 0x65, 0xfb, 0x4c, 0xa3,
+	0xaa, 0xf0, 0x25, 0x8d, 0x47, 0x28, 0x87, 0xf0, 0xab, 0xb7, 0x2d, 0xe7, 0x9c, 0x32, 0x32, 0x28,
+	0x84, 0xa6, 0x65, 0x68, 0x33, 0x65, 0xd4, 0xb4, 0x7a, 0x5d, 0xa5, 0xc8, 0x9e, 0x58, 0x92, 0x2e,
+	0x65, 0xef, 0xdc, 0x9b, 0xc6, 0x8a, 0xf1, 0x5b, 0x68, 0x68, 0x2d, 0x1c, 0xbb, 0x27, 0x2f, 0xf8,
+	0x2d, 0x84, 0x16, 0xfa, 0xb8, 0x06, 0xe2, 0x4c, 0xc7, 0x7b, 0x23, 0x65, 0xef, 0x98, 0xb0, 0x31,
+	0xf7, 0xf8, 0x32, 0x1a, 0x03, 0x0a, 0x55, 0xf9, 0xd3, 0x06, 0x9f, 0xf2, 0x97, 0x32, 0x58, 0x5a,
+	0x65, 0x96, 0x62, 0x11, 0x56, 0xc5, 0xae, 0xfa, 0x80, 0xef, 0x64, 0xe3, 0x7d, 0x3b, 0x5a, 0x8c,
+	0xb8, 0xa3, 0xe4, 0xbb, 0xb3, 0x1a, 0x9a, 0x8d, 0x07, 0x7f, 0x98, 0x87, 0x4d, 0xf6, 0xc2, 0xa5,
+	0xa8, 0x84, 0xfb, 0xbb, 0xd9, 0xf3, 0x0f, 0xdc, 0x3f, 0xca, 0xde, 0xe9, 0x09, 0xad, 0x30, 0x20,
+	0x7b, 0x0b, 0x5f, 0x03, 0x67, 0x4f, 0x87, 0xef, 0x6d, 0x08, 0x1c, 0x7b, 0x04, 0xfe, 0x2e, 0xde,
+	0x2b, 0x91, 0xaf, 0x90, 0x3f, 0x80, 0x52, 0x2a, 0x48, 0x88, 0x7c, 0x98, 0x72, 0x95, 0x9e, 0xf3,
+	0x42, 0xf8, 0xde, 0xad, 0x4b, 0xa8, 0xc4, 0x73, 0x8d, 0x57, 0x48, 0x17, 0x60, 0xfa, 0x2e, 0x27,
+	0xf9, 0x60, 0x36, 0xdb, 0xdc, 0x53, 0xa0, 0x7b, 0xf2, 0x45, 0x24, 0x71, 0xb1, 0x36, 0x6c, 0xe0,
+	0xd3, 0x90, 0xdc, 0x5d, 0x73, 0xc0, 0xdf, 0x8a, 0x24, 0xb7, 0xe6, 0xe2, 0x30, 0x16, 0x3d, 0x91,
+	0xb9, 0xf7, 0xd1, 0x65, 0x64, 0x71, 0x2d, 0xdf, 0xc0, 0x3a, 0x1e, 0x42, 0x27, 0x5f, 0x3c, 0x4a,
+	0x3d, 0xa9, 0x99, 0x40, 0x2c, 0x1e, 0xdd, 0xef, 0x60, 0x7d, 0xe6, 0x1d, 0xcf, 0xd4, 0x10, 0x2c,
+	0x7e, 0x9a, 0x74, 0x4f, 0xbe, 0x88, 0x24, 0x6a, 0xdc, 0xc1, 0x9f, 0x66, 0x60, 0x5b, 0xb4, 0xa0,
+	0xe3, 0x7b, 0xa7, 0x67, 0x1c, 0x65, 0x3b, 0x3e, 0xe9, 0x4e, 0x1f, 0xd5, 0xe1, 0xf6, 0x3e, 0xd9,
+	0xbf, 0xec, 0xe9, 0xca, 0xbd, 0x1b, 0xe7, 0x52, 0xf0, 0x47, 0xe3, 0xe4, 0x2b, 0xa4, 0x0d, 0xc5,
+	0xe4, 0x63, 0x72, 0xe4, 0xfd, 0x73, 0x5e, 0x99, 0x8b, 0x8a, 0xbc, 0x7e, 0xe1, 0x2b, 0x74, 0xf2,
+	0x95, 0x83, 0x7f, 0x94, 0x85, 0x8a, 0xe2, 0x8c, 0x42, 0x3f, 0xe6, 0x6c, 0xe1, 0x49, 0x1e, 0x38,
+	0x3e, 0x31, 0x66, 0x19, 0x73, 0x26, 0xcc, 0x63, 0x9e, 0x27, 0xf7, 0xcf, 0x27, 0x88, 0x67, 0xd4,
+	0x80, 0x52, 0x2a, 0xae, 0x24, 0x55, 0xea, 0xa2, 0x90, 0x98, 0xbd, 0xfd, 0xf3, 0x09, 0xe2, 0x52,
+	0xff, 0x1a, 0x48, 0x71, 0x78, 0x46, 0x54, 0xb0, 0x3c, 0xcf, 0x28, 0xb3, 0x21, 0x1c, 0x7b, 0x37,
+	0x2f, 0xa4, 0x89, 0x67, 0xfa, 0x6f, 0xc0, 0xd5, 0xea, 0xb0, 0x3f, 0xdd, 0xcc, 0xc4, 0x77, 0xc3,
+	0x48, 0x07, 0xb6, 0x74, 0x27, 0xac, 0x0e, 0xfb, 0x33, 0x37, 0xdb, 0xde, 0x4f, 0x15, 0x3c, 0x77,
+	0x8d, 0x62, 0x2f, 0xe9, 0x16, 0x4f, 0xdd, 0x34, 0x3b, 0xf8, 0x6d, 0x96, 0xbd, 0x33, 0x1d, 0x9f,
+	0x6a, 0xd7, 0x3d, 0x3f, 0x3e, 0x64, 0x25, 0x75, 0xb6, 0x1e, 0x52, 0x57, 0x1b, 0x76, 0xe6, 0x6f,
+	0x18, 0x30, 0xc4, 0xf9, 0x55, 0xb0, 0x59, 0xd8, 0xe2, 0xe5, 0x88, 0x66, 0x05, 0xdc, 0xa7, 0x9d,
+	0x62, 0xd3, 0x85, 0x0e, 0xef, 0x0b, 0x4b, 0xd5, 0x60, 0x43, 0x77, 0x46, 0x36, 0xf7, 0x62, 0x8b,
+	0x21, 0x26, 0x33, 0xe7, 0xcd, 0x53, 0x17, 0xf7, 0x45, 0x45, 0x1d, 0xfc, 0x45, 0x06, 0xae, 0xea,
+	0x8b, 0x87, 0xbc, 0x0b, 0x84, 0x0f, 0x79, 0xea, 0xfd, 0xf4, 0x9b, 0x33, 0x03, 0xbe, 0xe8, 0x99,
+	0xee, 0x0b, 0x1b, 0xff, 0x84, 0x0d, 0x89, 0x3e, 0x37, 0x93, 0x1f, 0x5e, 0x3c, 0x93, 0xdc, 0x04,
+	0xb8, 0x60, 0x3e, 0x5f, 0xc2, 0x16, 0xb2, 0xc7, 0xb0, 0x9f, 0x6e, 0x12, 0xd1, 0x59, 0x37, 0xf4,
+	0x1f, 0xb5, 0x1b, 0x87, 0xef, 0x7d, 0xb7, 0xcb, 0x50, 0xf7, 0xf0, 0x1f, 0x43, 0x7a, 0x03, 0x6f,
+	0x62, 0xdf, 0x3b, 0xf1, 0xc4, 0x5f, 0x87, 0x3c, 0x5f, 0x66, 0xbf, 0x9f, 0xff, 0xbf, 0x01, 0x00,
+	0x8f, 0xda, 0x9a, 0xff, 0xb2, 0x64, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -162,9 +162,12 @@ func (m *SMOUplinkUnitdata) GetECgi() []byte {
 }
 
 type ReportDeliveryResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// acks to deliver back to the UE, e.g. the RP-ACK for a mobile-originated
+	// SMS
+	Messages             []*SMODownlinkUnitdata `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *ReportDeliveryResponse) Reset()         { *m = ReportDeliveryResponse{} }
@@ -192,6 +195,13 @@ func (m *ReportDeliveryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReportDeliveryResponse proto.InternalMessageInfo
 
+func (m *ReportDeliveryResponse) GetMessages() []*SMODownlinkUnitdata {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 type ReportDeliveryRequest struct {
 	Report               *SMOUplinkUnitdata `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -321,39 +331,38 @@ func init() {
 func init() { proto.RegisterFile("lte/protos/sms_orc8r.proto", fileDescriptor_5e3e558366760a7d) }
 
 var fileDescriptor_5e3e558366760a7d = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc6, 0xeb, 0x37, 0x7f, 0x5e, 0x32, 0xa9, 0x80, 0x4e, 0x68, 0x70, 0x02, 0x54, 0xc1, 0xa7,
-	0x88, 0x43, 0x22, 0x19, 0x0e, 0xa8, 0x07, 0x0e, 0x4d, 0xa4, 0x9e, 0x4c, 0x54, 0x9b, 0x82, 0x54,
-	0x81, 0xac, 0xad, 0x33, 0xb2, 0x56, 0xf5, 0xee, 0x06, 0xef, 0x26, 0x55, 0xf9, 0x52, 0x7c, 0x3d,
-	0x8e, 0x28, 0x6b, 0x3b, 0x4a, 0xda, 0x52, 0x4e, 0x9c, 0xbc, 0xbb, 0xcf, 0xcf, 0x8f, 0xc7, 0xcf,
-	0xcc, 0x42, 0x3f, 0x33, 0x34, 0x5e, 0xe4, 0xca, 0x28, 0x3d, 0xd6, 0x42, 0xc7, 0x2a, 0x4f, 0xde,
-	0xe7, 0x23, 0x7b, 0x80, 0x2d, 0xc1, 0x52, 0xc1, 0x46, 0x99, 0xa1, 0x7e, 0xcf, 0x9e, 0x57, 0x60,
-	0xa2, 0x84, 0x50, 0xb2, 0xa0, 0xbc, 0x6f, 0xd0, 0x89, 0x82, 0xd9, 0x54, 0x5d, 0xcb, 0x8c, 0xcb,
-	0xab, 0x73, 0xc9, 0xcd, 0x9c, 0x19, 0x86, 0x08, 0x75, 0x2e, 0x34, 0x77, 0x9d, 0x81, 0x33, 0x6c,
-	0x85, 0x76, 0x8d, 0x3e, 0x1c, 0x4a, 0xa6, 0x63, 0x41, 0x5a, 0xb3, 0x94, 0xe2, 0x44, 0x49, 0xc3,
-	0xb8, 0xa4, 0xdc, 0xfd, 0x6f, 0xe0, 0x0c, 0xf7, 0xc3, 0x8e, 0x64, 0x3a, 0x28, 0xb4, 0x49, 0x25,
-	0x79, 0xbf, 0x1c, 0x38, 0x88, 0x82, 0xd9, 0xf9, 0xe2, 0x5f, 0xb8, 0x63, 0x17, 0x9a, 0x5c, 0x10,
-	0xd7, 0x2b, 0xb7, 0x66, 0xa1, 0x72, 0x87, 0x03, 0xd8, 0x5f, 0x52, 0x6c, 0xb8, 0xa0, 0xf8, 0x87,
-	0x92, 0xe4, 0xd6, 0xad, 0x0a, 0x4b, 0xfa, 0xc4, 0x05, 0x5d, 0x28, 0x49, 0x78, 0x0c, 0x3d, 0xa1,
-	0x2e, 0x79, 0x46, 0xb1, 0x36, 0xcc, 0x70, 0x25, 0xe3, 0x24, 0x63, 0x5a, 0x0b, 0x96, 0x5f, 0xf9,
-	0x6e, 0xc3, 0xe2, 0xcf, 0x0b, 0x20, 0x2a, 0xf4, 0xc9, 0x46, 0xc6, 0xa7, 0x50, 0x33, 0x8c, 0xbb,
-	0x4d, 0x4b, 0xad, 0x97, 0xd8, 0x81, 0x06, 0xc5, 0x49, 0xca, 0xdd, 0xff, 0xed, 0x59, 0x9d, 0x26,
-	0x29, 0xf7, 0x5c, 0xe8, 0x86, 0xb4, 0x50, 0xb9, 0x99, 0x52, 0xc6, 0x57, 0x94, 0xdf, 0x84, 0xa4,
-	0x17, 0x4a, 0x6a, 0xf2, 0x02, 0x38, 0xbc, 0xad, 0x7c, 0x5f, 0x92, 0x36, 0xf8, 0x0e, 0x9a, 0xb9,
-	0x15, 0x6c, 0x32, 0x6d, 0xff, 0xe5, 0x68, 0xd3, 0xc3, 0xd1, 0x9d, 0x14, 0xc3, 0x92, 0xf5, 0xde,
-	0x00, 0x9e, 0x92, 0x29, 0xc3, 0xd1, 0x95, 0xd7, 0x33, 0x68, 0xac, 0x73, 0xd5, 0xae, 0x33, 0xa8,
-	0x0d, 0x5b, 0x61, 0xb1, 0xf1, 0xce, 0xa0, 0xb3, 0xc3, 0x16, 0x15, 0xe1, 0x31, 0x3c, 0x2a, 0x83,
-	0x2f, 0xf8, 0xb6, 0x7f, 0xb4, 0xfb, 0xe9, 0xdb, 0x03, 0x12, 0x6e, 0x78, 0xff, 0x0c, 0x9e, 0x44,
-	0x41, 0x34, 0x5b, 0x4f, 0x58, 0x44, 0xf9, 0x8a, 0x27, 0x84, 0x1f, 0xa0, 0xb5, 0x29, 0x17, 0x1f,
-	0xfc, 0x89, 0xfe, 0x41, 0xa9, 0x16, 0x93, 0xfb, 0x59, 0xf1, 0xb9, 0xb7, 0xe7, 0x7f, 0x85, 0x6e,
-	0x65, 0x79, 0xca, 0x0c, 0x5d, 0xb3, 0x9b, 0xca, 0xf9, 0x04, 0xda, 0x5b, 0xd5, 0xe0, 0x5f, 0xaa,
-	0xbc, 0xdf, 0xfd, 0xa7, 0x03, 0xf5, 0x48, 0xe8, 0x29, 0x7e, 0x81, 0xc7, 0xbb, 0x7d, 0xc0, 0xc1,
-	0x96, 0xdf, 0xbd, 0x2d, 0xea, 0xbf, 0x7e, 0x80, 0x28, 0xdb, 0xbb, 0x87, 0x1f, 0xa1, 0xbd, 0x95,
-	0x32, 0xbe, 0xda, 0x7a, 0xe7, 0x6e, 0xa7, 0xfa, 0x47, 0x7f, 0x92, 0x2b, 0xbf, 0x93, 0x17, 0x17,
-	0x3d, 0x8b, 0x8c, 0xd7, 0xd7, 0x3d, 0xc9, 0xd4, 0x72, 0x3e, 0x4e, 0x55, 0x79, 0x9d, 0x2f, 0x9b,
-	0xf6, 0xf9, 0xf6, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xd9, 0x5d, 0x50, 0x0c, 0x04, 0x00,
-	0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x17, 0xfa, 0x03, 0xfa, 0x3a, 0x01, 0x73, 0x59, 0x49, 0x03, 0x4c, 0x21, 0xa7, 0x8a,
+	0x43, 0x2b, 0x05, 0x0e, 0x88, 0x03, 0x87, 0xb5, 0xd2, 0x4e, 0xa1, 0x5a, 0xb2, 0x81, 0x34, 0x81,
+	0x22, 0x2f, 0x7d, 0x8a, 0xac, 0xc5, 0x76, 0x89, 0xdd, 0x4e, 0xe3, 0x9f, 0xe2, 0xdf, 0xe3, 0x88,
+	0xe2, 0x24, 0x55, 0x0b, 0xa5, 0x1c, 0x10, 0xa7, 0xda, 0xfe, 0x7e, 0xfa, 0xcd, 0x7b, 0xef, 0x6b,
+	0x19, 0x9c, 0x4c, 0xe3, 0x78, 0x91, 0x4b, 0x2d, 0xd5, 0x58, 0x71, 0x15, 0xcb, 0x3c, 0x79, 0x9b,
+	0x8f, 0xcc, 0x01, 0xe9, 0x70, 0x9a, 0x72, 0x3a, 0xca, 0x34, 0x3a, 0x03, 0x73, 0x5e, 0x83, 0x89,
+	0xe4, 0x5c, 0x8a, 0x92, 0xf2, 0xbe, 0x40, 0x2f, 0x0a, 0x66, 0x53, 0x79, 0x2b, 0x32, 0x26, 0x6e,
+	0x2e, 0x05, 0xd3, 0x73, 0xaa, 0x29, 0x21, 0xd0, 0x64, 0x5c, 0x31, 0xdb, 0x72, 0xad, 0x61, 0x27,
+	0x34, 0x6b, 0xe2, 0xc3, 0xb1, 0xa0, 0x2a, 0xe6, 0xa8, 0x14, 0x4d, 0x31, 0x4e, 0xa4, 0xd0, 0x94,
+	0x09, 0xcc, 0xed, 0x7b, 0xae, 0x35, 0x3c, 0x0c, 0x7b, 0x82, 0xaa, 0xa0, 0xd4, 0x26, 0xb5, 0xe4,
+	0xfd, 0xb0, 0xe0, 0x28, 0x0a, 0x66, 0x97, 0x8b, 0xff, 0xe1, 0x4e, 0xfa, 0xd0, 0x66, 0x1c, 0x99,
+	0x5a, 0xd9, 0x0d, 0x03, 0x55, 0x3b, 0xe2, 0xc2, 0xe1, 0x12, 0x63, 0xcd, 0x38, 0xc6, 0xdf, 0xa4,
+	0x40, 0xbb, 0x69, 0x54, 0x58, 0xe2, 0x05, 0xe3, 0x78, 0x25, 0x05, 0x92, 0x77, 0x30, 0xe0, 0xf2,
+	0x9a, 0x65, 0x18, 0x2b, 0x4d, 0x35, 0x93, 0x22, 0x4e, 0x32, 0xaa, 0x14, 0xa7, 0xf9, 0x8d, 0x6f,
+	0xb7, 0x0c, 0xfe, 0xb4, 0x04, 0xa2, 0x52, 0x9f, 0xac, 0x65, 0xf2, 0x18, 0x1a, 0x9a, 0x32, 0xbb,
+	0x6d, 0xa8, 0x62, 0x49, 0x7a, 0xd0, 0xc2, 0x38, 0x49, 0x99, 0x7d, 0xdf, 0x9c, 0x35, 0x71, 0x92,
+	0x32, 0xef, 0x02, 0xfa, 0x21, 0x2e, 0x64, 0xae, 0xa7, 0x98, 0xb1, 0x15, 0xe6, 0x77, 0x21, 0xaa,
+	0x85, 0x14, 0xaa, 0xf8, 0xf8, 0x83, 0xaa, 0x4d, 0x65, 0x5b, 0x6e, 0x63, 0xd8, 0xf5, 0x4f, 0x46,
+	0xeb, 0xb0, 0x46, 0x3b, 0xe2, 0x08, 0xd7, 0xbc, 0x17, 0xc0, 0xf1, 0xaf, 0xae, 0x5f, 0x97, 0xa8,
+	0x34, 0x79, 0x03, 0xed, 0xdc, 0x08, 0x66, 0xaa, 0x5d, 0xff, 0xf9, 0xb6, 0xe5, 0x76, 0x02, 0x61,
+	0xc5, 0x7a, 0xaf, 0x80, 0x9c, 0xa1, 0xae, 0x06, 0xab, 0x6a, 0xaf, 0x27, 0xd0, 0x2a, 0x32, 0x29,
+	0xab, 0xeb, 0x84, 0xe5, 0xc6, 0x3b, 0x87, 0xde, 0x16, 0xfb, 0xef, 0xdd, 0xf8, 0xe7, 0xf0, 0x28,
+	0x0a, 0xa2, 0x59, 0x71, 0x3b, 0x23, 0xcc, 0x57, 0x2c, 0x41, 0xf2, 0x1e, 0x3a, 0xeb, 0x72, 0xc9,
+	0xde, 0x26, 0x9c, 0xa3, 0x4a, 0x2d, 0x6f, 0xfd, 0x47, 0xc9, 0xe6, 0xde, 0x81, 0xff, 0x19, 0xfa,
+	0xb5, 0xe5, 0x19, 0xd5, 0x78, 0x4b, 0xef, 0x6a, 0xe7, 0x53, 0xe8, 0x6e, 0x54, 0x43, 0xfe, 0x52,
+	0xe5, 0x6e, 0xf7, 0xef, 0x16, 0x34, 0x23, 0xae, 0xa6, 0xe4, 0x13, 0x3c, 0xdc, 0xce, 0x81, 0xb8,
+	0x1b, 0x7e, 0x3b, 0x23, 0x72, 0x5e, 0xee, 0x21, 0xca, 0x61, 0x7a, 0x07, 0xe4, 0x03, 0x74, 0x37,
+	0xa6, 0x4c, 0x5e, 0x6c, 0xfc, 0xe7, 0xf7, 0xa4, 0x9c, 0x93, 0x3f, 0xc9, 0xb5, 0xdf, 0xe9, 0xb3,
+	0xab, 0x81, 0x41, 0xc6, 0xc5, 0x53, 0x91, 0x64, 0x72, 0x39, 0x1f, 0xa7, 0xb2, 0x7a, 0x0a, 0xae,
+	0xdb, 0xe6, 0xf7, 0xf5, 0xcf, 0x01, 0x00, 0x1b, 0xc7, 0x38, 0xae, 0x48, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,6 +21,8 @@ import (
 	lte_protos "magma/lte/cloud/go/services/lte/protos"
 	"magma/lte/cloud/go/services/lte/servicers"
 	lte_storage "magma/lte/cloud/go/services/lte/storage"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/swagger"
 	swagger_protos "magma/orc8r/cloud/go/obsidian/swagger/protos"
@@ -44,6 +46,9 @@ func main() {
 		glog.Fatalf("Error creating lte service: %s", err)
 	}
 
+	// The subscriberdb stream provider deserializes subscriber configs
+	encryption.MustConfigure(subscriberdb.MustGetServiceConfig().SecretsEncryption)
+
 	obsidian.AttachHandlers(srv.EchoServer, handlers.GetHandlers())
 
 	builder_protos.RegisterMconfigBuilderServer(srv.GrpcServer, servicers.NewBuilderServicer())
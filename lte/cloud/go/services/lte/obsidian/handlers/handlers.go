@@ -14,6 +14,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -80,6 +81,7 @@ const (
 	ManageGatewayConnectedEnodebsPath = ManageGatewayPath + obsidian.UrlSep + "connected_enodeb_serials"
 	ManageGatewayCellularPoolingPath  = ManageGatewayCellularPath + obsidian.UrlSep + "pooling"
 	ManageGatewayVPNConfigPath        = ManageGatewayPath + obsidian.UrlSep + "vpn"
+	PreviewGatewayMconfigPath         = ManageGatewayPath + obsidian.UrlSep + "mconfig" + obsidian.UrlSep + "preview"
 
 	Enodebs            = "enodebs"
 	ListEnodebsPath    = ManageNetworkPath + obsidian.UrlSep + Enodebs
@@ -158,6 +160,7 @@ func GetHandlers() []obsidian.Handler {
 	ret = append(ret, handlers.GetPartialGatewayHandlers(ManageGatewayVPNConfigPath, &orc8r_models.GatewayVpnConfigs{}, serdes.Entity)...)
 
 	ret = append(ret, handlers.GetGatewayDeviceHandlers(ManageGatewayDevicePath, serdes.Device)...)
+	ret = append(ret, handlers.GetPreviewGatewayMconfigHandler(PreviewGatewayMconfigPath, serdes.Network, serdes.Entity))
 
 	return ret
 }
@@ -175,7 +178,7 @@ func getGateway(c echo.Context) error {
 		return nerr
 	}
 
-	magmadModel, nerr := handlers.LoadMagmadGateway(c.Request().Context(), nid, gid)
+	magmadModel, version, nerr := handlers.LoadMagmadGatewayWithVersion(c.Request().Context(), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
@@ -218,6 +221,7 @@ func getGateway(c echo.Context) error {
 		}
 	}
 
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -238,6 +242,11 @@ func deleteGateway(c echo.Context) error {
 		return nerr
 	}
 
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+
 	var deletes storage.TKs
 	deletes = append(deletes, storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: gid})
 
@@ -252,7 +261,7 @@ func deleteGateway(c echo.Context) error {
 	}
 	deletes = append(deletes, gw.Associations.Filter(lte.APNResourceEntityType)...)
 
-	err = handlers.DeleteMagmadGateway(reqCtx, nid, gid, deletes)
+	err = handlers.DeleteMagmadGatewayIfMatch(reqCtx, nid, gid, expectedVersion, deletes)
 	if err != nil {
 		return makeErr(err)
 	}
@@ -334,7 +343,8 @@ func createEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "attached_gateway_id is a read-only property")
 	}
 
-	_, err := configurator.CreateEntity(
+	_, err := configurator.CreateEntityWithContext(
+		c.Request().Context(),
 		nid,
 		configurator.NetworkEntity{
 			Type:        lte.CellularEnodebEntityType,
@@ -395,7 +405,7 @@ func updateEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "serial in body must match serial in path")
 	}
 
-	_, err := configurator.UpdateEntity(nid, payload.ToEntityUpdateCriteria(), serdes.Entity)
+	_, err := configurator.UpdateEntityWithContext(c.Request().Context(), nid, payload.ToEntityUpdateCriteria(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -408,7 +418,7 @@ func deleteEnodeb(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(nid, lte.CellularEnodebEntityType, eid)
+	err := configurator.DeleteEntityWithContext(c.Request().Context(), nid, lte.CellularEnodebEntityType, eid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -456,7 +466,8 @@ func deleteConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(
+	_, err := configurator.UpdateEntityWithContext(
+		c.Request().Context(),
 		networkID,
 		(&lte_models.EnodebSerials{}).ToDeleteUpdateCriteria(networkID, gatewayID, enodebSerial),
 		serdes.Entity,
@@ -478,7 +489,8 @@ func addConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(
+	_, err := configurator.UpdateEntityWithContext(
+		c.Request().Context(),
 		networkID,
 		(&lte_models.EnodebSerials{}).ToCreateUpdateCriteria(networkID, gatewayID, enodebSerial),
 		serdes.Entity,
@@ -525,7 +537,8 @@ func createApn(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(
+	_, err := configurator.CreateEntityWithContext(
+		c.Request().Context(),
 		networkID,
 		configurator.NetworkEntity{
 			Type:   lte.APNEntityType,
@@ -581,7 +594,7 @@ func updateApnConfiguration(c echo.Context) error {
 		return obsidian.HttpError(errors.Wrap(err, "failed to load existing APN"), http.StatusInternalServerError)
 	}
 
-	err = configurator.CreateOrUpdateEntityConfig(networkID, lte.APNEntityType, apnName, payload.ApnConfiguration, serdes.Entity)
+	err = configurator.CreateOrUpdateEntityConfigWithContext(c.Request().Context(), networkID, lte.APNEntityType, apnName, payload.ApnConfiguration, serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -608,7 +621,7 @@ func deleteApnConfiguration(c echo.Context) error {
 	deletes = append(deletes, ent.ParentAssociations.MultiFilter(lte.APNResourceEntityType, lte.APNPolicyProfileEntityType)...)
 	deletes = append(deletes, ent.GetTypeAndKey())
 
-	err = configurator.DeleteEntities(networkID, deletes)
+	err = configurator.DeleteEntitiesWithContext(c.Request().Context(), networkID, deletes)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -625,7 +638,7 @@ func AddNetworkWideSubscriberRuleName(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := addToNetworkSubscriberConfig(networkID, params[0], "")
+	err := addToNetworkSubscriberConfig(c.Request().Context(), networkID, params[0], "")
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "Failed to update config"), http.StatusInternalServerError)
 	}
@@ -641,7 +654,7 @@ func AddNetworkWideSubscriberBaseName(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := addToNetworkSubscriberConfig(networkID, "", params[0])
+	err := addToNetworkSubscriberConfig(c.Request().Context(), networkID, "", params[0])
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "Failed to update config"), http.StatusInternalServerError)
 	}
@@ -657,7 +670,7 @@ func RemoveNetworkWideSubscriberRuleName(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := removeFromNetworkSubscriberConfig(networkID, params[0], "")
+	err := removeFromNetworkSubscriberConfig(c.Request().Context(), networkID, params[0], "")
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "Failed to update config"), http.StatusInternalServerError)
 	}
@@ -673,14 +686,14 @@ func RemoveNetworkWideSubscriberBaseName(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := removeFromNetworkSubscriberConfig(networkID, "", params[0])
+	err := removeFromNetworkSubscriberConfig(c.Request().Context(), networkID, "", params[0])
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "Failed to update config"), http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
-func addToNetworkSubscriberConfig(networkID, ruleName, baseName string) error {
+func addToNetworkSubscriberConfig(ctx context.Context, networkID, ruleName, baseName string) error {
 	network, err := configurator.LoadNetwork(networkID, false, true, serdes.Network)
 	if err != nil {
 		return err
@@ -717,10 +730,10 @@ func addToNetworkSubscriberConfig(networkID, ruleName, baseName string) error {
 			subscriberConfig.NetworkWideBaseNames = append(subscriberConfig.NetworkWideBaseNames, policydb_models.BaseName(baseName))
 		}
 	}
-	return configurator.UpdateNetworkConfig(networkID, lte.NetworkSubscriberConfigType, subscriberConfig, serdes.Network)
+	return configurator.UpdateNetworkConfigWithContext(ctx, networkID, lte.NetworkSubscriberConfigType, subscriberConfig, serdes.Network)
 }
 
-func removeFromNetworkSubscriberConfig(networkID, ruleName, baseName string) error {
+func removeFromNetworkSubscriberConfig(ctx context.Context, networkID, ruleName, baseName string) error {
 	network, err := configurator.LoadNetwork(networkID, false, true, serdes.Network)
 	if err != nil {
 		return err
@@ -741,7 +754,7 @@ func removeFromNetworkSubscriberConfig(networkID, ruleName, baseName string) err
 		subscriberConfig.NetworkWideBaseNames = funk.Filter(subscriberConfig.NetworkWideBaseNames,
 			func(b policydb_models.BaseName) bool { return string(b) != baseName }).([]policydb_models.BaseName)
 	}
-	return configurator.UpdateNetworkConfig(networkID, lte.NetworkSubscriberConfigType, subscriberConfig, serdes.Network)
+	return configurator.UpdateNetworkConfigWithContext(ctx, networkID, lte.NetworkSubscriberConfigType, subscriberConfig, serdes.Network)
 }
 
 func getNetworkAndApnName(c echo.Context) (string, string, *echo.HTTPError) {
@@ -785,7 +798,7 @@ func createGatewayPoolHandler(c echo.Context) error {
 	if err := gatewayPool.ValidateModel(); err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
-	_, err := configurator.CreateEntity(networkID, gatewayPool.ToEntity(), serdes.Entity)
+	_, err := configurator.CreateEntityWithContext(c.Request().Context(), networkID, gatewayPool.ToEntity(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -840,7 +853,7 @@ func updateGatewayPoolHandler(c echo.Context) error {
 	if !exists {
 		return echo.ErrNotFound
 	}
-	_, err = configurator.UpdateEntity(networkID, gatewayPool.ToEntityUpdateCriteria(), serdes.Entity)
+	_, err = configurator.UpdateEntityWithContext(c.Request().Context(), networkID, gatewayPool.ToEntityUpdateCriteria(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -872,7 +885,7 @@ func deleteGatewayPoolHandler(c echo.Context) error {
 		)
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
-	err = configurator.DeleteEntity(networkID, lte.CellularGatewayPoolEntityType, poolID)
+	err = configurator.DeleteEntityWithContext(c.Request().Context(), networkID, lte.CellularGatewayPoolEntityType, poolID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -888,6 +901,9 @@ func getNetworkIDAndGatewayPoolID(c echo.Context) (string, string, *echo.HTTPErr
 }
 
 func makeErr(err error) *echo.HTTPError {
+	if nerr, ok := err.(*echo.HTTPError); ok {
+		return nerr
+	}
 	if err == merrors.ErrNotFound {
 		return echo.ErrNotFound
 	}
@@ -1771,7 +1771,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "6",
-			Version:      0,
+			Version:      1,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -1809,7 +1809,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "6",
-			Version:      0,
+			Version:      2,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -1845,7 +1845,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "6",
-			Version:      0,
+			Version:      3,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -1897,7 +1897,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "6",
-			Version:      0,
+			Version:      4,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -1933,7 +1933,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "2",
-			Version:      0,
+			Version:      5,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -1976,7 +1976,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "10",
-			Version:      0,
+			Version:      5,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -2017,7 +2017,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "10",
-			Version:      0,
+			Version:      5,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -2057,7 +2057,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 			Type:      orc8r.MagmadGatewayType, Key: "g1",
 			Associations: []storage.TypeAndKey{{Type: lte.CellularGatewayEntityType, Key: "g1"}},
 			GraphID:      "10",
-			Version:      0,
+			Version:      6,
 		},
 		storage.TypeAndKey{Type: lte.CellularGatewayEntityType, Key: "g1"}: {
 			NetworkID: "n1",
@@ -27,6 +27,12 @@ const (
 	DefaultBackOffIntervalSecs = 360
 	// DefaultMaxExportRetries is the default maximum retries when exporting records
 	DefaultMaxExportRetries = 10
+	// DefaultSpoolDir is the default directory undelivered records are queued in
+	DefaultSpoolDir = "/var/opt/magma/nprobe/spool"
+	// DefaultSpoolMaxRecords is the default maximum number of queued records per destination
+	DefaultSpoolMaxRecords = 100000
+	// DefaultFileSinkDir is the default directory file exporters write records under
+	DefaultFileSinkDir = "/var/opt/magma/nprobe/records"
 )
 
 // Config represents the configuration provided to nprobe service
@@ -40,6 +46,10 @@ type Config struct {
 	SkipVerifyServer     bool   `yaml:"skip_verify_server"`
 	ExporterKeyFile      string `yaml:"exporter_key"`
 	ExporterCrtFile      string `yaml:"exporter_crt"`
+
+	SpoolDir        string `yaml:"spool_dir"`
+	SpoolMaxRecords uint32 `yaml:"spool_max_records"`
+	FileSinkDir     string `yaml:"file_sink_dir"`
 }
 
 // GetServiceConfig parses nprobe service config and returns Config
@@ -58,5 +68,14 @@ func GetServiceConfig() Config {
 	if serviceConfig.MaxExportRetries == 0 {
 		serviceConfig.MaxExportRetries = DefaultMaxExportRetries
 	}
+	if serviceConfig.SpoolDir == "" {
+		serviceConfig.SpoolDir = DefaultSpoolDir
+	}
+	if serviceConfig.SpoolMaxRecords == 0 {
+		serviceConfig.SpoolMaxRecords = DefaultSpoolMaxRecords
+	}
+	if serviceConfig.FileSinkDir == "" {
+		serviceConfig.FileSinkDir = DefaultFileSinkDir
+	}
 	return serviceConfig
 }
@@ -22,6 +22,15 @@ import (
 	"github.com/golang/glog"
 )
 
+// Exporter hands encoded records over to a single delivery function.
+type Exporter interface {
+	// Export delivers a single record
+	Export(record []byte) error
+
+	// Close releases any resource held by the exporter
+	Close() error
+}
+
 // RecordExporter sends records to a remote host over tcp/tls
 type RecordExporter struct {
 	tlsConfig  *tls.Config
@@ -71,6 +80,20 @@ func (c *RecordExporter) SendMessageWithRetries(message []byte, retryCount uint3
 	return err
 }
 
+// Export sends a single record
+func (c *RecordExporter) Export(record []byte) error {
+	return c.sendMessage(record)
+}
+
+// Close closes the current connection, if any
+func (c *RecordExporter) Close() error {
+	c.mutex.Lock()
+	conn := c.conn
+	c.mutex.Unlock()
+	c.destroyConnection(conn)
+	return nil
+}
+
 // sendMessage sends a single message on the connection. If the connection is
 // not established, this establishes it. If the message sending fails, the
 // connection is closed
@@ -82,7 +105,7 @@ func (c *RecordExporter) sendMessage(message []byte) error {
 
 	// It's possible that the connection is closed here in contention for the
 	// connection. This is handled as an error and the sending can retry
-	err = c.conn.Send(message)
+	err = conn.Send(message)
 	if err != nil {
 		// write failed, close and cleanup connection
 		c.destroyConnection(conn)
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+)
+
+const (
+	fileSinkPrefix = "records"
+	fileSinkExt    = ".x2"
+)
+
+// FileExporter appends records to daily files in a local directory for
+// offline handover. Records are written back to back; each one is delimited
+// by the lengths carried in its ETSI TS 103 221-2 header.
+type FileExporter struct {
+	dir   string
+	file  *os.File
+	day   string
+	mutex sync.Mutex
+}
+
+// NewFileExporter creates a new file exporter writing to the directory name
+// under root, creating it if it doesn't exist. See ValidateFileSinkName.
+func NewFileExporter(root, name string) (*FileExporter, error) {
+	if len(root) == 0 {
+		return nil, fmt.Errorf("file sink root directory is not configured")
+	}
+	err := ValidateFileSinkName(name)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(root, filepath.Clean(name))
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{dir: dir}, nil
+}
+
+// ValidateFileSinkName checks that name is a relative path which can't escape
+// the root directory of the file sinks.
+func ValidateFileSinkName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("invalid file sink directory")
+	}
+	if filepath.IsAbs(name) || strings.HasPrefix(name, `\`) {
+		return fmt.Errorf("file sink directory %q must be a relative path", name)
+	}
+	for _, elem := range strings.FieldsFunc(name, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if elem == ".." {
+			return fmt.Errorf("file sink directory %q must not contain '..'", name)
+		}
+	}
+	return nil
+}
+
+// Export appends a single record to the file of the current day and syncs it
+// to disk
+func (f *FileExporter) Export(record []byte) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := f.getFile(clock.Now())
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(record)
+	if err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Close closes the current file, if any
+func (f *FileExporter) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// getFile returns the file records received at t are written to, rotating
+// the current one if the day changed.
+func (f *FileExporter) getFile(t time.Time) (*os.File, error) {
+	day := t.UTC().Format("20060102")
+	if f.file != nil && f.day == day {
+		return f.file, nil
+	}
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+
+	name := filepath.Join(f.dir, fmt.Sprintf("%s-%s%s", fileSinkPrefix, day, fileSinkExt))
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	f.file, f.day = file, day
+	return file, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nprobe_file")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	clock.SetAndFreezeClock(t, time.Date(2020, 3, 11, 23, 0, 0, 0, time.UTC))
+	defer clock.UnfreezeClock(t)
+
+	exp, err := NewFileExporter(dir, "sink")
+	assert.NoError(t, err)
+	assert.NoError(t, exp.Export([]byte("r1")))
+	assert.NoError(t, exp.Export([]byte("r2")))
+
+	// Records are written to a new file every day
+	clock.SetAndFreezeClock(t, time.Date(2020, 3, 12, 1, 0, 0, 0, time.UTC))
+	assert.NoError(t, exp.Export([]byte("r3")))
+	assert.NoError(t, exp.Close())
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "sink", "records-20200311.x2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "r1r2", string(content))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "sink", "records-20200312.x2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "r3", string(content))
+
+	_, err = NewFileExporter("", "sink")
+	assert.EqualError(t, err, "file sink root directory is not configured")
+	_, err = NewFileExporter(dir, "")
+	assert.EqualError(t, err, "invalid file sink directory")
+}
+
+func TestValidateFileSinkName(t *testing.T) {
+	assert.NoError(t, ValidateFileSinkName("sink"))
+	assert.NoError(t, ValidateFileSinkName("network1/sink"))
+	assert.NoError(t, ValidateFileSinkName("sink..old"))
+
+	assert.EqualError(t, ValidateFileSinkName("/etc"), `file sink directory "/etc" must be a relative path`)
+	assert.EqualError(t, ValidateFileSinkName(`\etc`), `file sink directory "\\etc" must be a relative path`)
+	assert.EqualError(t, ValidateFileSinkName(".."), `file sink directory ".." must not contain '..'`)
+	assert.EqualError(t, ValidateFileSinkName("sink/../../etc"), `file sink directory "sink/../../etc" must not contain '..'`)
+	assert.EqualError(t, ValidateFileSinkName(`sink\..\etc`), `file sink directory "sink\\..\\etc" must not contain '..'`)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+// QueuedExporter wraps an exporter with a persistent spool. Records that
+// can't be delivered are queued on disk and delivered in order once the
+// delivery function is reachable again.
+type QueuedExporter struct {
+	exporter   Exporter
+	spool      *Spool
+	maxRetries uint32
+	mutex      sync.Mutex
+}
+
+// NewQueuedExporter creates a new exporter delivering records through
+// exporter, with up to maxRetries attempts per record before spooling it.
+func NewQueuedExporter(exporter Exporter, spool *Spool, maxRetries uint32) *QueuedExporter {
+	if maxRetries == 0 {
+		maxRetries = 1
+	}
+	return &QueuedExporter{
+		exporter:   exporter,
+		spool:      spool,
+		maxRetries: maxRetries,
+	}
+}
+
+// Export delivers a record, or queues it if it can't be delivered. Records
+// are always delivered in order, so nothing is sent directly while the spool
+// isn't empty. An error is only returned if the record couldn't be queued.
+func (q *QueuedExporter) Export(record []byte) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.flush() == nil {
+		var err error
+		for i := 0; i < int(q.maxRetries); i++ {
+			err = q.exporter.Export(record)
+			if err == nil {
+				return nil
+			}
+		}
+		glog.Warningf("Failed to export record, spooling it: %v", err)
+	}
+	return q.spool.Push(record)
+}
+
+// Flush delivers the queued records, returning the first delivery error.
+func (q *QueuedExporter) Flush() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.flush()
+}
+
+// Pending returns the number of queued records
+func (q *QueuedExporter) Pending() int {
+	return q.spool.Len()
+}
+
+// Close closes the underlying exporter. Queued records are kept on disk.
+func (q *QueuedExporter) Close() error {
+	return q.exporter.Close()
+}
+
+// Drain makes a last attempt at delivering the queued records, then closes
+// the underlying exporter and deletes the spool. Records which still couldn't
+// be delivered are dropped; their number is returned.
+func (q *QueuedExporter) Drain() (int, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	err := q.flush()
+	if err != nil {
+		glog.V(2).Infof("Failed to deliver spooled records while draining: %v", err)
+	}
+	q.exporter.Close()
+	return q.spool.Remove()
+}
+
+func (q *QueuedExporter) flush() error {
+	if q.spool.Len() == 0 {
+		return nil
+	}
+	return q.spool.Flush(q.exporter)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueuedExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nprobe_queued")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	spool, err := NewSpool(dir, 2)
+	assert.NoError(t, err)
+	exp := &mockExporter{}
+	queued := NewQueuedExporter(exp, spool, 3)
+
+	assert.NoError(t, queued.Export([]byte("r1")))
+	assert.Equal(t, [][]byte{[]byte("r1")}, exp.records)
+	assert.Equal(t, 0, queued.Pending())
+
+	// Undeliverable records are spooled until the spool is full
+	exp.err = errors.New("unreachable")
+	assert.NoError(t, queued.Export([]byte("r2")))
+	assert.NoError(t, queued.Export([]byte("r3")))
+	assert.Error(t, queued.Export([]byte("r4")))
+	assert.Equal(t, 2, queued.Pending())
+	assert.EqualError(t, queued.Flush(), "unreachable")
+
+	// Spooled records are delivered first once reachable again
+	exp.err = nil
+	assert.NoError(t, queued.Export([]byte("r5")))
+	assert.Equal(t, [][]byte{[]byte("r1"), []byte("r2"), []byte("r3"), []byte("r5")}, exp.records)
+	assert.Equal(t, 0, queued.Pending())
+
+	assert.NoError(t, queued.Close())
+	assert.True(t, exp.closed)
+}
+
+func TestQueuedExporter_Drain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nprobe_queued")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	spoolDir := filepath.Join(dir, "spool")
+
+	spool, err := NewSpool(spoolDir, 0)
+	assert.NoError(t, err)
+	exp := &mockExporter{err: errors.New("unreachable")}
+	queued := NewQueuedExporter(exp, spool, 1)
+	assert.NoError(t, queued.Export([]byte("r1")))
+	assert.NoError(t, queued.Export([]byte("r2")))
+
+	// Queued records are delivered if possible
+	exp.err = nil
+	dropped, err := queued.Drain()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+	assert.Equal(t, [][]byte{[]byte("r1"), []byte("r2")}, exp.records)
+	assert.True(t, exp.closed)
+	_, err = os.Stat(spoolDir)
+	assert.True(t, os.IsNotExist(err))
+
+	// Otherwise they're dropped along with the spool
+	spool, err = NewSpool(spoolDir, 0)
+	assert.NoError(t, err)
+	exp = &mockExporter{err: errors.New("unreachable")}
+	queued = NewQueuedExporter(exp, spool, 1)
+	assert.NoError(t, queued.Export([]byte("r3")))
+	dropped, err = queued.Drain()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	_, err = os.Stat(spoolDir)
+	assert.True(t, os.IsNotExist(err))
+}
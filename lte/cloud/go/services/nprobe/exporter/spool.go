@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	spoolRecordExt = ".rec"
+	spoolTmpExt    = ".tmp"
+)
+
+// Spool is a persistent FIFO queue of records backed by a local directory.
+// Each record is stored in its own file named after its position in the
+// queue, so the queue survives service restarts.
+type Spool struct {
+	dir        string
+	maxRecords int
+	// sequence numbers of the queued records, in order
+	pending []uint64
+	next    uint64
+	mutex   sync.Mutex
+}
+
+// NewSpool opens the spool stored in dir, creating the directory if it
+// doesn't exist. Records left over by a previous run are kept in order.
+// maxRecords bounds the number of queued records, 0 means unbounded.
+func NewSpool(dir string, maxRecords int) (*Spool, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Spool{dir: dir, maxRecords: maxRecords}
+	for _, file := range files {
+		name := file.Name()
+		if strings.HasSuffix(name, spoolTmpExt) {
+			// partial write from a previous run
+			os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		if !strings.HasSuffix(name, spoolRecordExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolRecordExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.pending = append(s.pending, seq)
+	}
+	sort.Slice(s.pending, func(i, j int) bool { return s.pending[i] < s.pending[j] })
+	if len(s.pending) > 0 {
+		s.next = s.pending[len(s.pending)-1] + 1
+	}
+	return s, nil
+}
+
+// Len returns the number of queued records
+func (s *Spool) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.pending)
+}
+
+// Push appends a record at the end of the queue. The record is durable once
+// Push returns.
+func (s *Spool) Push(record []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.maxRecords > 0 && len(s.pending) >= s.maxRecords {
+		return fmt.Errorf("spool %s is full (%d records)", s.dir, s.maxRecords)
+	}
+
+	seq := s.next
+	tmp := s.path(seq) + spoolTmpExt
+	err := writeFileSync(tmp, record)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	err = os.Rename(tmp, s.path(seq))
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	s.pending = append(s.pending, seq)
+	s.next++
+	return nil
+}
+
+// Flush exports queued records in order, removing each of them once
+// exported. It stops at the first export failure and returns its error.
+func (s *Spool) Flush(exporter Exporter) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for len(s.pending) > 0 {
+		path := s.path(s.pending[0])
+		record, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		err = exporter.Export(record)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(path)
+		if err != nil {
+			return err
+		}
+		s.pending = s.pending[1:]
+	}
+	return nil
+}
+
+// Remove deletes the spool directory along with the records still queued,
+// returning how many records were dropped. The spool is empty afterwards.
+func (s *Spool) Remove() (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dropped := len(s.pending)
+	err := os.RemoveAll(s.dir)
+	if err != nil {
+		return 0, err
+	}
+	s.pending = nil
+	return dropped, nil
+}
+
+func (s *Spool) path(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolRecordExt))
+}
+
+func writeFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
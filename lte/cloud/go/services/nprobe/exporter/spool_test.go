@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockExporter records exported records, failing while err is set
+type mockExporter struct {
+	records [][]byte
+	err     error
+	closed  bool
+}
+
+func (m *mockExporter) Export(record []byte) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *mockExporter) Close() error {
+	m.closed = true
+	return nil
+}
+
+func TestSpool(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nprobe_spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	spool, err := NewSpool(dir, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, spool.Len())
+
+	assert.NoError(t, spool.Push([]byte("r1")))
+	assert.NoError(t, spool.Push([]byte("r2")))
+	assert.NoError(t, spool.Push([]byte("r3")))
+	assert.EqualError(t, spool.Push([]byte("r4")), "spool "+dir+" is full (3 records)")
+	assert.Equal(t, 3, spool.Len())
+
+	// Failed flush keeps all records
+	exp := &mockExporter{err: errors.New("unreachable")}
+	assert.EqualError(t, spool.Flush(exp), "unreachable")
+	assert.Equal(t, 3, spool.Len())
+
+	// Records survive a restart, partial writes are dropped
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "00000000000000000003.rec.tmp"), []byte("partial"), 0600))
+	spool, err = NewSpool(dir, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, spool.Len())
+	assert.NoError(t, spool.Push([]byte("r4")))
+
+	exp.err = nil
+	assert.NoError(t, spool.Flush(exp))
+	assert.Equal(t, [][]byte{[]byte("r1"), []byte("r2"), []byte("r3"), []byte("r4")}, exp.records)
+	assert.Equal(t, 0, spool.Len())
+
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
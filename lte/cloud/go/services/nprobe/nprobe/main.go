@@ -72,9 +72,7 @@ func main() {
 		glog.Fatalf("Failed to create new TlsConfig: %v", err)
 	}
 
-	// Init records exporter
-	recordExporter := exporter.NewRecordExporter(serviceConfig.DeliveryFunctionAddr, tlsConfig)
-	nProbeManager, err := manager.NewNProbeManager(serviceConfig, nprobeBlobstore, recordExporter)
+	nProbeManager, err := manager.NewNProbeManager(serviceConfig, nprobeBlobstore, tlsConfig)
 	if err != nil {
 		glog.Fatalf("Failed to create new NProbeManager: %v", err)
 	}
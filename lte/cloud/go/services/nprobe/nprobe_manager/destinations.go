@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package npmanager
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/nprobe/exporter"
+	"magma/lte/cloud/go/services/nprobe/obsidian/models"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// defaultSpoolName is the spool of the exporter used for networks without
+	// any destination
+	defaultSpoolName = "default"
+	// networksSpoolName is the parent of the spools of network destinations
+	networksSpoolName = "networks"
+)
+
+// destinationExporter is the exporter of a single NetworkProbeDestination
+type destinationExporter struct {
+	details  models.NetworkProbeDestinationDetails
+	exporter *exporter.QueuedExporter
+}
+
+// getNetworkProbeDestinations retrieves the list of all destinations provisioned for a specific network
+func getNetworkProbeDestinations(networkID string) (map[string]*models.NetworkProbeDestinationDetails, error) {
+	ents, _, err := configurator.LoadAllEntitiesOfType(
+		networkID,
+		lte.NetworkProbeDestinationEntityType,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]*models.NetworkProbeDestinationDetails, len(ents))
+	for _, ent := range ents {
+		ret[ent.Key] = (&models.NetworkProbeDestination{}).FromBackendModels(ent).DestinationDetails
+	}
+	return ret, nil
+}
+
+// getNetworkExporters returns the exporters records of a network are fanned
+// out to, one per destination. Exporters are kept across runs and are only
+// recreated when their destination changes. Networks without any destination
+// fall back to the default exporter, if configured.
+func (np *NProbeManager) getNetworkExporters(networkID string) ([]*exporter.QueuedExporter, error) {
+	destinations, err := getNetworkProbeDestinations(networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := np.destinations[networkID]
+	updated := make(map[string]*destinationExporter, len(destinations))
+	for destinationID, details := range destinations {
+		dest, ok := current[destinationID]
+		if ok && dest.details == *details {
+			updated[destinationID] = dest
+			delete(current, destinationID)
+			continue
+		}
+		if ok {
+			// The spool is kept, its records are delivered by the new exporter
+			dest.exporter.Close()
+			delete(current, destinationID)
+		}
+
+		exp, err := np.newDestinationExporter(networkID, destinationID, details)
+		if err != nil {
+			glog.Errorf("Failed to create exporter for destination %s in network %s: %v", destinationID, networkID, err)
+			continue
+		}
+		updated[destinationID] = &destinationExporter{details: *details, exporter: exp}
+	}
+	// drain exporters of removed destinations
+	drainDestinationExporters(networkID, current)
+	np.destinations[networkID] = updated
+	np.removeOrphanedSpools(networkID, destinations)
+
+	ret := make([]*exporter.QueuedExporter, 0, len(updated))
+	for _, dest := range updated {
+		ret = append(ret, dest.exporter)
+	}
+	if len(destinations) == 0 && np.DefaultExporter != nil {
+		ret = append(ret, np.DefaultExporter)
+	}
+	return ret, nil
+}
+
+// removeStaleNetworks closes the exporters of networks that no longer exist
+func (np *NProbeManager) removeStaleNetworks(networks []string) {
+	active := make(map[string]bool, len(networks))
+	for _, networkID := range networks {
+		active[networkID] = true
+	}
+	for networkID, destinations := range np.destinations {
+		if !active[networkID] {
+			drainDestinationExporters(networkID, destinations)
+			delete(np.destinations, networkID)
+		}
+	}
+
+	networksDir, err := np.getSpoolDir(networksSpoolName)
+	if err != nil {
+		return
+	}
+	for _, networkID := range listSpoolDirs(networksDir) {
+		if !active[networkID] {
+			glog.Warningf("Removing spooled records of deleted network %s", networkID)
+			os.RemoveAll(filepath.Join(networksDir, networkID))
+		}
+	}
+}
+
+// removeOrphanedSpools deletes the spools of destinations which were removed
+// while the service wasn't running. Their records can't be delivered anymore.
+func (np *NProbeManager) removeOrphanedSpools(
+	networkID string,
+	destinations map[string]*models.NetworkProbeDestinationDetails,
+) {
+	networkDir, err := np.getSpoolDir(networksSpoolName, networkID)
+	if err != nil {
+		return
+	}
+	for _, destinationID := range listSpoolDirs(networkDir) {
+		if _, ok := destinations[destinationID]; !ok {
+			glog.Warningf("Removing spooled records of deleted destination %s in network %s", destinationID, networkID)
+			os.RemoveAll(filepath.Join(networkDir, destinationID))
+		}
+	}
+}
+
+// newDestinationExporter creates a spooled exporter for a destination
+func (np *NProbeManager) newDestinationExporter(
+	networkID, destinationID string,
+	details *models.NetworkProbeDestinationDetails,
+) (*exporter.QueuedExporter, error) {
+	spoolDir, err := np.getSpoolDir(networksSpoolName, networkID, destinationID)
+	if err != nil {
+		return nil, err
+	}
+	spool, err := exporter.NewSpool(spoolDir, int(np.SpoolMaxRecords))
+	if err != nil {
+		return nil, err
+	}
+
+	var exp exporter.Exporter
+	switch details.ExporterType {
+	case "", models.NetworkProbeDestinationDetailsExporterTypeX2TLS:
+		exp = exporter.NewRecordExporter(details.DeliveryAddress, np.tlsConfig)
+	case models.NetworkProbeDestinationDetailsExporterTypeFile:
+		exp, err = exporter.NewFileExporter(np.FileSinkDir, details.DeliveryAddress)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported exporter type %s", details.ExporterType)
+	}
+	return exporter.NewQueuedExporter(exp, spool, np.MaxExportRetries), nil
+}
+
+// getSpoolDir returns the directory undelivered records are queued in
+func (np *NProbeManager) getSpoolDir(elems ...string) (string, error) {
+	for _, elem := range elems {
+		if elem == "" || elem == "." || elem == ".." || strings.ContainsAny(elem, `/\`) {
+			return "", fmt.Errorf("invalid spool directory name %q", elem)
+		}
+	}
+	return filepath.Join(append([]string{np.SpoolDir}, elems...)...), nil
+}
+
+// drainDestinationExporters closes the exporters of removed destinations,
+// delivering what they can of their spooled records and deleting the rest.
+func drainDestinationExporters(networkID string, destinations map[string]*destinationExporter) {
+	for destinationID, dest := range destinations {
+		dropped, err := dest.exporter.Drain()
+		if err != nil {
+			glog.Errorf("Failed to remove spool of destination %s in network %s: %v", destinationID, networkID, err)
+			continue
+		}
+		if dropped > 0 {
+			glog.Warningf(
+				"Dropped %d undelivered records of removed destination %s in network %s",
+				dropped, destinationID, networkID)
+		}
+	}
+}
+
+// listSpoolDirs returns the names of the subdirectories of dir
+func listSpoolDirs(dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var ret []string
+	for _, file := range files {
+		if file.IsDir() {
+			ret = append(ret, file.Name())
+		}
+	}
+	return ret
+}
@@ -15,6 +15,7 @@ package npmanager
 
 import (
 	"context"
+	"crypto/tls"
 	"time"
 
 	"magma/lte/cloud/go/lte"
@@ -40,32 +41,59 @@ const (
 
 // NProbeManager provides the main functionality for the nprobe
 // service. It collects ES events, encode records and export
-// them to a remote collector server.
+// them to the remote collectors of each network probe destination.
 type NProbeManager struct {
 	ElasticClient    *elastic.Client
 	Storage          storage.NProbeStorage
-	Exporter         *exporter.RecordExporter
+	DefaultExporter  *exporter.QueuedExporter
 	OperatorID       uint32
 	MaxExportRetries uint32
+	SpoolDir         string
+	SpoolMaxRecords  uint32
+	FileSinkDir      string
+
+	tlsConfig *tls.Config
+	// exporters of each network, keyed by network ID then destination ID
+	destinations map[string]map[string]*destinationExporter
 }
 
-// NewNProbeManager creates and returns a new nprobe manager
+// NewNProbeManager creates and returns a new nprobe manager. Records of networks
+// without any destination are sent to the configured delivery function
+// address, if any.
 func NewNProbeManager(
 	config nprobe.Config,
 	storage storage.NProbeStorage,
-	exporter *exporter.RecordExporter,
+	tlsConfig *tls.Config,
 ) (*NProbeManager, error) {
 	client, err := eventdC.GetElasticClient()
 	if err != nil {
 		return nil, err
 	}
-	return &NProbeManager{
+	np := &NProbeManager{
 		ElasticClient:    client,
 		Storage:          storage,
-		Exporter:         exporter,
 		OperatorID:       config.OperatorID,
 		MaxExportRetries: config.MaxExportRetries,
-	}, nil
+		SpoolDir:         config.SpoolDir,
+		SpoolMaxRecords:  config.SpoolMaxRecords,
+		FileSinkDir:      config.FileSinkDir,
+		tlsConfig:        tlsConfig,
+		destinations:     map[string]map[string]*destinationExporter{},
+	}
+
+	if len(config.DeliveryFunctionAddr) != 0 {
+		spoolDir, err := np.getSpoolDir(defaultSpoolName)
+		if err != nil {
+			return nil, err
+		}
+		spool, err := exporter.NewSpool(spoolDir, int(config.SpoolMaxRecords))
+		if err != nil {
+			return nil, err
+		}
+		recordExporter := exporter.NewRecordExporter(config.DeliveryFunctionAddr, tlsConfig)
+		np.DefaultExporter = exporter.NewQueuedExporter(recordExporter, spool, config.MaxExportRetries)
+	}
+	return np, nil
 }
 
 // getNetworkProbeTasks retrieves the list of all tasks provisioned for a specific network
@@ -127,8 +155,26 @@ func (np *NProbeManager) updateRecordState(
 	return np.Storage.StoreNProbeData(networkID, taskID, state)
 }
 
+// exportRecord fans a record out to all exporters. Records that can't be
+// delivered are spooled, so this only fails if a record couldn't be spooled.
+// In that case the record will be exported again on the next run, including
+// to the exporters that already accepted it.
+func exportRecord(record []byte, exporters []*exporter.QueuedExporter) error {
+	for _, exp := range exporters {
+		err := exp.Export(record)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processNProbeTask is the main function processing each task, managing state and exporting data
-func (np *NProbeManager) processNProbeTask(networkID string, task *models.NetworkProbeTask) error {
+func (np *NProbeManager) processNProbeTask(
+	networkID string,
+	task *models.NetworkProbeTask,
+	exporters []*exporter.QueuedExporter,
+) error {
 	taskID := string(task.TaskID)
 	state, err := np.Storage.GetNProbeData(networkID, taskID)
 	if err != nil {
@@ -151,7 +197,7 @@ func (np *NProbeManager) processNProbeTask(networkID string, task *models.Networ
 			continue
 		}
 
-		nerr = np.Exporter.SendMessageWithRetries(record, np.MaxExportRetries)
+		nerr = exportRecord(record, exporters)
 		if nerr != nil {
 			glog.Errorf("Failed to export record for targetID %s: %s\n", state.TargetID, nerr)
 			break
@@ -180,6 +226,7 @@ func (np *NProbeManager) ProcessNProbeTasks() error {
 		return err
 	}
 
+	np.removeStaleNetworks(networks)
 	for _, networkID := range networks {
 		tasks, err := getNetworkProbeTasks(networkID)
 		if err != nil {
@@ -187,8 +234,28 @@ func (np *NProbeManager) ProcessNProbeTasks() error {
 			continue
 		}
 
+		exporters, err := np.getNetworkExporters(networkID)
+		if err != nil {
+			glog.Errorf("Failed to retrieve nprobe destinations for network %s: %s", networkID, err)
+			continue
+		}
+		for _, exp := range exporters {
+			// deliver records spooled in previous runs
+			err = exp.Flush()
+			if err != nil {
+				glog.V(2).Infof("Failed to flush %d spooled records for network %s: %s", exp.Pending(), networkID, err)
+			}
+		}
+		if len(tasks) == 0 {
+			continue
+		}
+		if len(exporters) == 0 {
+			glog.Warningf("No nprobe destination for network %s, skipping its tasks", networkID)
+			continue
+		}
+
 		for _, task := range tasks {
-			err = np.processNProbeTask(networkID, task)
+			err = np.processNProbeTask(networkID, task, exporters)
 			if err != nil {
 				glog.Errorf("Failed to process events for targetID %s: %s\n", task.TaskDetails.TargetID, err)
 				return err
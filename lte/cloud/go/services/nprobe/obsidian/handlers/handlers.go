@@ -117,7 +117,8 @@ func getCreateNetworkProbeTaskHandlerFunc(storage storage.NProbeStorage) echo.Ha
 			return obsidian.HttpError(errors.Wrap(err, "failed to store NetworkProbeData"), http.StatusInternalServerError)
 		}
 
-		_, err := configurator.CreateEntity(
+		_, err := configurator.CreateEntityWithContext(
+			c.Request().Context(),
 			networkID,
 			configurator.NetworkEntity{
 				Type:   lte.NetworkProbeTaskEntityType,
@@ -171,7 +172,7 @@ func updateNetworkProbeTask(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, payload.ToEntityUpdateCriteria(), serdes.Entity)
+	_, err := configurator.UpdateEntityWithContext(c.Request().Context(), networkID, payload.ToEntityUpdateCriteria(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -188,7 +189,7 @@ func getDeleteNetworkProbeTaskHandlerFunc(storage storage.NProbeStorage) echo.Ha
 
 		networkID, taskID := values[0], values[1]
 		storage.DeleteNProbeData(networkID, taskID)
-		err := configurator.DeleteEntity(networkID, lte.NetworkProbeTaskEntityType, taskID)
+		err := configurator.DeleteEntityWithContext(c.Request().Context(), networkID, lte.NetworkProbeTaskEntityType, taskID)
 		if err != nil {
 			return obsidian.HttpError(err, http.StatusInternalServerError)
 		}
@@ -232,7 +233,8 @@ func createNetworkProbeDestination(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(
+	_, err := configurator.CreateEntityWithContext(
+		c.Request().Context(),
 		networkID,
 		configurator.NetworkEntity{
 			Type:   lte.NetworkProbeDestinationEntityType,
@@ -285,7 +287,7 @@ func updateNetworkProbeDestination(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, payload.ToEntityUpdateCriteria(), serdes.Entity)
+	_, err := configurator.UpdateEntityWithContext(c.Request().Context(), networkID, payload.ToEntityUpdateCriteria(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -300,7 +302,7 @@ func deleteNetworkProbeDestination(c echo.Context) error {
 	}
 
 	networkID, destinationID := values[0], values[1]
-	err := configurator.DeleteEntity(networkID, lte.NetworkProbeDestinationEntityType, destinationID)
+	err := configurator.DeleteEntityWithContext(c.Request().Context(), networkID, lte.NetworkProbeDestinationEntityType, destinationID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -410,6 +410,27 @@ func TestCreateNetworkProbeDestination(t *testing.T) {
 		GraphID:   "2",
 	}
 	assert.Equal(t, expected, actual)
+
+	// File sinks can't escape the file sink directory
+	payload = &models.NetworkProbeDestination{
+		DestinationID: "test2",
+		DestinationDetails: &models.NetworkProbeDestinationDetails{
+			DeliveryAddress: "../../etc",
+			DeliveryType:    "all",
+			ExporterType:    models.NetworkProbeDestinationDetailsExporterTypeFile,
+		},
+	}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            testURLRoot,
+		Payload:        payload,
+		Handler:        createNetworkProbeDestination,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  `file sink directory "../../etc" must not contain '..'`,
+	}
+	tests.RunUnitTest(t, e, tc)
 }
 
 func TestListNetworkProbeDestinations(t *testing.T) {
@@ -19,7 +19,7 @@ import (
 // swagger:model network_probe_destination_details
 type NetworkProbeDestinationDetails struct {
 
-	// delivery address
+	// host:port of the delivery function for the x2_tls exporter. For the file exporter, the directory records are written to, relative to the file sink directory of the nprobe service.
 	// Required: true
 	DeliveryAddress string `json:"delivery_address"`
 
@@ -27,6 +27,10 @@ type NetworkProbeDestinationDetails struct {
 	// Required: true
 	// Enum: [all events_only]
 	DeliveryType string `json:"delivery_type"`
+
+	// How records are handed over to this destination. Defaults to x2_tls.
+	// Enum: [x2_tls file]
+	ExporterType string `json:"exporter_type,omitempty"`
 }
 
 // Validate validates this network probe destination details
@@ -41,6 +45,10 @@ func (m *NetworkProbeDestinationDetails) Validate(formats strfmt.Registry) error
 		res = append(res, err)
 	}
 
+	if err := m.validateExporterType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -99,6 +107,49 @@ func (m *NetworkProbeDestinationDetails) validateDeliveryType(formats strfmt.Reg
 	return nil
 }
 
+var networkProbeDestinationDetailsTypeExporterTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["x2_tls","file"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		networkProbeDestinationDetailsTypeExporterTypePropEnum = append(networkProbeDestinationDetailsTypeExporterTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NetworkProbeDestinationDetailsExporterTypeX2TLS captures enum value "x2_tls"
+	NetworkProbeDestinationDetailsExporterTypeX2TLS string = "x2_tls"
+
+	// NetworkProbeDestinationDetailsExporterTypeFile captures enum value "file"
+	NetworkProbeDestinationDetailsExporterTypeFile string = "file"
+)
+
+// prop value enum
+func (m *NetworkProbeDestinationDetails) validateExporterTypeEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, networkProbeDestinationDetailsTypeExporterTypePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NetworkProbeDestinationDetails) validateExporterType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExporterType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateExporterTypeEnum("exporter_type", "body", m.ExporterType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NetworkProbeDestinationDetails) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -14,6 +14,8 @@
 package models
 
 import (
+	"magma/lte/cloud/go/services/nprobe/exporter"
+
 	strfmt "github.com/go-openapi/strfmt"
 )
 
@@ -22,5 +24,11 @@ func (m *NetworkProbeTask) ValidateModel() error {
 }
 
 func (m *NetworkProbeDestination) ValidateModel() error {
-	return m.Validate(strfmt.Default)
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if m.DestinationDetails.ExporterType == NetworkProbeDestinationDetailsExporterTypeFile {
+		return exporter.ValidateFileSinkName(m.DestinationDetails.DeliveryAddress)
+	}
+	return nil
 }
@@ -14,6 +14,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"sort"
 	"strings"
@@ -104,7 +105,7 @@ func CreateBaseName(c echo.Context) error {
 			writes = append(writes, w)
 		}
 	}
-	if err := configurator.WriteEntities(networkID, writes, serdes.Entity); err != nil {
+	if err := configurator.WriteEntitiesWithContext(c.Request().Context(), networkID, writes, serdes.Entity); err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to create base name"), http.StatusInternalServerError)
 	}
 
@@ -129,6 +130,7 @@ func GetBaseName(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
+	obsidian.SetETag(c, ret.Version)
 	return c.JSON(http.StatusOK, (&models.BaseNameRecord{}).FromEntity(ret))
 }
 
@@ -145,6 +147,10 @@ func UpdateBaseName(c echo.Context) error {
 	if string(bnr.Name) != baseName {
 		return obsidian.HttpError(errors.New("base name in body does not match URL param"), http.StatusBadRequest)
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 
 	// 404 if the entity doesn't exist
 	oldEnt, err := configurator.LoadEntity(
@@ -170,8 +176,10 @@ func UpdateBaseName(c echo.Context) error {
 	// 	- modify base name, with child assocs
 	//	- update parent assocs: subscriber
 
+	bnrUpdate := bnr.ToUpdateCriteria()
+	bnrUpdate.ExpectedVersion = expectedVersion
 	var writes []configurator.EntityWriteOperation
-	writes = append(writes, bnr.ToUpdateCriteria())
+	writes = append(writes, bnrUpdate)
 
 	remove, add := oldEnt.ParentAssociations.Difference(bnr.GetParentAssocs())
 	for _, tk := range remove.Filter(lte.SubscriberEntityType) {
@@ -191,7 +199,11 @@ func UpdateBaseName(c echo.Context) error {
 		writes = append(writes, w)
 	}
 
-	if err = configurator.WriteEntities(networkID, writes, serdes.Entity); err != nil {
+	err = configurator.WriteEntitiesWithContext(c.Request().Context(), networkID, writes, serdes.Entity)
+	if err == merrors.ErrVersionConflict {
+		return obsidian.VersionConflictHttpErr()
+	}
+	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to update base name"), http.StatusInternalServerError)
 	}
 
@@ -204,9 +216,14 @@ func DeleteBaseName(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.BaseNameEntityType, baseName)
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	err := deleteEntity(c.Request().Context(), networkID, lte.BaseNameEntityType, baseName, expectedVersion)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -286,7 +303,7 @@ func CreateRule(c echo.Context) error {
 		writes = append(writes, w)
 	}
 
-	if err := configurator.WriteEntities(networkID, writes, serdes.Entity); err != nil {
+	if err := configurator.WriteEntitiesWithContext(c.Request().Context(), networkID, writes, serdes.Entity); err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to create policy"), http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusCreated)
@@ -310,6 +327,7 @@ func GetRule(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
+	obsidian.SetETag(c, ent.Version)
 	return c.JSON(http.StatusOK, (&models.PolicyRule{}).FromEntity(ent))
 }
 
@@ -329,6 +347,10 @@ func UpdateRule(c echo.Context) error {
 	if ruleID != string(rule.ID) {
 		return obsidian.HttpError(errors.New("rule ID in body does not match URL param"), http.StatusBadRequest)
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 
 	updateToNewIPModel(rule.FlowList)
 
@@ -359,8 +381,10 @@ func UpdateRule(c echo.Context) error {
 	// 	- update parent assocs: subscriber
 	//	- update child assocs: policy_qos_profile
 
+	ruleUpdate := rule.ToEntityUpdateCriteria()
+	ruleUpdate.ExpectedVersion = expectedVersion
 	var writes []configurator.EntityWriteOperation
-	writes = append(writes, rule.ToEntityUpdateCriteria())
+	writes = append(writes, ruleUpdate)
 
 	remove, add := oldEnt.ParentAssociations.Difference(rule.GetParentAssocs())
 	for _, tk := range remove.Filter(lte.SubscriberEntityType) {
@@ -380,7 +404,11 @@ func UpdateRule(c echo.Context) error {
 		writes = append(writes, w)
 	}
 
-	if err = configurator.WriteEntities(networkID, writes, serdes.Entity); err != nil {
+	err = configurator.WriteEntitiesWithContext(c.Request().Context(), networkID, writes, serdes.Entity)
+	if err == merrors.ErrVersionConflict {
+		return obsidian.VersionConflictHttpErr()
+	}
+	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to update policy rule"), http.StatusInternalServerError)
 	}
 
@@ -393,9 +421,14 @@ func DeleteRule(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.PolicyRuleEntityType, ruleID)
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	err := deleteEntity(c.Request().Context(), networkID, lte.PolicyRuleEntityType, ruleID, expectedVersion)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 
 	return c.NoContent(http.StatusNoContent)
@@ -447,7 +480,7 @@ func createQoSProfile(c echo.Context) error {
 		return echo.ErrBadRequest
 	}
 
-	_, err = configurator.CreateEntity(networkID, profile.ToEntity(), serdes.Entity)
+	_, err = configurator.CreateEntityWithContext(c.Request().Context(), networkID, profile.ToEntity(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -460,13 +493,23 @@ func deleteQoSProfile(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.PolicyQoSProfileEntityType, profileID)
+	err := configurator.DeleteEntityWithContext(c.Request().Context(), networkID, lte.PolicyQoSProfileEntityType, profileID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+// deleteEntity deletes an entity. If expectedVersion is set, the entity is
+// only deleted if its current version matches.
+func deleteEntity(ctx context.Context, networkID, entityType, key string, expectedVersion *uint64) error {
+	if expectedVersion == nil {
+		return configurator.DeleteEntityWithContext(ctx, networkID, entityType, key)
+	}
+	del := configurator.EntityUpdateCriteria{Type: entityType, Key: key, DeleteEntity: true, ExpectedVersion: expectedVersion}
+	return configurator.WriteEntitiesWithContext(ctx, networkID, []configurator.EntityWriteOperation{del}, serdes.Entity)
+}
+
 func getNetworkAndParam(c echo.Context, paramName string) (string, string, *echo.HTTPError) {
 	vals, err := obsidian.GetParamValues(c, "network_id", paramName)
 	if err != nil {
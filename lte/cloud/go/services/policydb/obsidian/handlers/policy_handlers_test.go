@@ -1220,6 +1220,88 @@ func TestPolicyWithQoSProfile(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 }
 
+func TestPolicyRuleIfMatch(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	e := echo.New()
+
+	obsidianHandlers := handlers.GetHandlers()
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Type: lte.NetworkType}, serdes.Network)
+	assert.NoError(t, err)
+
+	getRule := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/policies/rules/:rule_id", obsidian.GET).HandlerFunc
+	updateRule := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/policies/rules/:rule_id", obsidian.PUT).HandlerFunc
+	deleteRule := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/policies/rules/:rule_id", obsidian.DELETE).HandlerFunc
+
+	rule := newTestPolicy("rule0")
+	ent, err := configurator.CreateEntity("n1", rule.ToEntity(), serdes.Entity)
+	assert.NoError(t, err)
+	version := ent.Version
+
+	// GET returns the rule's version as ETag
+	tc := tests.Test{
+		Method:          "GET",
+		URL:             "/magma/v1/networks/n1/policies/rules/rule0",
+		ParamNames:      []string{"network_id", "rule_id"},
+		ParamValues:     []string{"n1", "rule0"},
+		Handler:         getRule,
+		ExpectedStatus:  200,
+		ExpectedResult:  rule,
+		ExpectedHeaders: map[string]string{obsidian.ETagHeader: obsidian.VersionETag(version)},
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Stale PUT
+	rule.Priority = swag.Uint32(2)
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            "/magma/v1/networks/n1/policies/rules/rule0",
+		Payload:        rule,
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version + 1)},
+		ParamNames:     []string{"network_id", "rule_id"},
+		ParamValues:    []string{"n1", "rule0"},
+		Handler:        updateRule,
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	actual, err := configurator.LoadEntity("n1", lte.PolicyRuleEntityType, "rule0", configurator.FullEntityLoadCriteria(), serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, version, actual.Version)
+
+	// PUT at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	actual, err = configurator.LoadEntity("n1", lte.PolicyRuleEntityType, "rule0", configurator.FullEntityLoadCriteria(), serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, version+1, actual.Version)
+	assert.Equal(t, swag.Uint32(2), actual.Config.(*policyModels.PolicyRuleConfig).Priority)
+
+	// Stale DELETE
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            "/magma/v1/networks/n1/policies/rules/rule0",
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)},
+		ParamNames:     []string{"network_id", "rule_id"},
+		ParamValues:    []string{"n1", "rule0"},
+		Handler:        deleteRule,
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	exists, err := configurator.DoesEntityExist("n1", lte.PolicyRuleEntityType, "rule0")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// DELETE at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version + 1)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	exists, err = configurator.DoesEntityExist("n1", lte.PolicyRuleEntityType, "rule0")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 // config will be filled from the expected model
 func validatePolicy(t *testing.T, e *echo.Echo, getRule echo.HandlerFunc, expectedModel *policyModels.PolicyRule, expectedEnt configurator.NetworkEntity) {
 	expectedEnt.Config = getExpectedRuleConfig(expectedModel)
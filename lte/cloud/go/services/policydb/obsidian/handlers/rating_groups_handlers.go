@@ -69,7 +69,7 @@ func CreateRatingGroup(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(networkID, group.ToEntity(), serdes.Entity)
+	_, err := configurator.CreateEntityWithContext(c.Request().Context(), networkID, group.ToEntity(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -124,7 +124,7 @@ func UpdateRatingGroup(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	_, err = configurator.UpdateEntity(networkID, ratingGroup.ToEntityUpdateCriteria(groupID), serdes.Entity)
+	_, err = configurator.UpdateEntityWithContext(c.Request().Context(), networkID, ratingGroup.ToEntityUpdateCriteria(groupID), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -137,7 +137,7 @@ func DeleteRatingGroup(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.RatingGroupEntityType, ratingGroupID)
+	err := configurator.DeleteEntityWithContext(c.Request().Context(), networkID, lte.RatingGroupEntityType, ratingGroupID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
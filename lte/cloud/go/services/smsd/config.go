@@ -0,0 +1,37 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package smsd
+
+import (
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/services/smsd/webhook"
+	"magma/orc8r/lib/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+type Config struct {
+	// MOWebhook configures forwarding of mobile-originated SMS to an external
+	// application
+	MOWebhook webhook.Config `yaml:"moWebhook"`
+}
+
+func GetServiceConfig() Config {
+	var serviceConfig Config
+	_, _, err := config.GetStructuredServiceConfig(lte.ModuleName, ServiceName, &serviceConfig)
+	if err != nil {
+		glog.Fatalf("Failed parsing the smsd config file: %v ", err)
+	}
+	return serviceConfig
+}
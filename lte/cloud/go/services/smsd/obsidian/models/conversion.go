@@ -36,6 +36,34 @@ func (m *SmsMessage) FromProto(from *storage.SMS) *SmsMessage {
 	return m
 }
 
+func (m *MoSmsMessage) FromProto(from *storage.MOSMS) *MoSmsMessage {
+	m.Pk = from.Pk
+	m.Imsi = models.SubscriberID(from.Imsi)
+	m.Destination = from.Destination
+	m.AttemptCount = int64(from.AttemptCount)
+	m.Message = from.Message
+	m.ErrorStatus = from.ForwardingError
+
+	m.TimeReceived = tsToDT(from.ReceivedTime)
+	lastAttempt := tsToDT(from.LastForwardAttemptTime)
+	if lastAttempt != nil {
+		m.TimeLastAttempted = *lastAttempt
+	}
+
+	switch from.Status {
+	case storage.ForwardingStatus_PENDING:
+		m.Status = strPtr(MoSmsMessageStatusPending)
+	case storage.ForwardingStatus_FORWARDED:
+		m.Status = strPtr(MoSmsMessageStatusForwarded)
+	case storage.ForwardingStatus_FORWARDING_FAILED:
+		m.Status = strPtr(MoSmsMessageStatusFailed)
+	default:
+		m.Status = strPtr(MoSmsMessageStatusPending)
+	}
+
+	return m
+}
+
 func (m *MutableSmsMessage) ValidateModel() error {
 	return m.Validate(strfmt.Default)
 }
@@ -85,6 +85,16 @@ func (s *smsdServicer) ReportDelivery(ctx context.Context, request *lteProtos.Re
 		return ret, err
 	}
 
+	// Uplink messages are either mobile-originated SMSs or delivery reports
+	// for the messages we've sent
+	submit, err := s.serde.DecodeSubmit(request.Report.NasMessageContainer)
+	switch {
+	case err == nil:
+		return s.receiveSubmit(networkID, request.Report.Imsi, submit)
+	case err != sms_ll.ErrNotSubmit:
+		return ret, errors.Wrap(err, "failed to decode submit")
+	}
+
 	decoded, err := s.serde.DecodeDelivery(request.Report.NasMessageContainer)
 	if err != nil {
 		return ret, errors.Wrap(err, "failed to decode report")
@@ -106,3 +116,30 @@ func (s *smsdServicer) ReportDelivery(ctx context.Context, request *lteProtos.Re
 	}
 	return ret, nil
 }
+
+// receiveSubmit stores a segment of a mobile-originated SMS and returns the
+// RP-ACK to deliver back to the UE.
+func (s *smsdServicer) receiveSubmit(networkID string, imsi string, submit sms_ll.SMSSubmit) (*lteProtos.ReportDeliveryResponse, error) {
+	ret := &lteProtos.ReportDeliveryResponse{}
+	_, err := s.store.ReceiveMOSMSSegment(networkID, storage.MOSMSSegment{
+		Imsi:          imsi,
+		Destination:   submit.Destination,
+		ConcatRef:     uint32(submit.ConcatRef),
+		SegmentCount:  uint32(submit.SegmentCount),
+		SegmentNumber: uint32(submit.SegmentNumber),
+		Tpdu:          submit.TPDU,
+	})
+	if err != nil {
+		return ret, errors.Wrap(err, "failed to receive MO SMS")
+	}
+
+	ack, err := s.serde.EncodeSubmitAck(submit)
+	if err != nil {
+		return ret, errors.Wrap(err, "failed to encode RP-ACK")
+	}
+	ret.Messages = append(ret.Messages, &lteProtos.SMODownlinkUnitdata{
+		Imsi:                imsi,
+		NasMessageContainer: ack,
+	})
+	return ret, nil
+}
@@ -15,6 +15,9 @@ import (
 const (
 	SmsRootPath   = lteHandlers.ManageNetworkPath + obsidian.UrlSep + "sms"
 	SmsManagePath = SmsRootPath + obsidian.UrlSep + ":sms_pk"
+
+	MOSmsRootPath   = lteHandlers.ManageNetworkPath + obsidian.UrlSep + "mo_sms"
+	MOSmsManagePath = MOSmsRootPath + obsidian.UrlSep + ":sms_pk"
 )
 
 func NewRESTServicer(store storage.SMSStorage) *SMSDRestServicer {
@@ -31,6 +34,10 @@ func (s *SMSDRestServicer) GetHandlers() []obsidian.Handler {
 		{Path: SmsRootPath, Methods: obsidian.POST, HandlerFunc: s.createMessage},
 		{Path: SmsManagePath, Methods: obsidian.GET, HandlerFunc: s.getMessage},
 		{Path: SmsManagePath, Methods: obsidian.DELETE, HandlerFunc: s.deleteMessage},
+
+		{Path: MOSmsRootPath, Methods: obsidian.GET, HandlerFunc: s.listMOMessages},
+		{Path: MOSmsManagePath, Methods: obsidian.GET, HandlerFunc: s.getMOMessage},
+		{Path: MOSmsManagePath, Methods: obsidian.DELETE, HandlerFunc: s.deleteMOMessage},
 	}
 }
 
@@ -104,6 +111,54 @@ func (s *SMSDRestServicer) deleteMessage(c echo.Context) error {
 
 }
 
+func (s *SMSDRestServicer) listMOMessages(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	messages, err := s.store.GetMOSMSs(networkID, nil, nil, nil, nil)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	out := make([]*models.MoSmsMessage, 0, len(messages))
+	for _, msg := range messages {
+		out = append(out, (&models.MoSmsMessage{}).FromProto(msg))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *SMSDRestServicer) getMOMessage(c echo.Context) error {
+	networkID, pk, nerr := getNetworkAndSMSID(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	msgs, err := s.store.GetMOSMSs(networkID, []string{pk}, nil, nil, nil)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	if funk.IsEmpty(msgs) {
+		return echo.ErrNotFound
+	}
+
+	return c.JSON(http.StatusOK, (&models.MoSmsMessage{}).FromProto(msgs[0]))
+}
+
+func (s *SMSDRestServicer) deleteMOMessage(c echo.Context) error {
+	networkID, pk, nerr := getNetworkAndSMSID(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	err := s.store.DeleteMOSMSs(networkID, []string{pk})
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
 func getNetworkAndSMSID(c echo.Context) (string, string, *echo.HTTPError) {
 	vals, err := obsidian.GetParamValues(c, "network_id", "sms_pk")
 	if err != nil {
@@ -139,6 +139,9 @@ func TestSMSDServicer_ReportDelivery(t *testing.T) {
 	expFailed := map[string][]storage.SMSFailureReport{}
 	expNasContainer := []byte{0x1, 0x2}
 
+	// Delivery reports aren't submits
+	serde.On("DecodeSubmit", expNasContainer).Return(sms_ll.SMSSubmit{}, sms_ll.ErrNotSubmit)
+
 	// args are refs so modifications below will update the mock expectation
 	store.On("ReportDelivery", "n1", expDelivered, expFailed).
 		Return(nil).
@@ -188,6 +191,71 @@ func TestSMSDServicer_ReportDelivery(t *testing.T) {
 	store.AssertExpectations(t)
 }
 
+func TestSMSDServicer_ReportDelivery_MobileOriginated(t *testing.T) {
+	store := new(mocks.SMSStorage)
+	serde := new(mocks2.SMSSerde)
+	srv := servicers.NewSMSDServicer(store, serde)
+	ctx := getTestContext(context.Background())
+
+	// Happy path, segment is stored and acked
+	nasContainer := []byte{0x1, 0x2}
+	submit := sms_ll.SMSSubmit{
+		TransactionID: 1,
+		Reference:     0x20,
+		Destination:   "+123",
+		Message:       "hello",
+		TPDU:          []byte{0x3, 0x4},
+		ConcatRef:     2,
+		SegmentCount:  3,
+		SegmentNumber: 1,
+	}
+	expSegment := storage.MOSMSSegment{
+		Imsi:          "IMSI1",
+		Destination:   "+123",
+		ConcatRef:     2,
+		SegmentCount:  3,
+		SegmentNumber: 1,
+		Tpdu:          []byte{0x3, 0x4},
+	}
+	serde.On("DecodeSubmit", nasContainer).Return(submit, nil)
+	store.On("ReceiveMOSMSSegment", "n1", expSegment).Return("", nil).Once()
+	serde.On("EncodeSubmitAck", submit).Return([]byte{0x5, 0x6}, nil).Once()
+	actual, err := srv.ReportDelivery(ctx, &protos.ReportDeliveryRequest{Report: &protos.SMOUplinkUnitdata{
+		Imsi:                "IMSI1",
+		NasMessageContainer: nasContainer,
+	}})
+	assert.NoError(t, err)
+	expected := &protos.ReportDeliveryResponse{
+		Messages: []*protos.SMODownlinkUnitdata{
+			{
+				Imsi:                "IMSI1",
+				NasMessageContainer: []byte{0x5, 0x6},
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	// storage error, nothing is acked
+	store.On("ReceiveMOSMSSegment", "n1", expSegment).Return("", errors.New("store")).Once()
+	actual, err = srv.ReportDelivery(ctx, &protos.ReportDeliveryRequest{Report: &protos.SMOUplinkUnitdata{
+		Imsi:                "IMSI1",
+		NasMessageContainer: nasContainer,
+	}})
+	assert.EqualError(t, err, "failed to receive MO SMS: store")
+	assert.Empty(t, actual.Messages)
+
+	// serde error
+	serde.On("DecodeSubmit", []byte{0x7}).Return(sms_ll.SMSSubmit{}, errors.New("serde")).Once()
+	_, err = srv.ReportDelivery(ctx, &protos.ReportDeliveryRequest{Report: &protos.SMOUplinkUnitdata{
+		Imsi:                "IMSI1",
+		NasMessageContainer: []byte{0x7},
+	}})
+	assert.EqualError(t, err, "failed to decode submit: serde")
+
+	serde.AssertExpectations(t)
+	store.AssertExpectations(t)
+}
+
 func tsProto(t *testing.T, ti time.Time) *timestamp.Timestamp {
 	ret, err := ptypes.TimestampProto(ti)
 	assert.NoError(t, err)
@@ -19,6 +19,7 @@ import (
 	"magma/lte/cloud/go/services/smsd"
 	"magma/lte/cloud/go/services/smsd/servicers"
 	storage2 "magma/lte/cloud/go/services/smsd/storage"
+	"magma/lte/cloud/go/services/smsd/webhook"
 	"magma/lte/cloud/go/sms_ll"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/swagger"
@@ -41,7 +42,7 @@ func main() {
 	if err != nil {
 		glog.Fatalf("error opening db conn: %v", err)
 	}
-	store := storage2.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage2.DefaultSMSReferenceCounter{}, &storage.UUIDGenerator{})
+	store := storage2.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &storage2.DefaultSMSReferenceCounter{}, &storage2.DefaultSMSReassembler{}, &storage.UUIDGenerator{})
 	err = store.Init()
 	if err != nil {
 		glog.Fatalf("error initializing smsd storage: %s", err)
@@ -51,6 +52,11 @@ func main() {
 	obsidian.AttachHandlers(srv.EchoServer, restServicer.GetHandlers())
 	protos.RegisterSmsDServer(srv.GrpcServer, servicers.NewSMSDServicer(store, &sms_ll.DefaultSMSSerde{}))
 
+	webhookConfig := smsd.GetServiceConfig().MOWebhook
+	if webhookConfig.URL != "" {
+		go webhook.NewForwarder(store, webhookConfig).Run(webhookConfig.GetInterval())
+	}
+
 	swagger_protos.RegisterSwaggerSpecServer(srv.GrpcServer, swagger.NewSpecServicerFromFile(smsd.ServiceName))
 
 	err = srv.Run()
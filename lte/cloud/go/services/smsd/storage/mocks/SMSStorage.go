@@ -36,6 +36,20 @@ func (_m *SMSStorage) CreateSMS(networkID string, sms storage.MutableSMS) (strin
 	return r0, r1
 }
 
+// DeleteMOSMSs provides a mock function with given fields: networkID, pks
+func (_m *SMSStorage) DeleteMOSMSs(networkID string, pks []string) error {
+	ret := _m.Called(networkID, pks)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(networkID, pks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSMSs provides a mock function with given fields: networkID, pks
 func (_m *SMSStorage) DeleteSMSs(networkID string, pks []string) error {
 	ret := _m.Called(networkID, pks)
@@ -50,6 +64,52 @@ func (_m *SMSStorage) DeleteSMSs(networkID string, pks []string) error {
 	return r0
 }
 
+// GetMOSMSs provides a mock function with given fields: networkID, pks, imsis, startTime, endTime
+func (_m *SMSStorage) GetMOSMSs(networkID string, pks []string, imsis []string, startTime *time.Time, endTime *time.Time) ([]*storage.MOSMS, error) {
+	ret := _m.Called(networkID, pks, imsis, startTime, endTime)
+
+	var r0 []*storage.MOSMS
+	if rf, ok := ret.Get(0).(func(string, []string, []string, *time.Time, *time.Time) []*storage.MOSMS); ok {
+		r0 = rf(networkID, pks, imsis, startTime, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*storage.MOSMS)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string, []string, *time.Time, *time.Time) error); ok {
+		r1 = rf(networkID, pks, imsis, startTime, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMOSMSsToForward provides a mock function with given fields: limit
+func (_m *SMSStorage) GetMOSMSsToForward(limit uint64) ([]*storage.MOSMS, error) {
+	ret := _m.Called(limit)
+
+	var r0 []*storage.MOSMS
+	if rf, ok := ret.Get(0).(func(uint64) []*storage.MOSMS); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*storage.MOSMS)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSMSs provides a mock function with given fields: networkID, pks, imsis, onlyWaiting, startTime, endTime
 func (_m *SMSStorage) GetSMSs(networkID string, pks []string, imsis []string, onlyWaiting bool, startTime *time.Time, endTime *time.Time) ([]*storage.SMS, error) {
 	ret := _m.Called(networkID, pks, imsis, onlyWaiting, startTime, endTime)
@@ -110,6 +170,27 @@ func (_m *SMSStorage) Init() error {
 	return r0
 }
 
+// ReceiveMOSMSSegment provides a mock function with given fields: networkID, segment
+func (_m *SMSStorage) ReceiveMOSMSSegment(networkID string, segment storage.MOSMSSegment) (string, error) {
+	ret := _m.Called(networkID, segment)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, storage.MOSMSSegment) string); ok {
+		r0 = rf(networkID, segment)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, storage.MOSMSSegment) error); ok {
+		r1 = rf(networkID, segment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReportDelivery provides a mock function with given fields: networkID, deliveredMessages, failedMessages
 func (_m *SMSStorage) ReportDelivery(networkID string, deliveredMessages map[string][]byte, failedMessages map[string][]storage.SMSFailureReport) error {
 	ret := _m.Called(networkID, deliveredMessages, failedMessages)
@@ -123,3 +204,17 @@ func (_m *SMSStorage) ReportDelivery(networkID string, deliveredMessages map[str
 
 	return r0
 }
+
+// ReportMOForwarding provides a mock function with given fields: networkID, forwardedPks, failedMessages
+func (_m *SMSStorage) ReportMOForwarding(networkID string, forwardedPks []string, failedMessages map[string]string) error {
+	ret := _m.Called(networkID, forwardedPks, failedMessages)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, map[string]string) error); ok {
+		r0 = rf(networkID, forwardedPks, failedMessages)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
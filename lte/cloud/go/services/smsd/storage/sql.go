@@ -60,11 +60,12 @@ const (
 
 var allCols = []string{pkCol, deliveredCol, imsiCol, sourceCol, messageCol, createdCol, errorCol, attemptsCol, refCol, refCreatedCol}
 
-func NewSQLSMSStorage(db *sql.DB, sqlBuilder sqorc.StatementBuilder, counter SMSReferenceCounter, idGenerator storage.IDGenerator) SMSStorage {
+func NewSQLSMSStorage(db *sql.DB, sqlBuilder sqorc.StatementBuilder, counter SMSReferenceCounter, reassembler SMSReassembler, idGenerator storage.IDGenerator) SMSStorage {
 	return &sqlSMSStorage{
 		db:          db,
 		builder:     sqlBuilder,
 		counter:     counter,
+		reassembler: reassembler,
 		idGenerator: idGenerator,
 	}
 }
@@ -73,6 +74,7 @@ type sqlSMSStorage struct {
 	db          *sql.DB
 	builder     sqorc.StatementBuilder
 	counter     SMSReferenceCounter
+	reassembler SMSReassembler
 	idGenerator storage.IDGenerator
 }
 
@@ -137,6 +139,7 @@ func (s *sqlSMSStorage) Init() (err error) {
 		return
 	}
 
+	err = initMOTables(tx, s.builder)
 	return
 }
 
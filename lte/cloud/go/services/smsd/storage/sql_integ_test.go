@@ -14,6 +14,7 @@
 package storage_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
@@ -33,7 +34,7 @@ func TestSQLSMSStorage_Integration(t *testing.T) {
 		t.Fatalf("Could not initialize sqlite DB: %s", err)
 	}
 	refCounter := &mockRefCounter{numRefs: 1}
-	store := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), refCounter, &mockIDGenerator{})
+	store := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), refCounter, &mockReassembler{}, &mockIDGenerator{})
 
 	err = store.Init()
 	if err != nil {
@@ -447,6 +448,167 @@ func TestSQLSMSStorage_Integration(t *testing.T) {
 	assert.Empty(t, actualMessages)
 }
 
+func TestSQLSMSStorage_MOIntegration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign.keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	store := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &mockRefCounter{numRefs: 1}, &mockReassembler{}, &mockIDGenerator{})
+
+	err = store.Init()
+	if err != nil {
+		t.Fatalf("Could not initialize smsd tables: %s", err)
+	}
+
+	var frozenClock int64 = 1000
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+	defer clock.UnfreezeClock(t)
+
+	actualMessages, err := store.GetMOSMSs("n1", nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actualMessages)
+
+	// Single-segment message is stored immediately
+	pk, err := store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI1",
+		Destination:   "+15551234",
+		SegmentCount:  1,
+		SegmentNumber: 1,
+		Tpdu:          []byte("hello"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", pk)
+
+	// Out-of-order segments of a concatenated message are stored once the
+	// last one arrives
+	pk, err = store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI2",
+		Destination:   "+15555678",
+		ConcatRef:     42,
+		SegmentCount:  2,
+		SegmentNumber: 2,
+		Tpdu:          []byte(" world"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", pk)
+	// Same ref from a different IMSI doesn't complete the message
+	pk, err = store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI3",
+		Destination:   "+15555678",
+		ConcatRef:     42,
+		SegmentCount:  2,
+		SegmentNumber: 1,
+		Tpdu:          []byte("goodbye"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", pk)
+	pk, err = store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI2",
+		Destination:   "+15555678",
+		ConcatRef:     42,
+		SegmentCount:  2,
+		SegmentNumber: 1,
+		Tpdu:          []byte("hello"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", pk)
+
+	_, err = store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI2",
+		Destination:   "+15555678",
+		SegmentCount:  2,
+		SegmentNumber: 3,
+		Tpdu:          []byte("bad"),
+	})
+	assert.EqualError(t, err, "invalid segment number 3 of 2")
+
+	expectedMessages := []*storage.MOSMS{
+		{
+			Pk:           "1",
+			NetworkID:    "n1",
+			Status:       storage.ForwardingStatus_PENDING,
+			Imsi:         "IMSI1",
+			Destination:  "+15551234",
+			Message:      "hello",
+			ReceivedTime: timestampProto(t, frozenClock),
+		},
+		{
+			Pk:           "2",
+			NetworkID:    "n1",
+			Status:       storage.ForwardingStatus_PENDING,
+			Imsi:         "IMSI2",
+			Destination:  "+15555678",
+			Message:      "hello world",
+			ReceivedTime: timestampProto(t, frozenClock),
+		},
+	}
+	actualMessages, err = store.GetMOSMSs("n1", nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMessages, actualMessages)
+
+	actualMessages, err = store.GetMOSMSs("n1", nil, []string{"IMSI2"}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMessages[1:], actualMessages)
+
+	actualMessages, err = store.GetMOSMSs("n2", nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actualMessages)
+
+	// Orphaned segments expire
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock+7200, 0))
+	pk, err = store.ReceiveMOSMSSegment("n1", storage.MOSMSSegment{
+		Imsi:          "IMSI3",
+		Destination:   "+15555678",
+		ConcatRef:     42,
+		SegmentCount:  2,
+		SegmentNumber: 2,
+		Tpdu:          []byte(" world"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", pk)
+	clock.SetAndFreezeClock(t, time.Unix(frozenClock, 0))
+
+	// Forwarding: 1 succeeds, 2 fails until it runs out of retries
+	actualMessages, err = store.GetMOSMSsToForward(10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMessages, actualMessages)
+
+	err = store.ReportMOForwarding("n1", []string{"1"}, map[string]string{"2": "connection refused"})
+	assert.NoError(t, err)
+	actualMessages, err = store.GetMOSMSsToForward(10)
+	assert.NoError(t, err)
+	assert.Len(t, actualMessages, 1)
+	assert.Equal(t, "2", actualMessages[0].Pk)
+	assert.Equal(t, "connection refused", actualMessages[0].ForwardingError)
+	assert.Equal(t, uint32(1), actualMessages[0].AttemptCount)
+
+	err = store.ReportMOForwarding("n1", nil, map[string]string{"2": "connection refused"})
+	assert.NoError(t, err)
+	err = store.ReportMOForwarding("n1", nil, map[string]string{"2": "connection refused"})
+	assert.NoError(t, err)
+	actualMessages, err = store.GetMOSMSsToForward(10)
+	assert.NoError(t, err)
+	assert.Empty(t, actualMessages)
+
+	expectedMessages[0].Status = storage.ForwardingStatus_FORWARDED
+	expectedMessages[0].AttemptCount = 1
+	expectedMessages[0].LastForwardAttemptTime = timestampProto(t, frozenClock)
+	expectedMessages[1].Status = storage.ForwardingStatus_FORWARDING_FAILED
+	expectedMessages[1].AttemptCount = 3
+	expectedMessages[1].LastForwardAttemptTime = timestampProto(t, frozenClock)
+	expectedMessages[1].ForwardingError = "connection refused"
+	actualMessages, err = store.GetMOSMSs("n1", nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMessages, actualMessages)
+
+	// Delete
+	err = store.DeleteMOSMSs("n1", []string{"1"})
+	assert.NoError(t, err)
+	actualMessages, err = store.GetMOSMSs("n1", nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMessages[1:], actualMessages)
+}
+
 type mockRefCounter struct {
 	numRefs uint16
 }
@@ -464,6 +626,12 @@ func (m *mockIDGenerator) New() string {
 	return fmt.Sprintf("%d", m.curID)
 }
 
+type mockReassembler struct{}
+
+func (m *mockReassembler) Reassemble(tpdus [][]byte) (string, error) {
+	return string(bytes.Join(tpdus, nil)), nil
+}
+
 func timestampProto(t *testing.T, unix int64) *timestamp.Timestamp {
 	ret, err := ptypes.TimestampProto(time.Unix(unix, 0))
 	assert.NoError(t, err)
@@ -0,0 +1,438 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	moTable   = "smsd_mo_messages"
+	moImsiIdx = "smsd_mo_sms_imsi_idx"
+
+	destinationCol = "destination"
+	receivedCol    = "time_received_sec"
+	forwardedCol   = "is_forwarded"
+	attemptedCol   = "time_attempted_sec"
+
+	segmentsTable   = "smsd_mo_segments"
+	concatRefCol    = "concat_ref"
+	segmentCountCol = "segment_count"
+	segmentNumCol   = "segment_num"
+	tpduCol         = "tpdu"
+)
+
+// How long we'll wait for the outstanding segments of a concatenated MO SMS
+// before dropping the received ones
+const moSegmentTimeout = time.Hour
+
+var allMOCols = []string{pkCol, nidCol, forwardedCol, imsiCol, destinationCol, messageCol, receivedCol, errorCol, attemptsCol, attemptedCol}
+
+func initMOTables(tx *sql.Tx, builder sqorc.StatementBuilder) error {
+	_, err := builder.CreateTable(moTable).
+		IfNotExists().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(pkCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(forwardedCol).Type(sqorc.ColumnTypeBool).NotNull().Default(false).EndColumn().
+		Column(imsiCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(destinationCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(messageCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(receivedCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(errorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(attemptsCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(attemptedCol).Type(sqorc.ColumnTypeInt).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create MO sms table")
+	}
+
+	// index on (nid, imsi)
+	_, err = builder.CreateIndex(moImsiIdx).
+		IfNotExists().
+		On(moTable).
+		Columns(nidCol, imsiCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create MO sms imsi index")
+	}
+
+	_, err = builder.CreateTable(segmentsTable).
+		IfNotExists().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(imsiCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(destinationCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(concatRefCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(segmentCountCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(segmentNumCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(tpduCol).Type(sqorc.ColumnTypeBytes).NotNull().EndColumn().
+		Column(receivedCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		PrimaryKey(nidCol, imsiCol, destinationCol, concatRefCol, segmentCountCol, segmentNumCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create MO sms segment table")
+	}
+	return nil
+}
+
+func (s *sqlSMSStorage) ReceiveMOSMSSegment(networkID string, segment MOSMSSegment) (string, error) {
+	if segment.SegmentCount > 1 && (segment.SegmentNumber < 1 || segment.SegmentNumber > segment.SegmentCount) {
+		return "", fmt.Errorf("invalid segment number %d of %d", segment.SegmentNumber, segment.SegmentCount)
+	}
+
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		timeReceived := clock.Now().Unix()
+		err := garbageCollectExpiredSegments(tx, s.builder, networkID, clock.Now().Add(-moSegmentTimeout).Unix())
+		if err != nil {
+			return "", err
+		}
+
+		// Easy case - a single-segment message doesn't need reassembly
+		if segment.SegmentCount <= 1 {
+			return s.createMOSMS(tx, networkID, segment, [][]byte{segment.Tpdu}, timeReceived)
+		}
+
+		err = persistSegment(tx, s.builder, networkID, segment, timeReceived)
+		if err != nil {
+			return "", err
+		}
+		tpdus, err := loadSegments(tx, s.builder, networkID, segment)
+		if err != nil {
+			return "", err
+		}
+		if len(tpdus) < int(segment.SegmentCount) {
+			return "", nil
+		}
+
+		pk, err := s.createMOSMS(tx, networkID, segment, tpdus, timeReceived)
+		if err != nil {
+			return "", err
+		}
+		err = deleteSegments(tx, s.builder, networkID, segment)
+		if err != nil {
+			return "", err
+		}
+		return pk, nil
+	}
+
+	iPK, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return "", err
+	}
+	return iPK.(string), nil
+}
+
+func (s *sqlSMSStorage) GetMOSMSs(networkID string, pks []string, imsis []string, startTime, endTime *time.Time) ([]*MOSMS, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		/*
+			SELECT * FROM smsd_mo_messages
+			[[ WHERE (network_id = {networkID} AND pk IN {pks} AND imsi IN {imsis} AND time_received_sec > ... AND time_received_sec < ... ]]
+		*/
+		builder := s.builder.Select(allMOCols...).
+			From(moTable).
+			Where(sq.Eq{nidCol: networkID}).
+			RunWith(tx)
+		if !funk.IsEmpty(pks) {
+			builder = builder.Where(sq.Eq{pkCol: pks})
+		}
+		if !funk.IsEmpty(imsis) {
+			builder = builder.Where(sq.Eq{imsiCol: imsis})
+		}
+		if startTime != nil {
+			builder = builder.Where(sq.Gt{receivedCol: startTime.Unix()})
+		}
+		if endTime != nil {
+			builder = builder.Where(sq.Lt{receivedCol: endTime.Unix()})
+		}
+
+		rows, err := builder.Query()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load MO messages")
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetMOSMSs")
+
+		return scanMOMessages(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return []*MOSMS{}, err
+	}
+
+	retCasted := ret.([]*MOSMS)
+	sort.Slice(retCasted, func(i, j int) bool { return retCasted[i].Pk < retCasted[j].Pk })
+	return retCasted, nil
+}
+
+func (s *sqlSMSStorage) GetMOSMSsToForward(limit uint64) ([]*MOSMS, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		/*
+			SELECT * FROM smsd_mo_messages
+			WHERE NOT is_forwarded AND num_attempts < 3
+			ORDER BY time_received_sec, pk
+			LIMIT {limit}
+		*/
+		rows, err := s.builder.Select(allMOCols...).
+			From(moTable).
+			Where(sq.And{
+				sq.Eq{forwardedCol: false},
+				sq.Lt{attemptsCol: maxRetries},
+			}).
+			OrderBy(receivedCol, pkCol).
+			Limit(limit).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load MO messages to forward")
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "GetMOSMSsToForward")
+
+		return scanMOMessages(rows)
+	}
+
+	ret, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]*MOSMS), nil
+}
+
+func (s *sqlSMSStorage) ReportMOForwarding(networkID string, forwardedPks []string, failedMessages map[string]string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		timeAttempted := clock.Now().Unix()
+		sc := sq.NewStmtCache(tx)
+		defer sqorc.ClearStatementCacheLogOnError(sc, "ReportMOForwarding")
+
+		if !funk.IsEmpty(forwardedPks) {
+			_, err := s.builder.Update(moTable).
+				Set(forwardedCol, true).
+				Set(errorCol, sql.NullString{Valid: false}).
+				Set(attemptsCol, sq.Expr(fmt.Sprintf("%s+1", attemptsCol))).
+				Set(attemptedCol, timeAttempted).
+				Where(sq.Eq{nidCol: networkID, pkCol: forwardedPks}).
+				RunWith(sc).
+				Exec()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to mark MO SMSs as forwarded")
+			}
+		}
+
+		for pk, errorMessage := range failedMessages {
+			_, err := s.builder.Update(moTable).
+				Set(errorCol, sql.NullString{Valid: true, String: errorMessage}).
+				Set(attemptsCol, sq.Expr(fmt.Sprintf("%s+1", attemptsCol))).
+				Set(attemptedCol, timeAttempted).
+				Where(sq.Eq{nidCol: networkID, pkCol: pk}).
+				RunWith(sc).
+				Exec()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to set forwarding error on MO SMS")
+			}
+		}
+		return nil, nil
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlSMSStorage) DeleteMOSMSs(networkID string, pks []string) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.Delete(moTable).
+			Where(sq.Eq{nidCol: networkID, pkCol: pks}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to delete MO SMSs")
+		}
+		return nil, nil
+	}
+
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+func (s *sqlSMSStorage) createMOSMS(tx *sql.Tx, networkID string, segment MOSMSSegment, tpdus [][]byte, timeReceived int64) (string, error) {
+	message, err := s.reassembler.Reassemble(tpdus)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to reassemble MO SMS")
+	}
+
+	pk := s.idGenerator.New()
+	_, err = s.builder.Insert(moTable).
+		Columns(pkCol, nidCol, imsiCol, destinationCol, messageCol, receivedCol).
+		Values(pk, networkID, segment.Imsi, segment.Destination, message, timeReceived).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create MO SMS")
+	}
+	return pk, nil
+}
+
+func garbageCollectExpiredSegments(tx *sql.Tx, builder sqorc.StatementBuilder, networkID string, timeoutSecs int64) error {
+	// DELETE FROM smsd_mo_segments WHERE network_id = {nid} AND time_received_sec < {timeout}
+	_, err := builder.Delete(segmentsTable).
+		Where(sq.And{
+			sq.Eq{nidCol: networkID},
+			sq.Lt{receivedCol: timeoutSecs},
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to garbage collect expired MO SMS segments")
+	}
+	return nil
+}
+
+func persistSegment(tx *sql.Tx, builder sqorc.StatementBuilder, networkID string, segment MOSMSSegment, timeReceived int64) error {
+	// INSERT INTO smsd_mo_segments (...) VALUES (...)
+	// ON CONFLICT (network_id, imsi, destination, concat_ref, segment_count, segment_num) DO UPDATE SET tpdu = ..., time_received_sec = ...
+	_, err := builder.Insert(segmentsTable).
+		Columns(nidCol, imsiCol, destinationCol, concatRefCol, segmentCountCol, segmentNumCol, tpduCol, receivedCol).
+		Values(networkID, segment.Imsi, segment.Destination, segment.ConcatRef, segment.SegmentCount, segment.SegmentNumber, segment.Tpdu, timeReceived).
+		OnConflict(
+			[]sqorc.UpsertValue{
+				{Column: tpduCol, Value: segment.Tpdu},
+				{Column: receivedCol, Value: timeReceived},
+			},
+			nidCol, imsiCol, destinationCol, concatRefCol, segmentCountCol, segmentNumCol,
+		).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to persist MO SMS segment")
+	}
+	return nil
+}
+
+// Returns the TPDUs of all received segments of the message that the segment
+// belongs to, ordered by segment number.
+func loadSegments(tx *sql.Tx, builder sqorc.StatementBuilder, networkID string, segment MOSMSSegment) ([][]byte, error) {
+	rows, err := builder.Select(tpduCol).
+		From(segmentsTable).
+		Where(segmentKey(networkID, segment)).
+		OrderBy(segmentNumCol).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load MO SMS segments")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "loadSegments")
+
+	var ret [][]byte
+	for rows.Next() {
+		var tpdu []byte
+		err = rows.Scan(&tpdu)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan MO SMS segment")
+		}
+		ret = append(ret, tpdu)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "sql rows err")
+	}
+	return ret, nil
+}
+
+func deleteSegments(tx *sql.Tx, builder sqorc.StatementBuilder, networkID string, segment MOSMSSegment) error {
+	_, err := builder.Delete(segmentsTable).
+		Where(segmentKey(networkID, segment)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to delete reassembled MO SMS segments")
+	}
+	return nil
+}
+
+func segmentKey(networkID string, segment MOSMSSegment) sq.Eq {
+	return sq.Eq{
+		nidCol:          networkID,
+		imsiCol:         segment.Imsi,
+		destinationCol:  segment.Destination,
+		concatRefCol:    segment.ConcatRef,
+		segmentCountCol: segment.SegmentCount,
+	}
+}
+
+func scanMOMessages(rows *sql.Rows) ([]*MOSMS, error) {
+	var ret []*MOSMS
+	for rows.Next() {
+		var pk, networkID, imsi, destination, message string
+		var errorMessage sql.NullString
+		var forwarded bool
+		var timeReceived, numAttempts int64
+		var timeAttempted sql.NullInt64
+
+		err := rows.Scan(&pk, &networkID, &forwarded, &imsi, &destination, &message, &timeReceived, &errorMessage, &numAttempts, &timeAttempted)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan MO sms row")
+		}
+
+		receivedTs, err := ptypes.TimestampProto(time.Unix(timeReceived, 0))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not validate received time for MO sms %s", pk)
+		}
+
+		var attemptedTs *timestamp.Timestamp
+		if timeAttempted.Valid {
+			attemptedTs, err = ptypes.TimestampProto(time.Unix(timeAttempted.Int64, 0))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not validate attempted time for MO sms %s", pk)
+			}
+		}
+
+		status := ForwardingStatus_PENDING
+		switch {
+		case forwarded:
+			status = ForwardingStatus_FORWARDED
+		case numAttempts >= maxRetries:
+			status = ForwardingStatus_FORWARDING_FAILED
+		}
+
+		ret = append(ret, &MOSMS{
+			Pk:                     pk,
+			NetworkID:              networkID,
+			Status:                 status,
+			Imsi:                   imsi,
+			Destination:            destination,
+			Message:                message,
+			ReceivedTime:           receivedTs,
+			LastForwardAttemptTime: attemptedTs,
+			AttemptCount:           uint32(numAttempts),
+			ForwardingError:        errorMessage.String,
+		})
+	}
+	err := rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "sql rows err")
+	}
+	return ret, nil
+}
@@ -74,7 +74,7 @@ func runCase(t *testing.T, test *testCase) {
 	mock.ExpectBegin()
 	test.setup(mock)
 
-	store := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &mockRefCounter{numRefs: 1}, &mockIDGenerator{})
+	store := storage.NewSQLSMSStorage(db, sqorc.GetSqlBuilder(), &mockRefCounter{numRefs: 1}, &mockReassembler{}, &mockIDGenerator{})
 	actual, err := test.run(store)
 
 	if test.expectedError != nil {
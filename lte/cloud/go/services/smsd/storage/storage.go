@@ -72,6 +72,29 @@ type SMSStorage interface {
 	// ReportDelivery reports delivery status of a set of SMSs
 	// Map keys for both arguments are IMSIs
 	ReportDelivery(networkID string, deliveredMessages map[string][]SMSRef, failedMessages map[string][]SMSFailureReport) error
+
+	// ReceiveMOSMSSegment persists a segment of a mobile-originated SMS.
+	// Once all segments of a message have been received, they are
+	// reassembled into a new MO SMS and its auto-generated pk is returned.
+	// An empty pk is returned while segments of the message are outstanding.
+	// A retransmitted segment overwrites the earlier copy.
+	ReceiveMOSMSSegment(networkID string, segment MOSMSSegment) (string, error)
+
+	// GetMOSMSs returns all MO SMS messages in a time window matching the
+	// provided pk and sender IMSI filters. Filters behave as in GetSMSs.
+	GetMOSMSs(networkID string, pks []string, imsis []string, startTime, endTime *time.Time) ([]*MOSMS, error)
+
+	// GetMOSMSsToForward returns up to limit MO SMS messages, across all
+	// networks, which are pending forwarding to the external application.
+	GetMOSMSsToForward(limit uint64) ([]*MOSMS, error)
+
+	// ReportMOForwarding reports the forwarding status of a set of MO SMSs.
+	// Keys of failedMessages are pks, values the forwarding errors. Messages
+	// which failed to forward too many times are marked as failed.
+	ReportMOForwarding(networkID string, forwardedPks []string, failedMessages map[string]string) error
+
+	// DeleteMOSMSs deletes MO messages by pk. Semantics are all or nothing.
+	DeleteMOSMSs(networkID string, pks []string) error
 }
 
 // SMSReferenceCounter is a functional interface that wraps the logic to
@@ -85,3 +108,17 @@ type DefaultSMSReferenceCounter struct{}
 func (*DefaultSMSReferenceCounter) GetReferenceNumberCount(message string) uint16 {
 	return uint16(sms_ll.GetMessageCount(message))
 }
+
+// SMSReassembler is a functional interface that wraps the logic to recover
+// the text of a mobile-originated message from its segments.
+type SMSReassembler interface {
+	// Reassemble returns the text of a message given the TPDUs of all its
+	// segments, ordered by segment number.
+	Reassemble(tpdus [][]byte) (string, error)
+}
+
+type DefaultSMSReassembler struct{}
+
+func (*DefaultSMSReassembler) Reassemble(tpdus [][]byte) (string, error) {
+	return sms_ll.ReassembleSubmits(tpdus)
+}
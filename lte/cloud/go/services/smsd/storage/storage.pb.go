@@ -49,6 +49,34 @@ func (MessageStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0d2c4ccf1453ffdb, []int{0}
 }
 
+type ForwardingStatus int32
+
+const (
+	ForwardingStatus_PENDING           ForwardingStatus = 0
+	ForwardingStatus_FORWARDED         ForwardingStatus = 1
+	ForwardingStatus_FORWARDING_FAILED ForwardingStatus = 2
+)
+
+var ForwardingStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "FORWARDED",
+	2: "FORWARDING_FAILED",
+}
+
+var ForwardingStatus_value = map[string]int32{
+	"PENDING":           0,
+	"FORWARDED":         1,
+	"FORWARDING_FAILED": 2,
+}
+
+func (x ForwardingStatus) String() string {
+	return proto.EnumName(ForwardingStatus_name, int32(x))
+}
+
+func (ForwardingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{1}
+}
+
 // SMS represents a message tracked by the smsd service
 type SMS struct {
 	// pk uniquely identifies an SMS message (generated unique key)
@@ -233,38 +261,262 @@ func (m *MutableSMS) GetMessage() string {
 	return ""
 }
 
+// MOSMS represents a mobile-originated message sent by a subscriber
+type MOSMS struct {
+	// pk uniquely identifies an SMS message (generated unique key)
+	Pk string `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	// network of the sending subscriber
+	NetworkID string `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// forwarding status of the SMS to the external application
+	Status ForwardingStatus `protobuf:"varint,3,opt,name=status,proto3,enum=magma.lte.smsd.storage.ForwardingStatus" json:"status,omitempty"`
+	// sender of the message
+	Imsi string `protobuf:"bytes,10,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// destination number of the message
+	Destination string `protobuf:"bytes,11,opt,name=destination,proto3" json:"destination,omitempty"`
+	// the message content of the SMS, reassembled from all its segments
+	Message string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	// time at which the message (its last segment) was received
+	ReceivedTime *timestamp.Timestamp `protobuf:"bytes,20,opt,name=receivedTime,proto3" json:"receivedTime,omitempty"`
+	// time that we last tried forwarding this message. if status is
+	// forwarded, this will be the forwarding time
+	LastForwardAttemptTime *timestamp.Timestamp `protobuf:"bytes,21,opt,name=lastForwardAttemptTime,proto3" json:"lastForwardAttemptTime,omitempty"`
+	// number of times we've attempted to forward this SMS
+	AttemptCount uint32 `protobuf:"varint,22,opt,name=attemptCount,proto3" json:"attemptCount,omitempty"`
+	// error message from the most recent failed forwarding attempt
+	ForwardingError      string   `protobuf:"bytes,23,opt,name=forwardingError,proto3" json:"forwardingError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MOSMS) Reset()         { *m = MOSMS{} }
+func (m *MOSMS) String() string { return proto.CompactTextString(m) }
+func (*MOSMS) ProtoMessage()    {}
+func (*MOSMS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{2}
+}
+
+func (m *MOSMS) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MOSMS.Unmarshal(m, b)
+}
+func (m *MOSMS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MOSMS.Marshal(b, m, deterministic)
+}
+func (m *MOSMS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MOSMS.Merge(m, src)
+}
+func (m *MOSMS) XXX_Size() int {
+	return xxx_messageInfo_MOSMS.Size(m)
+}
+func (m *MOSMS) XXX_DiscardUnknown() {
+	xxx_messageInfo_MOSMS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MOSMS proto.InternalMessageInfo
+
+func (m *MOSMS) GetPk() string {
+	if m != nil {
+		return m.Pk
+	}
+	return ""
+}
+
+func (m *MOSMS) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *MOSMS) GetStatus() ForwardingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ForwardingStatus_PENDING
+}
+
+func (m *MOSMS) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *MOSMS) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MOSMS) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *MOSMS) GetReceivedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedTime
+	}
+	return nil
+}
+
+func (m *MOSMS) GetLastForwardAttemptTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastForwardAttemptTime
+	}
+	return nil
+}
+
+func (m *MOSMS) GetAttemptCount() uint32 {
+	if m != nil {
+		return m.AttemptCount
+	}
+	return 0
+}
+
+func (m *MOSMS) GetForwardingError() string {
+	if m != nil {
+		return m.ForwardingError
+	}
+	return ""
+}
+
+// MOSMSSegment is a single SMS-SUBMIT received from a subscriber. Messages
+// which don't fit into a single SMS are sent as multiple concatenated
+// segments.
+type MOSMSSegment struct {
+	Imsi        string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// concatenation info. segmentCount and segmentNumber are 1 for
+	// single-segment messages.
+	ConcatRef     uint32 `protobuf:"varint,3,opt,name=concatRef,proto3" json:"concatRef,omitempty"`
+	SegmentCount  uint32 `protobuf:"varint,4,opt,name=segmentCount,proto3" json:"segmentCount,omitempty"`
+	SegmentNumber uint32 `protobuf:"varint,5,opt,name=segmentNumber,proto3" json:"segmentNumber,omitempty"`
+	// the encoded SMS-SUBMIT TPDU of the segment
+	Tpdu                 []byte   `protobuf:"bytes,6,opt,name=tpdu,proto3" json:"tpdu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MOSMSSegment) Reset()         { *m = MOSMSSegment{} }
+func (m *MOSMSSegment) String() string { return proto.CompactTextString(m) }
+func (*MOSMSSegment) ProtoMessage()    {}
+func (*MOSMSSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{3}
+}
+
+func (m *MOSMSSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MOSMSSegment.Unmarshal(m, b)
+}
+func (m *MOSMSSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MOSMSSegment.Marshal(b, m, deterministic)
+}
+func (m *MOSMSSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MOSMSSegment.Merge(m, src)
+}
+func (m *MOSMSSegment) XXX_Size() int {
+	return xxx_messageInfo_MOSMSSegment.Size(m)
+}
+func (m *MOSMSSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MOSMSSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MOSMSSegment proto.InternalMessageInfo
+
+func (m *MOSMSSegment) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *MOSMSSegment) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MOSMSSegment) GetConcatRef() uint32 {
+	if m != nil {
+		return m.ConcatRef
+	}
+	return 0
+}
+
+func (m *MOSMSSegment) GetSegmentCount() uint32 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *MOSMSSegment) GetSegmentNumber() uint32 {
+	if m != nil {
+		return m.SegmentNumber
+	}
+	return 0
+}
+
+func (m *MOSMSSegment) GetTpdu() []byte {
+	if m != nil {
+		return m.Tpdu
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.lte.smsd.storage.MessageStatus", MessageStatus_name, MessageStatus_value)
+	proto.RegisterEnum("magma.lte.smsd.storage.ForwardingStatus", ForwardingStatus_name, ForwardingStatus_value)
 	proto.RegisterType((*SMS)(nil), "magma.lte.smsd.storage.SMS")
 	proto.RegisterType((*MutableSMS)(nil), "magma.lte.smsd.storage.MutableSMS")
+	proto.RegisterType((*MOSMS)(nil), "magma.lte.smsd.storage.MOSMS")
+	proto.RegisterType((*MOSMSSegment)(nil), "magma.lte.smsd.storage.MOSMSSegment")
 }
 
 func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0xab, 0xd3, 0x40,
-	0x14, 0xc5, 0x9d, 0x54, 0x5a, 0x7a, 0xd3, 0x3c, 0xca, 0xa0, 0xef, 0x0d, 0x5d, 0x68, 0x28, 0x0a,
-	0xc1, 0xc5, 0x14, 0xea, 0xc2, 0x8d, 0x2e, 0xaa, 0x89, 0x12, 0x68, 0xba, 0x48, 0x83, 0x82, 0x0b,
-	0x61, 0xda, 0x4c, 0x43, 0x68, 0xa6, 0x13, 0x66, 0x26, 0x82, 0x5f, 0xc6, 0xcf, 0x2a, 0x9d, 0x24,
-	0x60, 0x40, 0xfb, 0x76, 0xb9, 0x7f, 0xce, 0x09, 0xbf, 0x73, 0x07, 0x3c, 0x6d, 0xa4, 0x62, 0x05,
-	0xa7, 0xb5, 0x92, 0x46, 0xe2, 0x7b, 0xc1, 0x0a, 0xc1, 0x68, 0x65, 0x38, 0xd5, 0x42, 0xe7, 0xb4,
-	0x9b, 0x2e, 0x5e, 0x16, 0x52, 0x16, 0x15, 0x5f, 0xd9, 0xad, 0x43, 0x73, 0x5a, 0x99, 0x52, 0x70,
-	0x6d, 0x98, 0xa8, 0x5b, 0xe1, 0xf2, 0xf7, 0x08, 0x46, 0xfb, 0x64, 0x8f, 0xef, 0xc0, 0xa9, 0xcf,
-	0x04, 0xf9, 0x28, 0x98, 0xa6, 0x4e, 0x7d, 0xc6, 0x1f, 0x60, 0xac, 0x0d, 0x33, 0x8d, 0x26, 0x8e,
-	0x8f, 0x82, 0xbb, 0xf5, 0x6b, 0xfa, 0xef, 0x3f, 0xd0, 0x84, 0x6b, 0xcd, 0x0a, 0xbe, 0xb7, 0xcb,
-	0x69, 0x27, 0xc2, 0x18, 0x9e, 0x96, 0x42, 0x97, 0x04, 0xac, 0xa1, 0xfd, 0xc6, 0x4b, 0x98, 0x69,
-	0xd9, 0xa8, 0x23, 0x4f, 0x74, 0xa9, 0xf3, 0x0b, 0x71, 0xed, 0x6c, 0xd0, 0xc3, 0x04, 0x26, 0xa2,
-	0x35, 0x24, 0x33, 0x3b, 0xee, 0x4b, 0xfc, 0x1e, 0xdc, 0xa3, 0xe2, 0xcc, 0xf0, 0x3c, 0x2b, 0x05,
-	0x27, 0xcf, 0x7c, 0x14, 0xb8, 0xeb, 0x05, 0x6d, 0xf9, 0x68, 0xcf, 0x47, 0xb3, 0x9e, 0x2f, 0xfd,
-	0x7b, 0x1d, 0x67, 0xf0, 0x50, 0x31, 0x6d, 0x42, 0x5e, 0x95, 0x3f, 0xb9, 0xfa, 0xb5, 0x31, 0x86,
-	0x8b, 0xda, 0x58, 0xa7, 0xe7, 0x8f, 0x3a, 0xfd, 0x4f, 0x7a, 0x25, 0x62, 0x6d, 0xf9, 0x49, 0x36,
-	0x17, 0x43, 0xee, 0x7d, 0x14, 0x78, 0xe9, 0xa0, 0x87, 0x5f, 0x81, 0x97, 0x77, 0xd2, 0x48, 0x29,
-	0xa9, 0xc8, 0x83, 0xe5, 0x1a, 0x36, 0xaf, 0xdc, 0x8a, 0x9f, 0x76, 0x8d, 0xd0, 0xe4, 0x85, 0x8f,
-	0x82, 0x59, 0xda, 0x97, 0xcb, 0x1f, 0x00, 0x49, 0x63, 0xd8, 0xa1, 0xe2, 0xd7, 0x33, 0xf5, 0xb9,
-	0xa2, 0x1b, 0xb9, 0x3a, 0xb7, 0x73, 0x1d, 0x0d, 0x72, 0x7d, 0xf3, 0x0e, 0xbc, 0xc1, 0x09, 0xb1,
-	0x0b, 0x93, 0x6f, 0x9b, 0x38, 0x8b, 0x77, 0x5f, 0xe6, 0x4f, 0xb0, 0x07, 0xd3, 0x30, 0xda, 0xc6,
-	0x5f, 0xa3, 0x34, 0x0a, 0xe7, 0x08, 0x03, 0x8c, 0x3f, 0x6f, 0xe2, 0x6d, 0x14, 0xce, 0x9d, 0x8f,
-	0xd3, 0xef, 0x93, 0xee, 0x0d, 0x1c, 0xc6, 0x36, 0xb4, 0xb7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc3, 0x50, 0xc7, 0x85, 0x95, 0x02, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x5f, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc6, 0xdf, 0xa4, 0x5b, 0xa7, 0x9e, 0x36, 0x7b, 0x8b, 0xc5, 0x36, 0x6b, 0x9a, 0x20, 0xaa,
+	0x40, 0x8a, 0x76, 0x91, 0x49, 0xe3, 0x82, 0x1b, 0x40, 0x14, 0x92, 0x4d, 0x91, 0xd6, 0x0e, 0xa5,
+	0x15, 0x93, 0xb8, 0x00, 0xb9, 0x8d, 0x1b, 0x45, 0xab, 0xe3, 0xc8, 0x76, 0x36, 0xf1, 0x65, 0xb8,
+	0xe7, 0x5b, 0xf0, 0xd1, 0x50, 0x9c, 0x64, 0x6b, 0xc6, 0x36, 0xca, 0x5d, 0xce, 0xe3, 0xf3, 0x9c,
+	0x9e, 0x3f, 0x3f, 0x15, 0x2c, 0xa9, 0xb8, 0x20, 0x31, 0x75, 0x33, 0xc1, 0x15, 0x47, 0xbb, 0x8c,
+	0xc4, 0x8c, 0xb8, 0x4b, 0x45, 0x5d, 0xc9, 0x64, 0xe4, 0x56, 0xaf, 0xfb, 0xcf, 0x63, 0xce, 0xe3,
+	0x25, 0x3d, 0xd2, 0x59, 0xb3, 0x7c, 0x71, 0xa4, 0x12, 0x46, 0xa5, 0x22, 0x2c, 0x2b, 0x8d, 0x83,
+	0x1f, 0x2d, 0x68, 0x4d, 0x46, 0x13, 0xb4, 0x0d, 0x66, 0x76, 0x89, 0x0d, 0xdb, 0x70, 0x3a, 0xa1,
+	0x99, 0x5d, 0xa2, 0xb7, 0xd0, 0x96, 0x8a, 0xa8, 0x5c, 0x62, 0xd3, 0x36, 0x9c, 0xed, 0xe3, 0x97,
+	0xee, 0xfd, 0xbf, 0xe0, 0x8e, 0xa8, 0x94, 0x24, 0xa6, 0x13, 0x9d, 0x1c, 0x56, 0x26, 0x84, 0x60,
+	0x23, 0x61, 0x32, 0xc1, 0xa0, 0x0b, 0xea, 0x6f, 0x34, 0x80, 0x9e, 0xe4, 0xb9, 0x98, 0xd3, 0x91,
+	0x4c, 0x64, 0x94, 0xe2, 0xae, 0x7e, 0x6b, 0x68, 0x08, 0xc3, 0x16, 0x2b, 0x0b, 0xe2, 0x9e, 0x7e,
+	0xae, 0x43, 0xf4, 0x06, 0xba, 0x73, 0x41, 0x89, 0xa2, 0xd1, 0x34, 0x61, 0x14, 0x3f, 0xb5, 0x0d,
+	0xa7, 0x7b, 0xbc, 0xef, 0x96, 0xf3, 0xb9, 0xf5, 0x7c, 0xee, 0xb4, 0x9e, 0x2f, 0x5c, 0x4d, 0x47,
+	0x53, 0xd8, 0x5b, 0x12, 0xa9, 0x3c, 0xba, 0x4c, 0xae, 0xa8, 0xf8, 0x3e, 0x54, 0x8a, 0xb2, 0x4c,
+	0xe9, 0x4a, 0x3b, 0x7f, 0xad, 0xf4, 0x90, 0xb5, 0x98, 0x88, 0x94, 0xe1, 0x47, 0x9e, 0xa7, 0x0a,
+	0xef, 0xda, 0x86, 0x63, 0x85, 0x0d, 0x0d, 0xbd, 0x00, 0x2b, 0xaa, 0xac, 0xbe, 0x10, 0x5c, 0xe0,
+	0x3d, 0x3d, 0x57, 0x53, 0x2c, 0xe6, 0x16, 0x74, 0x31, 0xce, 0x99, 0xc4, 0xcf, 0x6c, 0xc3, 0xe9,
+	0x85, 0x75, 0x38, 0xf8, 0x0a, 0x30, 0xca, 0x15, 0x99, 0x2d, 0x69, 0x71, 0xa6, 0x7a, 0xaf, 0xc6,
+	0x23, 0x7b, 0x35, 0x1f, 0xdf, 0x6b, 0xab, 0xb1, 0xd7, 0xc1, 0xcf, 0x16, 0x6c, 0x8e, 0xce, 0xef,
+	0x43, 0xe0, 0x00, 0x3a, 0x29, 0x55, 0xd7, 0x5c, 0x5c, 0x06, 0x5e, 0x55, 0xf4, 0x56, 0x40, 0xef,
+	0x6f, 0x00, 0x69, 0x69, 0x40, 0x9c, 0x87, 0x00, 0x39, 0xe1, 0xe2, 0x9a, 0x88, 0x28, 0x49, 0xe3,
+	0x35, 0x18, 0xb1, 0xa1, 0x1b, 0x51, 0xa9, 0x92, 0x94, 0xa8, 0x84, 0xd7, 0x88, 0xac, 0x4a, 0x8f,
+	0x10, 0xf2, 0x0e, 0x7a, 0x82, 0xce, 0x69, 0x72, 0xb5, 0x36, 0x22, 0x8d, 0x7c, 0x14, 0xc2, 0x6e,
+	0x71, 0xe8, 0xaa, 0xdf, 0x7f, 0x43, 0xe4, 0x01, 0xe7, 0x5a, 0x84, 0x38, 0xf0, 0xff, 0xe2, 0x66,
+	0x47, 0xab, 0x8c, 0xdc, 0x95, 0x07, 0xbf, 0x0c, 0xe8, 0xe9, 0x5b, 0x4d, 0x68, 0xcc, 0x68, 0xaa,
+	0xee, 0xc5, 0xe1, 0xce, 0x0a, 0xcd, 0x3f, 0x57, 0x78, 0x00, 0x9d, 0x39, 0x4f, 0xe7, 0x44, 0x85,
+	0x74, 0xa1, 0xaf, 0x67, 0x85, 0xb7, 0x82, 0xc6, 0xa9, 0x2c, 0x5f, 0xb6, 0xbc, 0x51, 0xb6, 0xbc,
+	0xaa, 0x15, 0x50, 0x57, 0xf1, 0x38, 0x67, 0x33, 0x2a, 0xf0, 0xa6, 0x4e, 0x6a, 0x8a, 0x45, 0x77,
+	0x2a, 0x8b, 0x72, 0xdc, 0xd6, 0x44, 0xeb, 0xef, 0xc3, 0xd7, 0x60, 0x35, 0xfe, 0x31, 0x50, 0x17,
+	0xb6, 0x2e, 0x86, 0xc1, 0x34, 0x18, 0x9f, 0xf6, 0xff, 0x43, 0x16, 0x74, 0x3c, 0xff, 0x2c, 0xf8,
+	0xec, 0x87, 0xbe, 0xd7, 0x37, 0x10, 0x40, 0xfb, 0x64, 0x18, 0x9c, 0xf9, 0x5e, 0xdf, 0x3c, 0xf4,
+	0xa1, 0x7f, 0x97, 0xa4, 0xc2, 0xfb, 0xc9, 0x1f, 0x7b, 0x37, 0xde, 0x93, 0xf3, 0xf0, 0x62, 0x18,
+	0x7a, 0xda, 0xbb, 0x03, 0x4f, 0xaa, 0x30, 0x18, 0x9f, 0x7e, 0xab, 0xcb, 0x7c, 0xe8, 0x7c, 0xd9,
+	0xaa, 0xc0, 0x9c, 0xb5, 0xf5, 0x1d, 0x5f, 0xfd, 0x1e, 0x00, 0xc8, 0xf7, 0xc1, 0x35, 0x4b, 0x05,
+	0x00, 0x00,
 }
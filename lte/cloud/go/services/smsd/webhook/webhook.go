@@ -0,0 +1,182 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package webhook forwards mobile-originated SMS to an external application.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/lte/cloud/go/services/smsd/storage"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	defaultTimeout  = 10 * time.Second
+	defaultInterval = 5 * time.Second
+
+	// Max number of messages forwarded per interval
+	batchSize = 100
+)
+
+type Config struct {
+	// URL is the endpoint of the external application MO SMS are POSTed
+	// to. Forwarding is disabled if empty.
+	URL string `yaml:"url"`
+	// Headers are added to each request, e.g. for authorization
+	Headers map[string]string `yaml:"headers"`
+	// TimeoutSecs is the timeout of each request
+	TimeoutSecs uint `yaml:"timeoutSecs"`
+	// IntervalSecs is the interval at which pending MO SMS are forwarded
+	IntervalSecs uint `yaml:"intervalSecs"`
+}
+
+// GetTimeout returns the configured request timeout, or a default if it isn't
+// set.
+func (c Config) GetTimeout() time.Duration {
+	if c.TimeoutSecs == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(c.TimeoutSecs) * time.Second
+}
+
+// GetInterval returns the configured forwarding interval, or a default if it
+// isn't set.
+func (c Config) GetInterval() time.Duration {
+	if c.IntervalSecs == 0 {
+		return defaultInterval
+	}
+	return time.Duration(c.IntervalSecs) * time.Second
+}
+
+// Message is the JSON body POSTed to the webhook for each MO SMS.
+type Message struct {
+	ID           string    `json:"id"`
+	NetworkID    string    `json:"network_id"`
+	Imsi         string    `json:"imsi"`
+	Destination  string    `json:"destination"`
+	Message      string    `json:"message"`
+	TimeReceived time.Time `json:"time_received"`
+}
+
+// Forwarder POSTs MO SMS pending forwarding to the webhook, reporting the
+// outcome back to the store. Failed messages are retried on the next interval
+// until the store marks them as failed.
+type Forwarder struct {
+	store   storage.SMSStorage
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewForwarder(store storage.SMSStorage, config Config) *Forwarder {
+	return &Forwarder{
+		store:   store,
+		url:     config.URL,
+		headers: config.Headers,
+		client:  &http.Client{Timeout: config.GetTimeout()},
+	}
+}
+
+// Run forwards pending MO SMS every interval. It never returns.
+func (f *Forwarder) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		err := f.ForwardPending()
+		if err != nil {
+			glog.Errorf("Error forwarding MO SMS: %v", err)
+		}
+	}
+}
+
+// ForwardPending forwards a batch of the MO SMS pending forwarding.
+func (f *Forwarder) ForwardPending() error {
+	messages, err := f.store.GetMOSMSsToForward(batchSize)
+	if err != nil {
+		return err
+	}
+
+	forwardedByNetwork, failedByNetwork := map[string][]string{}, map[string]map[string]string{}
+	for _, msg := range messages {
+		err := f.forward(msg)
+		if err == nil {
+			forwardedByNetwork[msg.NetworkID] = append(forwardedByNetwork[msg.NetworkID], msg.Pk)
+			continue
+		}
+		glog.Warningf("Failed to forward MO SMS %s: %v", msg.Pk, err)
+		if _, ok := failedByNetwork[msg.NetworkID]; !ok {
+			failedByNetwork[msg.NetworkID] = map[string]string{}
+		}
+		failedByNetwork[msg.NetworkID][msg.Pk] = err.Error()
+	}
+
+	for networkID, forwarded := range forwardedByNetwork {
+		err = f.store.ReportMOForwarding(networkID, forwarded, failedByNetwork[networkID])
+		if err != nil {
+			return err
+		}
+		delete(failedByNetwork, networkID)
+	}
+	for networkID, failed := range failedByNetwork {
+		err = f.store.ReportMOForwarding(networkID, nil, failed)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Forwarder) forward(msg *storage.MOSMS) error {
+	received, err := ptypes.Timestamp(msg.ReceivedTime)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(Message{
+		ID:           msg.Pk,
+		NetworkID:    msg.NetworkID,
+		Imsi:         msg.Imsi,
+		Destination:  msg.Destination,
+		Message:      msg.Message,
+		TimeReceived: received,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range f.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, msg)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+ *  Copyright 2020 The Magma Authors.
+ *
+ *  This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/lte/cloud/go/services/smsd/storage"
+	"magma/lte/cloud/go/services/smsd/storage/mocks"
+	"magma/lte/cloud/go/services/smsd/webhook"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwarder_ForwardPending(t *testing.T) {
+	var received []webhook.Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer foo", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		msg := webhook.Message{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		received = append(received, msg)
+		if msg.Message == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("oops"))
+		}
+	}))
+	defer server.Close()
+
+	receivedTs, err := ptypes.TimestampProto(time.Unix(1000, 0))
+	assert.NoError(t, err)
+	store := &mocks.SMSStorage{}
+	store.On("GetMOSMSsToForward", uint64(100)).Return([]*storage.MOSMS{
+		{Pk: "1", NetworkID: "n1", Imsi: "IMSI1", Destination: "+1555", Message: "hello", ReceivedTime: receivedTs},
+		{Pk: "2", NetworkID: "n1", Imsi: "IMSI2", Destination: "+1555", Message: "fail", ReceivedTime: receivedTs},
+		{Pk: "3", NetworkID: "n2", Imsi: "IMSI3", Destination: "+1555", Message: "fail", ReceivedTime: receivedTs},
+	}, nil).Once()
+	store.On("ReportMOForwarding", "n1", []string{"1"}, map[string]string{"2": "webhook responded with status 500: oops"}).Return(nil).Once()
+	store.On("ReportMOForwarding", "n2", []string(nil), map[string]string{"3": "webhook responded with status 500: oops"}).Return(nil).Once()
+
+	forwarder := webhook.NewForwarder(store, webhook.Config{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer foo"},
+	})
+	err = forwarder.ForwardPending()
+	assert.NoError(t, err)
+	store.AssertExpectations(t)
+
+	assert.Len(t, received, 3)
+	assert.Equal(t, webhook.Message{
+		ID:           "1",
+		NetworkID:    "n1",
+		Imsi:         "IMSI1",
+		Destination:  "+1555",
+		Message:      "hello",
+		TimeReceived: time.Unix(1000, 0).UTC(),
+	}, received[0])
+}
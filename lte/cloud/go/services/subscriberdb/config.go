@@ -15,6 +15,7 @@ package subscriberdb
 
 import (
 	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/orc8r/lib/go/service/config"
 
 	"github.com/golang/glog"
@@ -26,6 +27,9 @@ type Config struct {
 	// ChangesetSizeTheshold specifies the max size of the cloud-agw changeset
 	// past which a resync signal will be sent back to the agw.
 	ChangesetSizeTheshold int `yaml:"changesetSizeTheshold"`
+	// SecretsEncryption configures the encryption of subscriber auth keys at
+	// rest. Shared by all services deserializing subscriber configs.
+	SecretsEncryption encryption.Config `yaml:"secretsEncryption"`
 }
 
 func MustGetServiceConfig() Config {
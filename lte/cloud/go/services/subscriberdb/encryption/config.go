@@ -0,0 +1,70 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProviderNone disables encryption of subscriber secrets
+	ProviderNone = "none"
+	// ProviderFile wraps data keys with the keys of a local key file
+	ProviderFile = "file"
+	// ProviderGRPC wraps data keys with a remote SubscriberKeyManagement
+	// service
+	ProviderGRPC = "grpc"
+)
+
+// Config configures the encryption of subscriber secrets at rest.
+type Config struct {
+	// Provider is the key provider, one of none, file, or grpc.
+	// Defaults to none.
+	Provider string `yaml:"provider"`
+	// KeyFile is the path to the key file of the file provider.
+	KeyFile string `yaml:"keyFile"`
+	// GRPCAddress is the address of the key management service of the grpc
+	// provider.
+	GRPCAddress string `yaml:"grpcAddress"`
+}
+
+// NewProvider returns the configured key provider, or nil if encryption is
+// disabled.
+func NewProvider(cfg Config) (KeyProvider, error) {
+	switch cfg.Provider {
+	case "", ProviderNone:
+		return nil, nil
+	case ProviderFile:
+		return NewFileKeyProvider(cfg.KeyFile)
+	case ProviderGRPC:
+		return NewRemoteKeyProvider(cfg.GRPCAddress)
+	default:
+		return nil, errors.Errorf("unknown key provider '%s'", cfg.Provider)
+	}
+}
+
+// MustConfigure sets the encryptor of the process from the config, exiting
+// on failure.
+func MustConfigure(cfg Config) {
+	provider, err := NewProvider(cfg)
+	if err != nil {
+		glog.Fatalf("Failed to configure subscriber secrets encryption: %+v", err)
+	}
+	if provider == nil {
+		SetEncryptor(nil)
+		return
+	}
+	SetEncryptor(NewEncryptor(provider))
+}
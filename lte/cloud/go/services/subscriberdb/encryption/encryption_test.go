@@ -0,0 +1,248 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/lte/cloud/go/services/subscriberdb/protos"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+var (
+	paths = []encryption.SecretPath{{"lte", "auth_key"}, {"lte", "auth_opc"}}
+
+	key0 = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0}, encryption.KeyEncryptionKeyBytes))
+	key1 = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, encryption.KeyEncryptionKeyBytes))
+)
+
+func TestEncryptor_EncryptDecryptSecrets(t *testing.T) {
+	e := encryption.NewEncryptor(newProvider(t, "k0", map[string]string{"k0": key0}))
+
+	doc := []byte(`{"lte":{"auth_algo":"MILENAGE","auth_key":"AAECAwQFBgcICQoLDA0ODw==","auth_opc":"AAECAwQFBgcICQoLDA0ODw==","state":"ACTIVE"},"static_ips":null}`)
+	encrypted, err := e.EncryptSecrets(doc, paths)
+	assert.NoError(t, err)
+	assert.True(t, encryption.IsEncrypted(encrypted))
+	assert.NotContains(t, string(encrypted), "AAECAwQFBgcICQoLDA0ODw==")
+	assert.Contains(t, string(encrypted), `"state":"ACTIVE"`)
+	keyID, err := encryption.GetKeyID(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "k0", keyID)
+
+	decrypted, err := e.DecryptSecrets(encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(doc), string(decrypted))
+
+	// Documents without secrets are left unchanged
+	noSecrets := []byte(`{"lte":{"state":"ACTIVE"}}`)
+	encrypted, err = e.EncryptSecrets(noSecrets, paths)
+	assert.NoError(t, err)
+	assert.Equal(t, noSecrets, encrypted)
+
+	// Plaintext documents are passed through
+	decrypted, err = e.DecryptSecrets(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, doc, decrypted)
+
+	// Tampered ciphertexts fail to decrypt
+	encrypted, err = e.EncryptSecrets(doc, paths)
+	assert.NoError(t, err)
+	tampered := bytes.Replace(encrypted, []byte(`"ciphertext":"`), []byte(`"ciphertext":"AAAA`), 1)
+	_, err = e.DecryptSecrets(tampered)
+	assert.Error(t, err)
+
+	// Data keys of unknown key encryption keys fail to unwrap
+	other := encryption.NewEncryptor(newProvider(t, "k1", map[string]string{"k1": key1}))
+	_, err = other.DecryptSecrets(encrypted)
+	assert.EqualError(t, err, "unknown key encryption key 'k0'")
+}
+
+func TestEncryptor_Reencrypt(t *testing.T) {
+	doc := []byte(`{"lte":{"auth_key":"AAECAwQFBgcICQoLDA0ODw==","state":"ACTIVE"}}`)
+
+	e0 := encryption.NewEncryptor(newProvider(t, "k0", map[string]string{"k0": key0}))
+	encrypted0, changed, err := e0.Reencrypt(doc, paths)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assertKeyID(t, "k0", encrypted0)
+
+	// Already encrypted under the current key
+	unchanged, changed, err := e0.Reencrypt(encrypted0, paths)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, encrypted0, unchanged)
+
+	// Rotate to k1, keeping k0 to decrypt
+	e1 := encryption.NewEncryptor(newProvider(t, "k1", map[string]string{"k0": key0, "k1": key1}))
+	encrypted1, changed, err := e1.Reencrypt(encrypted0, paths)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assertKeyID(t, "k1", encrypted1)
+	decrypted, err := e1.DecryptSecrets(encrypted1)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(doc), string(decrypted))
+}
+
+func TestSecretsSerde(t *testing.T) {
+	defer encryption.SetEncryptor(nil)
+	config := &models.SubscriberConfig{
+		Lte: &models.LteSubscription{
+			AuthAlgo: "MILENAGE",
+			AuthKey:  []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+			AuthOpc:  []byte("\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33\x33"),
+			State:    "ACTIVE",
+		},
+	}
+
+	// Plaintext without an encryptor
+	encryption.SetEncryptor(nil)
+	plaintext, err := serde.Serialize(config, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	assert.False(t, encryption.IsEncrypted(plaintext))
+
+	// Encrypted with an encryptor, and transparently decrypted
+	encryption.SetEncryptor(encryption.NewEncryptor(newProvider(t, "k0", map[string]string{"k0": key0})))
+	encrypted, err := serde.Serialize(config, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	assert.True(t, encryption.IsEncrypted(encrypted))
+	deserialized, err := serde.Deserialize(encrypted, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, config, deserialized)
+
+	// Plaintext configs are still readable with an encryptor
+	deserialized, err = serde.Deserialize(plaintext, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, config, deserialized)
+
+	// Encrypted configs can't be read without an encryptor
+	encryption.SetEncryptor(nil)
+	_, err = serde.Deserialize(encrypted, lte.SubscriberEntityType, serdes.Entity)
+	assert.EqualError(t, err, "cannot deserialize subscriber, its secrets are encrypted but encryption is not configured")
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	f, err := ioutil.TempFile("", "subscriber_keys")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("currentKeyId: k1\nkeys:\n  k0: " + key0 + "\n  k1: " + key1 + "\n")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	provider, err := encryption.NewFileKeyProvider(f.Name())
+	assert.NoError(t, err)
+	keyID, wrapped, err := provider.WrapKey([]byte("data key"))
+	assert.NoError(t, err)
+	assert.Equal(t, "k1", keyID)
+	dataKey, err := provider.UnwrapKey(keyID, wrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data key"), dataKey)
+	_, err = provider.UnwrapKey("k0", wrapped)
+	assert.Error(t, err)
+
+	_, err = encryption.NewKeyFileProvider(encryption.KeyFile{CurrentKeyID: "k2", Keys: map[string]string{"k0": key0}})
+	assert.EqualError(t, err, "current key 'k2' is missing from the keys")
+	_, err = encryption.NewKeyFileProvider(encryption.KeyFile{CurrentKeyID: "k0", Keys: map[string]string{"k0": "AAAA"}})
+	assert.EqualError(t, err, "expected key 'k0' to be 32 bytes but got 3 bytes")
+}
+
+func TestRemoteKeyProvider(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(t, err)
+	srv := grpc.NewServer()
+	protos.RegisterSubscriberKeyManagementServer(srv, encryption.NewKeyManagementServicer(newProvider(t, "k0", map[string]string{"k0": key0})))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	provider, err := encryption.NewRemoteKeyProvider(lis.Addr().String())
+	assert.NoError(t, err)
+	e := encryption.NewEncryptor(provider)
+	doc := []byte(`{"lte":{"auth_key":"AAECAwQFBgcICQoLDA0ODw=="}}`)
+	encrypted, err := e.EncryptSecrets(doc, paths)
+	assert.NoError(t, err)
+	assertKeyID(t, "k0", encrypted)
+	decrypted, err := e.DecryptSecrets(encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(doc), string(decrypted))
+
+	_, err = provider.UnwrapKey("k1", []byte("wrapped"))
+	assert.Error(t, err)
+}
+
+func TestReencryptEntityConfigs(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE cfg_entities (pk TEXT PRIMARY KEY, type TEXT, config BLOB)")
+	assert.NoError(t, err)
+	plaintext := `{"lte":{"auth_key":"AAECAwQFBgcICQoLDA0ODw=="}}`
+	_, err = db.Exec(
+		"INSERT INTO cfg_entities (pk, type, config) VALUES ('sub0', 'subscriber', ?), ('sub1', 'subscriber', NULL), ('apn0', 'apn', ?)",
+		[]byte(plaintext), []byte(`{"auth_key":"not a secret"}`),
+	)
+	assert.NoError(t, err)
+
+	reencrypt := func(e *encryption.Encryptor) int {
+		tx, err := db.Begin()
+		assert.NoError(t, err)
+		count, err := encryption.ReencryptEntityConfigs(tx, sqorc.GetSqlBuilder(), lte.SubscriberEntityType, paths, e)
+		assert.NoError(t, err)
+		assert.NoError(t, tx.Commit())
+		return count
+	}
+	loadConfig := func(pk string) []byte {
+		var config []byte
+		assert.NoError(t, db.QueryRow("SELECT config FROM cfg_entities WHERE pk = ?", pk).Scan(&config))
+		return config
+	}
+
+	// Migration encrypts plaintext configs, and is idempotent
+	e0 := encryption.NewEncryptor(newProvider(t, "k0", map[string]string{"k0": key0}))
+	assert.Equal(t, 1, reencrypt(e0))
+	assertKeyID(t, "k0", loadConfig("sub0"))
+	assert.Equal(t, 0, reencrypt(e0))
+	assert.Equal(t, `{"auth_key":"not a secret"}`, string(loadConfig("apn0")))
+
+	// Rotation re-encrypts under the new key
+	e1 := encryption.NewEncryptor(newProvider(t, "k1", map[string]string{"k0": key0, "k1": key1}))
+	assert.Equal(t, 1, reencrypt(e1))
+	config := loadConfig("sub0")
+	assertKeyID(t, "k1", config)
+	decrypted, err := e1.DecryptSecrets(config)
+	assert.NoError(t, err)
+	assert.JSONEq(t, plaintext, string(decrypted))
+}
+
+func newProvider(t *testing.T, currentKeyID string, keys map[string]string) encryption.KeyProvider {
+	provider, err := encryption.NewKeyFileProvider(encryption.KeyFile{CurrentKeyID: currentKeyID, Keys: keys})
+	assert.NoError(t, err)
+	return provider
+}
+
+func assertKeyID(t *testing.T, expected string, doc []byte) {
+	keyID, err := encryption.GetKeyID(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, keyID)
+}
@@ -0,0 +1,359 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// EnvelopeField is the top-level JSON field holding the encrypted
+	// secrets of a document.
+	EnvelopeField = "encrypted_secrets"
+
+	dataKeyBytes = 32
+
+	// A data key is reused for a bounded number of documents and time, so
+	// the KMS isn't called per write while still picking up rotated keys.
+	defaultMaxDataKeyUses = 10000
+	defaultDataKeyTTL     = 10 * time.Minute
+	// maxCachedDataKeys bounds the unwrapped data keys kept for decryption.
+	maxCachedDataKeys = 1024
+)
+
+// SecretPath is the path of JSON object fields to a secret, e.g.
+// {"lte", "auth_key"}.
+type SecretPath []string
+
+func (p SecretPath) String() string {
+	return strings.Join(p, ".")
+}
+
+// Envelope holds the secrets of a document, encrypted with a data key which
+// is itself wrapped by the key provider.
+type Envelope struct {
+	KeyID      string `json:"key_id"`
+	WrappedKey []byte `json:"wrapped_key"`
+	// Ciphertext is the encrypted JSON object of the secrets, by secret path,
+	// prepended with its nonce
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Encryptor envelope encrypts secret fields of JSON documents.
+type Encryptor struct {
+	provider KeyProvider
+
+	sync.Mutex
+	dataKey    *dataKey
+	cachedKeys map[string][]byte
+}
+
+type dataKey struct {
+	keyID      string
+	plaintext  []byte
+	wrapped    []byte
+	uses       int
+	createTime time.Time
+}
+
+// NewEncryptor returns an encryptor whose data keys are wrapped by the
+// key provider.
+func NewEncryptor(provider KeyProvider) *Encryptor {
+	return &Encryptor{provider: provider, cachedKeys: map[string][]byte{}}
+}
+
+// CurrentKeyID returns the ID of the key provider's current key encryption
+// key.
+func (e *Encryptor) CurrentKeyID() (string, error) {
+	return e.provider.CurrentKeyID()
+}
+
+// IsEncrypted returns true if the JSON document holds encrypted secrets.
+func IsEncrypted(doc []byte) bool {
+	if !bytes.Contains(doc, []byte(EnvelopeField)) {
+		return false
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return false
+	}
+	_, ok := fields[EnvelopeField]
+	return ok
+}
+
+// EncryptSecrets moves the secrets at paths of the JSON document into an
+// encrypted envelope. Documents without any of the secrets are returned
+// unchanged.
+func (e *Encryptor) EncryptSecrets(doc []byte, paths []SecretPath) ([]byte, error) {
+	return e.encryptSecrets(doc, paths, "")
+}
+
+// encryptSecrets encrypts with a data key wrapped by the key encryption key
+// keyID, or by any recent key encryption key if keyID is empty.
+func (e *Encryptor) encryptSecrets(doc []byte, paths []SecretPath, keyID string) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal document")
+	}
+	if _, ok := fields[EnvelopeField]; ok {
+		return nil, errors.New("document is already encrypted")
+	}
+
+	secrets := map[string]json.RawMessage{}
+	for _, path := range paths {
+		secret, err := extractSecret(fields, path)
+		if err != nil {
+			return nil, err
+		}
+		if secret != nil {
+			secrets[path.String()] = secret
+		}
+	}
+	if len(secrets) == 0 {
+		return doc, nil
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.getDataKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key.plaintext)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(aead, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := json.Marshal(Envelope{KeyID: key.keyID, WrappedKey: key.wrapped, Ciphertext: ciphertext})
+	if err != nil {
+		return nil, err
+	}
+	fields[EnvelopeField] = envelope
+	return json.Marshal(fields)
+}
+
+// DecryptSecrets restores the secrets of the document's encrypted envelope.
+// Documents without an envelope are returned unchanged.
+func (e *Encryptor) DecryptSecrets(doc []byte) ([]byte, error) {
+	if !IsEncrypted(doc) {
+		return doc, nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal document")
+	}
+	envelope := Envelope{}
+	if err := json.Unmarshal(fields[EnvelopeField], &envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encrypted secrets")
+	}
+	delete(fields, EnvelopeField)
+
+	key, err := e.unwrapDataKey(envelope.KeyID, envelope.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(aead, envelope.Ciphertext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt secrets")
+	}
+	secrets := map[string]json.RawMessage{}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal decrypted secrets")
+	}
+	for path, secret := range secrets {
+		if err := insertSecret(fields, strings.Split(path, "."), secret); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// Reencrypt encrypts the secrets of a plaintext document, or re-encrypts
+// the secrets of a document not encrypted under the current key encryption
+// key. The returned bool is false if the document was left unchanged.
+func (e *Encryptor) Reencrypt(doc []byte, paths []SecretPath) ([]byte, bool, error) {
+	currentKeyID, err := e.provider.CurrentKeyID()
+	if err != nil {
+		return nil, false, err
+	}
+	if IsEncrypted(doc) {
+		keyID, err := GetKeyID(doc)
+		if err != nil {
+			return nil, false, err
+		}
+		if keyID == currentKeyID {
+			return doc, false, nil
+		}
+		doc, err = e.DecryptSecrets(doc)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	encrypted, err := e.encryptSecrets(doc, paths, currentKeyID)
+	if err != nil {
+		return nil, false, err
+	}
+	return encrypted, !bytes.Equal(encrypted, doc), nil
+}
+
+// GetKeyID returns the ID of the key encryption key which wrapped the data
+// key of the encrypted document.
+func GetKeyID(doc []byte) (string, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal document")
+	}
+	envelope := Envelope{}
+	if err := json.Unmarshal(fields[EnvelopeField], &envelope); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal encrypted secrets")
+	}
+	return envelope.KeyID, nil
+}
+
+// getDataKey returns the data key to encrypt with, generating and wrapping
+// a new one when the current key is exhausted, expired, or not wrapped by the
+// required key encryption key.
+func (e *Encryptor) getDataKey(requiredKeyID string) (*dataKey, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	key := e.dataKey
+	isUsable := key != nil &&
+		key.uses < defaultMaxDataKeyUses &&
+		clock.Since(key.createTime) < defaultDataKeyTTL &&
+		(requiredKeyID == "" || key.keyID == requiredKeyID)
+	if isUsable {
+		key.uses++
+		return key, nil
+	}
+
+	plaintext := make([]byte, dataKeyBytes)
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key")
+	}
+	keyID, wrapped, err := e.provider.WrapKey(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	e.dataKey = &dataKey{keyID: keyID, plaintext: plaintext, wrapped: wrapped, uses: 1, createTime: clock.Now()}
+	e.cacheKey(keyID, wrapped, plaintext)
+	return e.dataKey, nil
+}
+
+func (e *Encryptor) unwrapDataKey(keyID string, wrapped []byte) ([]byte, error) {
+	cacheKey := keyID + ":" + string(wrapped)
+	e.Lock()
+	key, ok := e.cachedKeys[cacheKey]
+	e.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	key, err := e.provider.UnwrapKey(keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	e.Lock()
+	e.cacheKey(keyID, wrapped, key)
+	e.Unlock()
+	return key, nil
+}
+
+// cacheKey caches the unwrapped data key, evicting all cached keys when the
+// cache is full. Callers must hold the lock.
+func (e *Encryptor) cacheKey(keyID string, wrapped []byte, plaintext []byte) {
+	if len(e.cachedKeys) >= maxCachedDataKeys {
+		e.cachedKeys = map[string][]byte{}
+	}
+	e.cachedKeys[keyID+":"+string(wrapped)] = plaintext
+}
+
+// extractSecret removes and returns the JSON value at path, or nil if the
+// document has no value at path.
+func extractSecret(fields map[string]json.RawMessage, path SecretPath) (json.RawMessage, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	value, ok := fields[path[0]]
+	if !ok {
+		return nil, nil
+	}
+	if len(path) == 1 {
+		delete(fields, path[0])
+		if string(value) == "null" {
+			return nil, nil
+		}
+		return value, nil
+	}
+
+	children := map[string]json.RawMessage{}
+	if string(value) == "null" {
+		return nil, nil
+	}
+	if err := json.Unmarshal(value, &children); err != nil {
+		return nil, errors.Wrapf(err, "expected '%s' to be an object", path[0])
+	}
+	secret, err := extractSecret(children, path[1:])
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	marshaled, err := json.Marshal(children)
+	if err != nil {
+		return nil, err
+	}
+	fields[path[0]] = marshaled
+	return secret, nil
+}
+
+// insertSecret sets the JSON value at path, creating parent objects as
+// needed.
+func insertSecret(fields map[string]json.RawMessage, path []string, secret json.RawMessage) error {
+	if len(path) == 1 {
+		fields[path[0]] = secret
+		return nil
+	}
+	children := map[string]json.RawMessage{}
+	if value, ok := fields[path[0]]; ok && string(value) != "null" {
+		if err := json.Unmarshal(value, &children); err != nil {
+			return errors.Wrapf(err, "expected '%s' to be an object", path[0])
+		}
+	}
+	if err := insertSecret(children, path[1:], secret); err != nil {
+		return err
+	}
+	marshaled, err := json.Marshal(children)
+	if err != nil {
+		return err
+	}
+	fields[path[0]] = marshaled
+	return nil
+}
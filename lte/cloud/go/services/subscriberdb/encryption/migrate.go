@@ -0,0 +1,83 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"database/sql"
+
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+const (
+	entityTableName = "cfg_entities"
+	entityPkCol     = "pk"
+	entityTypeCol   = "type"
+	entityConfigCol = "config"
+)
+
+// ReencryptEntityConfigs encrypts the secrets of the configs of all
+// configurator entities of the type directly in the configurator tables,
+// re-encrypting configs not encrypted under the current key encryption key.
+// Returns the number of updated configs.
+func ReencryptEntityConfigs(tx *sql.Tx, builder sqorc.StatementBuilder, entityType string, paths []SecretPath, e *Encryptor) (int, error) {
+	sc := squirrel.NewStmtCache(tx)
+	defer func() { _ = sc.Clear() }()
+
+	rows, err := builder.Select(entityPkCol, entityConfigCol).
+		From(entityTableName).
+		Where(squirrel.Eq{entityTypeCol: entityType}).
+		RunWith(sc).
+		Query()
+	if err != nil {
+		return 0, errors.Wrapf(err, "error loading %s configs", entityType)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "ReencryptEntityConfigs")
+
+	newConfsByPk := map[string][]byte{}
+	for rows.Next() {
+		var pk string
+		var conf []byte
+		if err := rows.Scan(&pk, &conf); err != nil {
+			return 0, errors.Wrapf(err, "error scanning %s row", entityType)
+		}
+		if len(conf) == 0 {
+			continue
+		}
+		newConf, changed, err := e.Reencrypt(conf, paths)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error encrypting %s config %s", entityType, pk)
+		}
+		if changed {
+			newConfsByPk[pk] = newConf
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrapf(err, "error iterating over %s rows", entityType)
+	}
+
+	for pk, newConf := range newConfsByPk {
+		_, err := builder.Update(entityTableName).
+			Set(entityConfigCol, newConf).
+			Where(squirrel.Eq{entityPkCol: pk}).
+			RunWith(sc).
+			Exec()
+		if err != nil {
+			return 0, errors.Wrapf(err, "error updating %s config %s", entityType, pk)
+		}
+	}
+	return len(newConfsByPk), nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// KeyEncryptionKeyBytes is the size of the AES-256 key encryption keys of
+// key files.
+const KeyEncryptionKeyBytes = 32
+
+// KeyProvider wraps the data keys which encrypt subscriber secrets with key
+// encryption keys, which it never exposes.
+type KeyProvider interface {
+	// WrapKey encrypts the data key with the current key encryption key,
+	// returning the ID of the key encryption key with the wrapped data key.
+	WrapKey(dataKey []byte) (string, []byte, error)
+	// UnwrapKey decrypts a data key wrapped by the key encryption key keyID.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+	// CurrentKeyID returns the ID of the key encryption key new data keys
+	// are wrapped with.
+	CurrentKeyID() (string, error)
+}
+
+// KeyFile is the YAML key file of the file key provider.
+// Rotating keys is done by adding a new key & making it the current key,
+// old keys must be kept until all secrets are re-encrypted.
+type KeyFile struct {
+	// CurrentKeyID is the ID of the key new data keys are wrapped with
+	CurrentKeyID string `yaml:"currentKeyId"`
+	// Keys are the base64 encoded AES-256 key encryption keys, by ID
+	Keys map[string]string `yaml:"keys"`
+}
+
+type fileKeyProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewFileKeyProvider returns a key provider with the key encryption keys of
+// the YAML key file at path.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read key file")
+	}
+	keyFile := KeyFile{}
+	if err := yaml.Unmarshal(data, &keyFile); err != nil {
+		return nil, errors.Wrap(err, "failed to parse key file")
+	}
+	return NewKeyFileProvider(keyFile)
+}
+
+// NewKeyFileProvider returns a key provider with the key encryption keys of
+// the parsed key file.
+func NewKeyFileProvider(keyFile KeyFile) (KeyProvider, error) {
+	if _, ok := keyFile.Keys[keyFile.CurrentKeyID]; !ok {
+		return nil, errors.Errorf("current key '%s' is missing from the keys", keyFile.CurrentKeyID)
+	}
+	provider := &fileKeyProvider{currentKeyID: keyFile.CurrentKeyID, keys: map[string]cipher.AEAD{}}
+	for keyID, encodedKey := range keyFile.Keys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode key '%s'", keyID)
+		}
+		if len(key) != KeyEncryptionKeyBytes {
+			return nil, errors.Errorf("expected key '%s' to be %d bytes but got %d bytes", keyID, KeyEncryptionKeyBytes, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		provider.keys[keyID] = aead
+	}
+	return provider, nil
+}
+
+func (p *fileKeyProvider) WrapKey(dataKey []byte) (string, []byte, error) {
+	wrapped, err := seal(p.keys[p.currentKeyID], dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return p.currentKeyID, wrapped, nil
+}
+
+func (p *fileKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("unknown key encryption key '%s'", keyID)
+	}
+	return open(aead, wrappedKey)
+}
+
+func (p *fileKeyProvider) CurrentKeyID() (string, error) {
+	return p.currentKeyID, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce, prepended to the
+// returned ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is shorter than the nonce")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"time"
+
+	"magma/lte/cloud/go/services/subscriberdb/protos"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const remoteKeyProviderTimeout = 10 * time.Second
+
+type remoteKeyProvider struct {
+	client protos.SubscriberKeyManagementClient
+}
+
+// NewRemoteKeyProvider returns a key provider delegating to the
+// SubscriberKeyManagement service at address.
+func NewRemoteKeyProvider(address string) (KeyProvider, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial key management service at %s", address)
+	}
+	return NewRemoteKeyProviderFromClient(protos.NewSubscriberKeyManagementClient(conn)), nil
+}
+
+// NewRemoteKeyProviderFromClient returns a key provider delegating to the
+// key management client.
+func NewRemoteKeyProviderFromClient(client protos.SubscriberKeyManagementClient) KeyProvider {
+	return &remoteKeyProvider{client: client}
+}
+
+func (p *remoteKeyProvider) WrapKey(dataKey []byte) (string, []byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyProviderTimeout)
+	defer cancel()
+	res, err := p.client.WrapKey(ctx, &protos.WrapKeyRequest{Plaintext: dataKey})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to wrap data key")
+	}
+	return res.KeyId, res.Ciphertext, nil
+}
+
+func (p *remoteKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyProviderTimeout)
+	defer cancel()
+	res, err := p.client.UnwrapKey(ctx, &protos.UnwrapKeyRequest{KeyId: keyID, Ciphertext: wrappedKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unwrap data key")
+	}
+	return res.Plaintext, nil
+}
+
+func (p *remoteKeyProvider) CurrentKeyID() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteKeyProviderTimeout)
+	defer cancel()
+	res, err := p.client.GetCurrentKeyID(ctx, &protos.GetCurrentKeyIDRequest{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get current key ID")
+	}
+	return res.KeyId, nil
+}
+
+type keyManagementServicer struct {
+	provider KeyProvider
+}
+
+// NewKeyManagementServicer returns a SubscriberKeyManagement servicer backed
+// by the key provider, a stand-in for an external KMS.
+func NewKeyManagementServicer(provider KeyProvider) protos.SubscriberKeyManagementServer {
+	return &keyManagementServicer{provider: provider}
+}
+
+func (s *keyManagementServicer) WrapKey(ctx context.Context, req *protos.WrapKeyRequest) (*protos.WrapKeyResponse, error) {
+	if len(req.Plaintext) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "data key must be non-empty")
+	}
+	keyID, wrapped, err := s.provider.WrapKey(req.Plaintext)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protos.WrapKeyResponse{KeyId: keyID, Ciphertext: wrapped}, nil
+}
+
+func (s *keyManagementServicer) UnwrapKey(ctx context.Context, req *protos.UnwrapKeyRequest) (*protos.UnwrapKeyResponse, error) {
+	dataKey, err := s.provider.UnwrapKey(req.KeyId, req.Ciphertext)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &protos.UnwrapKeyResponse{Plaintext: dataKey}, nil
+}
+
+func (s *keyManagementServicer) GetCurrentKeyID(ctx context.Context, req *protos.GetCurrentKeyIDRequest) (*protos.GetCurrentKeyIDResponse, error) {
+	keyID, err := s.provider.CurrentKeyID()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &protos.GetCurrentKeyIDResponse{KeyId: keyID}, nil
+}
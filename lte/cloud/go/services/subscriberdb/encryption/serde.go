@@ -0,0 +1,83 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"sync"
+
+	"magma/orc8r/cloud/go/serde"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	encryptor   *Encryptor
+	encryptorMu sync.RWMutex
+)
+
+// SetEncryptor sets the encryptor used by the secrets serdes of the process.
+// A nil encryptor disables encryption of new writes, while encrypted
+// documents can no longer be read.
+func SetEncryptor(e *Encryptor) {
+	encryptorMu.Lock()
+	defer encryptorMu.Unlock()
+	encryptor = e
+}
+
+// GetEncryptor returns the encryptor of the process, or nil if encryption is
+// disabled.
+func GetEncryptor() *Encryptor {
+	encryptorMu.RLock()
+	defer encryptorMu.RUnlock()
+	return encryptor
+}
+
+// NewSecretsSerde returns a binary serde which envelope encrypts the secrets
+// at paths of the model's JSON serialization when an encryptor is set, and
+// transparently decrypts them on deserialization.
+func NewSecretsSerde(domain string, serdeType string, modelPtr serde.BinaryConvertible, paths ...SecretPath) serde.Serde {
+	return &secretsSerde{Serde: serde.NewBinarySerde(domain, serdeType, modelPtr), paths: paths}
+}
+
+type secretsSerde struct {
+	serde.Serde
+	paths []SecretPath
+}
+
+func (s *secretsSerde) Serialize(in interface{}) ([]byte, error) {
+	doc, err := s.Serde.Serialize(in)
+	if err != nil {
+		return nil, err
+	}
+	e := GetEncryptor()
+	if e == nil {
+		return doc, nil
+	}
+	return e.EncryptSecrets(doc, s.paths)
+}
+
+func (s *secretsSerde) Deserialize(in []byte) (interface{}, error) {
+	if IsEncrypted(in) {
+		e := GetEncryptor()
+		if e == nil {
+			return nil, errors.Errorf("cannot deserialize %s, its secrets are encrypted but encryption is not configured", s.GetType())
+		}
+		decrypted, err := e.DecryptSecrets(in)
+		if err != nil {
+			return nil, err
+		}
+		in = decrypted
+	}
+	return s.Serde.Deserialize(in)
+}
@@ -0,0 +1,720 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	ltemodels "magma/lte/cloud/go/services/lte/obsidian/models"
+	policydbmodels "magma/lte/cloud/go/services/policydb/obsidian/models"
+	"magma/lte/cloud/go/services/subscriberdb"
+	subscribermodels "magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/storage"
+	merrors "magma/orc8r/lib/go/errors"
+)
+
+const (
+	ImportSubscribersV2Path = ListSubscribersV2Path + obsidian.UrlSep + "import"
+	ExportSubscribersV2Path = ListSubscribersV2Path + obsidian.UrlSep + "export"
+
+	ParamFormat    = "format"
+	ParamDryRun    = "dry_run"
+	ParamChunkSize = "chunk_size"
+
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+
+	mimeTextCSV         = "text/csv"
+	mimeApplicationJSON = "application/x-ndjson"
+
+	defaultImportChunkSize   = 500
+	maxImportChunkSize       = 5000
+	maxReportedImportErrors  = 1000
+	exportPageSize           = 1000
+	csvListSeparator         = ";"
+	csvStaticIPSeparator     = "="
+	defaultSubscriberProfile = "default"
+)
+
+// csvColumns are the columns of subscriber CSV files, in export order.
+// Only id & auth_key are required on import.
+var csvColumns = []string{"id", "auth_key", "auth_opc", "name", "state", "sub_profile", "active_apns", "static_ips", "msisdn"}
+
+// subscriberRecord is a row of a subscriber file: the subscriber & its
+// optional MSISDN.
+type subscriberRecord struct {
+	*subscribermodels.MutableSubscriber
+	Msisdn string `json:"msisdn,omitempty"`
+}
+
+// subscriberReader reads subscriber records from a file, one at a time.
+// It returns io.EOF once all records are read. Errors other than
+// rowError stop the import.
+type subscriberReader interface {
+	Read() (*subscriberRecord, error)
+}
+
+// rowError is returned by readers for rows which can't be parsed; the
+// following rows can still be read.
+type rowError struct {
+	id  string
+	err error
+}
+
+func (e *rowError) Error() string {
+	return e.err.Error()
+}
+
+// importSubscribersV2Handler streams a CSV or NDJSON subscribers file,
+// validating each row and creating the valid subscribers in chunks of
+// chunk_size subscribers per configurator transaction. Rows which fail are
+// reported by row number in the result. With dry_run, rows are only validated.
+// Since chunks are committed as the file is read, an error which stops the
+// import midway is reported in the result along with the rows processed so
+// far, rather than as an error status.
+func importSubscribersV2Handler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	format, nerr := getSubscriberFileFormat(c, c.Request().Header.Get(echo.HeaderContentType))
+	if nerr != nil {
+		return nerr
+	}
+	dryRun := false
+	if dryRunParam := c.QueryParam(ParamDryRun); dryRunParam != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(dryRunParam)
+		if err != nil {
+			return obsidian.HttpError(fmt.Errorf("invalid dry run parameter: %s", err), http.StatusBadRequest)
+		}
+	}
+	chunkSize := uint64(defaultImportChunkSize)
+	if chunkSizeParam := c.QueryParam(ParamChunkSize); chunkSizeParam != "" {
+		var err error
+		chunkSize, err = strconv.ParseUint(chunkSizeParam, 10, 32)
+		if err != nil || chunkSize == 0 || chunkSize > maxImportChunkSize {
+			err := fmt.Errorf("invalid chunk size parameter, must be between 1 and %d", maxImportChunkSize)
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+	}
+
+	var reader subscriberReader
+	body := c.Request().Body
+	defer body.Close()
+	if format == FormatCSV {
+		csvReader, err := newCSVSubscriberReader(body)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		reader = csvReader
+	} else {
+		reader = &ndjsonSubscriberReader{reader: bufio.NewReader(body)}
+	}
+
+	importer, err := newSubscriberImporter(c.Request().Context(), networkID, dryRun, int(chunkSize))
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if rowErr, ok := err.(*rowError); ok {
+			importer.skipRow(rowErr.id, rowErr.err)
+			continue
+		}
+		if err != nil {
+			importer.stop(errors.Wrapf(err, "failed to read row %d", importer.result.TotalRows+1))
+			break
+		}
+		if err := importer.addRow(record); err != nil {
+			importer.stop(err)
+			break
+		}
+	}
+	// The rows read before the import stopped are still created
+	if err := importer.flush(); err != nil {
+		importer.stop(err)
+	}
+	return c.JSON(http.StatusOK, importer.result)
+}
+
+// exportSubscribersV2Handler streams all subscribers of the network, page by
+// page, in the format accepted by the import endpoint.
+func exportSubscribersV2Handler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	format, nerr := getSubscriberFileFormat(c, c.Request().Header.Get(echo.HeaderAccept))
+	if nerr != nil {
+		return nerr
+	}
+	msisdnsByIMSI, err := getMSISDNsByIMSI(networkID)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	// Load the first page before writing the headers, so errors can still be
+	// reported with a status code
+	subs, pageToken, err := loadMutableSubscriberPage(networkID, exportPageSize, "")
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	res := c.Response()
+	var writeRecord func(*subscriberRecord) error
+	var flush func() error
+	if format == FormatCSV {
+		res.Header().Set(echo.HeaderContentType, mimeTextCSV)
+		csvWriter := csv.NewWriter(res)
+		if err := csvWriter.Write(csvColumns); err != nil {
+			return err
+		}
+		writeRecord = func(record *subscriberRecord) error { return csvWriter.Write(toCSVRow(record)) }
+		flush = func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		}
+	} else {
+		res.Header().Set(echo.HeaderContentType, mimeApplicationJSON)
+		encoder := json.NewEncoder(res)
+		writeRecord = func(record *subscriberRecord) error { return encoder.Encode(record) }
+		flush = func() error { return nil }
+	}
+	res.WriteHeader(http.StatusOK)
+
+	for {
+		imsis := make([]string, 0, len(subs))
+		for imsi := range subs {
+			imsis = append(imsis, imsi)
+		}
+		sort.Strings(imsis)
+		for _, imsi := range imsis {
+			if err := writeRecord(&subscriberRecord{MutableSubscriber: subs[imsi], Msisdn: msisdnsByIMSI[imsi]}); err != nil {
+				return err
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		res.Flush()
+
+		if pageToken == "" {
+			return nil
+		}
+		subs, pageToken, err = loadMutableSubscriberPage(networkID, exportPageSize, pageToken)
+		if err != nil {
+			// The status code is already sent, the truncated file is the
+			// only error indication left to the client
+			return err
+		}
+	}
+}
+
+// getSubscriberFileFormat returns the format query parameter if set, else
+// the format matching the passed content type, defaulting to CSV.
+func getSubscriberFileFormat(c echo.Context, contentType string) (string, *echo.HTTPError) {
+	format := c.QueryParam(ParamFormat)
+	switch {
+	case format == FormatCSV || format == FormatNDJSON:
+		return format, nil
+	case format != "":
+		return "", obsidian.HttpError(fmt.Errorf("unsupported file format '%s', expected %s or %s", format, FormatCSV, FormatNDJSON), http.StatusBadRequest)
+	case strings.HasPrefix(contentType, mimeApplicationJSON):
+		return FormatNDJSON, nil
+	default:
+		return FormatCSV, nil
+	}
+}
+
+// subscriberImporter validates subscriber records and creates them in
+// chunks, keeping track of the import result.
+type subscriberImporter struct {
+	// ctx carries the request's operator to the configurator writes
+	ctx       context.Context
+	networkID string
+	dryRun    bool
+	chunkSize int
+
+	result *subscribermodels.SubscriberImportResult
+
+	// chunk of valid records waiting to be created, with their row numbers
+	chunk     []*subscriberRecord
+	chunkRows []int64
+
+	// network entities subscribers can reference, loaded once per import
+	apns      map[string]struct{}
+	policies  map[string]struct{}
+	baseNames map[string]struct{}
+	profiles  map[string]struct{}
+
+	// IMSIs by assigned MSISDN, and rows by imported subscriber ID.
+	// The records of the current chunk are only added once it's created,
+	// until then they're tracked by the pending maps.
+	msisdns           map[string]string
+	importedID        map[string]int64
+	pendingMSISDNs    map[string]string
+	pendingImportedID map[string]int64
+}
+
+func newSubscriberImporter(ctx context.Context, networkID string, dryRun bool, chunkSize int) (*subscriberImporter, error) {
+	importer := &subscriberImporter{
+		ctx:               ctx,
+		networkID:         networkID,
+		dryRun:            dryRun,
+		chunkSize:         chunkSize,
+		result:            &subscribermodels.SubscriberImportResult{DryRun: dryRun, Errors: []*subscribermodels.SubscriberImportError{}},
+		profiles:          map[string]struct{}{defaultSubscriberProfile: {}},
+		importedID:        map[string]int64{},
+		pendingMSISDNs:    map[string]string{},
+		pendingImportedID: map[string]int64{},
+	}
+	var err error
+	if importer.apns, err = loadEntityKeySet(networkID, lte.APNEntityType); err != nil {
+		return nil, err
+	}
+	if importer.policies, err = loadEntityKeySet(networkID, lte.PolicyRuleEntityType); err != nil {
+		return nil, err
+	}
+	if importer.baseNames, err = loadEntityKeySet(networkID, lte.BaseNameEntityType); err != nil {
+		return nil, err
+	}
+	networkConfig, err := configurator.LoadNetworkConfig(networkID, lte.CellularNetworkConfigType, serdes.Network)
+	if err != nil && err != merrors.ErrNotFound {
+		return nil, err
+	}
+	if err == nil {
+		for profile := range networkConfig.(*ltemodels.NetworkCellularConfigs).Epc.SubProfiles {
+			importer.profiles[profile] = struct{}{}
+		}
+	}
+	msisdns, err := subscriberdb.ListMSISDNs(networkID)
+	if err != nil {
+		return nil, err
+	}
+	importer.msisdns = map[string]string{}
+	for msisdn, imsi := range msisdns {
+		importer.msisdns[msisdn] = imsi
+	}
+	return importer, nil
+}
+
+// addRow validates the record, adding it to the current chunk if valid.
+// Returns an error only if the chunk can't be created at all, which stops
+// the import.
+func (i *subscriberImporter) addRow(record *subscriberRecord) error {
+	i.result.TotalRows++
+	row := i.result.TotalRows
+	if err := i.validate(record, row); err != nil {
+		i.addError(row, string(record.ID), err)
+		return nil
+	}
+	i.pendingImportedID[string(record.ID)] = row
+	if record.Msisdn != "" {
+		i.pendingMSISDNs[record.Msisdn] = string(record.ID)
+	}
+	i.chunk = append(i.chunk, record)
+	i.chunkRows = append(i.chunkRows, row)
+	if len(i.chunk) >= i.chunkSize {
+		return i.flush()
+	}
+	return nil
+}
+
+// stop reports the error which stopped the import before the end of the
+// file.
+func (i *subscriberImporter) stop(err error) {
+	i.result.StopError = err.Error()
+}
+
+// skipRow reports a row which couldn't be parsed.
+func (i *subscriberImporter) skipRow(id string, err error) {
+	i.result.TotalRows++
+	i.addError(i.result.TotalRows, id, err)
+}
+
+// flush creates the subscribers of the current chunk in a single
+// transaction, then assigns their MSISDNs. If the transaction fails, all the
+// chunk rows are reported as failed. Returns an error only if the chunk
+// can't be created at all, in which case its rows are reported as failed too.
+func (i *subscriberImporter) flush() error {
+	chunk, rows := i.chunk, i.chunkRows
+	i.chunk, i.chunkRows = nil, nil
+	i.pendingImportedID, i.pendingMSISDNs = map[string]int64{}, map[string]string{}
+	if len(chunk) == 0 {
+		return nil
+	}
+
+	// Subscribers which already exist are skipped, the others are still
+	// created
+	ids := make([]string, 0, len(chunk))
+	for _, record := range chunk {
+		ids = append(ids, string(record.ID))
+	}
+	found, _, err := configurator.LoadSerializedEntities(i.networkID, nil, nil, nil, storage.MakeTKs(lte.SubscriberEntityType, ids), configurator.EntityLoadCriteria{})
+	if err != nil {
+		err = errors.Wrap(err, "failed to load existing subscribers")
+		for idx, record := range chunk {
+			i.addError(rows[idx], string(record.ID), err)
+		}
+		return err
+	}
+	existing := map[string]struct{}{}
+	for _, ent := range found {
+		existing[ent.Key] = struct{}{}
+	}
+
+	var ents configurator.NetworkEntities
+	var created []*subscriberRecord
+	var createdRows []int64
+	for idx, record := range chunk {
+		if _, ok := existing[string(record.ID)]; ok {
+			i.addError(rows[idx], string(record.ID), errors.New("subscriber already exists"))
+			continue
+		}
+		ents = append(ents, getCreateSubscriberEnts(record.MutableSubscriber)...)
+		created = append(created, record)
+		createdRows = append(createdRows, rows[idx])
+	}
+	if len(created) == 0 {
+		return nil
+	}
+	if i.dryRun {
+		i.result.Imported += int64(len(created))
+		for idx, record := range created {
+			i.setImported(record, createdRows[idx], true)
+		}
+		return nil
+	}
+
+	if _, err := configurator.CreateEntitiesWithContext(i.ctx, i.networkID, ents, serdes.Entity); err != nil {
+		for idx, record := range created {
+			i.addError(createdRows[idx], string(record.ID), errors.Wrap(err, "failed to create subscriber chunk"))
+		}
+		return nil
+	}
+	i.result.Imported += int64(len(created))
+	for idx, record := range created {
+		if record.Msisdn == "" {
+			i.setImported(record, createdRows[idx], false)
+			continue
+		}
+		if err := subscriberdb.SetIMSIForMSISDN(i.networkID, record.Msisdn, string(record.ID)); err != nil {
+			// The subscriber is created, only its MSISDN is missing
+			i.addError(createdRows[idx], string(record.ID), errors.Wrap(err, "subscriber created but failed to assign MSISDN"))
+			i.setImported(record, createdRows[idx], false)
+			continue
+		}
+		i.setImported(record, createdRows[idx], true)
+	}
+	return nil
+}
+
+// setImported records the subscriber as imported, and its MSISDN as
+// assigned if withMSISDN is set.
+func (i *subscriberImporter) setImported(record *subscriberRecord, row int64, withMSISDN bool) {
+	i.importedID[string(record.ID)] = row
+	if withMSISDN && record.Msisdn != "" {
+		i.msisdns[record.Msisdn] = string(record.ID)
+	}
+}
+
+func (i *subscriberImporter) validate(record *subscriberRecord, row int64) error {
+	if record.MutableSubscriber == nil || record.Lte == nil {
+		return errors.New("missing subscriber lte configuration")
+	}
+	if err := record.ValidateModel(); err != nil {
+		return err
+	}
+	id := string(record.ID)
+	if previousRow, ok := i.importedID[id]; ok {
+		return errors.Errorf("duplicate subscriber, already imported from row %d", previousRow)
+	}
+	if previousRow, ok := i.pendingImportedID[id]; ok {
+		return errors.Errorf("duplicate subscriber, already imported from row %d", previousRow)
+	}
+	if _, ok := i.profiles[string(record.Lte.SubProfile)]; !ok {
+		return errors.Errorf("subscriber profile '%s' does not exist for the network", record.Lte.SubProfile)
+	}
+	for _, apn := range record.ActiveApns {
+		if _, ok := i.apns[apn]; !ok {
+			return errors.Errorf("APN '%s' does not exist for the network", apn)
+		}
+	}
+	for apn, policies := range record.ActivePoliciesByApn {
+		if _, ok := i.apns[apn]; !ok {
+			return errors.Errorf("APN '%s' does not exist for the network", apn)
+		}
+		if err := checkKeysExist(i.policies, "policy", policyIDsToStrings(policies)); err != nil {
+			return err
+		}
+	}
+	if err := checkKeysExist(i.policies, "policy", policyIDsToStrings(record.ActivePolicies)); err != nil {
+		return err
+	}
+	if err := checkKeysExist(i.baseNames, "base name", baseNamesToStrings(record.ActiveBaseNames)); err != nil {
+		return err
+	}
+	if record.Msisdn != "" {
+		if err := (subscribermodels.Msisdn(record.Msisdn)).Validate(strfmt.Default); err != nil {
+			return err
+		}
+		if imsi, ok := i.msisdns[record.Msisdn]; ok && imsi != id {
+			return errors.Errorf("MSISDN %s is already assigned to %s", record.Msisdn, imsi)
+		}
+		if imsi, ok := i.pendingMSISDNs[record.Msisdn]; ok && imsi != id {
+			return errors.Errorf("MSISDN %s is already assigned to %s", record.Msisdn, imsi)
+		}
+	}
+	return nil
+}
+
+func (i *subscriberImporter) addError(row int64, id string, err error) {
+	i.result.Failed++
+	if len(i.result.Errors) < maxReportedImportErrors {
+		i.result.Errors = append(i.result.Errors, &subscribermodels.SubscriberImportError{Row: row, ID: id, Error: err.Error()})
+	}
+}
+
+// csvSubscriberReader reads subscribers from a CSV file with a header row.
+type csvSubscriberReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func newCSVSubscriberReader(r io.Reader) (*csvSubscriberReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("empty CSV file, expected a header row")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read CSV header")
+	}
+	known := map[string]struct{}{}
+	for _, column := range csvColumns {
+		known[column] = struct{}{}
+	}
+	columns := map[string]int{}
+	for idx, column := range header {
+		column = strings.TrimSpace(column)
+		if _, ok := known[column]; !ok {
+			return nil, errors.Errorf("unknown CSV column '%s', expected columns are %s", column, strings.Join(csvColumns, ","))
+		}
+		columns[column] = idx
+	}
+	for _, required := range []string{"id", "auth_key"} {
+		if _, ok := columns[required]; !ok {
+			return nil, errors.Errorf("missing required CSV column '%s'", required)
+		}
+	}
+	return &csvSubscriberReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvSubscriberReader) Read() (*subscriberRecord, error) {
+	fields, err := r.reader.Read()
+	if err != nil {
+		// The reader resumes after the malformed row
+		if _, ok := err.(*csv.ParseError); ok {
+			return nil, &rowError{err: err}
+		}
+		return nil, err
+	}
+	get := func(column string) string {
+		idx, ok := r.columns[column]
+		if !ok || idx >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[idx])
+	}
+
+	id := get("id")
+	if id != "" && !strings.HasPrefix(id, "IMSI") {
+		id = "IMSI" + id
+	}
+	authKey, err := hex.DecodeString(get("auth_key"))
+	if err != nil {
+		return nil, &rowError{id: id, err: errors.Wrap(err, "invalid auth_key, expected hex")}
+	}
+	authOpc, err := hex.DecodeString(get("auth_opc"))
+	if err != nil {
+		return nil, &rowError{id: id, err: errors.Wrap(err, "invalid auth_opc, expected hex")}
+	}
+	state := get("state")
+	if state == "" {
+		state = subscribermodels.LteSubscriptionStateACTIVE
+	}
+	profile := get("sub_profile")
+	if profile == "" {
+		profile = defaultSubscriberProfile
+	}
+	sub := &subscribermodels.MutableSubscriber{
+		ID:   policydbmodels.SubscriberID(id),
+		Name: get("name"),
+		Lte: &subscribermodels.LteSubscription{
+			AuthAlgo:   subscribermodels.LteSubscriptionAuthAlgoMILENAGE,
+			AuthKey:    authKey,
+			AuthOpc:    authOpc,
+			State:      state,
+			SubProfile: subscribermodels.SubProfile(profile),
+		},
+	}
+	if apns := get("active_apns"); apns != "" {
+		sub.ActiveApns = strings.Split(apns, csvListSeparator)
+	}
+	if staticIPs := get("static_ips"); staticIPs != "" {
+		sub.StaticIps = subscribermodels.SubscriberStaticIps{}
+		for _, pair := range strings.Split(staticIPs, csvListSeparator) {
+			apnAndIP := strings.SplitN(pair, csvStaticIPSeparator, 2)
+			if len(apnAndIP) != 2 {
+				return nil, &rowError{id: id, err: errors.Errorf("invalid static IP '%s', expected apn=ip", pair)}
+			}
+			sub.StaticIps[apnAndIP[0]] = strfmt.IPv4(apnAndIP[1])
+		}
+	}
+	return &subscriberRecord{MutableSubscriber: sub, Msisdn: get("msisdn")}, nil
+}
+
+// ndjsonSubscriberReader reads subscribers from newline delimited JSON
+// mutable subscriber objects, one per line. Blank lines are skipped.
+type ndjsonSubscriberReader struct {
+	reader *bufio.Reader
+}
+
+func (r *ndjsonSubscriberReader) Read() (*subscriberRecord, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		// Each line is decoded on its own, so a malformed row is reported
+		// without losing track of the following rows
+		record := &subscriberRecord{MutableSubscriber: &subscribermodels.MutableSubscriber{}}
+		if err := json.Unmarshal(line, record); err != nil {
+			return nil, &rowError{id: getNDJSONRowID(line), err: err}
+		}
+		return record, nil
+	}
+}
+
+// getNDJSONRowID returns the subscriber ID of an NDJSON row which couldn't
+// be decoded, if it can be found.
+func getNDJSONRowID(line []byte) string {
+	row := struct {
+		ID string `json:"id"`
+	}{}
+	_ = json.Unmarshal(line, &row)
+	return row.ID
+}
+
+func toCSVRow(record *subscriberRecord) []string {
+	lteSub := record.Lte
+	if lteSub == nil {
+		lteSub = &subscribermodels.LteSubscription{}
+	}
+	staticIPs := make([]string, 0, len(record.StaticIps))
+	for apn, ip := range record.StaticIps {
+		staticIPs = append(staticIPs, apn+csvStaticIPSeparator+ip.String())
+	}
+	sort.Strings(staticIPs)
+	return []string{
+		string(record.ID),
+		hex.EncodeToString(lteSub.AuthKey),
+		hex.EncodeToString(lteSub.AuthOpc),
+		record.Name,
+		lteSub.State,
+		string(lteSub.SubProfile),
+		strings.Join(record.ActiveApns, csvListSeparator),
+		strings.Join(staticIPs, csvListSeparator),
+		record.Msisdn,
+	}
+}
+
+func getMSISDNsByIMSI(networkID string) (map[string]string, error) {
+	imsisByMSISDN, err := subscriberdb.ListMSISDNs(networkID)
+	if err != nil {
+		return nil, err
+	}
+	msisdnsByIMSI := make(map[string]string, len(imsisByMSISDN))
+	for msisdn, imsi := range imsisByMSISDN {
+		msisdnsByIMSI[imsi] = msisdn
+	}
+	return msisdnsByIMSI, nil
+}
+
+func loadEntityKeySet(networkID, entityType string) (map[string]struct{}, error) {
+	keys, err := configurator.ListEntityKeys(networkID, entityType)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[key] = struct{}{}
+	}
+	return set, nil
+}
+
+func checkKeysExist(set map[string]struct{}, entityName string, keys []string) error {
+	for _, key := range keys {
+		if _, ok := set[key]; !ok {
+			return errors.Errorf("%s '%s' does not exist for the network", entityName, key)
+		}
+	}
+	return nil
+}
+
+func policyIDsToStrings(policyIDs policydbmodels.PolicyIds) []string {
+	ids := make([]string, 0, len(policyIDs))
+	for _, id := range policyIDs {
+		ids = append(ids, string(id))
+	}
+	return ids
+}
+
+func baseNamesToStrings(baseNames policydbmodels.BaseNames) []string {
+	names := make([]string, 0, len(baseNames))
+	for _, name := range baseNames {
+		names = append(names, string(name))
+	}
+	return names
+}
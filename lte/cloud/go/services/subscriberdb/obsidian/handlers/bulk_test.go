@@ -0,0 +1,276 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	lteModels "magma/lte/cloud/go/services/lte/obsidian/models"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/handlers"
+	subscriberModels "magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	subscriberdbTestInit "magma/lte/cloud/go/services/subscriberdb/test_init"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/services/configurator"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
+	stateTestInit "magma/orc8r/cloud/go/services/state/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testKeyHex = "11111111111111111111111111111111"
+	testOpcHex = "22222222222222222222222222222222"
+)
+
+func TestImportSubscribersCSV(t *testing.T) {
+	initBulkTest(t)
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ImportSubscribersV2Path, obsidian.POST).HandlerFunc
+
+	file := strings.Join([]string{
+		"id,auth_key,auth_opc,state,sub_profile,active_apns,static_ips,msisdn",
+		"IMSI001010000000001," + testKeyHex + "," + testOpcHex + ",,,apn0;apn1,apn1=192.168.100.1,13109976224",
+		"001010000000002," + testKeyHex + ",,INACTIVE,present-profile,apn0,,",
+		"IMSI001010000000003,not-hex,,,,,,",
+		"IMSI001010000000004," + testKeyHex + ",,,missing-profile,,,",
+		"IMSI001010000000005," + testKeyHex + ",,,,apn2,,",
+		"IMSI001010000000001," + testKeyHex + ",,,,,,",
+		"IMSI001010000000006," + testKeyHex + ",,,,,,13109976224",
+	}, "\n")
+
+	// Dry run validates without creating anything
+	rec := runBulkRequest(t, e, importSubscribers, "POST", "?dry_run=true", "text/csv", file)
+	assert.Equal(t, 200, rec.Code)
+	result := &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, true, result.DryRun)
+	assert.Equal(t, int64(7), result.TotalRows)
+	assert.Equal(t, int64(2), result.Imported)
+	assert.Equal(t, int64(5), result.Failed)
+	assert.Len(t, result.Errors, 5)
+	assert.Equal(t, int64(3), result.Errors[0].Row)
+	assert.Contains(t, result.Errors[0].Error, "invalid auth_key, expected hex")
+	assert.Contains(t, result.Errors[1].Error, "subscriber profile 'missing-profile' does not exist for the network")
+	assert.Contains(t, result.Errors[2].Error, "APN 'apn2' does not exist for the network")
+	assert.Contains(t, result.Errors[3].Error, "duplicate subscriber, already imported from row 1")
+	assert.Contains(t, result.Errors[4].Error, "MSISDN 13109976224 is already assigned to IMSI001010000000001")
+	keys, err := configurator.ListEntityKeys("n1", lte.SubscriberEntityType)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// Import creates the valid rows, one subscriber per chunk
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "?chunk_size=1", "text/csv", file)
+	assert.Equal(t, 200, rec.Code)
+	result = &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, false, result.DryRun)
+	assert.Equal(t, int64(2), result.Imported)
+	assert.Equal(t, int64(5), result.Failed)
+	keys, err = configurator.ListEntityKeys("n1", lte.SubscriberEntityType)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001010000000001", "IMSI001010000000002"}, keys)
+	imsi, err := subscriberdb.GetIMSIForMSISDN("n1", "13109976224")
+	assert.NoError(t, err)
+	assert.Equal(t, "IMSI001010000000001", imsi)
+
+	// Existing subscribers are reported as failed
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "", "text/csv", "id,auth_key\nIMSI001010000000002,"+testKeyHex)
+	assert.Equal(t, 200, rec.Code)
+	result = &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, int64(0), result.Imported)
+	assert.Equal(t, int64(1), result.Failed)
+	assert.Equal(t, "subscriber already exists", result.Errors[0].Error)
+
+	// The MSISDN of a row which fails to be created stays available
+	file = strings.Join([]string{
+		"id,auth_key,msisdn",
+		"IMSI001010000000002," + testKeyHex + ",13109976225",
+		"IMSI001010000000007," + testKeyHex + ",13109976225",
+	}, "\n")
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "?chunk_size=1", "text/csv", file)
+	assert.Equal(t, 200, rec.Code)
+	result = &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, int64(1), result.Imported)
+	assert.Equal(t, int64(1), result.Failed)
+	assert.Equal(t, int64(1), result.Errors[0].Row)
+	imsi, err = subscriberdb.GetIMSIForMSISDN("n1", "13109976225")
+	assert.NoError(t, err)
+	assert.Equal(t, "IMSI001010000000007", imsi)
+
+	// Malformed rows are reported as failed
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "?dry_run=true", "text/csv", "id,auth_key\nIMSI001010000000008,\"bad\"quote\"\nIMSI001010000000009,"+testKeyHex)
+	assert.Equal(t, 200, rec.Code)
+	result = &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, int64(2), result.TotalRows)
+	assert.Equal(t, int64(1), result.Imported)
+	assert.Equal(t, int64(1), result.Failed)
+
+	// Unknown columns fail the whole file
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "", "text/csv", "id,auth_key,ki\n")
+	assert.Equal(t, 400, rec.Code)
+
+	// Missing required columns fail the whole file
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "", "text/csv", "id\n")
+	assert.Equal(t, 400, rec.Code)
+}
+
+func TestImportSubscribersNDJSON(t *testing.T) {
+	initBulkTest(t)
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ImportSubscribersV2Path, obsidian.POST).HandlerFunc
+
+	sub0 := newMutableSubscriber("IMSI001010000000001")
+	sub1 := newMutableSubscriber("IMSI001010000000002")
+	sub1.Lte.AuthKey = []byte("too short")
+	file := strings.Join([]string{
+		mustMarshal(t, sub0),
+		`{"id": "IMSI001010000000003", "lte": "not an object"}`,
+		mustMarshal(t, sub1),
+	}, "\n")
+
+	rec := runBulkRequest(t, e, importSubscribers, "POST", "", "application/x-ndjson", file)
+	assert.Equal(t, 200, rec.Code)
+	result := &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, int64(3), result.TotalRows)
+	assert.Equal(t, int64(1), result.Imported)
+	assert.Equal(t, int64(2), result.Failed)
+	assert.Equal(t, int64(2), result.Errors[0].Row)
+	assert.Equal(t, int64(3), result.Errors[1].Row)
+	assert.Contains(t, result.Errors[1].Error, "expected lte auth key to be 16 bytes")
+
+	ent, err := configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI001010000000001", configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, sub0.Lte, ent.Config.(*subscriberModels.SubscriberConfig).Lte)
+
+	// Malformed lines are reported as failed rows, the following rows are
+	// still imported
+	file = strings.Join([]string{
+		`{"id": "IMSI001010000000004", "lte": {`,
+		"",
+		mustMarshal(t, newMutableSubscriber("IMSI001010000000005")),
+	}, "\n")
+	rec = runBulkRequest(t, e, importSubscribers, "POST", "?format=ndjson", "", file)
+	assert.Equal(t, 200, rec.Code)
+	result = &subscriberModels.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.Equal(t, int64(2), result.TotalRows)
+	assert.Equal(t, int64(1), result.Imported)
+	assert.Equal(t, int64(1), result.Failed)
+	assert.Equal(t, int64(1), result.Errors[0].Row)
+	assert.Empty(t, result.StopError)
+	keys, err := configurator.ListEntityKeys("n1", lte.SubscriberEntityType)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001010000000001", "IMSI001010000000005"}, keys)
+}
+
+func TestExportSubscribers(t *testing.T) {
+	initBulkTest(t)
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ImportSubscribersV2Path, obsidian.POST).HandlerFunc
+	exportSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ExportSubscribersV2Path, obsidian.GET).HandlerFunc
+
+	file := strings.Join([]string{
+		"id,auth_key,auth_opc,name,state,sub_profile,active_apns,static_ips,msisdn",
+		"IMSI001010000000001," + testKeyHex + "," + testOpcHex + ",Jane Doe,ACTIVE,default,apn0;apn1,apn1=192.168.100.1,13109976224",
+		"IMSI001010000000002," + testKeyHex + ",,,INACTIVE,present-profile,apn0,,",
+	}, "\n") + "\n"
+	rec := runBulkRequest(t, e, importSubscribers, "POST", "", "text/csv", file)
+	assert.Equal(t, 200, rec.Code)
+
+	// CSV export matches the imported file
+	rec = runBulkRequest(t, e, exportSubscribers, "GET", "?format=csv", "", "")
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, file, rec.Body.String())
+
+	// NDJSON export has one mutable subscriber per line
+	rec = runBulkRequest(t, e, exportSubscribers, "GET", "?format=ndjson", "", "")
+	assert.Equal(t, 200, rec.Code)
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	exported := &struct {
+		subscriberModels.MutableSubscriber
+		Msisdn string `json:"msisdn"`
+	}{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), exported))
+	assert.Equal(t, "IMSI001010000000001", string(exported.ID))
+	assert.Equal(t, "13109976224", exported.Msisdn)
+	assert.Equal(t, subscriberModels.ApnList{"apn0", "apn1"}, exported.ActiveApns)
+
+	// Unsupported format
+	rec = runBulkRequest(t, e, exportSubscribers, "GET", "?format=xml", "", "")
+	assert.Equal(t, 400, rec.Code)
+}
+
+func initBulkTest(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	subscriberdbTestInit.StartTestService(t)
+	networkConfigs := map[string]interface{}{
+		lte.CellularNetworkConfigType: &lteModels.NetworkCellularConfigs{
+			Epc: &lteModels.NetworkEpcConfigs{SubProfiles: map[string]lteModels.NetworkEpcConfigsSubProfilesAnon{"present-profile": {}}},
+		},
+	}
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Configs: networkConfigs}, serdes.Network)
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{Type: lte.APNEntityType, Key: "apn0"},
+			{Type: lte.APNEntityType, Key: "apn1"},
+		},
+		serdes.Entity,
+	)
+	assert.NoError(t, err)
+}
+
+// runBulkRequest runs the handler on a raw request body, since bulk files
+// aren't JSON payloads.
+func runBulkRequest(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, method, query, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/magma/v1/lte/n1/subscribers_v2/bulk"+query, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set(echo.HeaderContentType, contentType)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("n1")
+	if err := handler(c); err != nil {
+		c.Error(err)
+	}
+	return rec
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	marshaled, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return string(marshaled)
+}
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"magma/orc8r/cloud/go/serde"
 
@@ -62,10 +63,14 @@ const (
 	listMSISDNsPath   = ltehandlers.ManageNetworkPath + obsidian.UrlSep + "msisdns"
 	manageMSISDNsPath = listMSISDNsPath + obsidian.UrlSep + ":msisdn"
 
-	ParamMSISDN    = "msisdn"
-	ParamIP        = "ip"
-	ParamPageSize  = "page_size"
-	ParamPageToken = "page_token"
+	ParamMSISDN     = "msisdn"
+	ParamIP         = "ip"
+	ParamIMSIPrefix = "imsi_prefix"
+	ParamState      = "state"
+	ParamSubProfile = "sub_profile"
+	ParamAPN        = "apn"
+	ParamPageSize   = "page_size"
+	ParamPageToken  = "page_token"
 )
 
 func GetHandlers() []obsidian.Handler {
@@ -73,6 +78,8 @@ func GetHandlers() []obsidian.Handler {
 		{Path: ListSubscribersPath, Methods: obsidian.GET, HandlerFunc: listSubscribersHandler},
 		{Path: ListSubscribersV2Path, Methods: obsidian.GET, HandlerFunc: listSubscribersV2Handler},
 		{Path: ListSubscribersV2Path, Methods: obsidian.POST, HandlerFunc: createSubscribersV2Handler},
+		{Path: ImportSubscribersV2Path, Methods: obsidian.POST, HandlerFunc: importSubscribersV2Handler},
+		{Path: ExportSubscribersV2Path, Methods: obsidian.GET, HandlerFunc: exportSubscribersV2Handler},
 		{Path: ListSubscribersPath, Methods: obsidian.POST, HandlerFunc: createSubscriberHandler},
 		{Path: ManageSubscriberPath, Methods: obsidian.GET, HandlerFunc: getSubscriberHandler},
 		{Path: ManageSubscriberPath, Methods: obsidian.PUT, HandlerFunc: updateSubscriberHandler},
@@ -95,6 +102,11 @@ func GetHandlers() []obsidian.Handler {
 
 const (
 	mobilitydStateExpectedMatchCount = 2
+
+	// maxFilteredPageLoads caps the configurator pages loaded to fill a
+	// filtered subscriber page, so a filter matching few subscribers doesn't
+	// scan the whole network in a single request.
+	maxFilteredPageLoads = 10
 )
 
 var (
@@ -133,6 +145,54 @@ type subscriberFilter func(sub *subscribermodels.Subscriber) bool
 
 func acceptAll(*subscribermodels.Subscriber) bool { return true }
 
+// subscriberQuery holds the subscriber config filters of a list request.
+// Empty fields match all subscribers.
+type subscriberQuery struct {
+	imsiPrefix string
+	state      string
+	subProfile string
+	apn        string
+}
+
+func getSubscriberQuery(c echo.Context) (subscriberQuery, *echo.HTTPError) {
+	query := subscriberQuery{
+		imsiPrefix: c.QueryParam(ParamIMSIPrefix),
+		state:      c.QueryParam(ParamState),
+		subProfile: c.QueryParam(ParamSubProfile),
+		apn:        c.QueryParam(ParamAPN),
+	}
+	if query.imsiPrefix != "" && !strings.HasPrefix(query.imsiPrefix, "IMSI") {
+		query.imsiPrefix = "IMSI" + query.imsiPrefix
+	}
+	if query.state != "" && query.state != subscribermodels.LteSubscriptionStateACTIVE && query.state != subscribermodels.LteSubscriptionStateINACTIVE {
+		err := fmt.Errorf("invalid state parameter '%s', expected %s or %s", query.state, subscribermodels.LteSubscriptionStateACTIVE, subscribermodels.LteSubscriptionStateINACTIVE)
+		return subscriberQuery{}, obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	return query, nil
+}
+
+// hasConfigFilters returns true if the query has filters which can't be
+// applied by configurator.
+func (q subscriberQuery) hasConfigFilters() bool {
+	return q.state != "" || q.subProfile != "" || q.apn != ""
+}
+
+func (q subscriberQuery) matches(sub *subscribermodels.Subscriber) bool {
+	if q.imsiPrefix != "" && !strings.HasPrefix(string(sub.ID), q.imsiPrefix) {
+		return false
+	}
+	if q.state != "" && (sub.Lte == nil || sub.Lte.State != q.state) {
+		return false
+	}
+	if q.subProfile != "" && (sub.Lte == nil || string(sub.Lte.SubProfile) != q.subProfile) {
+		return false
+	}
+	if q.apn != "" && !funk.ContainsString(sub.ActiveApns, q.apn) {
+		return false
+	}
+	return true
+}
+
 // listSubscribersHandler handles the base subscriber endpoint.
 // The returned subscribers can be filtered using the following query
 // parameters
@@ -200,6 +260,18 @@ func listSubscribersHandler(c echo.Context) error {
 // each reported subscriber is checked to ensure it actually is assigned the
 // requested IP.
 //
+// The returned subscribers can also be filtered by subscriber config, with
+// any combination of the following query parameters
+//	- imsi_prefix
+//	- state
+//	- sub_profile
+//	- apn
+//
+// The IMSI prefix filter is applied by configurator. The other config filters
+// are applied to each loaded configurator page, so a returned page can
+// hold fewer subscribers than the page size even when more pages remain.
+// The total count only accounts for the IMSI prefix filter.
+//
 // The returned subscribers can be paginated using the following parameters
 //  - page_size
 //  - page_token
@@ -226,6 +298,10 @@ func listSubscribersV2Handler(c echo.Context) error {
 		}
 	}
 	pageToken := c.QueryParam(ParamPageToken)
+	query, nerr := getSubscriberQuery(c)
+	if nerr != nil {
+		return nerr
+	}
 	reqCtx := c.Request().Context()
 
 	// First check for query params to filter by
@@ -234,7 +310,7 @@ func listSubscribersV2Handler(c echo.Context) error {
 		if err != nil {
 			return makeErr(err)
 		}
-		subs, err := loadSubscribers(reqCtx, networkID, acceptAll, queryIMSI)
+		subs, err := loadSubscribers(reqCtx, networkID, query.matches, queryIMSI)
 		if err != nil {
 			return makeErr(err)
 		}
@@ -245,7 +321,7 @@ func listSubscribersV2Handler(c echo.Context) error {
 		if err != nil {
 			return makeErr(err)
 		}
-		filter := func(sub *subscribermodels.Subscriber) bool { return sub.IsAssignedIP(ip) }
+		filter := func(sub *subscribermodels.Subscriber) bool { return sub.IsAssignedIP(ip) && query.matches(sub) }
 		subs, err := loadSubscribers(reqCtx, networkID, filter, queryIMSIs...)
 		if err != nil {
 			return makeErr(err)
@@ -255,16 +331,17 @@ func listSubscribersV2Handler(c echo.Context) error {
 
 	// List subscribers for a given page. If no page is specified, the max
 	// size will be returned.
-	subs, nextPageToken, err := loadSubscriberPage(reqCtx, networkID, uint32(pageSize), pageToken)
+	subs, nextPageToken, err := loadFilteredSubscriberPage(reqCtx, networkID, query, uint32(pageSize), pageToken)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
 	// get total number of subscribers
 	loadCriteria := configurator.EntityLoadCriteria{}
-	count, err := configurator.CountEntitiesOfType(
+	count, err := configurator.CountEntitiesOfTypeWithKeyPrefix(
 		networkID,
 		lte.SubscriberEntityType,
+		query.imsiPrefix,
 		loadCriteria,
 		serdes.Entity)
 	if err != nil {
@@ -295,7 +372,7 @@ func createSubscriberHandler(c echo.Context) error {
 		return nerr
 	}
 
-	nerr = createSubscribers(networkID, payload)
+	nerr = createSubscribers(c.Request().Context(), networkID, payload)
 	if nerr != nil {
 		return nerr
 	}
@@ -320,7 +397,7 @@ func createSubscribersV2Handler(c echo.Context) error {
 		return nerr
 	}
 
-	nerr = createSubscribers(networkID, payload...)
+	nerr = createSubscribers(c.Request().Context(), networkID, payload...)
 	if nerr != nil {
 		return nerr
 	}
@@ -333,10 +410,11 @@ func getSubscriberHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	subs, err := loadSubscriber(c.Request().Context(), networkID, subscriberID)
+	subs, version, err := loadSubscriberWithVersion(c.Request().Context(), networkID, subscriberID)
 	if err != nil {
 		return makeErr(err)
 	}
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, subs)
 }
 
@@ -357,12 +435,16 @@ func updateSubscriberHandler(c echo.Context) error {
 		err := fmt.Errorf("subscriber ID from parameters (%s) and payload (%s) must match", subscriberID, payload.ID)
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 
 	if nerr := validateSubscriberProfiles(networkID, string(payload.Lte.SubProfile)); nerr != nil {
 		return nerr
 	}
 
-	err := updateSubscriber(networkID, payload)
+	err := updateSubscriber(c.Request().Context(), networkID, payload, expectedVersion)
 	if err != nil {
 		return makeErr(err)
 	}
@@ -375,12 +457,20 @@ func deleteSubscriberHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := deleteSubscriber(networkID, subscriberID)
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := deleteSubscriber(c.Request().Context(), networkID, subscriberID, expectedVersion)
 	if err == merrors.ErrNotFound {
+		// A conditional delete can't match a missing subscriber
+		if expectedVersion != nil {
+			return obsidian.VersionConflictHttpErr()
+		}
 		return c.NoContent(http.StatusNoContent)
 	}
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -498,6 +588,10 @@ func updateSubscriberProfile(c echo.Context) error {
 	if err := payload.ValidateModel(); err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 
 	currentCfg, err := configurator.LoadEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, serdes.Entity)
 	if err != nil {
@@ -510,11 +604,15 @@ func updateSubscriberProfile(c echo.Context) error {
 		return nerr
 	}
 
-	_, err = configurator.UpdateEntity(
+	_, err = configurator.UpdateEntityWithContext(
+		c.Request().Context(),
 		networkID,
-		configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg},
+		configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg, ExpectedVersion: expectedVersion},
 		serdes.Entity,
 	)
+	if err == merrors.ErrVersionConflict {
+		return obsidian.VersionConflictHttpErr()
+	}
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to update profile"), http.StatusInternalServerError)
 	}
@@ -535,7 +633,7 @@ func makeSubscriberStateHandler(desiredState string) echo.HandlerFunc {
 
 		newConfig := cfg.(*subscribermodels.SubscriberConfig)
 		newConfig.Lte.State = desiredState
-		err = configurator.CreateOrUpdateEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, newConfig, serdes.Entity)
+		err = configurator.CreateOrUpdateEntityConfigWithContext(c.Request().Context(), networkID, lte.SubscriberEntityType, subscriberID, newConfig, serdes.Entity)
 		if err != nil {
 			return obsidian.HttpError(err, http.StatusInternalServerError)
 		}
@@ -623,10 +721,17 @@ func validateSubscriberProfiles(networkID string, profiles ...string) *echo.HTTP
 }
 
 func loadSubscriber(ctx context.Context, networkID, key string) (*subscribermodels.Subscriber, error) {
+	sub, _, err := loadSubscriberWithVersion(ctx, networkID, key)
+	return sub, err
+}
+
+// loadSubscriberWithVersion loads a subscriber along with the version of its
+// entity.
+func loadSubscriberWithVersion(ctx context.Context, networkID, key string) (*subscribermodels.Subscriber, uint64, error) {
 	loadCriteria := getSubscriberLoadCriteria(0, "")
 	ent, err := configurator.LoadEntity(networkID, lte.SubscriberEntityType, key, loadCriteria, serdes.Entity)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Configurator doesn't currently support loading a specified subgraph,
@@ -641,23 +746,23 @@ func loadSubscriber(ctx context.Context, networkID, key string) (*subscribermode
 			serdes.Entity,
 		)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 	}
 
 	mutableSub, err := (&subscribermodels.MutableSubscriber{}).FromEnt(ent, policyProfileEnts)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	states, err := getStatesForIMSIs(ctx, networkID, allSubscriberStateTypes, key, serdes.State)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	sub := mutableSub.ToSubscriber()
 	sub.FillAugmentedFields(states)
-	return sub, nil
+	return sub, ent.Version, nil
 }
 
 func loadSubscribers(ctx context.Context, networkID string, includeSub subscriberFilter, keys ...string) (map[string]*subscribermodels.Subscriber, error) {
@@ -675,32 +780,65 @@ func loadSubscribers(ctx context.Context, networkID string, includeSub subscribe
 }
 
 func loadSubscriberPage(ctx context.Context, networkID string, pageSize uint32, pageToken string) (map[string]*subscribermodels.Subscriber, string, error) {
-	mutableSubs, nextPageToken, err := loadMutableSubscriberPage(networkID, pageSize, pageToken)
-	if err != nil {
-		return nil, "", err
+	return loadFilteredSubscriberPage(ctx, networkID, subscriberQuery{}, pageSize, pageToken)
+}
+
+// loadFilteredSubscriberPage loads a page of subscribers matching the query.
+// Filters other than the IMSI prefix are applied to the loaded configurator
+// pages, so further pages are loaded until the page is full, all
+// subscribers are read, or maxFilteredPageLoads pages are loaded. The page
+// can then be short or empty, with the token to continue the scan from.
+// Each load is capped to the remaining page size, so the returned token is
+// always a valid configurator page token.
+func loadFilteredSubscriberPage(ctx context.Context, networkID string, query subscriberQuery, pageSize uint32, pageToken string) (map[string]*subscribermodels.Subscriber, string, error) {
+	subs := map[string]*subscribermodels.Subscriber{}
+	nextPageToken := pageToken
+	for loads := 1; ; loads++ {
+		loadSize := pageSize
+		if pageSize != 0 {
+			loadSize = pageSize - uint32(len(subs))
+		}
+		mutableSubs, token, err := loadMutableSubscriberPageWithPrefix(networkID, query.imsiPrefix, loadSize, nextPageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, mutableSub := range mutableSubs {
+			sub := mutableSub.ToSubscriber()
+			if query.matches(sub) {
+				subs[string(sub.ID)] = sub
+			}
+		}
+		nextPageToken = token
+
+		// The max page size is only known by configurator, so unsized pages
+		// are a single load
+		if !query.hasConfigFilters() || pageSize == 0 || nextPageToken == "" || uint32(len(subs)) >= pageSize || loads >= maxFilteredPageLoads {
+			break
+		}
 	}
-	imsis := make([]string, 0, len(mutableSubs))
-	for imsi := range mutableSubs {
+
+	imsis := make([]string, 0, len(subs))
+	for imsi := range subs {
 		imsis = append(imsis, imsi)
 	}
 	states, err := loadAllStatesForIMSIs(ctx, networkID, imsis)
 	if err != nil {
 		return nil, "", err
 	}
-
-	subs := map[string]*subscribermodels.Subscriber{}
-	for _, mutableSub := range mutableSubs {
-		sub := mutableSub.ToSubscriber()
+	for _, sub := range subs {
 		sub.FillAugmentedFields(states[string(sub.ID)])
-		subs[string(sub.ID)] = sub
 	}
 
 	return subs, nextPageToken, nil
 }
 
 func loadMutableSubscriberPage(networkID string, pageSize uint32, pageToken string) (map[string]*subscribermodels.MutableSubscriber, string, error) {
+	return loadMutableSubscriberPageWithPrefix(networkID, "", pageSize, pageToken)
+}
+
+func loadMutableSubscriberPageWithPrefix(networkID string, imsiPrefix string, pageSize uint32, pageToken string) (map[string]*subscribermodels.MutableSubscriber, string, error) {
 	loadCriteria := getSubscriberLoadCriteria(pageSize, pageToken)
-	ents, nextPageToken, err := configurator.LoadAllEntitiesOfType(networkID, lte.SubscriberEntityType, loadCriteria, serdes.Entity)
+	ents, nextPageToken, err := configurator.LoadEntitiesOfTypeWithKeyPrefix(networkID, lte.SubscriberEntityType, imsiPrefix, loadCriteria, serdes.Entity)
 	if err != nil {
 		return nil, "", err
 	}
@@ -725,7 +863,7 @@ func loadMutableSubscriberPage(networkID string, pageSize uint32, pageToken stri
 	return subs, nextPageToken, nil
 }
 
-func createSubscribers(networkID string, subs ...*subscribermodels.MutableSubscriber) *echo.HTTPError {
+func createSubscribers(ctx context.Context, networkID string, subs ...*subscribermodels.MutableSubscriber) *echo.HTTPError {
 	var ents configurator.NetworkEntities
 	var ids []string
 	uniqueIDs := map[string]int{}
@@ -752,7 +890,7 @@ func createSubscribers(networkID string, subs ...*subscribermodels.MutableSubscr
 		return obsidian.HttpError(errors.Errorf("found %v existing subscribers which would have been overwritten: %+v", len(found), found.TKs()), http.StatusBadRequest)
 	}
 
-	_, err = configurator.CreateEntities(networkID, ents, serdes.Entity)
+	_, err = configurator.CreateEntitiesWithContext(ctx, networkID, ents, serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -785,7 +923,9 @@ func getCreateSubscriberEnts(sub *subscribermodels.MutableSubscriber) configurat
 	return ents
 }
 
-func updateSubscriber(networkID string, sub *subscribermodels.MutableSubscriber) error {
+// updateSubscriber updates a subscriber. If expectedVersion is set, the
+// subscriber is only updated if its current version matches.
+func updateSubscriber(ctx context.Context, networkID string, sub *subscribermodels.MutableSubscriber, expectedVersion *uint64) error {
 	var writes []configurator.EntityWriteOperation
 
 	existingSub, err := configurator.LoadEntity(
@@ -816,10 +956,11 @@ func updateSubscriber(networkID string, sub *subscribermodels.MutableSubscriber)
 			StaticIps: sub.StaticIps,
 		},
 		AssociationsToSet: sub.GetAssocs(),
+		ExpectedVersion:   expectedVersion,
 	}
 	writes = append(writes, subUpdate)
 
-	err = configurator.WriteEntities(networkID, writes, serdes.Entity)
+	err = configurator.WriteEntitiesWithContext(ctx, networkID, writes, serdes.Entity)
 	if err != nil {
 		return err
 	}
@@ -827,7 +968,10 @@ func updateSubscriber(networkID string, sub *subscribermodels.MutableSubscriber)
 	return nil
 }
 
-func deleteSubscriber(networkID, key string) error {
+// deleteSubscriber deletes a subscriber along with its per-APN policy
+// profiles. If expectedVersion is set, nothing is deleted unless the
+// subscriber's current version matches.
+func deleteSubscriber(ctx context.Context, networkID, key string, expectedVersion *uint64) error {
 	ent, err := configurator.LoadEntity(
 		networkID, lte.SubscriberEntityType, key,
 		configurator.EntityLoadCriteria{LoadAssocsFromThis: true},
@@ -857,16 +1001,14 @@ func deleteSubscriber(networkID, key string) error {
 		return err
 	}
 
-	var deletes []storage.TypeAndKey
-	deletes = append(deletes, sub.ToTK())
-	deletes = append(deletes, sub.ActivePoliciesByApn.ToTKs(string(sub.ID))...)
-
-	err = configurator.DeleteEntities(networkID, deletes)
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	subTK := sub.ToTK()
+	var deletes []configurator.EntityWriteOperation
+	deletes = append(deletes, configurator.EntityUpdateCriteria{Type: subTK.Type, Key: subTK.Key, DeleteEntity: true, ExpectedVersion: expectedVersion})
+	for _, tk := range sub.ActivePoliciesByApn.ToTKs(string(sub.ID)) {
+		deletes = append(deletes, configurator.EntityUpdateCriteria{Type: tk.Type, Key: tk.Key, DeleteEntity: true})
 	}
 
-	return nil
+	return configurator.WriteEntitiesWithContext(ctx, networkID, deletes, serdes.Entity)
 }
 
 // loadAllStatesForIMSIs loads all states whose IMSI prefix is contained in the
@@ -956,5 +1098,8 @@ func makeErr(err error) *echo.HTTPError {
 	if err == merrors.ErrNotFound {
 		return echo.ErrNotFound
 	}
+	if err == merrors.ErrVersionConflict {
+		return obsidian.VersionConflictHttpErr()
+	}
 	return obsidian.HttpError(err, http.StatusInternalServerError)
 }
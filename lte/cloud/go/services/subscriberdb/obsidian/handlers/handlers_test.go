@@ -15,6 +15,10 @@ package handlers_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"sort"
 	"testing"
 	"time"
 
@@ -46,6 +50,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/stretchr/testify/assert"
+	"github.com/thoas/go-funk"
 )
 
 func TestCreateSubscriber(t *testing.T) {
@@ -864,6 +869,136 @@ func TestListSubscribersV2(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 }
 
+func TestListSubscribersV2Filters(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	networkConfigs := map[string]interface{}{
+		lte.CellularNetworkConfigType: &lteModels.NetworkCellularConfigs{
+			Epc: &lteModels.NetworkEpcConfigs{SubProfiles: map[string]lteModels.NetworkEpcConfigsSubProfilesAnon{"gold": {}}},
+		},
+	}
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Configs: networkConfigs}, serdes.Network)
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{Type: lte.APNEntityType, Key: "apn0"},
+			{Type: lte.APNEntityType, Key: "apn1"},
+		},
+		serdes.Entity,
+	)
+	assert.NoError(t, err)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/lte/:network_id/subscribers_v2"
+	handlers := handlers.GetHandlers()
+	createSubscribers := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.POST).HandlerFunc
+	listSubscribers := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.GET).HandlerFunc
+
+	// IMSI00101000000000{0-4} are active on apn0 & apn1, with the default profile
+	// IMSI00102000000000{0-4} are inactive on apn0, with the gold profile
+	var payload subscriberModels.MutableSubscribers
+	for i := 0; i < 5; i++ {
+		payload = append(payload, newMutableSubscriber(fmt.Sprintf("IMSI00101000000000%d", i)))
+		sub := newMutableSubscriber(fmt.Sprintf("IMSI00102000000000%d", i))
+		sub.Lte.State = "INACTIVE"
+		sub.Lte.SubProfile = "gold"
+		sub.ActiveApns = subscriberModels.ApnList{"apn0"}
+		sub.StaticIps = nil
+		payload = append(payload, sub)
+	}
+	tc := tests.Test{
+		Method:         "POST",
+		URL:            testURLRoot,
+		Payload:        tests.JSONMarshaler(payload),
+		Handler:        createSubscribers,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 201,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Pages are decoded from the raw response, since their contents depend
+	// on map iteration order
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/magma/v1/lte/n1/subscribers_v2"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("network_id")
+		c.SetParamValues("n1")
+		if err := listSubscribers(c); err != nil {
+			c.Error(err)
+		}
+		return rec
+	}
+	list := func(query string) subscriberModels.PaginatedSubscribers {
+		rec := get(query)
+		assert.Equal(t, 200, rec.Code)
+		page := subscriberModels.PaginatedSubscribers{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		return page
+	}
+	imsisOf := func(page subscriberModels.PaginatedSubscribers) []string {
+		imsis := funk.Keys(page.Subscribers).([]string)
+		sort.Strings(imsis)
+		return imsis
+	}
+
+	// IMSI prefix, with and without the IMSI type prefix
+	page := list("?imsi_prefix=0010100000000")
+	assert.Equal(t, int64(5), page.TotalCount)
+	assert.Len(t, page.Subscribers, 5)
+	page = list("?imsi_prefix=IMSI001020000000003")
+	assert.Equal(t, int64(1), page.TotalCount)
+	assert.Equal(t, []string{"IMSI001020000000003"}, imsisOf(page))
+
+	// IMSI prefix with pagination
+	page = list("?imsi_prefix=00102&page_size=3")
+	assert.Equal(t, []string{"IMSI001020000000000", "IMSI001020000000001", "IMSI001020000000002"}, imsisOf(page))
+	assert.NotEmpty(t, page.NextPageToken)
+	page = list("?imsi_prefix=00102&page_size=3&page_token=" + string(page.NextPageToken))
+	assert.Equal(t, []string{"IMSI001020000000003", "IMSI001020000000004"}, imsisOf(page))
+	assert.Empty(t, page.NextPageToken)
+
+	// State, sub profile & APN filters fill pages across configurator pages
+	page = list("?state=INACTIVE&page_size=2")
+	assert.Equal(t, []string{"IMSI001020000000000", "IMSI001020000000001"}, imsisOf(page))
+	page = list("?state=INACTIVE&page_size=2&page_token=" + string(page.NextPageToken))
+	assert.Equal(t, []string{"IMSI001020000000002", "IMSI001020000000003"}, imsisOf(page))
+	page = list("?state=INACTIVE&page_size=2&page_token=" + string(page.NextPageToken))
+	assert.Equal(t, []string{"IMSI001020000000004"}, imsisOf(page))
+	assert.Empty(t, page.NextPageToken)
+
+	page = list("?sub_profile=gold")
+	assert.Len(t, page.Subscribers, 5)
+	page = list("?apn=apn1&state=ACTIVE")
+	assert.Len(t, page.Subscribers, 5)
+	page = list("?apn=apn1&sub_profile=gold")
+	assert.Empty(t, page.Subscribers)
+	page = list("?imsi_prefix=00101&apn=apn0&page_size=10")
+	assert.Len(t, page.Subscribers, 5)
+	assert.Empty(t, page.NextPageToken)
+
+	// Invalid state
+	rec := get("?state=SUSPENDED")
+	assert.Equal(t, 400, rec.Code)
+
+	// A filtered page stops scanning after 10 configurator pages, returning
+	// an empty page with a token to continue from
+	payload = nil
+	for i := 0; i <= 10; i++ {
+		payload = append(payload, newMutableSubscriber(fmt.Sprintf("IMSI0010000000000%02d", i)))
+	}
+	tc.Payload = tests.JSONMarshaler(payload)
+	tests.RunUnitTest(t, e, tc)
+	page = list("?sub_profile=gold&page_size=1")
+	assert.Empty(t, page.Subscribers)
+	assert.NotEmpty(t, page.NextPageToken)
+	page = list("?sub_profile=gold&page_size=1&page_token=" + string(page.NextPageToken))
+	assert.Equal(t, []string{"IMSI001020000000000"}, imsisOf(page))
+}
+
 func TestGetSubscriber(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
@@ -1843,6 +1978,106 @@ func TestDeleteSubscriber(t *testing.T) {
 	assert.Equal(t, 0, len(actual))
 }
 
+func TestSubscriberIfMatch(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"}, serdes.Network)
+	assert.NoError(t, err)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/lte/:network_id/subscribers/:subscriber_id"
+	handlers := handlers.GetHandlers()
+	getSubscriber := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.GET).HandlerFunc
+	updateSubscriber := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.PUT).HandlerFunc
+	deleteSubscriber := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.DELETE).HandlerFunc
+
+	lteSub := &subscriberModels.LteSubscription{
+		AuthAlgo:   "MILENAGE",
+		AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+		AuthOpc:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+		State:      "ACTIVE",
+		SubProfile: "default",
+	}
+	ent, err := configurator.CreateEntity(
+		"n1",
+		configurator.NetworkEntity{
+			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
+			Config: &subscriberModels.SubscriberConfig{Lte: lteSub},
+		},
+		serdes.Entity,
+	)
+	assert.NoError(t, err)
+	version := ent.Version
+
+	// GET returns the subscriber's version as ETag
+	tc := tests.Test{
+		Method:          "GET",
+		URL:             testURLRoot,
+		Handler:         getSubscriber,
+		ParamNames:      []string{"network_id", "subscriber_id"},
+		ParamValues:     []string{"n1", "IMSI1234567890"},
+		ExpectedStatus:  200,
+		ExpectedHeaders: map[string]string{obsidian.ETagHeader: obsidian.VersionETag(version)},
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Stale PUT
+	payload := &subscriberModels.MutableSubscriber{ID: "IMSI1234567890", Name: "Jane Doe", Lte: lteSub}
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            testURLRoot,
+		Handler:        updateSubscriber,
+		Payload:        payload,
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version + 1)},
+		ParamNames:     []string{"network_id", "subscriber_id"},
+		ParamValues:    []string{"n1", "IMSI1234567890"},
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	actual, err := configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567890", configurator.EntityLoadCriteria{LoadMetadata: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, "", actual.Name)
+
+	// PUT at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	actual, err = configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567890", configurator.EntityLoadCriteria{LoadMetadata: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", actual.Name)
+	assert.Equal(t, version+1, actual.Version)
+
+	// Stale DELETE
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            testURLRoot,
+		Handler:        deleteSubscriber,
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)},
+		ParamNames:     []string{"network_id", "subscriber_id"},
+		ParamValues:    []string{"n1", "IMSI1234567890"},
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	exists, err := configurator.DoesEntityExist("n1", lte.SubscriberEntityType, "IMSI1234567890")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// DELETE at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(actual.Version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	exists, err = configurator.DoesEntityExist("n1", lte.SubscriberEntityType, "IMSI1234567890")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// Conditional DELETE of a missing subscriber
+	tc.ExpectedStatus, tc.ExpectedError = 412, "resource was modified, If-Match precondition failed"
+	tests.RunUnitTest(t, e, tc)
+}
+
 func TestActivateDeactivateSubscriber(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
@@ -15,13 +15,21 @@ package models
 
 import (
 	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
 )
 
 var (
+	// SubscriberConfigSecrets are the paths to the secrets of subscriber
+	// configs, encrypted at rest when secrets encryption is configured
+	SubscriberConfigSecrets = []encryption.SecretPath{
+		{"lte", "auth_key"},
+		{"lte", "auth_opc"},
+	}
+
 	// EntitySerdes contains the package's configurator network entity serdes
 	EntitySerdes = serde.NewRegistry(
-		configurator.NewNetworkEntityConfigSerde(lte.SubscriberEntityType, &SubscriberConfig{}),
+		encryption.NewSecretsSerde(configurator.NetworkEntitySerdeDomain, lte.SubscriberEntityType, &SubscriberConfig{}, SubscriberConfigSecrets...),
 	)
 )
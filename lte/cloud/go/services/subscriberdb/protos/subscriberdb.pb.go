@@ -616,6 +616,255 @@ func (m *SubscriberDigestWithIDs) GetDigests() []*protos.SubscriberDigestWithID
 	return nil
 }
 
+type WrapKeyRequest struct {
+	// plaintext is the data key to wrap
+	Plaintext            []byte   `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WrapKeyRequest) Reset()         { *m = WrapKeyRequest{} }
+func (m *WrapKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*WrapKeyRequest) ProtoMessage()    {}
+func (*WrapKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{13}
+}
+
+func (m *WrapKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WrapKeyRequest.Unmarshal(m, b)
+}
+func (m *WrapKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WrapKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *WrapKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrapKeyRequest.Merge(m, src)
+}
+func (m *WrapKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_WrapKeyRequest.Size(m)
+}
+func (m *WrapKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrapKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrapKeyRequest proto.InternalMessageInfo
+
+func (m *WrapKeyRequest) GetPlaintext() []byte {
+	if m != nil {
+		return m.Plaintext
+	}
+	return nil
+}
+
+type WrapKeyResponse struct {
+	// key_id identifies the key encryption key used to wrap the data key
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// ciphertext is the wrapped data key
+	Ciphertext           []byte   `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WrapKeyResponse) Reset()         { *m = WrapKeyResponse{} }
+func (m *WrapKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*WrapKeyResponse) ProtoMessage()    {}
+func (*WrapKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{14}
+}
+
+func (m *WrapKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WrapKeyResponse.Unmarshal(m, b)
+}
+func (m *WrapKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WrapKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *WrapKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrapKeyResponse.Merge(m, src)
+}
+func (m *WrapKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_WrapKeyResponse.Size(m)
+}
+func (m *WrapKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrapKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrapKeyResponse proto.InternalMessageInfo
+
+func (m *WrapKeyResponse) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *WrapKeyResponse) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+type UnwrapKeyRequest struct {
+	// key_id identifies the key encryption key which wrapped the data key
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// ciphertext is the wrapped data key
+	Ciphertext           []byte   `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnwrapKeyRequest) Reset()         { *m = UnwrapKeyRequest{} }
+func (m *UnwrapKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*UnwrapKeyRequest) ProtoMessage()    {}
+func (*UnwrapKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{15}
+}
+
+func (m *UnwrapKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnwrapKeyRequest.Unmarshal(m, b)
+}
+func (m *UnwrapKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnwrapKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *UnwrapKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwrapKeyRequest.Merge(m, src)
+}
+func (m *UnwrapKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_UnwrapKeyRequest.Size(m)
+}
+func (m *UnwrapKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwrapKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwrapKeyRequest proto.InternalMessageInfo
+
+func (m *UnwrapKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *UnwrapKeyRequest) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+type UnwrapKeyResponse struct {
+	// plaintext is the unwrapped data key
+	Plaintext            []byte   `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnwrapKeyResponse) Reset()         { *m = UnwrapKeyResponse{} }
+func (m *UnwrapKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*UnwrapKeyResponse) ProtoMessage()    {}
+func (*UnwrapKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{16}
+}
+
+func (m *UnwrapKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnwrapKeyResponse.Unmarshal(m, b)
+}
+func (m *UnwrapKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnwrapKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *UnwrapKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwrapKeyResponse.Merge(m, src)
+}
+func (m *UnwrapKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_UnwrapKeyResponse.Size(m)
+}
+func (m *UnwrapKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwrapKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwrapKeyResponse proto.InternalMessageInfo
+
+func (m *UnwrapKeyResponse) GetPlaintext() []byte {
+	if m != nil {
+		return m.Plaintext
+	}
+	return nil
+}
+
+type GetCurrentKeyIDRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentKeyIDRequest) Reset()         { *m = GetCurrentKeyIDRequest{} }
+func (m *GetCurrentKeyIDRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentKeyIDRequest) ProtoMessage()    {}
+func (*GetCurrentKeyIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{17}
+}
+
+func (m *GetCurrentKeyIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentKeyIDRequest.Unmarshal(m, b)
+}
+func (m *GetCurrentKeyIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentKeyIDRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentKeyIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentKeyIDRequest.Merge(m, src)
+}
+func (m *GetCurrentKeyIDRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentKeyIDRequest.Size(m)
+}
+func (m *GetCurrentKeyIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentKeyIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentKeyIDRequest proto.InternalMessageInfo
+
+type GetCurrentKeyIDResponse struct {
+	// key_id identifies the key encryption key new data keys are wrapped with
+	KeyId                string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCurrentKeyIDResponse) Reset()         { *m = GetCurrentKeyIDResponse{} }
+func (m *GetCurrentKeyIDResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentKeyIDResponse) ProtoMessage()    {}
+func (*GetCurrentKeyIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7926c2bb91580e5a, []int{18}
+}
+
+func (m *GetCurrentKeyIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentKeyIDResponse.Unmarshal(m, b)
+}
+func (m *GetCurrentKeyIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentKeyIDResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCurrentKeyIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentKeyIDResponse.Merge(m, src)
+}
+func (m *GetCurrentKeyIDResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentKeyIDResponse.Size(m)
+}
+func (m *GetCurrentKeyIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentKeyIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrentKeyIDResponse proto.InternalMessageInfo
+
+func (m *GetCurrentKeyIDResponse) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetMSISDNsRequest)(nil), "magma.lte.subscriberdb.GetMSISDNsRequest")
 	proto.RegisterType((*GetMSISDNsResponse)(nil), "magma.lte.subscriberdb.GetMSISDNsResponse")
@@ -631,51 +880,68 @@ func init() {
 	proto.RegisterType((*IPMapping)(nil), "magma.lte.subscriberdb.IPMapping")
 	proto.RegisterType((*ApnResourceInternal)(nil), "magma.lte.subscriberdb.ApnResourceInternal")
 	proto.RegisterType((*SubscriberDigestWithIDs)(nil), "magma.lte.subscriberdb.SubscriberDigestWithIDs")
+	proto.RegisterType((*WrapKeyRequest)(nil), "magma.lte.subscriberdb.WrapKeyRequest")
+	proto.RegisterType((*WrapKeyResponse)(nil), "magma.lte.subscriberdb.WrapKeyResponse")
+	proto.RegisterType((*UnwrapKeyRequest)(nil), "magma.lte.subscriberdb.UnwrapKeyRequest")
+	proto.RegisterType((*UnwrapKeyResponse)(nil), "magma.lte.subscriberdb.UnwrapKeyResponse")
+	proto.RegisterType((*GetCurrentKeyIDRequest)(nil), "magma.lte.subscriberdb.GetCurrentKeyIDRequest")
+	proto.RegisterType((*GetCurrentKeyIDResponse)(nil), "magma.lte.subscriberdb.GetCurrentKeyIDResponse")
 }
 
 func init() { proto.RegisterFile("subscriberdb.proto", fileDescriptor_7926c2bb91580e5a) }
 
 var fileDescriptor_7926c2bb91580e5a = []byte{
-	// 624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xf1, 0x6b, 0xd3, 0x40,
-	0x14, 0x5e, 0xd3, 0xb9, 0x99, 0xd7, 0xb5, 0x76, 0xb7, 0x31, 0x4b, 0x60, 0x30, 0x0f, 0xa6, 0x9d,
-	0x4a, 0x06, 0xf3, 0x17, 0x51, 0x84, 0x75, 0x56, 0x46, 0x64, 0x1b, 0x23, 0x11, 0x45, 0x41, 0xc2,
-	0x65, 0x3d, 0xeb, 0xd1, 0xf6, 0x72, 0xe6, 0x2e, 0x8e, 0xfe, 0xe6, 0x7f, 0xe3, 0x5f, 0xe1, 0xff,
-	0x26, 0x49, 0x2e, 0x69, 0xb6, 0xb5, 0x2e, 0x8a, 0x3f, 0xf5, 0xdd, 0x77, 0xdf, 0xfb, 0xde, 0xbd,
-	0xf7, 0xfa, 0xb5, 0x80, 0x64, 0x1c, 0xc8, 0x8b, 0x88, 0x05, 0x34, 0x1a, 0x04, 0xb6, 0x88, 0x42,
-	0x15, 0xa2, 0xad, 0x09, 0x19, 0x4e, 0x88, 0x3d, 0x56, 0xd4, 0x2e, 0xdf, 0x5a, 0x9b, 0x63, 0x45,
-	0xf7, 0x53, 0x8a, 0xdc, 0x27, 0x82, 0x67, 0x6c, 0x6b, 0xbb, 0x84, 0xde, 0x14, 0xc3, 0x27, 0xb0,
-	0x7e, 0x4c, 0xd5, 0xa9, 0xe7, 0x78, 0xfd, 0x33, 0xe9, 0xd2, 0x6f, 0x31, 0x95, 0x0a, 0x6d, 0x03,
-	0x70, 0xaa, 0x2e, 0xc3, 0x68, 0xe4, 0xb3, 0x41, 0xa7, 0xb6, 0x53, 0xeb, 0x9a, 0xae, 0xa9, 0x11,
-	0x67, 0x80, 0x3a, 0xb0, 0x3a, 0x91, 0x4c, 0x0e, 0xb8, 0xec, 0x18, 0x3b, 0xf5, 0xae, 0xe9, 0xe6,
-	0x47, 0xfc, 0xab, 0x06, 0xa8, 0x2c, 0x27, 0x45, 0xc8, 0x25, 0x45, 0x14, 0xee, 0xb1, 0x84, 0xe2,
-	0x07, 0x53, 0x3f, 0xa3, 0x76, 0x6a, 0x3b, 0xf5, 0x6e, 0xe3, 0xe0, 0x95, 0x3d, 0xbf, 0x17, 0xfb,
-	0xa6, 0x88, 0xed, 0x24, 0x99, 0x47, 0xd3, 0xd3, 0x34, 0xff, 0x0d, 0x57, 0xd1, 0xd4, 0x6d, 0xb2,
-	0x32, 0x66, 0x1d, 0x02, 0xba, 0x49, 0x42, 0x6d, 0xa8, 0x8f, 0xe8, 0x54, 0x77, 0x91, 0x84, 0x68,
-	0x13, 0xee, 0x7c, 0x27, 0xe3, 0x98, 0x76, 0x8c, 0x14, 0xcb, 0x0e, 0x2f, 0x8c, 0xe7, 0x35, 0xfc,
-	0x19, 0xda, 0x5e, 0x5e, 0xb9, 0xe2, 0x30, 0xb6, 0x60, 0x45, 0xb7, 0x94, 0xa9, 0xe9, 0x13, 0x42,
-	0xb0, 0x9c, 0xbc, 0xae, 0x53, 0x4f, 0xd1, 0x34, 0xc6, 0x1b, 0xb0, 0x5e, 0x92, 0xcf, 0xfa, 0xc2,
-	0x27, 0xb0, 0xd1, 0xa7, 0x63, 0xaa, 0xe8, 0xff, 0x28, 0x8b, 0xb7, 0x60, 0xf3, 0xaa, 0x9a, 0xae,
-	0x72, 0x08, 0xcd, 0x63, 0xaa, 0x9c, 0xf3, 0xaa, 0x3b, 0x6e, 0x43, 0x9d, 0x89, 0x7c, 0xbf, 0x49,
-	0x88, 0xdf, 0x41, 0x2b, 0x57, 0xd0, 0x6b, 0x3d, 0x82, 0x06, 0x13, 0xfe, 0x84, 0x08, 0xc1, 0xf8,
-	0x50, 0xea, 0x95, 0x3e, 0x58, 0xb4, 0x52, 0xe7, 0xfc, 0x34, 0x63, 0xba, 0xc0, 0x84, 0x0e, 0x25,
-	0x8e, 0xa0, 0xe9, 0xfd, 0xcd, 0xbb, 0xae, 0xd5, 0x34, 0xfe, 0xa5, 0x66, 0x1b, 0x5a, 0xde, 0x95,
-	0x4e, 0x70, 0x0f, 0xcc, 0x82, 0x8a, 0x5a, 0x60, 0x30, 0xa1, 0x2b, 0x1b, 0x4c, 0x14, 0x9b, 0x34,
-	0x66, 0x9b, 0x4c, 0xc6, 0x43, 0x04, 0xd7, 0xcb, 0x4d, 0x42, 0xfc, 0xb3, 0x06, 0x1b, 0x3d, 0xc1,
-	0x5d, 0x2a, 0xc3, 0x38, 0xba, 0xa0, 0x0e, 0x57, 0x34, 0xe2, 0x64, 0x9c, 0xf4, 0x43, 0xa4, 0x0c,
-	0x2f, 0x7c, 0x22, 0x78, 0x36, 0x23, 0xd3, 0x35, 0x53, 0xa4, 0x27, 0xb8, 0x44, 0xbb, 0xd0, 0xca,
-	0xae, 0x87, 0x44, 0xd1, 0x4b, 0x32, 0xcd, 0x47, 0xde, 0x4c, 0xd1, 0x63, 0x0d, 0xa2, 0xb7, 0xb0,
-	0x46, 0x04, 0xf7, 0x23, 0xad, 0x9e, 0x16, 0x6e, 0x1c, 0x3c, 0x2a, 0xf5, 0xdd, 0x3b, 0x3f, 0x7b,
-	0x1d, 0xf2, 0x2f, 0x6c, 0x18, 0x47, 0x44, 0xb1, 0x90, 0x27, 0x40, 0xfe, 0x18, 0xb7, 0x41, 0x66,
-	0x2f, 0xc3, 0xef, 0xe1, 0xbe, 0x57, 0x0c, 0xa9, 0xcf, 0x86, 0x54, 0xaa, 0x0f, 0x4c, 0x7d, 0x75,
-	0xfa, 0x12, 0xbd, 0x84, 0xd5, 0x41, 0x0a, 0xcc, 0xdb, 0xe6, 0xfc, 0x24, 0x37, 0xcf, 0x38, 0xf8,
-	0xb1, 0x0c, 0xed, 0x19, 0xe7, 0x24, 0x0c, 0x47, 0xb1, 0x40, 0x14, 0x60, 0xe6, 0x65, 0xb4, 0x57,
-	0xc5, 0xef, 0xe9, 0xf7, 0xc0, 0x7a, 0x5c, 0xfd, 0xa7, 0x01, 0x2f, 0xa1, 0x00, 0xcc, 0xc2, 0x59,
-	0xa8, 0xbb, 0x28, 0xf5, 0xba, 0xb7, 0xad, 0xbd, 0x0a, 0xcc, 0xa2, 0xc6, 0x08, 0xd6, 0xca, 0xd6,
-	0x42, 0x4f, 0x16, 0x25, 0xcf, 0xb1, 0xb3, 0xf5, 0xb4, 0x1a, 0xb9, 0x28, 0xf6, 0x11, 0x56, 0x32,
-	0xb7, 0xa1, 0xdd, 0x3f, 0x0c, 0x62, 0xe6, 0x1b, 0xeb, 0xe1, 0x6d, 0xb4, 0xb2, 0xb4, 0x77, 0x8b,
-	0xb4, 0x57, 0x4d, 0xfa, 0x9a, 0x8b, 0x96, 0x8e, 0xee, 0x7e, 0x5a, 0xc9, 0xfe, 0x6a, 0x82, 0xec,
-	0xf3, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0xce, 0x68, 0xfa, 0xc1, 0x06, 0x00, 0x00,
+	// 793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xed, 0x6e, 0xe3, 0x44,
+	0x14, 0xdd, 0x38, 0xbb, 0x2d, 0xbe, 0x6d, 0xd3, 0x74, 0x5a, 0xba, 0x91, 0xc5, 0xa2, 0x32, 0xd2,
+	0xb2, 0x2d, 0x20, 0x17, 0xca, 0x1f, 0x04, 0x42, 0xda, 0x76, 0x83, 0x8a, 0x69, 0xbb, 0xaa, 0x6c,
+	0x60, 0x05, 0x02, 0x45, 0x93, 0xe4, 0x92, 0x1d, 0x25, 0x19, 0x0f, 0x9e, 0x09, 0xc5, 0xff, 0x78,
+	0x1b, 0x9e, 0x82, 0x87, 0xe0, 0x8d, 0x90, 0xed, 0xb1, 0xe3, 0x7c, 0xd6, 0xac, 0xf6, 0x57, 0xc6,
+	0x67, 0xce, 0x9c, 0x33, 0xf7, 0xde, 0xb9, 0x57, 0x01, 0xa2, 0x26, 0x5d, 0xd5, 0x8b, 0x78, 0x17,
+	0xa3, 0x7e, 0xd7, 0x95, 0x51, 0xa8, 0x43, 0x72, 0x38, 0x66, 0x83, 0x31, 0x73, 0x47, 0x1a, 0xdd,
+	0xf2, 0xae, 0x73, 0x30, 0xd2, 0x78, 0x9a, 0x52, 0xd4, 0x29, 0x93, 0x22, 0x63, 0x3b, 0x4f, 0x4a,
+	0xe8, 0xa2, 0x18, 0xbd, 0x86, 0xbd, 0x4b, 0xd4, 0x37, 0x81, 0x17, 0xb4, 0x5f, 0x2a, 0x1f, 0x7f,
+	0x9f, 0xa0, 0xd2, 0xe4, 0x09, 0x80, 0x40, 0x7d, 0x17, 0x46, 0xc3, 0x0e, 0xef, 0xb7, 0x6a, 0x47,
+	0xb5, 0x63, 0xdb, 0xb7, 0x0d, 0xe2, 0xf5, 0x49, 0x0b, 0x36, 0xc7, 0x8a, 0xab, 0xbe, 0x50, 0x2d,
+	0xeb, 0xa8, 0x7e, 0x6c, 0xfb, 0xf9, 0x27, 0xfd, 0xa7, 0x06, 0xa4, 0x2c, 0xa7, 0x64, 0x28, 0x14,
+	0x12, 0x84, 0x5d, 0x9e, 0x50, 0x3a, 0xdd, 0xb8, 0x93, 0x51, 0x5b, 0xb5, 0xa3, 0xfa, 0xf1, 0xd6,
+	0xd9, 0xd7, 0xee, 0xf2, 0x58, 0xdc, 0x45, 0x11, 0xd7, 0x4b, 0x4e, 0x5e, 0xc4, 0x37, 0xe9, 0xf9,
+	0x6f, 0x84, 0x8e, 0x62, 0x7f, 0x87, 0x97, 0x31, 0xe7, 0x39, 0x90, 0x45, 0x12, 0x69, 0x42, 0x7d,
+	0x88, 0xb1, 0x89, 0x22, 0x59, 0x92, 0x03, 0x78, 0xf4, 0x07, 0x1b, 0x4d, 0xb0, 0x65, 0xa5, 0x58,
+	0xf6, 0xf1, 0xa5, 0xf5, 0x45, 0x8d, 0xfe, 0x0a, 0xcd, 0x20, 0x77, 0xae, 0x98, 0x8c, 0x43, 0xd8,
+	0x30, 0x21, 0x65, 0x6a, 0xe6, 0x8b, 0x10, 0x78, 0x98, 0xdc, 0xae, 0x55, 0x4f, 0xd1, 0x74, 0x4d,
+	0xf7, 0x61, 0xaf, 0x24, 0x9f, 0xc5, 0x45, 0xaf, 0x61, 0xbf, 0x8d, 0x23, 0xd4, 0xf8, 0x36, 0x6c,
+	0xe9, 0x21, 0x1c, 0xcc, 0xaa, 0x19, 0x97, 0xe7, 0xb0, 0x73, 0x89, 0xda, 0xbb, 0xad, 0x5a, 0xe3,
+	0x26, 0xd4, 0xb9, 0xcc, 0xeb, 0x9b, 0x2c, 0xe9, 0xf7, 0xd0, 0xc8, 0x15, 0x4c, 0x59, 0x2f, 0x60,
+	0x8b, 0xcb, 0xce, 0x98, 0x49, 0xc9, 0xc5, 0x40, 0x99, 0x92, 0x7e, 0xb0, 0xaa, 0xa4, 0xde, 0xed,
+	0x4d, 0xc6, 0xf4, 0x81, 0x4b, 0xb3, 0x54, 0x34, 0x82, 0x9d, 0xe0, 0xff, 0xdc, 0x6b, 0xce, 0xd3,
+	0x7a, 0x13, 0xcf, 0x26, 0x34, 0x82, 0x99, 0x48, 0xe8, 0x39, 0xd8, 0x05, 0x95, 0x34, 0xc0, 0xe2,
+	0xd2, 0x38, 0x5b, 0x5c, 0x16, 0x95, 0xb4, 0xa6, 0x95, 0x4c, 0xd2, 0xc3, 0xa4, 0x30, 0xc5, 0x4d,
+	0x96, 0xf4, 0xef, 0x1a, 0xec, 0x9f, 0x4b, 0xe1, 0xa3, 0x0a, 0x27, 0x51, 0x0f, 0x3d, 0xa1, 0x31,
+	0x12, 0x6c, 0x94, 0xc4, 0xc3, 0x94, 0x0a, 0x7b, 0x1d, 0x26, 0x45, 0x96, 0x23, 0xdb, 0xb7, 0x53,
+	0xe4, 0x5c, 0x0a, 0x45, 0x9e, 0x42, 0x23, 0xdb, 0x1e, 0x30, 0x8d, 0x77, 0x2c, 0xce, 0x53, 0xbe,
+	0x93, 0xa2, 0x97, 0x06, 0x24, 0xdf, 0xc1, 0x36, 0x93, 0xa2, 0x13, 0x19, 0xf5, 0xd4, 0x78, 0xeb,
+	0xec, 0x59, 0x29, 0xee, 0xf3, 0xdb, 0x97, 0x2f, 0x42, 0xf1, 0x1b, 0x1f, 0x4c, 0x22, 0xa6, 0x79,
+	0x28, 0x12, 0x20, 0xbf, 0x8c, 0xbf, 0xc5, 0xa6, 0x37, 0xa3, 0x3f, 0xc2, 0xe3, 0xa0, 0x48, 0x52,
+	0x9b, 0x0f, 0x50, 0xe9, 0x57, 0x5c, 0xbf, 0xf6, 0xda, 0x8a, 0x7c, 0x05, 0x9b, 0xfd, 0x14, 0x58,
+	0x56, 0xcd, 0xe5, 0x87, 0xfc, 0xfc, 0x04, 0x75, 0xa1, 0xf1, 0x2a, 0x62, 0xf2, 0x0a, 0xe3, 0xbc,
+	0x96, 0xef, 0x81, 0x2d, 0x47, 0x8c, 0x0b, 0x8d, 0x7f, 0xea, 0x34, 0xa1, 0xdb, 0xfe, 0x14, 0xa0,
+	0xdf, 0xc2, 0x6e, 0xc1, 0x37, 0x2f, 0xea, 0x5d, 0xd8, 0x18, 0x62, 0x3c, 0x2d, 0xfc, 0xa3, 0x21,
+	0xc6, 0x5e, 0x9f, 0xbc, 0x0f, 0xd0, 0xe3, 0xf2, 0x35, 0x46, 0xa9, 0x90, 0x95, 0x0a, 0x95, 0x10,
+	0xea, 0x41, 0xf3, 0x07, 0x71, 0x37, 0xeb, 0xfd, 0x86, 0x52, 0x9f, 0xc1, 0x5e, 0x49, 0xca, 0x5c,
+	0x6b, 0x7d, 0x1c, 0x2d, 0x38, 0xbc, 0x44, 0xfd, 0x62, 0x12, 0x45, 0x28, 0xf4, 0x15, 0xc6, 0x5e,
+	0xdb, 0xdc, 0x81, 0x7e, 0x0a, 0x8f, 0x17, 0x76, 0xd6, 0x46, 0x7a, 0xf6, 0xd7, 0x43, 0x68, 0x4e,
+	0xf3, 0x7c, 0x1d, 0x86, 0xc3, 0x89, 0x24, 0x08, 0x30, 0x9d, 0x87, 0xe4, 0xa4, 0xca, 0xcc, 0x4c,
+	0xfd, 0x9d, 0x8f, 0xaa, 0x8f, 0x57, 0xfa, 0x80, 0x74, 0xc1, 0x2e, 0xa6, 0x13, 0x39, 0x5e, 0x75,
+	0x74, 0x7e, 0x3e, 0x3a, 0x27, 0x15, 0x98, 0x85, 0xc7, 0x10, 0xb6, 0xcb, 0xe3, 0x89, 0x7c, 0xbc,
+	0xea, 0xf0, 0x92, 0x91, 0xe8, 0x7c, 0x52, 0x8d, 0x5c, 0x98, 0xfd, 0x04, 0x1b, 0xd9, 0xc4, 0x22,
+	0x4f, 0xd7, 0x24, 0x62, 0x3a, 0x7b, 0x9c, 0x0f, 0xef, 0xa3, 0x95, 0xa5, 0x83, 0x7b, 0xa4, 0x83,
+	0x6a, 0xd2, 0x73, 0x93, 0xe8, 0xc1, 0xd9, 0xbf, 0x56, 0xb9, 0x3f, 0xaf, 0x30, 0xbe, 0x61, 0x82,
+	0x0d, 0x70, 0x8c, 0x42, 0x93, 0x5f, 0x60, 0xd3, 0xb4, 0x0c, 0x59, 0x29, 0x38, 0xdb, 0x83, 0xce,
+	0xb3, 0x7b, 0x79, 0xe5, 0x07, 0x50, 0xbc, 0xfd, 0xd5, 0x0f, 0x60, 0xbe, 0xd3, 0x9c, 0x93, 0x0a,
+	0xcc, 0xc2, 0x43, 0xc3, 0xee, 0x5c, 0x4b, 0x10, 0x77, 0x4d, 0xd6, 0x97, 0x74, 0x95, 0x73, 0x5a,
+	0x99, 0x9f, 0xbb, 0x5e, 0xbc, 0xf3, 0xf3, 0x46, 0xf6, 0x17, 0xa8, 0x9b, 0xfd, 0x7e, 0xfe, 0xdf,
+	0x00, 0x66, 0xbe, 0xe1, 0x21, 0x59, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -915,3 +1181,153 @@ var _SubscriberLookup_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "subscriberdb.proto",
 }
+
+// SubscriberKeyManagementClient is the client API for SubscriberKeyManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubscriberKeyManagementClient interface {
+	// WrapKey encrypts a data key with the current key encryption key.
+	WrapKey(ctx context.Context, in *WrapKeyRequest, opts ...grpc.CallOption) (*WrapKeyResponse, error)
+	// UnwrapKey decrypts a data key with the key encryption key which wrapped it.
+	UnwrapKey(ctx context.Context, in *UnwrapKeyRequest, opts ...grpc.CallOption) (*UnwrapKeyResponse, error)
+	// GetCurrentKeyID returns the ID of the current key encryption key.
+	GetCurrentKeyID(ctx context.Context, in *GetCurrentKeyIDRequest, opts ...grpc.CallOption) (*GetCurrentKeyIDResponse, error)
+}
+
+type subscriberKeyManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriberKeyManagementClient(cc grpc.ClientConnInterface) SubscriberKeyManagementClient {
+	return &subscriberKeyManagementClient{cc}
+}
+
+func (c *subscriberKeyManagementClient) WrapKey(ctx context.Context, in *WrapKeyRequest, opts ...grpc.CallOption) (*WrapKeyResponse, error) {
+	out := new(WrapKeyResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.subscriberdb.SubscriberKeyManagement/WrapKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriberKeyManagementClient) UnwrapKey(ctx context.Context, in *UnwrapKeyRequest, opts ...grpc.CallOption) (*UnwrapKeyResponse, error) {
+	out := new(UnwrapKeyResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.subscriberdb.SubscriberKeyManagement/UnwrapKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriberKeyManagementClient) GetCurrentKeyID(ctx context.Context, in *GetCurrentKeyIDRequest, opts ...grpc.CallOption) (*GetCurrentKeyIDResponse, error) {
+	out := new(GetCurrentKeyIDResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.subscriberdb.SubscriberKeyManagement/GetCurrentKeyID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriberKeyManagementServer is the server API for SubscriberKeyManagement service.
+type SubscriberKeyManagementServer interface {
+	// WrapKey encrypts a data key with the current key encryption key.
+	WrapKey(context.Context, *WrapKeyRequest) (*WrapKeyResponse, error)
+	// UnwrapKey decrypts a data key with the key encryption key which wrapped it.
+	UnwrapKey(context.Context, *UnwrapKeyRequest) (*UnwrapKeyResponse, error)
+	// GetCurrentKeyID returns the ID of the current key encryption key.
+	GetCurrentKeyID(context.Context, *GetCurrentKeyIDRequest) (*GetCurrentKeyIDResponse, error)
+}
+
+// UnimplementedSubscriberKeyManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedSubscriberKeyManagementServer struct {
+}
+
+func (*UnimplementedSubscriberKeyManagementServer) WrapKey(ctx context.Context, req *WrapKeyRequest) (*WrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapKey not implemented")
+}
+func (*UnimplementedSubscriberKeyManagementServer) UnwrapKey(ctx context.Context, req *UnwrapKeyRequest) (*UnwrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapKey not implemented")
+}
+func (*UnimplementedSubscriberKeyManagementServer) GetCurrentKeyID(ctx context.Context, req *GetCurrentKeyIDRequest) (*GetCurrentKeyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentKeyID not implemented")
+}
+
+func RegisterSubscriberKeyManagementServer(s *grpc.Server, srv SubscriberKeyManagementServer) {
+	s.RegisterService(&_SubscriberKeyManagement_serviceDesc, srv)
+}
+
+func _SubscriberKeyManagement_WrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberKeyManagementServer).WrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.subscriberdb.SubscriberKeyManagement/WrapKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberKeyManagementServer).WrapKey(ctx, req.(*WrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriberKeyManagement_UnwrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberKeyManagementServer).UnwrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.subscriberdb.SubscriberKeyManagement/UnwrapKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberKeyManagementServer).UnwrapKey(ctx, req.(*UnwrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriberKeyManagement_GetCurrentKeyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentKeyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberKeyManagementServer).GetCurrentKeyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.subscriberdb.SubscriberKeyManagement/GetCurrentKeyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberKeyManagementServer).GetCurrentKeyID(ctx, req.(*GetCurrentKeyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SubscriberKeyManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.lte.subscriberdb.SubscriberKeyManagement",
+	HandlerType: (*SubscriberKeyManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WrapKey",
+			Handler:    _SubscriberKeyManagement_WrapKey_Handler,
+		},
+		{
+			MethodName: "UnwrapKey",
+			Handler:    _SubscriberKeyManagement_UnwrapKey_Handler,
+		},
+		{
+			MethodName: "GetCurrentKeyID",
+			Handler:    _SubscriberKeyManagement_GetCurrentKeyID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "subscriberdb.proto",
+}
@@ -0,0 +1,43 @@
+/*
+ Copyright 2021 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+
+	"magma/orc8r/cloud/go/serde"
+	backup_protos "magma/orc8r/cloud/go/services/configurator/backup/protos"
+)
+
+type entitySecretsServicer struct {
+	serdes serde.Registry
+}
+
+// NewEntitySecretsServicer returns a servicer which checks that archived
+// entity configs can be deserialized through the serdes, which decrypt
+// their secrets with the keys of this deployment.
+func NewEntitySecretsServicer(serdes serde.Registry) backup_protos.EntitySecretsServer {
+	return &entitySecretsServicer{serdes: serdes}
+}
+
+func (e *entitySecretsServicer) CheckSecrets(ctx context.Context, req *backup_protos.CheckSecretsRequest) (*backup_protos.CheckSecretsResponse, error) {
+	res := &backup_protos.CheckSecretsResponse{}
+	for _, config := range req.Configs {
+		_, err := serde.Deserialize(config.Config, config.Type, e.serdes)
+		if err != nil {
+			res.Unreadable = append(res.Unreadable, &backup_protos.UnreadableConfig{Type: config.Type, Key: config.Key, Error: err.Error()})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+/*
+ Copyright 2021 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/serdes"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/lte/cloud/go/services/subscriberdb/servicers"
+	"magma/orc8r/cloud/go/serde"
+	backup_protos "magma/orc8r/cloud/go/services/configurator/backup/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntitySecretsServicer_CheckSecrets(t *testing.T) {
+	defer encryption.SetEncryptor(nil)
+	servicer := servicers.NewEntitySecretsServicer(serdes.Entity)
+	config := &models.SubscriberConfig{
+		Lte: &models.LteSubscription{
+			AuthAlgo: "MILENAGE",
+			AuthKey:  bytes.Repeat([]byte{0x22}, 16),
+			AuthOpc:  bytes.Repeat([]byte{0x33}, 16),
+			State:    "ACTIVE",
+		},
+	}
+
+	plaintext, err := serde.Serialize(config, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	encryption.SetEncryptor(newEncryptor(t, "k0", 0))
+	encrypted, err := serde.Serialize(config, lte.SubscriberEntityType, serdes.Entity)
+	assert.NoError(t, err)
+	req := &backup_protos.CheckSecretsRequest{
+		Configs: []*backup_protos.ArchivedConfig{
+			{Type: lte.SubscriberEntityType, Key: "IMSI001010000000001", Config: plaintext},
+			{Type: lte.SubscriberEntityType, Key: "IMSI001010000000002", Config: encrypted},
+		},
+	}
+
+	// Same keys
+	res, err := servicer.CheckSecrets(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Empty(t, res.Unreadable)
+
+	// Other keys
+	encryption.SetEncryptor(newEncryptor(t, "k0", 1))
+	res, err = servicer.CheckSecrets(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Len(t, res.Unreadable, 1)
+	assert.Equal(t, "IMSI001010000000002", res.Unreadable[0].Key)
+
+	// No encryption
+	encryption.SetEncryptor(nil)
+	res, err = servicer.CheckSecrets(context.Background(), req)
+	assert.NoError(t, err)
+	expected := []*backup_protos.UnreadableConfig{
+		{
+			Type:  lte.SubscriberEntityType,
+			Key:   "IMSI001010000000002",
+			Error: "cannot deserialize subscriber, its secrets are encrypted but encryption is not configured",
+		},
+	}
+	assert.Equal(t, expected, res.Unreadable)
+}
+
+func newEncryptor(t *testing.T, keyID string, keyByte byte) *encryption.Encryptor {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{keyByte}, encryption.KeyEncryptionKeyBytes))
+	provider, err := encryption.NewKeyFileProvider(encryption.KeyFile{CurrentKeyID: keyID, Keys: map[string]string{keyID: key}})
+	assert.NoError(t, err)
+	return encryption.NewEncryptor(provider)
+}
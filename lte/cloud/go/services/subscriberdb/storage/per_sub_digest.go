@@ -14,12 +14,17 @@
 package storage
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	lte_protos "magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/subscriberdb/protos"
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/storage"
 	merrors "magma/orc8r/lib/go/errors"
 
+	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -32,18 +37,52 @@ const (
 	// per-sub digest blobstore, since the actual network of each blob of per-sub
 	// digests is used as the key of the blob.
 	perSubDigestBlobstoreNetworkKey = "per_sub_digest_network_internal"
+
+	// watchRetryInterval is the time to wait before watching the per-sub
+	// digests for changes again, after watching failed
+	watchRetryInterval = 5 * time.Second
 )
 
 type PerSubDigestStore struct {
 	fact blobstore.BlobStorageFactory
+	// cache holds the per-sub digests read from the blobstore, if the store
+	// watches them for changes
+	cache *perSubDigestCache
 }
 
 func NewPerSubDigestStore(fact blobstore.BlobStorageFactory) *PerSubDigestStore {
 	return &PerSubDigestStore{fact: fact}
 }
 
+// NewCachedPerSubDigestStore returns a per-sub digest store which caches the
+// digests it reads, and watches the blobstore's change feed to evict the
+// digests of a network as soon as they're updated or deleted, by any
+// process. Watching stops when ctx is done.
+func NewCachedPerSubDigestStore(ctx context.Context, fact blobstore.WatchableBlobStorageFactory) *PerSubDigestStore {
+	l := &PerSubDigestStore{fact: fact, cache: &perSubDigestCache{}}
+	afterSeq, err := l.startCaching(fact)
+	go l.watch(ctx, fact, afterSeq, err)
+	return l
+}
+
 // GetDigest returns a list of per-subscriber digests of a network, ordered by their subscriber ID.
 func (l *PerSubDigestStore) GetDigest(network string) ([]*lte_protos.SubscriberDigestWithID, error) {
+	if l.cache == nil {
+		return l.getDigest(network)
+	}
+	digests, generation, ok := l.cache.get(network)
+	if ok {
+		return digests, nil
+	}
+	digests, err := l.getDigest(network)
+	if err != nil {
+		return nil, err
+	}
+	l.cache.put(network, digests, generation)
+	return digests, nil
+}
+
+func (l *PerSubDigestStore) getDigest(network string) ([]*lte_protos.SubscriberDigestWithID, error) {
 	store, err := l.fact.StartTransaction(&storage.TxOptions{ReadOnly: true})
 	if err != nil {
 		return nil, errors.Wrapf(err, "error starting transaction")
@@ -119,3 +158,88 @@ func (l *PerSubDigestStore) DeleteDigests(networks []string) error {
 	}
 	return store.Commit()
 }
+
+// startCaching enables caching, and returns the sequence number of the
+// change after which changes have to evict cached digests.
+func (l *PerSubDigestStore) startCaching(fact blobstore.WatchableBlobStorageFactory) (uint64, error) {
+	afterSeq, err := fact.GetLatestChangeSeq()
+	if err != nil {
+		return 0, err
+	}
+	l.cache.reset(true)
+	return afterSeq, nil
+}
+
+// watch evicts the cached digests of networks whose digests change after
+// the passed sequence number, until ctx is done. While it isn't watching,
+// e.g. after reading the change feed failed, nothing is cached.
+func (l *PerSubDigestStore) watch(ctx context.Context, fact blobstore.WatchableBlobStorageFactory, afterSeq uint64, err error) {
+	networkKey := perSubDigestBlobstoreNetworkKey
+	filter := blobstore.SearchFilter{NetworkID: &networkKey, Types: map[string]bool{perSubDigestBlobstoreType: true}}
+	for {
+		if err == nil {
+			err = fact.Watch(ctx, filter, afterSeq, func(change blobstore.Change) error {
+				l.cache.evict(change.Key)
+				return nil
+			})
+			l.cache.reset(false)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		glog.Errorf("Error watching per-sub digests for changes, retrying: %+v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryInterval):
+		}
+		afterSeq, err = l.startCaching(fact)
+	}
+}
+
+// perSubDigestCache holds the per-sub digests of networks.
+type perSubDigestCache struct {
+	sync.RWMutex
+	enabled      bool
+	digestsByNID map[string][]*lte_protos.SubscriberDigestWithID
+	// generation is incremented by every eviction, so that digests read
+	// before an eviction aren't cached after it
+	generation uint64
+}
+
+// get returns the cached digests of the network if there are any, and the
+// current generation of the cache otherwise.
+func (c *perSubDigestCache) get(network string) ([]*lte_protos.SubscriberDigestWithID, uint64, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	digests, ok := c.digestsByNID[network]
+	return digests, c.generation, ok
+}
+
+// put caches the digests of the network, if nothing was evicted since the
+// passed generation.
+func (c *perSubDigestCache) put(network string, digests []*lte_protos.SubscriberDigestWithID, generation uint64) {
+	c.Lock()
+	defer c.Unlock()
+	if !c.enabled || c.generation != generation {
+		return
+	}
+	c.digestsByNID[network] = digests
+}
+
+func (c *perSubDigestCache) evict(network string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.digestsByNID, network)
+	c.generation++
+}
+
+// reset evicts all digests, and enables or disables caching.
+func (c *perSubDigestCache) reset(enabled bool) {
+	c.Lock()
+	defer c.Unlock()
+	c.digestsByNID = map[string][]*lte_protos.SubscriberDigestWithID{}
+	c.generation++
+	c.enabled = enabled
+}
@@ -14,14 +14,19 @@
 package storage_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	lte_protos "magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/subscriberdb"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPerSubDigestStore(t *testing.T) {
@@ -105,6 +110,50 @@ func TestPerSubDigestStore(t *testing.T) {
 	})
 }
 
+func TestCachedPerSubDigestStore(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	// The writer and the cached reader run in different services
+	writerFact := blobstore.NewEntStorageWithChangeFeed(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
+	require.NoError(t, writerFact.InitializeFactory())
+	writer := storage.NewPerSubDigestStore(writerFact)
+	readerFact := blobstore.NewEntStorageWithChangeFeed(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
+	require.NoError(t, readerFact.InitializeFactory())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reader := storage.NewCachedPerSubDigestStore(ctx, readerFact)
+
+	digests := func(digest string) []*lte_protos.SubscriberDigestWithID {
+		return []*lte_protos.SubscriberDigestWithID{{
+			Sid:    &lte_protos.SubscriberID{Id: "00001", Type: lte_protos.SubscriberID_IMSI},
+			Digest: &lte_protos.Digest{Md5Base64Digest: digest},
+		}}
+	}
+	getDigest := func() string {
+		got, err := reader.GetDigest("n0")
+		assert.NoError(t, err)
+		if len(got) == 0 {
+			return ""
+		}
+		return got[0].Digest.GetMd5Base64Digest()
+	}
+
+	assert.NoError(t, writer.SetDigest("n0", digests("apple")))
+	assert.Equal(t, "apple", getDigest())
+
+	// Writes which bypass the change feed aren't seen, since the digests are
+	// cached
+	noFeedWriter := storage.NewPerSubDigestStore(blobstore.NewEntStorage(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder()))
+	assert.NoError(t, noFeedWriter.SetDigest("n0", digests("banana")))
+	assert.Equal(t, "apple", getDigest())
+
+	// Updates and deletions evict the cached digests
+	assert.NoError(t, writer.SetDigest("n0", digests("cherry")))
+	assert.Eventually(t, func() bool { return getDigest() == "cherry" }, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, writer.DeleteDigests([]string{"n0"}))
+	assert.Eventually(t, func() bool { return getDigest() == "" }, 5*time.Second, 10*time.Millisecond)
+}
+
 func checkPerSubDigests(t *testing.T, expected []*lte_protos.SubscriberDigestWithID, got []*lte_protos.SubscriberDigestWithID) {
 	assert.Equal(t, len(expected), len(got))
 	for ind := range expected {
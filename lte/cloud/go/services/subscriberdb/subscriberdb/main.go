@@ -14,9 +14,13 @@ limitations under the License.
 package main
 
 import (
+	"context"
+
 	"magma/lte/cloud/go/lte"
 	lte_protos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/serdes"
 	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/lte/cloud/go/services/subscriberdb/obsidian/handlers"
 	"magma/lte/cloud/go/services/subscriberdb/protos"
 	"magma/lte/cloud/go/services/subscriberdb/servicers"
@@ -26,6 +30,7 @@ import (
 	"magma/orc8r/cloud/go/obsidian/swagger"
 	swagger_protos "magma/orc8r/cloud/go/obsidian/swagger/protos"
 	"magma/orc8r/cloud/go/service"
+	backup_protos "magma/orc8r/cloud/go/services/configurator/backup/protos"
 	state_protos "magma/orc8r/cloud/go/services/state/protos"
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
@@ -59,20 +64,24 @@ func main() {
 		glog.Fatalf("Error initializing flat digest storage: %+v", err)
 	}
 
-	perSubDigestFact := blobstore.NewEntStorage(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
+	// Per-sub digests are written by subscriberdb_cache, and cached until it
+	// changes them
+	perSubDigestFact := blobstore.NewEntStorageWithChangeFeed(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
 	if err := perSubDigestFact.InitializeFactory(); err != nil {
 		glog.Fatalf("Error initializing per-sub digest storage: %+v", err)
 	}
-	perSubDigestStore := subscriberdb_storage.NewPerSubDigestStore(perSubDigestFact)
+	perSubDigestStore := subscriberdb_storage.NewCachedPerSubDigestStore(context.Background(), perSubDigestFact)
 
 	serviceConfig := subscriberdb.MustGetServiceConfig()
 	glog.Infof("Subscriberdb service config %+v", serviceConfig)
+	encryption.MustConfigure(serviceConfig.SecretsEncryption)
 
 	// Attach handlers
 	obsidian.AttachHandlers(srv.EchoServer, handlers.GetHandlers())
 	protos.RegisterSubscriberLookupServer(srv.GrpcServer, servicers.NewLookupServicer(fact, ipStore))
 	state_protos.RegisterIndexerServer(srv.GrpcServer, servicers.NewIndexerServicer())
 	lte_protos.RegisterSubscriberDBCloudServer(srv.GrpcServer, servicers.NewSubscriberdbServicer(serviceConfig, digestStore, perSubDigestStore))
+	backup_protos.RegisterEntitySecretsServer(srv.GrpcServer, servicers.NewEntitySecretsServicer(serdes.Entity))
 
 	swagger_protos.RegisterSwaggerSpecServer(srv.GrpcServer, swagger.NewSpecServicerFromFile(subscriberdb.ServiceName))
 
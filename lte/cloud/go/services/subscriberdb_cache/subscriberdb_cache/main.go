@@ -14,8 +14,11 @@ limitations under the License.
 package main
 
 import (
+	"time"
+
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	subscriberdb_storage "magma/lte/cloud/go/services/subscriberdb/storage"
 	"magma/lte/cloud/go/services/subscriberdb_cache"
 	"magma/orc8r/cloud/go/blobstore"
@@ -41,12 +44,17 @@ func main() {
 		glog.Fatalf("Error initializing digest storage: %+v", err)
 	}
 
-	fact := blobstore.NewEntStorage(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
+	// Changes to per-sub digests are recorded, so that subscriberdb can evict
+	// them from its cache
+	fact := blobstore.NewEntStorageWithChangeFeed(subscriberdb.PerSubDigestTableBlobstore, db, sqorc.GetSqlBuilder())
 	if err := fact.InitializeFactory(); err != nil {
 		glog.Fatalf("Error initializing per-sub digest storage: %+v", err)
 	}
+	go blobstore.RunChangeFeedPruner(fact, blobstore.DefaultChangeFeedRetention, time.Hour)
 	perSubDigestStore := subscriberdb_storage.NewPerSubDigestStore(fact)
 
+	encryption.MustConfigure(subscriberdb.MustGetServiceConfig().SecretsEncryption)
+
 	serviceConfig := subscriberdb_cache.MustGetServiceConfig()
 	glog.Infof("Subscriberdb_cache service config %+v", serviceConfig)
 
@@ -49,6 +49,27 @@ func (_m *SMSSerde) DecodeDelivery(input []byte) (sms_ll.SMSDeliveryReport, erro
 	return r0, r1
 }
 
+// DecodeSubmit provides a mock function with given fields: input
+func (_m *SMSSerde) DecodeSubmit(input []byte) (sms_ll.SMSSubmit, error) {
+	ret := _m.Called(input)
+
+	var r0 sms_ll.SMSSubmit
+	if rf, ok := ret.Get(0).(func([]byte) sms_ll.SMSSubmit); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(sms_ll.SMSSubmit)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EncodeMessage provides a mock function with given fields: message, fromNum, timestamp, references
 func (_m *SMSSerde) EncodeMessage(message string, fromNum string, timestamp time.Time, references []uint8) ([][]byte, error) {
 	ret := _m.Called(message, fromNum, timestamp, references)
@@ -71,3 +92,26 @@ func (_m *SMSSerde) EncodeMessage(message string, fromNum string, timestamp time
 
 	return r0, r1
 }
+
+// EncodeSubmitAck provides a mock function with given fields: submit
+func (_m *SMSSerde) EncodeSubmitAck(submit sms_ll.SMSSubmit) ([]byte, error) {
+	ret := _m.Called(submit)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(sms_ll.SMSSubmit) []byte); ok {
+		r0 = rf(submit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sms_ll.SMSSubmit) error); ok {
+		r1 = rf(submit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -28,6 +28,8 @@ import (
 type SMSSerde interface {
 	EncodeMessage(message string, fromNum string, timestamp time.Time, references []uint8) ([][]byte, error)
 	DecodeDelivery(input []byte) (SMSDeliveryReport, error)
+	DecodeSubmit(input []byte) (SMSSubmit, error)
+	EncodeSubmitAck(submit SMSSubmit) ([]byte, error)
 }
 
 // DefaultSMSSerde is the SMSSerde impl that's backed by the exported functions
@@ -42,6 +44,19 @@ func (d *DefaultSMSSerde) DecodeDelivery(input []byte) (SMSDeliveryReport, error
 	return Decode(input)
 }
 
+func (d *DefaultSMSSerde) DecodeSubmit(input []byte) (SMSSubmit, error) {
+	return DecodeSubmit(input)
+}
+
+func (d *DefaultSMSSerde) EncodeSubmitAck(submit SMSSubmit) ([]byte, error) {
+	return GenerateRpAck(submit.Reference, submit.TransactionID)
+}
+
+// ErrNotSubmit is returned by DecodeSubmit when the input is a well-formed
+// CP message which doesn't carry a mobile-originated RP-DATA, e.g. a delivery
+// report.
+var ErrNotSubmit = errors.New("not a mobile-originated RP-DATA message")
+
 // Generate fully encoded SMS PDUs for delivery to a UE (MS). Will handle
 // encoding and chunking of messages as appropriate. We first generate TPDUs,
 // then RP-DATA headers, and finally CP-DATA headers, resulting in a set of
@@ -158,6 +173,133 @@ func Decode(input []byte) (SMSDeliveryReport, error) {
 	}
 }
 
+// SMSSubmit is a struct that wraps the decoded result of a mobile-originated
+// CP-DATA(RP-DATA(SMS-SUBMIT)) message.
+// Segments of a concatenated SMS are decoded individually: SegmentCount is
+// greater than 1 for these, and the full message text is recovered by passing
+// the TPDUs of all segments to ReassembleSubmits.
+type SMSSubmit struct {
+	// CP transaction ID and RP message reference of the RP-DATA, which
+	// have to be echoed in the RP-ACK
+	TransactionID byte
+	Reference     uint8
+
+	// Destination is the TP-Destination-Address of the SMS
+	Destination string
+	// Message is the text of this segment
+	Message string
+	// TPDU is the encoded SMS-SUBMIT
+	TPDU []byte
+
+	// Concatenation info. SegmentCount and SegmentNumber are 1 for
+	// single-segment messages.
+	ConcatRef     int
+	SegmentCount  int
+	SegmentNumber int
+}
+
+// Decodes a mobile-originated CP-DATA(RP-DATA(SMS-SUBMIT)) message.
+// Inputs:
+//	input: A byte array representing a fully encoded SMS sent by a UE
+// Outputs:
+//	- SMSSubmit: the decoded SMS-SUBMIT
+//	- error: ErrNotSubmit if the message is a valid CP message but not a
+//	CP-DATA(RP-DATA) from the UE, or any decoding error.
+func DecodeSubmit(input []byte) (SMSSubmit, error) {
+	ret := SMSSubmit{}
+	cpm := new(cpMessage)
+	err := cpm.unmarshalBinary(input)
+	if err != nil {
+		return ret, err
+	}
+	if cpm.messageType != CpData {
+		return ret, ErrNotSubmit
+	}
+
+	rpm := new(rpMessage)
+	err = rpm.unmarshalBinary(cpm.rpdu)
+	if err != nil {
+		return ret, err
+	}
+	msgType, _ := rpm.msgType()
+	if msgType != RpData || rpm.direction() != RpMo {
+		return ret, ErrNotSubmit
+	}
+
+	pdu, err := sms.Unmarshal(rpm.userData.tpdu, sms.AsMO)
+	if err != nil {
+		return ret, err
+	}
+	if pdu.SmsType() != tpdu.SmsSubmit {
+		return ret, fmt.Errorf("not an SMS-SUBMIT: %s", pdu.SmsType())
+	}
+	text, err := sms.Decode([]*tpdu.TPDU{pdu})
+	if err != nil {
+		return ret, err
+	}
+
+	ret = SMSSubmit{
+		TransactionID: cpm.GetTransactionId(),
+		Reference:     rpm.reference,
+		Destination:   pdu.DA.Number(),
+		Message:       string(text),
+		TPDU:          rpm.userData.tpdu,
+		SegmentCount:  1,
+		SegmentNumber: 1,
+	}
+	if segments, seqno, concatRef, ok := pdu.ConcatInfo(); ok {
+		ret.ConcatRef, ret.SegmentCount, ret.SegmentNumber = concatRef, segments, seqno
+	}
+	return ret, nil
+}
+
+// Reassembles the text of a concatenated mobile-originated SMS.
+// Inputs:
+//	tpdus: The SMS-SUBMIT TPDUs of all segments of the message, ordered by
+//	segment number.
+// Outputs:
+//	- string: the UTF-8 text of the full message
+//	- error: if the segments don't form a complete message
+func ReassembleSubmits(tpdus [][]byte) (string, error) {
+	segments := make([]*tpdu.TPDU, 0, len(tpdus))
+	for _, b := range tpdus {
+		pdu, err := sms.Unmarshal(b, sms.AsMO)
+		if err != nil {
+			return "", err
+		}
+		segments = append(segments, pdu)
+	}
+	if !sms.IsCompleteMessage(segments) {
+		return "", errors.New("incomplete concatenated SMS")
+	}
+
+	text, err := sms.Decode(segments)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// Generate a fully encoded CP-DATA(RP-ACK) acknowledging a mobile-originated
+// RP-DATA to a UE (MS).
+// Inputs:
+//	reference: The RP message reference of the acknowledged RP-DATA
+//	txID: The CP transaction ID of the acknowledged CP-DATA
+// Outputs:
+//	- byte array representing the encoded CP-DATA(RP-ACK)
+//	- Error (if any)
+func GenerateRpAck(reference uint8, txID byte) ([]byte, error) {
+	rpm := rpMessage{mti: RpMtiMtAck, reference: reference}
+
+	// The TI flag is set in messages sent by the side which didn't originate
+	// the transaction (TS 24.007 11.2.3.1.3)
+	cpm, err := createCpDataMessage(rpm.marshalBinary(), txID&0x7|0x8)
+	if err != nil {
+		return nil, err
+	}
+	return cpm.marshalBinary(), nil
+}
+
 func createTpdus(message string, from_num string, timestamp time.Time) []tpdu.TPDU {
 	tpdus, _ := sms.Encode([]byte(message), sms.AsDeliver, sms.From(from_num))
 	for i := range tpdus {
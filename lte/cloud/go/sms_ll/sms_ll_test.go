@@ -177,3 +177,81 @@ func TestUnmarshalAddressElement(t *testing.T) {
 		t.Errorf("RPAddressElement incorrect number. Have:\n%s\nwant\n%s", hex.Dump(rpadde.number), hex.Dump(num))
 	}
 }
+
+func TestDecodeSubmit(t *testing.T) {
+	input, _ := hex.DecodeString("09011b00200002b9111401030b918156685703f9000008c834888e2ecbcb")
+	actual, err := DecodeSubmit(input)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x0), actual.TransactionID)
+	assert.Equal(t, uint8(0x20), actual.Reference)
+	assert.Equal(t, "+18658675309", actual.Destination)
+	assert.Equal(t, "Hi there", actual.Message)
+	assert.Equal(t, "01030b918156685703f9000008c834888e2ecbcb", hex.EncodeToString(actual.TPDU))
+	assert.Equal(t, 1, actual.SegmentCount)
+	assert.Equal(t, 1, actual.SegmentNumber)
+
+	// Delivery reports aren't submits
+	input, _ = hex.DecodeString("d90106020141020000")
+	_, err = DecodeSubmit(input)
+	assert.Equal(t, ErrNotSubmit, err)
+
+	// MT RP-DATA isn't a submit
+	input, _ = hex.DecodeString("790127010702b9110020240b918156685703f90000029041610305000ec8b2bc7c9a83c2207a794e7701")
+	_, err = DecodeSubmit(input)
+	assert.Equal(t, ErrNotSubmit, err)
+
+	// CP-ACK isn't a submit
+	input, _ = hex.DecodeString("9904")
+	_, err = DecodeSubmit(input)
+	assert.Equal(t, ErrNotSubmit, err)
+
+	// Truncated RP-User-Data
+	input, _ = hex.DecodeString("09010a00200002b9111401030b")
+	_, err = DecodeSubmit(input)
+	assert.Error(t, err)
+}
+
+func TestDecodeAndReassembleMultipleSubmits(t *testing.T) {
+	inputs := []string{
+		"0901a000200002b9119941030b918156685703f90000a0050003010201906579f934078541f4f29c0e7a9b416190bd5c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c96cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2e97cbe572b95c2ecfe7f3f97c3e9fcfe7f3f97c3e9fcfe741ecb7fb0c6a97e7f3f0b90ca2a3c3",
+		"19012d00210002b9112641040b918156685703f900001c050003010202e8a739685e8797e5a0791d5e9683d86ff7d905",
+	}
+	var tpdus [][]byte
+	for i, in := range inputs {
+		input, _ := hex.DecodeString(in)
+		actual, err := DecodeSubmit(input)
+		assert.NoError(t, err)
+		assert.Equal(t, byte(i), actual.TransactionID)
+		assert.Equal(t, uint8(0x20+i), actual.Reference)
+		assert.Equal(t, 1, actual.ConcatRef)
+		assert.Equal(t, 2, actual.SegmentCount)
+		assert.Equal(t, i+1, actual.SegmentNumber)
+		tpdus = append(tpdus, actual.TPDU)
+	}
+
+	msg, err := ReassembleSubmits(tpdus)
+	assert.NoError(t, err)
+	assert.Equal(t, "Here's a test of a veeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeerrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrryyyyyyyyyyyyyyyyyy long message that's super super long.", msg)
+
+	// Segments out of order or missing
+	_, err = ReassembleSubmits([][]byte{tpdus[1], tpdus[0]})
+	assert.Error(t, err)
+	_, err = ReassembleSubmits(tpdus[:1])
+	assert.Error(t, err)
+}
+
+func TestGenerateRpAck(t *testing.T) {
+	b, err := GenerateRpAck(0x20, 0x1)
+	assert.NoError(t, err)
+	assert.Equal(t, "9901020320", hex.EncodeToString(b))
+
+	// The generated ack decodes as a delivery report for the reference
+	cpm := new(cpMessage)
+	assert.NoError(t, cpm.unmarshalBinary(b))
+	assert.Equal(t, byte(0x9), cpm.GetTransactionId())
+	rpm := new(rpMessage)
+	assert.NoError(t, rpm.unmarshalBinary(cpm.rpdu))
+	msgType, _ := rpm.msgType()
+	assert.Equal(t, RpAck, int(msgType))
+	assert.Equal(t, byte(0x20), rpm.reference)
+}
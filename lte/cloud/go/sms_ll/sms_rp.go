@@ -54,14 +54,16 @@ func (rpadde rpAddressElement) marshalBinary() []byte {
 
 // Decode an address element. Returns the length of the address element if present.
 func (rpadde *rpAddressElement) unmarshalBinary(input []byte) (int, error) {
-	// Empty addresses will be one byte long with a zero value length
-	if len(input) == 1 {
-		if input[0] != 0x0 {
-			return -1, smsRpError("Invalid RP Address of length 1")
-		} else {
-			rpadde.length = input[0]
-			return 1, nil
-		}
+	if len(input) == 0 {
+		return -1, smsRpError("Missing RP Address")
+	}
+	// Empty addresses will be one byte long with a zero value length, and
+	// may be followed by the remaining IEs of the message
+	if input[0] == 0x0 {
+		rpadde.length = input[0]
+		return 1, nil
+	} else if len(input) == 1 {
+		return -1, smsRpError("Invalid RP Address of length 1")
 	} else if len(input) < 3 { // if it's not zero length, we must have at least 3 octets
 		return -1, smsRpError("Invalid RP Address")
 	}
@@ -70,6 +72,9 @@ func (rpadde *rpAddressElement) unmarshalBinary(input []byte) (int, error) {
 	rpadde.numberInfo = input[1]
 
 	num_bytes := rpadde.getNumberOctets()
+	if len(input) < num_bytes+2 {
+		return -1, smsRpError("RP Address too short")
+	}
 	rpadde.number = make([]byte, num_bytes)
 	copy(rpadde.number, input[2:num_bytes+2])
 
@@ -117,19 +122,28 @@ func (rpue rpUserElement) marshalBinary(msgType byte) []byte {
 	return b
 }
 
-func (rpue *rpUserElement) unmarshalBinary(msgType byte, input []byte) int {
+func (rpue *rpUserElement) unmarshalBinary(msgType byte, input []byte) (int, error) {
 	idx := 0
 	if msgType == RpAck || msgType == RpError { // these start with IEI
+		if len(input) < 1 {
+			return 0, smsRpError("RP-User-Data too short")
+		}
 		rpue.iei = input[idx]
 		idx++
 	}
+	if len(input) < idx+1 {
+		return 0, smsRpError("RP-User-Data too short")
+	}
 	rpue.length = input[idx]
 	idx++
 
 	end := idx + int(rpue.length)
+	if len(input) < end {
+		return 0, smsRpError("RP-User-Data shorter than its length")
+	}
 	rpue.tpdu = make([]byte, rpue.length)
 	copy(rpue.tpdu, input[idx:end])
-	return end
+	return end, nil
 }
 
 // RP-Cause element (TS 24.011 8.2.5.4)
@@ -221,25 +235,35 @@ func (rpm *rpMessage) unmarshalBinary(input []byte) error {
 	switch rpmt {
 	case RpData:
 		// The next two IEs should be adddresses in this case. So, get the lengths and pass to unmarshal
-		n, _ := rpm.originatorAddress.unmarshalBinary(input[idx:])
+		n, err := rpm.originatorAddress.unmarshalBinary(input[idx:])
+		if err != nil {
+			return err
+		}
 		if rpm.direction() == RpMo && n != 1 {
 			return smsRpError("SMS-RP-DATA is MO, but OA length != 1")
 		}
 		idx += n
-		n, _ = rpm.destinationAddress.unmarshalBinary(input[idx:])
+		n, err = rpm.destinationAddress.unmarshalBinary(input[idx:])
+		if err != nil {
+			return err
+		}
 		if rpm.direction() == RpMt && n != 1 {
 			return smsRpError("SMS-RP-DATA is MT, but DA length != 1")
 		}
 		idx += n
 
-		rpm.userData.unmarshalBinary(RpData, input[idx:])
+		if _, err := rpm.userData.unmarshalBinary(RpData, input[idx:]); err != nil {
+			return err
+		}
 	case RpAck:
 		// RP-ACK and RP-ERROR may optionally contain an RP-User-Data
 		// element (TS24.001 7.3.3). If this is the case, it will be a
 		// TLV IE, with the first octet starting with the RP-User-Data
 		// IE ID (0x41).
 		if len(input) > 2 && input[idx] == RpUdeIei {
-			rpm.userData.unmarshalBinary(RpAck, input[idx:])
+			if _, err := rpm.userData.unmarshalBinary(RpAck, input[idx:]); err != nil {
+				return err
+			}
 		}
 	case RpError:
 		// Do nothing
@@ -0,0 +1,60 @@
+/*
+ Copyright 2021 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"database/sql"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/tools/migrations"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// This migration envelope encrypts the auth keys of all subscriber configs
+// with the key provider configured in the subscriberdb service config.
+// Configs already encrypted are left unchanged, unless they were encrypted
+// under a key other than the current key.
+func main() {
+	dbDriver := migrations.GetEnvWithDefault("SQL_DRIVER", "postgres")
+	dbSource := migrations.GetEnvWithDefault("DATABASE_SOURCE", "dbname=magma_dev user=magma_dev password=magma_dev host=postgres sslmode=disable")
+	db, err := sqorc.Open(dbDriver, dbSource)
+	if err != nil {
+		glog.Fatal(errors.Wrap(err, "could not open db connection"))
+	}
+
+	provider, err := encryption.NewProvider(subscriberdb.MustGetServiceConfig().SecretsEncryption)
+	if err != nil {
+		glog.Fatalf("could not create key provider: %s", err)
+	}
+	if provider == nil {
+		glog.Fatal("subscriber secrets encryption is not configured")
+	}
+	encryptor := encryption.NewEncryptor(provider)
+
+	doMigration := func(tx *sql.Tx) (interface{}, error) {
+		return encryption.ReencryptEntityConfigs(tx, sqorc.GetSqlBuilder(), lte.SubscriberEntityType, models.SubscriberConfigSecrets, encryptor)
+	}
+	count, err := migrations.ExecInTx(db, &sql.TxOptions{Isolation: sql.LevelSerializable}, nil, doMigration)
+	if err != nil {
+		glog.Fatalf("unexpected error occurred during migration: %s", err)
+	}
+
+	glog.Infof("Subscriber key encryption migration successfully completed, encrypted %d subscribers", count)
+}
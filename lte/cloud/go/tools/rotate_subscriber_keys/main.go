@@ -0,0 +1,68 @@
+/*
+ Copyright 2021 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// rotate_subscriber_keys re-encrypts the auth keys of all subscribers not
+// encrypted under the current key encryption key, after the current key of
+// the configured key provider was rotated. Old keys must be kept available
+// to the key provider until this completes.
+package main
+
+import (
+	"database/sql"
+	"flag"
+
+	"magma/lte/cloud/go/lte"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/tools/migrations"
+
+	"github.com/golang/glog"
+)
+
+func main() {
+	keyFile := flag.String("key_file", "", "Key file overriding the key provider of the subscriberdb service config")
+	flag.Parse()
+
+	cfg := subscriberdb.MustGetServiceConfig().SecretsEncryption
+	if *keyFile != "" {
+		cfg = encryption.Config{Provider: encryption.ProviderFile, KeyFile: *keyFile}
+	}
+	provider, err := encryption.NewProvider(cfg)
+	if err != nil {
+		glog.Fatalf("Error creating key provider: %s", err)
+	}
+	if provider == nil {
+		glog.Fatal("Subscriber secrets encryption is not configured")
+	}
+	encryptor := encryption.NewEncryptor(provider)
+	currentKeyID, err := encryptor.CurrentKeyID()
+	if err != nil {
+		glog.Fatalf("Error getting current key ID: %s", err)
+	}
+
+	db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+	if err != nil {
+		glog.Fatalf("Error opening db connection: %s", err)
+	}
+	rotate := func(tx *sql.Tx) (interface{}, error) {
+		return encryption.ReencryptEntityConfigs(tx, sqorc.GetSqlBuilder(), lte.SubscriberEntityType, models.SubscriberConfigSecrets, encryptor)
+	}
+	count, err := migrations.ExecInTx(db, &sql.TxOptions{Isolation: sql.LevelSerializable}, nil, rotate)
+	if err != nil {
+		glog.Fatalf("Error rotating subscriber keys: %s", err)
+	}
+	glog.Infof("Re-encrypted %d subscribers under key '%s'", count, currentKeyID)
+}
@@ -0,0 +1,49 @@
+/*
+ Copyright 2021 The Magma Authors.
+
+ This source code is licensed under the BSD-style license found in the
+ LICENSE file in the root directory of this source tree.
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// subscriber_kms serves the SubscriberKeyManagement service from a key file,
+// a stand-in for an external KMS for the grpc key provider of subscriber
+// secrets encryption.
+package main
+
+import (
+	"flag"
+	"net"
+
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/lte/cloud/go/services/subscriberdb/protos"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	keyFile := flag.String("key_file", "/var/opt/magma/configs/subscriber_keys.yml", "Key file holding the key encryption keys")
+	address := flag.String("address", ":9111", "Address to serve the key management service on")
+	flag.Parse()
+
+	provider, err := encryption.NewFileKeyProvider(*keyFile)
+	if err != nil {
+		glog.Fatalf("Error loading key file: %s", err)
+	}
+	lis, err := net.Listen("tcp", *address)
+	if err != nil {
+		glog.Fatalf("Error listening on %s: %s", *address, err)
+	}
+	srv := grpc.NewServer()
+	protos.RegisterSubscriberKeyManagementServer(srv, encryption.NewKeyManagementServicer(provider))
+	glog.Infof("Serving subscriber key management on %s", *address)
+	if err := srv.Serve(lis); err != nil {
+		glog.Fatalf("Error serving subscriber key management: %s", err)
+	}
+}
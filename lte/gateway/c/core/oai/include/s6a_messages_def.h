@@ -49,3 +49,9 @@ MESSAGE_DEF(
 MESSAGE_DEF(S6A_PURGE_UE_REQ, s6a_purge_ue_req_t, s6a_purge_ue_req)
 MESSAGE_DEF(S6A_PURGE_UE_ANS, s6a_purge_ue_ans_t, s6a_purge_ue_ans)
 MESSAGE_DEF(S6A_RESET_REQ, s6a_reset_req_t, s6a_reset_req)
+MESSAGE_DEF(
+    S6A_INSERT_SUBSCRIBER_DATA_REQ, s6a_insert_subscriber_data_req_t,
+    s6a_insert_subscriber_data_req)
+MESSAGE_DEF(
+    S6A_DELETE_SUBSCRIBER_DATA_REQ, s6a_delete_subscriber_data_req_t,
+    s6a_delete_subscriber_data_req)
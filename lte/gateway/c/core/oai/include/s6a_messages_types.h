@@ -55,6 +55,10 @@
 #define S6A_PURGE_UE_REQ(mSGpTR) (mSGpTR)->ittiMsg.s6a_purge_ue_req
 #define S6A_PURGE_UE_ANS(mSGpTR) (mSGpTR)->ittiMsg.s6a_purge_ue_ans
 #define S6A_RESET_REQ(mSGpTR) (mSGpTR)->ittiMsg.s6a_reset_req
+#define S6A_INSERT_SUBSCRIBER_DATA_REQ(mSGpTR)                                 \
+  (mSGpTR)->ittiMsg.s6a_insert_subscriber_data_req
+#define S6A_DELETE_SUBSCRIBER_DATA_REQ(mSGpTR)                                 \
+  (mSGpTR)->ittiMsg.s6a_delete_subscriber_data_req
 
 #define AUTS_LENGTH 14
 #define RESYNC_PARAM_LENGTH AUTS_LENGTH + RAND_LENGTH_OCTETS
@@ -161,4 +165,31 @@ typedef struct s6a_reset_req_s {
   uint8_t unused;
 } s6a_reset_req_t;
 
+typedef struct s6a_insert_subscriber_data_req_s {
+  char imsi[IMSI_BCD_DIGITS_MAX + 1];
+  uint8_t imsi_length;
+  uint32_t idr_flags;
+  /* Only the parts of the subscription data flagged here are updated */
+#define S6A_IDR_MSISDN_PRESENT (1 << 0)
+#define S6A_IDR_AMBR_PRESENT (1 << 1)
+#define S6A_IDR_APN_CONFIG_PROFILE_PRESENT (1 << 2)
+#define S6A_IDR_SUBSCRIBER_STATUS_PRESENT (1 << 3)
+#define S6A_IDR_NETWORK_ACCESS_MODE_PRESENT (1 << 4)
+#define S6A_IDR_ODB_PRESENT (1 << 5)
+  uint8_t presencemask;
+  subscription_data_t subscription_data;
+  /* Operator-Determined-Barring bit mask */
+#define ODB_ALL_PACKET_ORIENTED_SERVICES_BARRED (1U)
+  uint32_t operator_determined_barring;
+} s6a_insert_subscriber_data_req_t;
+
+typedef struct s6a_delete_subscriber_data_req_s {
+  char imsi[IMSI_BCD_DIGITS_MAX + 1];
+  uint8_t imsi_length;
+  uint32_t dsr_flags;
+  /* Context identifiers of the APN configurations to delete */
+  uint8_t nb_context_ids;
+  context_identifier_t context_ids[MAX_APN_PER_UE];
+} s6a_delete_subscriber_data_req_t;
+
 #endif /* FILE_S6A_MESSAGES_TYPES_SEEN */
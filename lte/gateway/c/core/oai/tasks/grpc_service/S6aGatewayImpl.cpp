@@ -22,9 +22,11 @@
 
 extern "C" {
 #include "s6a_service_handler.h"
+#include "common_defs.h"
 #include "log.h"
 }
 #include "S6aGatewayImpl.h"
+#include "proto_msg_to_itti_msg.h"
 
 namespace grpc {
 class Channel;
@@ -72,4 +74,57 @@ Status S6aGatewayImpl::Reset(
   return Status::OK;
 }
 
+static bool is_valid_imsi(const std::string& imsi) {
+  if (imsi.empty() || imsi.length() > IMSI_BCD_DIGITS_MAX) {
+    return false;
+  }
+  return imsi.find_first_not_of("0123456789") == std::string::npos;
+}
+
+Status S6aGatewayImpl::InsertSubscriberData(
+    ServerContext* context, const InsertSubscriberDataRequest* request,
+    InsertSubscriberDataAnswer* response) {
+  auto imsi = request->user_name();
+  OAILOG_INFO(
+      LOG_MME_APP, "Received IDR for %s with flags %u\n", imsi.c_str(),
+      request->idr_flags());
+  if (!is_valid_imsi(imsi)) {
+    return Status(StatusCode::INVALID_ARGUMENT, "Invalid IDR IMSI " + imsi);
+  }
+  s6a_insert_subscriber_data_req_t idr = {0};
+  convert_proto_msg_to_itti_s6a_insert_subscriber_data_req(request, &idr);
+  // Send message to MME_APP, which updates the subscription data of the UE
+  if (insert_subscriber_data_request(&idr) != RETURNok) {
+    return Status(
+        StatusCode::UNAVAILABLE, "Failed to send IDR to MME_APP for " + imsi);
+  }
+  if (response != NULL) {
+    response->set_error_code(ErrorCode::SUCCESS);
+  }
+  return Status::OK;
+}
+
+Status S6aGatewayImpl::DeleteSubscriberData(
+    ServerContext* context, const DeleteSubscriberDataRequest* request,
+    DeleteSubscriberDataAnswer* response) {
+  auto imsi = request->user_name();
+  OAILOG_INFO(
+      LOG_MME_APP, "Received DSR for %s with flags %u\n", imsi.c_str(),
+      request->dsr_flags());
+  if (!is_valid_imsi(imsi)) {
+    return Status(StatusCode::INVALID_ARGUMENT, "Invalid DSR IMSI " + imsi);
+  }
+  s6a_delete_subscriber_data_req_t dsr = {0};
+  convert_proto_msg_to_itti_s6a_delete_subscriber_data_req(request, &dsr);
+  // Send message to MME_APP, which removes the APN configurations of the UE
+  if (delete_subscriber_data_request(&dsr) != RETURNok) {
+    return Status(
+        StatusCode::UNAVAILABLE, "Failed to send DSR to MME_APP for " + imsi);
+  }
+  if (response != NULL) {
+    response->set_error_code(ErrorCode::SUCCESS);
+  }
+  return Status::OK;
+}
+
 }  // namespace magma
@@ -28,6 +28,10 @@ namespace magma {
 namespace feg {
 class CancelLocationAnswer;
 class CancelLocationRequest;
+class DeleteSubscriberDataAnswer;
+class DeleteSubscriberDataRequest;
+class InsertSubscriberDataAnswer;
+class InsertSubscriberDataRequest;
 class ResetAnswer;
 class ResetRequest;
 }  // namespace feg
@@ -65,6 +69,30 @@ class S6aGatewayImpl final : public S6aGatewayService::Service {
   grpc::Status Reset(
       ServerContext* context, const ResetRequest* request,
       ResetAnswer* response) override;
+  /*
+   * Insert Subscriber Data Request
+   * S6a Command Code: 319
+   *
+   * @param context: the grpc Server context
+   * @param request: InsertSubscriberDataRequest
+   * @param response (out): InsertSubscriberDataAnswer
+   * @return grpc Status instance
+   */
+  grpc::Status InsertSubscriberData(
+      ServerContext* context, const InsertSubscriberDataRequest* request,
+      InsertSubscriberDataAnswer* response) override;
+  /*
+   * Delete Subscriber Data Request
+   * S6a Command Code: 320
+   *
+   * @param context: the grpc Server context
+   * @param request: DeleteSubscriberDataRequest
+   * @param response (out): DeleteSubscriberDataAnswer
+   * @return grpc Status instance
+   */
+  grpc::Status DeleteSubscriberData(
+      ServerContext* context, const DeleteSubscriberDataRequest* request,
+      DeleteSubscriberDataAnswer* response) override;
 };
 
 }  // namespace magma
@@ -27,6 +27,7 @@
 #include "3gpp_24.008.h"
 #include "common_ies.h"
 #include "feg/protos/csfb.pb.h"
+#include "feg/protos/s6a_proxy.pb.h"
 #include "lte/protos/sms_orc8r.pb.h"
 
 extern "C" {
@@ -277,4 +278,139 @@ void convert_proto_msg_to_itti_sgsap_mm_information_req(
   return;
 }
 
+static void convert_proto_msg_to_itti_ambr(
+    const UpdateLocationAnswer::AggregatedMaximumBitrate& msg, ambr_t* ambr) {
+  ambr->br_ul   = msg.max_bandwidth_ul();
+  ambr->br_dl   = msg.max_bandwidth_dl();
+  ambr->br_unit = (apn_ambr_bitrate_unit_t) msg.unit();
+}
+
+static void convert_proto_msg_to_itti_apn_configuration(
+    const UpdateLocationAnswer::APNConfiguration& apn,
+    apn_configuration_t* itti_apn) {
+  itti_apn->context_identifier = apn.context_id();
+  itti_apn->pdn_type           = (pdn_type_t) apn.pdn();
+
+  auto service_sel = apn.service_selection();
+  itti_apn->service_selection_length =
+      (service_sel.length() > APN_MAX_LENGTH) ? APN_MAX_LENGTH :
+                                                service_sel.length();
+  memcpy(
+      itti_apn->service_selection, service_sel.c_str(),
+      itti_apn->service_selection_length);
+
+  auto charging_characteristics = apn.charging_characteristics();
+  itti_apn->charging_characteristics.length =
+      (charging_characteristics.length() > CHARGING_CHARACTERISTICS_LENGTH) ?
+          CHARGING_CHARACTERISTICS_LENGTH :
+          charging_characteristics.length();
+  memcpy(
+      itti_apn->charging_characteristics.value,
+      charging_characteristics.c_str(),
+      itti_apn->charging_characteristics.length);
+  itti_apn->charging_characteristics
+      .value[itti_apn->charging_characteristics.length] = '\0';
+
+  // Qos profile
+  itti_apn->subscribed_qos.qci = (qci_t) apn.qos_profile().class_id();
+  itti_apn->subscribed_qos.allocation_retention_priority.priority_level =
+      apn.qos_profile().priority_level();
+  itti_apn->subscribed_qos.allocation_retention_priority.pre_emp_vulnerability =
+      (pre_emption_vulnerability_t) apn.qos_profile()
+          .preemption_vulnerability();
+  itti_apn->subscribed_qos.allocation_retention_priority.pre_emp_capability =
+      (pre_emption_capability_t) apn.qos_profile().preemption_capability();
+
+  convert_proto_msg_to_itti_ambr(apn.ambr(), &itti_apn->ambr);
+}
+
+// S6a Insert Subscriber Data Request
+void convert_proto_msg_to_itti_s6a_insert_subscriber_data_req(
+    const InsertSubscriberDataRequest* msg,
+    s6a_insert_subscriber_data_req_t* itti_msg) {
+  auto imsi             = msg->user_name();
+  itti_msg->imsi_length = imsi.length();
+  strcpy(itti_msg->imsi, imsi.c_str());
+  itti_msg->idr_flags = msg->idr_flags();
+
+  subscription_data_t* subscription_data = &itti_msg->subscription_data;
+  // Proto3 scalars have no presence, the s6a_proxy flags the AVPs in the IDR
+  auto presence_flags = msg->presence_flags();
+  if (presence_flags & InsertSubscriberDataRequest::SUBSCRIBER_STATUS_PRESENT) {
+    subscription_data->subscriber_status =
+        (msg->subscriber_status() ==
+         InsertSubscriberDataRequest::OPERATOR_DETERMINED_BARRING) ?
+            SS_OPERATOR_DETERMINED_BARRING :
+            SS_SERVICE_GRANTED;
+    itti_msg->presencemask |= S6A_IDR_SUBSCRIBER_STATUS_PRESENT;
+  }
+  if (presence_flags &
+      InsertSubscriberDataRequest::NETWORK_ACCESS_MODE_PRESENT) {
+    if (msg->network_access_mode() ==
+        UpdateLocationAnswer_NetworkAccessMode_PACKET_AND_CIRCUIT) {
+      subscription_data->access_mode = NAM_PACKET_AND_CIRCUIT;
+    } else if (
+        msg->network_access_mode() ==
+        UpdateLocationAnswer_NetworkAccessMode_RESERVED) {
+      subscription_data->access_mode = NAM_RESERVED;
+    } else {
+      subscription_data->access_mode = NAM_ONLY_PACKET;
+    }
+    itti_msg->presencemask |= S6A_IDR_NETWORK_ACCESS_MODE_PRESENT;
+  }
+  if (presence_flags &
+      InsertSubscriberDataRequest::OPERATOR_DETERMINED_BARRING_PRESENT) {
+    itti_msg->operator_determined_barring = msg->operator_determined_barring();
+    itti_msg->presencemask |= S6A_IDR_ODB_PRESENT;
+  }
+
+  if (!msg->msisdn().empty() && msg->msisdn().length() <= MSISDN_LENGTH) {
+    memcpy(
+        subscription_data->msisdn, msg->msisdn().c_str(),
+        msg->msisdn().length());
+    subscription_data->msisdn_length = msg->msisdn().length();
+    itti_msg->presencemask |= S6A_IDR_MSISDN_PRESENT;
+  }
+
+  if (msg->has_total_ambr()) {
+    convert_proto_msg_to_itti_ambr(
+        msg->total_ambr(), &subscription_data->subscribed_ambr);
+    itti_msg->presencemask |= S6A_IDR_AMBR_PRESENT;
+  }
+
+  if (msg->apn_size() > 0) {
+    apn_config_profile_t* profile = &subscription_data->apn_config_profile;
+    profile->context_identifier   = msg->default_context_id();
+    profile->all_apn_conf_ind     = msg->all_apns_included() ?
+                                    ALL_APN_CONFIGURATIONS_INCLUDED :
+                                    MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED;
+    profile->nb_apns =
+        (msg->apn_size() > MAX_APN_PER_UE) ? MAX_APN_PER_UE : msg->apn_size();
+    for (uint8_t idx = 0; idx < profile->nb_apns; ++idx) {
+      convert_proto_msg_to_itti_apn_configuration(
+          msg->apn(idx), &profile->apn_configuration[idx]);
+    }
+    itti_msg->presencemask |= S6A_IDR_APN_CONFIG_PROFILE_PRESENT;
+  }
+  return;
+}
+
+// S6a Delete Subscriber Data Request
+void convert_proto_msg_to_itti_s6a_delete_subscriber_data_req(
+    const DeleteSubscriberDataRequest* msg,
+    s6a_delete_subscriber_data_req_t* itti_msg) {
+  auto imsi             = msg->user_name();
+  itti_msg->imsi_length = imsi.length();
+  strcpy(itti_msg->imsi, imsi.c_str());
+  itti_msg->dsr_flags = msg->dsr_flags();
+
+  itti_msg->nb_context_ids = (msg->context_ids_size() > MAX_APN_PER_UE) ?
+                                 MAX_APN_PER_UE :
+                                 msg->context_ids_size();
+  for (uint8_t idx = 0; idx < itti_msg->nb_context_ids; ++idx) {
+    itti_msg->context_ids[idx] = msg->context_ids(idx);
+  }
+  return;
+}
+
 }  // namespace magma
@@ -20,7 +20,9 @@
 #include <gmp.h>
 
 #include "feg/protos/csfb.grpc.pb.h"
+#include "feg/protos/s6a_proxy.grpc.pb.h"
 #include "lte/protos/sms_orc8r.grpc.pb.h"
+#include "s6a_messages_types.h"
 #include "sgs_messages_types.h"
 
 extern "C" {
@@ -29,9 +31,11 @@ extern "C" {
 namespace magma {
 namespace feg {
 class AlertRequest;
+class DeleteSubscriberDataRequest;
 class DownlinkUnitdata;
 class EPSDetachAck;
 class IMSIDetachAck;
+class InsertSubscriberDataRequest;
 class LocationUpdateAccept;
 class LocationUpdateReject;
 class MMInformationRequest;
@@ -94,4 +98,12 @@ void convert_proto_msg_to_itti_sgsap_service_abort_req(
 
 void convert_proto_msg_to_itti_sgsap_mm_information_req(
     const MMInformationRequest* msg, itti_sgsap_mm_information_req_t* itti_msg);
+
+void convert_proto_msg_to_itti_s6a_insert_subscriber_data_req(
+    const InsertSubscriberDataRequest* msg,
+    s6a_insert_subscriber_data_req_t* itti_msg);
+
+void convert_proto_msg_to_itti_s6a_delete_subscriber_data_req(
+    const DeleteSubscriberDataRequest* msg,
+    s6a_delete_subscriber_data_req_t* itti_msg);
 }  // namespace magma
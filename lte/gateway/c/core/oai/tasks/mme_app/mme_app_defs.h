@@ -77,6 +77,32 @@ int mme_app_handle_s6a_update_location_ans(
 int mme_app_handle_s6a_cancel_location_req(
     mme_app_desc_t* mme_app_desc_p, const s6a_cancel_location_req_t* clr_pP);
 
+int mme_app_handle_s6a_insert_subscriber_data_req(
+    mme_app_desc_t* mme_app_desc_p,
+    const s6a_insert_subscriber_data_req_t* idr_pP);
+
+int mme_app_handle_s6a_delete_subscriber_data_req(
+    mme_app_desc_t* mme_app_desc_p,
+    const s6a_delete_subscriber_data_req_t* dsr_pP);
+
+/*
+ * Applies the subscription data received in an IDR to the UE context. Only
+ * the parts present in the IDR are updated. APN configurations replace the
+ * stored ones if all of them are included, otherwise they're added or
+ * updated by context identifier.
+ */
+void mme_app_update_subscription_data(
+    ue_mm_context_t* ue_context_p,
+    const s6a_insert_subscriber_data_req_t* idr_pP);
+
+/*
+ * Removes the APN configurations listed in a DSR from the UE context. The
+ * default APN configuration is never removed.
+ */
+void mme_app_delete_subscription_data(
+    ue_mm_context_t* ue_context_p,
+    const s6a_delete_subscriber_data_req_t* dsr_pP);
+
 int mme_app_handle_nas_extended_service_req(
     mme_ue_s1ap_id_t ue_id, uint8_t servicetype, uint8_t csfb_response);
 
@@ -396,53 +396,13 @@ status_code_e mme_app_handle_s6a_update_location_ans(
   OAILOG_FUNC_RETURN(LOG_MME_APP, rc);
 }
 
-status_code_e mme_app_handle_s6a_cancel_location_req(
-    mme_app_desc_t* mme_app_desc_p,
-    const s6a_cancel_location_req_t* const clr_pP) {
-  uint64_t imsi                        = 0;
-  struct ue_mm_context_s* ue_context_p = NULL;
-  int cla_result                       = DIAMETER_SUCCESS;
-
+/*
+ * Detaches the UE on request of the HSS. UEs in idle state are paged first
+ * and detached once they're back in connected state.
+ */
+static status_code_e mme_app_detach_ue_for_hss(
+    ue_mm_context_t* const ue_context_p) {
   OAILOG_FUNC_IN(LOG_MME_APP);
-  if (clr_pP == NULL) {
-    OAILOG_ERROR(
-        LOG_MME_APP,
-        "Invalid S6a Cancel Location Request ITTI message received\n");
-    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
-  }
-
-  IMSI_STRING_TO_IMSI64((char*) clr_pP->imsi, &imsi);
-  OAILOG_DEBUG(
-      LOG_MME_APP, "S6a Cancel Location Request for imsi " IMSI_64_FMT "\n",
-      imsi);
-
-  if ((mme_app_send_s6a_cancel_location_ans(
-          cla_result, clr_pP->imsi, clr_pP->imsi_length, clr_pP->msg_cla_p)) !=
-      RETURNok) {
-    OAILOG_ERROR(
-        LOG_MME_APP,
-        "S6a Cancel Location Request: Failed to send Cancel Location Answer "
-        "from "
-        "MME app for imsi " IMSI_64_FMT "\n",
-        imsi);
-  }
-
-  if ((ue_context_p = mme_ue_context_exists_imsi(
-           &mme_app_desc_p->mme_ue_contexts, imsi)) == NULL) {
-    OAILOG_ERROR(
-        LOG_MME_APP,
-        "IMSI is not present in the MME context for imsi " IMSI_64_FMT "\n",
-        imsi);
-    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
-  }
-  if (clr_pP->cancellation_type != SUBSCRIPTION_WITHDRAWL) {
-    OAILOG_ERROR(
-        LOG_MME_APP,
-        "S6a Cancel Location Request: Cancellation_type not supported %d for"
-        "imsi " IMSI_64_FMT "\n",
-        clr_pP->cancellation_type, imsi);
-    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
-  }
   /*
    * set the flag: hss_initiated_detach to indicate that,
    * hss has initiated detach and MME shall not send PUR to hss
@@ -491,6 +451,56 @@ status_code_e mme_app_handle_s6a_cancel_location_req(
   OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNok);
 }
 
+status_code_e mme_app_handle_s6a_cancel_location_req(
+    mme_app_desc_t* mme_app_desc_p,
+    const s6a_cancel_location_req_t* const clr_pP) {
+  uint64_t imsi                        = 0;
+  struct ue_mm_context_s* ue_context_p = NULL;
+  int cla_result                       = DIAMETER_SUCCESS;
+
+  OAILOG_FUNC_IN(LOG_MME_APP);
+  if (clr_pP == NULL) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "Invalid S6a Cancel Location Request ITTI message received\n");
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+
+  IMSI_STRING_TO_IMSI64((char*) clr_pP->imsi, &imsi);
+  OAILOG_DEBUG(
+      LOG_MME_APP, "S6a Cancel Location Request for imsi " IMSI_64_FMT "\n",
+      imsi);
+
+  if ((mme_app_send_s6a_cancel_location_ans(
+          cla_result, clr_pP->imsi, clr_pP->imsi_length, clr_pP->msg_cla_p)) !=
+      RETURNok) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "S6a Cancel Location Request: Failed to send Cancel Location Answer "
+        "from "
+        "MME app for imsi " IMSI_64_FMT "\n",
+        imsi);
+  }
+
+  if ((ue_context_p = mme_ue_context_exists_imsi(
+           &mme_app_desc_p->mme_ue_contexts, imsi)) == NULL) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "IMSI is not present in the MME context for imsi " IMSI_64_FMT "\n",
+        imsi);
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+  if (clr_pP->cancellation_type != SUBSCRIPTION_WITHDRAWL) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "S6a Cancel Location Request: Cancellation_type not supported %d for"
+        "imsi " IMSI_64_FMT "\n",
+        clr_pP->cancellation_type, imsi);
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+  OAILOG_FUNC_RETURN(LOG_MME_APP, mme_app_detach_ue_for_hss(ue_context_p));
+}
+
 status_code_e mme_app_send_s6a_cancel_location_ans(
     int cla_result, const char* imsi, uint8_t imsi_length, void* msg_cla_p) {
   MessageDef* message_p                = NULL;
@@ -529,3 +539,165 @@ void mme_app_get_user_location_information(
   uli_t_p->s.ecgi.cell_identity.cell_id =
       ue_context_p->e_utran_cgi.cell_identity.cell_id;
 }
+
+//------------------------------------------------------------------------------
+void mme_app_update_subscription_data(
+    ue_mm_context_t* const ue_context_p,
+    const s6a_insert_subscriber_data_req_t* const idr_pP) {
+  const subscription_data_t* subscription_data = &idr_pP->subscription_data;
+
+  if (idr_pP->presencemask & S6A_IDR_SUBSCRIBER_STATUS_PRESENT) {
+    ue_context_p->subscriber_status = subscription_data->subscriber_status;
+  }
+  if (idr_pP->presencemask & S6A_IDR_NETWORK_ACCESS_MODE_PRESENT) {
+    ue_context_p->network_access_mode = subscription_data->access_mode;
+  }
+  if (idr_pP->presencemask & S6A_IDR_MSISDN_PRESENT) {
+    bdestroy_wrapper(&ue_context_p->msisdn);
+    ue_context_p->msisdn = blk2bstr(
+        subscription_data->msisdn, subscription_data->msisdn_length);
+  }
+  if (idr_pP->presencemask & S6A_IDR_AMBR_PRESENT) {
+    memcpy(
+        &ue_context_p->subscribed_ue_ambr, &subscription_data->subscribed_ambr,
+        sizeof(ambr_t));
+  }
+  if (!(idr_pP->presencemask & S6A_IDR_APN_CONFIG_PROFILE_PRESENT)) {
+    return;
+  }
+
+  const apn_config_profile_t* received = &subscription_data->apn_config_profile;
+  apn_config_profile_t* profile        = &ue_context_p->apn_config_profile;
+  if (received->all_apn_conf_ind == ALL_APN_CONFIGURATIONS_INCLUDED) {
+    memcpy(profile, received, sizeof(apn_config_profile_t));
+    return;
+  }
+  // Only added or modified APN configurations are included
+  profile->context_identifier = received->context_identifier;
+  for (uint8_t i = 0; i < received->nb_apns; i++) {
+    const apn_configuration_t* apn = &received->apn_configuration[i];
+    uint8_t j                      = 0;
+    while (j < profile->nb_apns &&
+           profile->apn_configuration[j].context_identifier !=
+               apn->context_identifier) {
+      j++;
+    }
+    if (j == MAX_APN_PER_UE) {
+      OAILOG_WARNING(
+          LOG_MME_APP,
+          "Ignoring APN configuration %u, the UE already has %d APNs\n",
+          apn->context_identifier, MAX_APN_PER_UE);
+      continue;
+    }
+    memcpy(
+        &profile->apn_configuration[j], apn, sizeof(apn_configuration_t));
+    if (j == profile->nb_apns) {
+      profile->nb_apns++;
+    }
+  }
+}
+
+//------------------------------------------------------------------------------
+void mme_app_delete_subscription_data(
+    ue_mm_context_t* const ue_context_p,
+    const s6a_delete_subscriber_data_req_t* const dsr_pP) {
+  apn_config_profile_t* profile = &ue_context_p->apn_config_profile;
+
+  for (uint8_t i = 0; i < dsr_pP->nb_context_ids; i++) {
+    context_identifier_t context_id = dsr_pP->context_ids[i];
+    if (context_id == profile->context_identifier) {
+      OAILOG_WARNING(
+          LOG_MME_APP, "Not deleting the default APN configuration %u\n",
+          context_id);
+      continue;
+    }
+    for (uint8_t j = 0; j < profile->nb_apns; j++) {
+      if (profile->apn_configuration[j].context_identifier != context_id) {
+        continue;
+      }
+      // Keep the remaining configurations contiguous
+      memmove(
+          &profile->apn_configuration[j], &profile->apn_configuration[j + 1],
+          (profile->nb_apns - j - 1) * sizeof(apn_configuration_t));
+      profile->nb_apns--;
+      memset(
+          &profile->apn_configuration[profile->nb_apns], 0,
+          sizeof(apn_configuration_t));
+      break;
+    }
+  }
+}
+
+//------------------------------------------------------------------------------
+status_code_e mme_app_handle_s6a_insert_subscriber_data_req(
+    mme_app_desc_t* mme_app_desc_p,
+    const s6a_insert_subscriber_data_req_t* const idr_pP) {
+  imsi64_t imsi64                      = INVALID_IMSI64;
+  struct ue_mm_context_s* ue_context_p = NULL;
+
+  OAILOG_FUNC_IN(LOG_MME_APP);
+  if (idr_pP == NULL) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "Invalid S6a Insert Subscriber Data Request ITTI message received\n");
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+
+  IMSI_STRING_TO_IMSI64((char*) idr_pP->imsi, &imsi64);
+  if ((ue_context_p = mme_ue_context_exists_imsi(
+           &mme_app_desc_p->mme_ue_contexts, imsi64)) == NULL) {
+    OAILOG_ERROR_UE(
+        LOG_MME_APP, imsi64,
+        "S6a Insert Subscriber Data Request for unknown UE\n");
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+  OAILOG_INFO_UE(
+      LOG_MME_APP, imsi64,
+      "Updating subscription data of ue_id " MME_UE_S1AP_ID_FMT "\n",
+      ue_context_p->mme_ue_s1ap_id);
+  mme_app_update_subscription_data(ue_context_p, idr_pP);
+
+  if (ue_context_p->subscriber_status == SS_OPERATOR_DETERMINED_BARRING &&
+      (idr_pP->presencemask & S6A_IDR_ODB_PRESENT) &&
+      (idr_pP->operator_determined_barring &
+       ODB_ALL_PACKET_ORIENTED_SERVICES_BARRED)) {
+    OAILOG_INFO_UE(
+        LOG_MME_APP, imsi64,
+        "All packet oriented services are barred, detaching "
+        "ue_id " MME_UE_S1AP_ID_FMT "\n",
+        ue_context_p->mme_ue_s1ap_id);
+    OAILOG_FUNC_RETURN(LOG_MME_APP, mme_app_detach_ue_for_hss(ue_context_p));
+  }
+  OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNok);
+}
+
+//------------------------------------------------------------------------------
+status_code_e mme_app_handle_s6a_delete_subscriber_data_req(
+    mme_app_desc_t* mme_app_desc_p,
+    const s6a_delete_subscriber_data_req_t* const dsr_pP) {
+  imsi64_t imsi64                      = INVALID_IMSI64;
+  struct ue_mm_context_s* ue_context_p = NULL;
+
+  OAILOG_FUNC_IN(LOG_MME_APP);
+  if (dsr_pP == NULL) {
+    OAILOG_ERROR(
+        LOG_MME_APP,
+        "Invalid S6a Delete Subscriber Data Request ITTI message received\n");
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+
+  IMSI_STRING_TO_IMSI64((char*) dsr_pP->imsi, &imsi64);
+  if ((ue_context_p = mme_ue_context_exists_imsi(
+           &mme_app_desc_p->mme_ue_contexts, imsi64)) == NULL) {
+    OAILOG_ERROR_UE(
+        LOG_MME_APP, imsi64,
+        "S6a Delete Subscriber Data Request for unknown UE\n");
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+  OAILOG_INFO_UE(
+      LOG_MME_APP, imsi64,
+      "Deleting %d APN configurations of ue_id " MME_UE_S1AP_ID_FMT "\n",
+      dsr_pP->nb_context_ids, ue_context_p->mme_ue_s1ap_id);
+  mme_app_delete_subscription_data(ue_context_p, dsr_pP);
+  OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNok);
+}
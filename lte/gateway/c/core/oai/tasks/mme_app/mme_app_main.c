@@ -123,6 +123,16 @@ static int handle_message(zloop_t* loop, zsock_t* reader, void* arg) {
       is_task_state_same = true;
     } break;
 
+    case S6A_INSERT_SUBSCRIBER_DATA_REQ: {
+      mme_app_handle_s6a_insert_subscriber_data_req(
+          mme_app_desc_p, &S6A_INSERT_SUBSCRIBER_DATA_REQ(received_message_p));
+    } break;
+
+    case S6A_DELETE_SUBSCRIBER_DATA_REQ: {
+      mme_app_handle_s6a_delete_subscriber_data_req(
+          mme_app_desc_p, &S6A_DELETE_SUBSCRIBER_DATA_REQ(received_message_p));
+    } break;
+
     case S11_CREATE_SESSION_RESPONSE: {
       mme_app_handle_create_sess_resp(
           mme_app_desc_p,
@@ -19,6 +19,7 @@
 
 #include "intertask_interface.h"
 #include "common_types.h"
+#include "conversions.h"
 #include "intertask_interface_types.h"
 #include "itti_types.h"
 #include "s6a_defs.h"
@@ -48,3 +49,27 @@ void handle_reset_request(void) {
   send_msg_to_task(&s6a_task_zmq_ctx, TASK_MME_APP, message_p);
   return;
 }
+
+int insert_subscriber_data_request(
+    const s6a_insert_subscriber_data_req_t* const idr) {
+  // send it to MME module for further processing
+  MessageDef* message_p = DEPRECATEDitti_alloc_new_message_fatal(
+      TASK_S6A, S6A_INSERT_SUBSCRIBER_DATA_REQ);
+  memcpy(
+      &S6A_INSERT_SUBSCRIBER_DATA_REQ(message_p), idr,
+      sizeof(s6a_insert_subscriber_data_req_t));
+  IMSI_STRING_TO_IMSI64((char*) idr->imsi, &message_p->ittiMsgHeader.imsi);
+  return send_msg_to_task(&s6a_task_zmq_ctx, TASK_MME_APP, message_p);
+}
+
+int delete_subscriber_data_request(
+    const s6a_delete_subscriber_data_req_t* const dsr) {
+  // send it to MME module for further processing
+  MessageDef* message_p = DEPRECATEDitti_alloc_new_message_fatal(
+      TASK_S6A, S6A_DELETE_SUBSCRIBER_DATA_REQ);
+  memcpy(
+      &S6A_DELETE_SUBSCRIBER_DATA_REQ(message_p), dsr,
+      sizeof(s6a_delete_subscriber_data_req_t));
+  IMSI_STRING_TO_IMSI64((char*) dsr->imsi, &message_p->ittiMsgHeader.imsi);
+  return send_msg_to_task(&s6a_task_zmq_ctx, TASK_MME_APP, message_p);
+}
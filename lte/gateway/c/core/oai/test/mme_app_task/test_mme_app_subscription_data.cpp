@@ -0,0 +1,165 @@
+/**
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+#include <string.h>
+#include <gtest/gtest.h>
+
+extern "C" {
+#include "bstrlib.h"
+#include "dynamic_memory_check.h"
+#include "mme_app_defs.h"
+#include "mme_app_ue_context.h"
+#include "s6a_messages_types.h"
+}
+
+class SubscriptionDataTest : public ::testing::Test {
+ protected:
+  virtual void SetUp() {
+    memset(&ue_context, 0, sizeof(ue_context));
+    ue_context.msisdn                  = bfromcstr("1234");
+    ue_context.subscribed_ue_ambr.br_ul = 100;
+    ue_context.subscribed_ue_ambr.br_dl = 200;
+
+    apn_config_profile_t* profile = &ue_context.apn_config_profile;
+    profile->context_identifier   = 1;
+    profile->nb_apns              = 2;
+    set_apn(&profile->apn_configuration[0], 1, "internet", 1000);
+    set_apn(&profile->apn_configuration[1], 2, "ims", 2000);
+  }
+
+  virtual void TearDown() { bdestroy_wrapper(&ue_context.msisdn); }
+
+  void set_apn(
+      apn_configuration_t* apn, context_identifier_t context_id,
+      const char* name, bitrate_t br_dl) {
+    memset(apn, 0, sizeof(apn_configuration_t));
+    apn->context_identifier       = context_id;
+    apn->service_selection_length = strlen(name);
+    memcpy(apn->service_selection, name, apn->service_selection_length);
+    apn->ambr.br_dl = br_dl;
+  }
+
+  const apn_configuration_t* get_apn(uint8_t idx) {
+    return &ue_context.apn_config_profile.apn_configuration[idx];
+  }
+
+  ue_mm_context_t ue_context;
+};
+
+TEST_F(SubscriptionDataTest, TestUpdateOnlyPresentData) {
+  s6a_insert_subscriber_data_req_t idr = {0};
+  idr.presencemask                     = S6A_IDR_AMBR_PRESENT;
+  idr.subscription_data.subscribed_ambr.br_ul = 300;
+  idr.subscription_data.subscribed_ambr.br_dl = 400;
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  EXPECT_EQ(ue_context.subscribed_ue_ambr.br_ul, 300);
+  EXPECT_EQ(ue_context.subscribed_ue_ambr.br_dl, 400);
+  EXPECT_EQ(ue_context.subscriber_status, SS_SERVICE_GRANTED);
+  // MSISDN and APNs weren't part of the IDR
+  EXPECT_EQ(biseqcstr(ue_context.msisdn, "1234"), 1);
+  EXPECT_EQ(ue_context.apn_config_profile.nb_apns, 2);
+
+  idr = {0};
+  idr.presencemask =
+      S6A_IDR_MSISDN_PRESENT | S6A_IDR_SUBSCRIBER_STATUS_PRESENT;
+  idr.subscription_data.subscriber_status = SS_OPERATOR_DETERMINED_BARRING;
+  memcpy(idr.subscription_data.msisdn, "5678", 4);
+  idr.subscription_data.msisdn_length = 4;
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  EXPECT_EQ(biseqcstr(ue_context.msisdn, "5678"), 1);
+  EXPECT_EQ(ue_context.subscriber_status, SS_OPERATOR_DETERMINED_BARRING);
+  EXPECT_EQ(ue_context.subscribed_ue_ambr.br_ul, 300);
+}
+
+TEST_F(SubscriptionDataTest, TestApnOnlyUpdateKeepsBarring) {
+  ue_context.subscriber_status   = SS_OPERATOR_DETERMINED_BARRING;
+  ue_context.network_access_mode = NAM_ONLY_PACKET;
+
+  // The subscriber status and network access mode default to 0 when they
+  // aren't part of the IDR
+  s6a_insert_subscriber_data_req_t idr = {0};
+  idr.presencemask = S6A_IDR_APN_CONFIG_PROFILE_PRESENT;
+  apn_config_profile_t* received = &idr.subscription_data.apn_config_profile;
+  received->context_identifier   = 1;
+  received->all_apn_conf_ind     = MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED;
+  received->nb_apns              = 1;
+  set_apn(&received->apn_configuration[0], 2, "ims", 3000);
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  EXPECT_EQ(ue_context.subscriber_status, SS_OPERATOR_DETERMINED_BARRING);
+  EXPECT_EQ(ue_context.network_access_mode, NAM_ONLY_PACKET);
+  EXPECT_EQ(get_apn(1)->ambr.br_dl, 3000);
+
+  // Barring is only lifted by an explicit subscriber status
+  idr              = {0};
+  idr.presencemask = S6A_IDR_SUBSCRIBER_STATUS_PRESENT |
+                     S6A_IDR_NETWORK_ACCESS_MODE_PRESENT;
+  idr.subscription_data.subscriber_status = SS_SERVICE_GRANTED;
+  idr.subscription_data.access_mode       = NAM_PACKET_AND_CIRCUIT;
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  EXPECT_EQ(ue_context.subscriber_status, SS_SERVICE_GRANTED);
+  EXPECT_EQ(ue_context.network_access_mode, NAM_PACKET_AND_CIRCUIT);
+}
+
+TEST_F(SubscriptionDataTest, TestUpdateModifiedApns) {
+  s6a_insert_subscriber_data_req_t idr = {0};
+  idr.presencemask = S6A_IDR_APN_CONFIG_PROFILE_PRESENT;
+  apn_config_profile_t* received = &idr.subscription_data.apn_config_profile;
+  received->context_identifier   = 1;
+  received->all_apn_conf_ind     = MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED;
+  received->nb_apns              = 2;
+  set_apn(&received->apn_configuration[0], 2, "ims", 3000);
+  set_apn(&received->apn_configuration[1], 3, "oai.ipv4", 4000);
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  // APN 2 is updated in place and APN 3 is added
+  ASSERT_EQ(ue_context.apn_config_profile.nb_apns, 3);
+  EXPECT_EQ(get_apn(0)->context_identifier, 1);
+  EXPECT_EQ(get_apn(0)->ambr.br_dl, 1000);
+  EXPECT_EQ(get_apn(1)->context_identifier, 2);
+  EXPECT_EQ(get_apn(1)->ambr.br_dl, 3000);
+  EXPECT_EQ(get_apn(2)->context_identifier, 3);
+  EXPECT_EQ(get_apn(2)->ambr.br_dl, 4000);
+  EXPECT_EQ(strncmp(get_apn(2)->service_selection, "oai.ipv4", 8), 0);
+}
+
+TEST_F(SubscriptionDataTest, TestReplaceAllApns) {
+  s6a_insert_subscriber_data_req_t idr = {0};
+  idr.presencemask = S6A_IDR_APN_CONFIG_PROFILE_PRESENT;
+  apn_config_profile_t* received = &idr.subscription_data.apn_config_profile;
+  received->context_identifier   = 3;
+  received->all_apn_conf_ind     = ALL_APN_CONFIGURATIONS_INCLUDED;
+  received->nb_apns              = 1;
+  set_apn(&received->apn_configuration[0], 3, "oai.ipv4", 4000);
+
+  mme_app_update_subscription_data(&ue_context, &idr);
+  ASSERT_EQ(ue_context.apn_config_profile.nb_apns, 1);
+  EXPECT_EQ(ue_context.apn_config_profile.context_identifier, 3);
+  EXPECT_EQ(get_apn(0)->context_identifier, 3);
+}
+
+TEST_F(SubscriptionDataTest, TestDeleteApns) {
+  s6a_delete_subscriber_data_req_t dsr = {0};
+  dsr.nb_context_ids                   = 3;
+  // The default APN is kept and unknown APNs are ignored
+  dsr.context_ids[0] = 1;
+  dsr.context_ids[1] = 2;
+  dsr.context_ids[2] = 5;
+
+  mme_app_delete_subscription_data(&ue_context, &dsr);
+  ASSERT_EQ(ue_context.apn_config_profile.nb_apns, 1);
+  EXPECT_EQ(get_apn(0)->context_identifier, 1);
+  EXPECT_EQ(get_apn(1)->context_identifier, 0);
+}
@@ -1061,6 +1061,8 @@ bool LocalEnforcer::update_tunnel_ids(
   if (revalidation_required(csr.event_triggers())) {
     schedule_revalidation(*session, csr.revalidation_time(), nullptr);
   }
+  provision_resource_allocation_trigger(
+      *session, csr.event_triggers(), nullptr);
 
   // handle transient errors during first init
   auto session_id = session->get_session_id();
@@ -1480,6 +1482,8 @@ void LocalEnforcer::update_monitoring_credits_and_rules(
       sessions_with_revalidation.insert(imsi_and_session_id);
       schedule_revalidation(*session, revalidation_time, &uc);
     }
+    provision_resource_allocation_trigger(
+        *session, usage_monitor_resp.event_triggers(), &uc);
 
     if (config.common_context.rat_type() == TGPP_LTE) {
       const BearerUpdate update = session->get_dedicated_bearer_updates(
@@ -1695,6 +1699,8 @@ void LocalEnforcer::init_policy_reauth_for_session(
   if (revalidation_required(request.event_triggers())) {
     schedule_revalidation(*session, request.revalidation_time(), &uc);
   }
+  provision_resource_allocation_trigger(
+      *session, request.event_triggers(), &uc);
 
   session->process_rules_to_remove(
       request.rules_to_remove(), &pending_deactivation, &uc);
@@ -1855,6 +1861,22 @@ void LocalEnforcer::schedule_revalidation(
       delta.count());
 }  // namespace magma
 
+void LocalEnforcer::provision_resource_allocation_trigger(
+    SessionState& session,
+    const google::protobuf::RepeatedField<int>& event_triggers,
+    SessionStateUpdateCriteria* uc) {
+  auto it = std::find(
+      event_triggers.begin(), event_triggers.end(),
+      SUCCESSFUL_RESOURCE_ALLOCATION);
+  if (it == event_triggers.end()) {
+    return;
+  }
+  auto triggers = session.get_event_triggers();
+  if (triggers.find(SUCCESSFUL_RESOURCE_ALLOCATION) == triggers.end()) {
+    session.add_new_event_trigger(SUCCESSFUL_RESOURCE_ALLOCATION, uc);
+  }
+}
+
 void LocalEnforcer::handle_activate_ue_flows_callback(
     const std::string& imsi, const std::string& ip_addr,
     const std::string& ipv6_addr, const Teids teids, Status status,
@@ -2045,6 +2067,12 @@ bool LocalEnforcer::bind_policy_to_bearer(
 
   session->bind_policy_to_bearer(request, &uc);
   install_rule_after_bearer_creation(*session, request);
+  // Report the dedicated bearer's setup on the next update if the PCRF
+  // provisioned the event trigger, as it does for the rules of Rx AF sessions
+  auto triggers = session->get_event_triggers();
+  if (triggers.find(SUCCESSFUL_RESOURCE_ALLOCATION) != triggers.end()) {
+    session->set_event_trigger(SUCCESSFUL_RESOURCE_ALLOCATION, READY, &uc);
+  }
   return true;
 }
 
@@ -544,6 +544,15 @@ class LocalEnforcer {
       const google::protobuf::Timestamp& revalidation_time,
       SessionStateUpdateCriteria* session_uc);
 
+  /**
+   * Keep the SUCCESSFUL_RESOURCE_ALLOCATION event trigger pending if it's one
+   * of the event triggers, so dedicated bearer setups are reported to the PCRF
+   */
+  void provision_resource_allocation_trigger(
+      SessionState& session,
+      const google::protobuf::RepeatedField<int>& event_triggers,
+      SessionStateUpdateCriteria* session_uc);
+
   void handle_add_ue_mac_flow_callback(
       const SubscriberID& sid, const std::string& ue_mac_addr,
       const std::string& msisdn, const std::string& ap_mac_addr,
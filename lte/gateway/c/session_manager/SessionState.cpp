@@ -2417,21 +2417,33 @@ void SessionState::get_event_trigger_updates(
     UpdateSessionRequest* update_request_out,
     SessionStateUpdateCriteria* session_uc) {
   // todo We should also handle other event triggers here too
-  auto it = pending_event_triggers_.find(REVALIDATION_TIMEOUT);
-  if (it != pending_event_triggers_.end() && it->second == READY) {
+  // Bearer events are reported so that the AFs bound to the session over Rx
+  // are notified of them
+  for (const auto trigger :
+       {REVALIDATION_TIMEOUT, SUCCESSFUL_RESOURCE_ALLOCATION}) {
+    auto it = pending_event_triggers_.find(trigger);
+    if (it == pending_event_triggers_.end() || it->second != READY) {
+      continue;
+    }
     MLOG(MDEBUG) << "Session " << session_id_
-                 << " updating due to EventTrigger: REVALIDATION_TIMEOUT"
-                 << " with request number " << request_number_;
+                 << " updating due to EventTrigger: "
+                 << EventTrigger_Name(trigger) << " with request number "
+                 << request_number_;
     auto new_req = update_request_out->mutable_usage_monitors()->Add();
     add_common_fields_to_usage_monitor_update(new_req);
-    new_req->set_event_trigger(REVALIDATION_TIMEOUT);
+    new_req->set_event_trigger(trigger);
     request_number_++;
     if (session_uc) {
       session_uc->request_number_increment++;
     }
+    if (trigger == SUCCESSFUL_RESOURCE_ALLOCATION) {
+      // The event trigger stays provisioned for the next dedicated bearers
+      set_event_trigger(trigger, PENDING, session_uc);
+      continue;
+    }
     // todo we might want to make sure that the update went successfully
     // before clearing here
-    remove_event_trigger(REVALIDATION_TIMEOUT, session_uc);
+    remove_event_trigger(trigger, session_uc);
   }
 }
 
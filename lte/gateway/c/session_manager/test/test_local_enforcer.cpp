@@ -2350,6 +2350,11 @@ TEST_F(LocalEnforcerTest, test_dedicated_bearer_lifecycle) {
 
   std::vector<Teids> existing_teids = session_map[IMSI1][0]->get_active_teids();
   EXPECT_EQ(3, existing_teids.size());  // default bearer + 2 dedicated bearers
+  // The PCRF didn't provision the successful resource allocation event trigger
+  EXPECT_EQ(
+      update[IMSI1][SESSION_ID_1].pending_event_triggers.count(
+          SUCCESSFUL_RESOURCE_ALLOCATION),
+      0);
 
   // Test unsuccessful creation of dedicated bearer for rule3 (bearer_id = 0)
   PolicyBearerBindingRequest bearer_bind_req_fail =
@@ -2563,6 +2568,68 @@ TEST_F(LocalEnforcerTest, test_rar_session_not_found) {
   EXPECT_EQ(raa.result(), ReAuthResult::SESSION_NOT_FOUND);
 }
 
+TEST_F(LocalEnforcerTest, test_resource_allocation_reported_when_provisioned) {
+  insert_static_rule_with_qos(0, "m1", "rule1", 1);  // QCI=1
+
+  test_cfg_.common_context.mutable_sid()->set_id(IMSI1);
+  test_cfg_.common_context.set_apn("apn1");
+  auto lte_context = test_cfg_.rat_specific_context.mutable_lte_context();
+  lte_context->mutable_qos_info()->set_qos_class_id(5);
+  lte_context->set_bearer_id(BEARER_ID_1);
+
+  // The PCRF provisions the successful resource allocation event trigger, as
+  // it does with the rules of Rx AF sessions
+  CreateSessionResponse response;
+  response.mutable_static_rules()->Add()->set_rule_id("rule1");
+  response.add_event_triggers(EventTrigger::SUCCESSFUL_RESOURCE_ALLOCATION);
+
+  EXPECT_CALL(
+      *spgw_client, create_dedicated_bearer(CheckCreateBearerReq(IMSI1, 1)))
+      .Times(1)
+      .WillOnce(testing::Return(true));
+  local_enforcer->init_session(
+      session_map, IMSI1, SESSION_ID_1, test_cfg_, response);
+  local_enforcer->update_tunnel_ids(
+      session_map,
+      create_update_tunnel_ids_request(IMSI1, BEARER_ID_1, teids1));
+  bool success =
+      session_store->create_sessions(IMSI1, std::move(session_map[IMSI1]));
+  EXPECT_TRUE(success);
+  session_map = session_store->read_sessions({IMSI1});
+  auto update = SessionStore::get_default_session_update(session_map);
+  evb->loopOnce();
+
+  // The event trigger is only ready to be reported once the bearer is bound
+  std::vector<std::unique_ptr<ServiceAction>> actions;
+  auto updates = local_enforcer->collect_updates(session_map, actions, update);
+  EXPECT_EQ(updates.usage_monitors_size(), 0);
+
+  local_enforcer->bind_policy_to_bearer(
+      session_map,
+      create_policy_bearer_bind_req(
+          IMSI1, BEARER_ID_1, "rule1", BEARER_ID_2, 1, 2),
+      update);
+  EXPECT_EQ(
+      update[IMSI1][SESSION_ID_1]
+          .pending_event_triggers[SUCCESSFUL_RESOURCE_ALLOCATION],
+      READY);
+  success = session_store->update_sessions(update);
+  EXPECT_TRUE(success);
+
+  session_map = session_store->read_sessions({IMSI1});
+  update      = SessionStore::get_default_session_update(session_map);
+  updates     = local_enforcer->collect_updates(session_map, actions, update);
+  EXPECT_EQ(updates.usage_monitors_size(), 1);
+  EXPECT_EQ(
+      updates.usage_monitors(0).event_trigger(),
+      EventTrigger::SUCCESSFUL_RESOURCE_ALLOCATION);
+  // The event trigger stays provisioned for the next dedicated bearers
+  EXPECT_EQ(
+      update[IMSI1][SESSION_ID_1]
+          .pending_event_triggers[SUCCESSFUL_RESOURCE_ALLOCATION],
+      PENDING);
+}
+
 TEST_F(LocalEnforcerTest, test_revalidation_timer_on_init) {
   const std::string mkey = "m1";
   insert_static_rule(1, mkey, "rule1");
@@ -0,0 +1,439 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blobstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// changesTableSuffix is appended to a blobstore's table name to get the
+	// name of its change feed table
+	changesTableSuffix = "_changes"
+	// changeSeqTableSuffix is appended to a blobstore's table name to get the
+	// name of the table holding its change feed's sequence counter
+	changeSeqTableSuffix = "_change_seq"
+
+	seqCol       = "seq"
+	opCol        = "op"
+	changedAtCol = "changed_at"
+	counterIDCol = "id"
+	prunedSeqCol = "pruned_seq"
+
+	// counterID is the ID of the only row of the sequence counter table
+	counterID = 0
+
+	// DefaultChangeFeedPollInterval is the interval at which watchers check
+	// for changes committed by other processes. Changes committed through
+	// the same factory are delivered without waiting for the next poll.
+	DefaultChangeFeedPollInterval = time.Second
+	// DefaultChangeFeedRetention is the default period for which changes are
+	// kept in change feeds
+	DefaultChangeFeedRetention = 24 * time.Hour
+	// watchBatchSize limits the number of changes read at once by watchers
+	watchBatchSize = 100
+)
+
+// ErrChangesPruned indicates changes after the requested sequence number
+// have been pruned from the change feed, so a watcher resuming from it would
+// miss changes. The watcher should reload its view of the blobstore and
+// resume from the latest change.
+var ErrChangesPruned = errors.New("changes after the requested sequence number have been pruned")
+
+// ChangeOp is the kind of change made to a blob.
+type ChangeOp string
+
+const (
+	ChangeOpCreate ChangeOp = "create"
+	ChangeOpUpdate ChangeOp = "update"
+	ChangeOpDelete ChangeOp = "delete"
+)
+
+// Change is a change made to a blob, as recorded in a change feed.
+type Change struct {
+	// Seq is the position of the change in the change feed. Changes are
+	// numbered consecutively, in the order their transactions committed.
+	Seq       uint64
+	NetworkID string
+	Type      string
+	Key       string
+	Op        ChangeOp
+	// Version is the version of the blob after the change, or before it was
+	// deleted.
+	Version uint64
+	// ChangedAt is the time the change was made
+	ChangedAt time.Time
+}
+
+// WatchableBlobStorageFactory is a BlobStorageFactory which records a feed
+// of all changes made to blobs through its transactions, in the same
+// transaction as the change.
+//
+// Recording a change locks the change feed until the transaction completes,
+// so transactions which change blobs are serialized.
+type WatchableBlobStorageFactory interface {
+	BlobStorageFactory
+
+	// GetLatestChangeSeq returns the sequence number of the latest change,
+	// or 0 if there are none. Watching from it returns only subsequent
+	// changes.
+	GetLatestChangeSeq() (uint64, error)
+
+	// ListChanges returns up to limit changes matching the filter, after the
+	// change with sequence number afterSeq, in order.
+	// Returns ErrChangesPruned if changes after afterSeq were pruned.
+	ListChanges(filter SearchFilter, afterSeq uint64, limit uint64) ([]Change, error)
+
+	// Watch calls handler with each change matching the filter after the
+	// change with sequence number afterSeq, in order, as they are committed.
+	// Blocks until ctx is done, reading changes fails, or handler returns an
+	// error, and returns that error.
+	Watch(ctx context.Context, filter SearchFilter, afterSeq uint64, handler func(Change) error) error
+
+	// PruneChanges deletes the changes made before the given time.
+	PruneChanges(before time.Time) error
+}
+
+// RunChangeFeedPruner prunes the changes made longer than the retention
+// period ago from the factory's change feed, every interval. It never
+// returns.
+// Watchers resuming from a pruned change get ErrChangesPruned.
+func RunChangeFeedPruner(fact WatchableBlobStorageFactory, retention time.Duration, interval time.Duration) {
+	for range time.Tick(interval) {
+		err := fact.PruneChanges(clock.Now().Add(-retention))
+		if err != nil {
+			glog.Errorf("Error pruning blob change feed: %v", err)
+		}
+	}
+}
+
+// changeFeed records and reads the change feed of a blobstore table, for
+// both the SQL and ent backends.
+type changeFeed struct {
+	changesTable string
+	seqTable     string
+	db           *sql.DB
+	builder      sqorc.StatementBuilder
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// changed is closed and replaced whenever a transaction which recorded
+	// changes commits, to wake up local watchers
+	changed chan struct{}
+}
+
+func newChangeFeed(tableName string, db *sql.DB, builder sqorc.StatementBuilder) *changeFeed {
+	return &changeFeed{
+		changesTable: tableName + changesTableSuffix,
+		seqTable:     tableName + changeSeqTableSuffix,
+		db:           db,
+		builder:      builder,
+		pollInterval: DefaultChangeFeedPollInterval,
+		changed:      make(chan struct{}),
+	}
+}
+
+func (f *changeFeed) initTables(tx *sql.Tx) error {
+	_, err := f.builder.CreateTable(f.changesTable).
+		IfNotExists().
+		Column(seqCol).Type(sqorc.ColumnTypeBigInt).NotNull().PrimaryKey().EndColumn().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(typeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(keyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(opCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(verCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(changedAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create change feed table")
+	}
+
+	_, err = f.builder.CreateTable(f.seqTable).
+		IfNotExists().
+		Column(counterIDCol).Type(sqorc.ColumnTypeInt).NotNull().PrimaryKey().EndColumn().
+		Column(seqCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(prunedSeqCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create change feed sequence table")
+	}
+
+	_, err = f.builder.Insert(f.seqTable).
+		Columns(counterIDCol, seqCol, prunedSeqCol).
+		Values(counterID, 0, 0).
+		OnConflict(nil, counterIDCol).
+		RunWith(tx).
+		Exec()
+	return errors.Wrap(err, "failed to initialize change feed sequence")
+}
+
+// record appends changes to the change feed within the transaction of the
+// runner. All changes must be for blobs of the passed network.
+func (f *changeFeed) record(runner sq.BaseRunner, networkID string, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Incrementing the counter locks its row until the transaction
+	// completes, so sequence numbers are assigned in commit order
+	_, err := f.builder.Update(f.seqTable).
+		Set(seqCol, sq.Expr(fmt.Sprintf("%s + ?", seqCol), len(changes))).
+		Where(sq.Eq{counterIDCol: counterID}).
+		RunWith(runner).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to increment change feed sequence")
+	}
+	lastSeq, _, err := f.getCounter(runner)
+	if err != nil {
+		return err
+	}
+
+	firstSeq := lastSeq - uint64(len(changes)) + 1
+	changedAt := clock.Now().UnixNano() / int64(time.Millisecond)
+	insert := f.builder.Insert(f.changesTable).
+		Columns(seqCol, nidCol, typeCol, keyCol, opCol, verCol, changedAtCol)
+	for i, change := range changes {
+		insert = insert.Values(firstSeq+uint64(i), networkID, change.Type, change.Key, string(change.Op), change.Version, changedAt)
+	}
+	_, err = insert.RunWith(runner).Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to record changes")
+	}
+	return nil
+}
+
+// getCounter returns the latest and the latest pruned sequence numbers.
+func (f *changeFeed) getCounter(runner sq.BaseRunner) (uint64, uint64, error) {
+	rows, err := f.builder.Select(seqCol, prunedSeqCol).
+		From(f.seqTable).
+		Where(sq.Eq{counterIDCol: counterID}).
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to read change feed sequence")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "getCounter")
+
+	if !rows.Next() {
+		return 0, 0, errors.New("change feed sequence is not initialized")
+	}
+	var seq, prunedSeq uint64
+	err = rows.Scan(&seq, &prunedSeq)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to scan change feed sequence")
+	}
+	return seq, prunedSeq, nil
+}
+
+// notifyCommitted wakes up local watchers after a transaction which
+// recorded changes commits.
+func (f *changeFeed) notifyCommitted() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *changeFeed) getChangedCh() <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.changed
+}
+
+func (f *changeFeed) GetLatestChangeSeq() (uint64, error) {
+	seq, _, err := f.getCounter(f.db)
+	return seq, err
+}
+
+func (f *changeFeed) ListChanges(filter SearchFilter, afterSeq uint64, limit uint64) ([]Change, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, prunedSeq, err := f.getCounter(tx)
+		if err != nil {
+			return nil, err
+		}
+		if afterSeq < prunedSeq {
+			return nil, ErrChangesPruned
+		}
+
+		rows, err := f.builder.Select(seqCol, nidCol, typeCol, keyCol, opCol, verCol, changedAtCol).
+			From(f.changesTable).
+			Where(sq.And{sq.Gt{seqCol: afterSeq}, getChangeFilter(filter)}).
+			OrderBy(seqCol).
+			Limit(limit).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query changes")
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "ListChanges")
+
+		var changes []Change
+		for rows.Next() {
+			var change Change
+			var op string
+			var changedAtMs int64
+			err = rows.Scan(&change.Seq, &change.NetworkID, &change.Type, &change.Key, &op, &change.Version, &changedAtMs)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to scan change row")
+			}
+			change.Op = ChangeOp(op)
+			change.ChangedAt = time.Unix(0, changedAtMs*int64(time.Millisecond))
+			changes = append(changes, change)
+		}
+		err = rows.Err()
+		if err != nil {
+			return nil, errors.Wrap(err, "sql rows err")
+		}
+		return changes, nil
+	}
+	ret, err := sqorc.ExecInTx(f.db, &sql.TxOptions{ReadOnly: true}, nil, txFn)
+	if err != nil {
+		return nil, err
+	}
+	return ret.([]Change), nil
+}
+
+func (f *changeFeed) Watch(ctx context.Context, filter SearchFilter, afterSeq uint64, handler func(Change) error) error {
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+	for {
+		// Get the notification channel before reading, so that changes
+		// committed while reading aren't missed
+		changed := f.getChangedCh()
+		changes, err := f.ListChanges(filter, afterSeq, watchBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			err = handler(change)
+			if err != nil {
+				return err
+			}
+			afterSeq = change.Seq
+		}
+
+		// Catch up without waiting if the batch was full
+		if len(changes) == watchBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *changeFeed) PruneChanges(before time.Time) error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		beforeMs := before.UnixNano() / int64(time.Millisecond)
+		rows, err := f.builder.Select(fmt.Sprintf("MAX(%s)", seqCol)).
+			From(f.changesTable).
+			Where(sq.Lt{changedAtCol: beforeMs}).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query changes to prune")
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "PruneChanges")
+		var maxSeq sql.NullInt64
+		if rows.Next() {
+			err = rows.Scan(&maxSeq)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to scan changes to prune")
+			}
+		}
+		if !maxSeq.Valid {
+			return nil, nil
+		}
+
+		// Changes are pruned by sequence number, so the feed never has gaps
+		_, err = f.builder.Delete(f.changesTable).
+			Where(sq.LtOrEq{seqCol: maxSeq.Int64}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to prune changes")
+		}
+		_, err = f.builder.Update(f.seqTable).
+			Set(prunedSeqCol, maxSeq.Int64).
+			Where(sq.Eq{counterIDCol: counterID}).
+			RunWith(tx).
+			Exec()
+		return nil, errors.Wrap(err, "failed to update pruned change sequence")
+	}
+	_, err := sqorc.ExecInTx(f.db, nil, nil, txFn)
+	return err
+}
+
+func getChangeFilter(filter SearchFilter) sq.And {
+	where := sq.And{}
+	if filter.NetworkID != nil {
+		where = append(where, sq.Eq{nidCol: *filter.NetworkID})
+	}
+	if !funk.IsEmpty(filter.Types) {
+		where = append(where, sq.Eq{typeCol: filter.GetTypes()})
+	}
+	// Apply only one of prefix or match predicates; prefix takes precedence
+	if !funk.IsEmpty(filter.KeyPrefix) {
+		where = append(where, sq.Like{keyCol: fmt.Sprintf("%s%%", *filter.KeyPrefix)})
+	} else if !funk.IsEmpty(filter.Keys) {
+		where = append(where, sq.Eq{keyCol: filter.GetKeys()})
+	}
+	return where
+}
+
+// getWriteChanges returns the changes made by writing blobs, given the
+// blobs which previously existed.
+func getWriteChanges(blobs Blobs, existingBlobs Blobs) []Change {
+	existingByTK := existingBlobs.ByTK()
+	changes := make([]Change, 0, len(blobs))
+	for _, blob := range blobs {
+		change := Change{Type: blob.Type, Key: blob.Key, Op: ChangeOpCreate, Version: blob.Version}
+		if existing, ok := existingByTK[storage.TypeAndKey{Type: blob.Type, Key: blob.Key}]; ok {
+			change.Op = ChangeOpUpdate
+			if blob.Version == 0 {
+				change.Version = existing.Version + 1
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// getDeleteChanges returns the changes made by deleting existing blobs.
+func getDeleteChanges(existingBlobs Blobs) []Change {
+	changes := make([]Change, 0, len(existingBlobs))
+	for _, blob := range existingBlobs {
+		changes = append(changes, Change{Type: blob.Type, Key: blob.Key, Op: ChangeOpDelete, Version: blob.Version})
+	}
+	return changes
+}
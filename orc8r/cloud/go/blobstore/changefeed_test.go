@@ -0,0 +1,202 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blobstore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlBlobStorage_ChangeFeed(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactoryWithChangeFeed("network_table", db, sqorc.GetSqlBuilder())
+	changeFeedIntegration(t, fact)
+}
+
+func TestSqlBlobStorage_ChangeFeedIntegration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactoryWithChangeFeed("network_table", db, sqorc.GetSqlBuilder())
+	integration(t, fact)
+}
+
+func TestEntStorage_ChangeFeed(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorageWithChangeFeed("states", db, sqorc.GetSqlBuilder())
+	changeFeedIntegration(t, fact)
+}
+
+func TestEntStorage_ChangeFeedIntegration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorageWithChangeFeed("states", db, sqorc.GetSqlBuilder())
+	integration(t, fact)
+}
+
+func changeFeedIntegration(t *testing.T, fact blobstore.WatchableBlobStorageFactory) {
+	t0 := time.Unix(1000, 0)
+	clock.SetAndFreezeClock(t, t0)
+	defer clock.UnfreezeClock(t)
+
+	require.NoError(t, fact.InitializeFactory())
+	// Initialization is idempotent
+	require.NoError(t, fact.InitializeFactory())
+
+	seq, err := fact.GetLatestChangeSeq()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), seq)
+
+	// Start watching before any changes
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watched := make(chan blobstore.Change, 100)
+	watchDone := make(chan error)
+	filter := blobstore.CreateSearchFilter(strPtr("n1"), []string{"t1"}, nil, strPtr("k"))
+	go func() {
+		watchDone <- fact.Watch(ctx, filter, 0, func(change blobstore.Change) error {
+			watched <- change
+			return nil
+		})
+	}()
+
+	// Creates and updates
+	store, err := fact.StartTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{
+		{Type: "t1", Key: "k1", Value: []byte("v1")},
+		{Type: "t2", Key: "k1", Value: []byte("v1")},
+	})
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n2", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v1"), Version: 5}})
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	// Rolled back changes aren't recorded
+	store, err = fact.StartTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k2", Value: []byte("v1")}})
+	require.NoError(t, err)
+	require.NoError(t, store.Rollback())
+
+	store, err = fact.StartTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{
+		{Type: "t1", Key: "k1", Value: []byte("v2")},
+		{Type: "t1", Key: "other", Value: []byte("v1")},
+	})
+	require.NoError(t, err)
+	err = store.IncrementVersion("n1", storage.TypeAndKey{Type: "t1", Key: "k1"})
+	require.NoError(t, err)
+	err = store.IncrementVersion("n1", storage.TypeAndKey{Type: "t1", Key: "k3"})
+	require.NoError(t, err)
+	// Deleting blobs which don't exist isn't a change
+	err = store.Delete("n1", []storage.TypeAndKey{{Type: "t1", Key: "k1"}, {Type: "t1", Key: "k4"}})
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	all, err := fact.ListChanges(blobstore.SearchFilter{}, 0, 100)
+	assert.NoError(t, err)
+	expected := []blobstore.Change{
+		{Seq: 1, NetworkID: "n1", Type: "t1", Key: "k1", Op: blobstore.ChangeOpCreate, Version: 0},
+		{Seq: 2, NetworkID: "n1", Type: "t2", Key: "k1", Op: blobstore.ChangeOpCreate, Version: 0},
+		{Seq: 3, NetworkID: "n2", Type: "t1", Key: "k1", Op: blobstore.ChangeOpCreate, Version: 5},
+		{Seq: 4, NetworkID: "n1", Type: "t1", Key: "k1", Op: blobstore.ChangeOpUpdate, Version: 1},
+		{Seq: 5, NetworkID: "n1", Type: "t1", Key: "other", Op: blobstore.ChangeOpCreate, Version: 0},
+		{Seq: 6, NetworkID: "n1", Type: "t1", Key: "k1", Op: blobstore.ChangeOpUpdate, Version: 2},
+		{Seq: 7, NetworkID: "n1", Type: "t1", Key: "k3", Op: blobstore.ChangeOpCreate, Version: 1},
+		{Seq: 8, NetworkID: "n1", Type: "t1", Key: "k1", Op: blobstore.ChangeOpDelete, Version: 2},
+	}
+	for i := range all {
+		assert.Equal(t, t0.Unix(), all[i].ChangedAt.Unix())
+		all[i].ChangedAt = time.Time{}
+	}
+	assert.Equal(t, expected, all)
+
+	seq, err = fact.GetLatestChangeSeq()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(8), seq)
+
+	// Filtered and paginated
+	changes, err := fact.ListChanges(filter, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{4, 6}, getSeqs(changes))
+	changes, err = fact.ListChanges(blobstore.CreateSearchFilter(nil, nil, []string{"k1"}, nil), 3, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{4, 6, 8}, getSeqs(changes))
+
+	// The watcher received the matching changes in order
+	var watchedSeqs []uint64
+	for len(watchedSeqs) < 5 {
+		select {
+		case change := <-watched:
+			watchedSeqs = append(watchedSeqs, change.Seq)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for changes, got %v", watchedSeqs)
+		}
+	}
+	assert.Equal(t, []uint64{1, 4, 6, 7, 8}, watchedSeqs)
+	cancel()
+	assert.Equal(t, context.Canceled, <-watchDone)
+
+	// Watch ends with the handler's error
+	handlerErr := errors.New("handler error")
+	err = fact.Watch(context.Background(), blobstore.SearchFilter{}, 6, func(change blobstore.Change) error {
+		assert.Equal(t, uint64(7), change.Seq)
+		return handlerErr
+	})
+	assert.Equal(t, handlerErr, err)
+
+	// Pruning
+	clock.SetAndFreezeClock(t, t0.Add(time.Hour))
+	store, err = fact.StartTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v3")}})
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	require.NoError(t, fact.PruneChanges(t0.Add(time.Minute)))
+	changes, err = fact.ListChanges(blobstore.SearchFilter{}, 8, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{9}, getSeqs(changes))
+	_, err = fact.ListChanges(blobstore.SearchFilter{}, 7, 100)
+	assert.Equal(t, blobstore.ErrChangesPruned, err)
+	err = fact.Watch(context.Background(), blobstore.SearchFilter{}, 0, func(change blobstore.Change) error { return nil })
+	assert.Equal(t, blobstore.ErrChangesPruned, err)
+
+	// Pruning nothing is a no-op
+	require.NoError(t, fact.PruneChanges(t0))
+	seq, err = fact.GetLatestChangeSeq()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), seq)
+}
+
+func getSeqs(changes []blobstore.Change) []uint64 {
+	var ret []uint64
+	for _, change := range changes {
+		ret = append(ret, change.Seq)
+	}
+	return ret
+}
@@ -18,6 +18,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"time"
 
 	"magma/orc8r/cloud/go/blobstore/ent"
 	"magma/orc8r/cloud/go/blobstore/ent/blob"
@@ -26,6 +27,8 @@ import (
 	"magma/orc8r/cloud/go/storage"
 	magmaerrors "magma/orc8r/lib/go/errors"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/facebookincubator/ent/dialect"
 	entsql "github.com/facebookincubator/ent/dialect/sql"
 	"github.com/thoas/go-funk"
 )
@@ -50,27 +53,74 @@ func NewEntStorage(tableName string, db *sql.DB, builder sqorc.StatementBuilder)
 	return &entFactory{tableName: tableName, db: db, client: client, builder: builder}
 }
 
+// NewEntStorageWithChangeFeed returns an ent-based implementation of
+// blobstore which also records a change feed of its blobs.
+// The same constraints as for NewEntStorage apply.
+func NewEntStorageWithChangeFeed(tableName string, db *sql.DB, builder sqorc.StatementBuilder) WatchableBlobStorageFactory {
+	fact := NewEntStorage(tableName, db, builder).(*entFactory)
+	fact.feed = newChangeFeed(tableName, db, builder)
+	return &watchableFactory{BlobStorageFactory: fact, changeFeed: fact.feed}
+}
+
+// NewEntStorageWithHistory returns an ent-based implementation of blobstore
+// which keeps the previous versions of blobs for the retention period.
+// A retention of 0 keeps them indefinitely.
+// The same constraints as for NewEntStorage apply.
+func NewEntStorageWithHistory(tableName string, db *sql.DB, builder sqorc.StatementBuilder, retention time.Duration) VersionedBlobStorageFactory {
+	fact := NewEntStorage(tableName, db, builder).(*entFactory)
+	fact.history = newBlobHistory(tableName, db, builder, retention)
+	return fact
+}
+
 type entFactory struct {
 	tableName string
 	db        *sql.DB
 	client    *ent.Client
 	builder   sqorc.StatementBuilder
+	// feed records changes to blobs, if set
+	feed *changeFeed
+	// history records previous versions of blobs, if set
+	history *blobHistory
 }
 
 func (f *entFactory) InitializeFactory() error {
-	return NewSQLBlobStorageFactory(f.tableName, f.db, f.builder).InitializeFactory()
+	fact := &sqlBlobStoreFactory{tableName: f.tableName, db: f.db, builder: f.builder, feed: f.feed, history: f.history}
+	return fact.InitializeFactory()
 }
 
 func (f *entFactory) StartTransaction(opts *storage.TxOptions) (TransactionalBlobStorage, error) {
+	return f.StartVersionedTransaction(opts)
+}
+
+func (f *entFactory) StartVersionedTransaction(opts *storage.TxOptions) (VersionedBlobStorage, error) {
 	tx, err := f.client.BeginTx(context.Background(), getSqlOpts(opts))
 	if err != nil {
 		return nil, err
 	}
-	return &entStorage{Tx: tx}, nil
+	return &entStorage{Tx: tx, feed: f.feed, history: f.history}, nil
+}
+
+func (f *entFactory) PruneHistory() (int64, error) {
+	if f.history == nil {
+		return 0, ErrHistoryNotEnabled
+	}
+	return f.history.prune()
 }
 
 type entStorage struct {
 	*ent.Tx
+	feed    *changeFeed
+	history *blobHistory
+	// changed is set once changes were recorded to the feed
+	changed bool
+}
+
+func (e *entStorage) Commit() error {
+	err := e.Tx.Commit()
+	if err == nil && e.changed {
+		e.feed.notifyCommitted()
+	}
+	return err
 }
 
 func (e *entStorage) Get(networkID string, id storage.TypeAndKey) (Blob, error) {
@@ -143,31 +193,61 @@ func (e *entStorage) Search(filter SearchFilter, criteria LoadCriteria) (map[str
 
 func (e *entStorage) IncrementVersion(networkID string, id storage.TypeAndKey) error {
 	ctx := context.Background()
-	switch _, err := e.Get(networkID, id); {
+	switch existing, err := e.Get(networkID, id); {
 	case err == magmaerrors.ErrNotFound:
+		created, err := e.history.continueVersions(entRunner{e.Tx.ExecQuerier()}, networkID, Blobs{{Type: id.Type, Key: id.Key, Version: 1}}, nil)
+		if err != nil {
+			return err
+		}
 		_, err = e.Blob.Create().
 			SetKey(id.Key).
 			SetType(id.Type).
 			SetNetworkID(networkID).
-			SetVersion(1).
+			SetVersion(created[0].Version).
 			Save(ctx)
-		return err
+		if err != nil {
+			return err
+		}
+		return e.recordChanges(networkID, []Change{{Type: id.Type, Key: id.Key, Op: ChangeOpCreate, Version: created[0].Version}})
 	case err != nil: // err != not found.
 		return err
 	default:
-		return e.Blob.Update().
+		err = e.Blob.Update().
 			Where(blob.NetworkID(networkID), blob.Type(id.Type), blob.Key(id.Key)).
 			AddVersion(1).
 			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = e.recordHistory(networkID, Blobs{existing}, false)
+		if err != nil {
+			return err
+		}
+		return e.recordChanges(networkID, []Change{{Type: id.Type, Key: id.Key, Op: ChangeOpUpdate, Version: existing.Version + 1}})
 	}
 }
 
 func (e *entStorage) Delete(networkID string, ids []storage.TypeAndKey) error {
 	ctx := context.Background()
+	var existingBlobs Blobs
+	if e.feed != nil || e.history != nil {
+		var err error
+		existingBlobs, err = e.GetMany(networkID, ids)
+		if err != nil {
+			return fmt.Errorf("error reading existing blobs: %s", err)
+		}
+	}
 	_, err := e.Blob.Delete().
 		Where(P(networkID, ids)).
 		Exec(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	err = e.recordHistory(networkID, existingBlobs, true)
+	if err != nil {
+		return err
+	}
+	return e.recordChanges(networkID, getDeleteChanges(existingBlobs))
 }
 
 func (e *entStorage) CreateOrUpdate(networkID string, blobs Blobs) error {
@@ -176,6 +256,10 @@ func (e *entStorage) CreateOrUpdate(networkID string, blobs Blobs) error {
 	if err != nil {
 		return fmt.Errorf("error reading existing blobs: %s", err)
 	}
+	blobs, err = e.history.continueVersions(entRunner{e.Tx.ExecQuerier()}, networkID, blobs, existingBlobs)
+	if err != nil {
+		return err
+	}
 	changeSet := partitionBlobsToCreateAndChange(blobs, existingBlobs)
 	for _, id := range getSortedTypeAndKeys(changeSet.blobsToChange) {
 		change := changeSet.blobsToChange[id]
@@ -204,7 +288,11 @@ func (e *entStorage) CreateOrUpdate(networkID string, blobs Blobs) error {
 			return err
 		}
 	}
-	return nil
+	err = e.recordHistory(networkID, existingBlobs, false)
+	if err != nil {
+		return err
+	}
+	return e.recordChanges(networkID, getWriteChanges(blobs, existingBlobs))
 }
 
 func (e *entStorage) GetExistingKeys(keys []string, filter SearchFilter) ([]string, error) {
@@ -225,6 +313,85 @@ func (e *entStorage) GetExistingKeys(keys []string, filter SearchFilter) ([]stri
 		Strings(ctx)
 }
 
+// recordChanges records changes to the change feed, if the factory has one.
+func (e *entStorage) recordChanges(networkID string, changes []Change) error {
+	if e.feed == nil || len(changes) == 0 {
+		return nil
+	}
+	err := e.feed.record(entRunner{e.Tx.ExecQuerier()}, networkID, changes)
+	if err != nil {
+		return err
+	}
+	e.changed = true
+	return nil
+}
+
+// recordHistory records replaced or deleted versions of blobs, if the
+// factory keeps history.
+func (e *entStorage) recordHistory(networkID string, replaced Blobs, deleted bool) error {
+	if e.history == nil {
+		return nil
+	}
+	return e.history.record(entRunner{e.Tx.ExecQuerier()}, networkID, replaced, deleted)
+}
+
+func (e *entStorage) GetAtVersion(networkID string, id storage.TypeAndKey, version uint64) (Blob, error) {
+	return getAtVersion(e, e.history, entRunner{e.Tx.ExecQuerier()}, networkID, id, version)
+}
+
+func (e *entStorage) ListHistory(networkID string, id storage.TypeAndKey) ([]HistoricalBlob, error) {
+	if e.history == nil {
+		return nil, ErrHistoryNotEnabled
+	}
+	return e.history.list(entRunner{e.Tx.ExecQuerier()}, networkID, id, nil)
+}
+
+func (e *entStorage) Restore(networkID string, id storage.TypeAndKey, version uint64) error {
+	return restore(e, e.history, entRunner{e.Tx.ExecQuerier()}, networkID, id, version)
+}
+
+// entRunner runs squirrel queries within an ent transaction.
+type entRunner struct {
+	execQuerier dialect.ExecQuerier
+}
+
+func (r entRunner) Exec(query string, args ...interface{}) (sql.Result, error) {
+	var res sql.Result
+	err := r.execQuerier.Exec(context.Background(), query, args, &res)
+	return res, err
+}
+
+func (r entRunner) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	rows := entsql.Rows{}
+	err := r.execQuerier.Query(context.Background(), query, args, &rows)
+	return rows.Rows, err
+}
+
+func (r entRunner) QueryRow(query string, args ...interface{}) sq.RowScanner {
+	rows, err := r.Query(query, args...)
+	return &entRow{rows: rows, err: err}
+}
+
+// entRow scans the first row of a query.
+type entRow struct {
+	rows *sql.Rows
+	err  error
+}
+
+func (r *entRow) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer sqorc.CloseRowsLogOnError(r.rows, "entRow")
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return r.rows.Scan(dest...)
+}
+
 func P(networkID string, ids []storage.TypeAndKey) predicate.Blob {
 	preds := make([]predicate.Blob, 0, len(ids))
 	for _, id := range ids {
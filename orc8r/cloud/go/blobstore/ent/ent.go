@@ -237,6 +237,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// ExecQuerier returns the transaction's driver, for running queries on
+// tables which aren't modeled by ent.
+func (tx *Tx) ExecQuerier() dialect.ExecQuerier {
+	return tx.config.driver
+}
+
 // keys returns the keys/ids from the edge map.
 func keys(m map[int]struct{}) []int {
 	s := make([]int, 0, len(m))
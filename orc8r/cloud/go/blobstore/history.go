@@ -0,0 +1,395 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blobstore
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	magmaerrors "magma/orc8r/lib/go/errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// historyTableSuffix is appended to a blobstore's table name to get the
+	// name of its history table
+	historyTableSuffix = "_history"
+
+	revisionCol   = "revision"
+	deletedCol    = "deleted"
+	replacedAtCol = "replaced_at"
+
+	// DefaultHistoryRetention is the default period for which replaced
+	// versions of blobs are kept
+	DefaultHistoryRetention = 30 * 24 * time.Hour
+)
+
+// ErrHistoryNotEnabled is returned by history operations of blobstores
+// which don't keep history.
+var ErrHistoryNotEnabled = errors.New("blob history is not enabled for this blobstore")
+
+// HistoricalBlob is a previous version of a blob, which was replaced by a
+// newer version or deleted.
+type HistoricalBlob struct {
+	Blob
+	// Deleted is set if the version was deleted, rather than replaced by a
+	// newer version
+	Deleted bool
+	// ReplacedAt is the time the version was replaced or deleted
+	ReplacedAt time.Time
+}
+
+// VersionedBlobStorageFactory is a BlobStorageFactory which keeps the
+// previous versions of blobs for a retention period, instead of discarding
+// them when blobs are updated or deleted.
+type VersionedBlobStorageFactory interface {
+	BlobStorageFactory
+
+	// StartVersionedTransaction opens a transaction, like StartTransaction,
+	// with access to the history of blobs.
+	StartVersionedTransaction(opts *storage.TxOptions) (VersionedBlobStorage, error)
+
+	// PruneHistory deletes the versions which were replaced before the
+	// retention period, and returns the number of versions deleted.
+	PruneHistory() (int64, error)
+}
+
+// VersionedBlobStorage is a TransactionalBlobStorage with access to the
+// previous versions of blobs.
+type VersionedBlobStorage interface {
+	TransactionalBlobStorage
+
+	// GetAtVersion loads a blob as it was at the passed version, which may be
+	// its current version.
+	// If the version is unknown, e.g. because it was pruned, ErrNotFound
+	// from magma/orc8r/lib/go/errors will be returned.
+	GetAtVersion(networkID string, id storage.TypeAndKey, version uint64) (Blob, error)
+
+	// ListHistory returns the previous versions of a blob, most recently
+	// replaced first. The current version isn't included.
+	ListHistory(networkID string, id storage.TypeAndKey) ([]HistoricalBlob, error)
+
+	// Restore writes the value a blob had at the passed version back to the
+	// blob, undoing any later updates or its deletion. The restored blob gets
+	// a new version, so the restore can itself be undone.
+	// Restoring the current version has no effect.
+	Restore(networkID string, id storage.TypeAndKey, version uint64) error
+}
+
+// RunHistoryPruner prunes the history of the factory's blobs every
+// interval. It never returns.
+func RunHistoryPruner(fact VersionedBlobStorageFactory, interval time.Duration) {
+	for range time.Tick(interval) {
+		deleted, err := fact.PruneHistory()
+		if err != nil {
+			glog.Errorf("Error pruning blob history: %v", err)
+			continue
+		}
+		if deleted > 0 {
+			glog.Infof("Pruned %d expired blob versions", deleted)
+		}
+	}
+}
+
+// blobHistory records and reads the previous versions of the blobs of a
+// blobstore table, for both the SQL and ent backends.
+type blobHistory struct {
+	table   string
+	db      *sql.DB
+	builder sqorc.StatementBuilder
+	// retention is how long replaced versions are kept, or 0 to keep them
+	// indefinitely
+	retention time.Duration
+}
+
+func newBlobHistory(tableName string, db *sql.DB, builder sqorc.StatementBuilder, retention time.Duration) *blobHistory {
+	return &blobHistory{table: tableName + historyTableSuffix, db: db, builder: builder, retention: retention}
+}
+
+func (h *blobHistory) initTable(tx *sql.Tx) error {
+	_, err := h.builder.CreateTable(h.table).
+		IfNotExists().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(typeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(keyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revisionCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(valCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(verCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(deletedCol).Type(sqorc.ColumnTypeBool).NotNull().EndColumn().
+		Column(replacedAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		PrimaryKey(nidCol, typeCol, keyCol, revisionCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create blob history table")
+	}
+
+	_, err = h.builder.CreateIndex(h.table + "_replaced_at_idx").
+		IfNotExists().
+		On(h.table).
+		Columns(replacedAtCol).
+		RunWith(tx).
+		Exec()
+	return errors.Wrap(err, "failed to create blob history index")
+}
+
+// record adds the replaced versions of blobs to their history, within the
+// transaction of the runner.
+// Each blob's versions are numbered by a revision, which orders them by the
+// time they were replaced.
+func (h *blobHistory) record(runner sq.BaseRunner, networkID string, replaced Blobs, deleted bool) error {
+	if len(replaced) == 0 {
+		return nil
+	}
+
+	rows, err := h.builder.Select(typeCol, keyCol, fmt.Sprintf("MAX(%s)", revisionCol)).
+		From(h.table).
+		Where(getWhereCondition(networkID, replaced.TKs())).
+		GroupBy(typeCol, keyCol).
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return errors.Wrap(err, "failed to query blob history revisions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "record")
+	revisions := map[storage.TypeAndKey]uint64{}
+	for rows.Next() {
+		var tk storage.TypeAndKey
+		var revision uint64
+		err = rows.Scan(&tk.Type, &tk.Key, &revision)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan blob history revision")
+		}
+		revisions[tk] = revision
+	}
+	err = rows.Err()
+	if err != nil {
+		return errors.Wrap(err, "sql rows err")
+	}
+
+	replacedAt := clock.Now().UnixNano() / int64(time.Millisecond)
+	insert := h.builder.Insert(h.table).
+		Columns(nidCol, typeCol, keyCol, revisionCol, valCol, verCol, deletedCol, replacedAtCol)
+	for _, blob := range replaced {
+		tk := storage.TypeAndKey{Type: blob.Type, Key: blob.Key}
+		revisions[tk]++
+		insert = insert.Values(networkID, blob.Type, blob.Key, revisions[tk], blob.Value, blob.Version, deleted, replacedAt)
+	}
+	_, err = insert.RunWith(runner).Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to record blob history")
+	}
+	return nil
+}
+
+// list returns the previous versions of a blob, most recent first.
+// If version is non-nil, only versions with that version number are
+// returned.
+func (h *blobHistory) list(runner sq.BaseRunner, networkID string, id storage.TypeAndKey, version *uint64) ([]HistoricalBlob, error) {
+	where := sq.And{sq.Eq{nidCol: networkID}, sq.Eq{typeCol: id.Type}, sq.Eq{keyCol: id.Key}}
+	if version != nil {
+		where = append(where, sq.Eq{verCol: *version})
+	}
+	rows, err := h.builder.Select(valCol, verCol, deletedCol, replacedAtCol).
+		From(h.table).
+		Where(where).
+		OrderBy(fmt.Sprintf("%s DESC", revisionCol)).
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query blob history")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "list")
+
+	var ret []HistoricalBlob
+	for rows.Next() {
+		blob := HistoricalBlob{Blob: Blob{Type: id.Type, Key: id.Key}}
+		var replacedAtMs int64
+		err = rows.Scan(&blob.Value, &blob.Version, &blob.Deleted, &replacedAtMs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan blob history row")
+		}
+		blob.ReplacedAt = time.Unix(0, replacedAtMs*int64(time.Millisecond))
+		ret = append(ret, blob)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "sql rows err")
+	}
+	return ret, nil
+}
+
+// getMaxVersion returns the highest version a blob previously had, or 0 if
+// it has no history.
+func (h *blobHistory) getMaxVersion(runner sq.BaseRunner, networkID string, id storage.TypeAndKey) (uint64, error) {
+	rows, err := h.builder.Select(fmt.Sprintf("MAX(%s)", verCol)).
+		From(h.table).
+		Where(sq.And{sq.Eq{nidCol: networkID}, sq.Eq{typeCol: id.Type}, sq.Eq{keyCol: id.Key}}).
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query blob history versions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "getMaxVersion")
+
+	var maxVersion sql.NullInt64
+	if rows.Next() {
+		err = rows.Scan(&maxVersion)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to scan blob history version")
+		}
+	}
+	return uint64(maxVersion.Int64), rows.Err()
+}
+
+// continueVersions returns the blobs, with the version of each blob which
+// doesn't exist yet set past the versions it had before it was deleted.
+// Versions then stay unique per blob when it's recreated.
+func (h *blobHistory) continueVersions(runner sq.BaseRunner, networkID string, blobs Blobs, existingBlobs Blobs) (Blobs, error) {
+	if h == nil {
+		return blobs, nil
+	}
+	existingByTK := existingBlobs.ByTK()
+	var created []storage.TypeAndKey
+	for _, blob := range blobs {
+		tk := storage.TypeAndKey{Type: blob.Type, Key: blob.Key}
+		if _, ok := existingByTK[tk]; !ok {
+			created = append(created, tk)
+		}
+	}
+	if len(created) == 0 {
+		return blobs, nil
+	}
+
+	rows, err := h.builder.Select(typeCol, keyCol, fmt.Sprintf("MAX(%s)", verCol)).
+		From(h.table).
+		Where(getWhereCondition(networkID, created)).
+		GroupBy(typeCol, keyCol).
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query blob history versions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "continueVersions")
+	nextVersions := map[storage.TypeAndKey]uint64{}
+	for rows.Next() {
+		var tk storage.TypeAndKey
+		var maxVersion uint64
+		err = rows.Scan(&tk.Type, &tk.Key, &maxVersion)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan blob history version")
+		}
+		nextVersions[tk] = maxVersion + 1
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "sql rows err")
+	}
+	if len(nextVersions) == 0 {
+		return blobs, nil
+	}
+
+	ret := make(Blobs, 0, len(blobs))
+	for _, blob := range blobs {
+		tk := storage.TypeAndKey{Type: blob.Type, Key: blob.Key}
+		if _, ok := existingByTK[tk]; !ok && blob.Version < nextVersions[tk] {
+			blob.Version = nextVersions[tk]
+		}
+		ret = append(ret, blob)
+	}
+	return ret, nil
+}
+
+func (h *blobHistory) prune() (int64, error) {
+	if h.retention <= 0 {
+		return 0, nil
+	}
+	before := clock.Now().Add(-h.retention).UnixNano() / int64(time.Millisecond)
+	res, err := h.builder.Delete(h.table).
+		Where(sq.Lt{replacedAtCol: before}).
+		RunWith(h.db).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune blob history")
+	}
+	return res.RowsAffected()
+}
+
+// getAtVersion implements VersionedBlobStorage.GetAtVersion for a store
+// whose history is recorded by h, in the store's transaction.
+func getAtVersion(store TransactionalBlobStorage, h *blobHistory, runner sq.BaseRunner, networkID string, id storage.TypeAndKey, version uint64) (Blob, error) {
+	if h == nil {
+		return Blob{}, ErrHistoryNotEnabled
+	}
+	current, err := store.Get(networkID, id)
+	if err != nil && err != magmaerrors.ErrNotFound {
+		return Blob{}, err
+	}
+	if err == nil && current.Version == version {
+		return current, nil
+	}
+
+	versions, err := h.list(runner, networkID, id, &version)
+	if err != nil {
+		return Blob{}, err
+	}
+	if len(versions) == 0 {
+		return Blob{}, magmaerrors.ErrNotFound
+	}
+	return versions[0].Blob, nil
+}
+
+// restore implements VersionedBlobStorage.Restore for a store whose history
+// is recorded by h, in the store's transaction.
+func restore(store TransactionalBlobStorage, h *blobHistory, runner sq.BaseRunner, networkID string, id storage.TypeAndKey, version uint64) error {
+	if h == nil {
+		return ErrHistoryNotEnabled
+	}
+	current, err := store.Get(networkID, id)
+	if err != nil && err != magmaerrors.ErrNotFound {
+		return err
+	}
+	exists := err == nil
+	if exists && current.Version == version {
+		return nil
+	}
+
+	versions, err := h.list(runner, networkID, id, &version)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return magmaerrors.ErrNotFound
+	}
+
+	// Give the restored blob a version it never had, so versions remain
+	// unambiguous
+	newVersion, err := h.getMaxVersion(runner, networkID, id)
+	if err != nil {
+		return err
+	}
+	if exists && current.Version > newVersion {
+		newVersion = current.Version
+	}
+	newVersion++
+	restored := Blob{Type: id.Type, Key: id.Key, Value: versions[0].Value, Version: newVersion}
+	return store.CreateOrUpdate(networkID, Blobs{restored})
+}
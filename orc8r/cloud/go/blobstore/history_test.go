@@ -0,0 +1,273 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blobstore_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+	magmaerrors "magma/orc8r/lib/go/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlBlobStorage_History(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactoryWithHistory("network_table", db, sqorc.GetSqlBuilder(), time.Hour)
+	historyIntegration(t, fact)
+}
+
+func TestSqlBlobStorage_HistoryIntegration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactoryWithHistory("network_table", db, sqorc.GetSqlBuilder(), time.Hour)
+	integration(t, fact)
+}
+
+func TestEntStorage_History(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorageWithHistory("states", db, sqorc.GetSqlBuilder(), time.Hour)
+	historyIntegration(t, fact)
+}
+
+func TestEntStorage_HistoryIntegration(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorageWithHistory("states", db, sqorc.GetSqlBuilder(), time.Hour)
+	integration(t, fact)
+}
+
+func TestSqlBlobStorage_HistoryRecreate(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactoryWithHistory("network_table", db, sqorc.GetSqlBuilder(), time.Hour)
+	historyRecreate(t, fact)
+}
+
+func TestEntStorage_HistoryRecreate(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorageWithHistory("states", db, sqorc.GetSqlBuilder(), time.Hour)
+	historyRecreate(t, fact)
+}
+
+func TestSqlBlobStorage_HistoryNotEnabled(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewSQLBlobStorageFactory("network_table", db, sqorc.GetSqlBuilder())
+	require.NoError(t, fact.InitializeFactory())
+
+	store, err := fact.StartTransaction(nil)
+	require.NoError(t, err)
+	versioned, ok := store.(blobstore.VersionedBlobStorage)
+	require.True(t, ok)
+	_, err = versioned.ListHistory("n1", storage.TypeAndKey{Type: "t1", Key: "k1"})
+	assert.EqualError(t, err, "blob history is not enabled for this blobstore")
+	require.NoError(t, store.Rollback())
+}
+
+func historyIntegration(t *testing.T, fact blobstore.VersionedBlobStorageFactory) {
+	t0 := time.Unix(1000, 0)
+	clock.SetAndFreezeClock(t, t0)
+	defer clock.UnfreezeClock(t)
+
+	require.NoError(t, fact.InitializeFactory())
+	// Initialization is idempotent
+	require.NoError(t, fact.InitializeFactory())
+
+	tk1 := storage.TypeAndKey{Type: "t1", Key: "k1"}
+	tk2 := storage.TypeAndKey{Type: "t1", Key: "k2"}
+
+	// Creating blobs records no history
+	store, err := fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{
+		{Type: "t1", Key: "k1", Value: []byte("v1")},
+		{Type: "t1", Key: "k2", Value: []byte("v1")},
+	})
+	require.NoError(t, err)
+	history, err := store.ListHistory("n1", tk1)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+	require.NoError(t, store.Commit())
+
+	// Updates record the replaced versions
+	clock.SetAndFreezeClock(t, t0.Add(time.Minute))
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v2")}})
+	require.NoError(t, err)
+	err = store.IncrementVersion("n1", tk1)
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	// Rolled back updates aren't recorded
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v3")}})
+	require.NoError(t, err)
+	require.NoError(t, store.Rollback())
+
+	// Deletes are soft
+	clock.SetAndFreezeClock(t, t0.Add(2*time.Minute))
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.Delete("n1", []storage.TypeAndKey{tk1, {Type: "t1", Key: "k3"}})
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	_, err = store.Get("n1", tk1)
+	assert.Equal(t, magmaerrors.ErrNotFound, err)
+	history, err = store.ListHistory("n1", tk1)
+	assert.NoError(t, err)
+	expected := []blobstore.HistoricalBlob{
+		{Blob: blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v2"), Version: 2}, Deleted: true, ReplacedAt: t0.Add(2 * time.Minute)},
+		{Blob: blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v2"), Version: 1}, ReplacedAt: t0.Add(time.Minute)},
+		{Blob: blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v1"), Version: 0}, ReplacedAt: t0.Add(time.Minute)},
+	}
+	assert.Equal(t, expected, history)
+	history, err = store.ListHistory("n2", tk1)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+
+	blob, err := store.GetAtVersion("n1", tk1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v1"), Version: 0}, blob)
+	blob, err = store.GetAtVersion("n1", tk2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k2", Value: []byte("v1"), Version: 0}, blob)
+	_, err = store.GetAtVersion("n1", tk1, 5)
+	assert.Equal(t, magmaerrors.ErrNotFound, err)
+	require.NoError(t, store.Commit())
+
+	// Restoring a deleted blob recreates it with a new version
+	clock.SetAndFreezeClock(t, t0.Add(3*time.Minute))
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.Restore("n1", tk1, 0)
+	require.NoError(t, err)
+	err = store.Restore("n1", tk1, 5)
+	assert.Equal(t, magmaerrors.ErrNotFound, err)
+	require.NoError(t, store.Commit())
+
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	blob, err = store.Get("n1", tk1)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v1"), Version: 3}, blob)
+
+	// Restoring an update replaces the current version, which is kept
+	err = store.Restore("n1", tk1, 1)
+	require.NoError(t, err)
+	blob, err = store.Get("n1", tk1)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v2"), Version: 4}, blob)
+	blob, err = store.GetAtVersion("n1", tk1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v1"), blob.Value)
+
+	// Restoring the current version has no effect
+	err = store.Restore("n1", tk1, 4)
+	require.NoError(t, err)
+	history, err = store.ListHistory("n1", tk1)
+	assert.NoError(t, err)
+	assert.Len(t, history, 4)
+	require.NoError(t, store.Commit())
+
+	// Pruning removes the versions replaced before the retention period
+	clock.SetAndFreezeClock(t, t0.Add(time.Hour+90*time.Second))
+	deleted, err := fact.PruneHistory()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	history, err = store.ListHistory("n1", tk1)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 2}, getVersions(history))
+	_, err = store.GetAtVersion("n1", tk1, 0)
+	assert.Equal(t, magmaerrors.ErrNotFound, err)
+	require.NoError(t, store.Commit())
+}
+
+// historyRecreate checks that a deleted blob which is recreated continues
+// from its previous versions instead of reusing them.
+func historyRecreate(t *testing.T, fact blobstore.VersionedBlobStorageFactory) {
+	require.NoError(t, fact.InitializeFactory())
+
+	tk1 := storage.TypeAndKey{Type: "t1", Key: "k1"}
+	tk2 := storage.TypeAndKey{Type: "t1", Key: "k2"}
+
+	store, err := fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v1")}})
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v2")}})
+	require.NoError(t, err)
+	err = store.IncrementVersion("n1", tk2)
+	require.NoError(t, err)
+	err = store.Delete("n1", []storage.TypeAndKey{tk1, tk2})
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	// Recreating a deleted blob continues after its deleted version
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	err = store.CreateOrUpdate("n1", blobstore.Blobs{{Type: "t1", Key: "k1", Value: []byte("v3")}})
+	require.NoError(t, err)
+	err = store.IncrementVersion("n1", tk2)
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+
+	store, err = fact.StartVersionedTransaction(nil)
+	require.NoError(t, err)
+	blob, err := store.Get("n1", tk1)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v3"), Version: 2}, blob)
+	blob, err = store.Get("n1", tk2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), blob.Version)
+
+	blob, err = store.GetAtVersion("n1", tk1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v1"), Version: 0}, blob)
+	blob, err = store.GetAtVersion("n1", tk1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v2"), Version: 1}, blob)
+	blob, err = store.GetAtVersion("n1", tk1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t1", Key: "k1", Value: []byte("v3"), Version: 2}, blob)
+
+	history, err := store.ListHistory("n1", tk1)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 0}, getVersions(history))
+	require.NoError(t, store.Commit())
+}
+
+func getVersions(history []blobstore.HistoricalBlob) []uint64 {
+	var ret []uint64
+	for _, blob := range history {
+		ret = append(ret, blob.Version)
+	}
+	return ret
+}
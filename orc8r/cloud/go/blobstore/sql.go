@@ -18,6 +18,7 @@ import (
 	"database/sql"
 	"fmt"
 	"sort"
+	"time"
 
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
@@ -43,18 +44,56 @@ func NewSQLBlobStorageFactory(tableName string, db *sql.DB, sqlBuilder sqorc.Sta
 	return &sqlBlobStoreFactory{tableName: tableName, db: db, builder: sqlBuilder}
 }
 
+// NewSQLBlobStorageFactoryWithChangeFeed returns a SQL-backed
+// BlobStorageFactory which also records a change feed of its blobs.
+func NewSQLBlobStorageFactoryWithChangeFeed(tableName string, db *sql.DB, sqlBuilder sqorc.StatementBuilder) WatchableBlobStorageFactory {
+	feed := newChangeFeed(tableName, db, sqlBuilder)
+	fact := &sqlBlobStoreFactory{tableName: tableName, db: db, builder: sqlBuilder, feed: feed}
+	return &watchableFactory{BlobStorageFactory: fact, changeFeed: feed}
+}
+
+// NewSQLBlobStorageFactoryWithHistory returns a SQL-backed
+// BlobStorageFactory which keeps the previous versions of blobs for the
+// retention period. A retention of 0 keeps them indefinitely.
+func NewSQLBlobStorageFactoryWithHistory(tableName string, db *sql.DB, sqlBuilder sqorc.StatementBuilder, retention time.Duration) VersionedBlobStorageFactory {
+	history := newBlobHistory(tableName, db, sqlBuilder, retention)
+	return &sqlBlobStoreFactory{tableName: tableName, db: db, builder: sqlBuilder, history: history}
+}
+
 type sqlBlobStoreFactory struct {
 	tableName string
 	db        *sql.DB
 	builder   sqorc.StatementBuilder
+	// feed records changes to blobs, if set
+	feed *changeFeed
+	// history records previous versions of blobs, if set
+	history *blobHistory
+}
+
+// watchableFactory adds a change feed to a blob storage factory which
+// records changes to it.
+type watchableFactory struct {
+	BlobStorageFactory
+	*changeFeed
 }
 
 func (fact *sqlBlobStoreFactory) StartTransaction(opts *storage.TxOptions) (TransactionalBlobStorage, error) {
+	return fact.StartVersionedTransaction(opts)
+}
+
+func (fact *sqlBlobStoreFactory) StartVersionedTransaction(opts *storage.TxOptions) (VersionedBlobStorage, error) {
 	tx, err := fact.db.BeginTx(context.Background(), getSqlOpts(opts))
 	if err != nil {
 		return nil, err
 	}
-	return &sqlBlobStorage{tableName: fact.tableName, tx: tx, builder: fact.builder}, nil
+	return &sqlBlobStorage{tableName: fact.tableName, tx: tx, builder: fact.builder, feed: fact.feed, history: fact.history}, nil
+}
+
+func (fact *sqlBlobStoreFactory) PruneHistory() (int64, error) {
+	if fact.history == nil {
+		return 0, ErrHistoryNotEnabled
+	}
+	return fact.history.prune()
 }
 
 func getSqlOpts(opts *storage.TxOptions) *sql.TxOptions {
@@ -94,13 +133,29 @@ func (fact *sqlBlobStoreFactory) initTable(tx *sql.Tx, tableName string) error {
 		PrimaryKey(nidCol, typeCol, keyCol).
 		RunWith(tx).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+	if fact.feed != nil {
+		err = fact.feed.initTables(tx)
+		if err != nil {
+			return err
+		}
+	}
+	if fact.history != nil {
+		return fact.history.initTable(tx)
+	}
+	return nil
 }
 
 type sqlBlobStorage struct {
 	tableName string
 	tx        *sql.Tx
 	builder   sqorc.StatementBuilder
+	feed      *changeFeed
+	history   *blobHistory
+	// changed is set once changes were recorded to the feed
+	changed bool
 }
 
 func (store *sqlBlobStorage) Commit() error {
@@ -110,6 +165,9 @@ func (store *sqlBlobStorage) Commit() error {
 
 	err := store.tx.Commit()
 	store.tx = nil
+	if err == nil && store.changed {
+		store.feed.notifyCommitted()
+	}
 	return err
 }
 
@@ -238,6 +296,10 @@ func (store *sqlBlobStorage) CreateOrUpdate(networkID string, blobs Blobs) error
 	if err != nil {
 		return fmt.Errorf("Error reading existing blobs: %s", err)
 	}
+	blobs, err = store.history.continueVersions(store.tx, networkID, blobs, existingBlobs)
+	if err != nil {
+		return err
+	}
 	blobsToCreateAndChange := partitionBlobsToCreateAndChange(blobs, existingBlobs)
 
 	if len(blobsToCreateAndChange.blobsToChange) > 0 {
@@ -253,7 +315,11 @@ func (store *sqlBlobStorage) CreateOrUpdate(networkID string, blobs Blobs) error
 		}
 	}
 
-	return nil
+	err = store.recordHistory(networkID, existingBlobs, false)
+	if err != nil {
+		return err
+	}
+	return store.recordChanges(networkID, getWriteChanges(blobs, existingBlobs))
 }
 
 func (store *sqlBlobStorage) GetExistingKeys(keys []string, filter SearchFilter) ([]string, error) {
@@ -299,12 +365,28 @@ func (store *sqlBlobStorage) Delete(networkID string, ids []storage.TypeAndKey)
 		return err
 	}
 
+	var existingBlobs Blobs
+	if store.feed != nil || store.history != nil {
+		var err error
+		existingBlobs, err = store.GetMany(networkID, ids)
+		if err != nil {
+			return fmt.Errorf("Error reading existing blobs: %s", err)
+		}
+	}
+
 	whereCondition := getWhereCondition(networkID, ids)
 	_, err := store.builder.Delete(store.tableName).
 		Where(whereCondition).
 		RunWith(store.tx).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+	err = store.recordHistory(networkID, existingBlobs, true)
+	if err != nil {
+		return err
+	}
+	return store.recordChanges(networkID, getDeleteChanges(existingBlobs))
 }
 
 func (store *sqlBlobStorage) IncrementVersion(networkID string, id storage.TypeAndKey) error {
@@ -312,9 +394,22 @@ func (store *sqlBlobStorage) IncrementVersion(networkID string, id storage.TypeA
 		return err
 	}
 
-	_, err := store.builder.Insert(store.tableName).
+	var existing Blobs
+	if store.feed != nil || store.history != nil {
+		var err error
+		existing, err = store.GetMany(networkID, []storage.TypeAndKey{id})
+		if err != nil {
+			return fmt.Errorf("Error reading existing blob: %s", err)
+		}
+	}
+
+	created, err := store.history.continueVersions(store.tx, networkID, Blobs{{Type: id.Type, Key: id.Key, Version: 1}}, existing)
+	if err != nil {
+		return err
+	}
+	_, err = store.builder.Insert(store.tableName).
 		Columns(nidCol, typeCol, keyCol, verCol).
-		Values(networkID, id.Type, id.Key, 1).
+		Values(networkID, id.Type, id.Key, created[0].Version).
 		OnConflict(
 			[]sqorc.UpsertValue{{Column: verCol, Value: sq.Expr(fmt.Sprintf("%s.%s+1", store.tableName, verCol))}},
 			nidCol, typeCol, keyCol,
@@ -324,9 +419,66 @@ func (store *sqlBlobStorage) IncrementVersion(networkID string, id storage.TypeA
 	if err != nil {
 		return errors.Wrapf(err, "Error incrementing version on network %s with type %s and key %s", networkID, id.Type, id.Key)
 	}
+	err = store.recordHistory(networkID, existing, false)
+	if err != nil {
+		return err
+	}
+	if store.feed == nil {
+		return nil
+	}
+	updated, err := store.Get(networkID, id)
+	if err != nil {
+		return fmt.Errorf("Error reading incremented blob: %s", err)
+	}
+	return store.recordChanges(networkID, getWriteChanges(Blobs{updated}, existing))
+}
+
+// recordChanges records changes to the change feed, if the factory has one.
+func (store *sqlBlobStorage) recordChanges(networkID string, changes []Change) error {
+	if store.feed == nil || len(changes) == 0 {
+		return nil
+	}
+	err := store.feed.record(store.tx, networkID, changes)
+	if err != nil {
+		return err
+	}
+	store.changed = true
 	return nil
 }
 
+// recordHistory records replaced or deleted versions of blobs, if the
+// factory keeps history.
+func (store *sqlBlobStorage) recordHistory(networkID string, replaced Blobs, deleted bool) error {
+	if store.history == nil {
+		return nil
+	}
+	return store.history.record(store.tx, networkID, replaced, deleted)
+}
+
+func (store *sqlBlobStorage) GetAtVersion(networkID string, id storage.TypeAndKey, version uint64) (Blob, error) {
+	if err := store.validateTx(); err != nil {
+		return Blob{}, err
+	}
+	return getAtVersion(store, store.history, store.tx, networkID, id, version)
+}
+
+func (store *sqlBlobStorage) ListHistory(networkID string, id storage.TypeAndKey) ([]HistoricalBlob, error) {
+	if err := store.validateTx(); err != nil {
+		return nil, err
+	}
+	if store.history == nil {
+		return nil, ErrHistoryNotEnabled
+	}
+	return store.history.list(store.tx, networkID, id, nil)
+}
+
+func (store *sqlBlobStorage) Restore(networkID string, id storage.TypeAndKey, version uint64) error {
+	if err := store.validateTx(); err != nil {
+		return err
+	}
+	return restore(store, store.history, store.tx, networkID, id, version)
+}
+
 func (store *sqlBlobStorage) validateTx() error {
 	if store.tx == nil {
 		return errors.New("no transaction is available")
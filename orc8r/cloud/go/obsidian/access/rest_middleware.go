@@ -21,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/services/accessd"
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+	"magma/orc8r/cloud/go/services/configurator"
 	merrors "magma/orc8r/lib/go/errors"
 
 	"github.com/golang/glog"
@@ -31,6 +32,7 @@ import (
 // 1) determines request's access type (READ/WRITE)
 // 2) finds Operator & Entities of the request
 // 3) verifies Operator's access permissions for the entities
+// 4) attributes the request's configuration changes to the Operator
 func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		decorate := getDecorator(c.Request())
@@ -66,6 +68,9 @@ func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		// Attribute the configuration changes made by the request to the operator
+		c.SetRequest(req.WithContext(configurator.WithOperator(req.Context(), operator.GetOperator())))
+
 		if next != nil {
 			glog.V(4).Info("Access middleware successfully verified permissions. Sending request to the next middleware.")
 			return next(c)
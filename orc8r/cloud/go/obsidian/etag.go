@@ -0,0 +1,96 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package obsidian
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+// VersionETag returns the strong entity tag of a resource at the given
+// configurator version.
+func VersionETag(version uint64) string {
+	return fmt.Sprintf("%q", strconv.FormatUint(version, 10))
+}
+
+// SetETag sets the ETag header of the response to the entity tag of a
+// resource at the given configurator version.
+func SetETag(c echo.Context, version uint64) {
+	c.Response().Header().Set(ETagHeader, VersionETag(version))
+}
+
+// GetIfMatchVersion returns the configurator version the request's If-Match
+// header is conditioned on. Returns nil if the request has no If-Match
+// header, or if it matches any version ("*").
+// A header which can't match any version (e.g. a weak tag) results in a
+// precondition failed HTTP error, and a list of several entity tags in a
+// bad request HTTP error.
+func GetIfMatchVersion(c echo.Context) (*uint64, *echo.HTTPError) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(IfMatchHeader))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	if strings.Contains(ifMatch, ",") {
+		return nil, HttpError(fmt.Errorf("multiple entity tags in %s header are not supported", IfMatchHeader), http.StatusBadRequest)
+	}
+
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil || !strings.HasPrefix(ifMatch, `"`) {
+		return nil, VersionConflictHttpErr()
+	}
+	version, err := strconv.ParseUint(unquoted, 10, 64)
+	if err != nil {
+		return nil, VersionConflictHttpErr()
+	}
+	return &version, nil
+}
+
+// CheckIfMatch checks the request's If-Match header against the current
+// configurator version of the resource, returning a precondition failed
+// HTTP error if they don't match.
+func CheckIfMatch(c echo.Context, version uint64) *echo.HTTPError {
+	expectedVersion, nerr := GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	if expectedVersion != nil && *expectedVersion != version {
+		return VersionConflictHttpErr()
+	}
+	return nil
+}
+
+// WriteHttpError wraps the error returned by a configurator write as an HTTP
+// error, mapping version conflicts to precondition failed (412).
+// Other errors default to internal server error (500).
+func WriteHttpError(err error) *echo.HTTPError {
+	if err == merrors.ErrVersionConflict {
+		return VersionConflictHttpErr()
+	}
+	return HttpError(err, http.StatusInternalServerError)
+}
+
+func VersionConflictHttpErr() *echo.HTTPError {
+	return HttpError(fmt.Errorf("resource was modified, %s precondition failed", IfMatchHeader), http.StatusPreconditionFailed)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package obsidian
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetETag(t *testing.T) {
+	c, rec := newETagTestContext("")
+	SetETag(c, 42)
+	assert.Equal(t, `"42"`, rec.Header().Get(ETagHeader))
+}
+
+func TestGetIfMatchVersion(t *testing.T) {
+	v42 := uint64(42)
+	tests := []struct {
+		ifMatch         string
+		expectedVersion *uint64
+		expectedCode    int
+	}{
+		{ifMatch: "", expectedVersion: nil},
+		{ifMatch: "*", expectedVersion: nil},
+		{ifMatch: `"42"`, expectedVersion: &v42},
+		{ifMatch: ` "42" `, expectedVersion: &v42},
+		{ifMatch: `W/"42"`, expectedCode: http.StatusPreconditionFailed},
+		{ifMatch: `"foo"`, expectedCode: http.StatusPreconditionFailed},
+		{ifMatch: `42`, expectedCode: http.StatusPreconditionFailed},
+		{ifMatch: `"41", "42"`, expectedCode: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		c, _ := newETagTestContext(test.ifMatch)
+		version, nerr := GetIfMatchVersion(c)
+		if test.expectedCode != 0 {
+			assert.NotNil(t, nerr, test.ifMatch)
+			assert.Equal(t, test.expectedCode, nerr.Code, test.ifMatch)
+			continue
+		}
+		assert.Nil(t, nerr, test.ifMatch)
+		assert.Equal(t, test.expectedVersion, version, test.ifMatch)
+	}
+}
+
+func TestCheckIfMatch(t *testing.T) {
+	c, _ := newETagTestContext("")
+	assert.Nil(t, CheckIfMatch(c, 42))
+
+	c, _ = newETagTestContext(`"42"`)
+	assert.Nil(t, CheckIfMatch(c, 42))
+
+	c, _ = newETagTestContext(`"41"`)
+	nerr := CheckIfMatch(c, 42)
+	assert.NotNil(t, nerr)
+	assert.Equal(t, http.StatusPreconditionFailed, nerr.Code)
+}
+
+func TestWriteHttpError(t *testing.T) {
+	assert.Equal(t, http.StatusPreconditionFailed, WriteHttpError(merrors.ErrVersionConflict).Code)
+	assert.Equal(t, http.StatusInternalServerError, WriteHttpError(errors.New("foo")).Code)
+}
+
+func newETagTestContext(ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	if ifMatch != "" {
+		req.Header.Set(IfMatchHeader, ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
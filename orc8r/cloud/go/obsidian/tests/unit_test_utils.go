@@ -35,12 +35,16 @@ type Test struct {
 	Payload          encoding.BinaryMarshaler
 	MalformedPayload bool
 	Handler          echo.HandlerFunc
+	// Headers are set on the request
+	Headers map[string]string
 
 	ParamNames  []string
 	ParamValues []string
 
 	ExpectedStatus int
 	ExpectedResult encoding.BinaryMarshaler
+	// ExpectedHeaders are checked against the response headers
+	ExpectedHeaders map[string]string
 
 	ExpectedError          string
 	ExpectedErrorSubstring string
@@ -63,6 +67,9 @@ func RunUnitTest(t *testing.T, e *echo.Echo, test Test) {
 	} else {
 		req = httptest.NewRequest(test.Method, test.URL, bytes.NewReader([]byte{}))
 	}
+	for name, value := range test.Headers {
+		req.Header.Set(name, value)
+	}
 
 	recorder := httptest.NewRecorder()
 	c := e.NewContext(req, recorder)
@@ -74,6 +81,9 @@ func RunUnitTest(t *testing.T, e *echo.Echo, test Test) {
 		c.Error(handlerErr)
 	}
 	assert.Equal(t, test.ExpectedStatus, recorder.Code)
+	for name, value := range test.ExpectedHeaders {
+		assert.Equal(t, value, recorder.Header().Get(name), name)
+	}
 
 	if test.ExpectedError != "" {
 		if httpErr, ok := handlerErr.(*echo.HTTPError); ok {
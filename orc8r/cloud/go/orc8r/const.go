@@ -33,6 +33,9 @@ const (
 	UpgradeReleaseChannelEntityType = "upgrade_release_channel"
 
 	CallTraceEntityType = "call_trace"
+
+	MconfigRolloutConfigType  = "mconfig_rollout"
+	MconfigBaselineEntityType = "mconfig_baseline"
 )
 
 // K8s
@@ -48,6 +51,7 @@ const (
 	AnnotationFieldSeparator = ","
 
 	AnalyticsCollectorLabel = "orc8r.io/analytics_collector"
+	EntitySecretsLabel      = "orc8r.io/entity_secrets"
 	MconfigBuilderLabel     = "orc8r.io/mconfig_builder"
 	MetricsExporterLabel    = "orc8r.io/metrics_exporter"
 	ObsidianHandlersLabel   = "orc8r.io/obsidian_handlers"
@@ -55,6 +59,7 @@ const (
 	StreamProviderLabel     = "orc8r.io/stream_provider"
 	SwaggerSpecLabel        = "orc8r.io/swagger_spec"
 
+	EntitySecretsTypesAnnotation           = "orc8r.io/entity_secrets_types"
 	ObsidianHandlersPathPrefixesAnnotation = "orc8r.io/obsidian_handlers_path_prefixes"
 	StateIndexerVersionAnnotation          = "orc8r.io/state_indexer_version"
 	StateIndexerTypesAnnotation            = "orc8r.io/state_indexer_types"
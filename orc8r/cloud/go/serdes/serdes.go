@@ -16,6 +16,7 @@ package serdes
 import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/serde"
+	rollout_types "magma/orc8r/cloud/go/services/configurator/rollout/types"
 	ctraced_models "magma/orc8r/cloud/go/services/ctraced/obsidian/models"
 	directoryd_types "magma/orc8r/cloud/go/services/directoryd/types"
 	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
@@ -28,10 +29,12 @@ const (
 
 var (
 	// Network contains the base orc8r serdes for configurator network configs
-	Network = models.NetworkSerdes
+	Network = models.NetworkSerdes.
+		MustMerge(rollout_types.NetworkSerdes)
 	// Entity contains the base orc8r serdes for configurator network entities
 	Entity = models.EntitySerdes.
-		MustMerge(ctraced_models.EntitySerdes)
+		MustMerge(ctraced_models.EntitySerdes).
+		MustMerge(rollout_types.EntitySerdes)
 	// State contains the base orc8r serdes for the state service
 	State = serde.NewRegistry(
 		state.NewStateSerde(orc8r.GatewayStateType, &models.GatewayStatus{}),
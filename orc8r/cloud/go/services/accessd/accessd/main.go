@@ -16,6 +16,8 @@ limitations under the License.
 package main
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
@@ -41,11 +43,16 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Failed to connect to database: %s", err)
 	}
-	fact := blobstore.NewEntStorage(storage.AccessdTableBlobstore, db, sqorc.GetSqlBuilder())
+	serviceConfig := accessd.GetServiceConfig()
+	retention := serviceConfig.GetBlobHistoryRetention()
+	fact := blobstore.NewEntStorageWithHistory(storage.AccessdTableBlobstore, db, sqorc.GetSqlBuilder(), retention)
 	err = fact.InitializeFactory()
 	if err != nil {
 		glog.Fatalf("Error initializing accessd database: %s", err)
 	}
+	if retention > 0 {
+		go blobstore.RunHistoryPruner(fact, time.Hour)
+	}
 	store := storage.NewAccessdBlobstore(fact)
 
 	// Add servicers to the service
@@ -191,3 +191,43 @@ func ListOperators(ctx context.Context) ([]*protos.Identity, error) {
 	}
 	return opslist.List, nil
 }
+
+// ListOperatorACLHistory returns the previous versions of the operator's
+// ACL, most recently replaced first
+func ListOperatorACLHistory(ctx context.Context, operator *protos.Identity) ([]*accessprotos.AccessControl_ListVersion, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListOperatorACLHistory(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Versions, nil
+}
+
+// GetOperatorACLAtVersion returns the operator's ACL as it was at the passed
+// version
+func GetOperatorACLAtVersion(ctx context.Context, operator *protos.Identity, version uint64) (*accessprotos.AccessControl_List, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetOperatorACLAtVersion(ctx, &accessprotos.AccessControl_VersionRequest{Operator: operator, Version: version})
+}
+
+// RestoreOperatorACL restores the operator's ACL to the passed version,
+// undoing any later changes or its deletion
+func RestoreOperatorACL(ctx context.Context, operator *protos.Identity, version uint64) error {
+	client, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RestoreOperatorACL(ctx, &accessprotos.AccessControl_VersionRequest{Operator: operator, Version: version})
+	if err != nil {
+		errMsg := fmt.Sprintf("Restore Permissions for Operator %s error: %s", operator.HashString(), err)
+		glog.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accessd
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/lib/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+type Config struct {
+	// BlobHistoryRetentionHours is how long previous versions of operators'
+	// access control lists are kept after they're updated or deleted.
+	// 0 keeps them indefinitely.
+	BlobHistoryRetentionHours uint `yaml:"blobHistoryRetentionHours"`
+}
+
+func GetServiceConfig() Config {
+	var serviceConfig Config
+	_, _, err := config.GetStructuredServiceConfig(orc8r.ModuleName, ServiceName, &serviceConfig)
+	if err != nil {
+		glog.Fatalf("Failed parsing the accessd config file: %v ", err)
+	}
+	return serviceConfig
+}
+
+// GetBlobHistoryRetention returns how long previous versions of blobs are
+// kept, or 0 if they're kept indefinitely.
+func (c Config) GetBlobHistoryRetention() time.Duration {
+	return time.Duration(c.BlobHistoryRetentionHours) * time.Hour
+}
@@ -322,6 +322,163 @@ func (m *AccessControl_Lists) GetAcls() []*AccessControl_List {
 	return nil
 }
 
+// RPC Request used to get or restore a previous version of an Operator's
+// ACL
+type AccessControl_VersionRequest struct {
+	Operator             *protos.Identity `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Version              uint64           `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AccessControl_VersionRequest) Reset()         { *m = AccessControl_VersionRequest{} }
+func (m *AccessControl_VersionRequest) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_VersionRequest) ProtoMessage()    {}
+func (*AccessControl_VersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{0, 5}
+}
+
+func (m *AccessControl_VersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_VersionRequest.Unmarshal(m, b)
+}
+func (m *AccessControl_VersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_VersionRequest.Marshal(b, m, deterministic)
+}
+func (m *AccessControl_VersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_VersionRequest.Merge(m, src)
+}
+func (m *AccessControl_VersionRequest) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_VersionRequest.Size(m)
+}
+func (m *AccessControl_VersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_VersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_VersionRequest proto.InternalMessageInfo
+
+func (m *AccessControl_VersionRequest) GetOperator() *protos.Identity {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AccessControl_VersionRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// Previous version of an Operator's ACL, which was replaced by a newer
+// version or deleted
+type AccessControl_ListVersion struct {
+	Acl     *AccessControl_List `protobuf:"bytes,1,opt,name=acl,proto3" json:"acl,omitempty"`
+	Version uint64              `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Set if the version was deleted, rather than replaced
+	Deleted bool `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Time the version was replaced or deleted, in milliseconds since
+	// the epoch
+	ReplacedAtMs         int64    `protobuf:"varint,4,opt,name=replaced_at_ms,json=replacedAtMs,proto3" json:"replaced_at_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessControl_ListVersion) Reset()         { *m = AccessControl_ListVersion{} }
+func (m *AccessControl_ListVersion) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_ListVersion) ProtoMessage()    {}
+func (*AccessControl_ListVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{0, 6}
+}
+
+func (m *AccessControl_ListVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_ListVersion.Unmarshal(m, b)
+}
+func (m *AccessControl_ListVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_ListVersion.Marshal(b, m, deterministic)
+}
+func (m *AccessControl_ListVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_ListVersion.Merge(m, src)
+}
+func (m *AccessControl_ListVersion) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_ListVersion.Size(m)
+}
+func (m *AccessControl_ListVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_ListVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_ListVersion proto.InternalMessageInfo
+
+func (m *AccessControl_ListVersion) GetAcl() *AccessControl_List {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *AccessControl_ListVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AccessControl_ListVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *AccessControl_ListVersion) GetReplacedAtMs() int64 {
+	if m != nil {
+		return m.ReplacedAtMs
+	}
+	return 0
+}
+
+type AccessControl_History struct {
+	// Most recently replaced first
+	Versions             []*AccessControl_ListVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *AccessControl_History) Reset()         { *m = AccessControl_History{} }
+func (m *AccessControl_History) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_History) ProtoMessage()    {}
+func (*AccessControl_History) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{0, 7}
+}
+
+func (m *AccessControl_History) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_History.Unmarshal(m, b)
+}
+func (m *AccessControl_History) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_History.Marshal(b, m, deterministic)
+}
+func (m *AccessControl_History) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_History.Merge(m, src)
+}
+func (m *AccessControl_History) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_History.Size(m)
+}
+func (m *AccessControl_History) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_History.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_History proto.InternalMessageInfo
+
+func (m *AccessControl_History) GetVersions() []*AccessControl_ListVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.accessd.AccessControl_Permission", AccessControl_Permission_name, AccessControl_Permission_value)
 	proto.RegisterType((*AccessControl)(nil), "magma.orc8r.accessd.AccessControl")
@@ -331,46 +488,58 @@ func init() {
 	proto.RegisterType((*AccessControl_ListRequest)(nil), "magma.orc8r.accessd.AccessControl.ListRequest")
 	proto.RegisterType((*AccessControl_PermissionsRequest)(nil), "magma.orc8r.accessd.AccessControl.PermissionsRequest")
 	proto.RegisterType((*AccessControl_Lists)(nil), "magma.orc8r.accessd.AccessControl.Lists")
+	proto.RegisterType((*AccessControl_VersionRequest)(nil), "magma.orc8r.accessd.AccessControl.VersionRequest")
+	proto.RegisterType((*AccessControl_ListVersion)(nil), "magma.orc8r.accessd.AccessControl.ListVersion")
+	proto.RegisterType((*AccessControl_History)(nil), "magma.orc8r.accessd.AccessControl.History")
 }
 
 func init() { proto.RegisterFile("access.proto", fileDescriptor_a098e900d2c3a6f2) }
 
 var fileDescriptor_a098e900d2c3a6f2 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xe1, 0x6b, 0xd3, 0x40,
-	0x14, 0x4f, 0xba, 0xb5, 0x74, 0x2f, 0x6b, 0x89, 0x4f, 0x85, 0x7a, 0x7e, 0x19, 0x01, 0xb1, 0x22,
-	0xcb, 0xb0, 0x32, 0x18, 0x53, 0xd0, 0xda, 0x06, 0x19, 0xd4, 0x55, 0x4f, 0xe7, 0x60, 0xdf, 0x62,
-	0x72, 0xce, 0xb0, 0x26, 0x57, 0x73, 0xb7, 0x41, 0xbf, 0xf9, 0xcd, 0x7f, 0xd8, 0x8f, 0x22, 0x92,
-	0xbb, 0xac, 0x4d, 0x58, 0x37, 0x52, 0xdd, 0xa7, 0x5c, 0xee, 0xfd, 0x7e, 0xbf, 0xf7, 0x7b, 0xef,
-	0x5d, 0x2e, 0xb0, 0xe9, 0x07, 0x01, 0x13, 0xc2, 0x9d, 0xa6, 0x5c, 0x72, 0xbc, 0x1b, 0xfb, 0xa7,
-	0xb1, 0xef, 0xf2, 0x34, 0xd8, 0x4b, 0x5d, 0x1d, 0x09, 0xc9, 0x03, 0xf5, 0xba, 0xa3, 0x10, 0x62,
-	0x27, 0xe0, 0x71, 0xcc, 0x13, 0x8d, 0x27, 0x0f, 0x4b, 0xa1, 0x28, 0x64, 0x89, 0x8c, 0xe4, 0x4c,
-	0x07, 0x9d, 0x3f, 0x75, 0x68, 0xf5, 0x95, 0xc6, 0x80, 0x27, 0x32, 0xe5, 0x13, 0xf2, 0xc3, 0x84,
-	0x86, 0xa7, 0x20, 0xf8, 0x08, 0x6a, 0x51, 0xd8, 0x31, 0xb7, 0xcc, 0xae, 0xd5, 0xbb, 0xef, 0x16,
-	0xd3, 0x1e, 0xe4, 0x2a, 0xb4, 0x16, 0x85, 0x38, 0x06, 0x6b, 0xca, 0xd2, 0x38, 0x12, 0x22, 0xe2,
-	0x89, 0xe8, 0xd4, 0xb6, 0xcc, 0x6e, 0xbb, 0xb7, 0xed, 0x2e, 0xb1, 0xe9, 0x96, 0x52, 0xb9, 0xef,
-	0xe7, 0x2c, 0x5a, 0x54, 0x20, 0xbf, 0x4c, 0x58, 0x1f, 0x45, 0x42, 0xe2, 0x33, 0x68, 0xf2, 0x29,
-	0x4b, 0x7d, 0xc9, 0xd3, 0x9b, 0x6d, 0xcc, 0x61, 0xf8, 0x01, 0x9a, 0x6a, 0x2f, 0x62, 0x99, 0x93,
-	0xb5, 0xae, 0xd5, 0xdb, 0xad, 0xe0, 0x24, 0xcb, 0xe6, 0x7a, 0x39, 0xcf, 0x4b, 0x64, 0x3a, 0xa3,
-	0x73, 0x19, 0xf2, 0x15, 0x5a, 0xa5, 0x10, 0xda, 0xb0, 0x76, 0xc6, 0x66, 0xca, 0xd1, 0x06, 0xcd,
-	0x96, 0xf8, 0x0a, 0xea, 0x17, 0xfe, 0xe4, 0x9c, 0xa9, 0xe2, 0xad, 0xde, 0x93, 0x0a, 0x29, 0x75,
-	0x8f, 0xa9, 0xe6, 0xed, 0xd7, 0xf6, 0x4c, 0xf2, 0xd3, 0x04, 0x2b, 0x33, 0x42, 0xd9, 0xf7, 0x73,
-	0xf6, 0x6f, 0xd5, 0x7b, 0x57, 0xaa, 0x5f, 0xc1, 0xca, 0xa2, 0xe2, 0x0b, 0xc0, 0xc5, 0x6c, 0xc4,
-	0x7f, 0xf8, 0xd9, 0x86, 0x86, 0xde, 0xcb, 0x1b, 0x73, 0x0d, 0x21, 0x07, 0x91, 0x21, 0xd4, 0xb3,
-	0x06, 0x08, 0x7c, 0x01, 0xeb, 0x7e, 0x30, 0x11, 0x1d, 0x53, 0xd5, 0xf0, 0xb8, 0xe2, 0x04, 0xa9,
-	0x22, 0x39, 0x4f, 0x01, 0x16, 0xee, 0xb1, 0x09, 0xeb, 0x87, 0xe3, 0x43, 0xcf, 0x36, 0xb2, 0x15,
-	0xf5, 0xfa, 0x43, 0xdb, 0xc4, 0x0d, 0xa8, 0x1f, 0xd3, 0x83, 0x4f, 0x9e, 0x5d, 0xeb, 0xfd, 0xae,
-	0xc3, 0xbd, 0x92, 0xd2, 0x3b, 0x3f, 0xf1, 0x4f, 0x59, 0x8a, 0x14, 0xac, 0x8f, 0x4c, 0x8e, 0x2f,
-	0x2b, 0x71, 0xab, 0x7a, 0xd0, 0xcd, 0x22, 0x77, 0x4a, 0xf8, 0xcf, 0x3c, 0x0a, 0x1d, 0x03, 0x8f,
-	0xa0, 0x7d, 0x34, 0x0d, 0x7d, 0xc9, 0x6e, 0x57, 0xf6, 0x25, 0xb4, 0x87, 0x6c, 0xc2, 0x0a, 0xb2,
-	0xcb, 0xfb, 0xbc, 0x9c, 0x4d, 0xa1, 0xfd, 0x76, 0x51, 0x68, 0x7f, 0x30, 0xba, 0x8e, 0x5d, 0x75,
-	0x0c, 0x8e, 0x81, 0x27, 0x60, 0x17, 0x34, 0x45, 0x7f, 0x30, 0x12, 0x48, 0x96, 0xaa, 0x2a, 0x06,
-	0xe9, 0x56, 0x94, 0x16, 0x8e, 0x81, 0x52, 0xf9, 0x2d, 0x9c, 0x4f, 0xdc, 0x5d, 0xe9, 0xae, 0xb9,
-	0x3c, 0xcf, 0xa4, 0xfa, 0xa7, 0xe1, 0x18, 0x78, 0x0c, 0xf6, 0xe0, 0x1b, 0x0b, 0xce, 0x8a, 0x79,
-	0x6f, 0x65, 0x78, 0xaf, 0xa1, 0x95, 0x61, 0xe6, 0xbd, 0xc2, 0xab, 0x28, 0x72, 0x43, 0xeb, 0x1c,
-	0x03, 0xf7, 0x61, 0x53, 0x8f, 0x3f, 0xbf, 0xb6, 0x57, 0x18, 0xfe, 0x9b, 0xe6, 0x49, 0x43, 0xff,
-	0x18, 0xbe, 0xe8, 0xe7, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x04, 0xce, 0x33, 0x6d,
-	0x06, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x6d, 0x4f, 0xd3, 0x50,
+	0x14, 0x6e, 0xb7, 0x01, 0xe5, 0x0c, 0x96, 0x79, 0x7d, 0xab, 0xd7, 0x2f, 0x4b, 0xa3, 0x71, 0x6a,
+	0x28, 0x61, 0x86, 0x04, 0xd1, 0x44, 0xeb, 0x68, 0x14, 0x33, 0x40, 0xaf, 0x02, 0x09, 0x91, 0x90,
+	0xda, 0x5e, 0xb1, 0x61, 0x5b, 0xe7, 0xbd, 0x17, 0xcc, 0xbe, 0xf9, 0xcd, 0xff, 0xe1, 0x4f, 0xf0,
+	0xdf, 0xf8, 0x3f, 0xfc, 0x01, 0xa6, 0xb7, 0x2f, 0x6b, 0xc3, 0x20, 0xdd, 0xe4, 0xd3, 0x7a, 0xef,
+	0x39, 0xcf, 0x73, 0x9e, 0xf3, 0xd2, 0xb3, 0xc2, 0x82, 0xe3, 0xba, 0x94, 0x73, 0x73, 0xc0, 0x02,
+	0x11, 0xa0, 0xeb, 0x3d, 0xe7, 0xb8, 0xe7, 0x98, 0x01, 0x73, 0xd7, 0x98, 0x19, 0x59, 0x3c, 0x7c,
+	0x47, 0x1e, 0x97, 0xa5, 0x07, 0x5f, 0x76, 0x83, 0x5e, 0x2f, 0xe8, 0x47, 0xfe, 0xf8, 0x6e, 0xce,
+	0xe4, 0x7b, 0xb4, 0x2f, 0x7c, 0x31, 0x8c, 0x8c, 0xc6, 0x1f, 0x0d, 0x16, 0x2d, 0xc9, 0xd1, 0x0e,
+	0xfa, 0x82, 0x05, 0x5d, 0xfc, 0x43, 0x85, 0x59, 0x5b, 0xba, 0xa0, 0xfb, 0x50, 0xf2, 0x3d, 0x5d,
+	0x6d, 0xa8, 0xcd, 0x6a, 0xeb, 0xa6, 0x99, 0x0d, 0xbb, 0x19, 0xb3, 0x90, 0x92, 0xef, 0xa1, 0x1d,
+	0xa8, 0x0e, 0x28, 0xeb, 0xf9, 0x9c, 0xfb, 0x41, 0x9f, 0xeb, 0xa5, 0x86, 0xda, 0xac, 0xb5, 0x96,
+	0xcc, 0x31, 0x32, 0xcd, 0x5c, 0x28, 0xf3, 0x5d, 0x8a, 0x22, 0x59, 0x06, 0xfc, 0x57, 0x85, 0x4a,
+	0xc7, 0xe7, 0x02, 0xad, 0x80, 0x16, 0x0c, 0x28, 0x73, 0x44, 0xc0, 0x2e, 0x97, 0x91, 0xba, 0xa1,
+	0xf7, 0xa0, 0xc9, 0x3b, 0x9f, 0x86, 0x4a, 0xca, 0xcd, 0x6a, 0x6b, 0xb5, 0x80, 0x92, 0x30, 0x9a,
+	0x69, 0xc7, 0x38, 0xbb, 0x2f, 0xd8, 0x90, 0xa4, 0x34, 0xf8, 0x0b, 0x2c, 0xe6, 0x4c, 0xa8, 0x0e,
+	0xe5, 0x13, 0x3a, 0x94, 0x8a, 0xe6, 0x49, 0xf8, 0x88, 0x5e, 0xc0, 0xcc, 0x99, 0xd3, 0x3d, 0xa5,
+	0x32, 0xf9, 0x6a, 0xeb, 0x61, 0x81, 0x90, 0x51, 0x8d, 0x49, 0x84, 0x5b, 0x2f, 0xad, 0xa9, 0xf8,
+	0xa7, 0x0a, 0xd5, 0x50, 0x08, 0xa1, 0xdf, 0x4e, 0xe9, 0x74, 0xd9, 0xdb, 0xe7, 0xb2, 0x9f, 0x40,
+	0xca, 0x28, 0xe3, 0x33, 0x40, 0xa3, 0xde, 0xf0, 0xff, 0xd0, 0xb3, 0x04, 0xb3, 0xd1, 0x9d, 0x5e,
+	0xba, 0x0c, 0x10, 0x3b, 0xe1, 0x0d, 0x98, 0x09, 0x0b, 0xc0, 0xd1, 0x33, 0xa8, 0x38, 0x6e, 0x97,
+	0xeb, 0xaa, 0xcc, 0xe1, 0x41, 0xc1, 0x0e, 0x12, 0x09, 0xc2, 0x87, 0x50, 0xdb, 0xa3, 0x4c, 0x8e,
+	0xd5, 0xf4, 0xca, 0x75, 0x98, 0x3b, 0x8b, 0x48, 0xa4, 0xf4, 0x0a, 0x49, 0x8e, 0xf8, 0x57, 0xdc,
+	0xa6, 0x38, 0x06, 0x7a, 0x0a, 0x65, 0xc7, 0xed, 0xc6, 0xbc, 0x85, 0xa5, 0x86, 0x98, 0x8b, 0x83,
+	0x84, 0x16, 0x8f, 0x76, 0xa9, 0xa0, 0x9e, 0x5e, 0x6e, 0xa8, 0x4d, 0x8d, 0x24, 0x47, 0x74, 0x0f,
+	0x6a, 0x8c, 0x0e, 0xba, 0x8e, 0x4b, 0xbd, 0x23, 0x47, 0x1c, 0xf5, 0xb8, 0x5e, 0x69, 0xa8, 0xcd,
+	0x32, 0x59, 0x48, 0x6e, 0x2d, 0xb1, 0xc5, 0xf1, 0x2e, 0xcc, 0xbd, 0xf1, 0xb9, 0x08, 0xd8, 0x10,
+	0xbd, 0x05, 0x2d, 0x66, 0x4d, 0xea, 0x69, 0x16, 0x14, 0x99, 0x54, 0x31, 0xc5, 0x1b, 0x8f, 0x01,
+	0x46, 0x83, 0x81, 0x34, 0xa8, 0x6c, 0xef, 0x6c, 0xdb, 0x75, 0x25, 0x7c, 0x22, 0xb6, 0xb5, 0x51,
+	0x57, 0xd1, 0x3c, 0xcc, 0xec, 0x93, 0xcd, 0x8f, 0x76, 0xbd, 0xd4, 0xfa, 0xad, 0xc1, 0x8d, 0x1c,
+	0xe9, 0x96, 0xd3, 0x77, 0x8e, 0x29, 0x43, 0x04, 0xaa, 0x1f, 0xa8, 0xd8, 0x49, 0x4a, 0x5d, 0x54,
+	0x4e, 0xdc, 0x4d, 0x7c, 0x2d, 0xe7, 0xbf, 0x17, 0xf8, 0x9e, 0xa1, 0xa0, 0x5d, 0xa8, 0xed, 0x0e,
+	0x3c, 0x47, 0xd0, 0xab, 0xa5, 0x7d, 0x0e, 0xb5, 0x0d, 0x59, 0xf8, 0x94, 0x76, 0xfc, 0xe4, 0x8c,
+	0x47, 0x13, 0xa8, 0xbd, 0x1e, 0x25, 0x6a, 0xb5, 0x3b, 0x17, 0xa1, 0x8b, 0x8e, 0x8d, 0xa1, 0xa0,
+	0x03, 0xa8, 0x67, 0x38, 0xb9, 0xd5, 0xee, 0x70, 0x84, 0xc7, 0xb2, 0x4a, 0x04, 0x6e, 0x16, 0xa4,
+	0xe6, 0x86, 0x82, 0x84, 0xd4, 0x9b, 0x79, 0xf5, 0xd1, 0xea, 0x44, 0x6b, 0x3c, 0x59, 0x15, 0xb8,
+	0xf8, 0xd6, 0x31, 0x14, 0xb4, 0x0f, 0xf5, 0xf6, 0x57, 0xea, 0x9e, 0x64, 0xe3, 0x5e, 0x49, 0xf3,
+	0x5e, 0xc2, 0x62, 0xe8, 0x93, 0xd6, 0x0a, 0x9d, 0xf7, 0xc2, 0x97, 0x94, 0xce, 0x50, 0xd0, 0x3a,
+	0x2c, 0x44, 0xed, 0x8f, 0xff, 0x11, 0x27, 0x69, 0xfe, 0x21, 0xdc, 0xca, 0x46, 0xb7, 0xda, 0x9d,
+	0xe4, 0x8d, 0xbc, 0x80, 0xe5, 0x51, 0x81, 0x9c, 0x63, 0x0a, 0x43, 0x41, 0xdf, 0xe1, 0x76, 0x7e,
+	0xb6, 0xac, 0x74, 0x23, 0xad, 0x14, 0x20, 0xca, 0x6f, 0xc8, 0x49, 0x06, 0xf0, 0x13, 0x20, 0x42,
+	0x43, 0x15, 0x34, 0x3b, 0xd8, 0x53, 0xc4, 0x1c, 0x57, 0xb5, 0x57, 0xda, 0xc1, 0x6c, 0xf4, 0xa5,
+	0xf2, 0x39, 0xfa, 0x7d, 0xf2, 0x6f, 0x00, 0xb4, 0x63, 0xd4, 0x6e, 0xfe, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -413,6 +582,15 @@ type AccessControlManagerClient interface {
 	ListOperators(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the previous versions of the Operator's ACL, which are kept
+	// for the configured retention period after they're replaced or deleted
+	ListOperatorACLHistory(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_History, error)
+	// Returns the Operator's ACL as it was at the given version
+	GetOperatorACLAtVersion(ctx context.Context, in *AccessControl_VersionRequest, opts ...grpc.CallOption) (*AccessControl_List, error)
+	// Restores the Operator's ACL to the given version, undoing any later
+	// changes or its deletion. The restored ACL gets a new version, so the
+	// restore can itself be undone.
+	RestoreOperatorACL(ctx context.Context, in *AccessControl_VersionRequest, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type accessControlManagerClient struct {
@@ -504,6 +682,33 @@ func (c *accessControlManagerClient) DeleteEntity(ctx context.Context, in *proto
 	return out, nil
 }
 
+func (c *accessControlManagerClient) ListOperatorACLHistory(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_History, error) {
+	out := new(AccessControl_History)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/ListOperatorACLHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) GetOperatorACLAtVersion(ctx context.Context, in *AccessControl_VersionRequest, opts ...grpc.CallOption) (*AccessControl_List, error) {
+	out := new(AccessControl_List)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/GetOperatorACLAtVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) RestoreOperatorACL(ctx context.Context, in *AccessControl_VersionRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/RestoreOperatorACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessControlManagerServer is the server API for AccessControlManager service.
 type AccessControlManagerServer interface {
 	// Overwrites Permissions for operator Identity to manage others
@@ -534,6 +739,15 @@ type AccessControlManagerServer interface {
 	ListOperators(context.Context, *protos.Void) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(context.Context, *protos.Identity) (*protos.Void, error)
+	// Returns the previous versions of the Operator's ACL, which are kept
+	// for the configured retention period after they're replaced or deleted
+	ListOperatorACLHistory(context.Context, *protos.Identity) (*AccessControl_History, error)
+	// Returns the Operator's ACL as it was at the given version
+	GetOperatorACLAtVersion(context.Context, *AccessControl_VersionRequest) (*AccessControl_List, error)
+	// Restores the Operator's ACL to the given version, undoing any later
+	// changes or its deletion. The restored ACL gets a new version, so the
+	// restore can itself be undone.
+	RestoreOperatorACL(context.Context, *AccessControl_VersionRequest) (*protos.Void, error)
 }
 
 // UnimplementedAccessControlManagerServer can be embedded to have forward compatible implementations.
@@ -567,6 +781,15 @@ func (*UnimplementedAccessControlManagerServer) ListOperators(ctx context.Contex
 func (*UnimplementedAccessControlManagerServer) DeleteEntity(ctx context.Context, req *protos.Identity) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntity not implemented")
 }
+func (*UnimplementedAccessControlManagerServer) ListOperatorACLHistory(ctx context.Context, req *protos.Identity) (*AccessControl_History, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperatorACLHistory not implemented")
+}
+func (*UnimplementedAccessControlManagerServer) GetOperatorACLAtVersion(ctx context.Context, req *AccessControl_VersionRequest) (*AccessControl_List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorACLAtVersion not implemented")
+}
+func (*UnimplementedAccessControlManagerServer) RestoreOperatorACL(ctx context.Context, req *AccessControl_VersionRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOperatorACL not implemented")
+}
 
 func RegisterAccessControlManagerServer(s *grpc.Server, srv AccessControlManagerServer) {
 	s.RegisterService(&_AccessControlManager_serviceDesc, srv)
@@ -734,6 +957,60 @@ func _AccessControlManager_DeleteEntity_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessControlManager_ListOperatorACLHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).ListOperatorACLHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/ListOperatorACLHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).ListOperatorACLHistory(ctx, req.(*protos.Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_GetOperatorACLAtVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).GetOperatorACLAtVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/GetOperatorACLAtVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).GetOperatorACLAtVersion(ctx, req.(*AccessControl_VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_RestoreOperatorACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).RestoreOperatorACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/RestoreOperatorACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).RestoreOperatorACL(ctx, req.(*AccessControl_VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.accessd.AccessControlManager",
 	HandlerType: (*AccessControlManagerServer)(nil),
@@ -774,6 +1051,18 @@ var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntity",
 			Handler:    _AccessControlManager_DeleteEntity_Handler,
 		},
+		{
+			MethodName: "ListOperatorACLHistory",
+			Handler:    _AccessControlManager_ListOperatorACLHistory_Handler,
+		},
+		{
+			MethodName: "GetOperatorACLAtVersion",
+			Handler:    _AccessControlManager_GetOperatorACLAtVersion_Handler,
+		},
+		{
+			MethodName: "RestoreOperatorACL",
+			Handler:    _AccessControlManager_RestoreOperatorACL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "access.proto",
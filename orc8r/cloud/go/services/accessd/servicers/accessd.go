@@ -142,6 +142,26 @@ func (srv *AccessControlServer) ListOperators(ctx context.Context, _ *protos.Voi
 	return res, err
 }
 
+// ListOperatorACLHistory returns the previous versions of the operator's ACL
+func (srv *AccessControlServer) ListOperatorACLHistory(ctx context.Context, oper *protos.Identity) (*accessprotos.AccessControl_History, error) {
+	versions, err := srv.store.ListACLHistory(oper)
+	if err != nil {
+		return nil, err
+	}
+	return &accessprotos.AccessControl_History{Versions: versions}, nil
+}
+
+// GetOperatorACLAtVersion returns the operator's ACL as it was at the
+// requested version
+func (srv *AccessControlServer) GetOperatorACLAtVersion(ctx context.Context, req *accessprotos.AccessControl_VersionRequest) (*accessprotos.AccessControl_List, error) {
+	return srv.store.GetACLAtVersion(req.GetOperator(), req.GetVersion())
+}
+
+// RestoreOperatorACL restores the operator's ACL to the requested version
+func (srv *AccessControlServer) RestoreOperatorACL(ctx context.Context, req *accessprotos.AccessControl_VersionRequest) (*protos.Void, error) {
+	return &protos.Void{}, srv.store.RestoreACL(req.GetOperator(), req.GetVersion())
+}
+
 // Cleanup a given entity from all Operators' ACLs
 // TBD: This needs to be implemented to avoid security venerability when deleting
 //      a network with customer selected ID (vs. generated by the cloud ID)
@@ -40,4 +40,19 @@ type AccessdStorage interface {
 
 	// DeleteACL removes the ACL associated with the passed identity.
 	DeleteACL(id *protos.Identity) error
+
+	// ListACLHistory returns the previous versions of the identity's ACL,
+	// most recently replaced first.
+	// If ACL history isn't enabled, returns wrapped codes.FailedPrecondition.
+	ListACLHistory(id *protos.Identity) ([]*accessprotos.AccessControl_ListVersion, error)
+
+	// GetACLAtVersion returns the identity's ACL as it was at the passed
+	// version.
+	// If the version is unknown, returns wrapped codes.NotFound.
+	GetACLAtVersion(id *protos.Identity, version uint64) (*accessprotos.AccessControl_List, error)
+
+	// RestoreACL restores the identity's ACL to the passed version, undoing
+	// any later changes or its deletion.
+	// If the version is unknown, returns wrapped codes.NotFound.
+	RestoreACL(id *protos.Identity, version uint64) error
 }
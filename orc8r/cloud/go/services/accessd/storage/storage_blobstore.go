@@ -14,9 +14,12 @@ limitations under the License.
 package storage
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/blobstore"
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
 	"magma/orc8r/cloud/go/storage"
+	merrors "magma/orc8r/lib/go/errors"
 	"magma/orc8r/lib/go/protos"
 
 	"github.com/golang/protobuf/proto"
@@ -241,3 +244,119 @@ func (a *accessdBlobstore) DeleteACL(id *protos.Identity) error {
 	}
 	return nil
 }
+
+func (a *accessdBlobstore) ListACLHistory(id *protos.Identity) ([]*accessprotos.AccessControl_ListVersion, error) {
+	if id == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil Identity")
+	}
+
+	store, err := a.startVersionedTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer store.Rollback()
+
+	tk := storage.TypeAndKey{Type: AccessdDefaultType, Key: id.HashString()}
+	blobs, err := store.ListHistory(placeholderNetworkID, tk)
+	if err != nil {
+		return nil, historyErrorToStatus(err, "failed to list acl history")
+	}
+
+	ret := make([]*accessprotos.AccessControl_ListVersion, 0, len(blobs))
+	for _, blob := range blobs {
+		acl := &accessprotos.AccessControl_List{}
+		err = proto.Unmarshal(blob.Value, acl)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal acl: %s", err)
+		}
+		ret = append(ret, &accessprotos.AccessControl_ListVersion{
+			Acl:          acl,
+			Version:      blob.Version,
+			Deleted:      blob.Deleted,
+			ReplacedAtMs: blob.ReplacedAt.UnixNano() / int64(time.Millisecond),
+		})
+	}
+
+	err = store.Commit()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to commit transaction: %s", err)
+	}
+	return ret, nil
+}
+
+func (a *accessdBlobstore) GetACLAtVersion(id *protos.Identity, version uint64) (*accessprotos.AccessControl_List, error) {
+	if id == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil Identity")
+	}
+
+	store, err := a.startVersionedTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer store.Rollback()
+
+	tk := storage.TypeAndKey{Type: AccessdDefaultType, Key: id.HashString()}
+	blob, err := store.GetAtVersion(placeholderNetworkID, tk, version)
+	if err != nil {
+		return nil, historyErrorToStatus(err, "failed to get acl at version")
+	}
+
+	acl := &accessprotos.AccessControl_List{}
+	err = proto.Unmarshal(blob.Value, acl)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal acl: %s", err)
+	}
+
+	err = store.Commit()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to commit transaction: %s", err)
+	}
+	return acl, nil
+}
+
+func (a *accessdBlobstore) RestoreACL(id *protos.Identity, version uint64) error {
+	if id == nil {
+		return status.Error(codes.InvalidArgument, "nil Identity")
+	}
+
+	store, err := a.startVersionedTransaction(&storage.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer store.Rollback()
+
+	tk := storage.TypeAndKey{Type: AccessdDefaultType, Key: id.HashString()}
+	err = store.Restore(placeholderNetworkID, tk, version)
+	if err != nil {
+		return historyErrorToStatus(err, "failed to restore acl")
+	}
+
+	err = store.Commit()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to commit transaction: %s", err)
+	}
+	return nil
+}
+
+func (a *accessdBlobstore) startVersionedTransaction(opts *storage.TxOptions) (blobstore.VersionedBlobStorage, error) {
+	factory, ok := a.factory.(blobstore.VersionedBlobStorageFactory)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "acl history is not enabled")
+	}
+	store, err := factory.StartVersionedTransaction(opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to start transaction: %s", err)
+	}
+	return store, nil
+}
+
+func historyErrorToStatus(err error, msg string) error {
+	switch err {
+	case merrors.ErrNotFound:
+		return status.Errorf(codes.NotFound, "%s: %s", msg, err)
+	case blobstore.ErrHistoryNotEnabled:
+		return status.Error(codes.FailedPrecondition, "acl history is not enabled")
+	default:
+		return status.Errorf(codes.Internal, "%s: %s", msg, err)
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestAccessdStorageBlobstore_Integation(t *testing.T) {
@@ -38,6 +40,59 @@ func TestAccessdStorageBlobstore_Integation(t *testing.T) {
 	testAccessdStorageImpl(t, store)
 }
 
+func TestAccessdStorageBlobstore_History(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	fact := blobstore.NewEntStorageWithHistory(storage.AccessdTableBlobstore, db, sqorc.GetSqlBuilder(), 0)
+	err = fact.InitializeFactory()
+	assert.NoError(t, err)
+	store := storage.NewAccessdBlobstore(fact)
+
+	op := identity.NewOperator("test_operator_0")
+	nw := identity.NewNetwork("test_network_0")
+	readACL := &accessprotos.AccessControl_List{
+		Operator: op,
+		Entities: map[string]*accessprotos.AccessControl_Entity{nw.HashString(): {Id: nw, Permissions: accessprotos.AccessControl_READ}},
+	}
+	writeACL := &accessprotos.AccessControl_List{
+		Operator: op,
+		Entities: map[string]*accessprotos.AccessControl_Entity{nw.HashString(): {Id: nw, Permissions: accessprotos.AccessControl_WRITE}},
+	}
+
+	assert.NoError(t, store.PutACL(op, readACL))
+	assert.NoError(t, store.PutACL(op, writeACL))
+	assert.NoError(t, store.DeleteACL(op))
+
+	versions, err := store.ListACLHistory(op)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].Deleted)
+	assert.True(t, proto.Equal(writeACL, versions[0].Acl))
+	assert.False(t, versions[1].Deleted)
+	assert.True(t, proto.Equal(readACL, versions[1].Acl))
+
+	aclRecvd, err := store.GetACLAtVersion(op, versions[1].Version)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(readACL, aclRecvd))
+
+	// Undo the deletion
+	assert.NoError(t, store.RestoreACL(op, versions[0].Version))
+	aclRecvd, err = store.GetACL(op)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(writeACL, aclRecvd))
+
+	_, err = store.GetACLAtVersion(op, 42)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = store.RestoreACL(op, 42)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// History isn't enabled for stores without it
+	fact = blobstore.NewEntStorage("other_table", db, sqorc.GetSqlBuilder()).(blobstore.VersionedBlobStorageFactory)
+	assert.NoError(t, fact.InitializeFactory())
+	_, err = storage.NewAccessdBlobstore(fact).ListACLHistory(op)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func testAccessdStorageImpl(t *testing.T, store storage.AccessdStorage) {
 	ids := []*protos.Identity{
 		identity.NewOperator("test_operator_0"),
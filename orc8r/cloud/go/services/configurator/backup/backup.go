@@ -0,0 +1,306 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package backup exports networks to portable, versioned archives, and
+// imports them back under a new or existing network ID.
+//
+// An archive holds the network's configs, every entity of the network, the
+// association graph between the entities, and the tenants the network
+// belongs to. Configs are kept serialized, so archives can be imported into
+// any deployment which runs the same plugins. Encrypted secrets of configs,
+// e.g. subscriber auth keys, are archived encrypted, so archives holding
+// them can only be imported into deployments with the same secrets keys.
+package backup
+
+import (
+	"context"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/services/tenants"
+	merrors "magma/orc8r/lib/go/errors"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// ArchiveVersion is the version of the archive format written by Export.
+// Import only accepts archives of this version.
+const ArchiveVersion = 1
+
+// ErrInvalidArchive is returned, wrapped, when an archive can't be
+// imported, either because it's malformed or because it conflicts with the
+// existing networks.
+var ErrInvalidArchive = storage.ErrInvalidNetworkArchive
+
+// Archive is a portable snapshot of a network.
+type Archive struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Network    Network   `json:"network"`
+	Entities   []Entity  `json:"entities"`
+	// Tenants the network belongs to
+	Tenants []Tenant `json:"tenants"`
+}
+
+// Network is an archived network.
+type Network struct {
+	ID          string `json:"id"`
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Configs maps config types to serialized configs
+	Configs map[string][]byte `json:"configs,omitempty"`
+}
+
+// Entity is an archived network entity.
+type Entity struct {
+	Type        string `json:"type"`
+	Key         string `json:"key"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	PhysicalID  string `json:"physical_id,omitempty"`
+	// Config is the serialized config of the entity
+	Config []byte `json:"config,omitempty"`
+	// Associations are the entities this entity is associated to
+	Associations []EntityID `json:"associations,omitempty"`
+}
+
+// EntityID identifies an archived entity.
+type EntityID struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+}
+
+// Tenant is an archived tenant.
+type Tenant struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ImportOptions configures an import.
+type ImportOptions struct {
+	// NetworkID is the ID of the network to import into. The network is
+	// created if it doesn't exist yet. Defaults to the archived network's ID.
+	// The archived network's ID is rewritten to NetworkID like IDRewrites.
+	NetworkID string
+	// IDRewrites maps archived IDs to the IDs to import them as, e.g. to
+	// import gateways under new IDs. The keys of the entities and of their
+	// associations, the physical IDs, and the string values of the configs
+	// which are exactly an archived ID are rewritten.
+	IDRewrites map[string]string
+	// ClearPhysicalIDs imports the entities without their physical IDs.
+	// Physical IDs are unique across networks, so they must be cleared when
+	// cloning a network within the same deployment.
+	ClearPhysicalIDs bool
+	// DryRun validates the import without applying it
+	DryRun bool
+	// RestoreTenants adds the network to the archived tenants, creating the
+	// tenants which don't exist. Tenants span networks, so this must only be
+	// set by operators allowed to manage every tenant of the deployment.
+	RestoreTenants bool
+}
+
+// ImportResult summarizes an import.
+type ImportResult struct {
+	NetworkID string `json:"network_id"`
+	// CreatedNetwork is true iff the network didn't exist before the import
+	CreatedNetwork bool `json:"created_network"`
+	Entities       int  `json:"entities"`
+	// Tenants the network was added to, empty unless RestoreTenants is set
+	Tenants []int64 `json:"tenants"`
+	DryRun  bool    `json:"dry_run"`
+}
+
+// Validate returns an error wrapping ErrInvalidArchive if the archive isn't
+// a well-formed archive of the current version.
+func (a *Archive) Validate() error {
+	if a.Version != ArchiveVersion {
+		return errors.Wrapf(ErrInvalidArchive, "unsupported archive version %d, expected %d", a.Version, ArchiveVersion)
+	}
+	if a.Network.ID == "" {
+		return errors.Wrap(ErrInvalidArchive, "archived network has no ID")
+	}
+	return nil
+}
+
+// Export snapshots a network, its entities, and the tenants it belongs to.
+// In-progress mconfig rollouts aren't archived: their state and the
+// gateways' mconfig baselines are specific to the exported deployment.
+// Returns ErrNotFound from magma/orc8r/lib/go/errors if the network doesn't
+// exist.
+func Export(ctx context.Context, networkID string) (*Archive, error) {
+	networkArchive, err := configurator.ExportNetwork(networkID)
+	if err != nil {
+		return nil, err
+	}
+	tenantList, err := tenants.GetAllTenants(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load tenants")
+	}
+
+	archive := &Archive{
+		Version:    ArchiveVersion,
+		ExportedAt: clock.Now().UTC(),
+		Network:    networkFromProto(networkArchive.Network),
+		Entities:   make([]Entity, 0, len(networkArchive.Entities)),
+		Tenants:    []Tenant{},
+	}
+	delete(archive.Network.Configs, orc8r.MconfigRolloutConfigType)
+	for _, ent := range networkArchive.Entities {
+		if ent.Type == orc8r.MconfigBaselineEntityType {
+			continue
+		}
+		archive.Entities = append(archive.Entities, entityFromProto(ent))
+	}
+	for _, tenant := range tenantList.Tenants {
+		if funk.ContainsString(tenant.Tenant.GetNetworks(), networkID) {
+			archive.Tenants = append(archive.Tenants, Tenant{ID: tenant.Id, Name: tenant.Tenant.GetName()})
+		}
+	}
+	return archive, nil
+}
+
+// Import recreates an archived network and its entities, and, if
+// opts.RestoreTenants is set, its tenant memberships.
+// The network and its entities are imported in a single transaction, but
+// tenants are only updated once it's committed.
+// Returns an error wrapping ErrInvalidArchive if the archive can't be
+// imported, including when this deployment can't decrypt the archived
+// secrets.
+func Import(ctx context.Context, archive *Archive, opts ImportOptions) (ImportResult, error) {
+	if err := archive.Validate(); err != nil {
+		return ImportResult{}, err
+	}
+	if err := checkSecrets(ctx, archive.Entities); err != nil {
+		return ImportResult{}, err
+	}
+	rewrites := map[string]string{}
+	for archivedID, newID := range opts.IDRewrites {
+		rewrites[archivedID] = newID
+	}
+	if opts.NetworkID != "" && opts.NetworkID != archive.Network.ID {
+		rewrites[archive.Network.ID] = opts.NetworkID
+	}
+	if len(rewrites) != 0 {
+		var err error
+		archive, err = rewriteIDs(archive, rewrites)
+		if err != nil {
+			return ImportResult{}, err
+		}
+	}
+
+	networkArchive := &storage.NetworkArchive{
+		Network:  networkToProto(archive.Network),
+		Entities: make([]*storage.NetworkEntity, 0, len(archive.Entities)),
+	}
+	for _, ent := range archive.Entities {
+		networkArchive.Entities = append(networkArchive.Entities, entityToProto(ent))
+	}
+	res, err := configurator.ImportNetworkWithContext(ctx, opts.NetworkID, networkArchive, opts.ClearPhysicalIDs, opts.DryRun)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	ret := ImportResult{
+		NetworkID:      res.NetworkID,
+		CreatedNetwork: res.CreatedNetwork,
+		Entities:       int(res.ImportedEntities),
+		Tenants:        []int64{},
+		DryRun:         opts.DryRun,
+	}
+	if !opts.RestoreTenants {
+		return ret, nil
+	}
+	for _, tenant := range archive.Tenants {
+		err = addNetworkToTenant(ctx, tenant, res.NetworkID, opts.DryRun)
+		if err != nil {
+			return ret, errors.Wrapf(err, "failed to add network %s to tenant %d", res.NetworkID, tenant.ID)
+		}
+		ret.Tenants = append(ret.Tenants, tenant.ID)
+	}
+	return ret, nil
+}
+
+func addNetworkToTenant(ctx context.Context, archivedTenant Tenant, networkID string, dryRun bool) error {
+	tenant, err := tenants.GetTenant(ctx, archivedTenant.ID)
+	if err == merrors.ErrNotFound {
+		if dryRun {
+			return nil
+		}
+		_, err = tenants.CreateTenant(ctx, archivedTenant.ID, &protos.Tenant{Name: archivedTenant.Name, Networks: []string{networkID}})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if dryRun || funk.ContainsString(tenant.Networks, networkID) {
+		return nil
+	}
+	tenant.Networks = append(tenant.Networks, networkID)
+	return tenants.SetTenant(ctx, archivedTenant.ID, *tenant)
+}
+
+func networkFromProto(network *storage.Network) Network {
+	return Network{
+		ID:          network.GetID(),
+		Type:        network.GetType(),
+		Name:        network.GetName(),
+		Description: network.GetDescription(),
+		Configs:     network.GetConfigs(),
+	}
+}
+
+func networkToProto(network Network) *storage.Network {
+	return &storage.Network{
+		ID:          network.ID,
+		Type:        network.Type,
+		Name:        network.Name,
+		Description: network.Description,
+		Configs:     network.Configs,
+	}
+}
+
+func entityFromProto(ent *storage.NetworkEntity) Entity {
+	ret := Entity{
+		Type:        ent.Type,
+		Key:         ent.Key,
+		Name:        ent.Name,
+		Description: ent.Description,
+		PhysicalID:  ent.PhysicalID,
+		Config:      ent.Config,
+	}
+	for _, assoc := range ent.Associations {
+		ret.Associations = append(ret.Associations, EntityID{Type: assoc.Type, Key: assoc.Key})
+	}
+	return ret
+}
+
+func entityToProto(ent Entity) *storage.NetworkEntity {
+	ret := &storage.NetworkEntity{
+		Type:        ent.Type,
+		Key:         ent.Key,
+		Name:        ent.Name,
+		Description: ent.Description,
+		PhysicalID:  ent.PhysicalID,
+		Config:      ent.Config,
+	}
+	for _, assoc := range ent.Associations {
+		ret.Associations = append(ret.Associations, &storage.EntityID{Type: assoc.Type, Key: assoc.Key})
+	}
+	return ret
+}
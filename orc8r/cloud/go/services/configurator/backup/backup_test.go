@@ -0,0 +1,211 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/backup"
+	backup_protos "magma/orc8r/cloud/go/services/configurator/backup/protos"
+	rollout_types "magma/orc8r/cloud/go/services/configurator/rollout/types"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/tenants"
+	tenants_test_init "magma/orc8r/cloud/go/services/tenants/test_init"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/test_utils"
+	merrors "magma/orc8r/lib/go/errors"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretHolderType = "secret_holder"
+
+func TestExport(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	tenants_test_init.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
+	defer clock.UnfreezeClock(t)
+	createLabNetwork(t)
+	// In-progress rollouts aren't archived
+	err := configurator.UpdateNetworkConfig("lab", orc8r.MconfigRolloutConfigType, &rollout_types.Rollout{State: rollout_types.StateInProgress}, serdes.Network)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntity("lab", configurator.NetworkEntity{Type: orc8r.MconfigBaselineEntityType, Key: "gw1", Config: &rollout_types.Baseline{Configs: &protos.GatewayConfigs{}}}, serdes.Entity)
+	require.NoError(t, err)
+
+	archive, err := backup.Export(context.Background(), "lab")
+	require.NoError(t, err)
+	assert.Equal(t, backup.ArchiveVersion, archive.Version)
+	assert.Equal(t, time.Unix(1000000, 0).UTC(), archive.ExportedAt)
+	assert.Equal(t, "lab", archive.Network.ID)
+	assert.Equal(t, "lab network", archive.Network.Name)
+	assert.Contains(t, archive.Network.Configs, orc8r.NetworkFeaturesConfig)
+	assert.NotContains(t, archive.Network.Configs, orc8r.MconfigRolloutConfigType)
+	expectedEntities := []backup.Entity{
+		{
+			Type:         orc8r.MagmadGatewayType,
+			Key:          "gw1",
+			PhysicalID:   "hw1",
+			Associations: []backup.EntityID{{Type: orc8r.UpgradeTierEntityType, Key: "default"}},
+		},
+		{Type: orc8r.UpgradeTierEntityType, Key: "default", Config: archive.Entities[1].Config},
+	}
+	assert.Equal(t, expectedEntities, archive.Entities)
+	assert.NotEmpty(t, archive.Entities[1].Config)
+	assert.Equal(t, []backup.Tenant{{ID: 1, Name: "lab_tenant"}}, archive.Tenants)
+
+	_, err = backup.Export(context.Background(), "missing")
+	assert.Equal(t, merrors.ErrNotFound, err)
+}
+
+func TestImport(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	tenants_test_init.StartTestService(t)
+	createLabNetwork(t)
+	archive, err := backup.Export(context.Background(), "lab")
+	require.NoError(t, err)
+
+	// Unsupported version
+	badArchive := *archive
+	badArchive.Version = 0
+	_, err = backup.Import(context.Background(), &badArchive, backup.ImportOptions{NetworkID: "staging"})
+	assert.Equal(t, backup.ErrInvalidArchive, errors.Cause(err))
+
+	// Physical IDs are unique across networks
+	_, err = backup.Import(context.Background(), archive, backup.ImportOptions{NetworkID: "staging"})
+	assert.Equal(t, backup.ErrInvalidArchive, errors.Cause(err))
+
+	// Dry run doesn't touch the network or its tenants
+	opts := backup.ImportOptions{NetworkID: "staging", ClearPhysicalIDs: true, RestoreTenants: true, DryRun: true}
+	res, err := backup.Import(context.Background(), archive, opts)
+	require.NoError(t, err)
+	assert.Equal(t, backup.ImportResult{NetworkID: "staging", CreatedNetwork: true, Entities: 2, Tenants: []int64{1}, DryRun: true}, res)
+	exists, err := configurator.DoesNetworkExist("staging")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assertTenantNetworks(t, 1, "lab")
+
+	// Tenant memberships are only restored on request
+	opts = backup.ImportOptions{NetworkID: "staging", ClearPhysicalIDs: true}
+	res, err = backup.Import(context.Background(), archive, opts)
+	require.NoError(t, err)
+	assert.Equal(t, backup.ImportResult{NetworkID: "staging", CreatedNetwork: true, Entities: 2, Tenants: []int64{}}, res)
+	assertTenantNetworks(t, 1, "lab")
+	gw, err := configurator.LoadEntity("staging", orc8r.MagmadGatewayType, "gw1", configurator.EntityLoadCriteria{LoadMetadata: true, LoadAssocsFromThis: true}, serdes.Entity)
+	require.NoError(t, err)
+	assert.Empty(t, gw.PhysicalID)
+	assert.Equal(t, []string{"default"}, gw.Associations.Filter(orc8r.UpgradeTierEntityType).Keys())
+
+	// Missing tenants are created, existing ones get the network added
+	err = tenants.DeleteTenant(context.Background(), 1)
+	require.NoError(t, err)
+	archive.Tenants = append(archive.Tenants, backup.Tenant{ID: 2, Name: "staging_tenant"})
+	_, err = tenants.CreateTenant(context.Background(), 2, &protos.Tenant{Name: "staging_tenant", Networks: []string{"other"}})
+	require.NoError(t, err)
+	opts = backup.ImportOptions{NetworkID: "prod", ClearPhysicalIDs: true, RestoreTenants: true}
+	res, err = backup.Import(context.Background(), archive, opts)
+	require.NoError(t, err)
+	assert.Equal(t, backup.ImportResult{NetworkID: "prod", CreatedNetwork: true, Entities: 2, Tenants: []int64{1, 2}}, res)
+	assertTenantNetworks(t, 1, "prod")
+	assertTenantNetworks(t, 2, "other", "prod")
+}
+
+func TestImport_Secrets(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	tenants_test_init.StartTestService(t)
+	startTestSecretsService(t)
+	archive := &backup.Archive{
+		Version: backup.ArchiveVersion,
+		Network: backup.Network{ID: "lab"},
+		Entities: []backup.Entity{
+			{Type: secretHolderType, Key: "readable", Config: []byte("readable")},
+			{Type: orc8r.UpgradeTierEntityType, Key: "unchecked", Config: []byte("other key")},
+		},
+	}
+
+	res, err := backup.Import(context.Background(), archive, backup.ImportOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Entities)
+
+	archive.Entities = append(archive.Entities, backup.Entity{Type: secretHolderType, Key: "unreadable", Config: []byte("other key")})
+	_, err = backup.Import(context.Background(), archive, backup.ImportOptions{DryRun: true})
+	assert.Equal(t, backup.ErrInvalidArchive, errors.Cause(err))
+	assert.Contains(t, err.Error(), "secret_holder unreadable: encrypted with another key")
+}
+
+func createLabNetwork(t *testing.T) {
+	network := configurator.Network{
+		ID:      "lab",
+		Name:    "lab network",
+		Configs: map[string]interface{}{orc8r.NetworkFeaturesConfig: models.NewDefaultFeaturesConfig()},
+	}
+	require.NoError(t, configurator.CreateNetwork(network, serdes.Network))
+	_, err := configurator.CreateEntities(
+		"lab",
+		configurator.NetworkEntities{
+			{Type: orc8r.UpgradeTierEntityType, Key: "default", Config: &models.Tier{Version: "1.0.0"}},
+			{
+				Type:         orc8r.MagmadGatewayType,
+				Key:          "gw1",
+				PhysicalID:   "hw1",
+				Associations: storage.TKs{{Type: orc8r.UpgradeTierEntityType, Key: "default"}},
+			},
+		},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	_, err = tenants.CreateTenant(context.Background(), 1, &protos.Tenant{Name: "lab_tenant", Networks: []string{"lab"}})
+	require.NoError(t, err)
+}
+
+func assertTenantNetworks(t *testing.T, tenantID int64, networks ...string) {
+	tenant, err := tenants.GetTenant(context.Background(), tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, networks, tenant.Networks)
+}
+
+// startTestSecretsService starts an entity secrets service which can't
+// decrypt the secret_holder configs encrypted with "other key".
+func startTestSecretsService(t *testing.T) {
+	srv, lis := test_utils.NewTestOrchestratorService(
+		t,
+		orc8r.ModuleName,
+		"test_entity_secrets_service",
+		map[string]string{orc8r.EntitySecretsLabel: "true"},
+		map[string]string{orc8r.EntitySecretsTypesAnnotation: secretHolderType},
+	)
+	backup_protos.RegisterEntitySecretsServer(srv.GrpcServer, &testSecretsServicer{})
+	go srv.RunTest(lis)
+}
+
+type testSecretsServicer struct{}
+
+func (s *testSecretsServicer) CheckSecrets(ctx context.Context, req *backup_protos.CheckSecretsRequest) (*backup_protos.CheckSecretsResponse, error) {
+	res := &backup_protos.CheckSecretsResponse{}
+	for _, config := range req.Configs {
+		if string(config.Config) == "other key" {
+			res.Unreadable = append(res.Unreadable, &backup_protos.UnreadableConfig{Type: config.Type, Key: config.Key, Error: "encrypted with another key"})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: secrets.proto
+
+package protos
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ArchivedConfig struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Serialized entity config
+	Config               []byte   `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchivedConfig) Reset()         { *m = ArchivedConfig{} }
+func (m *ArchivedConfig) String() string { return proto.CompactTextString(m) }
+func (*ArchivedConfig) ProtoMessage()    {}
+func (*ArchivedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4bc6c625e214507, []int{0}
+}
+
+func (m *ArchivedConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchivedConfig.Unmarshal(m, b)
+}
+func (m *ArchivedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchivedConfig.Marshal(b, m, deterministic)
+}
+func (m *ArchivedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedConfig.Merge(m, src)
+}
+func (m *ArchivedConfig) XXX_Size() int {
+	return xxx_messageInfo_ArchivedConfig.Size(m)
+}
+func (m *ArchivedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedConfig proto.InternalMessageInfo
+
+func (m *ArchivedConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ArchivedConfig) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ArchivedConfig) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type CheckSecretsRequest struct {
+	Configs              []*ArchivedConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckSecretsRequest) Reset()         { *m = CheckSecretsRequest{} }
+func (m *CheckSecretsRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckSecretsRequest) ProtoMessage()    {}
+func (*CheckSecretsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4bc6c625e214507, []int{1}
+}
+
+func (m *CheckSecretsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckSecretsRequest.Unmarshal(m, b)
+}
+func (m *CheckSecretsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckSecretsRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckSecretsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckSecretsRequest.Merge(m, src)
+}
+func (m *CheckSecretsRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckSecretsRequest.Size(m)
+}
+func (m *CheckSecretsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckSecretsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckSecretsRequest proto.InternalMessageInfo
+
+func (m *CheckSecretsRequest) GetConfigs() []*ArchivedConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+type UnreadableConfig struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnreadableConfig) Reset()         { *m = UnreadableConfig{} }
+func (m *UnreadableConfig) String() string { return proto.CompactTextString(m) }
+func (*UnreadableConfig) ProtoMessage()    {}
+func (*UnreadableConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4bc6c625e214507, []int{2}
+}
+
+func (m *UnreadableConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnreadableConfig.Unmarshal(m, b)
+}
+func (m *UnreadableConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnreadableConfig.Marshal(b, m, deterministic)
+}
+func (m *UnreadableConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnreadableConfig.Merge(m, src)
+}
+func (m *UnreadableConfig) XXX_Size() int {
+	return xxx_messageInfo_UnreadableConfig.Size(m)
+}
+func (m *UnreadableConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnreadableConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnreadableConfig proto.InternalMessageInfo
+
+func (m *UnreadableConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *UnreadableConfig) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *UnreadableConfig) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CheckSecretsResponse struct {
+	Unreadable           []*UnreadableConfig `protobuf:"bytes,1,rep,name=unreadable,proto3" json:"unreadable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CheckSecretsResponse) Reset()         { *m = CheckSecretsResponse{} }
+func (m *CheckSecretsResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckSecretsResponse) ProtoMessage()    {}
+func (*CheckSecretsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4bc6c625e214507, []int{3}
+}
+
+func (m *CheckSecretsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckSecretsResponse.Unmarshal(m, b)
+}
+func (m *CheckSecretsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckSecretsResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckSecretsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckSecretsResponse.Merge(m, src)
+}
+func (m *CheckSecretsResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckSecretsResponse.Size(m)
+}
+func (m *CheckSecretsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckSecretsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckSecretsResponse proto.InternalMessageInfo
+
+func (m *CheckSecretsResponse) GetUnreadable() []*UnreadableConfig {
+	if m != nil {
+		return m.Unreadable
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ArchivedConfig)(nil), "magma.orc8r.configurator.backup.ArchivedConfig")
+	proto.RegisterType((*CheckSecretsRequest)(nil), "magma.orc8r.configurator.backup.CheckSecretsRequest")
+	proto.RegisterType((*UnreadableConfig)(nil), "magma.orc8r.configurator.backup.UnreadableConfig")
+	proto.RegisterType((*CheckSecretsResponse)(nil), "magma.orc8r.configurator.backup.CheckSecretsResponse")
+}
+
+func init() { proto.RegisterFile("secrets.proto", fileDescriptor_d4bc6c625e214507) }
+
+var fileDescriptor_d4bc6c625e214507 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0x80, 0xad, 0xd5, 0xe9, 0x9e, 0x9b, 0x8c, 0x38, 0xa4, 0x78, 0xb1, 0xf4, 0xd4, 0x53, 0xc4,
+	0xa9, 0xe0, 0x55, 0x87, 0x07, 0x2f, 0x03, 0x23, 0x5e, 0x3c, 0x99, 0x66, 0xcf, 0xad, 0xd4, 0x35,
+	0xf5, 0x25, 0x15, 0x7a, 0xf0, 0x2f, 0xf8, 0x9b, 0xc5, 0xa6, 0xca, 0x26, 0x83, 0xb2, 0x53, 0xde,
+	0x4b, 0xf2, 0xde, 0xf7, 0x25, 0x3c, 0xe8, 0x1b, 0x54, 0x84, 0xd6, 0xf0, 0x82, 0xb4, 0xd5, 0xec,
+	0x74, 0x21, 0x67, 0x0b, 0xc9, 0x35, 0xa9, 0x6b, 0xe2, 0x4a, 0xe7, 0xaf, 0xe9, 0xac, 0x24, 0x69,
+	0x35, 0xf1, 0x44, 0xaa, 0xac, 0x2c, 0xa2, 0x09, 0x1c, 0xde, 0x90, 0x9a, 0xa7, 0x1f, 0x38, 0x1d,
+	0xd7, 0xc7, 0x8c, 0xc1, 0x8e, 0xad, 0x0a, 0x0c, 0xbc, 0xd0, 0x8b, 0xbb, 0xa2, 0x8e, 0xd9, 0x00,
+	0xfc, 0x0c, 0xab, 0x60, 0xbb, 0xde, 0xfa, 0x09, 0xd9, 0x31, 0x74, 0x5c, 0xbb, 0xc0, 0x0f, 0xbd,
+	0xb8, 0x27, 0x9a, 0x2c, 0x7a, 0x81, 0xa3, 0xf1, 0x1c, 0x55, 0xf6, 0xe8, 0x34, 0x04, 0xbe, 0x97,
+	0x68, 0x2c, 0xbb, 0x87, 0x3d, 0x77, 0xc1, 0x04, 0x5e, 0xe8, 0xc7, 0x07, 0xa3, 0x33, 0xde, 0x62,
+	0xc6, 0x57, 0xb5, 0xc4, 0x6f, 0x7d, 0x34, 0x81, 0xc1, 0x53, 0x4e, 0x28, 0xa7, 0x32, 0x79, 0xc3,
+	0x8d, 0x9c, 0x87, 0xb0, 0x8b, 0x44, 0x9a, 0x6a, 0xe5, 0xae, 0x70, 0x49, 0x94, 0xc2, 0x70, 0xd5,
+	0xd8, 0x14, 0x3a, 0x37, 0xc8, 0x1e, 0x00, 0xca, 0x3f, 0x4e, 0x63, 0x7d, 0xde, 0x6a, 0xfd, 0x5f,
+	0x4d, 0x2c, 0x35, 0x19, 0x7d, 0x79, 0xd0, 0xbf, 0xcb, 0x6d, 0x6a, 0xab, 0x06, 0xc6, 0x3e, 0xa1,
+	0xb7, 0x0c, 0x67, 0x97, 0xad, 0x80, 0x35, 0xbf, 0x7b, 0x72, 0xb5, 0x61, 0x95, 0x7b, 0x61, 0xb4,
+	0x75, 0xbb, 0xff, 0xdc, 0xa9, 0xe7, 0xc4, 0x24, 0x6e, 0xbd, 0xf8, 0x1e, 0x00, 0xaa, 0x36, 0x9f,
+	0x72, 0x40, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EntitySecretsClient is the client API for EntitySecrets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EntitySecretsClient interface {
+	// CheckSecrets returns the configs whose secrets can't be decrypted by
+	// this deployment.
+	CheckSecrets(ctx context.Context, in *CheckSecretsRequest, opts ...grpc.CallOption) (*CheckSecretsResponse, error)
+}
+
+type entitySecretsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEntitySecretsClient(cc grpc.ClientConnInterface) EntitySecretsClient {
+	return &entitySecretsClient{cc}
+}
+
+func (c *entitySecretsClient) CheckSecrets(ctx context.Context, in *CheckSecretsRequest, opts ...grpc.CallOption) (*CheckSecretsResponse, error) {
+	out := new(CheckSecretsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.backup.EntitySecrets/CheckSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntitySecretsServer is the server API for EntitySecrets service.
+type EntitySecretsServer interface {
+	// CheckSecrets returns the configs whose secrets can't be decrypted by
+	// this deployment.
+	CheckSecrets(context.Context, *CheckSecretsRequest) (*CheckSecretsResponse, error)
+}
+
+// UnimplementedEntitySecretsServer can be embedded to have forward compatible implementations.
+type UnimplementedEntitySecretsServer struct {
+}
+
+func (*UnimplementedEntitySecretsServer) CheckSecrets(ctx context.Context, req *CheckSecretsRequest) (*CheckSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSecrets not implemented")
+}
+
+func RegisterEntitySecretsServer(s *grpc.Server, srv EntitySecretsServer) {
+	s.RegisterService(&_EntitySecrets_serviceDesc, srv)
+}
+
+func _EntitySecrets_CheckSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitySecretsServer).CheckSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.backup.EntitySecrets/CheckSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitySecretsServer).CheckSecrets(ctx, req.(*CheckSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EntitySecrets_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.orc8r.configurator.backup.EntitySecrets",
+	HandlerType: (*EntitySecretsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckSecrets",
+			Handler:    _EntitySecrets_CheckSecrets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secrets.proto",
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseIDRewrite parses an ID rewrite of the form <archived ID>:<new ID>.
+func ParseIDRewrite(rewrite string) (string, string, error) {
+	parts := strings.SplitN(rewrite, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID rewrite %q, expected <archived ID>:<new ID>", rewrite)
+	}
+	return parts[0], parts[1], nil
+}
+
+// rewriteIDs returns a copy of the archive whose IDs are rewritten: the
+// keys of the entities and of their associations, their physical IDs, and
+// the string values of the network and entity configs which are exactly an
+// archived ID.
+func rewriteIDs(archive *Archive, rewrites map[string]string) (*Archive, error) {
+	rewrite := func(id string) string {
+		if newID, ok := rewrites[id]; ok {
+			return newID
+		}
+		return id
+	}
+
+	ret := *archive
+	ret.Network.Configs = map[string][]byte{}
+	for typ, config := range archive.Network.Configs {
+		rewritten, err := rewriteConfigIDs(config, rewrites)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to rewrite IDs of network config %s", typ)
+		}
+		ret.Network.Configs[typ] = rewritten
+	}
+
+	ret.Entities = make([]Entity, 0, len(archive.Entities))
+	for _, ent := range archive.Entities {
+		config, err := rewriteConfigIDs(ent.Config, rewrites)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to rewrite IDs of the config of entity %s %s", ent.Type, ent.Key)
+		}
+		rewritten := ent
+		rewritten.Key = rewrite(ent.Key)
+		rewritten.PhysicalID = rewrite(ent.PhysicalID)
+		rewritten.Config = config
+		rewritten.Associations = nil
+		for _, assoc := range ent.Associations {
+			rewritten.Associations = append(rewritten.Associations, EntityID{Type: assoc.Type, Key: rewrite(assoc.Key)})
+		}
+		ret.Entities = append(ret.Entities, rewritten)
+	}
+	return &ret, nil
+}
+
+// rewriteConfigIDs rewrites the string values of a JSON serialized config
+// which are exactly one of the rewritten IDs. Configs which aren't JSON
+// objects or arrays, or which don't hold any of the IDs, are returned
+// unchanged.
+func rewriteConfigIDs(config []byte, rewrites map[string]string) ([]byte, error) {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return config, nil
+	}
+	switch doc.(type) {
+	case map[string]interface{}, []interface{}:
+	default:
+		return config, nil
+	}
+
+	rewritten, changed := rewriteValueIDs(doc, rewrites)
+	if !changed {
+		return config, nil
+	}
+	return json.Marshal(rewritten)
+}
+
+func rewriteValueIDs(value interface{}, rewrites map[string]string) (interface{}, bool) {
+	changed := false
+	switch v := value.(type) {
+	case string:
+		if newID, ok := rewrites[v]; ok {
+			return newID, true
+		}
+	case map[string]interface{}:
+		for key, field := range v {
+			rewritten, fieldChanged := rewriteValueIDs(field, rewrites)
+			v[key] = rewritten
+			changed = changed || fieldChanged
+		}
+	case []interface{}:
+		for i, elem := range v {
+			rewritten, elemChanged := rewriteValueIDs(elem, rewrites)
+			v[i] = rewritten
+			changed = changed || elemChanged
+		}
+	}
+	return value, changed
+}
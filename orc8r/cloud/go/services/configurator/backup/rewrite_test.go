@@ -0,0 +1,75 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDRewrite(t *testing.T) {
+	archivedID, newID, err := ParseIDRewrite("gw1:gw2")
+	assert.NoError(t, err)
+	assert.Equal(t, "gw1", archivedID)
+	assert.Equal(t, "gw2", newID)
+
+	for _, rewrite := range []string{"gw1", "gw1:", ":gw2", ""} {
+		_, _, err = ParseIDRewrite(rewrite)
+		assert.Error(t, err, rewrite)
+	}
+}
+
+func TestRewriteIDs(t *testing.T) {
+	archive := &Archive{
+		Version: ArchiveVersion,
+		Network: Network{
+			ID:      "lab",
+			Configs: map[string][]byte{"federation": []byte(`{"feg_network_id":"lab_feg","note":"lab"}`)},
+		},
+		Entities: []Entity{
+			{
+				Type:         "magmad_gateway",
+				Key:          "gw1",
+				PhysicalID:   "hw1",
+				Config:       []byte(`{"tier":"default","checkin_interval":60}`),
+				Associations: []EntityID{{Type: "cellular_gateway", Key: "gw1"}},
+			},
+			{Type: "cellular_gateway", Key: "gw1", Config: []byte(`"gw1"`)},
+			{Type: "upgrade_tier", Key: "default", Config: []byte{0x01, 0x02}},
+		},
+	}
+	rewrites := map[string]string{"lab": "staging", "lab_feg": "staging_feg", "gw1": "gw2", "hw1": "hw2"}
+
+	rewritten, err := rewriteIDs(archive, rewrites)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"feg_network_id":"staging_feg","note":"staging"}`, string(rewritten.Network.Configs["federation"]))
+	expected := []Entity{
+		{
+			Type:         "magmad_gateway",
+			Key:          "gw2",
+			PhysicalID:   "hw2",
+			Config:       []byte(`{"tier":"default","checkin_interval":60}`),
+			Associations: []EntityID{{Type: "cellular_gateway", Key: "gw2"}},
+		},
+		// Only JSON objects and arrays are rewritten
+		{Type: "cellular_gateway", Key: "gw2", Config: []byte(`"gw1"`)},
+		{Type: "upgrade_tier", Key: "default", Config: []byte{0x01, 0x02}},
+	}
+	assert.Equal(t, expected, rewritten.Entities)
+
+	// The archive itself is left unchanged
+	assert.Equal(t, "gw1", archive.Entities[0].Key)
+	assert.Equal(t, `{"feg_network_id":"lab_feg","note":"lab"}`, string(archive.Network.Configs["federation"]))
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2021 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"strings"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator/backup/protos"
+	merrors "magma/orc8r/lib/go/errors"
+	"magma/orc8r/lib/go/registry"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// maxSecretsCheckBatchSize bounds the configs sent to an entity secrets
+// service per request.
+const maxSecretsCheckBatchSize = 1000
+
+// checkSecrets returns an error wrapping ErrInvalidArchive if any archived
+// config holds secrets which the service owning its entity type can't
+// decrypt.
+// Archives keep secrets, e.g. subscriber auth keys, encrypted as stored, so
+// they can only be imported into deployments sharing the exporting
+// deployment's secrets keys.
+func checkSecrets(ctx context.Context, entities []Entity) error {
+	services, err := registry.FindServices(orc8r.EntitySecretsLabel)
+	if err != nil {
+		return errors.Wrap(err, "failed to find entity secrets services")
+	}
+	for _, service := range services {
+		types, err := registry.GetAnnotationList(service, orc8r.EntitySecretsTypesAnnotation)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get entity secrets types of service %s", service)
+		}
+		var configs []*protos.ArchivedConfig
+		for _, ent := range entities {
+			if len(ent.Config) != 0 && funk.ContainsString(types, ent.Type) {
+				configs = append(configs, &protos.ArchivedConfig{Type: ent.Type, Key: ent.Key, Config: ent.Config})
+			}
+		}
+		if len(configs) == 0 {
+			continue
+		}
+		err = checkSecretsOfService(ctx, strings.ToLower(service), configs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkSecretsOfService(ctx context.Context, service string, configs []*protos.ArchivedConfig) error {
+	conn, err := registry.GetConnection(service)
+	if err != nil {
+		initErr := merrors.NewInitError(err, service)
+		glog.Error(initErr)
+		return initErr
+	}
+	client := protos.NewEntitySecretsClient(conn)
+
+	for start := 0; start < len(configs); start += maxSecretsCheckBatchSize {
+		end := start + maxSecretsCheckBatchSize
+		if end > len(configs) {
+			end = len(configs)
+		}
+		res, err := client.CheckSecrets(ctx, &protos.CheckSecretsRequest{Configs: configs[start:end]})
+		if err != nil {
+			return errors.Wrapf(err, "failed to check secrets with service %s", service)
+		}
+		if len(res.Unreadable) != 0 {
+			first := res.Unreadable[0]
+			return errors.Wrapf(
+				ErrInvalidArchive,
+				"%d archived configs have secrets which can't be decrypted, e.g. %s %s: %s; archives can only be imported into deployments with the exporting deployment's secrets keys",
+				len(res.Unreadable), first.Type, first.Key, first.Error,
+			)
+		}
+	}
+	return nil
+}
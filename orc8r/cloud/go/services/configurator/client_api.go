@@ -15,6 +15,8 @@ package configurator
 
 import (
 	"context"
+	"io"
+	"strings"
 
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator/protos"
@@ -28,6 +30,9 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // ListNetworkIDs loads a list of all networkIDs registered
@@ -65,13 +70,18 @@ func ListNetworksOfType(networkType string) ([]string, error) {
 	return funk.Map(networks.Networks, func(n *storage.Network) string { return n.ID }).([]string), nil
 }
 
-func CreateNetwork(network Network, serdes serde.Registry) error {
-	_, err := CreateNetworks([]Network{network}, serdes)
+func CreateNetworkWithContext(ctx context.Context, network Network, serdes serde.Registry) error {
+	_, err := CreateNetworksWithContext(ctx, []Network{network}, serdes)
 	return err
 }
 
-// CreateNetworks registers the given list of Networks and returns the created networks
-func CreateNetworks(networks []Network, serdes serde.Registry) ([]Network, error) {
+// CreateNetwork is CreateNetworkWithContext with a background context.
+func CreateNetwork(network Network, serdes serde.Registry) error {
+	return CreateNetworkWithContext(context.Background(), network, serdes)
+}
+
+// CreateNetworksWithContext registers the given list of Networks and returns the created networks
+func CreateNetworksWithContext(ctx context.Context, networks []Network, serdes serde.Registry) ([]Network, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -85,7 +95,7 @@ func CreateNetworks(networks []Network, serdes serde.Registry) ([]Network, error
 		}
 		req.Networks = append(req.Networks, pNet)
 	}
-	res, err := client.CreateNetworks(context.Background(), req)
+	res, err := client.CreateNetworks(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +111,13 @@ func CreateNetworks(networks []Network, serdes serde.Registry) ([]Network, error
 	return ret, nil
 }
 
-// UpdateNetworks updates the specified networks and returns the updated networks
-func UpdateNetworks(updates []NetworkUpdateCriteria, serdes serde.Registry) error {
+// CreateNetworks is CreateNetworksWithContext with a background context.
+func CreateNetworks(networks []Network, serdes serde.Registry) ([]Network, error) {
+	return CreateNetworksWithContext(context.Background(), networks, serdes)
+}
+
+// UpdateNetworksWithContext updates the specified networks and returns the updated networks
+func UpdateNetworksWithContext(ctx context.Context, updates []NetworkUpdateCriteria, serdes serde.Registry) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
@@ -116,33 +131,48 @@ func UpdateNetworks(updates []NetworkUpdateCriteria, serdes serde.Registry) erro
 		}
 		req.Updates = append(req.Updates, protoUpdate)
 	}
-	_, err = client.UpdateNetworks(context.Background(), req)
-	return err
+	_, err = client.UpdateNetworks(ctx, req)
+	return mapVersionConflict(err)
 }
 
-// DeleteNetworks deletes the network specified by networkID
-func DeleteNetworks(networkIDs []string) error {
+// UpdateNetworks is UpdateNetworksWithContext with a background context.
+func UpdateNetworks(updates []NetworkUpdateCriteria, serdes serde.Registry) error {
+	return UpdateNetworksWithContext(context.Background(), updates, serdes)
+}
+
+// DeleteNetworksWithContext deletes the network specified by networkID
+func DeleteNetworksWithContext(ctx context.Context, networkIDs []string) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
-	_, err = client.DeleteNetworks(context.Background(), &protos.DeleteNetworksRequest{NetworkIDs: networkIDs})
+	_, err = client.DeleteNetworks(ctx, &protos.DeleteNetworksRequest{NetworkIDs: networkIDs})
 	return err
 }
 
-// DeleteNetwork deletes a network.
-func DeleteNetwork(networkID string) error {
+// DeleteNetworks is DeleteNetworksWithContext with a background context.
+func DeleteNetworks(networkIDs []string) error {
+	return DeleteNetworksWithContext(context.Background(), networkIDs)
+}
+
+// DeleteNetworkWithContext deletes a network.
+func DeleteNetworkWithContext(ctx context.Context, networkID string) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 	_, err = client.DeleteNetworks(
-		context.Background(),
+		ctx,
 		&protos.DeleteNetworksRequest{NetworkIDs: []string{networkID}},
 	)
 	return err
 }
 
+// DeleteNetwork is DeleteNetworkWithContext with a background context.
+func DeleteNetwork(networkID string) error {
+	return DeleteNetworkWithContext(context.Background(), networkID)
+}
+
 // DoesNetworkExist returns true iff the network exists.
 func DoesNetworkExist(networkID string) (bool, error) {
 	loaded, _, err := LoadNetworks([]string{networkID}, true, false, nil)
@@ -246,63 +276,63 @@ func LoadNetworkConfig(networkID, configType string, serdes serde.Registry) (int
 	return network.Configs[configType], nil
 }
 
-func UpdateNetworkConfig(networkID, configType string, config interface{}, serdes serde.Registry) error {
+func UpdateNetworkConfigWithContext(ctx context.Context, networkID, configType string, config interface{}, serdes serde.Registry) error {
 	updateCriteria := NetworkUpdateCriteria{
 		ID:                   networkID,
 		ConfigsToAddOrUpdate: map[string]interface{}{configType: config},
 	}
-	return UpdateNetworks([]NetworkUpdateCriteria{updateCriteria}, serdes)
+	return UpdateNetworksWithContext(ctx, []NetworkUpdateCriteria{updateCriteria}, serdes)
+}
+
+// UpdateNetworkConfig is UpdateNetworkConfigWithContext with a background context.
+func UpdateNetworkConfig(networkID, configType string, config interface{}, serdes serde.Registry) error {
+	return UpdateNetworkConfigWithContext(context.Background(), networkID, configType, config, serdes)
 }
 
-// WriteEntities executes a series of entity writes (creation or update) to be
+// WriteEntitiesWithContext executes a series of entity writes (creation or update) to be
 // executed in order within a single transaction.
 // This function is all-or-nothing - any failure or error encountered during
 // any operation will rollback the entire batch.
-func WriteEntities(networkID string, writes []EntityWriteOperation, serdes serde.Registry) error {
+func WriteEntitiesWithContext(ctx context.Context, networkID string, writes []EntityWriteOperation, serdes serde.Registry) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 
-	req := &protos.WriteEntitiesRequest{NetworkID: networkID}
-	for _, write := range writes {
-		switch op := write.(type) {
-		case NetworkEntity:
-			protoEnt, err := op.toProto(serdes)
-			if err != nil {
-				return err
-			}
-			req.Writes = append(req.Writes, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Create{Create: protoEnt}})
-		case EntityUpdateCriteria:
-			protoEuc, err := op.toProto(serdes)
-			if err != nil {
-				return err
-			}
-			req.Writes = append(req.Writes, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Update{Update: protoEuc}})
-		default:
-			return errors.Errorf("unrecognized entity write operation %T", op)
-		}
-	}
-
-	_, err = client.WriteEntities(context.Background(), req)
+	protoWrites, err := entityWritesToProto(writes, serdes)
 	if err != nil {
 		return err
 	}
+	req := &protos.WriteEntitiesRequest{NetworkID: networkID, Writes: protoWrites}
+	_, err = client.WriteEntities(ctx, req)
+	if err != nil {
+		return mapVersionConflict(err)
+	}
 	return nil
 }
 
-// CreateEntity creates a network entity.
-func CreateEntity(networkID string, entity NetworkEntity, serdes serde.Registry) (NetworkEntity, error) {
-	ret, err := CreateEntities(networkID, NetworkEntities{entity}, serdes)
+// WriteEntities is WriteEntitiesWithContext with a background context.
+func WriteEntities(networkID string, writes []EntityWriteOperation, serdes serde.Registry) error {
+	return WriteEntitiesWithContext(context.Background(), networkID, writes, serdes)
+}
+
+// CreateEntityWithContext creates a network entity.
+func CreateEntityWithContext(ctx context.Context, networkID string, entity NetworkEntity, serdes serde.Registry) (NetworkEntity, error) {
+	ret, err := CreateEntitiesWithContext(ctx, networkID, NetworkEntities{entity}, serdes)
 	if err != nil {
 		return NetworkEntity{}, err
 	}
 	return ret[0], nil
 }
 
-// CreateEntities registers the given entities and returns the created network
+// CreateEntity is CreateEntityWithContext with a background context.
+func CreateEntity(networkID string, entity NetworkEntity, serdes serde.Registry) (NetworkEntity, error) {
+	return CreateEntityWithContext(context.Background(), networkID, entity, serdes)
+}
+
+// CreateEntitiesWithContext registers the given entities and returns the created network
 // entities.
-func CreateEntities(networkID string, entities NetworkEntities, serdes serde.Registry) (NetworkEntities, error) {
+func CreateEntitiesWithContext(ctx context.Context, networkID string, entities NetworkEntities, serdes serde.Registry) (NetworkEntities, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -316,7 +346,7 @@ func CreateEntities(networkID string, entities NetworkEntities, serdes serde.Reg
 		}
 		req.Entities = append(req.Entities, protoEnt)
 	}
-	res, err := client.CreateEntities(context.Background(), req)
+	res, err := client.CreateEntities(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -325,15 +355,25 @@ func CreateEntities(networkID string, entities NetworkEntities, serdes serde.Reg
 	return created, nil
 }
 
-// CreateInternalEntity is a loose wrapper around CreateEntity to create an
-// entity in the internal network structure
+// CreateEntities is CreateEntitiesWithContext with a background context.
+func CreateEntities(networkID string, entities NetworkEntities, serdes serde.Registry) (NetworkEntities, error) {
+	return CreateEntitiesWithContext(context.Background(), networkID, entities, serdes)
+}
+
+// CreateInternalEntityWithContext is a loose wrapper around CreateEntityWithContext
+// to create an entity in the internal network structure
+func CreateInternalEntityWithContext(ctx context.Context, entity NetworkEntity, serdes serde.Registry) (NetworkEntity, error) {
+	return CreateEntityWithContext(ctx, storage.InternalNetworkID, entity, serdes)
+}
+
+// CreateInternalEntity is CreateInternalEntityWithContext with a background context.
 func CreateInternalEntity(entity NetworkEntity, serdes serde.Registry) (NetworkEntity, error) {
-	return CreateEntity(storage.InternalNetworkID, entity, serdes)
+	return CreateInternalEntityWithContext(context.Background(), entity, serdes)
 }
 
-// UpdateEntity updates a network entity.
-func UpdateEntity(networkID string, update EntityUpdateCriteria, serdes serde.Registry) (NetworkEntity, error) {
-	updates, err := UpdateEntities(networkID, []EntityUpdateCriteria{update}, serdes)
+// UpdateEntityWithContext updates a network entity.
+func UpdateEntityWithContext(ctx context.Context, networkID string, update EntityUpdateCriteria, serdes serde.Registry) (NetworkEntity, error) {
+	updates, err := UpdateEntitiesWithContext(ctx, networkID, []EntityUpdateCriteria{update}, serdes)
 	if err != nil {
 		return NetworkEntity{}, err
 	}
@@ -343,8 +383,13 @@ func UpdateEntity(networkID string, update EntityUpdateCriteria, serdes serde.Re
 	return NetworkEntity{}, merrors.ErrNotFound
 }
 
-// UpdateEntities updates the registered entities and returns the updated entities
-func UpdateEntities(networkID string, updates []EntityUpdateCriteria, serdes serde.Registry) (NetworkEntities, error) {
+// UpdateEntity is UpdateEntityWithContext with a background context.
+func UpdateEntity(networkID string, update EntityUpdateCriteria, serdes serde.Registry) (NetworkEntity, error) {
+	return UpdateEntityWithContext(context.Background(), networkID, update, serdes)
+}
+
+// UpdateEntitiesWithContext updates the registered entities and returns the updated entities
+func UpdateEntitiesWithContext(ctx context.Context, networkID string, updates []EntityUpdateCriteria, serdes serde.Registry) (NetworkEntities, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -358,9 +403,9 @@ func UpdateEntities(networkID string, updates []EntityUpdateCriteria, serdes ser
 		}
 		req.Updates = append(req.Updates, upProto)
 	}
-	res, err := client.UpdateEntities(context.Background(), req)
+	res, err := client.UpdateEntities(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, mapVersionConflict(err)
 	}
 
 	updatedEnts := funk.Values(res.UpdatedEntities).([]*storage.NetworkEntity)
@@ -369,35 +414,55 @@ func UpdateEntities(networkID string, updates []EntityUpdateCriteria, serdes ser
 	return ret, nil
 }
 
-// UpdateInternalEntity is a loose wrapper around UpdateEntity to update an
-// entity in the internal network structure.
+// UpdateEntities is UpdateEntitiesWithContext with a background context.
+func UpdateEntities(networkID string, updates []EntityUpdateCriteria, serdes serde.Registry) (NetworkEntities, error) {
+	return UpdateEntitiesWithContext(context.Background(), networkID, updates, serdes)
+}
+
+// UpdateInternalEntityWithContext is a loose wrapper around UpdateEntityWithContext
+// to update an entity in the internal network structure.
+func UpdateInternalEntityWithContext(ctx context.Context, update EntityUpdateCriteria, serdes serde.Registry) (NetworkEntity, error) {
+	return UpdateEntityWithContext(ctx, storage.InternalNetworkID, update, serdes)
+}
+
+// UpdateInternalEntity is UpdateInternalEntityWithContext with a background context.
 func UpdateInternalEntity(update EntityUpdateCriteria, serdes serde.Registry) (NetworkEntity, error) {
-	return UpdateEntity(storage.InternalNetworkID, update, serdes)
+	return UpdateInternalEntityWithContext(context.Background(), update, serdes)
 }
 
-func CreateOrUpdateEntityConfig(networkID string, entityType string, entityKey string, config interface{}, serdes serde.Registry) error {
+func CreateOrUpdateEntityConfigWithContext(ctx context.Context, networkID string, entityType string, entityKey string, config interface{}, serdes serde.Registry) error {
 	updateCriteria := EntityUpdateCriteria{
 		Key:       entityKey,
 		Type:      entityType,
 		NewConfig: config,
 	}
-	_, err := UpdateEntities(networkID, []EntityUpdateCriteria{updateCriteria}, serdes)
+	_, err := UpdateEntitiesWithContext(ctx, networkID, []EntityUpdateCriteria{updateCriteria}, serdes)
 	return err
 }
 
+// CreateOrUpdateEntityConfig is CreateOrUpdateEntityConfigWithContext with a background context.
+func CreateOrUpdateEntityConfig(networkID string, entityType string, entityKey string, config interface{}, serdes serde.Registry) error {
+	return CreateOrUpdateEntityConfigWithContext(context.Background(), networkID, entityType, entityKey, config, serdes)
+}
+
+func DeleteEntityWithContext(ctx context.Context, networkID string, entityType string, entityKey string) error {
+	return DeleteEntitiesWithContext(ctx, networkID, storage2.TKs{{Type: entityType, Key: entityKey}})
+}
+
+// DeleteEntity is DeleteEntityWithContext with a background context.
 func DeleteEntity(networkID string, entityType string, entityKey string) error {
-	return DeleteEntities(networkID, storage2.TKs{{Type: entityType, Key: entityKey}})
+	return DeleteEntityWithContext(context.Background(), networkID, entityType, entityKey)
 }
 
-// DeleteEntities deletes the entities specified by networkID and tks.
+// DeleteEntitiesWithContext deletes the entities specified by networkID and tks.
 // We also have cascading deletes to delete foreign keys for assocs.
-func DeleteEntities(networkID string, ids storage2.TKs) error {
+func DeleteEntitiesWithContext(ctx context.Context, networkID string, ids storage2.TKs) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 	_, err = client.DeleteEntities(
-		context.Background(),
+		ctx,
 		&protos.DeleteEntitiesRequest{
 			NetworkID: networkID,
 			ID:        tksToEntIDs(ids),
@@ -406,10 +471,20 @@ func DeleteEntities(networkID string, ids storage2.TKs) error {
 	return err
 }
 
-// DeleteInternalEntity is a loose wrapper around DeleteEntities to delete an
-// entity in the internal network structure
+// DeleteEntities is DeleteEntitiesWithContext with a background context.
+func DeleteEntities(networkID string, ids storage2.TKs) error {
+	return DeleteEntitiesWithContext(context.Background(), networkID, ids)
+}
+
+// DeleteInternalEntityWithContext is a loose wrapper around DeleteEntityWithContext
+// to delete an entity in the internal network structure
+func DeleteInternalEntityWithContext(ctx context.Context, entityType, entityKey string) error {
+	return DeleteEntityWithContext(ctx, storage.InternalNetworkID, entityType, entityKey)
+}
+
+// DeleteInternalEntity is DeleteInternalEntityWithContext with a background context.
 func DeleteInternalEntity(entityType, entityKey string) error {
-	return DeleteEntity(storage.InternalNetworkID, entityType, entityKey)
+	return DeleteInternalEntityWithContext(context.Background(), entityType, entityKey)
 }
 
 // GetPhysicalIDOfEntity gets the physicalID associated with the entity identified by (networkID, entityType, entityKey)
@@ -661,6 +736,13 @@ func DoesInternalEntityExist(entityType, entityKey string) (bool, error) {
 // load criteria. To exhaustively read all pages, clients must continue
 // querying until an empty page token is received in the load result.
 func LoadAllEntitiesOfType(networkID string, entityType string, criteria EntityLoadCriteria, serdes serde.Registry) (NetworkEntities, string, error) {
+	return LoadEntitiesOfTypeWithKeyPrefix(networkID, entityType, "", criteria, serdes)
+}
+
+// LoadEntitiesOfTypeWithKeyPrefix is the same as LoadAllEntitiesOfType, but
+// only loads the entities whose key starts with keyPrefix. An empty prefix
+// loads all entities of the type.
+func LoadEntitiesOfTypeWithKeyPrefix(networkID string, entityType string, keyPrefix string, criteria EntityLoadCriteria, serdes serde.Registry) (NetworkEntities, string, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, "", err
@@ -670,10 +752,8 @@ func LoadAllEntitiesOfType(networkID string, entityType string, criteria EntityL
 		context.Background(),
 		&protos.LoadEntitiesRequest{
 			NetworkID: networkID,
-			Filter: &storage.EntityLoadFilter{
-				TypeFilter: &wrappers.StringValue{Value: entityType},
-			},
-			Criteria: criteria.toProto(),
+			Filter:    getTypeAndKeyPrefixFilter(entityType, keyPrefix),
+			Criteria:  criteria.toProto(),
 		},
 	)
 	if err != nil {
@@ -690,6 +770,12 @@ func LoadAllEntitiesOfType(networkID string, entityType string, criteria EntityL
 
 // CountEntitiesOfType provides total count of entities of this type
 func CountEntitiesOfType(networkID string, entityType string, criteria EntityLoadCriteria, serdes serde.Registry) (uint64, error) {
+	return CountEntitiesOfTypeWithKeyPrefix(networkID, entityType, "", criteria, serdes)
+}
+
+// CountEntitiesOfTypeWithKeyPrefix provides total count of entities of this
+// type whose key starts with keyPrefix
+func CountEntitiesOfTypeWithKeyPrefix(networkID string, entityType string, keyPrefix string, criteria EntityLoadCriteria, serdes serde.Registry) (uint64, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return 0, err
@@ -698,10 +784,8 @@ func CountEntitiesOfType(networkID string, entityType string, criteria EntityLoa
 		context.Background(),
 		&protos.LoadEntitiesRequest{
 			NetworkID: networkID,
-			Filter: &storage.EntityLoadFilter{
-				TypeFilter: &wrappers.StringValue{Value: entityType},
-			},
-			Criteria: criteria.toProto(),
+			Filter:    getTypeAndKeyPrefixFilter(entityType, keyPrefix),
+			Criteria:  criteria.toProto(),
 		},
 	)
 	if err != nil {
@@ -710,6 +794,152 @@ func CountEntitiesOfType(networkID string, entityType string, criteria EntityLoa
 	return res.Count, nil
 }
 
+// WithOperator returns a copy of the context which attributes the network and
+// entity mutations made with it to the given operator.
+func WithOperator(ctx context.Context, operator string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, protos.OperatorMetadataKey, operator)
+}
+
+// LoadRevisions loads the recorded mutations of a network and its entities,
+// most recent first.
+// Loads are paginated. To exhaustively read all pages, clients must continue
+// querying until an empty page token is received.
+func LoadRevisions(networkID string, filter RevisionLoadFilter, criteria RevisionLoadCriteria, serdes serde.Registry) ([]Revision, string, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := client.LoadRevisions(
+		context.Background(),
+		&protos.LoadRevisionsRequest{
+			NetworkID: networkID,
+			Filter:    filter.toProto(),
+			Criteria:  criteria.toProto(),
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret := make([]Revision, 0, len(res.Revisions))
+	for _, protoRevision := range res.Revisions {
+		revision, err := (Revision{}).fromProto(protoRevision, serdes)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "request succeeded but deserialization failed")
+		}
+		ret = append(ret, revision)
+	}
+	return ret, res.NextPageToken, nil
+}
+
+// RevertToRevision restores the network or entity mutated by a revision to
+// its state right after the revision. The revert is recorded as a new
+// revision.
+// Returns ErrNotFound if the network has no such revision.
+func RevertToRevision(ctx context.Context, networkID string, revisionID string) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RevertToRevision(ctx, &protos.RevertToRevisionRequest{NetworkID: networkID, RevisionID: revisionID})
+	if status.Code(err) == codes.NotFound {
+		return merrors.ErrNotFound
+	}
+	return mapVersionConflict(err)
+}
+
+// ExportNetwork snapshots a network and all of its entities. Configs are left
+// serialized.
+// Returns ErrNotFound if the network doesn't exist.
+func ExportNetwork(networkID string) (*storage.NetworkArchive, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.ExportNetwork(context.Background(), &protos.ExportNetworkRequest{NetworkID: networkID})
+	if err != nil {
+		return nil, err
+	}
+	archive := &storage.NetworkArchive{}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if status.Code(err) == codes.NotFound {
+			return nil, merrors.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunk.AddToArchive(archive)
+	}
+	return archive, nil
+}
+
+// ImportNetworkWithContext recreates an exported network, and all of its
+// entities, under the given network ID, which defaults to the ID of the
+// exported network. The network is created if it doesn't exist yet.
+// Set clearPhysicalIDs to import the entities without their physical IDs,
+// e.g. when cloning a network within the same deployment. Set dryRun to
+// validate the import without applying it.
+// Returns an error wrapping storage.ErrInvalidNetworkArchive if the archive
+// can't be imported.
+func ImportNetworkWithContext(ctx context.Context, networkID string, archive *storage.NetworkArchive, clearPhysicalIDs bool, dryRun bool) (*protos.ImportNetworkResponse, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.ImportNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, chunk := range protos.ChunkNetworkArchive(archive, protos.MaxNetworkArchiveChunkSize) {
+		req := &protos.ImportNetworkRequest{Chunk: chunk}
+		if i == 0 {
+			req.NetworkID = networkID
+			req.ClearPhysicalIDs = clearPhysicalIDs
+			req.DryRun = dryRun
+		}
+		err = stream.Send(req)
+		// On io.EOF, the server's error is returned by CloseAndRecv
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if status.Code(err) == codes.InvalidArgument {
+		msg := strings.TrimSuffix(status.Convert(err).Message(), ": "+storage.ErrInvalidNetworkArchive.Error())
+		return nil, errors.WithMessage(storage.ErrInvalidNetworkArchive, msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func getTypeAndKeyPrefixFilter(entityType string, keyPrefix string) *storage.EntityLoadFilter {
+	filter := &storage.EntityLoadFilter{
+		TypeFilter: &wrappers.StringValue{Value: entityType},
+	}
+	if keyPrefix != "" {
+		filter.KeyPrefixFilter = &wrappers.StringValue{Value: keyPrefix}
+	}
+	return filter
+}
+
+// mapVersionConflict converts the status returned by configurator on version
+// conflicts to ErrVersionConflict from magma/orc8r/lib/go/errors.
+func mapVersionConflict(err error) error {
+	if status.Code(err) == codes.Aborted {
+		return merrors.ErrVersionConflict
+	}
+	return err
+}
+
 func getNBConfiguratorClient() (protos.NorthboundConfiguratorClient, error) {
 	conn, err := registry.GetConnection(ServiceName)
 	if err != nil {
@@ -728,6 +958,39 @@ func GetMconfigFor(hardwareID string) (*protos.GetMconfigResponse, error) {
 	return client.GetMconfigInternal(context.Background(), &protos.GetMconfigRequest{HardwareID: hardwareID})
 }
 
+// ErrInvalidMconfigPreview is returned, wrapped, when previewed changes can't
+// be applied to the gateway's network.
+var ErrInvalidMconfigPreview = errors.New("invalid mconfig preview")
+
+// PreviewMconfig returns the mconfig the gateway with the given hardware ID
+// would get once the previewed changes are applied, without applying them.
+// Network configs are serialized with networkSerdes, entity configs with
+// entitySerdes.
+// Returns ErrNotFound from magma/orc8r/lib/go/errors if the gateway doesn't
+// exist, and an error wrapping ErrInvalidMconfigPreview if the changes can't
+// be applied.
+func PreviewMconfig(ctx context.Context, hardwareID string, preview MconfigPreview, networkSerdes, entitySerdes serde.Registry) (*protos.GetMconfigResponse, error) {
+	client, err := getSBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	protoPreview, err := preview.toProto(networkSerdes, entitySerdes)
+	if err != nil {
+		return nil, errors.WithMessage(ErrInvalidMconfigPreview, err.Error())
+	}
+	res, err := client.GetMconfigInternal(ctx, &protos.GetMconfigRequest{HardwareID: hardwareID, Preview: protoPreview})
+	switch status.Code(err) {
+	case codes.OK:
+		return res, nil
+	case codes.NotFound:
+		return nil, merrors.ErrNotFound
+	case codes.InvalidArgument:
+		return nil, errors.WithMessage(ErrInvalidMconfigPreview, status.Convert(err).Message())
+	default:
+		return nil, err
+	}
+}
+
 func getSBConfiguratorClient() (protos.SouthboundConfiguratorClient, error) {
 	conn, err := registry.GetConnection(ServiceName)
 	if err != nil {
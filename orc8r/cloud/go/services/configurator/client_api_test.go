@@ -13,15 +13,22 @@ limitations under the License.
 package configurator_test
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	configurator_storage "magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/services/configurator/test_init"
 	"magma/orc8r/cloud/go/storage"
+	merrors "magma/orc8r/lib/go/errors"
 
 	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -295,6 +302,54 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, "foobar", entities[0].Name)
 }
 
+func TestExportImportNetwork(t *testing.T) {
+	test_init.StartTestService(t)
+	networkSerdes := serde.NewRegistry(&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "foo"})
+	entitySerdes := serde.NewRegistry(&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "foo"})
+
+	_, err := configurator.CreateNetworks([]configurator.Network{{ID: networkID1, Name: "n", Configs: map[string]interface{}{"foo": "bar"}}}, networkSerdes)
+	assert.NoError(t, err)
+	// Archives larger than the gRPC message size limit are streamed in chunks
+	var entities configurator.NetworkEntities
+	for i := 0; i < 8; i++ {
+		entities = append(entities, configurator.NetworkEntity{Type: "foo", Key: fmt.Sprintf("key%d", i), Config: strings.Repeat("a", 600*1024)})
+	}
+	_, err = configurator.CreateEntities(networkID1, entities[:4], entitySerdes)
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, entities[4:], entitySerdes)
+	assert.NoError(t, err)
+
+	archive, err := configurator.ExportNetwork(networkID1)
+	assert.NoError(t, err)
+	assert.Equal(t, networkID1, archive.Network.ID)
+	assert.Len(t, archive.Entities, 8)
+	_, err = configurator.ExportNetwork("missing")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Dry run
+	res, err := configurator.ImportNetworkWithContext(context.Background(), networkID2, archive, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.ImportNetworkResponse{NetworkID: networkID2, CreatedNetwork: true, ImportedEntities: 8}, res)
+	exists, err := configurator.DoesNetworkExist(networkID2)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// Physical IDs aren't set, so the network can be cloned as is
+	res, err = configurator.ImportNetworkWithContext(context.Background(), networkID2, archive, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.ImportNetworkResponse{NetworkID: networkID2, CreatedNetwork: true, ImportedEntities: 8}, res)
+	imported, err := configurator.ExportNetwork(networkID2)
+	assert.NoError(t, err)
+	assert.Len(t, imported.Entities, 8)
+	for i, ent := range imported.Entities {
+		assert.True(t, proto.Equal(archive.Entities[i], ent))
+	}
+
+	// Entities already exist
+	_, err = configurator.ImportNetworkWithContext(context.Background(), networkID2, archive, false, false)
+	assert.Equal(t, configurator_storage.ErrInvalidNetworkArchive, errors.Cause(err))
+}
+
 func strPointer(str string) *string {
 	return &str
 }
@@ -716,6 +716,323 @@ func (m *DeleteEntitiesRequest) GetID() []*storage.EntityID {
 	return nil
 }
 
+type LoadRevisionsRequest struct {
+	NetworkID            string                        `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Filter               *storage.RevisionLoadFilter   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Criteria             *storage.RevisionLoadCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *LoadRevisionsRequest) Reset()         { *m = LoadRevisionsRequest{} }
+func (m *LoadRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadRevisionsRequest) ProtoMessage()    {}
+func (*LoadRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{15}
+}
+
+func (m *LoadRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadRevisionsRequest.Unmarshal(m, b)
+}
+func (m *LoadRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadRevisionsRequest.Merge(m, src)
+}
+func (m *LoadRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadRevisionsRequest.Size(m)
+}
+func (m *LoadRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadRevisionsRequest proto.InternalMessageInfo
+
+func (m *LoadRevisionsRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *LoadRevisionsRequest) GetFilter() *storage.RevisionLoadFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *LoadRevisionsRequest) GetCriteria() *storage.RevisionLoadCriteria {
+	if m != nil {
+		return m.Criteria
+	}
+	return nil
+}
+
+type RevertToRevisionRequest struct {
+	NetworkID            string   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	RevisionID           string   `protobuf:"bytes,2,opt,name=revisionID,proto3" json:"revisionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertToRevisionRequest) Reset()         { *m = RevertToRevisionRequest{} }
+func (m *RevertToRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertToRevisionRequest) ProtoMessage()    {}
+func (*RevertToRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{16}
+}
+
+func (m *RevertToRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertToRevisionRequest.Unmarshal(m, b)
+}
+func (m *RevertToRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertToRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RevertToRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertToRevisionRequest.Merge(m, src)
+}
+func (m *RevertToRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RevertToRevisionRequest.Size(m)
+}
+func (m *RevertToRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertToRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertToRevisionRequest proto.InternalMessageInfo
+
+func (m *RevertToRevisionRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *RevertToRevisionRequest) GetRevisionID() string {
+	if m != nil {
+		return m.RevisionID
+	}
+	return ""
+}
+
+type ExportNetworkRequest struct {
+	NetworkID            string   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportNetworkRequest) Reset()         { *m = ExportNetworkRequest{} }
+func (m *ExportNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportNetworkRequest) ProtoMessage()    {}
+func (*ExportNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{17}
+}
+
+func (m *ExportNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportNetworkRequest.Unmarshal(m, b)
+}
+func (m *ExportNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportNetworkRequest.Merge(m, src)
+}
+func (m *ExportNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportNetworkRequest.Size(m)
+}
+func (m *ExportNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportNetworkRequest proto.InternalMessageInfo
+
+func (m *ExportNetworkRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+// NetworkArchiveChunk is a part of a streamed storage.NetworkArchive. The
+// first chunk holds the archived network, and every chunk holds a batch of
+// the archived entities.
+type NetworkArchiveChunk struct {
+	Network              *storage.Network         `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Entities             []*storage.NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *NetworkArchiveChunk) Reset()         { *m = NetworkArchiveChunk{} }
+func (m *NetworkArchiveChunk) String() string { return proto.CompactTextString(m) }
+func (*NetworkArchiveChunk) ProtoMessage()    {}
+func (*NetworkArchiveChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{18}
+}
+
+func (m *NetworkArchiveChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkArchiveChunk.Unmarshal(m, b)
+}
+func (m *NetworkArchiveChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkArchiveChunk.Marshal(b, m, deterministic)
+}
+func (m *NetworkArchiveChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkArchiveChunk.Merge(m, src)
+}
+func (m *NetworkArchiveChunk) XXX_Size() int {
+	return xxx_messageInfo_NetworkArchiveChunk.Size(m)
+}
+func (m *NetworkArchiveChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkArchiveChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkArchiveChunk proto.InternalMessageInfo
+
+func (m *NetworkArchiveChunk) GetNetwork() *storage.Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *NetworkArchiveChunk) GetEntities() []*storage.NetworkEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+// ImportNetworkRequest is a chunk of an import stream. The import options
+// are read from the first request of the stream.
+type ImportNetworkRequest struct {
+	// ID of the network to import into. The network is created if it doesn't
+	// exist yet. Defaults to the ID of the archived network.
+	NetworkID string               `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Chunk     *NetworkArchiveChunk `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// Set clearPhysicalIDs to import the entities without their physical IDs,
+	// which are unique across networks.
+	ClearPhysicalIDs bool `protobuf:"varint,3,opt,name=clearPhysicalIDs,proto3" json:"clearPhysicalIDs,omitempty"`
+	// Set dryRun to validate the import without applying it
+	DryRun               bool     `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportNetworkRequest) Reset()         { *m = ImportNetworkRequest{} }
+func (m *ImportNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportNetworkRequest) ProtoMessage()    {}
+func (*ImportNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{19}
+}
+
+func (m *ImportNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportNetworkRequest.Unmarshal(m, b)
+}
+func (m *ImportNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportNetworkRequest.Merge(m, src)
+}
+func (m *ImportNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportNetworkRequest.Size(m)
+}
+func (m *ImportNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportNetworkRequest proto.InternalMessageInfo
+
+func (m *ImportNetworkRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ImportNetworkRequest) GetChunk() *NetworkArchiveChunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *ImportNetworkRequest) GetClearPhysicalIDs() bool {
+	if m != nil {
+		return m.ClearPhysicalIDs
+	}
+	return false
+}
+
+func (m *ImportNetworkRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ImportNetworkResponse struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// createdNetwork is true iff the network didn't exist before the import
+	CreatedNetwork       bool     `protobuf:"varint,2,opt,name=createdNetwork,proto3" json:"createdNetwork,omitempty"`
+	ImportedEntities     uint32   `protobuf:"varint,3,opt,name=importedEntities,proto3" json:"importedEntities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportNetworkResponse) Reset()         { *m = ImportNetworkResponse{} }
+func (m *ImportNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportNetworkResponse) ProtoMessage()    {}
+func (*ImportNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{20}
+}
+
+func (m *ImportNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportNetworkResponse.Unmarshal(m, b)
+}
+func (m *ImportNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportNetworkResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportNetworkResponse.Merge(m, src)
+}
+func (m *ImportNetworkResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportNetworkResponse.Size(m)
+}
+func (m *ImportNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportNetworkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportNetworkResponse proto.InternalMessageInfo
+
+func (m *ImportNetworkResponse) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ImportNetworkResponse) GetCreatedNetwork() bool {
+	if m != nil {
+		return m.CreatedNetwork
+	}
+	return false
+}
+
+func (m *ImportNetworkResponse) GetImportedEntities() uint32 {
+	if m != nil {
+		return m.ImportedEntities
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListNetworkIDsResponse)(nil), "magma.orc8r.configurator.ListNetworkIDsResponse")
 	proto.RegisterType((*LoadNetworksRequest)(nil), "magma.orc8r.configurator.LoadNetworksRequest")
@@ -734,64 +1051,87 @@ func init() {
 	proto.RegisterType((*UpdateEntitiesResponse)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse")
 	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse.UpdatedEntitiesEntry")
 	proto.RegisterType((*DeleteEntitiesRequest)(nil), "magma.orc8r.configurator.DeleteEntitiesRequest")
+	proto.RegisterType((*LoadRevisionsRequest)(nil), "magma.orc8r.configurator.LoadRevisionsRequest")
+	proto.RegisterType((*RevertToRevisionRequest)(nil), "magma.orc8r.configurator.RevertToRevisionRequest")
+	proto.RegisterType((*ExportNetworkRequest)(nil), "magma.orc8r.configurator.ExportNetworkRequest")
+	proto.RegisterType((*NetworkArchiveChunk)(nil), "magma.orc8r.configurator.NetworkArchiveChunk")
+	proto.RegisterType((*ImportNetworkRequest)(nil), "magma.orc8r.configurator.ImportNetworkRequest")
+	proto.RegisterType((*ImportNetworkResponse)(nil), "magma.orc8r.configurator.ImportNetworkResponse")
 }
 
 func init() { proto.RegisterFile("northbound.proto", fileDescriptor_90b042c70967f647) }
 
 var fileDescriptor_90b042c70967f647 = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x12, 0x41,
-	0x14, 0x67, 0x69, 0xa4, 0xf0, 0x6a, 0x5b, 0x1c, 0x0b, 0x21, 0xc4, 0x68, 0x33, 0xa7, 0x6a, 0x94,
-	0x6d, 0xa8, 0xda, 0xa6, 0x27, 0x53, 0xc0, 0x88, 0x6d, 0x9a, 0x76, 0xa3, 0x6d, 0xd2, 0x83, 0x66,
-	0x0b, 0x53, 0x5c, 0x0b, 0x3b, 0x74, 0x76, 0xb6, 0x0d, 0x1e, 0xbd, 0xf8, 0x85, 0xf4, 0xe6, 0x97,
-	0xf0, 0xe8, 0xa7, 0xd1, 0xb0, 0x33, 0x3b, 0xec, 0xc2, 0x02, 0xbb, 0x3d, 0x18, 0x4f, 0x6c, 0x66,
-	0xe6, 0xfd, 0x7e, 0xef, 0xcf, 0x6f, 0xde, 0x3c, 0x20, 0x6f, 0x53, 0xc6, 0x3f, 0x9d, 0x53, 0xd7,
-	0x6e, 0x57, 0xfa, 0x8c, 0x72, 0x8a, 0x4a, 0x3d, 0xb3, 0xd3, 0x33, 0x2b, 0x94, 0xb5, 0x76, 0x58,
-	0xa5, 0x45, 0xed, 0x0b, 0xab, 0xe3, 0x32, 0x93, 0x53, 0x56, 0x7e, 0xe4, 0xed, 0xe8, 0xde, 0x8e,
-	0xee, 0x1d, 0x76, 0xf4, 0x16, 0xed, 0xf5, 0xa8, 0x2d, 0x4c, 0xcb, 0xaf, 0x82, 0x07, 0x5a, 0x5d,
-	0xea, 0xb6, 0xf5, 0x0e, 0xd5, 0x1d, 0xc2, 0xae, 0xad, 0x16, 0x19, 0x9e, 0x1d, 0x81, 0xe9, 0x0e,
-	0xa7, 0xcc, 0xec, 0x10, 0xff, 0x57, 0x20, 0xe0, 0x1d, 0x28, 0x1e, 0x58, 0x0e, 0x3f, 0x24, 0xfc,
-	0x86, 0xb2, 0xcb, 0x66, 0xdd, 0x31, 0x88, 0xd3, 0xa7, 0xb6, 0x43, 0xd0, 0x43, 0x00, 0x5b, 0xad,
-	0x96, 0xb4, 0xf5, 0x85, 0x8d, 0x9c, 0x11, 0x58, 0xc1, 0x3f, 0x34, 0xb8, 0x7f, 0x40, 0xcd, 0xb6,
-	0x34, 0x75, 0x0c, 0x72, 0xe5, 0x12, 0x87, 0xa3, 0x63, 0xc8, 0xb6, 0x98, 0xc5, 0x09, 0xb3, 0xcc,
-	0x52, 0x7a, 0x5d, 0xdb, 0x58, 0xaa, 0xbe, 0xa8, 0x4c, 0x8b, 0xb0, 0xe2, 0x3b, 0x23, 0x41, 0x86,
-	0x78, 0x35, 0x69, 0x6c, 0x28, 0x18, 0xb4, 0x0f, 0x99, 0x0b, 0xab, 0xcb, 0x09, 0x2b, 0x2d, 0x78,
-	0x80, 0x5b, 0x89, 0x00, 0x5f, 0x7b, 0xa6, 0x86, 0x84, 0xc0, 0x1f, 0xa0, 0x50, 0x63, 0xc4, 0xe4,
-	0x64, 0xdc, 0xf1, 0x06, 0x64, 0x65, 0x78, 0x22, 0xdc, 0xa5, 0xea, 0xe3, 0xd8, 0x3c, 0x86, 0x32,
-	0xc5, 0x36, 0x14, 0xc7, 0xf1, 0x65, 0x46, 0xdf, 0x41, 0xbe, 0xe5, 0xed, 0xb4, 0x3f, 0xde, 0x9e,
-	0x68, 0x55, 0x42, 0xf8, 0xe8, 0xf8, 0x33, 0x14, 0xde, 0xf7, 0xdb, 0x11, 0xf1, 0x1c, 0xc3, 0xa2,
-	0xeb, 0x6d, 0xf8, 0x2c, 0xdb, 0xb1, 0x59, 0x04, 0xa0, 0xaa, 0x84, 0x8f, 0x83, 0xb7, 0xa1, 0x50,
-	0x27, 0x5d, 0x32, 0xc9, 0x35, 0x4f, 0x2c, 0xbf, 0xa4, 0x58, 0x1a, 0x36, 0xb7, 0xb8, 0x45, 0x94,
-	0xdd, 0x03, 0xc8, 0xa9, 0x53, 0x25, 0x6d, 0x5d, 0xdb, 0xc8, 0x19, 0xa3, 0x05, 0xf4, 0x56, 0xd5,
-	0x5d, 0x08, 0xa9, 0x3a, 0x3f, 0x00, 0x8f, 0x60, 0x30, 0x59, 0x76, 0x74, 0x14, 0x90, 0xa5, 0x50,
-	0xd1, 0xf3, 0x24, 0x68, 0x93, 0xaa, 0xc4, 0x5f, 0x60, 0xed, 0x74, 0xf8, 0x9d, 0x2c, 0xa6, 0x3a,
-	0x64, 0x6e, 0x86, 0x56, 0x4e, 0x29, 0xed, 0x15, 0xe5, 0xe9, 0x74, 0x2f, 0x46, 0xe8, 0x03, 0x89,
-	0x6d, 0x48, 0x5b, 0xfc, 0x53, 0x03, 0x34, 0xb9, 0x8d, 0x9a, 0x90, 0x11, 0xf2, 0xf0, 0x78, 0x97,
-	0xaa, 0x7a, 0xec, 0x8a, 0x0b, 0x9c, 0x37, 0x29, 0x43, 0x02, 0xa0, 0x23, 0xc8, 0x88, 0xaa, 0xcb,
-	0xdc, 0xbf, 0x8c, 0x9b, 0xad, 0xb0, 0x76, 0x86, 0x88, 0x02, 0x67, 0x2f, 0x07, 0x8b, 0x4c, 0xf8,
-	0x89, 0x7f, 0xa7, 0xa1, 0x30, 0x96, 0x3b, 0x79, 0x47, 0xce, 0x46, 0x77, 0x84, 0xc8, 0x3d, 0xa9,
-	0xde, 0xa4, 0xb1, 0xa8, 0x9b, 0xe2, 0x73, 0x20, 0x0a, 0x79, 0xe1, 0x4a, 0x00, 0x5b, 0x14, 0xa1,
-	0x1e, 0xa7, 0x08, 0x01, 0x37, 0x2b, 0x22, 0x48, 0x05, 0xdd, 0xb0, 0x39, 0x1b, 0x18, 0xab, 0x6e,
-	0x78, 0xb5, 0xec, 0xc0, 0x5a, 0xd4, 0x41, 0x94, 0x87, 0x85, 0x4b, 0x32, 0x90, 0xda, 0x18, 0x7e,
-	0xa2, 0x06, 0xdc, 0xb9, 0x36, 0xbb, 0xae, 0x9f, 0xec, 0xc4, 0xb1, 0x0a, 0xeb, 0xdd, 0xf4, 0x8e,
-	0x86, 0xbf, 0x6a, 0x7e, 0x83, 0x4b, 0x26, 0xcc, 0x7d, 0xc8, 0x8e, 0x65, 0x25, 0xb1, 0x17, 0x0a,
-	0x00, 0x73, 0xbf, 0x09, 0xfe, 0xcb, 0x02, 0xe3, 0x6f, 0x9a, 0xdf, 0x0b, 0x93, 0x85, 0x7e, 0x34,
-	0xea, 0x94, 0x22, 0xf2, 0x5b, 0x8a, 0x7d, 0xd4, 0x28, 0xff, 0x68, 0x50, 0x1c, 0xf7, 0x44, 0x26,
-	0xa0, 0x1f, 0xa1, 0x42, 0x91, 0x80, 0xc6, 0x74, 0xd6, 0x68, 0xac, 0xff, 0x59, 0x86, 0x57, 0xfe,
-	0x53, 0x91, 0xac, 0x14, 0xbb, 0x90, 0x6e, 0xd6, 0x65, 0x15, 0x9e, 0xc4, 0xad, 0x42, 0xb3, 0x6e,
-	0xa4, 0x9b, 0xf5, 0xea, 0xf7, 0x1c, 0x14, 0x0f, 0xd5, 0x74, 0x55, 0x0b, 0x9c, 0x47, 0xa7, 0xb0,
-	0x12, 0x1e, 0x73, 0xd0, 0xbd, 0x10, 0xf8, 0x09, 0xb5, 0xda, 0xe5, 0xcd, 0xe9, 0x7c, 0xd1, 0x33,
-	0x12, 0x4e, 0x21, 0x17, 0x56, 0xc2, 0xaf, 0x3d, 0x9a, 0x91, 0xb4, 0xc8, 0xb9, 0x63, 0x16, 0x6d,
-	0xf4, 0x20, 0x81, 0x53, 0xe8, 0x04, 0x56, 0xc2, 0x8f, 0xfe, 0x2c, 0xda, 0xc8, 0xf1, 0xa0, 0x3c,
-	0x99, 0x00, 0x81, 0x1b, 0x7e, 0xe0, 0x67, 0xe1, 0x46, 0x8e, 0x02, 0xd1, 0xb8, 0x1c, 0xee, 0x06,
-	0x67, 0x45, 0xf4, 0x6c, 0x46, 0xaa, 0x27, 0x67, 0xca, 0x72, 0xb2, 0x81, 0xcf, 0x20, 0x8e, 0xdb,
-	0xe5, 0x38, 0x85, 0x18, 0x2c, 0x87, 0xda, 0x37, 0xaa, 0xc4, 0xee, 0xf3, 0x82, 0x57, 0x4f, 0xf8,
-	0x2e, 0x04, 0x05, 0xa1, 0x48, 0xe7, 0x0a, 0x62, 0x9c, 0x75, 0x33, 0xbe, 0x41, 0x90, 0x36, 0xdc,
-	0x23, 0xe6, 0x0b, 0x22, 0x01, 0x6d, 0x74, 0xfb, 0x09, 0xea, 0x25, 0x0e, 0x6d, 0x64, 0x3f, 0x88,
-	0xd6, 0x8b, 0x23, 0xf4, 0xa2, 0x50, 0xe7, 0xe8, 0x65, 0x1c, 0x33, 0xd1, 0xa0, 0xa8, 0xe4, 0xe2,
-	0xc2, 0x72, 0x8d, 0xba, 0x36, 0xbf, 0x2d, 0xeb, 0x56, 0x5c, 0x56, 0x8f, 0xc5, 0xa7, 0xdd, 0xcb,
-	0x9e, 0x65, 0xc4, 0x7f, 0xbb, 0x73, 0xf1, 0xbb, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0x76, 0x49,
-	0xdc, 0x33, 0x24, 0x0e, 0x00, 0x00,
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0xd4, 0x71, 0x4e, 0xea, 0xd4, 0x4c, 0x93, 0x60, 0xad, 0x50, 0x89, 0xe6, 0x02,
+	0x85, 0x0a, 0xbc, 0xc1, 0x29, 0x6d, 0xd4, 0x2b, 0xa8, 0x6d, 0x84, 0x69, 0x54, 0xa5, 0xa3, 0xd2,
+	0x48, 0xb9, 0x00, 0x6d, 0xd7, 0xd3, 0x64, 0x89, 0xbd, 0xe3, 0xce, 0xce, 0x3a, 0x98, 0x4b, 0x6e,
+	0x10, 0x4f, 0xc2, 0x13, 0x70, 0x07, 0x0f, 0xc1, 0x0d, 0x12, 0xcf, 0xc1, 0x03, 0x80, 0x76, 0x67,
+	0x76, 0xbd, 0xbb, 0x1e, 0xdb, 0xbb, 0x11, 0x42, 0x5c, 0xc5, 0x9d, 0x99, 0xf3, 0x7d, 0xe7, 0xe7,
+	0xdb, 0x39, 0x67, 0x0a, 0x0d, 0x8f, 0x71, 0x71, 0xf9, 0x8a, 0x05, 0xde, 0xa0, 0x35, 0xe6, 0x4c,
+	0x30, 0xd4, 0x1c, 0xd9, 0x17, 0x23, 0xbb, 0xc5, 0xb8, 0x73, 0xcc, 0x5b, 0x0e, 0xf3, 0x5e, 0xbb,
+	0x17, 0x01, 0xb7, 0x05, 0xe3, 0xe6, 0x7b, 0xd1, 0x8e, 0x15, 0xed, 0x58, 0xd1, 0x61, 0xdf, 0x72,
+	0xd8, 0x68, 0xc4, 0x3c, 0x69, 0x6a, 0x7e, 0x9a, 0x3e, 0xe0, 0x0c, 0x59, 0x30, 0xb0, 0x2e, 0x98,
+	0xe5, 0x53, 0x3e, 0x71, 0x1d, 0xea, 0x5b, 0x69, 0x30, 0xcb, 0x17, 0x8c, 0xdb, 0x17, 0x34, 0xfe,
+	0x2b, 0x11, 0xf0, 0x31, 0xec, 0x9d, 0xb8, 0xbe, 0x78, 0x46, 0xc5, 0x35, 0xe3, 0x57, 0xfd, 0xae,
+	0x4f, 0xa8, 0x3f, 0x66, 0x9e, 0x4f, 0xd1, 0x3d, 0x00, 0x2f, 0x59, 0x6d, 0x1a, 0xfb, 0xeb, 0x07,
+	0x9b, 0x24, 0xb5, 0x82, 0x7f, 0x31, 0xe0, 0xee, 0x09, 0xb3, 0x07, 0xca, 0xd4, 0x27, 0xf4, 0x4d,
+	0x40, 0x7d, 0x81, 0x9e, 0x43, 0xcd, 0xe1, 0xae, 0xa0, 0xdc, 0xb5, 0x9b, 0x95, 0x7d, 0xe3, 0x60,
+	0xab, 0xfd, 0x49, 0x6b, 0x51, 0x84, 0xad, 0xd8, 0x19, 0x05, 0x12, 0xe2, 0x75, 0x94, 0x31, 0x49,
+	0x60, 0xd0, 0x53, 0xa8, 0xbe, 0x76, 0x87, 0x82, 0xf2, 0xe6, 0x7a, 0x04, 0x78, 0x54, 0x0a, 0xf0,
+	0xf3, 0xc8, 0x94, 0x28, 0x08, 0xfc, 0x35, 0xec, 0x76, 0x38, 0xb5, 0x05, 0xcd, 0x3b, 0xde, 0x83,
+	0x9a, 0x0a, 0x4f, 0x86, 0xbb, 0xd5, 0xfe, 0xa0, 0x30, 0x0f, 0x49, 0x4c, 0xb1, 0x07, 0x7b, 0x79,
+	0x7c, 0x95, 0xd1, 0x17, 0xd0, 0x70, 0xa2, 0x9d, 0xc1, 0x37, 0x37, 0x27, 0xba, 0xa3, 0x20, 0x62,
+	0x74, 0xfc, 0x2d, 0xec, 0x7e, 0x35, 0x1e, 0x68, 0xe2, 0x79, 0x0e, 0x1b, 0x41, 0xb4, 0x11, 0xb3,
+	0x3c, 0x2a, 0xcc, 0x22, 0x01, 0x93, 0x4a, 0xc4, 0x38, 0xf8, 0x11, 0xec, 0x76, 0xe9, 0x90, 0xce,
+	0x73, 0xad, 0x12, 0xcb, 0xef, 0x4a, 0x2c, 0x3d, 0x4f, 0xb8, 0xc2, 0xa5, 0x89, 0xdd, 0xbb, 0xb0,
+	0x99, 0x9c, 0x6a, 0x1a, 0xfb, 0xc6, 0xc1, 0x26, 0x99, 0x2d, 0xa0, 0x2f, 0x93, 0xba, 0x4b, 0x21,
+	0xb5, 0x57, 0x07, 0x10, 0x11, 0x4c, 0xe7, 0xcb, 0x8e, 0x4e, 0x53, 0xb2, 0x94, 0x2a, 0x7a, 0x50,
+	0x06, 0x6d, 0x5e, 0x95, 0xf8, 0x7b, 0xd8, 0x39, 0x0b, 0x7f, 0x97, 0x8b, 0xa9, 0x0b, 0xd5, 0xeb,
+	0xd0, 0xca, 0x6f, 0x56, 0xa2, 0xa2, 0x7c, 0xb8, 0xd8, 0x8b, 0x19, 0xfa, 0x54, 0x61, 0x13, 0x65,
+	0x8b, 0x7f, 0x35, 0x00, 0xcd, 0x6f, 0xa3, 0x3e, 0x54, 0xa5, 0x3c, 0x22, 0xde, 0xad, 0xb6, 0x55,
+	0xb8, 0xe2, 0x12, 0xe7, 0x8b, 0x35, 0xa2, 0x00, 0xd0, 0x29, 0x54, 0x65, 0xd5, 0x55, 0xee, 0x1f,
+	0x16, 0xcd, 0x56, 0x56, 0x3b, 0x21, 0xa2, 0xc4, 0x79, 0xb2, 0x09, 0x1b, 0x5c, 0xfa, 0x89, 0xff,
+	0xac, 0xc0, 0x6e, 0x2e, 0x77, 0xea, 0x1b, 0x39, 0x9f, 0x7d, 0x23, 0x54, 0xed, 0x29, 0xf5, 0x96,
+	0x8d, 0x25, 0xf9, 0x52, 0x62, 0x0e, 0xc4, 0xa0, 0x21, 0x5d, 0x49, 0x61, 0xcb, 0x22, 0x74, 0x8b,
+	0x14, 0x21, 0xe5, 0x66, 0x4b, 0x06, 0x99, 0x40, 0xf7, 0x3c, 0xc1, 0xa7, 0xe4, 0x4e, 0x90, 0x5d,
+	0x35, 0x7d, 0xd8, 0xd1, 0x1d, 0x44, 0x0d, 0x58, 0xbf, 0xa2, 0x53, 0xa5, 0x8d, 0xf0, 0x27, 0xea,
+	0xc1, 0xad, 0x89, 0x3d, 0x0c, 0xe2, 0x64, 0x97, 0x8e, 0x55, 0x5a, 0x3f, 0xae, 0x1c, 0x1b, 0xf8,
+	0x07, 0x23, 0xbe, 0xe0, 0xca, 0x09, 0xf3, 0x29, 0xd4, 0x72, 0x59, 0x29, 0xed, 0x45, 0x02, 0x80,
+	0x45, 0x7c, 0x09, 0xfe, 0x97, 0x05, 0xc6, 0x3f, 0x1a, 0xf1, 0x5d, 0x58, 0x2e, 0xf4, 0xd3, 0xd9,
+	0x4d, 0x29, 0x23, 0xbf, 0xa1, 0xd8, 0x67, 0x17, 0xe5, 0xdf, 0x06, 0xec, 0xe5, 0x3d, 0x51, 0x09,
+	0x18, 0x6b, 0x54, 0x28, 0x13, 0xd0, 0x5b, 0xcc, 0xaa, 0xc7, 0xfa, 0x3f, 0xcb, 0xf0, 0x4d, 0xdc,
+	0x2a, 0xca, 0x95, 0xe2, 0x31, 0x54, 0xfa, 0x5d, 0x55, 0x85, 0xfb, 0x45, 0xab, 0xd0, 0xef, 0x92,
+	0x4a, 0xbf, 0x8b, 0xff, 0x30, 0x60, 0x27, 0xbc, 0xab, 0x09, 0x9d, 0xb8, 0xbe, 0xcb, 0xbc, 0x82,
+	0x94, 0x27, 0xb9, 0x2e, 0x53, 0xa0, 0x2f, 0xc4, 0x0c, 0x9a, 0x3e, 0x43, 0xe6, 0xfa, 0xcc, 0xc3,
+	0x72, 0x78, 0x9a, 0x4e, 0x73, 0x06, 0xef, 0x10, 0x3a, 0xa1, 0x5c, 0xbc, 0x60, 0xf1, 0xc9, 0x62,
+	0xa1, 0xdd, 0x03, 0xe0, 0xca, 0x20, 0xca, 0x6a, 0xb8, 0x9d, 0x5a, 0xc1, 0x0f, 0x60, 0xa7, 0xf7,
+	0xdd, 0x98, 0xf1, 0x78, 0xfe, 0x2b, 0x84, 0x8a, 0x7f, 0x36, 0xe0, 0xae, 0x32, 0xf8, 0x8c, 0x3b,
+	0x97, 0xee, 0x84, 0x76, 0x2e, 0x03, 0xef, 0x0a, 0x75, 0x60, 0x43, 0x1d, 0x52, 0xed, 0xa7, 0xc4,
+	0x58, 0x13, 0x5b, 0xfe, 0xbb, 0xd7, 0xd0, 0x6f, 0x06, 0xec, 0xf4, 0x47, 0x65, 0x03, 0x44, 0x1d,
+	0xb8, 0xe5, 0x84, 0x11, 0x29, 0x41, 0x7c, 0xb4, 0xd8, 0x01, 0x4d, 0x1a, 0x88, 0xb4, 0x45, 0xf7,
+	0xa1, 0xe1, 0x0c, 0xa9, 0xcd, 0x4f, 0x2f, 0xa7, 0xbe, 0xeb, 0xd8, 0xc3, 0x70, 0x30, 0x0a, 0x05,
+	0x51, 0x23, 0x73, 0xeb, 0x68, 0x0f, 0xaa, 0x03, 0x3e, 0x25, 0x81, 0xd7, 0x7c, 0x2b, 0x3a, 0xa1,
+	0xfe, 0x85, 0x7f, 0x32, 0x60, 0x37, 0xe7, 0xbf, 0xba, 0x45, 0x96, 0x07, 0xf0, 0x3e, 0x6c, 0x67,
+	0xc7, 0xc4, 0x28, 0x92, 0x1a, 0xc9, 0xad, 0x86, 0x3e, 0xba, 0x11, 0xfc, 0xec, 0x6a, 0x88, 0x7c,
+	0xac, 0x93, 0xb9, 0xf5, 0xf6, 0x5f, 0xb7, 0x61, 0xef, 0x59, 0xf2, 0x76, 0xe9, 0xa4, 0xb2, 0x80,
+	0xce, 0x60, 0x3b, 0xfb, 0x88, 0x40, 0x6f, 0x67, 0x52, 0xf6, 0x92, 0xb9, 0x03, 0xf3, 0x70, 0x71,
+	0x16, 0xf5, 0x2f, 0x10, 0xbc, 0x86, 0x02, 0xd8, 0xce, 0xce, 0xd2, 0x68, 0x89, 0x18, 0xb4, 0x53,
+	0xbd, 0x79, 0x58, 0xdc, 0x20, 0xa1, 0x7d, 0x09, 0xdb, 0xd9, 0x91, 0x7a, 0x19, 0xad, 0x76, 0xf8,
+	0x36, 0xe7, 0x13, 0x20, 0x71, 0xb3, 0xe3, 0xf3, 0x32, 0x5c, 0xed, 0xa0, 0xad, 0xc7, 0x15, 0x70,
+	0x3b, 0xfd, 0x12, 0x43, 0x4b, 0x04, 0xab, 0x79, 0xb1, 0x99, 0xe5, 0x9e, 0x53, 0x84, 0xfa, 0xc1,
+	0x50, 0xe0, 0x35, 0xc4, 0xa1, 0x9e, 0x19, 0x8e, 0x50, 0xab, 0xf0, 0x14, 0x25, 0x79, 0xad, 0x92,
+	0x53, 0x57, 0x5a, 0x10, 0x09, 0xe9, 0x4a, 0x41, 0xe4, 0x59, 0x0f, 0x8b, 0x1b, 0xa4, 0x69, 0xb3,
+	0x1d, 0x78, 0xb5, 0x20, 0x4a, 0xd0, 0xea, 0x9b, 0x7b, 0x5a, 0x2f, 0x45, 0x68, 0xb5, 0xdd, 0x56,
+	0xaf, 0x17, 0x5f, 0xea, 0x25, 0x41, 0x5d, 0xa1, 0x97, 0x3c, 0x66, 0xa9, 0x67, 0x58, 0x22, 0x97,
+	0x00, 0xea, 0x1d, 0x16, 0x78, 0xe2, 0xa6, 0xac, 0x47, 0x45, 0x59, 0x23, 0x96, 0x84, 0xf6, 0x1a,
+	0xea, 0x99, 0x99, 0x60, 0x99, 0x4a, 0x75, 0xc3, 0x83, 0x59, 0x72, 0x1c, 0x48, 0x88, 0xcf, 0xa1,
+	0x91, 0x6f, 0xda, 0xe8, 0xe3, 0xc5, 0x58, 0x0b, 0x1a, 0xbc, 0xbe, 0x80, 0x1c, 0xea, 0x99, 0xbe,
+	0xbd, 0x2c, 0x28, 0x5d, 0x83, 0x37, 0xcb, 0xb5, 0x34, 0xbc, 0x76, 0x68, 0x20, 0x01, 0xf5, 0xfe,
+	0xa8, 0x20, 0xa7, 0xae, 0xe7, 0x9a, 0x56, 0xe1, 0xf3, 0xf1, 0x07, 0x70, 0x60, 0x3c, 0xa9, 0x9d,
+	0x57, 0xe5, 0x7f, 0x7c, 0xbd, 0x92, 0x7f, 0x8f, 0xfe, 0x19, 0x00, 0x46, 0x39, 0x2e, 0x46, 0x41,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -829,6 +1169,18 @@ type NorthboundConfiguratorClient interface {
 	LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error)
 	// CountEntities counts the number of Entities specified by the request
 	CountEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityCountResult, error)
+	// LoadRevisions fetches the change history of a network, or of some of
+	// its entities
+	LoadRevisions(ctx context.Context, in *LoadRevisionsRequest, opts ...grpc.CallOption) (*storage.RevisionLoadResult, error)
+	// RevertToRevision restores a network or entity to its state right after
+	// the given revision
+	RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// ExportNetwork snapshots a network and all of its entities, streamed in
+	// chunks
+	ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (NorthboundConfigurator_ExportNetworkClient, error)
+	// ImportNetwork recreates an exported network, and its entities, under
+	// the given network ID. The archive is streamed in chunks.
+	ImportNetwork(ctx context.Context, opts ...grpc.CallOption) (NorthboundConfigurator_ImportNetworkClient, error)
 }
 
 type northboundConfiguratorClient struct {
@@ -938,6 +1290,90 @@ func (c *northboundConfiguratorClient) CountEntities(ctx context.Context, in *Lo
 	return out, nil
 }
 
+func (c *northboundConfiguratorClient) LoadRevisions(ctx context.Context, in *LoadRevisionsRequest, opts ...grpc.CallOption) (*storage.RevisionLoadResult, error) {
+	out := new(storage.RevisionLoadResult)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) RevertToRevision(ctx context.Context, in *RevertToRevisionRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/RevertToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (NorthboundConfigurator_ExportNetworkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NorthboundConfigurator_serviceDesc.Streams[0], "/magma.orc8r.configurator.NorthboundConfigurator/ExportNetwork", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &northboundConfiguratorExportNetworkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NorthboundConfigurator_ExportNetworkClient interface {
+	Recv() (*NetworkArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type northboundConfiguratorExportNetworkClient struct {
+	grpc.ClientStream
+}
+
+func (x *northboundConfiguratorExportNetworkClient) Recv() (*NetworkArchiveChunk, error) {
+	m := new(NetworkArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *northboundConfiguratorClient) ImportNetwork(ctx context.Context, opts ...grpc.CallOption) (NorthboundConfigurator_ImportNetworkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NorthboundConfigurator_serviceDesc.Streams[1], "/magma.orc8r.configurator.NorthboundConfigurator/ImportNetwork", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &northboundConfiguratorImportNetworkClient{stream}
+	return x, nil
+}
+
+type NorthboundConfigurator_ImportNetworkClient interface {
+	Send(*ImportNetworkRequest) error
+	CloseAndRecv() (*ImportNetworkResponse, error)
+	grpc.ClientStream
+}
+
+type northboundConfiguratorImportNetworkClient struct {
+	grpc.ClientStream
+}
+
+func (x *northboundConfiguratorImportNetworkClient) Send(m *ImportNetworkRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *northboundConfiguratorImportNetworkClient) CloseAndRecv() (*ImportNetworkResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportNetworkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NorthboundConfiguratorServer is the server API for NorthboundConfigurator service.
 type NorthboundConfiguratorServer interface {
 	// ListNetworkIDs fetches the list of networkIDs registered
@@ -963,6 +1399,18 @@ type NorthboundConfiguratorServer interface {
 	LoadEntities(context.Context, *LoadEntitiesRequest) (*storage.EntityLoadResult, error)
 	// CountEntities counts the number of Entities specified by the request
 	CountEntities(context.Context, *LoadEntitiesRequest) (*storage.EntityCountResult, error)
+	// LoadRevisions fetches the change history of a network, or of some of
+	// its entities
+	LoadRevisions(context.Context, *LoadRevisionsRequest) (*storage.RevisionLoadResult, error)
+	// RevertToRevision restores a network or entity to its state right after
+	// the given revision
+	RevertToRevision(context.Context, *RevertToRevisionRequest) (*protos.Void, error)
+	// ExportNetwork snapshots a network and all of its entities, streamed in
+	// chunks
+	ExportNetwork(*ExportNetworkRequest, NorthboundConfigurator_ExportNetworkServer) error
+	// ImportNetwork recreates an exported network, and its entities, under
+	// the given network ID. The archive is streamed in chunks.
+	ImportNetwork(NorthboundConfigurator_ImportNetworkServer) error
 }
 
 // UnimplementedNorthboundConfiguratorServer can be embedded to have forward compatible implementations.
@@ -1002,6 +1450,18 @@ func (*UnimplementedNorthboundConfiguratorServer) LoadEntities(ctx context.Conte
 func (*UnimplementedNorthboundConfiguratorServer) CountEntities(ctx context.Context, req *LoadEntitiesRequest) (*storage.EntityCountResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountEntities not implemented")
 }
+func (*UnimplementedNorthboundConfiguratorServer) LoadRevisions(ctx context.Context, req *LoadRevisionsRequest) (*storage.RevisionLoadResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadRevisions not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) RevertToRevision(ctx context.Context, req *RevertToRevisionRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToRevision not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ExportNetwork(req *ExportNetworkRequest, srv NorthboundConfigurator_ExportNetworkServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportNetwork not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ImportNetwork(srv NorthboundConfigurator_ImportNetworkServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportNetwork not implemented")
+}
 
 func RegisterNorthboundConfiguratorServer(s *grpc.Server, srv NorthboundConfiguratorServer) {
 	s.RegisterService(&_NorthboundConfigurator_serviceDesc, srv)
@@ -1205,6 +1665,89 @@ func _NorthboundConfigurator_CountEntities_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NorthboundConfigurator_LoadRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).LoadRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/LoadRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).LoadRevisions(ctx, req.(*LoadRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_RevertToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).RevertToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/RevertToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).RevertToRevision(ctx, req.(*RevertToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_ExportNetwork_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNetworkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NorthboundConfiguratorServer).ExportNetwork(m, &northboundConfiguratorExportNetworkServer{stream})
+}
+
+type NorthboundConfigurator_ExportNetworkServer interface {
+	Send(*NetworkArchiveChunk) error
+	grpc.ServerStream
+}
+
+type northboundConfiguratorExportNetworkServer struct {
+	grpc.ServerStream
+}
+
+func (x *northboundConfiguratorExportNetworkServer) Send(m *NetworkArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NorthboundConfigurator_ImportNetwork_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NorthboundConfiguratorServer).ImportNetwork(&northboundConfiguratorImportNetworkServer{stream})
+}
+
+type NorthboundConfigurator_ImportNetworkServer interface {
+	SendAndClose(*ImportNetworkResponse) error
+	Recv() (*ImportNetworkRequest, error)
+	grpc.ServerStream
+}
+
+type northboundConfiguratorImportNetworkServer struct {
+	grpc.ServerStream
+}
+
+func (x *northboundConfiguratorImportNetworkServer) SendAndClose(m *ImportNetworkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *northboundConfiguratorImportNetworkServer) Recv() (*ImportNetworkRequest, error) {
+	m := new(ImportNetworkRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.configurator.NorthboundConfigurator",
 	HandlerType: (*NorthboundConfiguratorServer)(nil),
@@ -1253,7 +1796,26 @@ var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CountEntities",
 			Handler:    _NorthboundConfigurator_CountEntities_Handler,
 		},
+		{
+			MethodName: "LoadRevisions",
+			Handler:    _NorthboundConfigurator_LoadRevisions_Handler,
+		},
+		{
+			MethodName: "RevertToRevision",
+			Handler:    _NorthboundConfigurator_RevertToRevision_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportNetwork",
+			Handler:       _NorthboundConfigurator_ExportNetwork_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportNetwork",
+			Handler:       _NorthboundConfigurator_ImportNetwork_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "northbound.proto",
 }
@@ -10,6 +10,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	storage "magma/orc8r/cloud/go/services/configurator/storage"
 	protos "magma/orc8r/lib/go/protos"
 	math "math"
 )
@@ -26,10 +27,14 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetMconfigRequest struct {
-	HardwareID           string   `protobuf:"bytes,1,opt,name=hardwareID,proto3" json:"hardwareID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HardwareID string `protobuf:"bytes,1,opt,name=hardwareID,proto3" json:"hardwareID,omitempty"`
+	// If set, the mconfig is rendered as if the previewed changes were
+	// applied. The changes are never committed, and the gateway's staged
+	// rollout, if any, is ignored.
+	Preview              *MconfigPreview `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *GetMconfigRequest) Reset()         { *m = GetMconfigRequest{} }
@@ -64,6 +69,63 @@ func (m *GetMconfigRequest) GetHardwareID() string {
 	return ""
 }
 
+func (m *GetMconfigRequest) GetPreview() *MconfigPreview {
+	if m != nil {
+		return m.Preview
+	}
+	return nil
+}
+
+// MconfigPreview holds proposed configuration changes to render an mconfig
+// against.
+type MconfigPreview struct {
+	NetworkUpdates []*storage.NetworkUpdateCriteria `protobuf:"bytes,1,rep,name=networkUpdates,proto3" json:"networkUpdates,omitempty"`
+	// entityWrites are applied to the gateway's network, in order
+	EntityWrites         []*WriteEntityRequest `protobuf:"bytes,2,rep,name=entityWrites,proto3" json:"entityWrites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MconfigPreview) Reset()         { *m = MconfigPreview{} }
+func (m *MconfigPreview) String() string { return proto.CompactTextString(m) }
+func (*MconfigPreview) ProtoMessage()    {}
+func (*MconfigPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480661e00faacec1, []int{1}
+}
+
+func (m *MconfigPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MconfigPreview.Unmarshal(m, b)
+}
+func (m *MconfigPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MconfigPreview.Marshal(b, m, deterministic)
+}
+func (m *MconfigPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MconfigPreview.Merge(m, src)
+}
+func (m *MconfigPreview) XXX_Size() int {
+	return xxx_messageInfo_MconfigPreview.Size(m)
+}
+func (m *MconfigPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MconfigPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MconfigPreview proto.InternalMessageInfo
+
+func (m *MconfigPreview) GetNetworkUpdates() []*storage.NetworkUpdateCriteria {
+	if m != nil {
+		return m.NetworkUpdates
+	}
+	return nil
+}
+
+func (m *MconfigPreview) GetEntityWrites() []*WriteEntityRequest {
+	if m != nil {
+		return m.EntityWrites
+	}
+	return nil
+}
+
 type GetMconfigResponse struct {
 	// configs contains the mconfigs for the requested hardware ID
 	// The contained configs_by_key should be str->any.Any, where the any.Any
@@ -83,7 +145,7 @@ func (m *GetMconfigResponse) Reset()         { *m = GetMconfigResponse{} }
 func (m *GetMconfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMconfigResponse) ProtoMessage()    {}
 func (*GetMconfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_480661e00faacec1, []int{1}
+	return fileDescriptor_480661e00faacec1, []int{2}
 }
 
 func (m *GetMconfigResponse) XXX_Unmarshal(b []byte) error {
@@ -120,30 +182,39 @@ func (m *GetMconfigResponse) GetLogicalID() string {
 
 func init() {
 	proto.RegisterType((*GetMconfigRequest)(nil), "magma.orc8r.configurator.GetMconfigRequest")
+	proto.RegisterType((*MconfigPreview)(nil), "magma.orc8r.configurator.MconfigPreview")
 	proto.RegisterType((*GetMconfigResponse)(nil), "magma.orc8r.configurator.GetMconfigResponse")
 }
 
 func init() { proto.RegisterFile("southbound.proto", fileDescriptor_480661e00faacec1) }
 
 var fileDescriptor_480661e00faacec1 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0x1b, 0x0f, 0x6a, 0xc7, 0x8b, 0xdd, 0x83, 0x84, 0x28, 0x5a, 0x72, 0x12, 0x94, 0x0d,
-	0xb4, 0x08, 0x9e, 0x3c, 0xd8, 0x42, 0xc9, 0xc1, 0x4b, 0x04, 0x0f, 0xde, 0xa6, 0xc9, 0x9a, 0x06,
-	0x92, 0x9d, 0x76, 0x77, 0x43, 0xf1, 0x33, 0xfa, 0xa5, 0x84, 0x1d, 0xb4, 0x2b, 0xfe, 0xc1, 0xd3,
-	0xc2, 0x9b, 0xdf, 0xe3, 0xcd, 0xbc, 0x85, 0x63, 0x4b, 0xbd, 0x5b, 0x2d, 0xa9, 0xd7, 0x95, 0x5c,
-	0x1b, 0x72, 0x24, 0xe2, 0x0e, 0xeb, 0x0e, 0x25, 0x99, 0xf2, 0xd6, 0xc8, 0x92, 0xf4, 0x4b, 0x53,
-	0xf7, 0x06, 0x1d, 0x99, 0x64, 0xec, 0x27, 0x99, 0x9f, 0x64, 0x1e, 0xb6, 0x59, 0xc7, 0x04, 0x7b,
-	0x93, 0x8b, 0x1f, 0x88, 0x92, 0xba, 0x8e, 0x34, 0x03, 0xe9, 0x14, 0x46, 0x0b, 0xe5, 0x1e, 0xd8,
-	0x54, 0xa8, 0x4d, 0xaf, 0xac, 0x13, 0xe7, 0x00, 0x2b, 0x34, 0xd5, 0x16, 0x8d, 0xca, 0xe7, 0x71,
-	0x34, 0x8e, 0x2e, 0x87, 0x45, 0xa0, 0xa4, 0x0d, 0x88, 0xd0, 0x64, 0xd7, 0xa4, 0xad, 0x12, 0x37,
-	0x70, 0xc0, 0x8a, 0xf5, 0x96, 0xa3, 0xc9, 0xa9, 0x0c, 0x37, 0x5f, 0xa0, 0x53, 0x5b, 0x7c, 0x9d,
-	0x31, 0x52, 0x7c, 0xb0, 0xe2, 0x0c, 0x86, 0x2d, 0xd5, 0x4d, 0x89, 0x6d, 0x3e, 0x8f, 0xf7, 0x7c,
-	0xd6, 0x4e, 0x98, 0xbc, 0x45, 0x70, 0xf2, 0xf8, 0xd9, 0xc8, 0x2c, 0xb8, 0x5e, 0xdc, 0x01, 0xec,
-	0xb6, 0x10, 0xa3, 0x2f, 0x61, 0x4f, 0xd4, 0x54, 0xc9, 0x5f, 0xf9, 0xe9, 0x40, 0x6c, 0xc2, 0x2b,
-	0x72, 0xed, 0x94, 0xd1, 0xd8, 0x8a, 0x2b, 0xf9, 0x5b, 0xdd, 0xf2, 0x5b, 0x51, 0xc9, 0xf5, 0xff,
-	0x60, 0x2e, 0x28, 0x1d, 0xdc, 0x1f, 0x3e, 0xef, 0xf3, 0x27, 0x2c, 0xf9, 0x9d, 0xbe, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xb6, 0xde, 0x9a, 0xfe, 0xef, 0x01, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xd4, 0x40,
+	0x10, 0x85, 0x37, 0x2b, 0xb8, 0x4e, 0x8f, 0x2c, 0xbb, 0x7d, 0x90, 0x10, 0x45, 0x43, 0x4e, 0x01,
+	0x97, 0x04, 0x22, 0xa2, 0x27, 0x91, 0x9d, 0x95, 0x61, 0x0e, 0xca, 0x10, 0x51, 0xc1, 0x8b, 0xf4,
+	0x24, 0x65, 0xa6, 0x31, 0xe9, 0xca, 0x74, 0x77, 0x26, 0xcc, 0xef, 0xf3, 0xe8, 0x9f, 0x12, 0xbb,
+	0x13, 0x27, 0x51, 0x23, 0x7b, 0x6a, 0xe8, 0xfa, 0xde, 0xab, 0xaa, 0x47, 0x91, 0x0b, 0x85, 0x8d,
+	0xde, 0x6e, 0xb0, 0x11, 0x79, 0x54, 0x4b, 0xd4, 0x48, 0xdd, 0x8a, 0x15, 0x15, 0x8b, 0x50, 0x66,
+	0x2f, 0x65, 0x94, 0xa1, 0xf8, 0xca, 0x8b, 0x46, 0x32, 0x8d, 0xd2, 0xf3, 0x4d, 0x25, 0x36, 0x95,
+	0xd8, 0xc0, 0x2a, 0xae, 0x2c, 0x61, 0xb5, 0xde, 0x93, 0x7f, 0x10, 0x19, 0x56, 0x15, 0x8a, 0x0e,
+	0x78, 0x3d, 0x04, 0xb2, 0x12, 0x9b, 0x3c, 0x2e, 0x30, 0x56, 0x20, 0xf7, 0x3c, 0x03, 0x15, 0x5b,
+	0x33, 0xdb, 0x2e, 0x56, 0x1a, 0x25, 0x2b, 0xa0, 0x7f, 0x3b, 0x87, 0x0b, 0x81, 0x72, 0x34, 0x70,
+	0xd0, 0x92, 0xcb, 0x25, 0xe8, 0xb7, 0x56, 0x9b, 0xc2, 0xae, 0x01, 0xa5, 0xe9, 0x63, 0x42, 0xb6,
+	0x4c, 0xe6, 0x2d, 0x93, 0xb0, 0xba, 0x71, 0x1d, 0xdf, 0x09, 0x67, 0xe9, 0xe0, 0x87, 0x5e, 0x93,
+	0xb3, 0x5a, 0xc2, 0x9e, 0x43, 0xeb, 0x9e, 0xfa, 0x4e, 0x38, 0x4f, 0xc2, 0x68, 0x6a, 0xef, 0xa8,
+	0xb3, 0x5e, 0x5b, 0x3e, 0xed, 0x85, 0xc1, 0x77, 0x87, 0x9c, 0x8f, 0x6b, 0xf4, 0x0b, 0x39, 0x17,
+	0xa0, 0x5b, 0x94, 0xdf, 0x3e, 0xd4, 0x39, 0xd3, 0xa0, 0x5c, 0xc7, 0xbf, 0x13, 0xce, 0x93, 0x17,
+	0xd3, 0xee, 0xfd, 0x7a, 0xef, 0x86, 0xba, 0x85, 0xe4, 0x1a, 0x24, 0x67, 0xe9, 0x1f, 0x76, 0x74,
+	0x4d, 0xee, 0x83, 0xd0, 0x5c, 0x1f, 0x3e, 0xfd, 0x22, 0x94, 0x7b, 0x6a, 0xec, 0xaf, 0xa6, 0xed,
+	0x0d, 0xf7, 0xc6, 0x48, 0xba, 0x6c, 0xd2, 0x91, 0x43, 0xc0, 0x09, 0x1d, 0xc6, 0xa7, 0x6a, 0x14,
+	0x0a, 0xe8, 0x73, 0x72, 0x66, 0x7f, 0x94, 0x09, 0x6f, 0x9e, 0x3c, 0x1c, 0xb5, 0x58, 0x32, 0x0d,
+	0x2d, 0x3b, 0x2c, 0x2c, 0x92, 0xf6, 0x2c, 0x7d, 0x44, 0x66, 0x25, 0x16, 0x3c, 0x63, 0xe5, 0xea,
+	0xc6, 0x04, 0x3b, 0x4b, 0x8f, 0x1f, 0xc9, 0x0f, 0x87, 0x3c, 0x78, 0xff, 0xfb, 0xde, 0x16, 0x83,
+	0x31, 0xe9, 0x2b, 0x42, 0x8e, 0x53, 0xd0, 0xcb, 0x51, 0xb3, 0x8f, 0xc8, 0x73, 0xef, 0x7f, 0xfd,
+	0x83, 0x13, 0xba, 0x1b, 0x6e, 0xb1, 0x12, 0x1a, 0xa4, 0x60, 0x25, 0x7d, 0x3a, 0x9d, 0xcb, 0x5f,
+	0x27, 0xe3, 0x5d, 0xdd, 0x0e, 0xb6, 0x01, 0x05, 0x27, 0xd7, 0xf7, 0x3e, 0xdf, 0xb5, 0x27, 0xbe,
+	0xb1, 0xef, 0xb3, 0x9f, 0x03, 0x00, 0xc7, 0x1d, 0xd2, 0x0a, 0x4d, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -13,7 +13,25 @@
 
 package protos
 
-import "github.com/golang/protobuf/ptypes/wrappers"
+import (
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+const (
+	// OperatorMetadataKey is the gRPC metadata key of the operator on whose
+	// behalf configurator writes are made, which the writes' revisions are
+	// attributed to.
+	OperatorMetadataKey = "x-magma-configurator-operator"
+
+	// MaxNetworkArchiveChunkSize bounds the serialized size of the network
+	// archive chunks streamed by ExportNetwork and ImportNetwork, well under
+	// the gRPC message size limit. Entities larger than the bound are sent
+	// in their own chunk.
+	MaxNetworkArchiveChunkSize = 1024 * 1024
+)
 
 func GetStringWrapper(v *string) *wrappers.StringValue {
 	if v == nil {
@@ -21,3 +39,32 @@ func GetStringWrapper(v *string) *wrappers.StringValue {
 	}
 	return &wrappers.StringValue{Value: *v}
 }
+
+// ChunkNetworkArchive splits a network archive into chunks of at most
+// maxSize bytes, apart from entities larger than maxSize, to be streamed.
+// The first chunk holds the network.
+func ChunkNetworkArchive(archive *storage.NetworkArchive, maxSize int) []*NetworkArchiveChunk {
+	chunk := &NetworkArchiveChunk{Network: archive.Network}
+	chunks := []*NetworkArchiveChunk{chunk}
+	size := proto.Size(chunk)
+	for _, ent := range archive.Entities {
+		entSize := proto.Size(ent)
+		if len(chunk.Entities) != 0 && size+entSize > maxSize {
+			chunk = &NetworkArchiveChunk{}
+			chunks = append(chunks, chunk)
+			size = 0
+		}
+		chunk.Entities = append(chunk.Entities, ent)
+		size += entSize
+	}
+	return chunks
+}
+
+// AddToArchive appends the contents of a streamed chunk to the network
+// archive.
+func (m *NetworkArchiveChunk) AddToArchive(archive *storage.NetworkArchive) {
+	if m.GetNetwork() != nil {
+		archive.Network = m.Network
+	}
+	archive.Entities = append(archive.Entities, m.GetEntities()...)
+}
@@ -0,0 +1,425 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rollout stages the release of configuration changes to the
+// gateways of a network.
+//
+// Starting a rollout pins every gateway of the network to its current
+// mconfig. Configuration changes made while the rollout is in progress only
+// reach the gateways selected by its released stages, and each advance
+// releases the next stage. Once the last stage is released, the rollout
+// completes and every gateway gets its current mconfig.
+//
+// A rollout is halted, either explicitly or when too many released gateways
+// stop checking in. Halted rollouts pin every gateway, including released
+// ones, back to its baseline mconfig until the rollout is resumed.
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/rollout/types"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/state/wrappers"
+	"magma/orc8r/cloud/go/storage"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// ErrInvalidRollout is returned, wrapped, when a rollout operation doesn't
+// apply to the network's rollout.
+var ErrInvalidRollout = errors.New("invalid mconfig rollout")
+
+// Get returns the network's rollout.
+// If the network has no rollout, returns ErrNotFound from
+// magma/orc8r/lib/go/errors.
+func Get(networkID string) (*types.Rollout, error) {
+	rollout, _, err := load(networkID)
+	return rollout, err
+}
+
+// Start starts a rollout on the network and releases its first stage.
+// The current mconfig of each gateway is saved as the gateway's baseline, so
+// the rollout should be started before making the changes to roll out.
+// Returns ErrNotFound from magma/orc8r/lib/go/errors if the network doesn't
+// exist.
+func Start(ctx context.Context, networkID string, stages []types.Stage, health types.HealthPolicy) (*types.Rollout, error) {
+	exists, err := configurator.DoesNetworkExist(networkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check network existence")
+	}
+	if !exists {
+		return nil, merrors.ErrNotFound
+	}
+	_, err = Get(networkID)
+	if err == nil {
+		return nil, errors.Wrapf(ErrInvalidRollout, "network %s already has a rollout", networkID)
+	}
+	if err != merrors.ErrNotFound {
+		return nil, err
+	}
+
+	now := clock.Now().UTC()
+	rollout := &types.Rollout{
+		Stages:    stages,
+		State:     types.StateInProgress,
+		Health:    health,
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+	if err := rollout.ValidateModel(); err != nil {
+		return nil, errors.WithMessage(ErrInvalidRollout, err.Error())
+	}
+
+	// Clear baselines left over by a rollout which failed to start
+	err = deleteBaselines(ctx, networkID)
+	if err != nil {
+		return nil, err
+	}
+	gateways, err := loadGateways(networkID)
+	if err != nil {
+		return nil, err
+	}
+	unhealthy, err := getUnhealthyGateways(ctx, networkID, gateways, health)
+	if err != nil {
+		return nil, err
+	}
+	rollout.BaselineUnhealthy = unhealthy
+
+	var writes []configurator.EntityWriteOperation
+	for _, gw := range gateways {
+		res, err := configurator.GetMconfigFor(gw.PhysicalID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get mconfig of gateway %s", gw.Key)
+		}
+		writes = append(writes, configurator.NetworkEntity{
+			Type:   orc8r.MconfigBaselineEntityType,
+			Key:    gw.Key,
+			Config: &types.Baseline{Configs: res.Configs},
+		})
+	}
+	if len(writes) > 0 {
+		err = configurator.WriteEntitiesWithContext(ctx, networkID, writes, types.EntitySerdes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to save mconfig baselines")
+		}
+	}
+
+	err = configurator.UpdateNetworkConfigWithContext(ctx, networkID, orc8r.MconfigRolloutConfigType, rollout, types.NetworkSerdes)
+	if err != nil {
+		if cleanupErr := deleteBaselines(ctx, networkID); cleanupErr != nil {
+			glog.Errorf("Failed to clean up mconfig baselines of network %s: %s", networkID, cleanupErr)
+		}
+		return nil, errors.Wrap(err, "failed to start rollout")
+	}
+	return rollout, nil
+}
+
+// Advance releases the next stage of the network's rollout, or completes
+// the rollout if its last stage is already released.
+// The rollout is halted instead if its released gateways regressed.
+func Advance(ctx context.Context, networkID string) (*types.Rollout, error) {
+	rollout, version, err := load(networkID)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.State != types.StateInProgress {
+		return nil, errors.Wrapf(ErrInvalidRollout, "can't advance %s rollout", rollout.State)
+	}
+
+	halted, err := haltIfRegressed(ctx, networkID, rollout, version)
+	if halted || err != nil {
+		return rollout, err
+	}
+
+	if rollout.CurrentStage == len(rollout.Stages)-1 {
+		err = complete(ctx, networkID, version)
+		if err != nil {
+			return nil, err
+		}
+		rollout.State = types.StateCompleted
+		rollout.UpdatedAt = clock.Now().UTC()
+		return rollout, nil
+	}
+	rollout.CurrentStage++
+	return rollout, store(ctx, networkID, rollout, version)
+}
+
+// Halt halts the network's rollout, pinning every gateway to its baseline
+// mconfig.
+func Halt(ctx context.Context, networkID string, reason string) (*types.Rollout, error) {
+	rollout, version, err := load(networkID)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.State != types.StateInProgress {
+		return nil, errors.Wrapf(ErrInvalidRollout, "can't halt %s rollout", rollout.State)
+	}
+	rollout.State = types.StateHalted
+	rollout.HaltReason = reason
+	return rollout, store(ctx, networkID, rollout, version)
+}
+
+// Resume resumes the network's halted rollout at the stage it was halted at.
+func Resume(ctx context.Context, networkID string) (*types.Rollout, error) {
+	rollout, version, err := load(networkID)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.State != types.StateHalted {
+		return nil, errors.Wrapf(ErrInvalidRollout, "can't resume %s rollout", rollout.State)
+	}
+	rollout.State = types.StateInProgress
+	rollout.HaltReason = ""
+	return rollout, store(ctx, networkID, rollout, version)
+}
+
+// Cancel deletes the network's rollout and the gateways' baselines. Every
+// gateway then gets its current mconfig, so changes which shouldn't be
+// released must be reverted before cancelling.
+func Cancel(ctx context.Context, networkID string) error {
+	_, version, err := load(networkID)
+	if err != nil {
+		return err
+	}
+	return complete(ctx, networkID, version)
+}
+
+// CheckHealth halts the network's rollout if its released gateways
+// regressed. Returns the network's rollout.
+func CheckHealth(ctx context.Context, networkID string) (*types.Rollout, error) {
+	rollout, version, err := load(networkID)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.State != types.StateInProgress {
+		return rollout, nil
+	}
+	_, err = haltIfRegressed(ctx, networkID, rollout, version)
+	return rollout, err
+}
+
+// PeriodicallyCheckHealth checks the health of the rollouts in progress of
+// every network, every dur.
+func PeriodicallyCheckHealth(dur time.Duration) {
+	for range time.Tick(dur) {
+		err := checkAllNetworks(context.Background())
+		if err != nil {
+			glog.Errorf("Failed to check mconfig rollouts: %s", err)
+		}
+	}
+}
+
+func checkAllNetworks(ctx context.Context) error {
+	networks, err := configurator.ListNetworkIDs()
+	if err != nil {
+		return err
+	}
+	for _, networkID := range networks {
+		rollout, err := CheckHealth(ctx, networkID)
+		if err == merrors.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			glog.Errorf("Failed to check mconfig rollout of network %s: %s", networkID, err)
+			continue
+		}
+		if rollout.State == types.StateHalted {
+			glog.Warningf("Mconfig rollout of network %s is halted: %s", networkID, rollout.HaltReason)
+		}
+	}
+	return nil
+}
+
+// haltIfRegressed halts the rollout if more of its released gateways than
+// the health policy allows regressed. Returns true iff the rollout was
+// halted.
+func haltIfRegressed(ctx context.Context, networkID string, rollout *types.Rollout, version uint64) (bool, error) {
+	regressed, err := getRegressedGateways(ctx, networkID, rollout)
+	if err != nil {
+		return false, err
+	}
+	if uint32(len(regressed)) <= rollout.Health.MaxRegressions {
+		return false, nil
+	}
+	rollout.State = types.StateHalted
+	rollout.HaltReason = fmt.Sprintf("released gateways stopped checking in: %s", strings.Join(regressed, ", "))
+	return true, store(ctx, networkID, rollout, version)
+}
+
+// getRegressedGateways returns the released gateways which were healthy
+// when the rollout started, but no longer are.
+func getRegressedGateways(ctx context.Context, networkID string, rollout *types.Rollout) ([]string, error) {
+	gateways, err := loadGateways(networkID)
+	if err != nil {
+		return nil, err
+	}
+	var released configurator.NetworkEntities
+	for _, gw := range gateways {
+		if rollout.IsReleased(gw.Key, getTiers(gw)) {
+			released = append(released, gw)
+		}
+	}
+	unhealthy, err := getUnhealthyGateways(ctx, networkID, released, rollout.Health)
+	if err != nil {
+		return nil, err
+	}
+	var regressed []string
+	for _, gatewayID := range unhealthy {
+		if !funk.ContainsString(rollout.BaselineUnhealthy, gatewayID) {
+			regressed = append(regressed, gatewayID)
+		}
+	}
+	return regressed, nil
+}
+
+// getUnhealthyGateways returns the sorted IDs of the gateways which haven't
+// checked in within the policy's checkin timeout.
+func getUnhealthyGateways(ctx context.Context, networkID string, gateways configurator.NetworkEntities, policy types.HealthPolicy) ([]string, error) {
+	if len(gateways) == 0 {
+		return nil, nil
+	}
+	var hwIDs []string
+	for _, gw := range gateways {
+		hwIDs = append(hwIDs, gw.PhysicalID)
+	}
+	statuses, err := wrappers.GetGatewayStatuses(ctx, networkID, hwIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load gateway statuses")
+	}
+
+	var unhealthy []string
+	for _, gw := range gateways {
+		if !isHealthy(statuses[gw.PhysicalID], policy) {
+			unhealthy = append(unhealthy, gw.Key)
+		}
+	}
+	sort.Strings(unhealthy)
+	return unhealthy, nil
+}
+
+func isHealthy(status *models.GatewayStatus, policy types.HealthPolicy) bool {
+	if status == nil {
+		return false
+	}
+	lastCheckin := time.Unix(0, int64(status.CheckinTime)*int64(time.Millisecond))
+	return clock.Since(lastCheckin) <= policy.GetCheckinTimeout()
+}
+
+// loadGateways loads the network's registered gateways, with their upgrade
+// tiers.
+func loadGateways(networkID string) (configurator.NetworkEntities, error) {
+	gateways, _, err := configurator.LoadEntities(
+		networkID,
+		swag.String(orc8r.MagmadGatewayType),
+		nil,
+		nil,
+		nil,
+		configurator.EntityLoadCriteria{LoadAssocsToThis: true},
+		types.EntitySerdes,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load gateways")
+	}
+	var ret configurator.NetworkEntities
+	for _, gw := range gateways {
+		if gw.PhysicalID != "" {
+			ret = append(ret, gw)
+		}
+	}
+	return ret, nil
+}
+
+func getTiers(gateway configurator.NetworkEntity) []string {
+	var tiers []string
+	for _, parent := range gateway.ParentAssociations {
+		if parent.Type == orc8r.UpgradeTierEntityType {
+			tiers = append(tiers, parent.Key)
+		}
+	}
+	return tiers
+}
+
+// load returns the network's rollout, and the version of the network it was
+// loaded from.
+func load(networkID string) (*types.Rollout, uint64, error) {
+	network, err := configurator.LoadNetwork(networkID, false, true, types.NetworkSerdes)
+	if err != nil {
+		return nil, 0, err
+	}
+	rollout, ok := network.Configs[orc8r.MconfigRolloutConfigType]
+	if !ok {
+		return nil, 0, merrors.ErrNotFound
+	}
+	return rollout.(*types.Rollout), network.Version, nil
+}
+
+// store updates the network's rollout, unless the network changed since
+// version. Returns ErrVersionConflict from magma/orc8r/lib/go/errors
+// otherwise.
+func store(ctx context.Context, networkID string, rollout *types.Rollout, version uint64) error {
+	rollout.UpdatedAt = clock.Now().UTC()
+	return configurator.UpdateNetworksWithContext(
+		ctx,
+		[]configurator.NetworkUpdateCriteria{{
+			ID:                   networkID,
+			ConfigsToAddOrUpdate: map[string]interface{}{orc8r.MconfigRolloutConfigType: rollout},
+			ExpectedVersion:      &version,
+		}},
+		types.NetworkSerdes,
+	)
+}
+
+// complete deletes the network's rollout, then the baselines it pinned the
+// gateways to.
+func complete(ctx context.Context, networkID string, version uint64) error {
+	err := configurator.UpdateNetworksWithContext(
+		ctx,
+		[]configurator.NetworkUpdateCriteria{{
+			ID:              networkID,
+			ConfigsToDelete: []string{orc8r.MconfigRolloutConfigType},
+			ExpectedVersion: &version,
+		}},
+		types.NetworkSerdes,
+	)
+	if err != nil {
+		return err
+	}
+	return deleteBaselines(ctx, networkID)
+}
+
+func deleteBaselines(ctx context.Context, networkID string) error {
+	keys, err := configurator.ListEntityKeys(networkID, orc8r.MconfigBaselineEntityType)
+	if err != nil {
+		return errors.Wrap(err, "failed to list mconfig baselines")
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	var ids storage.TKs
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: orc8r.MconfigBaselineEntityType, Key: key})
+	}
+	return configurator.DeleteEntitiesWithContext(ctx, networkID, ids)
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rollout_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/mconfig"
+	"magma/orc8r/cloud/go/services/configurator/rollout"
+	"magma/orc8r/cloud/go/services/configurator/rollout/types"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/device"
+	device_test_init "magma/orc8r/cloud/go/services/device/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	state_test_init "magma/orc8r/cloud/go/services/state/test_init"
+	"magma/orc8r/cloud/go/services/state/test_utils"
+	storage2 "magma/orc8r/cloud/go/storage"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollout(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	device_test_init.StartTestService(t)
+	state_test_init.StartTestService(t)
+	configurator_test_init.StartNewTestBuilder(t, featuresBuilder{})
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
+	defer clock.UnfreezeClock(t)
+	ctx := context.Background()
+
+	_, err := rollout.Start(ctx, "n1", []types.Stage{{Percentage: 100}}, types.HealthPolicy{})
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// g2 is in tier t1, g3 never checks in
+	err = configurator.CreateNetwork(
+		configurator.Network{ID: "n1", Configs: map[string]interface{}{orc8r.NetworkFeaturesConfig: features("v1")}},
+		serdes.Network,
+	)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"},
+			{Type: orc8r.MagmadGatewayType, Key: "g2", PhysicalID: "hw2"},
+			{Type: orc8r.MagmadGatewayType, Key: "g3", PhysicalID: "hw3"},
+			{Type: orc8r.UpgradeTierEntityType, Key: "t1", Associations: storage2.TKs{{Type: orc8r.MagmadGatewayType, Key: "g2"}}},
+		},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	for _, hwID := range []string{"hw1", "hw2", "hw3"} {
+		err = device.RegisterDevice(ctx, "n1", orc8r.AccessGatewayRecordType, hwID, &models.GatewayDevice{HardwareID: hwID, Key: &models.ChallengeKey{KeyType: "ECHO"}}, serdes.Device)
+		require.NoError(t, err)
+	}
+	checkin(t, "hw1", "hw2")
+
+	_, err = rollout.Get("n1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+	_, err = rollout.Start(ctx, "n1", nil, types.HealthPolicy{})
+	assert.Equal(t, rollout.ErrInvalidRollout, errors.Cause(err))
+
+	stages := []types.Stage{{Gateways: []string{"g1"}}, {Tiers: []string{"t1"}}, {Percentage: 100}}
+	started, err := rollout.Start(ctx, "n1", stages, types.HealthPolicy{})
+	require.NoError(t, err)
+	expected := &types.Rollout{
+		Stages:            stages,
+		State:             types.StateInProgress,
+		BaselineUnhealthy: []string{"g3"},
+		StartedAt:         clock.Now().UTC(),
+		UpdatedAt:         clock.Now().UTC(),
+	}
+	assert.Equal(t, expected, started)
+	_, err = rollout.Start(ctx, "n1", stages, types.HealthPolicy{})
+	assert.Equal(t, rollout.ErrInvalidRollout, errors.Cause(err))
+
+	// Only released gateways get the change
+	err = configurator.UpdateNetworkConfig("n1", orc8r.NetworkFeaturesConfig, features("v2"), serdes.Network)
+	require.NoError(t, err)
+	assertVersions(t, map[string]string{"hw1": "v2", "hw2": "v1", "hw3": "v1"})
+
+	advanced, err := rollout.Advance(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, advanced.CurrentStage)
+	assertVersions(t, map[string]string{"hw1": "v2", "hw2": "v2", "hw3": "v1"})
+
+	// Halted rollouts pin released gateways back to their baseline
+	halted, err := rollout.Halt(ctx, "n1", "investigating")
+	require.NoError(t, err)
+	assert.Equal(t, types.StateHalted, halted.State)
+	assert.Equal(t, "investigating", halted.HaltReason)
+	assertVersions(t, map[string]string{"hw1": "v1", "hw2": "v1", "hw3": "v1"})
+	_, err = rollout.Advance(ctx, "n1")
+	assert.Equal(t, rollout.ErrInvalidRollout, errors.Cause(err))
+
+	resumed, err := rollout.Resume(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, types.StateInProgress, resumed.State)
+	assert.Empty(t, resumed.HaltReason)
+	assertVersions(t, map[string]string{"hw1": "v2", "hw2": "v2", "hw3": "v1"})
+	_, err = rollout.Resume(ctx, "n1")
+	assert.Equal(t, rollout.ErrInvalidRollout, errors.Cause(err))
+
+	// Released gateways stop checking in
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0).Add(10*time.Minute))
+	checked, err := rollout.CheckHealth(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, types.StateHalted, checked.State)
+	assert.Equal(t, "released gateways stopped checking in: g1, g2", checked.HaltReason)
+	assertVersions(t, map[string]string{"hw1": "v1", "hw2": "v1", "hw3": "v1"})
+
+	// Gateways which were unhealthy at start don't count as regressions
+	checkin(t, "hw1", "hw2")
+	_, err = rollout.Resume(ctx, "n1")
+	require.NoError(t, err)
+	advanced, err = rollout.Advance(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, advanced.CurrentStage)
+	assert.Equal(t, types.StateInProgress, advanced.State)
+	assertVersions(t, map[string]string{"hw1": "v2", "hw2": "v2", "hw3": "v2"})
+
+	completed, err := rollout.Advance(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, types.StateCompleted, completed.State)
+	_, err = rollout.Get("n1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+	baselines, err := configurator.ListEntityKeys("n1", orc8r.MconfigBaselineEntityType)
+	require.NoError(t, err)
+	assert.Empty(t, baselines)
+
+	// Cancel releases the current mconfig to every gateway
+	_, err = rollout.Start(ctx, "n1", []types.Stage{{Gateways: []string{"g1"}}}, types.HealthPolicy{})
+	require.NoError(t, err)
+	err = configurator.UpdateNetworkConfig("n1", orc8r.NetworkFeaturesConfig, features("v3"), serdes.Network)
+	require.NoError(t, err)
+	assertVersions(t, map[string]string{"hw1": "v3", "hw2": "v2", "hw3": "v2"})
+	require.NoError(t, rollout.Cancel(ctx, "n1"))
+	assertVersions(t, map[string]string{"hw1": "v3", "hw2": "v3", "hw3": "v3"})
+	assert.Equal(t, merrors.ErrNotFound, rollout.Cancel(ctx, "n1"))
+}
+
+// featuresBuilder builds the network's features config into the mconfig
+type featuresBuilder struct{}
+
+func (featuresBuilder) Build(network *storage.Network, graph *storage.EntityGraph, gatewayID string) (mconfig.ConfigsByKey, error) {
+	return mconfig.ConfigsByKey{"features": network.Configs[orc8r.NetworkFeaturesConfig]}, nil
+}
+
+func features(version string) *models.NetworkFeatures {
+	return &models.NetworkFeatures{Features: map[string]string{"version": version}}
+}
+
+func checkin(t *testing.T, hwIDs ...string) {
+	for _, hwID := range hwIDs {
+		ctx := test_utils.GetContextWithCertificate(t, hwID)
+		test_utils.ReportGatewayStatus(t, ctx, models.NewDefaultGatewayStatus(hwID))
+	}
+}
+
+func assertVersions(t *testing.T, expected map[string]string) {
+	actual := map[string]string{}
+	for hwID := range expected {
+		res, err := configurator.GetMconfigFor(hwID)
+		require.NoError(t, err)
+		bytesVal := &wrappers.BytesValue{}
+		require.NoError(t, ptypes.UnmarshalAny(res.Configs.ConfigsByKey["features"], bytesVal))
+		cfg := &models.NetworkFeatures{}
+		require.NoError(t, json.Unmarshal(bytesVal.Value, cfg))
+		actual[hwID] = cfg.Features["version"]
+	}
+	assert.Equal(t, expected, actual)
+}
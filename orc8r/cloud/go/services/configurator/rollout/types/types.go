@@ -0,0 +1,207 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package types contains the configurator representation of staged mconfig
+// rollouts, shared by the southbound configurator servicer, which pins
+// gateways to their baseline mconfig, and the rollout client API.
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// DefaultCheckinTimeoutSecs is the default duration after which a gateway
+// which hasn't checked in is considered unhealthy.
+const DefaultCheckinTimeoutSecs = 5 * 60
+
+var (
+	// NetworkSerdes contains the package's configurator network config serdes
+	NetworkSerdes = serde.NewRegistry(
+		configurator.NewNetworkConfigSerde(orc8r.MconfigRolloutConfigType, &Rollout{}),
+	)
+	// EntitySerdes contains the package's configurator network entity serdes
+	EntitySerdes = serde.NewRegistry(
+		configurator.NewNetworkEntityConfigSerde(orc8r.MconfigBaselineEntityType, &Baseline{}),
+	)
+)
+
+// State is the state of a rollout.
+type State string
+
+const (
+	// StateInProgress rollouts release the current mconfig to the gateways
+	// selected by their released stages.
+	StateInProgress State = "in_progress"
+	// StateHalted rollouts pin every gateway to its baseline mconfig.
+	StateHalted State = "halted"
+	// StateCompleted rollouts released the current mconfig to every gateway.
+	// They're deleted once completed, so this state is never stored.
+	StateCompleted State = "completed"
+)
+
+// Rollout is a staged rollout of a network's configuration changes.
+//
+// When a rollout starts, the mconfig of each gateway of the network is saved
+// as the gateway's baseline. Until the rollout completes, gateways only get
+// their current mconfig once one of the released stages selects them, and
+// get their baseline mconfig otherwise.
+type Rollout struct {
+	// Stages are released in order. A stage's gateways stay released once
+	// later stages are.
+	Stages []Stage `json:"stages"`
+	// CurrentStage is the index of the last released stage
+	CurrentStage int          `json:"current_stage"`
+	State        State        `json:"state"`
+	HaltReason   string       `json:"halt_reason,omitempty"`
+	Health       HealthPolicy `json:"health"`
+	// BaselineUnhealthy are the gateways which were already unhealthy when
+	// the rollout started. They don't count as regressions.
+	BaselineUnhealthy []string  `json:"baseline_unhealthy_gateways,omitempty"`
+	StartedAt         time.Time `json:"started_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Stage selects gateways to release the current mconfig to. A gateway is
+// selected if it matches any of the stage's selectors.
+type Stage struct {
+	// Percentage of the network's gateways to select. Gateways are bucketed
+	// by a hash of their ID, so a gateway selected by a percentage is also
+	// selected by any higher percentage.
+	Percentage uint32 `json:"percentage,omitempty"`
+	// Tiers selects the gateways of the listed upgrade tiers
+	Tiers []string `json:"tiers,omitempty"`
+	// Gateways selects the listed gateways
+	Gateways []string `json:"gateways,omitempty"`
+}
+
+// HealthPolicy determines when a rollout is halted.
+// A released gateway regresses when it was healthy when the rollout started,
+// and no longer checks in.
+type HealthPolicy struct {
+	// CheckinTimeoutSecs is the duration after which a gateway which hasn't
+	// checked in is unhealthy. Defaults to DefaultCheckinTimeoutSecs.
+	CheckinTimeoutSecs uint32 `json:"checkin_timeout_secs,omitempty"`
+	// MaxRegressions is the number of released gateways which can regress
+	// before the rollout is halted
+	MaxRegressions uint32 `json:"max_regressions"`
+}
+
+// GetCheckinTimeout returns the policy's checkin timeout, or the default
+// timeout if it's unset.
+func (p HealthPolicy) GetCheckinTimeout() time.Duration {
+	if p.CheckinTimeoutSecs == 0 {
+		return DefaultCheckinTimeoutSecs * time.Second
+	}
+	return time.Duration(p.CheckinTimeoutSecs) * time.Second
+}
+
+func (m *Rollout) MarshalBinary() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *Rollout) UnmarshalBinary(b []byte) error {
+	return json.Unmarshal(b, m)
+}
+
+// ValidateModel returns an error if the rollout is malformed.
+func (m *Rollout) ValidateModel() error {
+	if len(m.Stages) == 0 {
+		return fmt.Errorf("rollout must have at least one stage")
+	}
+	for i, stage := range m.Stages {
+		if stage.Percentage > 100 {
+			return fmt.Errorf("stage %d: percentage must be at most 100", i)
+		}
+		if stage.Percentage == 0 && len(stage.Tiers) == 0 && len(stage.Gateways) == 0 {
+			return fmt.Errorf("stage %d doesn't select any gateway", i)
+		}
+	}
+	if m.CurrentStage < 0 || m.CurrentStage >= len(m.Stages) {
+		return fmt.Errorf("current stage %d out of range", m.CurrentStage)
+	}
+	switch m.State {
+	case StateInProgress, StateHalted, StateCompleted:
+	default:
+		return fmt.Errorf("invalid rollout state %q", m.State)
+	}
+	return nil
+}
+
+// IsReleased returns true iff the gateway should get its current mconfig,
+// given the upgrade tiers it belongs to.
+func (m *Rollout) IsReleased(gatewayID string, tiers []string) bool {
+	switch m.State {
+	case StateCompleted:
+		return true
+	case StateHalted:
+		return false
+	}
+	for i := 0; i <= m.CurrentStage && i < len(m.Stages); i++ {
+		if m.Stages[i].selects(gatewayID, tiers) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Stage) selects(gatewayID string, tiers []string) bool {
+	if s.Percentage > 0 && bucket(gatewayID) < s.Percentage {
+		return true
+	}
+	for _, gw := range s.Gateways {
+		if gw == gatewayID {
+			return true
+		}
+	}
+	for _, selected := range s.Tiers {
+		for _, tier := range tiers {
+			if tier == selected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bucket maps a gateway ID to [0, 100).
+func bucket(gatewayID string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(gatewayID))
+	return h.Sum32() % 100
+}
+
+// Baseline is the mconfig a gateway is pinned to during a rollout. It's
+// stored as the config of the gateway's baseline entity, keyed by the
+// gateway ID.
+type Baseline struct {
+	Configs *protos.GatewayConfigs
+}
+
+func (m *Baseline) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(m.Configs)
+}
+
+func (m *Baseline) UnmarshalBinary(b []byte) error {
+	m.Configs = &protos.GatewayConfigs{}
+	return proto.Unmarshal(b, m.Configs)
+}
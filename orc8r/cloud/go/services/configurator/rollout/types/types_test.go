@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"magma/orc8r/cloud/go/services/configurator/rollout/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollout_IsReleased(t *testing.T) {
+	rollout := &types.Rollout{
+		Stages: []types.Stage{
+			{Gateways: []string{"canary"}},
+			{Tiers: []string{"t1"}, Percentage: 30},
+			{Percentage: 100},
+		},
+		State: types.StateInProgress,
+	}
+	assert.True(t, rollout.IsReleased("canary", nil))
+	assert.False(t, rollout.IsReleased("gw", []string{"t1"}))
+
+	rollout.CurrentStage = 1
+	assert.True(t, rollout.IsReleased("canary", nil))
+	assert.True(t, rollout.IsReleased("gw", []string{"t0", "t1"}))
+	// Percentages select roughly that share of gateways
+	released := 0
+	for i := 0; i < 1000; i++ {
+		if rollout.IsReleased(fmt.Sprintf("gw%d", i), nil) {
+			released++
+		}
+	}
+	assert.InDelta(t, 300, released, 60)
+
+	rollout.CurrentStage = 2
+	assert.True(t, rollout.IsReleased("gw", nil))
+
+	rollout.State = types.StateHalted
+	assert.False(t, rollout.IsReleased("canary", nil))
+	rollout.State = types.StateCompleted
+	assert.True(t, rollout.IsReleased("gw", nil))
+}
+
+func TestRollout_ValidateModel(t *testing.T) {
+	rollout := &types.Rollout{Stages: []types.Stage{{Percentage: 10}}, State: types.StateInProgress}
+	assert.NoError(t, rollout.ValidateModel())
+
+	rollout.Stages = nil
+	assert.EqualError(t, rollout.ValidateModel(), "rollout must have at least one stage")
+	rollout.Stages = []types.Stage{{Percentage: 101}}
+	assert.EqualError(t, rollout.ValidateModel(), "stage 0: percentage must be at most 100")
+	rollout.Stages = []types.Stage{{Percentage: 10}, {}}
+	assert.EqualError(t, rollout.ValidateModel(), "stage 1 doesn't select any gateway")
+	rollout.Stages = []types.Stage{{Percentage: 10}}
+	rollout.CurrentStage = 1
+	assert.EqualError(t, rollout.ValidateModel(), "current stage 1 out of range")
+	rollout.CurrentStage = 0
+	rollout.State = "done"
+	assert.EqualError(t, rollout.ValidateModel(), `invalid rollout state "done"`)
+}
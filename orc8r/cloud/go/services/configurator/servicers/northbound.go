@@ -16,13 +16,18 @@ package servicers
 import (
 	"context"
 	"fmt"
+	"io"
 
+	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	orc8rStorage "magma/orc8r/cloud/go/storage"
 	commonProtos "magma/orc8r/lib/go/protos"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -74,7 +79,7 @@ func (srv *nbConfiguratorServicer) ListNetworkIDs(context context.Context, void
 
 func (srv *nbConfiguratorServicer) CreateNetworks(context context.Context, req *protos.CreateNetworksRequest) (*protos.CreateNetworksResponse, error) {
 	emptyRes := &protos.CreateNetworksResponse{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return emptyRes, err
 	}
@@ -93,7 +98,7 @@ func (srv *nbConfiguratorServicer) CreateNetworks(context context.Context, req *
 
 func (srv *nbConfiguratorServicer) UpdateNetworks(context context.Context, req *protos.UpdateNetworksRequest) (*commonProtos.Void, error) {
 	void := &commonProtos.Void{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return void, err
 	}
@@ -105,14 +110,14 @@ func (srv *nbConfiguratorServicer) UpdateNetworks(context context.Context, req *
 	err = store.UpdateNetworks(updates)
 	if err != nil {
 		storage.RollbackLogOnError(store)
-		return void, err
+		return void, toVersionConflictStatus(err)
 	}
 	return void, store.Commit()
 }
 
 func (srv *nbConfiguratorServicer) DeleteNetworks(context context.Context, req *protos.DeleteNetworksRequest) (*commonProtos.Void, error) {
 	void := &commonProtos.Void{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return void, err
 	}
@@ -160,7 +165,7 @@ func (srv *nbConfiguratorServicer) CountEntities(context context.Context, req *p
 
 func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *protos.WriteEntitiesRequest) (*protos.WriteEntitiesResponse, error) {
 	emptyRes := &protos.WriteEntitiesResponse{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return emptyRes, err
 	}
@@ -179,6 +184,10 @@ func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *p
 			ret.CreatedEntities = append(ret.CreatedEntities, &createdEnt)
 		case *protos.WriteEntityRequest_Update:
 			updatedEnt, err := store.UpdateEntity(req.NetworkID, *op.Update)
+			if errors.Cause(err) == storage.ErrVersionConflict {
+				storage.RollbackLogOnError(store)
+				return emptyRes, toVersionConflictStatus(err)
+			}
 			if err != nil {
 				storage.RollbackLogOnError(store)
 				return emptyRes, status.Error(codes.Internal, err.Error())
@@ -194,7 +203,7 @@ func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *p
 
 func (srv *nbConfiguratorServicer) CreateEntities(context context.Context, req *protos.CreateEntitiesRequest) (*protos.CreateEntitiesResponse, error) {
 	emptyRes := &protos.CreateEntitiesResponse{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return emptyRes, err
 	}
@@ -213,7 +222,7 @@ func (srv *nbConfiguratorServicer) CreateEntities(context context.Context, req *
 
 func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *protos.UpdateEntitiesRequest) (*protos.UpdateEntitiesResponse, error) {
 	emptyRes := &protos.UpdateEntitiesResponse{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return emptyRes, err
 	}
@@ -223,7 +232,7 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 		updatedEntity, err := store.UpdateEntity(req.NetworkID, *update)
 		if err != nil {
 			storage.RollbackLogOnError(store)
-			return emptyRes, err
+			return emptyRes, toVersionConflictStatus(err)
 		}
 		updatedEntities[update.Key] = &updatedEntity
 	}
@@ -232,7 +241,7 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 
 func (srv *nbConfiguratorServicer) DeleteEntities(context context.Context, req *protos.DeleteEntitiesRequest) (*commonProtos.Void, error) {
 	void := &commonProtos.Void{}
-	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	store, err := srv.startWriteTransaction(context)
 	if err != nil {
 		return void, err
 	}
@@ -251,3 +260,200 @@ func (srv *nbConfiguratorServicer) DeleteEntities(context context.Context, req *
 	}
 	return void, store.Commit()
 }
+
+func (srv *nbConfiguratorServicer) LoadRevisions(context context.Context, req *protos.LoadRevisionsRequest) (*storage.RevisionLoadResult, error) {
+	emptyRes := &storage.RevisionLoadResult{}
+	if req.Filter == nil || req.Criteria == nil {
+		return emptyRes, status.Error(codes.InvalidArgument, "revision load filter and criteria must be set")
+	}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return emptyRes, err
+	}
+
+	res, err := store.LoadRevisions(req.NetworkID, *req.Filter, *req.Criteria)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	return &res, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) RevertToRevision(context context.Context, req *protos.RevertToRevisionRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	store, err := srv.startWriteTransaction(context)
+	if err != nil {
+		return void, err
+	}
+
+	loaded, err := store.LoadRevisions(
+		req.NetworkID,
+		storage.RevisionLoadFilter{Id: &wrappers.StringValue{Value: req.RevisionID}},
+		storage.RevisionLoadCriteria{},
+	)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	if len(loaded.Revisions) == 0 {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.NotFound, "revision %s not found in network %s", req.RevisionID, req.NetworkID)
+	}
+	revision := loaded.Revisions[0]
+	gatewayID, err := getOwningGatewayID(store, revision)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, status.Error(codes.Internal, err.Error())
+	}
+	err = storage.RevertToRevision(store, revision)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, status.Error(codes.Internal, err.Error())
+	}
+	// Gateways are versioned as a whole by their magmad gateway entity, so
+	// reverting any of a gateway's entities bumps the magmad gateway's
+	// version, like the gateway handlers do
+	if gatewayID != "" {
+		_, err = store.UpdateEntity(req.NetworkID, storage.EntityUpdateCriteria{Type: orc8r.MagmadGatewayType, Key: gatewayID})
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return void, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return void, store.Commit()
+}
+
+// getOwningGatewayID returns the ID of the magmad gateway which the entity
+// mutated by the revision belongs to, or an empty string if there is none.
+// A gateway's entities, e.g. its cellular gateway, share the gateway's key
+// and are associated from its magmad gateway.
+func getOwningGatewayID(store storage.ConfiguratorStorage, revision *storage.Revision) (string, error) {
+	if revision.Type == "" || revision.Type == orc8r.MagmadGatewayType {
+		return "", nil
+	}
+	loaded, err := store.LoadEntities(
+		revision.NetworkID,
+		storage.EntityLoadFilter{IDs: []*storage.EntityID{{Type: orc8r.MagmadGatewayType, Key: revision.Key}}},
+		storage.EntityLoadCriteria{LoadAssocsFromThis: true},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load gateway of reverted entity")
+	}
+	for _, gateway := range loaded.Entities {
+		for _, assoc := range gateway.Associations {
+			if assoc.Type == revision.Type && assoc.Key == revision.Key {
+				return gateway.Key, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (srv *nbConfiguratorServicer) ExportNetwork(req *protos.ExportNetworkRequest, stream protos.NorthboundConfigurator_ExportNetworkServer) error {
+	store, err := srv.factory.StartTransaction(stream.Context(), &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+
+	archive, err := storage.ExportNetwork(store, req.NetworkID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return err
+	}
+	if archive == nil {
+		storage.RollbackLogOnError(store)
+		return status.Errorf(codes.NotFound, "network %s not found", req.NetworkID)
+	}
+	if err = store.Commit(); err != nil {
+		return err
+	}
+
+	for _, chunk := range protos.ChunkNetworkArchive(archive, protos.MaxNetworkArchiveChunkSize) {
+		if err = stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (srv *nbConfiguratorServicer) ImportNetwork(stream protos.NorthboundConfigurator_ImportNetworkServer) error {
+	// Receive the whole archive before starting the transaction, so it isn't
+	// held open while the client streams
+	var opts *protos.ImportNetworkRequest
+	archive := &storage.NetworkArchive{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if opts == nil {
+			opts = req
+		}
+		req.GetChunk().AddToArchive(archive)
+	}
+	if opts == nil || archive.Network == nil {
+		return status.Error(codes.InvalidArgument, "network archive must be set")
+	}
+
+	store, err := srv.startWriteTransaction(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	networkID := opts.NetworkID
+	if networkID == "" {
+		networkID = archive.Network.GetID()
+	}
+	createdNetwork, err := storage.ImportNetwork(store, networkID, archive, opts.ClearPhysicalIDs)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		if errors.Cause(err) == storage.ErrInvalidNetworkArchive {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return err
+	}
+	res := &protos.ImportNetworkResponse{
+		NetworkID:        networkID,
+		CreatedNetwork:   createdNetwork,
+		ImportedEntities: uint32(len(archive.Entities)),
+	}
+	// Dry runs apply the whole import, so storage constraints are validated
+	// too, then discard it
+	if opts.DryRun {
+		err = store.Rollback()
+	} else {
+		err = store.Commit()
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+// startWriteTransaction starts a transaction which records its network and
+// entity mutations as revisions, attributed to the operator set in the
+// request's metadata, if any.
+func (srv *nbConfiguratorServicer) startWriteTransaction(ctx context.Context) (storage.ConfiguratorStorage, error) {
+	store, err := srv.factory.StartTransaction(ctx, &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return nil, err
+	}
+	operator := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(protos.OperatorMetadataKey)) != 0 {
+		operator = md.Get(protos.OperatorMetadataKey)[0]
+	}
+	return storage.NewRevisionRecordingStorage(store, operator), nil
+}
+
+// toVersionConflictStatus converts version conflicts to an Aborted status,
+// so clients can tell them apart and restart their read-modify-write.
+// Other errors are returned as-is.
+func toVersionConflictStatus(err error) error {
+	if errors.Cause(err) == storage.ErrVersionConflict {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
@@ -20,6 +20,7 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator/mconfig"
 	cfg_protos "magma/orc8r/cloud/go/services/configurator/protos"
+	rollout_types "magma/orc8r/cloud/go/services/configurator/rollout/types"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	orc8r_storage "magma/orc8r/cloud/go/storage"
 	"magma/orc8r/lib/go/protos"
@@ -73,7 +74,12 @@ func (srv *sbConfiguratorServicer) GetMconfigInternal(ctx context.Context, req *
 	storage.CommitLogOnError(store)
 
 	ent := loadResult.Entities[0]
-	cfg, err := srv.getMconfigImpl(ent.NetworkID, ent.Key)
+	var cfg *protos.GatewayConfigs
+	if req.Preview != nil {
+		cfg, err = srv.previewMconfig(ctx, ent.NetworkID, ent.Key, req.Preview)
+	} else {
+		cfg, err = srv.getMconfigImpl(ent.NetworkID, ent.Key)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -87,30 +93,168 @@ func (srv *sbConfiguratorServicer) getMconfigImpl(networkID string, gatewayID st
 		return nil, status.Errorf(codes.Aborted, "failed to start transaction: %s", err)
 	}
 
+	network, graph, err := loadMconfigInputs(store, networkID, gatewayID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return nil, err
+	}
+	baseline, err := loadRolloutBaseline(store, network, graph, gatewayID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return nil, err
+	}
+
+	// Error on commit is fine for a readonly tx
+	storage.CommitLogOnError(store)
+
+	if baseline != nil {
+		return baseline, nil
+	}
+	return buildMconfig(network, graph, gatewayID)
+}
+
+// previewMconfig builds the mconfig of the gateway after applying the
+// previewed changes to its network. The changes are always rolled back.
+func (srv *sbConfiguratorServicer) previewMconfig(ctx context.Context, networkID string, gatewayID string, preview *cfg_protos.MconfigPreview) (*protos.GatewayConfigs, error) {
+	store, err := srv.factory.StartTransaction(ctx, nil)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return nil, status.Errorf(codes.Aborted, "failed to start transaction: %s", err)
+	}
+
+	err = applyPreview(store, networkID, preview)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to apply previewed changes: %s", err)
+	}
+	network, graph, err := loadMconfigInputs(store, networkID, gatewayID)
+	// Previewed changes are never committed
+	storage.RollbackLogOnError(store)
+	if err != nil {
+		return nil, err
+	}
+	return buildMconfig(network, graph, gatewayID)
+}
+
+// applyPreview applies the previewed changes to the store. As with any other
+// write, configs arrive serialized by the serdes of their models, see
+// configurator.PreviewMconfig.
+func applyPreview(store storage.ConfiguratorStorage, networkID string, preview *cfg_protos.MconfigPreview) error {
+	var networkUpdates []storage.NetworkUpdateCriteria
+	for _, update := range preview.NetworkUpdates {
+		if update.ID == "" {
+			update.ID = networkID
+		}
+		if update.ID != networkID {
+			return fmt.Errorf("network update for %s doesn't apply to the gateway's network %s", update.ID, networkID)
+		}
+		if update.DeleteNetwork {
+			return fmt.Errorf("can't preview the deletion of network %s", networkID)
+		}
+		networkUpdates = append(networkUpdates, *update)
+	}
+	if len(networkUpdates) > 0 {
+		if err := store.UpdateNetworks(networkUpdates); err != nil {
+			return err
+		}
+	}
+
+	for _, write := range preview.EntityWrites {
+		var err error
+		switch op := write.Request.(type) {
+		case *cfg_protos.WriteEntityRequest_Create:
+			_, err = store.CreateEntity(networkID, *op.Create)
+		case *cfg_protos.WriteEntityRequest_Update:
+			_, err = store.UpdateEntity(networkID, *op.Update)
+		default:
+			err = fmt.Errorf("write request %T not recognized", write.Request)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadMconfigInputs loads the network and the entity graph the gateway's
+// mconfig is built from.
+func loadMconfigInputs(store storage.ConfiguratorStorage, networkID string, gatewayID string) (*storage.Network, *storage.EntityGraph, error) {
 	graph, err := store.LoadGraphForEntity(
 		networkID,
 		storage.EntityID{Type: orc8r.MagmadGatewayType, Key: gatewayID},
 		storage.FullEntityLoadCriteria,
 	)
 	if err != nil {
-		storage.RollbackLogOnError(store)
-		return nil, status.Errorf(codes.Internal, "failed to load entity graph: %s", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to load entity graph: %s", err)
 	}
 
 	nwLoad, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{networkID}}, storage.FullNetworkLoadCriteria)
 	if err != nil {
-		storage.RollbackLogOnError(store)
-		return nil, status.Errorf(codes.Internal, "failed to load network: %s", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to load network: %s", err)
 	}
 	if !funk.IsEmpty(nwLoad.NetworkIDsNotFound) || funk.IsEmpty(nwLoad.Networks) {
-		storage.RollbackLogOnError(store)
-		return nil, status.Errorf(codes.Internal, "network %s not found: %s", networkID, err)
+		return nil, nil, status.Errorf(codes.Internal, "network %s not found: %s", networkID, err)
 	}
+	return nwLoad.Networks[0], &graph, nil
+}
 
-	// Error on commit is fine for a readonly tx
-	storage.CommitLogOnError(store)
+// loadRolloutBaseline returns the mconfig the gateway is pinned to by the
+// network's staged rollout, or nil if the gateway gets its current mconfig.
+// Gateways created after the rollout started have no baseline, so they
+// always get their current mconfig.
+func loadRolloutBaseline(store storage.ConfiguratorStorage, network *storage.Network, graph *storage.EntityGraph, gatewayID string) (*protos.GatewayConfigs, error) {
+	rolloutConfig, ok := network.Configs[orc8r.MconfigRolloutConfigType]
+	if !ok {
+		return nil, nil
+	}
+	rollout := &rollout_types.Rollout{}
+	err := rollout.UnmarshalBinary(rolloutConfig)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal mconfig rollout: %s", err)
+	}
+	if rollout.IsReleased(gatewayID, getGatewayTiers(graph, gatewayID)) {
+		return nil, nil
+	}
+
+	loadResult, err := store.LoadEntities(
+		network.ID,
+		storage.EntityLoadFilter{
+			TypeFilter: &wrappers.StringValue{Value: orc8r.MconfigBaselineEntityType},
+			KeyFilter:  &wrappers.StringValue{Value: gatewayID},
+		},
+		storage.EntityLoadCriteria{LoadConfig: true},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load mconfig baseline: %s", err)
+	}
+	if funk.IsEmpty(loadResult.Entities) {
+		return nil, nil
+	}
+	baseline := &rollout_types.Baseline{}
+	err = baseline.UnmarshalBinary(loadResult.Entities[0].Config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal mconfig baseline: %s", err)
+	}
+	return baseline.Configs, nil
+}
+
+func getGatewayTiers(graph *storage.EntityGraph, gatewayID string) []string {
+	var tiers []string
+	for _, ent := range graph.Entities {
+		if ent.Type != orc8r.MagmadGatewayType || ent.Key != gatewayID {
+			continue
+		}
+		for _, parent := range ent.ParentAssociations {
+			if parent.Type == orc8r.UpgradeTierEntityType {
+				tiers = append(tiers, parent.Key)
+			}
+		}
+	}
+	return tiers
+}
 
-	ret, err := mconfig.CreateMconfigJSON(nwLoad.Networks[0], &graph, gatewayID)
+func buildMconfig(network *storage.Network, graph *storage.EntityGraph, gatewayID string) (*protos.GatewayConfigs, error) {
+	ret, err := mconfig.CreateMconfigJSON(network, graph, gatewayID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to build mconfig: %s", err)
 	}
@@ -0,0 +1,215 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidNetworkArchive indicates that a network archive can't be
+// imported, either because it's malformed or because it conflicts with the
+// existing state.
+var ErrInvalidNetworkArchive = errors.New("invalid network archive")
+
+// ExportNetwork returns a snapshot of a network and all of its entities, or
+// nil if the network doesn't exist.
+func ExportNetwork(store ConfiguratorStorage, networkID string) (*NetworkArchive, error) {
+	loadedNetworks, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{networkID}}, FullNetworkLoadCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load network to export")
+	}
+	if len(loadedNetworks.Networks) == 0 {
+		return nil, nil
+	}
+	network := loadedNetworks.Networks[0]
+	network.Version = 0
+
+	entities, err := loadAllEntities(store, networkID, EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsFromThis: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load entities to export")
+	}
+	for _, ent := range entities {
+		ent.NetworkID = ""
+		ent.Pk = ""
+		ent.GraphID = ""
+		ent.Version = 0
+	}
+	return &NetworkArchive{Network: network, Entities: entities}, nil
+}
+
+// ImportNetwork recreates an archived network, and all of its entities,
+// under the passed network ID. The network is created if it doesn't exist
+// yet, otherwise its metadata is overwritten and the archived configs are
+// added to it. None of the archived entities may already exist in the
+// network.
+// Returns whether the network was created.
+// Validation failures are wrapped ErrInvalidNetworkArchive errors.
+func ImportNetwork(store ConfiguratorStorage, networkID string, archive *NetworkArchive, clearPhysicalIDs bool) (bool, error) {
+	if archive.GetNetwork() == nil {
+		return false, errors.Wrap(ErrInvalidNetworkArchive, "archive has no network")
+	}
+	if networkID == "" {
+		networkID = archive.Network.ID
+	}
+	if networkID == "" {
+		return false, errors.Wrap(ErrInvalidNetworkArchive, "no network ID to import into")
+	}
+
+	loadedNetworks, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{networkID}}, NetworkLoadCriteria{})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to load network to import into")
+	}
+	createNetwork := len(loadedNetworks.Networks) == 0
+	err = validateArchivedEntities(store, networkID, createNetwork, archive.Entities, clearPhysicalIDs)
+	if err != nil {
+		return false, err
+	}
+
+	if createNetwork {
+		_, err = store.CreateNetwork(Network{
+			ID:          networkID,
+			Type:        archive.Network.Type,
+			Name:        archive.Network.Name,
+			Description: archive.Network.Description,
+			Configs:     archive.Network.Configs,
+		})
+	} else {
+		err = store.UpdateNetworks([]NetworkUpdateCriteria{{
+			ID:                   networkID,
+			NewType:              &wrappers.StringValue{Value: archive.Network.Type},
+			NewName:              &wrappers.StringValue{Value: archive.Network.Name},
+			NewDescription:       &wrappers.StringValue{Value: archive.Network.Description},
+			ConfigsToAddOrUpdate: archive.Network.Configs,
+		}})
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to write network")
+	}
+
+	// Create all entities before associating them, so the archive's entities
+	// can be imported in any order
+	for _, ent := range archive.Entities {
+		physicalID := ent.PhysicalID
+		if clearPhysicalIDs {
+			physicalID = ""
+		}
+		_, err = store.CreateEntity(networkID, NetworkEntity{
+			Type:        ent.Type,
+			Key:         ent.Key,
+			Name:        ent.Name,
+			Description: ent.Description,
+			PhysicalID:  physicalID,
+			Config:      ent.Config,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to create entity %s", ent.GetTypeAndKey())
+		}
+	}
+	for _, ent := range archive.Entities {
+		if len(ent.Associations) == 0 {
+			continue
+		}
+		_, err = store.UpdateEntity(networkID, EntityUpdateCriteria{Type: ent.Type, Key: ent.Key, AssociationsToAdd: ent.Associations})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to associate entity %s", ent.GetTypeAndKey())
+		}
+	}
+	return createNetwork, nil
+}
+
+// validateArchivedEntities checks that the archived entities can be created
+// in the network, and that their associations all point to entities which
+// will exist after the import.
+func validateArchivedEntities(store ConfiguratorStorage, networkID string, isNewNetwork bool, entities []*NetworkEntity, clearPhysicalIDs bool) error {
+	existingTKs := map[storage.TypeAndKey]bool{}
+	if !isNewNetwork {
+		existingEnts, err := loadAllEntities(store, networkID, EntityLoadCriteria{})
+		if err != nil {
+			return errors.Wrap(err, "failed to load existing entities")
+		}
+		for _, ent := range existingEnts {
+			existingTKs[ent.GetTypeAndKey()] = true
+		}
+	}
+
+	archivedTKs := map[storage.TypeAndKey]bool{}
+	physicalIDs := map[string]bool{}
+	for _, ent := range entities {
+		tk := ent.GetTypeAndKey()
+		if ent.Type == "" || ent.Key == "" {
+			return errors.Wrap(ErrInvalidNetworkArchive, "archived entities must have a type and a key")
+		}
+		if archivedTKs[tk] {
+			return errors.Wrapf(ErrInvalidNetworkArchive, "entity %s is archived more than once", tk)
+		}
+		if existingTKs[tk] {
+			return errors.Wrapf(ErrInvalidNetworkArchive, "entity %s already exists in network %s", tk, networkID)
+		}
+		archivedTKs[tk] = true
+
+		if clearPhysicalIDs || ent.PhysicalID == "" {
+			continue
+		}
+		if physicalIDs[ent.PhysicalID] {
+			return errors.Wrapf(ErrInvalidNetworkArchive, "physical ID %s is archived more than once", ent.PhysicalID)
+		}
+		physicalIDs[ent.PhysicalID] = true
+		loaded, err := store.LoadEntities(networkID, EntityLoadFilter{PhysicalID: &wrappers.StringValue{Value: ent.PhysicalID}}, EntityLoadCriteria{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to check physical ID of entity %s", tk)
+		}
+		if len(loaded.Entities) != 0 {
+			return errors.Wrapf(ErrInvalidNetworkArchive, "physical ID %s of entity %s is already in use", ent.PhysicalID, tk)
+		}
+	}
+
+	for _, ent := range entities {
+		for _, assoc := range ent.Associations {
+			assocTK := assoc.ToTypeAndKey()
+			if !archivedTKs[assocTK] && !existingTKs[assocTK] {
+				return errors.Wrapf(ErrInvalidNetworkArchive, "entity %s is associated to unknown entity %s", ent.GetTypeAndKey(), assocTK)
+			}
+		}
+	}
+	return nil
+}
+
+// loadAllEntities loads every entity of a network, one type at a time since
+// multi-type loads can't be paginated.
+func loadAllEntities(store ConfiguratorStorage, networkID string, criteria EntityLoadCriteria) ([]*NetworkEntity, error) {
+	types, err := store.ListEntityTypes(networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*NetworkEntity
+	for _, typ := range types {
+		criteria.PageToken = ""
+		for {
+			loaded, err := store.LoadEntities(networkID, EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: typ}}, criteria)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, loaded.Entities...)
+			if loaded.NextPageToken == "" {
+				break
+			}
+			criteria.PageToken = loaded.NextPageToken
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportNetwork(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	require.NoError(t, err)
+	// Small load size to exercise pagination, though associations are capped
+	// to it too
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 3)
+	require.NoError(t, factory.InitializeServiceStorage())
+
+	run := func(commit bool, f func(store storage.ConfiguratorStorage) error) error {
+		store, err := factory.StartTransaction(context.Background(), nil)
+		require.NoError(t, err)
+		err = f(store)
+		if err != nil || !commit {
+			require.NoError(t, store.Rollback())
+			return err
+		}
+		return store.Commit()
+	}
+	export := func(networkID string) *storage.NetworkArchive {
+		var archive *storage.NetworkArchive
+		err := run(true, func(store storage.ConfiguratorStorage) error {
+			var err error
+			archive, err = storage.ExportNetwork(store, networkID)
+			return err
+		})
+		require.NoError(t, err)
+		return archive
+	}
+	importNetwork := func(networkID string, archive *storage.NetworkArchive, clearPhysicalIDs bool) (bool, error) {
+		var created bool
+		err := run(true, func(store storage.ConfiguratorStorage) error {
+			var err error
+			created, err = storage.ImportNetwork(store, networkID, archive, clearPhysicalIDs)
+			return err
+		})
+		return created, err
+	}
+
+	assert.Nil(t, export("n1"))
+
+	// Tier associated to 3 gateways, and a standalone entity
+	err = run(true, func(store storage.ConfiguratorStorage) error {
+		_, err := store.CreateNetwork(storage.Network{ID: "n1", Type: "lte", Name: "lab", Configs: map[string][]byte{"cfg": []byte("v1")}})
+		if err != nil {
+			return err
+		}
+		for _, key := range []string{"g1", "g2", "g3"} {
+			_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "gw", Key: key, PhysicalID: "hw-" + key, Config: []byte(key)})
+			if err != nil {
+				return err
+			}
+		}
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{
+			Type:         "tier",
+			Key:          "t1",
+			Name:         "tier",
+			Associations: []*storage.EntityID{{Type: "gw", Key: "g1"}, {Type: "gw", Key: "g2"}, {Type: "gw", Key: "g3"}},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "apn", Key: "internet", Description: "apn"})
+		return err
+	})
+	require.NoError(t, err)
+
+	archive := export("n1")
+	expected := &storage.NetworkArchive{
+		Network: &storage.Network{ID: "n1", Type: "lte", Name: "lab", Configs: map[string][]byte{"cfg": []byte("v1")}},
+		Entities: []*storage.NetworkEntity{
+			{Type: "apn", Key: "internet", Description: "apn"},
+			{Type: "gw", Key: "g1", PhysicalID: "hw-g1", Config: []byte("g1")},
+			{Type: "gw", Key: "g2", PhysicalID: "hw-g2", Config: []byte("g2")},
+			{Type: "gw", Key: "g3", PhysicalID: "hw-g3", Config: []byte("g3")},
+			{
+				Type:         "tier",
+				Key:          "t1",
+				Name:         "tier",
+				Associations: []*storage.EntityID{{Type: "gw", Key: "g1"}, {Type: "gw", Key: "g2"}, {Type: "gw", Key: "g3"}},
+			},
+		},
+	}
+	assert.Equal(t, expected, archive)
+
+	// Physical IDs are already used by n1
+	_, err = importNetwork("n2", archive, false)
+	assert.Equal(t, storage.ErrInvalidNetworkArchive, errors.Cause(err))
+	assert.Contains(t, err.Error(), "physical ID hw-g1")
+
+	// Dry run leaves no trace
+	err = run(false, func(store storage.ConfiguratorStorage) error {
+		created, err := storage.ImportNetwork(store, "n2", archive, true)
+		assert.True(t, created)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Nil(t, export("n2"))
+
+	// Clone into a new network, with the entities out of order
+	reordered := &storage.NetworkArchive{Network: archive.Network, Entities: []*storage.NetworkEntity{archive.Entities[4], archive.Entities[0], archive.Entities[1], archive.Entities[2], archive.Entities[3]}}
+	created, err := importNetwork("n2", reordered, true)
+	require.NoError(t, err)
+	assert.True(t, created)
+	clone := export("n2")
+	expected.Network.ID = "n2"
+	for _, ent := range expected.Entities {
+		ent.PhysicalID = ""
+	}
+	assert.Equal(t, expected, clone)
+
+	// Entities can't be imported twice into the same network
+	_, err = importNetwork("n2", clone, false)
+	assert.Equal(t, storage.ErrInvalidNetworkArchive, errors.Cause(err))
+	assert.Contains(t, err.Error(), "already exists in network n2")
+
+	// Associations must point to archived or existing entities
+	dangling := &storage.NetworkArchive{
+		Network:  &storage.Network{ID: "n2", Name: "renamed"},
+		Entities: []*storage.NetworkEntity{{Type: "tier", Key: "t2", Associations: []*storage.EntityID{{Type: "gw", Key: "g4"}}}},
+	}
+	_, err = importNetwork("", dangling, false)
+	assert.Equal(t, storage.ErrInvalidNetworkArchive, errors.Cause(err))
+
+	// Import into the existing network, associating to its entities
+	dangling.Entities[0].Associations = []*storage.EntityID{{Type: "gw", Key: "g3"}}
+	created, err = importNetwork("", dangling, false)
+	require.NoError(t, err)
+	assert.False(t, created)
+	clone = export("n2")
+	assert.Equal(t, "renamed", clone.Network.Name)
+	assert.Equal(t, map[string][]byte{"cfg": []byte("v1")}, clone.Network.Configs)
+	require.Len(t, clone.Entities, 6)
+	assert.Equal(t, &storage.NetworkEntity{Type: "tier", Key: "t2", Associations: []*storage.EntityID{{Type: "gw", Key: "g3"}}}, clone.Entities[5])
+
+	_, err = importNetwork("n3", &storage.NetworkArchive{}, false)
+	assert.Equal(t, storage.ErrInvalidNetworkArchive, errors.Cause(err))
+}
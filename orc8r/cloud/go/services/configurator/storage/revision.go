@@ -0,0 +1,322 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+)
+
+// NewRevisionRecordingStorage returns a ConfiguratorStorage which records
+// the network and entity mutations made through the passed store as
+// revisions attributed to the passed operator. The revisions are written in
+// the same transaction as the mutations.
+// The state of mconfig rollouts, i.e. the rollout network config and the
+// gateways' mconfig baselines, isn't configuration, so it isn't recorded.
+func NewRevisionRecordingStorage(store ConfiguratorStorage, operator string) ConfiguratorStorage {
+	return &revisionRecordingStorage{ConfiguratorStorage: store, operator: operator}
+}
+
+type revisionRecordingStorage struct {
+	ConfiguratorStorage
+	operator string
+}
+
+func (store *revisionRecordingStorage) CreateNetwork(network Network) (Network, error) {
+	createdNetwork, err := store.ConfiguratorStorage.CreateNetwork(network)
+	if err != nil {
+		return Network{}, err
+	}
+	err = store.recordNetworkRevision(network.ID, nil)
+	if err != nil {
+		return Network{}, err
+	}
+	return createdNetwork, nil
+}
+
+func (store *revisionRecordingStorage) UpdateNetworks(updates []NetworkUpdateCriteria) error {
+	networksBefore := map[string]*Network{}
+	for _, update := range updates {
+		before, err := store.loadNetworkState(update.ID)
+		if err != nil {
+			return err
+		}
+		networksBefore[update.ID] = before
+	}
+	err := store.ConfiguratorStorage.UpdateNetworks(updates)
+	if err != nil {
+		return err
+	}
+	for networkID, before := range networksBefore {
+		err = store.recordNetworkRevision(networkID, before)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (store *revisionRecordingStorage) CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
+	createdEnt, err := store.ConfiguratorStorage.CreateEntity(networkID, entity)
+	if err != nil || !isRecordedEntityType(entity.Type) {
+		return createdEnt, err
+	}
+	err = store.recordEntityRevision(networkID, entity.GetTypeAndKey(), nil)
+	if err != nil {
+		return NetworkEntity{}, err
+	}
+	return createdEnt, nil
+}
+
+func (store *revisionRecordingStorage) UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
+	if !isRecordedEntityType(update.Type) {
+		return store.ConfiguratorStorage.UpdateEntity(networkID, update)
+	}
+	emptyRet := NetworkEntity{Type: update.Type, Key: update.Key}
+	tk := storage.TypeAndKey{Type: update.Type, Key: update.Key}
+	before, err := store.loadEntityState(networkID, tk)
+	if err != nil {
+		return emptyRet, err
+	}
+	updatedEnt, err := store.ConfiguratorStorage.UpdateEntity(networkID, update)
+	if err != nil {
+		return updatedEnt, err
+	}
+	err = store.recordEntityRevision(networkID, tk, before)
+	if err != nil {
+		return emptyRet, err
+	}
+	return updatedEnt, nil
+}
+
+// recordNetworkRevision records the mutation of a network from the passed
+// state to its current state.
+func (store *revisionRecordingStorage) recordNetworkRevision(networkID string, before *Network) error {
+	after, err := store.loadNetworkState(networkID)
+	if err != nil {
+		return err
+	}
+	if isNoopNetworkMutation(before, after) {
+		return nil
+	}
+	_, err = store.CreateRevision(Revision{
+		NetworkID:     networkID,
+		Operation:     getRevisionOperation(before == nil, after == nil),
+		Operator:      store.operator,
+		NetworkBefore: before,
+		NetworkAfter:  after,
+	})
+	return err
+}
+
+// recordEntityRevision records the mutation of an entity from the passed
+// state to its current state.
+func (store *revisionRecordingStorage) recordEntityRevision(networkID string, tk storage.TypeAndKey, before *NetworkEntity) error {
+	after, err := store.loadEntityState(networkID, tk)
+	if err != nil {
+		return err
+	}
+	if isNoopEntityMutation(before, after) {
+		return nil
+	}
+	_, err = store.CreateRevision(Revision{
+		NetworkID:    networkID,
+		Type:         tk.Type,
+		Key:          tk.Key,
+		Operation:    getRevisionOperation(before == nil, after == nil),
+		Operator:     store.operator,
+		EntityBefore: before,
+		EntityAfter:  after,
+	})
+	return err
+}
+
+// loadNetworkState returns the state of a network as recorded by its
+// revisions, or nil if the network doesn't exist.
+func (store *revisionRecordingStorage) loadNetworkState(networkID string) (*Network, error) {
+	res, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{networkID}}, FullNetworkLoadCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load network state for revision")
+	}
+	if len(res.Networks) == 0 {
+		return nil, nil
+	}
+	network := res.Networks[0]
+	delete(network.Configs, orc8r.MconfigRolloutConfigType)
+	return network, nil
+}
+
+// loadEntityState returns the state of an entity as recorded by its
+// revisions, or nil if the entity doesn't exist.
+// Parent associations are owned by the parent entities, so they're recorded
+// in the parents' revisions instead.
+func (store *revisionRecordingStorage) loadEntityState(networkID string, tk storage.TypeAndKey) (*NetworkEntity, error) {
+	res, err := store.LoadEntities(
+		networkID,
+		EntityLoadFilter{IDs: []*EntityID{(&EntityID{}).FromTypeAndKey(tk)}},
+		EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsFromThis: true},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load entity state for revision")
+	}
+	if len(res.Entities) == 0 {
+		return nil, nil
+	}
+	ent := res.Entities[0]
+	// Internal IDs aren't meaningful outside of storage
+	ent.Pk, ent.GraphID = "", ""
+	ent.NetworkID = networkID
+	return ent, nil
+}
+
+func isRecordedEntityType(entityType string) bool {
+	return entityType != orc8r.MconfigBaselineEntityType
+}
+
+func getRevisionOperation(isCreate bool, isDelete bool) RevisionOperation {
+	switch {
+	case isCreate:
+		return RevisionOperation_CREATE
+	case isDelete:
+		return RevisionOperation_DELETE
+	default:
+		return RevisionOperation_UPDATE
+	}
+}
+
+// isNoopNetworkMutation returns true if the network states before and after
+// a mutation only differ by their version, e.g. after an empty update.
+func isNoopNetworkMutation(before, after *Network) bool {
+	if before == nil || after == nil {
+		return before == after
+	}
+	before, after = proto.Clone(before).(*Network), proto.Clone(after).(*Network)
+	before.Version, after.Version = 0, 0
+	return proto.Equal(before, after)
+}
+
+// isNoopEntityMutation returns true if the entity states before and after a
+// mutation only differ by their version.
+func isNoopEntityMutation(before, after *NetworkEntity) bool {
+	if before == nil || after == nil {
+		return before == after
+	}
+	before, after = proto.Clone(before).(*NetworkEntity), proto.Clone(after).(*NetworkEntity)
+	before.Version, after.Version = 0, 0
+	return proto.Equal(before, after)
+}
+
+// RevertToRevision restores the network or entity mutated by a revision to
+// its state right after the revision.
+// Reverting to the deletion of an entity deletes it, and reverting to any
+// other revision of a deleted entity recreates it. Parent associations of
+// entities are owned by the parents, so they aren't restored.
+// The revert is itself recorded as a new revision.
+func RevertToRevision(store ConfiguratorStorage, revision *Revision) error {
+	if revision.Type == "" {
+		return revertNetwork(store, revision)
+	}
+	return revertEntity(store, revision)
+}
+
+func revertNetwork(store ConfiguratorStorage, revision *Revision) error {
+	target := revision.NetworkAfter
+	if target != nil {
+		target = proto.Clone(target).(*Network)
+		delete(target.Configs, orc8r.MconfigRolloutConfigType)
+	}
+	loaded, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{revision.NetworkID}}, FullNetworkLoadCriteria)
+	if err != nil {
+		return errors.Wrap(err, "failed to load network to revert")
+	}
+
+	switch {
+	case target == nil && len(loaded.Networks) == 0:
+		return nil
+	case target == nil:
+		return store.UpdateNetworks([]NetworkUpdateCriteria{{ID: revision.NetworkID, DeleteNetwork: true}})
+	case len(loaded.Networks) == 0:
+		_, err = store.CreateNetwork(*target)
+		return err
+	}
+
+	current := loaded.Networks[0]
+	update := NetworkUpdateCriteria{
+		ID:                   revision.NetworkID,
+		NewName:              &wrappers.StringValue{Value: target.Name},
+		NewDescription:       &wrappers.StringValue{Value: target.Description},
+		NewType:              &wrappers.StringValue{Value: target.Type},
+		ConfigsToAddOrUpdate: target.Configs,
+	}
+	for configType := range current.Configs {
+		// Rollouts aren't recorded, so they're left as is
+		if configType == orc8r.MconfigRolloutConfigType {
+			continue
+		}
+		if _, ok := target.Configs[configType]; !ok {
+			update.ConfigsToDelete = append(update.ConfigsToDelete, configType)
+		}
+	}
+	return store.UpdateNetworks([]NetworkUpdateCriteria{update})
+}
+
+func revertEntity(store ConfiguratorStorage, revision *Revision) error {
+	target := revision.EntityAfter
+	loaded, err := store.LoadEntities(
+		revision.NetworkID,
+		EntityLoadFilter{IDs: []*EntityID{{Type: revision.Type, Key: revision.Key}}},
+		EntityLoadCriteria{LoadMetadata: true},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to load entity to revert")
+	}
+
+	switch {
+	case target == nil && len(loaded.Entities) == 0:
+		return nil
+	case target == nil:
+		_, err = store.UpdateEntity(revision.NetworkID, EntityUpdateCriteria{Type: revision.Type, Key: revision.Key, DeleteEntity: true})
+		return err
+	case len(loaded.Entities) == 0:
+		_, err = store.CreateEntity(revision.NetworkID, NetworkEntity{
+			Type:         target.Type,
+			Key:          target.Key,
+			Name:         target.Name,
+			Description:  target.Description,
+			PhysicalID:   target.PhysicalID,
+			Config:       target.Config,
+			Associations: target.Associations,
+		})
+		return err
+	}
+
+	update := EntityUpdateCriteria{
+		Type:              revision.Type,
+		Key:               revision.Key,
+		NewName:           &wrappers.StringValue{Value: target.Name},
+		NewDescription:    &wrappers.StringValue{Value: target.Description},
+		NewConfig:         &wrappers.BytesValue{Value: target.Config},
+		AssociationsToSet: &EntityAssociationsToSet{AssociationsToSet: target.Associations},
+	}
+	// Physical IDs are unique, so only write them when they change
+	if target.PhysicalID != "" && target.PhysicalID != loaded.Entities[0].PhysicalID {
+		update.NewPhysicalID = &wrappers.StringValue{Value: target.PhysicalID}
+	}
+	_, err = store.UpdateEntity(revision.NetworkID, update)
+	return err
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisionRecordingStorage(t *testing.T) {
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	defer clock.UnfreezeClock(t)
+
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	require.NoError(t, err)
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 100)
+	require.NoError(t, factory.InitializeServiceStorage())
+
+	write := func(operator string, f func(store storage.ConfiguratorStorage) error) {
+		store, err := factory.StartTransaction(context.Background(), nil)
+		require.NoError(t, err)
+		require.NoError(t, f(storage.NewRevisionRecordingStorage(store, operator)))
+		require.NoError(t, store.Commit())
+	}
+	load := func(filter storage.RevisionLoadFilter, criteria storage.RevisionLoadCriteria) storage.RevisionLoadResult {
+		store, err := factory.StartTransaction(context.Background(), nil)
+		require.NoError(t, err)
+		res, err := store.LoadRevisions("n1", filter, criteria)
+		require.NoError(t, err)
+		require.NoError(t, store.Commit())
+		return res
+	}
+
+	// Create a network and entities, then update and delete them
+	write("alice", func(store storage.ConfiguratorStorage) error {
+		_, err := store.CreateNetwork(storage.Network{ID: "n1", Type: "lte", Name: "net", Configs: map[string][]byte{"cfg": []byte("v1")}})
+		return err
+	})
+	write("alice", func(store storage.ConfiguratorStorage) error {
+		_, err := store.CreateEntity("n1", storage.NetworkEntity{Type: "gw", Key: "g1", Name: "gateway", Config: []byte("c1")})
+		if err != nil {
+			return err
+		}
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{
+			Type:         "tier",
+			Key:          "t1",
+			Associations: []*storage.EntityID{{Type: "gw", Key: "g1"}},
+		})
+		return err
+	})
+	write("bob", func(store storage.ConfiguratorStorage) error {
+		_, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewConfig: &wrappers.BytesValue{Value: []byte("c2")}})
+		if err != nil {
+			return err
+		}
+		// Empty updates aren't recorded
+		_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1"})
+		return err
+	})
+	write("bob", func(store storage.ConfiguratorStorage) error {
+		return store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: "renamed"}}})
+	})
+	write("", func(store storage.ConfiguratorStorage) error {
+		_, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", DeleteEntity: true})
+		return err
+	})
+
+	res := load(storage.RevisionLoadFilter{}, storage.RevisionLoadCriteria{})
+	require.Len(t, res.Revisions, 6)
+	assert.Empty(t, res.NextPageToken)
+	for _, revision := range res.Revisions {
+		assert.NotEmpty(t, revision.Id)
+		revision.Id = ""
+	}
+	g1Created := &storage.NetworkEntity{NetworkID: "n1", Type: "gw", Key: "g1", Name: "gateway", Config: []byte("c1")}
+	g1Updated := &storage.NetworkEntity{NetworkID: "n1", Type: "gw", Key: "g1", Name: "gateway", Config: []byte("c2"), Version: 1}
+	// The empty update still bumped the version
+	g1Deleted := &storage.NetworkEntity{NetworkID: "n1", Type: "gw", Key: "g1", Name: "gateway", Config: []byte("c2"), Version: 2}
+	nCreated := &storage.Network{ID: "n1", Type: "lte", Name: "net", Configs: map[string][]byte{"cfg": []byte("v1")}}
+	nUpdated := &storage.Network{ID: "n1", Type: "lte", Name: "renamed", Configs: map[string][]byte{"cfg": []byte("v1")}, Version: 1}
+	expected := []*storage.Revision{
+		{NetworkID: "n1", Type: "gw", Key: "g1", Operation: storage.RevisionOperation_DELETE, Timestamp: 1000000, SequenceNumber: 6, EntityBefore: g1Deleted},
+		{NetworkID: "n1", Operation: storage.RevisionOperation_UPDATE, Operator: "bob", Timestamp: 1000000, SequenceNumber: 5, NetworkBefore: nCreated, NetworkAfter: nUpdated},
+		{NetworkID: "n1", Type: "gw", Key: "g1", Operation: storage.RevisionOperation_UPDATE, Operator: "bob", Timestamp: 1000000, SequenceNumber: 4, EntityBefore: g1Created, EntityAfter: g1Updated},
+		{
+			NetworkID: "n1", Type: "tier", Key: "t1", Operation: storage.RevisionOperation_CREATE, Operator: "alice", Timestamp: 1000000, SequenceNumber: 3,
+			EntityAfter: &storage.NetworkEntity{NetworkID: "n1", Type: "tier", Key: "t1", Associations: []*storage.EntityID{{Type: "gw", Key: "g1"}}},
+		},
+		{NetworkID: "n1", Type: "gw", Key: "g1", Operation: storage.RevisionOperation_CREATE, Operator: "alice", Timestamp: 1000000, SequenceNumber: 2, EntityAfter: g1Created},
+		{NetworkID: "n1", Operation: storage.RevisionOperation_CREATE, Operator: "alice", Timestamp: 1000000, SequenceNumber: 1, NetworkAfter: nCreated},
+	}
+	assert.Equal(t, expected, res.Revisions)
+
+	// Paginate through the history of a single entity
+	entityFilter := storage.RevisionLoadFilter{TypeFilter: &wrappers.StringValue{Value: "gw"}, KeyFilter: &wrappers.StringValue{Value: "g1"}}
+	page1 := load(entityFilter, storage.RevisionLoadCriteria{PageSize: 2})
+	require.Len(t, page1.Revisions, 2)
+	assert.Equal(t, []uint64{6, 4}, []uint64{page1.Revisions[0].SequenceNumber, page1.Revisions[1].SequenceNumber})
+	assert.NotEmpty(t, page1.NextPageToken)
+	page2 := load(entityFilter, storage.RevisionLoadCriteria{PageSize: 2, PageToken: page1.NextPageToken})
+	require.Len(t, page2.Revisions, 1)
+	assert.Equal(t, uint64(2), page2.Revisions[0].SequenceNumber)
+	assert.Empty(t, page2.NextPageToken)
+
+	// Only the network's own revisions
+	networkFilter := storage.RevisionLoadFilter{TypeFilter: &wrappers.StringValue{}, KeyFilter: &wrappers.StringValue{}}
+	res = load(networkFilter, storage.RevisionLoadCriteria{})
+	assert.Len(t, res.Revisions, 2)
+
+	// Revert the deleted gateway to its creation, which recreates it
+	createRevision := page2.Revisions[0]
+	write("carol", func(store storage.ConfiguratorStorage) error {
+		return storage.RevertToRevision(store, createRevision)
+	})
+	store, err := factory.StartTransaction(context.Background(), nil)
+	require.NoError(t, err)
+	loaded, err := store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{IDs: []*storage.EntityID{{Type: "gw", Key: "g1"}}},
+		storage.FullEntityLoadCriteria,
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.Commit())
+	require.Len(t, loaded.Entities, 1)
+	assert.Equal(t, "gateway", loaded.Entities[0].Name)
+	assert.Equal(t, []byte("c1"), loaded.Entities[0].Config)
+
+	res = load(entityFilter, storage.RevisionLoadCriteria{PageSize: 1})
+	require.Len(t, res.Revisions, 1)
+	assert.Equal(t, storage.RevisionOperation_CREATE, res.Revisions[0].Operation)
+	assert.Equal(t, "carol", res.Revisions[0].Operator)
+	assert.Equal(t, uint64(7), res.Revisions[0].SequenceNumber)
+
+	// Revert the network rename
+	networkRevisions := load(networkFilter, storage.RevisionLoadCriteria{})
+	write("carol", func(store storage.ConfiguratorStorage) error {
+		return storage.RevertToRevision(store, networkRevisions.Revisions[1])
+	})
+	res = load(networkFilter, storage.RevisionLoadCriteria{PageSize: 1})
+	require.Len(t, res.Revisions, 1)
+	assert.Equal(t, "net", res.Revisions[0].NetworkAfter.Name)
+	assert.Equal(t, "renamed", res.Revisions[0].NetworkBefore.Name)
+}
+
+func TestRevisionRecordingStorage_Rollouts(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	require.NoError(t, err)
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 100)
+	require.NoError(t, factory.InitializeServiceStorage())
+	store, err := factory.StartTransaction(context.Background(), nil)
+	require.NoError(t, err)
+	defer store.Rollback()
+	recording := storage.NewRevisionRecordingStorage(store, "alice")
+
+	_, err = recording.CreateNetwork(storage.Network{ID: "n1", Configs: map[string][]byte{"cfg": []byte("v1")}})
+	require.NoError(t, err)
+	// Rollout state isn't recorded
+	err = recording.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", ConfigsToAddOrUpdate: map[string][]byte{orc8r.MconfigRolloutConfigType: []byte("r1")}}})
+	require.NoError(t, err)
+	_, err = recording.CreateEntity("n1", storage.NetworkEntity{Type: orc8r.MconfigBaselineEntityType, Key: "g1", Config: []byte("b1")})
+	require.NoError(t, err)
+	_, err = recording.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: orc8r.MconfigBaselineEntityType, Key: "g1", DeleteEntity: true})
+	require.NoError(t, err)
+	err = recording.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", ConfigsToAddOrUpdate: map[string][]byte{"cfg": []byte("v2")}}})
+	require.NoError(t, err)
+
+	res, err := store.LoadRevisions("n1", storage.RevisionLoadFilter{}, storage.RevisionLoadCriteria{})
+	require.NoError(t, err)
+	require.Len(t, res.Revisions, 2)
+	assert.Equal(t, map[string][]byte{"cfg": []byte("v2")}, res.Revisions[0].NetworkAfter.Configs)
+
+	// Reverting the network leaves its rollout as is
+	require.NoError(t, storage.RevertToRevision(recording, res.Revisions[1]))
+	loaded, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{"n1"}}, storage.FullNetworkLoadCriteria)
+	require.NoError(t, err)
+	expected := map[string][]byte{"cfg": []byte("v1"), orc8r.MconfigRolloutConfigType: []byte("r1")}
+	assert.Equal(t, expected, loaded.Networks[0].Configs)
+}
@@ -34,6 +34,8 @@ const (
 
 	entityTable      = "cfg_entities"
 	entityAssocTable = "cfg_assocs"
+
+	revisionTable = "cfg_revisions"
 )
 
 const (
@@ -60,6 +62,17 @@ const (
 
 	aFrCol = "from_pk"
 	aToCol = "to_pk"
+
+	revIDCol       = "id"
+	revNidCol      = "network_id"
+	revSeqCol      = "seq"
+	revTypeCol     = "type"
+	revKeyCol      = "\"key\""
+	revOpCol       = "operation"
+	revOperatorCol = "operator"
+	revTimeCol     = "timestamp"
+	revBeforeCol   = "before"
+	revAfterCol    = "after"
 )
 
 // NewSQLConfiguratorStorageFactory returns a ConfiguratorStorageFactory
@@ -184,6 +197,27 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	// Revisions outlive the networks and entities they record, so there are
+	// no FKs to the other tables
+	_, err = fact.builder.CreateTable(revisionTable).
+		IfNotExists().
+		Column(revIDCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(revNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revSeqCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(revTypeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revKeyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revOpCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(revOperatorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(revTimeCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(revBeforeCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(revAfterCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create revisions table")
+		return
+	}
+
 	// Create indexes (index is not implicitly created on a referencing FK)
 	_, err = fact.builder.CreateIndex("graph_id_idx").
 		IfNotExists().
@@ -196,6 +230,17 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	_, err = fact.builder.CreateIndex("revision_seq_idx").
+		IfNotExists().
+		On(revisionTable).
+		Columns(revNidCol, revSeqCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create revision sequence index")
+		return
+	}
+
 	// Create internal network(s)
 	_, err = fact.builder.Insert(networksTable).
 		Columns(nwIDCol, nwTypeCol, nwNameCol, nwDescCol).
@@ -371,6 +416,16 @@ func (store *sqlConfiguratorStorage) UpdateNetworks(updates []NetworkUpdateCrite
 	stmtCache := sq.NewStmtCache(store.tx)
 	defer sqorc.ClearStatementCacheLogOnError(stmtCache, "UpdateNetworks")
 
+	// Check versions of networks to delete before touching anything
+	for _, update := range updates {
+		if update.DeleteNetwork && update.ExpectedVersion != nil {
+			err := store.checkNetworkVersion(update.ID, update.ExpectedVersion.Value, stmtCache)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// Update networks first
 	for _, update := range networksToUpdate {
 		err := store.updateNetwork(update, stmtCache)
@@ -404,6 +459,30 @@ func (store *sqlConfiguratorStorage) CountEntities(networkID string, filter Enti
 	return ret, nil
 }
 
+func (store *sqlConfiguratorStorage) ListEntityTypes(networkID string) ([]string, error) {
+	rows, err := store.builder.Select(entTypeCol).Distinct().
+		From(entityTable).
+		Where(sq.Eq{entNidCol: networkID}).
+		OrderBy(entTypeCol).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for entity types")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "ListEntityTypes")
+
+	var types []string
+	for rows.Next() {
+		var typ string
+		err = rows.Scan(&typ)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan entity type")
+		}
+		types = append(types, typ)
+	}
+	return types, rows.Err()
+}
+
 func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) (EntityLoadResult, error) {
 	if err := validatePaginatedLoadParameters(filter, criteria); err != nil {
 		return EntityLoadResult{}, err
@@ -531,22 +610,35 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 		return emptyRet, errors.Wrap(err, "failed to load entity being updated")
 	}
 	if entToUpdate == nil {
+		if update.ExpectedVersion != nil {
+			return emptyRet, ErrVersionConflict
+		}
 		return emptyRet, nil
 	}
 
 	if update.DeleteEntity {
 		// Cascading FK relations in the schema will handle the other tables
-		_, err := store.builder.Delete(entityTable).
-			Where(sq.And{
-				sq.Eq{entNidCol: networkID},
-				sq.Eq{entTypeCol: update.Type},
-				sq.Eq{entKeyCol: update.Key},
-			}).
+		where := sq.And{
+			sq.Eq{entNidCol: networkID},
+			sq.Eq{entTypeCol: update.Type},
+			sq.Eq{entKeyCol: update.Key},
+		}
+		if update.ExpectedVersion != nil {
+			where = append(where, sq.Eq{entVerCol: update.ExpectedVersion.Value})
+		}
+		res, err := store.builder.Delete(entityTable).
+			Where(where).
 			RunWith(store.tx).
 			Exec()
 		if err != nil {
 			return emptyRet, errors.Wrapf(err, "failed to delete entity (%s, %s)", update.Type, update.Key)
 		}
+		if update.ExpectedVersion != nil {
+			err = checkVersionedWrite(res)
+			if err != nil {
+				return emptyRet, err
+			}
+		}
 
 		// Deleting a node could partition its graph
 		err = store.fixGraph(networkID, entToUpdate.GraphID, entToUpdate)
@@ -18,6 +18,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"sort"
+	"unicode/utf8"
 
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
@@ -119,7 +120,7 @@ func (store *sqlConfiguratorStorage) getBuilder(networkID string, filter EntityL
 	// SELECT ent.pk, ent.key, ent.type, ent.physical_id, ent.version, ent.graph_id, ent.name, ent.description, ent.config
 	// FROM cfg_entities AS ent
 	// [[ JOIN (on cfg_assocs and cfg_entities to get child/parent assocs) ]]
-	// [[ WHERE ent.network_id = $network_filter AND ent.key = $key_filter AND ent.type = $type_filter AND ent.key > $page_token ]]
+	// [[ WHERE ent.network_id = $network_filter AND ent.key = $key_filter AND SUBSTR(ent.key, 1, $prefix_len) = $key_prefix_filter AND ent.type = $type_filter AND ent.key > $page_token ]]
 	// ORDER BY ent.key
 	// LIMIT $page_size ;
 
@@ -214,6 +215,12 @@ func (store *sqlConfiguratorStorage) getBuilder(networkID string, filter EntityL
 	if filter.KeyFilter != nil {
 		where = append(where, sq.Eq{entCol(entKeyCol): filter.KeyFilter.Value})
 	}
+	if filter.KeyPrefixFilter != nil {
+		// SUBSTR instead of LIKE, so the prefix doesn't need escaping for
+		// every dialect
+		prefix := filter.KeyPrefixFilter.Value
+		where = append(where, sq.Expr(fmt.Sprintf("SUBSTR(%s, 1, ?) = ?", entCol(entKeyCol)), utf8.RuneCountInString(prefix), prefix))
+	}
 	if filter.TypeFilter != nil {
 		where = append(where, sq.Eq{entCol(entTypeCol): filter.TypeFilter.Value})
 	}
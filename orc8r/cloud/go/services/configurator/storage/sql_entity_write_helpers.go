@@ -207,12 +207,18 @@ func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update En
 
 // entOut is an output parameter
 func (store *sqlConfiguratorStorage) processEntityFieldsUpdate(pk string, update EntityUpdateCriteria, entOut *NetworkEntity) error {
-	_, err := store.getEntityUpdateQueryBuilder(pk, update).
+	res, err := store.getEntityUpdateQueryBuilder(pk, update).
 		RunWith(store.tx).
 		Exec()
 	if err != nil {
 		return errors.Wrap(err, "failed to update entity fields")
 	}
+	if update.ExpectedVersion != nil {
+		err = checkVersionedWrite(res)
+		if err != nil {
+			return err
+		}
+	}
 
 	if update.NewName != nil {
 		entOut.Name = (*update.NewName).Value
@@ -294,7 +300,7 @@ func (store *sqlConfiguratorStorage) processEdgeUpdates(networkID string, update
 
 func (store *sqlConfiguratorStorage) getEntityUpdateQueryBuilder(pk string, update EntityUpdateCriteria) sq.UpdateBuilder {
 	// UPDATE cfg_entities SET (name, description, physical_id, config, version) = ($1, $2, $3, $4, cfg_entities.version + 1)
-	// WHERE pk = $5
+	// WHERE pk = $5 [AND version = $6]
 	updateBuilder := store.builder.Update(entityTable).Where(sq.Eq{entPkCol: pk})
 	if update.NewName != nil {
 		updateBuilder = updateBuilder.Set(entNameCol, update.NewName.Value)
@@ -309,6 +315,9 @@ func (store *sqlConfiguratorStorage) getEntityUpdateQueryBuilder(pk string, upda
 		updateBuilder = updateBuilder.Set(entConfCol, update.NewConfig.Value)
 	}
 	updateBuilder = updateBuilder.Set(entVerCol, sq.Expr(fmt.Sprintf("%s+1", entVerCol)))
+	if update.ExpectedVersion != nil {
+		updateBuilder = updateBuilder.Where(sq.Eq{entVerCol: update.ExpectedVersion.Value})
+	}
 	return updateBuilder
 }
 
@@ -23,6 +23,7 @@ import (
 	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -903,6 +904,31 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 	)
 	assert.NoError(t, store.Commit())
 
+	// Load paginated entities filtered by key prefix
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	paginatedLoadCriteria.PageToken = ""
+	prefixFilter := storage.EntityLoadFilter{
+		TypeFilter:      &wrappers.StringValue{Value: "foo"},
+		KeyPrefixFilter: &wrappers.StringValue{Value: "h"},
+	}
+	actualEntityLoad, err = store.LoadEntities("n1", prefixFilter, paginatedLoadCriteria)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		storage.EntityLoadResult{
+			Entities: []*storage.NetworkEntity{
+				&expectedFoohueEnt, // type: foo, key: hue
+			},
+			NextPageToken: "",
+		},
+		actualEntityLoad,
+	)
+	actualCount, err := store.CountEntities("n1", prefixFilter, paginatedLoadCriteria)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), actualCount.Count)
+	assert.NoError(t, store.Commit())
+
 	// Ensure multi-type pagination loads fail
 	paginatedLoadCriteria.PageToken = ""
 	store, err = factory.StartTransaction(context.Background(), nil)
@@ -919,3 +945,96 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 	assert.Error(t, err)
 	assert.NoError(t, store.Commit())
 }
+
+func TestSqlConfiguratorStorage_VersionedUpdates(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), integTestMaxLoadSize)
+	err = factory.InitializeServiceStorage()
+	assert.NoError(t, err)
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Type: "type1", Name: "Network 1"})
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n2", Type: "type1", Name: "Network 2"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "bar", Config: []byte("v0")})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "baz"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Networks: matching version is applied and bumps the version, stale
+	// version is rejected
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n1", NewName: &wrappers.StringValue{Value: "updated"}, ExpectedVersion: &wrappers.UInt64Value{Value: 0}},
+	})
+	assert.NoError(t, err)
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n1", NewName: &wrappers.StringValue{Value: "stale"}, ExpectedVersion: &wrappers.UInt64Value{Value: 0}},
+	})
+	assert.Equal(t, storage.ErrVersionConflict, errors.Cause(err))
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n2", DeleteNetwork: true, ExpectedVersion: &wrappers.UInt64Value{Value: 1}},
+	})
+	assert.Equal(t, storage.ErrVersionConflict, errors.Cause(err))
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	loadNetworksActual, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{"n1", "n2"}}, storage.FullNetworkLoadCriteria)
+	assert.NoError(t, err)
+	assert.Len(t, loadNetworksActual.Networks, 2)
+	assert.Equal(t, "updated", loadNetworksActual.Networks[0].Name)
+	assert.Equal(t, uint64(1), loadNetworksActual.Networks[0].Version)
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n2", DeleteNetwork: true, ExpectedVersion: &wrappers.UInt64Value{Value: 0}},
+	})
+	assert.NoError(t, err)
+	loadNetworksActual, err = store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{"n2"}}, storage.FullNetworkLoadCriteria)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"n2"}, loadNetworksActual.NetworkIDsNotFound)
+	assert.NoError(t, store.Commit())
+
+	// Entities
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	updated, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("v1")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("stale")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.Equal(t, storage.ErrVersionConflict, errors.Cause(err))
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "baz", DeleteEntity: true, ExpectedVersion: &wrappers.UInt64Value{Value: 3}})
+	assert.Equal(t, storage.ErrVersionConflict, errors.Cause(err))
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "baz", DeleteEntity: true, ExpectedVersion: &wrappers.UInt64Value{Value: 0}})
+	assert.NoError(t, err)
+	// Deleting an entity which doesn't exist can't match any version
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "baz", DeleteEntity: true, ExpectedVersion: &wrappers.UInt64Value{Value: 0}})
+	assert.Equal(t, storage.ErrVersionConflict, errors.Cause(err))
+
+	loadEntitiesActual, err := store.LoadEntities("n1", storage.EntityLoadFilter{}, storage.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Len(t, loadEntitiesActual.Entities, 1)
+	assert.Equal(t, []byte("v1"), loadEntitiesActual.Entities[0].Config)
+	assert.Equal(t, uint64(1), loadEntitiesActual.Entities[0].Version)
+	assert.NoError(t, store.Commit())
+}
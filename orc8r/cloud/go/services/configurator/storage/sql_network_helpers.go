@@ -164,10 +164,19 @@ func (store *sqlConfiguratorStorage) updateNetwork(update NetworkUpdateCriteria,
 		updateBuilder = updateBuilder.Set(nwTypeCol, stringPtrToVal(update.NewType))
 	}
 	updateBuilder = updateBuilder.Set(nwVerCol, sq.Expr(fmt.Sprintf("%s.%s+1", networksTable, nwVerCol)))
-	_, err := updateBuilder.RunWith(stmtCache).Exec()
+	if update.ExpectedVersion != nil {
+		updateBuilder = updateBuilder.Where(sq.Eq{nwVerCol: update.ExpectedVersion.Value})
+	}
+	res, err := updateBuilder.RunWith(stmtCache).Exec()
 	if err != nil {
 		return errors.Wrapf(err, "error updating network %s", update.ID)
 	}
+	if update.ExpectedVersion != nil {
+		err = checkVersionedWrite(res)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Sort config keys for deterministic behavior on upserts
 	configUpdateTypes := funk.Keys(update.ConfigsToAddOrUpdate).([]string)
@@ -208,6 +217,35 @@ func (store *sqlConfiguratorStorage) updateNetwork(update NetworkUpdateCriteria,
 	return nil
 }
 
+// checkNetworkVersion bumps the version of a network to be deleted, failing
+// with ErrVersionConflict if it doesn't match the expected version. This
+// also locks the network's row until the end of the transaction.
+func (store *sqlConfiguratorStorage) checkNetworkVersion(networkID string, expectedVersion uint64, stmtCache *sq.StmtCache) error {
+	// UPDATE cfg_networks SET version = cfg_networks.version+1 WHERE id = $1 AND version = $2
+	res, err := store.builder.Update(networksTable).
+		Set(nwVerCol, sq.Expr(fmt.Sprintf("%s.%s+1", networksTable, nwVerCol))).
+		Where(sq.Eq{nwIDCol: networkID, nwVerCol: expectedVersion}).
+		RunWith(stmtCache).
+		Exec()
+	if err != nil {
+		return errors.Wrapf(err, "error checking version of network %s", networkID)
+	}
+	return checkVersionedWrite(res)
+}
+
+// checkVersionedWrite returns ErrVersionConflict if a write conditioned on a
+// version didn't affect any row.
+func checkVersionedWrite(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected by versioned write")
+	}
+	if rowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
 func stringPtrToVal(value *wrappers.StringValue) interface{} {
 	if value == nil {
 		return ""
@@ -0,0 +1,260 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/lib/go/util"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (store *sqlConfiguratorStorage) LoadRevisions(networkID string, filter RevisionLoadFilter, criteria RevisionLoadCriteria) (RevisionLoadResult, error) {
+	where := sq.And{sq.Eq{revNidCol: networkID}}
+	if filter.TypeFilter != nil {
+		where = append(where, sq.Eq{revTypeCol: filter.TypeFilter.Value})
+	}
+	if filter.KeyFilter != nil {
+		where = append(where, sq.Eq{revKeyCol: filter.KeyFilter.Value})
+	}
+	if filter.Id != nil {
+		where = append(where, sq.Eq{revIDCol: filter.Id.Value})
+	}
+	if criteria.PageToken != "" {
+		token, err := deserializeRevisionPageToken(criteria.PageToken)
+		if err != nil {
+			return RevisionLoadResult{}, errors.Wrap(err, "failed to decode revision page token")
+		}
+		where = append(where, sq.Or{
+			sq.Lt{revSeqCol: token.LastIncludedSequenceNumber},
+			sq.And{
+				sq.Eq{revSeqCol: token.LastIncludedSequenceNumber},
+				sq.Lt{revIDCol: token.LastIncludedId},
+			},
+		})
+	}
+
+	pageSize := store.getRevisionLoadPageSize(criteria)
+	rows, err := store.builder.
+		Select(revIDCol, revNidCol, revSeqCol, revTypeCol, revKeyCol, revOpCol, revOperatorCol, revTimeCol, revBeforeCol, revAfterCol).
+		From(revisionTable).
+		Where(where).
+		OrderBy(fmt.Sprintf("%s DESC", revSeqCol), fmt.Sprintf("%s DESC", revIDCol)).
+		Limit(uint64(pageSize)).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return RevisionLoadResult{}, errors.Wrap(err, "failed to query for revisions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadRevisions")
+
+	res := RevisionLoadResult{Revisions: []*Revision{}}
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return RevisionLoadResult{}, err
+		}
+		res.Revisions = append(res.Revisions, revision)
+	}
+	err = rows.Err()
+	if err != nil {
+		return RevisionLoadResult{}, errors.Wrap(err, "sql rows err")
+	}
+
+	// Set next page token when there may be more pages to return
+	if len(res.Revisions) == pageSize {
+		last := res.Revisions[len(res.Revisions)-1]
+		res.NextPageToken, err = serializeRevisionPageToken(&RevisionPageToken{
+			LastIncludedSequenceNumber: last.SequenceNumber,
+			LastIncludedId:             last.Id,
+		})
+		if err != nil {
+			return RevisionLoadResult{}, err
+		}
+	}
+	return res, nil
+}
+
+func (store *sqlConfiguratorStorage) CreateRevision(revision Revision) (Revision, error) {
+	// Avoid typed nils, which would marshal to empty states
+	var before, after proto.Message
+	if revision.NetworkBefore != nil {
+		before = revision.NetworkBefore
+	}
+	if revision.NetworkAfter != nil {
+		after = revision.NetworkAfter
+	}
+	if revision.EntityBefore != nil {
+		before = revision.EntityBefore
+	}
+	if revision.EntityAfter != nil {
+		after = revision.EntityAfter
+	}
+	marshaledBefore, err := marshalRevisionState(before)
+	if err != nil {
+		return Revision{}, err
+	}
+	marshaledAfter, err := marshalRevisionState(after)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	var lastSeq uint64
+	err = store.builder.Select(fmt.Sprintf("COALESCE(MAX(%s), 0)", revSeqCol)).
+		From(revisionTable).
+		Where(sq.Eq{revNidCol: revision.NetworkID}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&lastSeq)
+	if err != nil {
+		return Revision{}, errors.Wrap(err, "failed to get last revision sequence number")
+	}
+
+	// Revision IDs are drawn separately from the ID generator, so recording
+	// revisions doesn't shift the IDs of networks and entities
+	revision.Id = uuid.New().String()
+	revision.SequenceNumber = lastSeq + 1
+	revision.Timestamp = clock.Now().UnixNano() / int64(time.Millisecond)
+	_, err = store.builder.Insert(revisionTable).
+		Columns(revIDCol, revNidCol, revSeqCol, revTypeCol, revKeyCol, revOpCol, revOperatorCol, revTimeCol, revBeforeCol, revAfterCol).
+		Values(
+			revision.Id, revision.NetworkID, revision.SequenceNumber, revision.Type, revision.Key, int32(revision.Operation),
+			revision.Operator, revision.Timestamp, marshaledBefore, marshaledAfter,
+		).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return Revision{}, errors.Wrap(err, "failed to insert revision")
+	}
+	return revision, nil
+}
+
+func (store *sqlConfiguratorStorage) getRevisionLoadPageSize(criteria RevisionLoadCriteria) int {
+	if criteria.PageSize == 0 {
+		return int(store.maxEntityLoadSize)
+	}
+	return util.MinInt(int(criteria.PageSize), int(store.maxEntityLoadSize))
+}
+
+func scanRevision(rows *sql.Rows) (*Revision, error) {
+	var (
+		id, networkID, entType, key string
+		seq                         uint64
+		operation                   int32
+		operator                    sql.NullString
+		timestamp                   int64
+		before, after               []byte
+	)
+	err := rows.Scan(&id, &networkID, &seq, &entType, &key, &operation, &operator, &timestamp, &before, &after)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan revision row")
+	}
+	revision := &Revision{
+		Id:             id,
+		NetworkID:      networkID,
+		Type:           entType,
+		Key:            key,
+		Operation:      RevisionOperation(operation),
+		Operator:       operator.String,
+		Timestamp:      timestamp,
+		SequenceNumber: seq,
+	}
+
+	// Revisions of the network itself have no entity type
+	if entType == "" {
+		revision.NetworkBefore, err = unmarshalRevisionNetwork(before)
+		if err != nil {
+			return nil, err
+		}
+		revision.NetworkAfter, err = unmarshalRevisionNetwork(after)
+		if err != nil {
+			return nil, err
+		}
+		return revision, nil
+	}
+	revision.EntityBefore, err = unmarshalRevisionEntity(before)
+	if err != nil {
+		return nil, err
+	}
+	revision.EntityAfter, err = unmarshalRevisionEntity(after)
+	if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+func marshalRevisionState(state proto.Message) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	marshaled, err := proto.Marshal(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal revision state")
+	}
+	return marshaled, nil
+}
+
+func unmarshalRevisionNetwork(marshaled []byte) (*Network, error) {
+	if marshaled == nil {
+		return nil, nil
+	}
+	network := &Network{}
+	err := proto.Unmarshal(marshaled, network)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revision network state")
+	}
+	return network, nil
+}
+
+func unmarshalRevisionEntity(marshaled []byte) (*NetworkEntity, error) {
+	if marshaled == nil {
+		return nil, nil
+	}
+	ent := &NetworkEntity{}
+	err := proto.Unmarshal(marshaled, ent)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revision entity state")
+	}
+	return ent, nil
+}
+
+func serializeRevisionPageToken(token *RevisionPageToken) (string, error) {
+	marshalledToken, err := proto.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(marshalledToken), nil
+}
+
+func deserializeRevisionPageToken(encodedToken string) (*RevisionPageToken, error) {
+	marshalledToken, err := base64.StdEncoding.DecodeString(encodedToken)
+	if err != nil {
+		return nil, err
+	}
+	token := &RevisionPageToken{}
+	err = proto.Unmarshal(marshalledToken, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -25,6 +25,10 @@ import (
 	"github.com/thoas/go-funk"
 )
 
+// ErrVersionConflict is returned when a network or entity update specifies an
+// expected version which doesn't match the current version of its target.
+var ErrVersionConflict = errors.New("version conflict")
+
 // ConfiguratorStorageFactory creates ConfiguratorStorage implementations bound
 // to transactions.
 type ConfiguratorStorageFactory interface {
@@ -64,6 +68,8 @@ type ConfiguratorStorage interface {
 	CreateNetwork(network Network) (Network, error)
 
 	// UpdateNetworks updates a set of networks.
+	// Returns ErrVersionConflict if an update's expected version doesn't
+	// match its network.
 	UpdateNetworks(updates []NetworkUpdateCriteria) error
 
 	// =======================================================================
@@ -83,6 +89,10 @@ type ConfiguratorStorage interface {
 	// load criteria.
 	CountEntities(networkID string, filter EntityLoadFilter, loadCriteria EntityLoadCriteria) (EntityCountResult, error)
 
+	// ListEntityTypes returns the sorted, distinct types of the entities in
+	// a network.
+	ListEntityTypes(networkID string) ([]string, error)
+
 	// CreateEntity creates a new entity. The created entity is returned
 	// with system-generated fields filled in.
 	CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error)
@@ -91,8 +101,29 @@ type ConfiguratorStorage interface {
 	// The updates to the specified entity will be returned as a NetworkEntity
 	// object. Apart from identity fields, only fields which were updated will
 	// be filled out, with system-generated IDs included.
+	// Returns ErrVersionConflict if the update's expected version doesn't
+	// match the entity.
 	UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error)
 
+	// =======================================================================
+	// Revision Operations
+	// =======================================================================
+
+	// CreateRevision records a mutation of a network or of one of its
+	// entities. The ID, sequence number, and timestamp of the revision are
+	// assigned by storage.
+	// Use NewRevisionRecordingStorage to record the mutations made through a
+	// ConfiguratorStorage.
+	CreateRevision(revision Revision) (Revision, error)
+
+	// LoadRevisions returns the recorded mutations of a network and its
+	// entities, most recent first.
+	//
+	// Loads are paginated: to exhaustively read all pages, clients must
+	// continue querying until an empty page token is received in the load
+	// result.
+	LoadRevisions(networkID string, filter RevisionLoadFilter, criteria RevisionLoadCriteria) (RevisionLoadResult, error)
+
 	// =======================================================================
 	// Graph Operations
 	// =======================================================================
@@ -208,7 +239,7 @@ func (e EntitiesByTK) Ents() []*NetworkEntity {
 // IsLoadAllEntities return true if the EntityLoadFilter is specifying to load
 // all entities in a network, false if there are any filter conditions.
 func (m *EntityLoadFilter) IsLoadAllEntities() bool {
-	return m.TypeFilter == nil && m.KeyFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs)
+	return m.TypeFilter == nil && m.KeyFilter == nil && m.KeyPrefixFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs)
 }
 
 // FullEntityLoadCriteria is an EntityLoadCriteria which loads everything
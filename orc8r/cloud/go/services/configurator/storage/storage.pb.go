@@ -21,6 +21,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevisionOperation is the kind of mutation recorded by a revision.
+type RevisionOperation int32
+
+const (
+	RevisionOperation_CREATE RevisionOperation = 0
+	RevisionOperation_UPDATE RevisionOperation = 1
+	RevisionOperation_DELETE RevisionOperation = 2
+)
+
+var RevisionOperation_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+}
+
+var RevisionOperation_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+}
+
+func (x RevisionOperation) String() string {
+	return proto.EnumName(RevisionOperation_name, int32(x))
+}
+
+func (RevisionOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{0}
+}
+
 // A network represents a tenant. Networks can be configured in a hierarchical
 // manner - network-level configurations are assumed to apply across multiple
 // entities within the network.
@@ -269,10 +298,14 @@ type NetworkUpdateCriteria struct {
 	// New config values to add or existing ones to update
 	ConfigsToAddOrUpdate map[string][]byte `protobuf:"bytes,30,rep,name=configs_to_add_or_update,json=configsToAddOrUpdate,proto3" json:"configs_to_add_or_update,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Config values to delete
-	ConfigsToDelete      []string `protobuf:"bytes,31,rep,name=configs_to_delete,json=configsToDelete,proto3" json:"configs_to_delete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ConfigsToDelete []string `protobuf:"bytes,31,rep,name=configs_to_delete,json=configsToDelete,proto3" json:"configs_to_delete,omitempty"`
+	// If set, the update (or deletion) is only applied if the network's
+	// current version matches. Otherwise the update fails with
+	// ErrVersionConflict.
+	ExpectedVersion      *wrappers.UInt64Value `protobuf:"bytes,40,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *NetworkUpdateCriteria) Reset()         { *m = NetworkUpdateCriteria{} }
@@ -349,6 +382,13 @@ func (m *NetworkUpdateCriteria) GetConfigsToDelete() []string {
 	return nil
 }
 
+func (m *NetworkUpdateCriteria) GetExpectedVersion() *wrappers.UInt64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 type EntityID struct {
 	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -558,7 +598,10 @@ type EntityLoadFilter struct {
 	GraphID *wrappers.StringValue `protobuf:"bytes,4,opt,name=graphID,proto3" json:"graphID,omitempty"`
 	// If PhysicalID is provided, the query will return all entities matching
 	// the provided ID. All other fields are ignored if this is set.
-	PhysicalID           *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	PhysicalID *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	// If KeyPrefixFilter is provided, the query will return all entities
+	// whose key starts with the given prefix.
+	KeyPrefixFilter      *wrappers.StringValue `protobuf:"bytes,6,opt,name=key_prefix_filter,json=keyPrefixFilter,proto3" json:"key_prefix_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -624,6 +667,13 @@ func (m *EntityLoadFilter) GetPhysicalID() *wrappers.StringValue {
 	return nil
 }
 
+func (m *EntityLoadFilter) GetKeyPrefixFilter() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyPrefixFilter
+	}
+	return nil
+}
+
 // EntityLoadCriteria specifies how much of an entity to load
 type EntityLoadCriteria struct {
 	// Set LoadMetadata to true to load the metadata fields (name, description)
@@ -861,9 +911,13 @@ type EntityUpdateCriteria struct {
 	AssociationsToSet    *EntityAssociationsToSet `protobuf:"bytes,30,opt,name=associations_to_set,json=associationsToSet,proto3" json:"associations_to_set,omitempty"`
 	AssociationsToAdd    []*EntityID              `protobuf:"bytes,31,rep,name=associations_to_add,json=associationsToAdd,proto3" json:"associations_to_add,omitempty"`
 	AssociationsToDelete []*EntityID              `protobuf:"bytes,32,rep,name=associations_to_delete,json=associationsToDelete,proto3" json:"associations_to_delete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// If set, the update (or deletion) is only applied if the entity's
+	// current version matches. Otherwise the update fails with
+	// ErrVersionConflict.
+	ExpectedVersion      *wrappers.UInt64Value `protobuf:"bytes,40,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *EntityUpdateCriteria) Reset()         { *m = EntityUpdateCriteria{} }
@@ -961,6 +1015,13 @@ func (m *EntityUpdateCriteria) GetAssociationsToDelete() []*EntityID {
 	return nil
 }
 
+func (m *EntityUpdateCriteria) GetExpectedVersion() *wrappers.UInt64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 type EntityAssociationsToSet struct {
 	AssociationsToSet    []*EntityID `protobuf:"bytes,1,rep,name=associations_to_set,json=associationsToSet,proto3" json:"associations_to_set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -1107,7 +1168,405 @@ func (m *GraphEdge) GetFrom() *EntityID {
 	return nil
 }
 
+// Revision records a single mutation of a network or of a network entity.
+type Revision struct {
+	// Unique ID of the revision
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkID string `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// (Type, Key) of the mutated entity. Both are empty for mutations of the
+	// network itself.
+	Type      string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Key       string            `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Operation RevisionOperation `protobuf:"varint,5,opt,name=operation,proto3,enum=magma.orc8r.configurator.storage.RevisionOperation" json:"operation,omitempty"`
+	// Identity of the operator on whose behalf the mutation was made. Empty
+	// if the mutation wasn't attributed to an operator.
+	Operator string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Unix time of the mutation, in milliseconds
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Revisions of a network are numbered in commit order. Revisions
+	// committed by concurrent transactions may share a sequence number.
+	SequenceNumber uint64 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// State of the network or entity before and after the mutation. Before
+	// states are unset for creations, and after states for deletions.
+	NetworkBefore        *Network       `protobuf:"bytes,10,opt,name=network_before,json=networkBefore,proto3" json:"network_before,omitempty"`
+	NetworkAfter         *Network       `protobuf:"bytes,11,opt,name=network_after,json=networkAfter,proto3" json:"network_after,omitempty"`
+	EntityBefore         *NetworkEntity `protobuf:"bytes,12,opt,name=entity_before,json=entityBefore,proto3" json:"entity_before,omitempty"`
+	EntityAfter          *NetworkEntity `protobuf:"bytes,13,opt,name=entity_after,json=entityAfter,proto3" json:"entity_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Revision) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *Revision) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Revision) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Revision) GetOperation() RevisionOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return RevisionOperation_CREATE
+}
+
+func (m *Revision) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Revision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Revision) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
+func (m *Revision) GetNetworkBefore() *Network {
+	if m != nil {
+		return m.NetworkBefore
+	}
+	return nil
+}
+
+func (m *Revision) GetNetworkAfter() *Network {
+	if m != nil {
+		return m.NetworkAfter
+	}
+	return nil
+}
+
+func (m *Revision) GetEntityBefore() *NetworkEntity {
+	if m != nil {
+		return m.EntityBefore
+	}
+	return nil
+}
+
+func (m *Revision) GetEntityAfter() *NetworkEntity {
+	if m != nil {
+		return m.EntityAfter
+	}
+	return nil
+}
+
+type RevisionLoadFilter struct {
+	// If set, only load revisions of the entities matching these type and
+	// key filters. Set both to empty strings to only load revisions of the
+	// network itself.
+	TypeFilter *wrappers.StringValue `protobuf:"bytes,1,opt,name=type_filter,json=typeFilter,proto3" json:"type_filter,omitempty"`
+	KeyFilter  *wrappers.StringValue `protobuf:"bytes,2,opt,name=key_filter,json=keyFilter,proto3" json:"key_filter,omitempty"`
+	// If set, only load the revision with this ID
+	Id                   *wrappers.StringValue `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RevisionLoadFilter) Reset()         { *m = RevisionLoadFilter{} }
+func (m *RevisionLoadFilter) String() string { return proto.CompactTextString(m) }
+func (*RevisionLoadFilter) ProtoMessage()    {}
+func (*RevisionLoadFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+
+func (m *RevisionLoadFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionLoadFilter.Unmarshal(m, b)
+}
+func (m *RevisionLoadFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionLoadFilter.Marshal(b, m, deterministic)
+}
+func (m *RevisionLoadFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionLoadFilter.Merge(m, src)
+}
+func (m *RevisionLoadFilter) XXX_Size() int {
+	return xxx_messageInfo_RevisionLoadFilter.Size(m)
+}
+func (m *RevisionLoadFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionLoadFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionLoadFilter proto.InternalMessageInfo
+
+func (m *RevisionLoadFilter) GetTypeFilter() *wrappers.StringValue {
+	if m != nil {
+		return m.TypeFilter
+	}
+	return nil
+}
+
+func (m *RevisionLoadFilter) GetKeyFilter() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyFilter
+	}
+	return nil
+}
+
+func (m *RevisionLoadFilter) GetId() *wrappers.StringValue {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type RevisionLoadCriteria struct {
+	// page_size is the maximum number of revisions returned per load.
+	// The storage default is used if left unset.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of a previous load.
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionLoadCriteria) Reset()         { *m = RevisionLoadCriteria{} }
+func (m *RevisionLoadCriteria) String() string { return proto.CompactTextString(m) }
+func (*RevisionLoadCriteria) ProtoMessage()    {}
+func (*RevisionLoadCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
+}
+
+func (m *RevisionLoadCriteria) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionLoadCriteria.Unmarshal(m, b)
+}
+func (m *RevisionLoadCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionLoadCriteria.Marshal(b, m, deterministic)
+}
+func (m *RevisionLoadCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionLoadCriteria.Merge(m, src)
+}
+func (m *RevisionLoadCriteria) XXX_Size() int {
+	return xxx_messageInfo_RevisionLoadCriteria.Size(m)
+}
+func (m *RevisionLoadCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionLoadCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionLoadCriteria proto.InternalMessageInfo
+
+func (m *RevisionLoadCriteria) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *RevisionLoadCriteria) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type RevisionLoadResult struct {
+	// Loaded revisions, most recent first
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// next_page_token is set when there may be more revisions to load.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionLoadResult) Reset()         { *m = RevisionLoadResult{} }
+func (m *RevisionLoadResult) String() string { return proto.CompactTextString(m) }
+func (*RevisionLoadResult) ProtoMessage()    {}
+func (*RevisionLoadResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{19}
+}
+
+func (m *RevisionLoadResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionLoadResult.Unmarshal(m, b)
+}
+func (m *RevisionLoadResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionLoadResult.Marshal(b, m, deterministic)
+}
+func (m *RevisionLoadResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionLoadResult.Merge(m, src)
+}
+func (m *RevisionLoadResult) XXX_Size() int {
+	return xxx_messageInfo_RevisionLoadResult.Size(m)
+}
+func (m *RevisionLoadResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionLoadResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionLoadResult proto.InternalMessageInfo
+
+func (m *RevisionLoadResult) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *RevisionLoadResult) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// RevisionPageToken is an opaque token provided to load the next page of
+// revisions.
+type RevisionPageToken struct {
+	LastIncludedSequenceNumber uint64   `protobuf:"varint,1,opt,name=last_included_sequence_number,json=lastIncludedSequenceNumber,proto3" json:"last_included_sequence_number,omitempty"`
+	LastIncludedId             string   `protobuf:"bytes,2,opt,name=last_included_id,json=lastIncludedId,proto3" json:"last_included_id,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *RevisionPageToken) Reset()         { *m = RevisionPageToken{} }
+func (m *RevisionPageToken) String() string { return proto.CompactTextString(m) }
+func (*RevisionPageToken) ProtoMessage()    {}
+func (*RevisionPageToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{20}
+}
+
+func (m *RevisionPageToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionPageToken.Unmarshal(m, b)
+}
+func (m *RevisionPageToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionPageToken.Marshal(b, m, deterministic)
+}
+func (m *RevisionPageToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionPageToken.Merge(m, src)
+}
+func (m *RevisionPageToken) XXX_Size() int {
+	return xxx_messageInfo_RevisionPageToken.Size(m)
+}
+func (m *RevisionPageToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionPageToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionPageToken proto.InternalMessageInfo
+
+func (m *RevisionPageToken) GetLastIncludedSequenceNumber() uint64 {
+	if m != nil {
+		return m.LastIncludedSequenceNumber
+	}
+	return 0
+}
+
+func (m *RevisionPageToken) GetLastIncludedId() string {
+	if m != nil {
+		return m.LastIncludedId
+	}
+	return ""
+}
+
+// NetworkArchive is a portable snapshot of a network and all of its
+// entities. Storage-assigned fields (graph IDs, versions, and parent
+// associations) are left unset.
+type NetworkArchive struct {
+	Network *Network `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	// Entities of the network, ordered by type and key. The association
+	// graph is captured by the entities' associations.
+	Entities             []*NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NetworkArchive) Reset()         { *m = NetworkArchive{} }
+func (m *NetworkArchive) String() string { return proto.CompactTextString(m) }
+func (*NetworkArchive) ProtoMessage()    {}
+func (*NetworkArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{21}
+}
+
+func (m *NetworkArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkArchive.Unmarshal(m, b)
+}
+func (m *NetworkArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkArchive.Marshal(b, m, deterministic)
+}
+func (m *NetworkArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkArchive.Merge(m, src)
+}
+func (m *NetworkArchive) XXX_Size() int {
+	return xxx_messageInfo_NetworkArchive.Size(m)
+}
+func (m *NetworkArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkArchive proto.InternalMessageInfo
+
+func (m *NetworkArchive) GetNetwork() *Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *NetworkArchive) GetEntities() []*NetworkEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("magma.orc8r.configurator.storage.RevisionOperation", RevisionOperation_name, RevisionOperation_value)
 	proto.RegisterType((*Network)(nil), "magma.orc8r.configurator.storage.Network")
 	proto.RegisterMapType((map[string][]byte)(nil), "magma.orc8r.configurator.storage.Network.ConfigsEntry")
 	proto.RegisterType((*NetworkLoadFilter)(nil), "magma.orc8r.configurator.storage.NetworkLoadFilter")
@@ -1126,87 +1585,117 @@ func init() {
 	proto.RegisterType((*EntityAssociationsToSet)(nil), "magma.orc8r.configurator.storage.EntityAssociationsToSet")
 	proto.RegisterType((*EntityGraph)(nil), "magma.orc8r.configurator.storage.EntityGraph")
 	proto.RegisterType((*GraphEdge)(nil), "magma.orc8r.configurator.storage.GraphEdge")
+	proto.RegisterType((*Revision)(nil), "magma.orc8r.configurator.storage.Revision")
+	proto.RegisterType((*RevisionLoadFilter)(nil), "magma.orc8r.configurator.storage.RevisionLoadFilter")
+	proto.RegisterType((*RevisionLoadCriteria)(nil), "magma.orc8r.configurator.storage.RevisionLoadCriteria")
+	proto.RegisterType((*RevisionLoadResult)(nil), "magma.orc8r.configurator.storage.RevisionLoadResult")
+	proto.RegisterType((*RevisionPageToken)(nil), "magma.orc8r.configurator.storage.RevisionPageToken")
+	proto.RegisterType((*NetworkArchive)(nil), "magma.orc8r.configurator.storage.NetworkArchive")
 }
 
 func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 1217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xef, 0x6e, 0x1b, 0x45,
-	0x10, 0xd7, 0x9d, 0x9d, 0xd8, 0x1e, 0xdb, 0xf9, 0xb3, 0x71, 0xd2, 0x23, 0x2d, 0xa9, 0x39, 0x04,
-	0x4a, 0x8a, 0x70, 0x4a, 0x2a, 0x95, 0x12, 0x0a, 0x52, 0x1a, 0x3b, 0x95, 0x05, 0xa4, 0xe1, 0x62,
-	0x10, 0x2a, 0x42, 0xc7, 0xd6, 0xb7, 0x71, 0x4e, 0xb6, 0x6f, 0x4f, 0x7b, 0xeb, 0x1a, 0xf7, 0x05,
-	0x00, 0x95, 0xa7, 0xe1, 0x11, 0x78, 0x13, 0x5e, 0x80, 0xcf, 0x7c, 0x44, 0xfb, 0xe7, 0xec, 0xb3,
-	0xd3, 0x2a, 0x77, 0x80, 0xd4, 0x6f, 0xb7, 0x33, 0xfb, 0xfb, 0xed, 0xce, 0xec, 0xcc, 0x6f, 0x0e,
-	0xaa, 0x11, 0xa7, 0x0c, 0xf7, 0x48, 0x23, 0x64, 0x94, 0x53, 0x54, 0x1f, 0xe2, 0xde, 0x10, 0x37,
-	0x28, 0xeb, 0x3e, 0x60, 0x8d, 0x2e, 0x0d, 0x2e, 0xfc, 0xde, 0x88, 0x61, 0x4e, 0x59, 0x43, 0xef,
-	0xdb, 0xde, 0xe9, 0x51, 0xda, 0x1b, 0x90, 0x7d, 0xb9, 0xff, 0xd9, 0xe8, 0x62, 0x7f, 0xcc, 0x70,
-	0x18, 0x12, 0x16, 0x29, 0x06, 0xfb, 0xa5, 0x09, 0x85, 0x53, 0xc2, 0xc7, 0x94, 0xf5, 0xd1, 0x0a,
-	0x98, 0xed, 0xa6, 0x65, 0xd4, 0x8d, 0xdd, 0x92, 0x63, 0xb6, 0x9b, 0x08, 0x41, 0xbe, 0x33, 0x09,
-	0x89, 0x65, 0x4a, 0x8b, 0xfc, 0x16, 0xb6, 0x00, 0x0f, 0x89, 0x05, 0xca, 0x26, 0xbe, 0x51, 0x1d,
-	0xca, 0x1e, 0x89, 0xba, 0xcc, 0x0f, 0xb9, 0x4f, 0x03, 0xab, 0x2c, 0x5d, 0x49, 0x13, 0x3a, 0x83,
-	0x82, 0xba, 0x5d, 0x64, 0xd5, 0xea, 0xb9, 0xdd, 0xf2, 0xc1, 0xfd, 0xc6, 0x75, 0x37, 0x6f, 0xe8,
-	0x5b, 0x35, 0x8e, 0x15, 0xb0, 0x15, 0x70, 0x36, 0x71, 0x62, 0x1a, 0x64, 0x41, 0xe1, 0x39, 0x61,
-	0x91, 0x38, 0x6f, 0xa7, 0x6e, 0xec, 0xe6, 0x9d, 0x78, 0xb9, 0x7d, 0x08, 0x95, 0x24, 0x04, 0xad,
-	0x41, 0xae, 0x4f, 0x26, 0x3a, 0x2c, 0xf1, 0x89, 0x6a, 0xb0, 0xf4, 0x1c, 0x0f, 0x46, 0x2a, 0xb0,
-	0x8a, 0xa3, 0x16, 0x87, 0xe6, 0x03, 0xc3, 0xf6, 0x60, 0x5d, 0x1f, 0xfb, 0x25, 0xc5, 0xde, 0x89,
-	0x3f, 0xe0, 0x84, 0x09, 0x02, 0xdf, 0x8b, 0x2c, 0xa3, 0x9e, 0x13, 0x04, 0xbe, 0x17, 0xa1, 0xcf,
-	0xa0, 0xcc, 0x27, 0x21, 0x71, 0x2f, 0xe4, 0x06, 0x49, 0x53, 0x3e, 0xb8, 0xd5, 0x50, 0xa9, 0x6e,
-	0xc4, 0xa9, 0x6e, 0x9c, 0x73, 0xe6, 0x07, 0xbd, 0x6f, 0x05, 0xbb, 0x03, 0x02, 0xa0, 0x08, 0xed,
-	0x1f, 0x60, 0x23, 0x71, 0xca, 0x31, 0xf3, 0x39, 0x61, 0x3e, 0x46, 0xef, 0x42, 0x75, 0x40, 0xb1,
-	0xe7, 0x0e, 0x09, 0xc7, 0x1e, 0xe6, 0x58, 0x5e, 0xb9, 0xe8, 0x54, 0x84, 0xf1, 0x2b, 0x6d, 0x43,
-	0xef, 0x80, 0x5c, 0xbb, 0x71, 0x3a, 0x4d, 0xb9, 0xa7, 0x2c, 0x6c, 0x3a, 0x6a, 0xfb, 0x37, 0x63,
-	0x2e, 0x0a, 0x87, 0x44, 0xa3, 0x01, 0x47, 0x2d, 0x28, 0x06, 0xca, 0xa8, 0x42, 0x29, 0x1f, 0xec,
-	0xa5, 0x7e, 0x03, 0x67, 0x0a, 0x45, 0x77, 0xa1, 0xa6, 0xbf, 0xdb, 0xcd, 0xc8, 0x0d, 0x28, 0x77,
-	0x2f, 0xe8, 0x28, 0xf0, 0x2c, 0x53, 0x66, 0x07, 0xcd, 0x7c, 0xa7, 0x94, 0x9f, 0x08, 0x8f, 0xfd,
-	0x4b, 0x1e, 0x36, 0x35, 0xcf, 0x37, 0xa1, 0x87, 0x39, 0x99, 0x06, 0xbc, 0x58, 0x6f, 0xef, 0xc1,
-	0x8a, 0x47, 0x06, 0x84, 0x13, 0x57, 0xd3, 0xc8, 0x2a, 0x2b, 0x3a, 0x55, 0x65, 0x8d, 0xcb, 0xf4,
-	0x63, 0x11, 0xc9, 0xd8, 0x95, 0x65, 0x58, 0x4b, 0x91, 0xfa, 0x42, 0x40, 0xc6, 0xa7, 0xa2, 0x4e,
-	0x5b, 0xb0, 0x2a, 0x80, 0xc9, 0x5a, 0xdd, 0x4c, 0x81, 0x5f, 0x09, 0xc8, 0xb8, 0x99, 0x28, 0x66,
-	0x7d, 0xbe, 0x78, 0x50, 0x6b, 0x2b, 0xe5, 0xf9, 0xb2, 0x77, 0x7e, 0x35, 0xc0, 0xd2, 0xef, 0xe6,
-	0x72, 0xea, 0x62, 0xcf, 0x73, 0x29, 0x73, 0x47, 0x32, 0x29, 0xd6, 0x8e, 0x7c, 0x93, 0xaf, 0x53,
-	0xbf, 0xc9, 0x7c, 0x2e, 0xe3, 0x2e, 0xe9, 0xd0, 0x23, 0xcf, 0x7b, 0xc2, 0x94, 0x53, 0xb5, 0x4c,
-	0xad, 0xfb, 0x0a, 0x17, 0xba, 0x03, 0xeb, 0x89, 0xab, 0xa8, 0x04, 0x5b, 0xb7, 0xe5, 0x23, 0xae,
-	0x4e, 0x01, 0x4d, 0x69, 0xde, 0x7e, 0x0c, 0x6f, 0xbd, 0x96, 0x3e, 0x53, 0x7b, 0xdd, 0x85, 0x62,
-	0x2b, 0xe0, 0x3e, 0x9f, 0x28, 0x71, 0x91, 0x19, 0x54, 0x40, 0xf9, 0x1d, 0x73, 0x99, 0x53, 0x2e,
-	0xfb, 0xf7, 0x1c, 0x54, 0x75, 0xc0, 0x0a, 0x89, 0x6e, 0x41, 0x69, 0x5a, 0x64, 0x1a, 0x3c, 0x33,
-	0x4c, 0x59, 0xcd, 0xab, 0xac, 0xb9, 0xd9, 0x0d, 0xff, 0x9d, 0x88, 0xed, 0x00, 0x84, 0x97, 0x93,
-	0xc8, 0xef, 0xe2, 0x41, 0xbb, 0x29, 0x2b, 0xaf, 0xe4, 0x24, 0x2c, 0x68, 0x0b, 0x96, 0x55, 0xe6,
-	0xa4, 0x22, 0x55, 0x1c, 0xbd, 0x12, 0x52, 0xd5, 0x63, 0x38, 0xbc, 0x6c, 0x37, 0xad, 0x5d, 0x09,
-	0x8a, 0x97, 0xa2, 0x01, 0xc2, 0xbe, 0xb5, 0xa7, 0x1a, 0x20, 0xec, 0xa3, 0x53, 0xa8, 0xe0, 0x28,
-	0xa2, 0x5d, 0x1f, 0x8b, 0x03, 0x23, 0xeb, 0x40, 0xd6, 0xc4, 0x9d, 0xeb, 0x6b, 0x22, 0xce, 0xaa,
-	0x33, 0x87, 0x47, 0xdf, 0xc3, 0x46, 0x88, 0x19, 0x09, 0xb8, 0x3b, 0x47, 0x7b, 0x2f, 0x33, 0x2d,
-	0x52, 0x34, 0x47, 0x49, 0xf2, 0x84, 0x02, 0x9f, 0xcc, 0x29, 0xb0, 0xfd, 0x87, 0x09, 0x6b, 0x0a,
-	0x9a, 0x50, 0xd1, 0x05, 0xcd, 0x34, 0xb2, 0x69, 0x26, 0xfa, 0x14, 0xa0, 0x4f, 0x26, 0x59, 0x14,
-	0xb7, 0xd4, 0x27, 0x13, 0x0d, 0x7e, 0x08, 0xb9, 0x76, 0x33, 0xb2, 0x72, 0x99, 0xe3, 0x16, 0x30,
-	0x74, 0x7f, 0xf6, 0x7e, 0xf9, 0x34, 0xed, 0x1e, 0xbf, 0xee, 0xc3, 0xb9, 0x7a, 0x59, 0x4a, 0x13,
-	0xf0, 0x6c, 0xbf, 0xfd, 0xb7, 0x01, 0x68, 0x96, 0xc4, 0x6c, 0x43, 0xe2, 0x36, 0x94, 0x13, 0x43,
-	0x42, 0xcf, 0x08, 0x98, 0xcd, 0x08, 0xf4, 0x21, 0x6c, 0xc8, 0x0d, 0xb2, 0x2c, 0xa4, 0x02, 0xf0,
-	0x4b, 0x3f, 0x92, 0x2d, 0x52, 0x74, 0xd6, 0x84, 0x4b, 0x3e, 0x75, 0xd4, 0xa1, 0x9d, 0x4b, 0x3f,
-	0x42, 0x1f, 0xc1, 0x66, 0x72, 0xfb, 0x05, 0xa3, 0x43, 0x05, 0xc8, 0x4b, 0x00, 0x9a, 0x01, 0x4e,
-	0x18, 0x1d, 0x4a, 0xc8, 0x4d, 0x28, 0x85, 0xb8, 0x47, 0xdc, 0xc8, 0x7f, 0x41, 0xac, 0xe5, 0xba,
-	0xb1, 0x5b, 0x75, 0x8a, 0xc2, 0x70, 0xee, 0xbf, 0x20, 0xe8, 0x6d, 0x00, 0xe9, 0xe4, 0xb4, 0x4f,
-	0x02, 0xab, 0xa0, 0x9a, 0x58, 0x58, 0x3a, 0xc2, 0x60, 0xff, 0x69, 0x24, 0xeb, 0x47, 0xcf, 0xaf,
-	0x2f, 0xa0, 0x48, 0x84, 0xcd, 0x27, 0xf1, 0xfc, 0xda, 0x4f, 0xad, 0x95, 0x8a, 0xcc, 0x99, 0x12,
-	0xa0, 0xef, 0x00, 0xc5, 0xdf, 0x0b, 0x33, 0x2c, 0x5b, 0x7d, 0xac, 0xc5, 0x2c, 0xf1, 0xb4, 0x43,
-	0xef, 0x8b, 0x19, 0xf3, 0x13, 0x77, 0x13, 0xf1, 0x29, 0xe1, 0xa9, 0x0a, 0xf3, 0xd9, 0x34, 0xc6,
-	0x3d, 0x58, 0x57, 0x2c, 0xc7, 0x74, 0x14, 0x70, 0x1d, 0x63, 0x0d, 0x96, 0xba, 0x62, 0x29, 0x1f,
-	0x35, 0xef, 0xa8, 0x85, 0x7d, 0x0c, 0xab, 0x6a, 0xeb, 0x14, 0x2d, 0xa6, 0xf0, 0x00, 0x47, 0xdc,
-	0xf5, 0x83, 0xee, 0x60, 0xe4, 0x11, 0xcf, 0x95, 0xf7, 0x88, 0x55, 0x18, 0x09, 0x5f, 0x5b, 0xbb,
-	0x14, 0xd4, 0x7e, 0xb9, 0x04, 0x35, 0xf5, 0xb9, 0x30, 0x84, 0x53, 0xe9, 0xb0, 0x28, 0x3b, 0x3d,
-	0x9a, 0xf5, 0x49, 0x6a, 0x32, 0x57, 0x94, 0x51, 0x4b, 0xf3, 0x9b, 0x1e, 0xcc, 0xc7, 0x20, 0x2c,
-	0x6e, 0xa2, 0xe9, 0xd2, 0x8c, 0xe7, 0x6a, 0x40, 0xc6, 0x67, 0x33, 0x15, 0x3f, 0x04, 0x10, 0x24,
-	0xba, 0x75, 0x6e, 0x48, 0x82, 0x9b, 0x57, 0x08, 0x1e, 0x4d, 0x38, 0x89, 0xb4, 0xce, 0x04, 0x64,
-	0xac, 0xdb, 0xca, 0x87, 0x8d, 0xa4, 0xd0, 0x8a, 0xbe, 0x8a, 0x08, 0x97, 0xe3, 0xa0, 0x7c, 0xf0,
-	0x49, 0xda, 0xba, 0x4a, 0xaa, 0x6c, 0x87, 0x9e, 0x13, 0xee, 0xac, 0xe3, 0x45, 0x13, 0x7a, 0x7a,
-	0xf5, 0x28, 0xec, 0x79, 0x72, 0x82, 0x67, 0x2b, 0xe1, 0x05, 0xee, 0x23, 0xcf, 0x43, 0x3f, 0xc2,
-	0xd6, 0x22, 0xb7, 0xfe, 0x41, 0xa8, 0x67, 0xa6, 0xaf, 0xcd, 0xd3, 0xab, 0x3f, 0x0a, 0x7b, 0x04,
-	0x37, 0x5e, 0x13, 0xeb, 0xab, 0x02, 0x13, 0x39, 0x34, 0xfe, 0x6b, 0x60, 0xe7, 0x84, 0xdb, 0x7f,
-	0x19, 0x50, 0x56, 0xfe, 0xc7, 0x42, 0xa3, 0xff, 0x5f, 0x4d, 0x79, 0x02, 0x55, 0x46, 0x29, 0x77,
-	0xa7, 0x8c, 0xd9, 0xe5, 0xa4, 0x22, 0x08, 0x5a, 0x31, 0xe1, 0x11, 0x2c, 0x11, 0xaf, 0x47, 0xe2,
-	0xb9, 0xf5, 0xc1, 0xf5, 0x44, 0x32, 0xaa, 0x96, 0xd7, 0x23, 0x8e, 0x42, 0xda, 0x3f, 0x1b, 0x50,
-	0x9a, 0x1a, 0xd1, 0x21, 0x98, 0x9c, 0xea, 0xc9, 0x9b, 0xe5, 0x5a, 0x26, 0xa7, 0xe8, 0x73, 0xc8,
-	0x0b, 0xd9, 0xd7, 0x93, 0x37, 0x0b, 0x5a, 0xe2, 0x1e, 0x95, 0x9e, 0x16, 0xb4, 0xe7, 0xd9, 0xb2,
-	0xec, 0xa2, 0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xf6, 0x54, 0xf7, 0xcc, 0x0e, 0x00,
-	0x00,
+	// 1609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0xdb, 0x46,
+	0x16, 0x5e, 0x52, 0xb2, 0x2d, 0x1d, 0xfd, 0x58, 0x1e, 0x2b, 0x09, 0xd7, 0x49, 0x1c, 0x2d, 0x17,
+	0xbb, 0xeb, 0x64, 0x77, 0x95, 0xac, 0xb3, 0x48, 0xb2, 0xde, 0xb4, 0x80, 0x62, 0xc9, 0x89, 0xd0,
+	0xd4, 0x71, 0x68, 0x25, 0x28, 0x52, 0x14, 0x2c, 0x2d, 0x8e, 0x64, 0x42, 0x12, 0x87, 0x25, 0x47,
+	0x76, 0x94, 0xab, 0x5e, 0xb5, 0x28, 0x9a, 0xa7, 0xe8, 0x23, 0xf4, 0x01, 0xfa, 0x0e, 0x05, 0xfa,
+	0x00, 0xed, 0x0b, 0xf4, 0xba, 0x97, 0xc5, 0xfc, 0x49, 0x94, 0x6c, 0xd7, 0x62, 0x12, 0xa0, 0xbd,
+	0x9b, 0x39, 0xc3, 0xef, 0x9b, 0x73, 0xe6, 0xfc, 0x4a, 0x50, 0x88, 0x28, 0x09, 0x9d, 0x2e, 0xae,
+	0x06, 0x21, 0xa1, 0x04, 0x55, 0x06, 0x4e, 0x77, 0xe0, 0x54, 0x49, 0xd8, 0xbe, 0x17, 0x56, 0xdb,
+	0xc4, 0xef, 0x78, 0xdd, 0x61, 0xe8, 0x50, 0x12, 0x56, 0xe5, 0x77, 0x6b, 0xeb, 0x5d, 0x42, 0xba,
+	0x7d, 0x7c, 0x93, 0x7f, 0x7f, 0x30, 0xec, 0xdc, 0x3c, 0x0e, 0x9d, 0x20, 0xc0, 0x61, 0x24, 0x18,
+	0xcc, 0xaf, 0x75, 0x58, 0xda, 0xc5, 0xf4, 0x98, 0x84, 0x3d, 0x54, 0x04, 0xbd, 0x59, 0x37, 0xb4,
+	0x8a, 0xb6, 0x91, 0xb5, 0xf4, 0x66, 0x1d, 0x21, 0x48, 0xb7, 0x46, 0x01, 0x36, 0x74, 0x2e, 0xe1,
+	0x6b, 0x26, 0xf3, 0x9d, 0x01, 0x36, 0x40, 0xc8, 0xd8, 0x1a, 0x55, 0x20, 0xe7, 0xe2, 0xa8, 0x1d,
+	0x7a, 0x01, 0xf5, 0x88, 0x6f, 0xe4, 0xf8, 0x51, 0x5c, 0x84, 0xf6, 0x60, 0x49, 0x68, 0x17, 0x19,
+	0xe5, 0x4a, 0x6a, 0x23, 0xb7, 0x79, 0xa7, 0x7a, 0x9e, 0xe6, 0x55, 0xa9, 0x55, 0x75, 0x5b, 0x00,
+	0x1b, 0x3e, 0x0d, 0x47, 0x96, 0xa2, 0x41, 0x06, 0x2c, 0x1d, 0xe1, 0x30, 0x62, 0xf7, 0xad, 0x57,
+	0xb4, 0x8d, 0xb4, 0xa5, 0xb6, 0x6b, 0x5b, 0x90, 0x8f, 0x43, 0x50, 0x09, 0x52, 0x3d, 0x3c, 0x92,
+	0x66, 0xb1, 0x25, 0x2a, 0xc3, 0xc2, 0x91, 0xd3, 0x1f, 0x0a, 0xc3, 0xf2, 0x96, 0xd8, 0x6c, 0xe9,
+	0xf7, 0x34, 0xd3, 0x85, 0x15, 0x79, 0xed, 0x63, 0xe2, 0xb8, 0x3b, 0x5e, 0x9f, 0xe2, 0x90, 0x11,
+	0x78, 0x6e, 0x64, 0x68, 0x95, 0x14, 0x23, 0xf0, 0xdc, 0x08, 0xbd, 0x07, 0x39, 0x3a, 0x0a, 0xb0,
+	0xdd, 0xe1, 0x1f, 0x70, 0x9a, 0xdc, 0xe6, 0x95, 0xaa, 0x78, 0xea, 0xaa, 0x7a, 0xea, 0xea, 0x3e,
+	0x0d, 0x3d, 0xbf, 0xfb, 0x9c, 0xb1, 0x5b, 0xc0, 0x00, 0x82, 0xd0, 0xfc, 0x04, 0x56, 0x63, 0xb7,
+	0x6c, 0x87, 0x1e, 0xc5, 0xa1, 0xe7, 0xa0, 0xbf, 0x42, 0xa1, 0x4f, 0x1c, 0xd7, 0x1e, 0x60, 0xea,
+	0xb8, 0x0e, 0x75, 0xb8, 0xca, 0x19, 0x2b, 0xcf, 0x84, 0x1f, 0x4a, 0x19, 0xfa, 0x0b, 0xf0, 0xbd,
+	0xad, 0x9e, 0x53, 0xe7, 0xdf, 0xe4, 0x98, 0x4c, 0x5a, 0x6d, 0xbe, 0xd6, 0xa6, 0xac, 0xb0, 0x70,
+	0x34, 0xec, 0x53, 0xd4, 0x80, 0x8c, 0x2f, 0x84, 0xc2, 0x94, 0xdc, 0xe6, 0xf5, 0xb9, 0x7d, 0x60,
+	0x8d, 0xa1, 0xe8, 0x16, 0x94, 0xe5, 0xba, 0x59, 0x8f, 0x6c, 0x9f, 0x50, 0xbb, 0x43, 0x86, 0xbe,
+	0x6b, 0xe8, 0xfc, 0x75, 0xd0, 0xe4, 0x6c, 0x97, 0xd0, 0x1d, 0x76, 0x62, 0x7e, 0x9f, 0x86, 0x0b,
+	0x92, 0xe7, 0x59, 0xe0, 0x3a, 0x14, 0x8f, 0x0d, 0x9e, 0x8d, 0xb7, 0xbf, 0x41, 0xd1, 0xc5, 0x7d,
+	0x4c, 0xb1, 0x2d, 0x69, 0x78, 0x94, 0x65, 0xac, 0x82, 0x90, 0xaa, 0x30, 0xbd, 0xcb, 0x2c, 0x39,
+	0xb6, 0x79, 0x18, 0x96, 0xe7, 0x78, 0xfa, 0x25, 0x1f, 0x1f, 0xef, 0xb2, 0x38, 0x6d, 0xc0, 0x32,
+	0x03, 0xc6, 0x63, 0xf5, 0xc2, 0x1c, 0xf8, 0xa2, 0x8f, 0x8f, 0xeb, 0x13, 0x8c, 0xba, 0x9f, 0x39,
+	0xd4, 0xb8, 0x38, 0xe7, 0xfd, 0x3c, 0x77, 0xbe, 0xd2, 0xc0, 0x90, 0x7e, 0xb3, 0x29, 0xb1, 0x1d,
+	0xd7, 0xb5, 0x49, 0x68, 0x0f, 0xf9, 0xa3, 0x18, 0xeb, 0xdc, 0x27, 0x4f, 0xe7, 0xf6, 0xc9, 0xf4,
+	0x5b, 0xaa, 0x2c, 0x69, 0x91, 0x9a, 0xeb, 0x3e, 0x09, 0xc5, 0xa1, 0x48, 0x99, 0x72, 0xfb, 0x94,
+	0x23, 0x74, 0x03, 0x56, 0x62, 0xaa, 0x88, 0x07, 0x36, 0xae, 0x71, 0x27, 0x2e, 0x8f, 0x01, 0x75,
+	0x2e, 0x46, 0x0f, 0xa1, 0x84, 0x5f, 0x06, 0xb8, 0x4d, 0xb1, 0x6b, 0xab, 0xa4, 0xdb, 0x38, 0xc3,
+	0xf0, 0x67, 0x4d, 0x9f, 0xde, 0xf9, 0xaf, 0x30, 0x7c, 0x59, 0xa1, 0x9e, 0xcb, 0xd4, 0x7c, 0x08,
+	0x7f, 0x3e, 0x53, 0xcf, 0x44, 0x79, 0x7a, 0x0b, 0x32, 0x0d, 0x9f, 0x7a, 0x74, 0x24, 0xaa, 0x14,
+	0x77, 0x85, 0x00, 0xf2, 0xb5, 0xe2, 0xd2, 0xc7, 0x5c, 0xe6, 0xb7, 0x29, 0x28, 0xc8, 0x97, 0x13,
+	0x48, 0x74, 0x05, 0xb2, 0xe3, 0x68, 0x95, 0xe0, 0x89, 0x60, 0xcc, 0xaa, 0x9f, 0x64, 0x4d, 0x4d,
+	0x34, 0x7c, 0xb3, 0x6a, 0xb8, 0x0e, 0x10, 0x1c, 0x8e, 0x22, 0xaf, 0xed, 0xf4, 0x9b, 0x75, 0x1e,
+	0xc2, 0x59, 0x2b, 0x26, 0x41, 0x17, 0x61, 0x51, 0xb8, 0x80, 0x97, 0xb6, 0xbc, 0x25, 0x77, 0xac,
+	0xe6, 0x75, 0x43, 0x27, 0x38, 0x6c, 0xd6, 0xf9, 0xf3, 0x67, 0x2d, 0xb5, 0x65, 0x99, 0x14, 0xf4,
+	0x8c, 0xeb, 0x22, 0x93, 0x82, 0x1e, 0xda, 0x85, 0xbc, 0x13, 0x45, 0xa4, 0xed, 0x39, 0xec, 0xc2,
+	0xc8, 0xd8, 0xe4, 0xc1, 0x75, 0xe3, 0xfc, 0xe0, 0x52, 0xaf, 0x6a, 0x4d, 0xe1, 0xd1, 0xc7, 0xb0,
+	0x1a, 0x38, 0x21, 0xf6, 0xa9, 0x3d, 0x45, 0x7b, 0x3b, 0x31, 0x2d, 0x12, 0x34, 0xb5, 0x38, 0x79,
+	0xac, 0x94, 0xef, 0x4c, 0x95, 0x72, 0xf3, 0x75, 0x0a, 0x4a, 0x02, 0x1a, 0x2b, 0xc7, 0x33, 0xc5,
+	0x57, 0x4b, 0x56, 0x7c, 0xd1, 0xff, 0x01, 0x7a, 0x78, 0x94, 0xa4, 0x74, 0x67, 0x7b, 0x78, 0x24,
+	0xc1, 0xf7, 0x21, 0xd5, 0xac, 0x47, 0x46, 0x2a, 0xb1, 0xdd, 0x0c, 0x86, 0xee, 0x4c, 0xfc, 0x97,
+	0x9e, 0xa7, 0x6e, 0x28, 0xef, 0xde, 0x9f, 0x8a, 0x97, 0x85, 0x79, 0x0c, 0x9e, 0x7c, 0x8f, 0x1e,
+	0xc1, 0x0a, 0x33, 0x38, 0x08, 0x71, 0xc7, 0x7b, 0xa9, 0xec, 0x5e, 0x9c, 0x83, 0x64, 0xb9, 0x87,
+	0x47, 0x7b, 0x1c, 0x25, 0xfb, 0xd6, 0x2f, 0x1a, 0xa0, 0x89, 0x3b, 0x92, 0xf5, 0xad, 0x6b, 0x90,
+	0x8b, 0xf5, 0x2d, 0xd9, 0xb6, 0x60, 0xd2, 0xb6, 0xd0, 0xbf, 0x61, 0x95, 0x7f, 0xc0, 0x03, 0x8c,
+	0x17, 0x25, 0x7a, 0xe8, 0x45, 0x3c, 0xd9, 0x32, 0x56, 0x89, 0x1d, 0xf1, 0xa0, 0x89, 0x5a, 0xa4,
+	0x75, 0xe8, 0x45, 0xe8, 0x3f, 0x70, 0x21, 0xfe, 0x79, 0x27, 0x24, 0x03, 0x01, 0x48, 0x73, 0x00,
+	0x9a, 0x00, 0x76, 0x42, 0x32, 0xe0, 0x90, 0xcb, 0x90, 0x0d, 0x9c, 0x2e, 0xb6, 0x23, 0xef, 0x15,
+	0xe6, 0x0f, 0x50, 0xb0, 0x32, 0x4c, 0xb0, 0xef, 0xbd, 0xc2, 0xe8, 0x2a, 0x00, 0x3f, 0xa4, 0xa4,
+	0x87, 0x7d, 0x63, 0x49, 0x94, 0x03, 0x26, 0x69, 0x31, 0x81, 0xf9, 0x93, 0x16, 0x8f, 0x44, 0xd9,
+	0x52, 0x3f, 0x80, 0x0c, 0x66, 0x32, 0x0f, 0xab, 0x96, 0x7a, 0x73, 0xee, 0xf2, 0x2d, 0xc8, 0xac,
+	0x31, 0x01, 0xfa, 0x08, 0x90, 0x5a, 0xcf, 0xb4, 0xd5, 0x64, 0x91, 0x56, 0x52, 0x2c, 0xaa, 0x01,
+	0xa3, 0xbf, 0xb3, 0xb6, 0xf7, 0x92, 0xda, 0x31, 0xfb, 0x44, 0x09, 0x2b, 0x30, 0xf1, 0xde, 0xd8,
+	0xc6, 0xeb, 0xb0, 0x22, 0x58, 0xb6, 0xc9, 0xd0, 0xa7, 0xd2, 0xc6, 0x32, 0x2c, 0xb4, 0xd9, 0x96,
+	0x3b, 0x35, 0x6d, 0x89, 0x8d, 0xb9, 0x0d, 0xcb, 0xe2, 0xd3, 0x31, 0x9a, 0x0d, 0x06, 0x7d, 0x27,
+	0xa2, 0xb6, 0xe7, 0xb7, 0xfb, 0x43, 0x17, 0xbb, 0x36, 0xd7, 0x43, 0xd5, 0x73, 0xc4, 0xce, 0x9a,
+	0xf2, 0x48, 0x40, 0xcd, 0x1f, 0x16, 0xa0, 0x2c, 0x96, 0x33, 0x73, 0xc1, 0x5c, 0x15, 0x9d, 0x85,
+	0x9d, 0x9c, 0x16, 0xe4, 0x4d, 0x62, 0x58, 0xc8, 0x0b, 0xa1, 0x2c, 0xf2, 0xbf, 0xf7, 0xac, 0xb0,
+	0x0d, 0x4c, 0x62, 0xc7, 0xd2, 0x77, 0x9e, 0x89, 0xa1, 0xe0, 0xe3, 0xe3, 0xbd, 0x49, 0x06, 0x6f,
+	0x01, 0x30, 0x12, 0x99, 0x3a, 0x97, 0x38, 0xc1, 0xe5, 0x13, 0x04, 0x0f, 0x46, 0x14, 0x47, 0xb2,
+	0x62, 0xf9, 0xf8, 0x58, 0xa6, 0x95, 0x07, 0xab, 0xf1, 0x92, 0xcd, 0xf2, 0x2a, 0xc2, 0x94, 0x37,
+	0x96, 0xdc, 0xe6, 0xff, 0xe6, 0x8d, 0xab, 0x78, 0xbd, 0x6e, 0x91, 0x7d, 0x4c, 0xad, 0x15, 0x67,
+	0x56, 0x84, 0x5e, 0x9c, 0xbc, 0xca, 0x71, 0x5d, 0xe3, 0x5a, 0xe2, 0x10, 0x9e, 0xe1, 0xae, 0xb9,
+	0x2e, 0xfa, 0x14, 0x2e, 0xce, 0x72, 0xcb, 0x99, 0xa5, 0x92, 0x98, 0xbe, 0x3c, 0x4d, 0xff, 0x8e,
+	0x87, 0x1c, 0x73, 0x08, 0x97, 0xce, 0x78, 0x34, 0xf4, 0xe2, 0x74, 0x67, 0x68, 0x6f, 0xfb, 0x42,
+	0xfb, 0x98, 0x9a, 0x3f, 0x6b, 0x90, 0x13, 0xe7, 0x0f, 0x59, 0xdb, 0x78, 0xb7, 0xc5, 0xe9, 0x09,
+	0x14, 0x42, 0x42, 0xa8, 0x3d, 0x66, 0x4c, 0x5e, 0x97, 0xf2, 0x8c, 0xa0, 0xa1, 0x08, 0x6b, 0xb0,
+	0x80, 0xdd, 0x2e, 0x56, 0xad, 0xf4, 0x9f, 0xe7, 0x13, 0x71, 0xab, 0x1a, 0x6e, 0x17, 0x5b, 0x02,
+	0x69, 0x7e, 0xa9, 0x41, 0x76, 0x2c, 0x44, 0x5b, 0xa0, 0x53, 0x22, 0x87, 0x81, 0x24, 0x6a, 0xe9,
+	0x94, 0xa0, 0xf7, 0x21, 0xcd, 0xfa, 0x87, 0xa1, 0x27, 0x46, 0x73, 0x9c, 0xf9, 0x63, 0x1a, 0x32,
+	0x16, 0x3e, 0xf2, 0x98, 0xfb, 0xd9, 0x28, 0xe6, 0xb9, 0xea, 0x47, 0x8d, 0xe7, 0x4e, 0x8f, 0x99,
+	0xfa, 0x59, 0x63, 0x66, 0xea, 0x64, 0xa9, 0x4b, 0x4f, 0x4a, 0xdd, 0x53, 0xc8, 0x92, 0x00, 0x87,
+	0xdc, 0xdd, 0xbc, 0xff, 0x17, 0x37, 0x6f, 0x9f, 0xaf, 0xa5, 0x52, 0xe9, 0x89, 0x82, 0x5a, 0x13,
+	0x16, 0xb4, 0x06, 0x19, 0xb1, 0x21, 0x62, 0x18, 0xc8, 0x5a, 0xe3, 0x3d, 0x53, 0x99, 0x7a, 0x03,
+	0x1c, 0x51, 0x67, 0x10, 0xf0, 0x56, 0x98, 0xb2, 0x26, 0x02, 0xf4, 0x0f, 0x58, 0x8e, 0xf0, 0x67,
+	0x43, 0xec, 0xb7, 0xb1, 0xed, 0x0f, 0x07, 0x07, 0x38, 0x34, 0x32, 0xbc, 0x37, 0x14, 0x95, 0x78,
+	0x97, 0x4b, 0xd1, 0x1e, 0x14, 0xa5, 0xa1, 0xf6, 0x01, 0xee, 0x90, 0x50, 0x8c, 0xc9, 0x89, 0x7e,
+	0x77, 0x16, 0x24, 0xc1, 0x03, 0x8e, 0x47, 0xbb, 0xa0, 0x04, 0xb6, 0xd3, 0x61, 0x63, 0x4c, 0x2e,
+	0x29, 0x61, 0x5e, 0xe2, 0x6b, 0x0c, 0x8e, 0x5a, 0x50, 0x10, 0xbd, 0x43, 0x29, 0x98, 0xaf, 0x68,
+	0x6f, 0x92, 0x28, 0x79, 0xc1, 0x22, 0xb5, 0xb4, 0x40, 0xee, 0xa5, 0x92, 0x85, 0x37, 0x23, 0xcd,
+	0x09, 0x12, 0xae, 0xa9, 0xf9, 0x9d, 0x06, 0x48, 0xf9, 0xf3, 0x0f, 0x32, 0x0b, 0xff, 0x8b, 0x07,
+	0x7a, 0x6a, 0x0e, 0x90, 0xee, 0xb9, 0xa6, 0x05, 0xe5, 0xb8, 0xfe, 0xe3, 0x5e, 0x3f, 0x35, 0x94,
+	0x69, 0xbf, 0x39, 0x94, 0xe9, 0xb3, 0x43, 0xd9, 0x17, 0x33, 0x8f, 0x22, 0x47, 0x96, 0x47, 0x90,
+	0x0d, 0xa5, 0x34, 0x9a, 0xbf, 0xb6, 0x2a, 0x22, 0x6b, 0x02, 0x3e, 0x6d, 0x72, 0xd2, 0x4f, 0x9b,
+	0x9c, 0x3e, 0xd7, 0x60, 0x45, 0xe1, 0xc7, 0x52, 0x54, 0x83, 0xab, 0xd3, 0x13, 0xd1, 0x6c, 0xda,
+	0x88, 0x91, 0x6a, 0x2d, 0x3e, 0x1a, 0xed, 0x4f, 0xa7, 0xd0, 0x06, 0x94, 0xa6, 0x29, 0x3c, 0x57,
+	0x6a, 0x50, 0x8c, 0xa3, 0x9a, 0xae, 0xf9, 0x8d, 0x06, 0x45, 0x19, 0x3f, 0xb5, 0xb0, 0x7d, 0xe8,
+	0x1d, 0x61, 0xb4, 0x0d, 0x4b, 0xea, 0x7f, 0x14, 0x2d, 0x69, 0x9e, 0x28, 0xe4, 0x54, 0x1b, 0xd1,
+	0xdf, 0xb2, 0x8d, 0xdc, 0xb8, 0x0b, 0x2b, 0x27, 0x8a, 0x12, 0x02, 0x58, 0xdc, 0xb6, 0x1a, 0xb5,
+	0x56, 0xa3, 0xf4, 0x27, 0xb6, 0x7e, 0xb6, 0x57, 0x67, 0x6b, 0x8d, 0xad, 0xeb, 0x8d, 0xc7, 0x8d,
+	0x56, 0xa3, 0xa4, 0x3f, 0xc8, 0xbe, 0x58, 0x92, 0xdc, 0x07, 0x8b, 0x3c, 0xc4, 0x6e, 0xff, 0x3a,
+	0x00, 0xe2, 0x4a, 0x1f, 0x18, 0x0a, 0x15, 0x00, 0x00,
 }
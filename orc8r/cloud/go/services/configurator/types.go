@@ -15,8 +15,10 @@ package configurator
 
 import (
 	"fmt"
+	"time"
 
 	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	storage2 "magma/orc8r/cloud/go/storage"
 
@@ -96,6 +98,11 @@ type NetworkUpdateCriteria struct {
 
 	// Config values to delete
 	ConfigsToDelete []string
+
+	// If set, the update (or deletion) is only applied if the network's
+	// current version matches. Otherwise it fails with ErrVersionConflict
+	// from magma/orc8r/lib/go/errors.
+	ExpectedVersion *uint64
 }
 
 func (nuc NetworkUpdateCriteria) toProto(serdes serde.Registry) (*storage.NetworkUpdateCriteria, error) {
@@ -115,6 +122,8 @@ func (nuc NetworkUpdateCriteria) toProto(serdes serde.Registry) (*storage.Networ
 
 		ConfigsToAddOrUpdate: bConfigs,
 		ConfigsToDelete:      nuc.ConfigsToDelete,
+
+		ExpectedVersion: uint64PtrToWrapper(nuc.ExpectedVersion),
 	}
 	return ret, nil
 }
@@ -436,6 +445,10 @@ type EntityLoadFilter struct {
 	// If PhysicalID is provided, the query will return all entities matching
 	// the provided ID value.
 	PhysicalID *string
+
+	// If KeyPrefixFilter is provided, the query will return all entities
+	// whose key starts with the given prefix.
+	KeyPrefixFilter *string
 }
 
 // EntityLoadCriteria specifies how much of an entity to load
@@ -491,12 +504,181 @@ type EntityLoadResult struct {
 	NextPageToken string
 }
 
+// Revision records a single mutation of a network or of a network entity.
+type Revision struct {
+	ID        string
+	NetworkID string
+
+	// (Type, Key) of the mutated entity. Both are empty for mutations of the
+	// network itself.
+	Type string
+	Key  string
+
+	Operation storage.RevisionOperation
+
+	// Operator on whose behalf the mutation was made. Empty if the mutation
+	// wasn't attributed to an operator.
+	Operator  string
+	Timestamp time.Time
+
+	// State of the network or entity before and after the mutation. Before
+	// states are nil for creations, and after states for deletions.
+	NetworkBefore *Network
+	NetworkAfter  *Network
+	EntityBefore  *NetworkEntity
+	EntityAfter   *NetworkEntity
+}
+
+// IsNetworkRevision returns true iff the revision mutated the network itself
+// rather than one of its entities.
+func (r Revision) IsNetworkRevision() bool {
+	return r.Type == ""
+}
+
+func (r Revision) fromProto(p *storage.Revision, serdes serde.Registry) (Revision, error) {
+	r = Revision{
+		ID:        p.Id,
+		NetworkID: p.NetworkID,
+		Type:      p.Type,
+		Key:       p.Key,
+		Operation: p.Operation,
+		Operator:  p.Operator,
+		Timestamp: time.Unix(0, p.Timestamp*int64(time.Millisecond)),
+	}
+	var err error
+	if r.NetworkBefore, err = revisionNetworkFromProto(p.NetworkBefore, serdes); err != nil {
+		return r, err
+	}
+	if r.NetworkAfter, err = revisionNetworkFromProto(p.NetworkAfter, serdes); err != nil {
+		return r, err
+	}
+	if r.EntityBefore, err = revisionEntityFromProto(p.EntityBefore, serdes); err != nil {
+		return r, err
+	}
+	if r.EntityAfter, err = revisionEntityFromProto(p.EntityAfter, serdes); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+// revisionNetworkFromProto leaves configs of types without a serde
+// serialized, rather than dropping them.
+func revisionNetworkFromProto(p *storage.Network, serdes serde.Registry) (*Network, error) {
+	if p == nil {
+		return nil, nil
+	}
+	network, err := (Network{}).FromProto(p, serdes)
+	if err != nil {
+		return nil, err
+	}
+	for typ, config := range p.Configs {
+		if _, ok := network.Configs[typ]; !ok {
+			network.Configs[typ] = config
+		}
+	}
+	return &network, nil
+}
+
+// revisionEntityFromProto leaves configs of types without a serde serialized.
+func revisionEntityFromProto(p *storage.NetworkEntity, serdes serde.Registry) (*NetworkEntity, error) {
+	if p == nil {
+		return nil, nil
+	}
+	ent, err := (NetworkEntity{}).fromProtoWithDefault(p, serdes)
+	if err != nil {
+		return nil, err
+	}
+	return &ent, nil
+}
+
+// RevisionLoadFilter specifies which revisions of a network to load
+type RevisionLoadFilter struct {
+	// If Type and Key are provided, only revisions of the matching entities
+	// are loaded. Set both to empty strings to only load revisions of the
+	// network itself.
+	Type *string
+	Key  *string
+}
+
+func (rlf RevisionLoadFilter) toProto() *storage.RevisionLoadFilter {
+	return &storage.RevisionLoadFilter{
+		TypeFilter: strPtrToWrapper(rlf.Type),
+		KeyFilter:  strPtrToWrapper(rlf.Key),
+	}
+}
+
+// RevisionLoadCriteria specifies the page of revisions to load
+type RevisionLoadCriteria struct {
+	// PageSize is the maximum number of revisions returned per load.
+	PageSize uint32
+	// PageToken is an opaque token provided to load the next page of
+	// revisions.
+	PageToken string
+}
+
+func (rlc RevisionLoadCriteria) toProto() *storage.RevisionLoadCriteria {
+	return &storage.RevisionLoadCriteria{
+		PageSize:  rlc.PageSize,
+		PageToken: rlc.PageToken,
+	}
+}
+
 // EntityWriteOperation is an interface around entity creation/update for the
 // generic multi-operation configurator endpoint.
 type EntityWriteOperation interface {
 	isEntityWriteOperation()
 }
 
+func entityWritesToProto(writes []EntityWriteOperation, serdes serde.Registry) ([]*protos.WriteEntityRequest, error) {
+	var ret []*protos.WriteEntityRequest
+	for _, write := range writes {
+		switch op := write.(type) {
+		case NetworkEntity:
+			protoEnt, err := op.toProto(serdes)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Create{Create: protoEnt}})
+		case EntityUpdateCriteria:
+			protoEuc, err := op.toProto(serdes)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Update{Update: protoEuc}})
+		default:
+			return nil, errors.Errorf("unrecognized entity write operation %T", op)
+		}
+	}
+	return ret, nil
+}
+
+// MconfigPreview holds the configuration changes to preview a gateway's
+// mconfig against.
+type MconfigPreview struct {
+	// NetworkUpdates are applied to the gateway's network. An empty ID
+	// defaults to the gateway's network.
+	NetworkUpdates []NetworkUpdateCriteria
+	// EntityWrites are applied to the gateway's network, in order
+	EntityWrites []EntityWriteOperation
+}
+
+func (p MconfigPreview) toProto(networkSerdes, entitySerdes serde.Registry) (*protos.MconfigPreview, error) {
+	ret := &protos.MconfigPreview{}
+	for _, update := range p.NetworkUpdates {
+		protoUpdate, err := update.toProto(networkSerdes)
+		if err != nil {
+			return nil, err
+		}
+		ret.NetworkUpdates = append(ret.NetworkUpdates, protoUpdate)
+	}
+	writes, err := entityWritesToProto(p.EntityWrites, entitySerdes)
+	if err != nil {
+		return nil, err
+	}
+	ret.EntityWrites = writes
+	return ret, nil
+}
+
 // EntityUpdateCriteria specifies a patch operation on a network entity.
 type EntityUpdateCriteria struct {
 	// (Type, Key) of the entity to update
@@ -524,6 +706,11 @@ type EntityUpdateCriteria struct {
 	AssociationsToSet    []storage2.TypeAndKey
 	AssociationsToAdd    []storage2.TypeAndKey
 	AssociationsToDelete []storage2.TypeAndKey
+
+	// If set, the update (or deletion) is only applied if the entity's
+	// current version matches. Otherwise it fails with ErrVersionConflict
+	// from magma/orc8r/lib/go/errors.
+	ExpectedVersion *uint64
 }
 
 func (euc EntityUpdateCriteria) toProto(serdes serde.Registry) (*storage.EntityUpdateCriteria, error) {
@@ -536,6 +723,7 @@ func (euc EntityUpdateCriteria) toProto(serdes serde.Registry) (*storage.EntityU
 		NewPhysicalID:        strPtrToWrapper(euc.NewPhysicalID),
 		AssociationsToAdd:    tksToEntIDs(euc.AssociationsToAdd),
 		AssociationsToDelete: tksToEntIDs(euc.AssociationsToDelete),
+		ExpectedVersion:      uint64PtrToWrapper(euc.ExpectedVersion),
 	}
 
 	if euc.AssociationsToSet != nil {
@@ -609,6 +797,13 @@ func strPtrToWrapper(in *string) *wrappers.StringValue {
 	return &wrappers.StringValue{Value: *in}
 }
 
+func uint64PtrToWrapper(in *uint64) *wrappers.UInt64Value {
+	if in == nil {
+		return nil
+	}
+	return &wrappers.UInt64Value{Value: *in}
+}
+
 func tksToEntIDs(tks []storage2.TypeAndKey) []*storage.EntityID {
 	if funk.IsEmpty(tks) {
 		return nil
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctraced
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
+	"magma/orc8r/lib/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+const (
+	defaultTraceSchedulerInterval = 30 * time.Second
+	defaultStorageGCInterval      = time.Hour
+
+	// StorageBackendBlobstore stores call trace files in the SQL blobstore
+	StorageBackendBlobstore = "blobstore"
+	// StorageBackendFilesystem stores call trace files in a local directory
+	StorageBackendFilesystem = "filesystem"
+	// StorageBackendS3 stores call trace files in an S3-compatible object store
+	StorageBackendS3 = "s3"
+)
+
+type Config struct {
+	// TraceSchedulerIntervalSecs is the interval at which scheduled call
+	// traces are checked for their start trigger, and running call traces
+	// for their end condition
+	TraceSchedulerIntervalSecs uint `yaml:"traceSchedulerIntervalSecs"`
+
+	Storage StorageConfig `yaml:"storage"`
+}
+
+type StorageConfig struct {
+	// Backend is where call trace files are stored, one of blobstore,
+	// filesystem or s3. Defaults to blobstore.
+	Backend string `yaml:"backend"`
+	// RetentionHours is how long call trace files are kept before they're
+	// garbage collected. 0 keeps them until their call trace is deleted.
+	// Not supported by the blobstore backend.
+	RetentionHours uint `yaml:"retentionHours"`
+	// GCIntervalSecs is the interval at which expired call trace files are
+	// garbage collected
+	GCIntervalSecs uint `yaml:"gcIntervalSecs"`
+
+	Filesystem FilesystemStorageConfig `yaml:"filesystem"`
+	S3         objectstore.S3Config    `yaml:"s3"`
+}
+
+type FilesystemStorageConfig struct {
+	// Directory is where call trace files are stored
+	Directory string `yaml:"directory"`
+}
+
+func GetServiceConfig() Config {
+	var serviceConfig Config
+	_, _, err := config.GetStructuredServiceConfig(orc8r.ModuleName, ServiceName, &serviceConfig)
+	if err != nil {
+		glog.Fatalf("Failed parsing the ctraced config file: %v ", err)
+	}
+	return serviceConfig
+}
+
+// GetTraceSchedulerInterval returns the configured trace scheduler interval,
+// or a default if it isn't set.
+func (c Config) GetTraceSchedulerInterval() time.Duration {
+	if c.TraceSchedulerIntervalSecs == 0 {
+		return defaultTraceSchedulerInterval
+	}
+	return time.Duration(c.TraceSchedulerIntervalSecs) * time.Second
+}
+
+// GetRetention returns how long call trace files are kept, or 0 if they're
+// kept indefinitely.
+func (c StorageConfig) GetRetention() time.Duration {
+	return time.Duration(c.RetentionHours) * time.Hour
+}
+
+// GetGCInterval returns the configured garbage collection interval, or a
+// default if it isn't set.
+func (c StorageConfig) GetGCInterval() time.Duration {
+	if c.GCIntervalSecs == 0 {
+		return defaultStorageGCInterval
+	}
+	return time.Duration(c.GCIntervalSecs) * time.Second
+}
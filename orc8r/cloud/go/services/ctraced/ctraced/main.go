@@ -14,6 +14,8 @@ limitations under the License.
 package main
 
 import (
+	"fmt"
+
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/swagger"
@@ -22,13 +24,16 @@ import (
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/ctraced"
 	"magma/orc8r/cloud/go/services/ctraced/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/ctraced/scheduler"
 	"magma/orc8r/cloud/go/services/ctraced/servicers"
 	ctraced_storage "magma/orc8r/cloud/go/services/ctraced/storage"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
 	"magma/orc8r/lib/go/protos"
 
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 )
 
 func main() {
@@ -38,24 +43,32 @@ func main() {
 		glog.Fatalf("Error creating ctraced service: %+v", err)
 	}
 
+	serviceConfig := ctraced.GetServiceConfig()
+
 	// Init storage
-	db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+	ctracedStorage, err := getCtracedStorage(serviceConfig.Storage)
 	if err != nil {
-		glog.Fatalf("Error opening db connection: %+v", err)
+		glog.Fatalf("Error initializing ctraced storage: %+v", err)
 	}
-	fact := blobstore.NewSQLBlobStorageFactory(ctraced.LookupTableBlobstore, db, sqorc.GetSqlBuilder())
-	err = fact.InitializeFactory()
-	if err != nil {
-		glog.Fatalf("Error initializing ctraced table: %+v", err)
+	if retention := serviceConfig.Storage.GetRetention(); retention > 0 {
+		expiringStorage, ok := ctracedStorage.(ctraced_storage.ExpiringCtracedStorage)
+		if ok {
+			go ctraced_storage.RunGarbageCollector(expiringStorage, retention, serviceConfig.Storage.GetGCInterval())
+		} else {
+			glog.Warningf("Call trace retention is not supported by the %s storage backend", serviceConfig.Storage.Backend)
+		}
 	}
-	ctracedBlobstore := ctraced_storage.NewCtracedBlobstore(fact)
 
 	// Init gRPC servicer
-	protos.RegisterCallTraceControllerServer(srv.GrpcServer, servicers.NewCallTraceServicer(ctracedBlobstore))
+	protos.RegisterCallTraceControllerServer(srv.GrpcServer, servicers.NewCallTraceServicer(ctracedStorage))
 	swagger_protos.RegisterSwaggerSpecServer(srv.GrpcServer, swagger.NewSpecServicerFromFile(ctraced.ServiceName))
 
 	gwClient := handlers.NewGwCtracedClient()
-	obsidian.AttachHandlers(srv.EchoServer, handlers.GetObsidianHandlers(gwClient, ctracedBlobstore))
+	obsidian.AttachHandlers(srv.EchoServer, handlers.GetObsidianHandlers(gwClient, ctracedStorage))
+
+	// Start and end scheduled call traces
+	traceScheduler := scheduler.NewTraceScheduler(gwClient, ctracedStorage)
+	go traceScheduler.Run(serviceConfig.GetTraceSchedulerInterval())
 
 	// Run service
 	err = srv.Run()
@@ -63,3 +76,33 @@ func main() {
 		glog.Fatalf("Error running ctraced service: %+v", err)
 	}
 }
+
+func getCtracedStorage(config ctraced.StorageConfig) (ctraced_storage.CtracedStorage, error) {
+	switch config.Backend {
+	case "", ctraced.StorageBackendBlobstore:
+		db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open db connection")
+		}
+		fact := blobstore.NewSQLBlobStorageFactory(ctraced.LookupTableBlobstore, db, sqorc.GetSqlBuilder())
+		err = fact.InitializeFactory()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize ctraced table")
+		}
+		return ctraced_storage.NewCtracedBlobstore(fact), nil
+	case ctraced.StorageBackendFilesystem:
+		store, err := objectstore.NewFilesystemStore(config.Filesystem.Directory)
+		if err != nil {
+			return nil, err
+		}
+		return ctraced_storage.NewCtracedObjectStore(store), nil
+	case ctraced.StorageBackendS3:
+		store, err := objectstore.NewS3Store(config.S3)
+		if err != nil {
+			return nil, err
+		}
+		return ctraced_storage.NewCtracedObjectStore(store), nil
+	default:
+		return nil, fmt.Errorf("unknown ctraced storage backend %q", config.Backend)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package decoder parses call trace captures into a per-message ladder
+// of the control plane signalling they contain.
+package decoder
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+)
+
+// Protocols recognized by the decoder
+const (
+	ProtocolS1AP     = "S1AP"
+	ProtocolNAS      = "NAS"
+	ProtocolGTPv2    = "GTPv2"
+	ProtocolDiameter = "Diameter"
+)
+
+// Message is the summary of a single control plane message in a call trace.
+type Message struct {
+	// Frame is the 1-based number of the packet in the capture which
+	// carried the message
+	Frame       int
+	Timestamp   time.Time
+	Source      string
+	Destination string
+	// Protocol is the outermost decoded protocol, one of the Protocol*
+	// constants other than ProtocolNAS
+	Protocol    string
+	MessageType string
+	// NASMessageType is the NAS message carried in an S1AP message, if any
+	NASMessageType string
+	// IMSI is read off the message itself when present, otherwise it is
+	// correlated from other messages of the same UE context or session
+	IMSI string
+	// Result is the GTPv2 cause or Diameter result code, if any
+	Result string
+}
+
+// Filter selects messages out of a decoded trace. Empty fields match all
+// messages.
+type Filter struct {
+	IMSI string
+	// Protocol is matched case-insensitively. ProtocolNAS matches any
+	// message which carries a NAS message.
+	Protocol string
+	// MessageType is matched against both the message type and the NAS
+	// message type, ignoring case, spaces, dashes and underscores
+	MessageType string
+}
+
+// decoded is the per-protocol decoding result of a single message.
+type decoded struct {
+	protocol       string
+	messageType    string
+	nasMessageType string
+	imsi           string
+	result         string
+	// correlationKeys identify the UE context or session the message
+	// belongs to, e.g. S1AP UE IDs, GTP-C TEIDs or Diameter session IDs
+	correlationKeys []string
+}
+
+// Decode parses a pcap or pcapng capture and returns every S1AP, GTPv2-C and
+// Diameter message in it, in capture order. Packets of other protocols and
+// messages which fail to decode are skipped. An error is only returned if
+// the capture file itself can't be read.
+func Decode(capture []byte) ([]*Message, error) {
+	return DecodeReader(bytes.NewReader(capture))
+}
+
+// DecodeReader is Decode for a capture read from r. The capture is read one
+// frame at a time, so only the decoded messages are held in memory.
+func DecodeReader(r io.Reader) ([]*Message, error) {
+	reader, err := newCaptureReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		messages []*Message
+		keys     [][]string
+	)
+	for i := 0; ; i++ {
+		pkt, err := reader.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Frames read before a truncated or malformed frame are kept
+			if i == 0 {
+				return nil, err
+			}
+			break
+		}
+		for _, seg := range extractSegments(pkt) {
+			for _, d := range decodeSegment(seg) {
+				messages = append(messages, &Message{
+					Frame:          i + 1,
+					Timestamp:      pkt.timestamp,
+					Source:         seg.source(),
+					Destination:    seg.destination(),
+					Protocol:       d.protocol,
+					MessageType:    d.messageType,
+					NASMessageType: d.nasMessageType,
+					IMSI:           d.imsi,
+					Result:         d.result,
+				})
+				keys = append(keys, d.correlationKeys)
+			}
+		}
+	}
+	correlateIMSIs(messages, keys)
+	return messages, nil
+}
+
+// FilterMessages returns the messages which match the filter.
+func FilterMessages(messages []*Message, filter Filter) []*Message {
+	ret := []*Message{}
+	for _, msg := range messages {
+		if filter.Matches(msg) {
+			ret = append(ret, msg)
+		}
+	}
+	return ret
+}
+
+// Matches returns true if the message satisfies every set field of the filter.
+func (f Filter) Matches(msg *Message) bool {
+	if f.IMSI != "" && strings.TrimPrefix(f.IMSI, "IMSI") != msg.IMSI {
+		return false
+	}
+	if f.Protocol != "" {
+		isNAS := strings.EqualFold(f.Protocol, ProtocolNAS) && msg.NASMessageType != ""
+		if !isNAS && !strings.EqualFold(f.Protocol, msg.Protocol) {
+			return false
+		}
+	}
+	if f.MessageType != "" {
+		want := normalizeMessageType(f.MessageType)
+		if want != normalizeMessageType(msg.MessageType) && want != normalizeMessageType(msg.NASMessageType) {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeSegment(seg segment) []*decoded {
+	switch {
+	case isS1AP(seg):
+		d, err := decodeS1AP(seg)
+		if err != nil {
+			return nil
+		}
+		return []*decoded{d}
+	case isDiameter(seg):
+		// Keep whatever was decoded before a malformed message
+		ds, _ := decodeDiameter(seg)
+		return ds
+	case isGTPv2(seg):
+		d, err := decodeGTPv2(seg)
+		if err != nil {
+			return nil
+		}
+		return []*decoded{d}
+	}
+	return nil
+}
+
+// correlateIMSIs fills in the IMSI of messages which don't carry one
+// themselves but share a correlation key with a message that does.
+// A forward pass attributes messages to the most recently seen IMSI for a
+// key, which handles IDs being reused over the course of a trace. A backward
+// pass then fills in messages preceding the first IMSI of their context,
+// e.g. a GUTI attach before the identity response.
+func correlateIMSIs(messages []*Message, keys [][]string) {
+	correlate := func(indices []int) {
+		imsiByKey := map[string]string{}
+		for _, i := range indices {
+			msg := messages[i]
+			if msg.IMSI == "" {
+				for _, key := range keys[i] {
+					if imsi, ok := imsiByKey[key]; ok {
+						msg.IMSI = imsi
+						break
+					}
+				}
+			}
+			if msg.IMSI == "" {
+				continue
+			}
+			for _, key := range keys[i] {
+				imsiByKey[key] = msg.IMSI
+			}
+		}
+	}
+
+	forward := make([]int, len(messages))
+	backward := make([]int, len(messages))
+	for i := range messages {
+		forward[i] = i
+		backward[i] = len(messages) - 1 - i
+	}
+	correlate(forward)
+	correlate(backward)
+}
+
+// peerKey identifies the pair of endpoints a segment was exchanged between,
+// independent of direction.
+func peerKey(seg segment) string {
+	a, b := seg.source(), seg.destination()
+	if a > b {
+		a, b = b, a
+	}
+	return a + "-" + b
+}
+
+func normalizeMessageType(messageType string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_':
+			return -1
+		}
+		return r
+	}, strings.ToLower(messageType))
+}
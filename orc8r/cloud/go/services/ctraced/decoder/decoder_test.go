@@ -0,0 +1,152 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder_test
+
+import (
+	"bytes"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"magma/orc8r/cloud/go/services/ctraced/decoder"
+	"magma/orc8r/cloud/go/services/ctraced/decoder/test_utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testIMSI = "001010123456789"
+
+func TestDecode_Pcap(t *testing.T) {
+	capture := test_utils.NewPcap(test_utils.LinkTypeLinuxSLL, getTestFrames(test_utils.LinkTypeLinuxSLL))
+	msgs, err := decoder.Decode(capture)
+	assert.NoError(t, err)
+	assertTestLadder(t, msgs)
+}
+
+func TestDecode_Pcapng(t *testing.T) {
+	capture := test_utils.NewPcapng(test_utils.LinkTypeEthernet, getTestFrames(test_utils.LinkTypeEthernet))
+	msgs, err := decoder.Decode(capture)
+	assert.NoError(t, err)
+	assertTestLadder(t, msgs)
+}
+
+func TestDecodeReader(t *testing.T) {
+	// Frames are read as the capture is streamed in
+	capture := test_utils.NewPcapng(test_utils.LinkTypeEthernet, getTestFrames(test_utils.LinkTypeEthernet))
+	msgs, err := decoder.DecodeReader(iotest.OneByteReader(bytes.NewReader(capture)))
+	assert.NoError(t, err)
+	assertTestLadder(t, msgs)
+
+	// Frames before a truncated frame are kept
+	capture = test_utils.NewPcap(test_utils.LinkTypeLinuxSLL, getTestFrames(test_utils.LinkTypeLinuxSLL))
+	msgs, err = decoder.DecodeReader(bytes.NewReader(capture[:len(capture)-1]))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, msgs)
+	assert.Less(t, len(msgs), 7)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	_, err := decoder.Decode([]byte("abcdefghijklmnopqrstuvwxyz\n"))
+	assert.EqualError(t, err, "unrecognized capture file magic 0x61626364")
+
+	_, err = decoder.Decode(nil)
+	assert.EqualError(t, err, "capture file is too short")
+
+	// Unrelated traffic is skipped
+	frame := test_utils.UDPFrame(test_utils.LinkTypeEthernet, 53, 53, []byte{1, 2, 3})
+	msgs, err := decoder.Decode(test_utils.NewPcap(test_utils.LinkTypeEthernet, [][]byte{frame}))
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestFilterMessages(t *testing.T) {
+	capture := test_utils.NewPcap(test_utils.LinkTypeLinuxSLL, getTestFrames(test_utils.LinkTypeLinuxSLL))
+	msgs, err := decoder.Decode(capture)
+	assert.NoError(t, err)
+
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{}), 7)
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{IMSI: testIMSI}), 6)
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{IMSI: "IMSI" + testIMSI}), 6)
+	assert.Empty(t, decoder.FilterMessages(msgs, decoder.Filter{IMSI: "001010000000000"}))
+
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{Protocol: "s1ap"}), 2)
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{Protocol: decoder.ProtocolNAS}), 2)
+	assert.Len(t, decoder.FilterMessages(msgs, decoder.Filter{Protocol: decoder.ProtocolDiameter}), 3)
+
+	filtered := decoder.FilterMessages(msgs, decoder.Filter{MessageType: "create_session_response"})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "Create Session Response", filtered[0].MessageType)
+
+	filtered = decoder.FilterMessages(msgs, decoder.Filter{IMSI: testIMSI, MessageType: "attach request"})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "InitialUEMessage", filtered[0].MessageType)
+}
+
+func getTestFrames(linkType uint32) [][]byte {
+	initialUE := test_utils.S1APMessage(0, 12, []test_utils.S1APIE{
+		{ID: 8, Value: []byte{0x40, 0x00, 0x01}},
+		{ID: 26, Value: test_utils.NASPDU(test_utils.AttachRequest(testIMSI))},
+	})
+	downlinkNAS := test_utils.S1APMessage(0, 11, []test_utils.S1APIE{
+		{ID: 0, Value: []byte{0x00, 0x07}},
+		{ID: 8, Value: []byte{0x40, 0x00, 0x01}},
+		{ID: 26, Value: test_utils.NASPDU([]byte{0x07, 0x52, 0x00})},
+	})
+	csReq := test_utils.GTPv2Message(32, 0, []test_utils.GTPv2IE{
+		{Type: 1, Value: test_utils.TBCD(testIMSI)},
+		{Type: 87, Value: []byte{0x8a, 0x00, 0x00, 0x11, 0x11, 10, 0, 0, 1}},
+	})
+	csResp := test_utils.GTPv2Message(33, 0x1111, []test_utils.GTPv2IE{
+		{Type: 2, Value: []byte{16, 0}},
+		{Type: 87, Value: []byte{0x8b, 0x00, 0x00, 0x22, 0x22, 10, 0, 0, 2}},
+	})
+	ulr := test_utils.DiameterMessage(316, true, []test_utils.DiameterAVP{
+		{Code: 263, Data: []byte("mme;1;ulr")},
+		{Code: 1, Data: []byte(testIMSI)},
+	})
+	ula := test_utils.DiameterMessage(316, false, []test_utils.DiameterAVP{
+		{Code: 263, Data: []byte("mme;1;ulr")},
+		{Code: 268, Data: []byte{0, 0, 0x07, 0xd1}},
+	})
+	cer := test_utils.DiameterMessage(257, true, nil)
+
+	return [][]byte{
+		test_utils.SCTPFrame(linkType, 36412, 36412, 18, initialUE),
+		test_utils.SCTPFrame(linkType, 36412, 36412, 18, downlinkNAS),
+		test_utils.UDPFrame(linkType, 2123, 2123, csReq),
+		test_utils.UDPFrame(linkType, 2123, 2123, csResp),
+		test_utils.SCTPFrame(linkType, 3868, 3868, 46, cer),
+		test_utils.TCPFrame(linkType, 40000, 3868, append(ulr, ula...)),
+	}
+}
+
+func assertTestLadder(t *testing.T, msgs []*decoder.Message) {
+	expected := []decoder.Message{
+		{Frame: 1, Protocol: "S1AP", MessageType: "InitialUEMessage", NASMessageType: "Attach request", IMSI: testIMSI},
+		{Frame: 2, Protocol: "S1AP", MessageType: "DownlinkNASTransport", NASMessageType: "Authentication request", IMSI: testIMSI},
+		{Frame: 3, Protocol: "GTPv2", MessageType: "Create Session Request", IMSI: testIMSI},
+		{Frame: 4, Protocol: "GTPv2", MessageType: "Create Session Response", IMSI: testIMSI, Result: "Cause 16"},
+		{Frame: 5, Protocol: "Diameter", MessageType: "Capabilities-Exchange-Request"},
+		{Frame: 6, Protocol: "Diameter", MessageType: "Update-Location-Request", IMSI: testIMSI},
+		{Frame: 6, Protocol: "Diameter", MessageType: "Update-Location-Answer", IMSI: testIMSI, Result: "Result-Code 2001"},
+	}
+	assert.Len(t, msgs, len(expected))
+	for i, msg := range msgs {
+		assert.Equal(t, time.Unix(int64(1600000000+msg.Frame), 0).UTC(), msg.Timestamp)
+		assert.Equal(t, "192.168.60.142:", msg.Source[:len("192.168.60.142:")])
+		msg.Timestamp = time.Time{}
+		msg.Source, msg.Destination = "", ""
+		assert.Equal(t, expected[i], *msg)
+	}
+}
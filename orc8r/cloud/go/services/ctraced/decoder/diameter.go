@@ -0,0 +1,204 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	diameterPort     = 3868
+	diameterSCTPPPID = 46
+
+	diameterHeaderLen     = 20
+	diameterVersion       = 1
+	diameterFlagRequest   = 0x80
+	diameterAVPFlagVendor = 0x80
+
+	avpUserName               = 1
+	avpSessionID              = 263
+	avpResultCode             = 268
+	avpExperimentalResult     = 297
+	avpExperimentalResultCode = 298
+	avpSubscriptionID         = 443
+	avpSubscriptionIDData     = 444
+	avpSubscriptionIDType     = 450
+
+	subscriptionIDTypeIMSI = 1
+)
+
+// diameterCommands maps a command code to the name of its request and
+// answer, e.g. "Update-Location" becomes Update-Location-Request/Answer.
+var diameterCommands = map[uint32]string{
+	257:     "Capabilities-Exchange",
+	258:     "Re-Auth",
+	265:     "AA",
+	271:     "Accounting",
+	272:     "Credit-Control",
+	274:     "Abort-Session",
+	275:     "Session-Termination",
+	280:     "Device-Watchdog",
+	282:     "Disconnect-Peer",
+	301:     "Server-Assignment",
+	303:     "Multimedia-Auth",
+	304:     "Registration-Termination",
+	305:     "Push-Profile",
+	316:     "Update-Location",
+	317:     "Cancel-Location",
+	318:     "Authentication-Information",
+	319:     "Insert-Subscriber-Data",
+	320:     "Delete-Subscriber-Data",
+	321:     "Purge-UE",
+	322:     "Reset",
+	323:     "Notify",
+	8388620: "Spending-Limit",
+	8388635: "Spending-Status-Notification",
+}
+
+func isDiameter(seg segment) bool {
+	switch seg.transport {
+	case transportSCTP:
+		return seg.sctpPPID == diameterSCTPPPID || seg.hasPort(diameterPort)
+	case transportTCP:
+		return seg.hasPort(diameterPort)
+	}
+	return false
+}
+
+// decodeDiameter decodes every complete Diameter message in a segment.
+// TCP segments may carry more than one message back to back.
+func decodeDiameter(seg segment) ([]*decoded, error) {
+	var ret []*decoded
+	payload := seg.payload
+	for len(payload) >= diameterHeaderLen {
+		if payload[0] != diameterVersion {
+			return ret, fmt.Errorf("unsupported Diameter version %d", payload[0])
+		}
+		length := int(uint32(payload[1])<<16 | uint32(payload[2])<<8 | uint32(payload[3]))
+		if length < diameterHeaderLen || length > len(payload) {
+			return ret, errors.New("Diameter message is truncated")
+		}
+		ret = append(ret, decodeDiameterMessage(payload[:length]))
+		payload = payload[length:]
+	}
+	if len(ret) == 0 {
+		return nil, errors.New("Diameter header is truncated")
+	}
+	return ret, nil
+}
+
+func decodeDiameterMessage(msg []byte) *decoded {
+	isRequest := msg[4]&diameterFlagRequest != 0
+	commandCode := uint32(msg[5])<<16 | uint32(msg[6])<<8 | uint32(msg[7])
+	ret := &decoded{
+		protocol:    ProtocolDiameter,
+		messageType: diameterMessageName(commandCode, isRequest),
+	}
+
+	for _, avp := range decodeAVPs(msg[diameterHeaderLen:]) {
+		switch avp.code {
+		case avpSessionID:
+			ret.correlationKeys = append(ret.correlationKeys, "diameter-session:"+string(avp.data))
+		case avpUserName:
+			// S6a uses the IMSI as user name, SWx uses an NAI whose user
+			// part is the IMSI prefixed with an identity type digit
+			userName := string(avp.data)
+			if imsi := validIMSI(userName); imsi != "" {
+				ret.imsi = imsi
+			} else if at := strings.IndexByte(userName, '@'); at > 1 {
+				ret.imsi = validIMSI(userName[1:at])
+			}
+		case avpSubscriptionID:
+			if imsi := decodeSubscriptionIDIMSI(avp.data); imsi != "" {
+				ret.imsi = imsi
+			}
+		case avpResultCode:
+			if len(avp.data) == 4 {
+				ret.result = fmt.Sprintf("Result-Code %d", binary.BigEndian.Uint32(avp.data))
+			}
+		case avpExperimentalResult:
+			for _, inner := range decodeAVPs(avp.data) {
+				if inner.code == avpExperimentalResultCode && len(inner.data) == 4 {
+					ret.result = fmt.Sprintf("Experimental-Result-Code %d", binary.BigEndian.Uint32(inner.data))
+				}
+			}
+		}
+	}
+	return ret
+}
+
+func diameterMessageName(commandCode uint32, isRequest bool) string {
+	suffix := "Answer"
+	if isRequest {
+		suffix = "Request"
+	}
+	if name, ok := diameterCommands[commandCode]; ok {
+		return name + "-" + suffix
+	}
+	return fmt.Sprintf("Unknown Diameter command %d %s", commandCode, suffix)
+}
+
+func decodeSubscriptionIDIMSI(grouped []byte) string {
+	var (
+		idType uint32
+		data   string
+	)
+	for _, avp := range decodeAVPs(grouped) {
+		switch avp.code {
+		case avpSubscriptionIDType:
+			if len(avp.data) == 4 {
+				idType = binary.BigEndian.Uint32(avp.data)
+			}
+		case avpSubscriptionIDData:
+			data = string(avp.data)
+		}
+	}
+	if idType != subscriptionIDTypeIMSI {
+		return ""
+	}
+	return validIMSI(data)
+}
+
+type diameterAVP struct {
+	code uint32
+	data []byte
+}
+
+// decodeAVPs decodes a sequence of AVPs, stopping at the first malformed one.
+func decodeAVPs(buf []byte) []diameterAVP {
+	var ret []diameterAVP
+	for len(buf) >= 8 {
+		code := binary.BigEndian.Uint32(buf)
+		flags := buf[4]
+		length := int(uint32(buf[5])<<16 | uint32(buf[6])<<8 | uint32(buf[7]))
+		headerLen := 8
+		if flags&diameterAVPFlagVendor != 0 {
+			headerLen = 12
+		}
+		if length < headerLen || length > len(buf) {
+			break
+		}
+		ret = append(ret, diameterAVP{code: code, data: buf[headerLen:length]})
+		next := pad4(length)
+		if next > len(buf) {
+			break
+		}
+		buf = buf[next:]
+	}
+	return ret
+}
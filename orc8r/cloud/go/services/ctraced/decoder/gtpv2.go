@@ -0,0 +1,150 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	gtpcPort = 2123
+
+	gtpv2Version = 2
+
+	gtpv2IEIMSI  = 1
+	gtpv2IECause = 2
+	gtpv2IEFTEID = 87
+)
+
+// gtpv2MessageTypes are the GTPv2-C messages from 3GPP TS 29.274 table 6.1-1.
+var gtpv2MessageTypes = map[byte]string{
+	1:   "Echo Request",
+	2:   "Echo Response",
+	3:   "Version Not Supported Indication",
+	32:  "Create Session Request",
+	33:  "Create Session Response",
+	34:  "Modify Bearer Request",
+	35:  "Modify Bearer Response",
+	36:  "Delete Session Request",
+	37:  "Delete Session Response",
+	38:  "Change Notification Request",
+	39:  "Change Notification Response",
+	64:  "Modify Bearer Command",
+	65:  "Modify Bearer Failure Indication",
+	66:  "Delete Bearer Command",
+	67:  "Delete Bearer Failure Indication",
+	68:  "Bearer Resource Command",
+	69:  "Bearer Resource Failure Indication",
+	70:  "Downlink Data Notification Failure Indication",
+	95:  "Create Bearer Request",
+	96:  "Create Bearer Response",
+	97:  "Update Bearer Request",
+	98:  "Update Bearer Response",
+	99:  "Delete Bearer Request",
+	100: "Delete Bearer Response",
+	170: "Release Access Bearers Request",
+	171: "Release Access Bearers Response",
+	176: "Downlink Data Notification",
+	177: "Downlink Data Notification Acknowledge",
+}
+
+func isGTPv2(seg segment) bool {
+	return seg.transport == transportUDP && seg.hasPort(gtpcPort) &&
+		len(seg.payload) > 0 && seg.payload[0]>>5 == gtpv2Version
+}
+
+// decodeGTPv2 decodes a GTPv2-C message header and the top-level IMSI,
+// Cause and F-TEID IEs. Piggybacked messages are ignored.
+func decodeGTPv2(seg segment) (*decoded, error) {
+	msg := seg.payload
+	if len(msg) < 8 {
+		return nil, errors.New("GTPv2 header is truncated")
+	}
+	hasTEID := msg[0]&0x08 != 0
+	msgType := msg[1]
+	length := int(binary.BigEndian.Uint16(msg[2:]))
+	if length+4 > len(msg) {
+		return nil, errors.New("GTPv2 message is truncated")
+	}
+	msg = msg[:length+4]
+
+	ret := &decoded{protocol: ProtocolGTPv2, messageType: gtpv2MessageName(msgType)}
+	headerLen := 8
+	if hasTEID {
+		headerLen = 12
+		if len(msg) < headerLen {
+			return nil, errors.New("GTPv2 header is truncated")
+		}
+		if teid := binary.BigEndian.Uint32(msg[4:]); teid != 0 {
+			ret.correlationKeys = append(ret.correlationKeys, gtpcTEIDKey(teid))
+		}
+	}
+
+	ies := msg[headerLen:]
+	for len(ies) >= 4 {
+		ieType := ies[0]
+		ieLen := int(binary.BigEndian.Uint16(ies[1:]))
+		if 4+ieLen > len(ies) {
+			break
+		}
+		value := ies[4 : 4+ieLen]
+		switch ieType {
+		case gtpv2IEIMSI:
+			ret.imsi = validIMSI(decodeTBCD(value))
+		case gtpv2IECause:
+			if len(value) > 0 {
+				ret.result = fmt.Sprintf("Cause %d", value[0])
+			}
+		case gtpv2IEFTEID:
+			// Flags (V4, V6, interface type) followed by the TEID/GRE key
+			if len(value) >= 5 {
+				if teid := binary.BigEndian.Uint32(value[1:]); teid != 0 {
+					ret.correlationKeys = append(ret.correlationKeys, gtpcTEIDKey(teid))
+				}
+			}
+		}
+		ies = ies[4+ieLen:]
+	}
+	return ret, nil
+}
+
+func gtpv2MessageName(msgType byte) string {
+	if name, ok := gtpv2MessageTypes[msgType]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown GTPv2 message %d", msgType)
+}
+
+func gtpcTEIDKey(teid uint32) string {
+	return fmt.Sprintf("gtpc-teid:%d", teid)
+}
+
+// decodeTBCD decodes a telephony BCD string, as used by the GTPv2 IMSI IE.
+// Each octet holds two digits, low nibble first, with 0xf as filler.
+func decodeTBCD(value []byte) string {
+	var digits strings.Builder
+	for _, b := range value {
+		for _, nibble := range []byte{b & 0x0f, b >> 4} {
+			if nibble > 9 {
+				return digits.String()
+			}
+			digits.WriteByte('0' + nibble)
+		}
+	}
+	return digits.String()
+}
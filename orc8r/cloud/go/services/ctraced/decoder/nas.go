@@ -0,0 +1,212 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	nasProtocolDiscriminatorESM = 0x2
+	nasProtocolDiscriminatorEMM = 0x7
+
+	nasSecurityHeaderPlain                   = 0x0
+	nasSecurityHeaderIntegrity               = 0x1
+	nasSecurityHeaderIntegrityCiphered       = 0x2
+	nasSecurityHeaderIntegrityNewCtx         = 0x3
+	nasSecurityHeaderIntegrityCipheredNewCtx = 0x4
+	nasSecurityHeaderServiceRequest          = 0xc
+	nasSecurityProtectedHeaderLen            = 6
+
+	nasAttachRequest    = 0x41
+	nasDetachRequest    = 0x45
+	nasIdentityResponse = 0x56
+
+	mobileIdentityTypeIMSI = 0x1
+)
+
+// emmMessageTypes are the EPS mobility management messages from
+// 3GPP TS 24.301 table 9.8.1.
+var emmMessageTypes = map[byte]string{
+	0x41: "Attach request",
+	0x42: "Attach accept",
+	0x43: "Attach complete",
+	0x44: "Attach reject",
+	0x45: "Detach request",
+	0x46: "Detach accept",
+	0x48: "Tracking area update request",
+	0x49: "Tracking area update accept",
+	0x4a: "Tracking area update complete",
+	0x4b: "Tracking area update reject",
+	0x4c: "Extended service request",
+	0x4e: "Service reject",
+	0x50: "GUTI reallocation command",
+	0x51: "GUTI reallocation complete",
+	0x52: "Authentication request",
+	0x53: "Authentication response",
+	0x54: "Authentication reject",
+	0x55: "Identity request",
+	0x56: "Identity response",
+	0x5c: "Authentication failure",
+	0x5d: "Security mode command",
+	0x5e: "Security mode complete",
+	0x5f: "Security mode reject",
+	0x60: "EMM status",
+	0x61: "EMM information",
+	0x62: "Downlink NAS transport",
+	0x63: "Uplink NAS transport",
+	0x64: "CS service notification",
+}
+
+// esmMessageTypes are the EPS session management messages from
+// 3GPP TS 24.301 table 9.8.2.
+var esmMessageTypes = map[byte]string{
+	0xc1: "Activate default EPS bearer context request",
+	0xc2: "Activate default EPS bearer context accept",
+	0xc3: "Activate default EPS bearer context reject",
+	0xc5: "Activate dedicated EPS bearer context request",
+	0xc6: "Activate dedicated EPS bearer context accept",
+	0xc7: "Activate dedicated EPS bearer context reject",
+	0xc9: "Modify EPS bearer context request",
+	0xca: "Modify EPS bearer context accept",
+	0xcb: "Modify EPS bearer context reject",
+	0xcd: "Deactivate EPS bearer context request",
+	0xce: "Deactivate EPS bearer context accept",
+	0xd0: "PDN connectivity request",
+	0xd1: "PDN connectivity reject",
+	0xd2: "PDN disconnect request",
+	0xd3: "PDN disconnect reject",
+	0xd4: "Bearer resource allocation request",
+	0xd5: "Bearer resource allocation reject",
+	0xd6: "Bearer resource modification request",
+	0xd7: "Bearer resource modification reject",
+	0xd9: "ESM information request",
+	0xda: "ESM information response",
+	0xe8: "ESM status",
+}
+
+type nasSummary struct {
+	messageType string
+	imsi        string
+}
+
+// decodeNAS summarizes an EPS NAS message. Integrity protected messages are
+// unwrapped, ciphered messages can't be decoded and are reported as such.
+func decodeNAS(pdu []byte) nasSummary {
+	if len(pdu) < 2 {
+		return nasSummary{}
+	}
+	securityHeader := pdu[0] >> 4
+	discriminator := pdu[0] & 0x0f
+	if discriminator != nasProtocolDiscriminatorEMM {
+		return decodePlainNAS(pdu)
+	}
+
+	switch securityHeader {
+	case nasSecurityHeaderPlain:
+		return decodePlainNAS(pdu)
+	case nasSecurityHeaderIntegrity, nasSecurityHeaderIntegrityNewCtx:
+		if len(pdu) <= nasSecurityProtectedHeaderLen {
+			return nasSummary{}
+		}
+		return decodePlainNAS(pdu[nasSecurityProtectedHeaderLen:])
+	case nasSecurityHeaderIntegrityCiphered, nasSecurityHeaderIntegrityCipheredNewCtx:
+		return nasSummary{messageType: "Ciphered NAS message"}
+	case nasSecurityHeaderServiceRequest:
+		return nasSummary{messageType: "Service request"}
+	}
+	return nasSummary{messageType: fmt.Sprintf("Unknown NAS security header type %d", securityHeader)}
+}
+
+func decodePlainNAS(pdu []byte) nasSummary {
+	if len(pdu) < 2 {
+		return nasSummary{}
+	}
+	switch pdu[0] & 0x0f {
+	case nasProtocolDiscriminatorEMM:
+		msgType := pdu[1]
+		ret := nasSummary{messageType: nasMessageName(emmMessageTypes, msgType)}
+		switch msgType {
+		case nasAttachRequest, nasDetachRequest:
+			// Half octet of attach/detach type and NAS key set ID, then
+			// the EPS mobile identity LV
+			if len(pdu) > 3 {
+				ret.imsi = decodeMobileIdentityIMSI(pdu[3:])
+			}
+		case nasIdentityResponse:
+			if len(pdu) > 2 {
+				ret.imsi = decodeMobileIdentityIMSI(pdu[2:])
+			}
+		}
+		return ret
+	case nasProtocolDiscriminatorESM:
+		// EPS bearer ID/PD, procedure transaction ID, message type
+		if len(pdu) < 3 {
+			return nasSummary{}
+		}
+		return nasSummary{messageType: nasMessageName(esmMessageTypes, pdu[2])}
+	}
+	return nasSummary{}
+}
+
+func nasMessageName(names map[byte]string, msgType byte) string {
+	if name, ok := names[msgType]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown NAS message 0x%02x", msgType)
+}
+
+// decodeMobileIdentityIMSI decodes a length-prefixed (E)PS mobile identity,
+// returning the IMSI if the identity is one. See TS 24.008 section 10.5.1.4.
+func decodeMobileIdentityIMSI(lv []byte) string {
+	if len(lv) < 2 {
+		return ""
+	}
+	length := int(lv[0])
+	if length < 1 || length > len(lv)-1 {
+		return ""
+	}
+	identity := lv[1 : 1+length]
+	if identity[0]&0x07 != mobileIdentityTypeIMSI {
+		return ""
+	}
+	odd := identity[0]&0x08 != 0
+
+	var digits strings.Builder
+	digits.WriteByte('0' + identity[0]>>4)
+	for i, b := range identity[1:] {
+		digits.WriteByte('0' + b&0x0f)
+		isLast := i == len(identity)-2
+		if isLast && !odd {
+			break
+		}
+		digits.WriteByte('0' + b>>4)
+	}
+	return validIMSI(digits.String())
+}
+
+// validIMSI returns the IMSI if it is made of 6-15 decimal digits and an
+// empty string otherwise.
+func validIMSI(imsi string) string {
+	if len(imsi) < 6 || len(imsi) > 15 {
+		return ""
+	}
+	for _, c := range imsi {
+		if c < '0' || c > '9' {
+			return ""
+		}
+	}
+	return imsi
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+)
+
+// Link-layer header types, see https://www.tcpdump.org/linktypes.html
+const (
+	linkTypeNull      = 0
+	linkTypeEthernet  = 1
+	linkTypeRaw       = 101
+	linkTypeLinuxSLL  = 113
+	linkTypeIPv4      = 228
+	linkTypeIPv6      = 229
+	linkTypeLinuxSLL2 = 276
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88a8
+
+	ipProtoTCP  = 6
+	ipProtoUDP  = 17
+	ipProtoSCTP = 132
+
+	sctpChunkData = 0
+)
+
+// transport identifies the transport protocol an application payload was
+// carried over.
+type transport int
+
+const (
+	transportUDP transport = iota
+	transportTCP
+	transportSCTP
+)
+
+// segment is an application-layer payload extracted from a captured frame,
+// along with the addressing needed to dispatch and correlate it.
+type segment struct {
+	transport transport
+	srcIP     net.IP
+	dstIP     net.IP
+	srcPort   uint16
+	dstPort   uint16
+	// sctpPPID is the SCTP payload protocol identifier, only set for SCTP
+	sctpPPID uint32
+	payload  []byte
+}
+
+func (s segment) source() string {
+	return net.JoinHostPort(s.srcIP.String(), strconv.Itoa(int(s.srcPort)))
+}
+
+func (s segment) destination() string {
+	return net.JoinHostPort(s.dstIP.String(), strconv.Itoa(int(s.dstPort)))
+}
+
+// hasPort returns true if either the source or destination port matches.
+func (s segment) hasPort(port uint16) bool {
+	return s.srcPort == port || s.dstPort == port
+}
+
+// extractSegments strips link, network and transport headers off a frame.
+// SCTP packets may bundle several DATA chunks, so more than one segment can
+// be returned for a single frame. Frames which aren't IP or use an
+// unsupported transport yield no segments.
+func extractSegments(pkt capturedPacket) []segment {
+	ipPacket, ok := stripLinkLayer(pkt.linkType, pkt.data)
+	if !ok || len(ipPacket) == 0 {
+		return nil
+	}
+
+	var (
+		src, dst net.IP
+		proto    byte
+		payload  []byte
+	)
+	switch ipPacket[0] >> 4 {
+	case 4:
+		if len(ipPacket) < 20 {
+			return nil
+		}
+		ihl := int(ipPacket[0]&0x0f) * 4
+		totalLen := int(binary.BigEndian.Uint16(ipPacket[2:]))
+		if ihl < 20 || totalLen < ihl || totalLen > len(ipPacket) {
+			// Tolerate a truncated capture, but not a malformed header
+			if ihl < 20 || ihl > len(ipPacket) {
+				return nil
+			}
+			totalLen = len(ipPacket)
+		}
+		// Skip non-initial fragments, their payload has no transport header
+		if binary.BigEndian.Uint16(ipPacket[6:])&0x1fff != 0 {
+			return nil
+		}
+		src, dst = net.IP(ipPacket[12:16]), net.IP(ipPacket[16:20])
+		proto = ipPacket[9]
+		payload = ipPacket[ihl:totalLen]
+	case 6:
+		if len(ipPacket) < 40 {
+			return nil
+		}
+		src, dst = net.IP(ipPacket[8:24]), net.IP(ipPacket[24:40])
+		proto = ipPacket[6]
+		payload = ipPacket[40:]
+	default:
+		return nil
+	}
+
+	switch proto {
+	case ipProtoUDP:
+		if len(payload) < 8 {
+			return nil
+		}
+		return []segment{{
+			transport: transportUDP,
+			srcIP:     src,
+			dstIP:     dst,
+			srcPort:   binary.BigEndian.Uint16(payload),
+			dstPort:   binary.BigEndian.Uint16(payload[2:]),
+			payload:   payload[8:],
+		}}
+	case ipProtoTCP:
+		if len(payload) < 20 {
+			return nil
+		}
+		dataOffset := int(payload[12]>>4) * 4
+		if dataOffset < 20 || dataOffset >= len(payload) {
+			return nil
+		}
+		return []segment{{
+			transport: transportTCP,
+			srcIP:     src,
+			dstIP:     dst,
+			srcPort:   binary.BigEndian.Uint16(payload),
+			dstPort:   binary.BigEndian.Uint16(payload[2:]),
+			payload:   payload[dataOffset:],
+		}}
+	case ipProtoSCTP:
+		return extractSCTPChunks(src, dst, payload)
+	}
+	return nil
+}
+
+func stripLinkLayer(linkType uint32, data []byte) ([]byte, bool) {
+	switch linkType {
+	case linkTypeEthernet:
+		if len(data) < 14 {
+			return nil, false
+		}
+		etherType := binary.BigEndian.Uint16(data[12:])
+		data = data[14:]
+		for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+			if len(data) < 4 {
+				return nil, false
+			}
+			etherType = binary.BigEndian.Uint16(data[2:])
+			data = data[4:]
+		}
+		return data, isIPEtherType(etherType)
+	case linkTypeLinuxSLL:
+		if len(data) < 16 {
+			return nil, false
+		}
+		return data[16:], isIPEtherType(binary.BigEndian.Uint16(data[14:]))
+	case linkTypeLinuxSLL2:
+		if len(data) < 20 {
+			return nil, false
+		}
+		return data[20:], isIPEtherType(binary.BigEndian.Uint16(data))
+	case linkTypeNull:
+		// 4 byte address family in host byte order, the IP version nibble
+		// is checked when parsing the network layer instead
+		if len(data) < 4 {
+			return nil, false
+		}
+		return data[4:], true
+	case linkTypeRaw, linkTypeIPv4, linkTypeIPv6:
+		return data, true
+	}
+	return nil, false
+}
+
+func isIPEtherType(etherType uint16) bool {
+	return etherType == etherTypeIPv4 || etherType == etherTypeIPv6
+}
+
+func extractSCTPChunks(src, dst net.IP, packet []byte) []segment {
+	if len(packet) < 12 {
+		return nil
+	}
+	srcPort := binary.BigEndian.Uint16(packet)
+	dstPort := binary.BigEndian.Uint16(packet[2:])
+
+	var segments []segment
+	chunks := packet[12:]
+	for len(chunks) >= 4 {
+		chunkType := chunks[0]
+		chunkLen := int(binary.BigEndian.Uint16(chunks[2:]))
+		if chunkLen < 4 || chunkLen > len(chunks) {
+			break
+		}
+		// DATA chunk: type, flags, length, TSN, stream ID, stream seq, PPID
+		if chunkType == sctpChunkData && chunkLen >= 16 {
+			segments = append(segments, segment{
+				transport: transportSCTP,
+				srcIP:     src,
+				dstIP:     dst,
+				srcPort:   srcPort,
+				dstPort:   dstPort,
+				sctpPPID:  binary.BigEndian.Uint32(chunks[12:]),
+				payload:   chunks[16:chunkLen],
+			})
+		}
+		next := pad4(chunkLen)
+		if next > len(chunks) {
+			break
+		}
+		chunks = chunks[next:]
+	}
+	return segments
+}
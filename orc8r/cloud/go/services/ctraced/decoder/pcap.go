@@ -0,0 +1,284 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pcapMagicMicros = 0xa1b2c3d4
+	pcapMagicNanos  = 0xa1b23c4d
+	pcapHeaderLen   = 24
+	pcapRecordLen   = 16
+
+	pcapngSectionHeaderBlock   = 0x0a0d0d0a
+	pcapngInterfaceDescBlock   = 0x00000001
+	pcapngSimplePacketBlock    = 0x00000003
+	pcapngEnhancedPacketBlock  = 0x00000006
+	pcapngByteOrderMagic       = 0x1a2b3c4d
+	pcapngOptionEnd            = 0
+	pcapngOptionIfTsresol      = 9
+	pcapngDefaultTsresolPerSec = 1e6
+
+	// maxCaptureRecordLen bounds the memory allocated for a single pcap
+	// record or pcapng block of a malformed capture
+	maxCaptureRecordLen = 16 << 20
+)
+
+// capturedPacket is a single frame read out of a pcap or pcapng file.
+type capturedPacket struct {
+	timestamp time.Time
+	linkType  uint32
+	data      []byte
+}
+
+type pcapngInterface struct {
+	linkType       uint32
+	ticksPerSecond float64
+}
+
+// captureReader reads the frames of a pcap or pcapng file one at a time,
+// so a capture can be decoded without holding the whole file in memory.
+// next returns io.EOF once all frames are read.
+type captureReader interface {
+	next() (capturedPacket, error)
+}
+
+// newCaptureReader returns a reader of the frames of a classic pcap or
+// pcapng file, in capture order.
+func newCaptureReader(r io.Reader) (captureReader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if len(magic) < 4 {
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return nil, errors.New("capture file is too short")
+	}
+	if binary.LittleEndian.Uint32(magic) == pcapngSectionHeaderBlock {
+		return &pcapngReader{reader: br, order: binary.LittleEndian}, nil
+	}
+	return newPcapReader(br)
+}
+
+type pcapReader struct {
+	reader   io.Reader
+	order    binary.ByteOrder
+	nanos    bool
+	linkType uint32
+}
+
+func newPcapReader(r io.Reader) (*pcapReader, error) {
+	header := make([]byte, pcapHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.New("pcap global header is truncated")
+		}
+		return nil, err
+	}
+
+	pr := &pcapReader{reader: r}
+	switch {
+	case binary.LittleEndian.Uint32(header) == pcapMagicMicros:
+		pr.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(header) == pcapMagicMicros:
+		pr.order = binary.BigEndian
+	case binary.LittleEndian.Uint32(header) == pcapMagicNanos:
+		pr.order, pr.nanos = binary.LittleEndian, true
+	case binary.BigEndian.Uint32(header) == pcapMagicNanos:
+		pr.order, pr.nanos = binary.BigEndian, true
+	default:
+		return nil, fmt.Errorf("unrecognized capture file magic 0x%08x", binary.BigEndian.Uint32(header))
+	}
+	pr.linkType = pr.order.Uint32(header[20:24])
+	return pr, nil
+}
+
+func (r *pcapReader) next() (capturedPacket, error) {
+	record := make([]byte, pcapRecordLen)
+	if _, err := io.ReadFull(r.reader, record); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return capturedPacket{}, errors.New("pcap record header is truncated")
+		}
+		return capturedPacket{}, err
+	}
+	sec := r.order.Uint32(record)
+	frac := r.order.Uint32(record[4:])
+	capLen := r.order.Uint32(record[8:])
+	if capLen > maxCaptureRecordLen {
+		return capturedPacket{}, fmt.Errorf("pcap record length %d is too large", capLen)
+	}
+	data := make([]byte, capLen)
+	if _, err := io.ReadFull(r.reader, data); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return capturedPacket{}, errors.New("pcap record data is truncated")
+		}
+		return capturedPacket{}, err
+	}
+
+	nsec := int64(frac) * 1000
+	if r.nanos {
+		nsec = int64(frac)
+	}
+	return capturedPacket{
+		timestamp: time.Unix(int64(sec), nsec).UTC(),
+		linkType:  r.linkType,
+		data:      data,
+	}, nil
+}
+
+type pcapngReader struct {
+	reader     io.Reader
+	order      binary.ByteOrder
+	interfaces []pcapngInterface
+}
+
+func (r *pcapngReader) next() (capturedPacket, error) {
+	for {
+		// Every block starts with its type and length, and is at least
+		// 12 bytes long
+		header := make([]byte, 12)
+		if _, err := io.ReadFull(r.reader, header); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return capturedPacket{}, errors.New("pcapng block header is truncated")
+			}
+			return capturedPacket{}, err
+		}
+		blockType := r.order.Uint32(header)
+		if blockType == pcapngSectionHeaderBlock {
+			// Each section declares its own byte order, and interface IDs
+			// are scoped to the section
+			switch {
+			case binary.LittleEndian.Uint32(header[8:]) == pcapngByteOrderMagic:
+				r.order = binary.LittleEndian
+			case binary.BigEndian.Uint32(header[8:]) == pcapngByteOrderMagic:
+				r.order = binary.BigEndian
+			default:
+				return capturedPacket{}, errors.New("pcapng section has invalid byte-order magic")
+			}
+			r.interfaces = nil
+		}
+		blockLen := r.order.Uint32(header[4:])
+		if blockLen < 12 || blockLen > maxCaptureRecordLen {
+			return capturedPacket{}, errors.New("pcapng block length is invalid")
+		}
+		block := make([]byte, blockLen)
+		copy(block, header)
+		if _, err := io.ReadFull(r.reader, block[12:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return capturedPacket{}, errors.New("pcapng block length is invalid")
+			}
+			return capturedPacket{}, err
+		}
+		body := block[8 : blockLen-4]
+
+		switch blockType {
+		case pcapngInterfaceDescBlock:
+			if len(body) < 8 {
+				return capturedPacket{}, errors.New("pcapng interface description block is truncated")
+			}
+			intf := pcapngInterface{
+				linkType:       uint32(r.order.Uint16(body)),
+				ticksPerSecond: pcapngDefaultTsresolPerSec,
+			}
+			if resol, ok := findPcapngOption(r.order, body[8:], pcapngOptionIfTsresol); ok && len(resol) > 0 {
+				intf.ticksPerSecond = tsresolToTicks(resol[0])
+			}
+			r.interfaces = append(r.interfaces, intf)
+		case pcapngEnhancedPacketBlock:
+			if len(body) < 20 {
+				return capturedPacket{}, errors.New("pcapng enhanced packet block is truncated")
+			}
+			intfID := int(r.order.Uint32(body))
+			if intfID >= len(r.interfaces) {
+				return capturedPacket{}, fmt.Errorf("pcapng packet references unknown interface %d", intfID)
+			}
+			intf := r.interfaces[intfID]
+			ticks := uint64(r.order.Uint32(body[4:]))<<32 | uint64(r.order.Uint32(body[8:]))
+			capLen := int(r.order.Uint32(body[12:]))
+			if capLen > len(body)-20 {
+				return capturedPacket{}, errors.New("pcapng packet data is truncated")
+			}
+			return capturedPacket{
+				timestamp: ticksToTime(ticks, intf.ticksPerSecond),
+				linkType:  intf.linkType,
+				data:      body[20 : 20+capLen],
+			}, nil
+		case pcapngSimplePacketBlock:
+			if len(r.interfaces) == 0 {
+				return capturedPacket{}, errors.New("pcapng simple packet block without interface")
+			}
+			if len(body) < 4 {
+				return capturedPacket{}, errors.New("pcapng simple packet block is truncated")
+			}
+			capLen := int(r.order.Uint32(body))
+			if capLen > len(body)-4 {
+				capLen = len(body) - 4
+			}
+			return capturedPacket{
+				linkType: r.interfaces[0].linkType,
+				data:     body[4 : 4+capLen],
+			}, nil
+		}
+	}
+}
+
+func findPcapngOption(order binary.ByteOrder, options []byte, code uint16) ([]byte, bool) {
+	for len(options) >= 4 {
+		optCode := order.Uint16(options)
+		optLen := int(order.Uint16(options[2:]))
+		if optCode == pcapngOptionEnd {
+			return nil, false
+		}
+		if 4+optLen > len(options) {
+			return nil, false
+		}
+		if optCode == code {
+			return options[4 : 4+optLen], true
+		}
+		options = options[4+pad4(optLen):]
+	}
+	return nil, false
+}
+
+// tsresolToTicks converts an if_tsresol option value into timestamp ticks
+// per second. The MSB selects between a power of 10 and a power of 2.
+func tsresolToTicks(resol byte) float64 {
+	if resol&0x80 == 0 {
+		return math.Pow10(int(resol))
+	}
+	return math.Pow(2, float64(resol&0x7f))
+}
+
+func ticksToTime(ticks uint64, ticksPerSecond float64) time.Time {
+	perSecond := uint64(ticksPerSecond)
+	if perSecond == 0 {
+		return time.Time{}
+	}
+	sec := ticks / perSecond
+	nsec := float64(ticks%perSecond) * 1e9 / ticksPerSecond
+	return time.Unix(int64(sec), int64(nsec)).UTC()
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
@@ -0,0 +1,276 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	s1apSCTPPort = 36412
+	s1apSCTPPPID = 18
+
+	s1apIEMMEUES1APID = 0
+	s1apIEENBUES1APID = 8
+	s1apIENASPDU      = 26
+)
+
+// s1apProcedures maps an S1AP procedure code to the names of its
+// initiating, successful outcome and unsuccessful outcome messages.
+// See 3GPP TS 36.413 section 9.1.
+var s1apProcedures = map[byte][3]string{
+	0:  {"HandoverRequired", "HandoverCommand", "HandoverPreparationFailure"},
+	1:  {"HandoverRequest", "HandoverRequestAcknowledge", "HandoverFailure"},
+	2:  {"HandoverNotify", "", ""},
+	3:  {"PathSwitchRequest", "PathSwitchRequestAcknowledge", "PathSwitchRequestFailure"},
+	4:  {"HandoverCancel", "HandoverCancelAcknowledge", ""},
+	5:  {"E-RABSetupRequest", "E-RABSetupResponse", ""},
+	6:  {"E-RABModifyRequest", "E-RABModifyResponse", ""},
+	7:  {"E-RABReleaseCommand", "E-RABReleaseResponse", ""},
+	8:  {"E-RABReleaseIndication", "", ""},
+	9:  {"InitialContextSetupRequest", "InitialContextSetupResponse", "InitialContextSetupFailure"},
+	10: {"Paging", "", ""},
+	11: {"DownlinkNASTransport", "", ""},
+	12: {"InitialUEMessage", "", ""},
+	13: {"UplinkNASTransport", "", ""},
+	14: {"Reset", "ResetAcknowledge", ""},
+	15: {"ErrorIndication", "", ""},
+	16: {"NASNonDeliveryIndication", "", ""},
+	17: {"S1SetupRequest", "S1SetupResponse", "S1SetupFailure"},
+	18: {"UEContextReleaseRequest", "", ""},
+	19: {"DownlinkS1cdma2000tunnelling", "", ""},
+	20: {"UplinkS1cdma2000tunnelling", "", ""},
+	21: {"UEContextModificationRequest", "UEContextModificationResponse", "UEContextModificationFailure"},
+	22: {"UECapabilityInfoIndication", "", ""},
+	23: {"UEContextReleaseCommand", "UEContextReleaseComplete", ""},
+	24: {"ENBStatusTransfer", "", ""},
+	25: {"MMEStatusTransfer", "", ""},
+	26: {"DeactivateTrace", "", ""},
+	27: {"TraceStart", "", ""},
+	28: {"TraceFailureIndication", "", ""},
+	29: {"ENBConfigurationUpdate", "ENBConfigurationUpdateAcknowledge", "ENBConfigurationUpdateFailure"},
+	30: {"MMEConfigurationUpdate", "MMEConfigurationUpdateAcknowledge", "MMEConfigurationUpdateFailure"},
+	31: {"LocationReportingControl", "", ""},
+	32: {"LocationReportingFailureIndication", "", ""},
+	33: {"LocationReport", "", ""},
+	34: {"OverloadStart", "", ""},
+	35: {"OverloadStop", "", ""},
+	36: {"WriteReplaceWarningRequest", "WriteReplaceWarningResponse", ""},
+	37: {"ENBDirectInformationTransfer", "", ""},
+	38: {"MMEDirectInformationTransfer", "", ""},
+	39: {"PrivateMessage", "", ""},
+	40: {"ENBConfigurationTransfer", "", ""},
+	41: {"MMEConfigurationTransfer", "", ""},
+	42: {"CellTrafficTrace", "", ""},
+	43: {"KillRequest", "KillResponse", ""},
+	44: {"DownlinkUEAssociatedLPPaTransport", "", ""},
+	45: {"UplinkUEAssociatedLPPaTransport", "", ""},
+	46: {"DownlinkNonUEAssociatedLPPaTransport", "", ""},
+	47: {"UplinkNonUEAssociatedLPPaTransport", "", ""},
+}
+
+func isS1AP(seg segment) bool {
+	return seg.transport == transportSCTP && (seg.sctpPPID == s1apSCTPPPID || seg.hasPort(s1apSCTPPort))
+}
+
+// decodeS1AP decodes the S1AP-PDU header and protocol IEs of an aligned PER
+// encoded S1AP message. Only the fields needed for a message ladder are
+// decoded: the message name, UE S1AP IDs and an embedded NAS-PDU.
+func decodeS1AP(seg segment) (*decoded, error) {
+	r := &byteReader{buf: seg.payload}
+
+	// S1AP-PDU is an extensible CHOICE: 1 extension bit, 2 bit index
+	choice, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if choice&0x80 != 0 {
+		return nil, errors.New("unsupported S1AP-PDU extension")
+	}
+	outcome := int(choice>>5) & 0x03
+	if outcome > 2 {
+		return nil, fmt.Errorf("invalid S1AP-PDU choice %d", outcome)
+	}
+	procedureCode, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	// criticality
+	if _, err = r.readByte(); err != nil {
+		return nil, err
+	}
+	value, err := r.readOpenType()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &decoded{
+		protocol:    ProtocolS1AP,
+		messageType: s1apMessageName(procedureCode, outcome),
+	}
+
+	ies, err := decodeS1APProtocolIEs(value)
+	if err != nil {
+		// Keep the message name even if the IEs are unreadable
+		return ret, nil
+	}
+	peers := peerKey(seg)
+	for _, ie := range ies {
+		switch ie.id {
+		case s1apIEMMEUES1APID:
+			if id, ok := decodeAPERLargeRangeInt(ie.value); ok {
+				ret.correlationKeys = append(ret.correlationKeys, fmt.Sprintf("s1ap-mme:%s:%d", peers, id))
+			}
+		case s1apIEENBUES1APID:
+			if id, ok := decodeAPERLargeRangeInt(ie.value); ok {
+				ret.correlationKeys = append(ret.correlationKeys, fmt.Sprintf("s1ap-enb:%s:%d", peers, id))
+			}
+		case s1apIENASPDU:
+			// NAS-PDU is an unconstrained OCTET STRING
+			nasReader := &byteReader{buf: ie.value}
+			nasPDU, err := nasReader.readOpenType()
+			if err != nil {
+				continue
+			}
+			nas := decodeNAS(nasPDU)
+			ret.nasMessageType = nas.messageType
+			if nas.imsi != "" {
+				ret.imsi = nas.imsi
+			}
+		}
+	}
+	return ret, nil
+}
+
+func s1apMessageName(procedureCode byte, outcome int) string {
+	names, ok := s1apProcedures[procedureCode]
+	if ok && names[outcome] != "" {
+		return names[outcome]
+	}
+	outcomes := [3]string{"initiatingMessage", "successfulOutcome", "unsuccessfulOutcome"}
+	return fmt.Sprintf("Unknown S1AP procedure %d (%s)", procedureCode, outcomes[outcome])
+}
+
+type s1apIE struct {
+	id    uint16
+	value []byte
+}
+
+// decodeS1APProtocolIEs decodes an extensible SEQUENCE whose first member is
+// a ProtocolIE-Container, which is how every S1AP message is defined.
+func decodeS1APProtocolIEs(value []byte) ([]s1apIE, error) {
+	r := &byteReader{buf: value}
+	// Extension and optional bitmap, padded to an octet
+	if _, err := r.readByte(); err != nil {
+		return nil, err
+	}
+	count, err := r.readUint16()
+	if err != nil {
+		return nil, err
+	}
+
+	ies := make([]s1apIE, 0, count)
+	for i := 0; i < int(count); i++ {
+		id, err := r.readUint16()
+		if err != nil {
+			return ies, err
+		}
+		// criticality
+		if _, err = r.readByte(); err != nil {
+			return ies, err
+		}
+		ieValue, err := r.readOpenType()
+		if err != nil {
+			return ies, err
+		}
+		ies = append(ies, s1apIE{id: id, value: ieValue})
+	}
+	return ies, nil
+}
+
+// decodeAPERLargeRangeInt decodes a constrained whole number whose
+// range exceeds 64K, e.g. MME-UE-S1AP-ID. Such numbers are encoded as a
+// 2 bit octet count followed by the octet-aligned value.
+func decodeAPERLargeRangeInt(value []byte) (uint32, bool) {
+	if len(value) < 1 {
+		return 0, false
+	}
+	n := int(value[0]>>6) + 1
+	if len(value) < 1+n {
+		return 0, false
+	}
+	var ret uint32
+	for _, b := range value[1 : 1+n] {
+		ret = ret<<8 | uint32(b)
+	}
+	return ret, true
+}
+
+// byteReader reads octet-aligned fields out of a buffer.
+type byteReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *byteReader) readByte() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, errors.New("unexpected end of message")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *byteReader) readUint16() (uint16, error) {
+	b, err := r.readBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func (r *byteReader) readBytes(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.buf) {
+		return nil, errors.New("unexpected end of message")
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// readOpenType reads an aligned PER length determinant and the value it covers.
+// Fragmented (>= 16K) values are not supported.
+func (r *byteReader) readOpenType() ([]byte, error) {
+	first, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	var length int
+	switch {
+	case first&0x80 == 0:
+		length = int(first)
+	case first&0xc0 == 0x80:
+		second, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		length = int(first&0x3f)<<8 | int(second)
+	default:
+		return nil, errors.New("fragmented PER length determinants are not supported")
+	}
+	return r.readBytes(length)
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package test_utils builds synthetic call trace captures for tests.
+package test_utils
+
+import (
+	"encoding/binary"
+)
+
+const (
+	LinkTypeEthernet = 1
+	LinkTypeLinuxSLL = 113
+
+	// Frames are timestamped FirstFrameTimestamp + frame number seconds
+	FirstFrameTimestamp = 1600000000
+)
+
+var (
+	srcIP = []byte{192, 168, 60, 142}
+	dstIP = []byte{192, 168, 60, 1}
+)
+
+// NewPcap returns a little-endian, microsecond resolution pcap file.
+func NewPcap(linkType uint32, frames [][]byte) []byte {
+	buf := make([]byte, 24)
+	binary.LittleEndian.PutUint32(buf, 0xa1b2c3d4)
+	binary.LittleEndian.PutUint16(buf[4:], 2)
+	binary.LittleEndian.PutUint16(buf[6:], 4)
+	binary.LittleEndian.PutUint32(buf[16:], 65535)
+	binary.LittleEndian.PutUint32(buf[20:], linkType)
+	for i, frame := range frames {
+		record := make([]byte, 16)
+		binary.LittleEndian.PutUint32(record, uint32(FirstFrameTimestamp+i+1))
+		binary.LittleEndian.PutUint32(record[8:], uint32(len(frame)))
+		binary.LittleEndian.PutUint32(record[12:], uint32(len(frame)))
+		buf = append(buf, record...)
+		buf = append(buf, frame...)
+	}
+	return buf
+}
+
+// NewPcapng returns a little-endian pcapng file with a single interface
+// using nanosecond timestamps.
+func NewPcapng(linkType uint16, frames [][]byte) []byte {
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb, 0x1a2b3c4d)
+	binary.LittleEndian.PutUint16(shb[4:], 1)
+	binary.LittleEndian.PutUint64(shb[8:], ^uint64(0))
+	buf := pcapngBlock(0x0a0d0d0a, shb)
+
+	idb := make([]byte, 8)
+	binary.LittleEndian.PutUint16(idb, linkType)
+	// if_tsresol = 9, then opt_endofopt
+	idb = append(idb, 9, 0, 1, 0, 9, 0, 0, 0, 0, 0, 0, 0)
+	buf = append(buf, pcapngBlock(1, idb)...)
+
+	for i, frame := range frames {
+		ticks := uint64(FirstFrameTimestamp+i+1) * 1e9
+		epb := make([]byte, 20)
+		binary.LittleEndian.PutUint32(epb[4:], uint32(ticks>>32))
+		binary.LittleEndian.PutUint32(epb[8:], uint32(ticks))
+		binary.LittleEndian.PutUint32(epb[12:], uint32(len(frame)))
+		binary.LittleEndian.PutUint32(epb[16:], uint32(len(frame)))
+		epb = append(epb, frame...)
+		epb = append(epb, make([]byte, pad4(len(frame))-len(frame))...)
+		buf = append(buf, pcapngBlock(6, epb)...)
+	}
+	return buf
+}
+
+func pcapngBlock(blockType uint32, body []byte) []byte {
+	length := uint32(len(body) + 12)
+	buf := make([]byte, 8, length)
+	binary.LittleEndian.PutUint32(buf, blockType)
+	binary.LittleEndian.PutUint32(buf[4:], length)
+	buf = append(buf, body...)
+	trailer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(trailer, length)
+	return append(buf, trailer...)
+}
+
+// UDPFrame wraps a payload in UDP, IPv4 and link-layer headers.
+func UDPFrame(linkType uint32, srcPort, dstPort uint16, payload []byte) []byte {
+	udp := make([]byte, 8)
+	binary.BigEndian.PutUint16(udp, srcPort)
+	binary.BigEndian.PutUint16(udp[2:], dstPort)
+	binary.BigEndian.PutUint16(udp[4:], uint16(8+len(payload)))
+	return linkFrame(linkType, ipv4Packet(17, append(udp, payload...)))
+}
+
+// TCPFrame wraps a payload in TCP, IPv4 and link-layer headers.
+func TCPFrame(linkType uint32, srcPort, dstPort uint16, payload []byte) []byte {
+	tcp := make([]byte, 20)
+	binary.BigEndian.PutUint16(tcp, srcPort)
+	binary.BigEndian.PutUint16(tcp[2:], dstPort)
+	tcp[12] = 5 << 4
+	tcp[13] = 0x18
+	return linkFrame(linkType, ipv4Packet(6, append(tcp, payload...)))
+}
+
+// SCTPFrame wraps a payload in a single SCTP DATA chunk, IPv4 and link-layer
+// headers.
+func SCTPFrame(linkType uint32, srcPort, dstPort uint16, ppid uint32, payload []byte) []byte {
+	sctp := make([]byte, 12)
+	binary.BigEndian.PutUint16(sctp, srcPort)
+	binary.BigEndian.PutUint16(sctp[2:], dstPort)
+
+	chunk := make([]byte, 16)
+	chunk[1] = 0x03
+	binary.BigEndian.PutUint16(chunk[2:], uint16(16+len(payload)))
+	binary.BigEndian.PutUint32(chunk[12:], ppid)
+	chunk = append(chunk, payload...)
+	chunk = append(chunk, make([]byte, pad4(len(chunk))-len(chunk))...)
+	return linkFrame(linkType, ipv4Packet(132, append(sctp, chunk...)))
+}
+
+func ipv4Packet(proto byte, payload []byte) []byte {
+	ip := make([]byte, 20)
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+len(payload)))
+	ip[8] = 64
+	ip[9] = proto
+	copy(ip[12:], srcIP)
+	copy(ip[16:], dstIP)
+	return append(ip, payload...)
+}
+
+func linkFrame(linkType uint32, ipPacket []byte) []byte {
+	var header []byte
+	switch linkType {
+	case LinkTypeEthernet:
+		header = make([]byte, 14)
+		binary.BigEndian.PutUint16(header[12:], 0x0800)
+	case LinkTypeLinuxSLL:
+		header = make([]byte, 16)
+		binary.BigEndian.PutUint16(header[14:], 0x0800)
+	}
+	return append(header, ipPacket...)
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
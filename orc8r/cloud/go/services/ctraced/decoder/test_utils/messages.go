@@ -0,0 +1,143 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test_utils
+
+import (
+	"encoding/binary"
+)
+
+type S1APIE struct {
+	ID    uint16
+	Value []byte
+}
+
+type GTPv2IE struct {
+	Type  byte
+	Value []byte
+}
+
+type DiameterAVP struct {
+	Code uint32
+	Data []byte
+}
+
+// S1APMessage encodes an aligned PER S1AP-PDU. Outcome is 0 for an
+// initiating message, 1 for a successful and 2 for an unsuccessful outcome.
+func S1APMessage(outcome byte, procedureCode byte, ies []S1APIE) []byte {
+	value := []byte{0x00, 0x00, byte(len(ies))}
+	for _, ie := range ies {
+		header := make([]byte, 3)
+		binary.BigEndian.PutUint16(header, ie.ID)
+		value = append(value, header...)
+		value = append(value, perLength(len(ie.Value))...)
+		value = append(value, ie.Value...)
+	}
+	pdu := []byte{outcome << 5, procedureCode, 0x40}
+	pdu = append(pdu, perLength(len(value))...)
+	return append(pdu, value...)
+}
+
+// NASPDU encodes a NAS-PDU IE value.
+func NASPDU(nas []byte) []byte {
+	return append(perLength(len(nas)), nas...)
+}
+
+// AttachRequest returns a plain EMM attach request identified by IMSI.
+func AttachRequest(imsi string) []byte {
+	identity := []byte{(imsi[0]-'0')<<4 | 0x01}
+	if len(imsi)%2 == 1 {
+		identity[0] |= 0x08
+	}
+	rest := imsi[1:]
+	for i := 0; i < len(rest); i += 2 {
+		b := rest[i] - '0'
+		if i+1 < len(rest) {
+			b |= (rest[i+1] - '0') << 4
+		} else {
+			b |= 0xf0
+		}
+		identity = append(identity, b)
+	}
+	nas := []byte{0x07, 0x41, 0x71, byte(len(identity))}
+	return append(nas, identity...)
+}
+
+// GTPv2Message encodes a GTPv2-C message with a TEID in its header.
+func GTPv2Message(msgType byte, teid uint32, ies []GTPv2IE) []byte {
+	var body []byte
+	for _, ie := range ies {
+		header := make([]byte, 4)
+		header[0] = ie.Type
+		binary.BigEndian.PutUint16(header[1:], uint16(len(ie.Value)))
+		body = append(body, header...)
+		body = append(body, ie.Value...)
+	}
+	header := make([]byte, 12)
+	header[0] = 0x48
+	header[1] = msgType
+	binary.BigEndian.PutUint16(header[2:], uint16(8+len(body)))
+	binary.BigEndian.PutUint32(header[4:], teid)
+	header[10] = 0x01
+	return append(header, body...)
+}
+
+// TBCD encodes a digit string as telephony BCD.
+func TBCD(digits string) []byte {
+	var ret []byte
+	for i := 0; i < len(digits); i += 2 {
+		b := digits[i] - '0'
+		if i+1 < len(digits) {
+			b |= (digits[i+1] - '0') << 4
+		} else {
+			b |= 0xf0
+		}
+		ret = append(ret, b)
+	}
+	return ret
+}
+
+// DiameterMessage encodes a Diameter message of non-vendor-specific AVPs.
+func DiameterMessage(commandCode uint32, isRequest bool, avps []DiameterAVP) []byte {
+	var body []byte
+	for _, avp := range avps {
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, avp.Code)
+		header[4] = 0x40
+		putUint24(header[5:], uint32(8+len(avp.Data)))
+		body = append(body, header...)
+		body = append(body, avp.Data...)
+		body = append(body, make([]byte, pad4(len(avp.Data))-len(avp.Data))...)
+	}
+	header := make([]byte, 20)
+	header[0] = 1
+	putUint24(header[1:], uint32(20+len(body)))
+	if isRequest {
+		header[4] = 0x80
+	}
+	putUint24(header[5:], commandCode)
+	return append(header, body...)
+}
+
+func perLength(n int) []byte {
+	if n < 128 {
+		return []byte{byte(n)}
+	}
+	return []byte{0x80 | byte(n>>8), byte(n)}
+}
+
+func putUint24(b []byte, v uint32) {
+	b[0] = byte(v >> 16)
+	b[1] = byte(v >> 8)
+	b[2] = byte(v)
+}
@@ -14,22 +14,31 @@ limitations under the License.
 package handlers
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/ctraced/storage"
 	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
 	"magma/orc8r/lib/go/protos"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GwCtracedClient interface {
 	StartCallTrace(networkId string, gatewayId string, req *protos.StartTraceRequest) (*protos.StartTraceResponse, error)
 
 	EndCallTrace(networkId string, gatewayId string, req *protos.EndTraceRequest) (*protos.EndTraceResponse, error)
+
+	// EndCallTraceStream ends a call trace, returning whether it ended and a
+	// reader of the capture the gateway streams back in chunks.
+	EndCallTraceStream(networkId string, gatewayId string, req *protos.EndTraceRequest) (bool, io.Reader, error)
 }
 
 type gwCtracedClientImpl struct{}
@@ -81,3 +90,41 @@ func (c gwCtracedClientImpl) EndCallTrace(networkId string, gatewayId string, re
 	}
 	return resp, err
 }
+
+// EndCallTraceStream ends a call trace on the specified gateway, and returns
+// whether it ended along with a reader of the capture, which the gateway
+// streams back in chunks. Gateways which don't stream captures yet send them
+// whole.
+// If gateway not registered, returns ErrNotFound from magma/orc8r/lib/go/errors.
+func (c gwCtracedClientImpl) EndCallTraceStream(networkId string, gatewayId string, req *protos.EndTraceRequest) (bool, io.Reader, error) {
+	client, ctx, err := getGWCtracedClient(networkId, gatewayId)
+	if err != nil {
+		return false, nil, err
+	}
+	stream, err := client.EndCallTraceChunks(ctx, req)
+	if err != nil {
+		return false, nil, errors.Wrap(err, "failed to end call trace, gRPC client received error")
+	}
+	first, err := stream.Recv()
+	if status.Code(err) == codes.Unimplemented {
+		resp, err := client.EndCallTrace(ctx, req)
+		if err != nil {
+			return false, nil, errors.Wrap(err, "failed to end call trace, gRPC client received error")
+		}
+		return resp.Success, bytes.NewReader(resp.TraceContent), nil
+	}
+	if err != nil {
+		return false, nil, errors.Wrap(err, "failed to end call trace, gRPC client received error")
+	}
+	if !first.Success {
+		return false, nil, nil
+	}
+	next := func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.TraceContent, nil
+	}
+	return true, storage.NewChunkReader(first.TraceContent, next), nil
+}
@@ -15,17 +15,21 @@ package handlers
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/serdes"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/ctraced/decoder"
 	"magma/orc8r/cloud/go/services/ctraced/obsidian/models"
 	"magma/orc8r/cloud/go/services/ctraced/storage"
 	merrors "magma/orc8r/lib/go/errors"
 	"magma/orc8r/lib/go/protos"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/labstack/echo"
 	"github.com/pkg/errors"
 )
@@ -38,9 +42,15 @@ const (
 	tracingPath = tracingRootPath + obsidian.UrlSep + ":" + pathParamTraceID
 	// v1/networks/:network_id/tracing/:trace_id/download
 	tracingDownloadPath = tracingPath + obsidian.UrlSep + "download"
+	// v1/networks/:network_id/tracing/:trace_id/messages
+	tracingMessagesPath = tracingPath + obsidian.UrlSep + "messages"
 
 	pathParamTraceID   = "trace_id"
 	pathParamNetworkID = "network_id"
+
+	queryParamIMSI        = "imsi"
+	queryParamProtocol    = "protocol"
+	queryParamMessageType = "message_type"
 )
 
 func GetObsidianHandlers(client GwCtracedClient, storage storage.CtracedStorage) []obsidian.Handler {
@@ -51,6 +61,7 @@ func GetObsidianHandlers(client GwCtracedClient, storage storage.CtracedStorage)
 		{Path: tracingPath, Methods: obsidian.PUT, HandlerFunc: getUpdateCallTraceHandlerFunc(client, storage)},
 		{Path: tracingPath, Methods: obsidian.DELETE, HandlerFunc: getDeleteCallTraceHandlerFunc(client, storage)},
 		{Path: tracingDownloadPath, Methods: obsidian.GET, HandlerFunc: getDownloadCallTraceHandlerFunc(storage)},
+		{Path: tracingMessagesPath, Methods: obsidian.GET, HandlerFunc: getCallTraceMessagesHandlerFunc(storage)},
 	}
 
 	return ret
@@ -93,6 +104,7 @@ func getCreateCallTraceHandlerFunc(client GwCtracedClient) echo.HandlerFunc {
 			State: &models.CallTraceState{
 				CallTraceAvailable: false,
 				CallTraceEnding:    false,
+				TimeCreated:        strfmt.DateTime(clock.Now()),
 			},
 		}
 		exists, err := configurator.DoesEntityExist(networkID, orc8r.CallTraceEntityType, cfg.TraceID)
@@ -106,21 +118,23 @@ func getCreateCallTraceHandlerFunc(client GwCtracedClient) echo.HandlerFunc {
 			return obsidian.HttpError(err, http.StatusBadRequest)
 		}
 
-		req, err := buildStartTraceRequest(cfg)
-		if err != nil {
-			return obsidian.HttpError(errors.Wrap(err, "failed to build call trace request"), http.StatusInternalServerError)
-		}
-
-		resp, err := client.StartCallTrace(networkID, cfg.GatewayID, req)
-		if err != nil {
-			return obsidian.HttpError(errors.Wrap(err, "failed to start call trace"), http.StatusInternalServerError)
-		}
-		if !resp.Success {
-			return obsidian.HttpError(errors.New("failed to start call trace"), http.StatusInternalServerError)
+		// Scheduled traces are started by the trace scheduler once their
+		// start trigger fires
+		if cfg.IsScheduled() {
+			ctr.State.CallTraceScheduled = true
+		} else {
+			resp, err := client.StartCallTrace(networkID, cfg.GatewayID, cfg.ToStartTraceRequest())
+			if err != nil {
+				return obsidian.HttpError(errors.Wrap(err, "failed to start call trace"), http.StatusInternalServerError)
+			}
+			if !resp.Success {
+				return obsidian.HttpError(errors.New("failed to start call trace"), http.StatusInternalServerError)
+			}
+			ctr.State.TimeStarted = strfmt.DateTime(clock.Now())
 		}
 
 		createdEntity := ctr.ToEntity()
-		_, err = configurator.CreateEntity(networkID, createdEntity, serdes.Entity)
+		_, err = configurator.CreateEntityWithContext(c.Request().Context(), networkID, createdEntity, serdes.Entity)
 		if err != nil {
 			return obsidian.HttpError(errors.Wrap(err, "failed to create call trace"), http.StatusInternalServerError)
 		}
@@ -158,23 +172,40 @@ func getUpdateCallTraceHandlerFunc(client GwCtracedClient, storage storage.Ctrac
 			return obsidian.HttpError(errors.New("Error: call trace end already triggered earlier"), http.StatusBadRequest)
 		}
 
+		// A trace which hasn't started yet has nothing to collect from the
+		// gateway, so it's only marked as ended
+		if callTrace.State.CallTraceScheduled {
+			callTrace.State.CallTraceScheduled = false
+			callTrace.State.CallTraceEnding = true
+			update := configurator.EntityUpdateCriteria{
+				Type:      orc8r.CallTraceEntityType,
+				Key:       callTraceID,
+				NewConfig: callTrace,
+			}
+			_, err = configurator.UpdateEntityWithContext(c.Request().Context(), networkID, update, serdes.Entity)
+			if err != nil {
+				return obsidian.HttpError(err, http.StatusInternalServerError)
+			}
+			return c.NoContent(http.StatusNoContent)
+		}
+
 		req := &protos.EndTraceRequest{
 			TraceId: callTraceID,
 		}
-		resp, err := client.EndCallTrace(networkID, callTrace.Config.GatewayID, req)
+		ended, trace, err := client.EndCallTraceStream(networkID, callTrace.Config.GatewayID, req)
 		if err != nil {
 			return err
 		}
-		if !resp.Success {
+		if !ended {
 			return obsidian.HttpError(errors.New("Failed to end call trace"), http.StatusInternalServerError)
 		}
 
-		err = storage.StoreCallTrace(networkID, callTraceID, resp.TraceContent)
+		err = storage.StoreCallTraceFrom(networkID, callTraceID, trace)
 		if err != nil {
 			return obsidian.HttpError(errors.Wrap(err, fmt.Sprintf("failed to save call trace data, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, callTrace.Config.GatewayID, callTraceID)), http.StatusInternalServerError)
 		}
 
-		_, err = configurator.UpdateEntity(networkID, mutableCallTrace.ToEntityUpdateCriteria(callTraceID, *callTrace), serdes.Entity)
+		_, err = configurator.UpdateEntityWithContext(c.Request().Context(), networkID, mutableCallTrace.ToEntityUpdateCriteria(callTraceID, *callTrace), serdes.Entity)
 		if err != nil {
 			return obsidian.HttpError(err, http.StatusInternalServerError)
 		}
@@ -194,7 +225,7 @@ func getDeleteCallTraceHandlerFunc(client GwCtracedClient, storage storage.Ctrac
 			return obsidian.HttpError(errors.Wrap(err, "failed to delete call trace data"), http.StatusInternalServerError)
 		}
 
-		err = configurator.DeleteEntity(networkID, orc8r.CallTraceEntityType, callTraceID)
+		err = configurator.DeleteEntityWithContext(c.Request().Context(), networkID, orc8r.CallTraceEntityType, callTraceID)
 		if err != nil {
 			return obsidian.HttpError(err, http.StatusInternalServerError)
 		}
@@ -209,21 +240,62 @@ func getDownloadCallTraceHandlerFunc(storage storage.CtracedStorage) echo.Handle
 			return nerr
 		}
 
-		callTrace, err := storage.GetCallTrace(networkID, callTraceID)
+		// Stream the call trace, since it can be too large to hold in memory
+		callTrace, err := storage.OpenCallTrace(networkID, callTraceID)
+		if err == merrors.ErrNotFound {
+			return obsidian.HttpError(errors.New("call trace data is not available"), http.StatusNotFound)
+		}
 		if err != nil {
 			return obsidian.HttpError(errors.Wrap(err, "failed to retrieve call trace data"), http.StatusInternalServerError)
 		}
+		defer callTrace.Close()
 
 		res := c.Response()
 		header := res.Header()
 		header.Set(echo.HeaderContentType, "application/pcapng")
 		header.Set(echo.HeaderContentDisposition, "attachment; filename="+fmt.Sprintf("%s.pcapng", callTraceID))
 		res.WriteHeader(http.StatusOK)
-		_, err = res.Write(callTrace)
+		_, err = io.Copy(res, callTrace)
 		return err
 	}
 }
 
+func getCallTraceMessagesHandlerFunc(storage storage.CtracedStorage) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		networkID, callTraceID, nerr := getNetworkIDAndCallTraceID(c)
+		if nerr != nil {
+			return nerr
+		}
+
+		// Decode the call trace as it's streamed, since it can be too large
+		// to hold in memory
+		callTrace, err := storage.OpenCallTrace(networkID, callTraceID)
+		if err == merrors.ErrNotFound {
+			return obsidian.HttpError(errors.New("call trace data is not available"), http.StatusNotFound)
+		}
+		if err != nil {
+			return obsidian.HttpError(errors.Wrap(err, "failed to retrieve call trace data"), http.StatusInternalServerError)
+		}
+		defer callTrace.Close()
+
+		msgs, err := decoder.DecodeReader(callTrace)
+		if err != nil {
+			return obsidian.HttpError(errors.Wrap(err, "failed to decode call trace"), http.StatusInternalServerError)
+		}
+		filter := decoder.Filter{
+			IMSI:        c.QueryParam(queryParamIMSI),
+			Protocol:    c.QueryParam(queryParamProtocol),
+			MessageType: c.QueryParam(queryParamMessageType),
+		}
+
+		ret := []*models.CallTraceMessage{}
+		for _, msg := range decoder.FilterMessages(msgs, filter) {
+			ret = append(ret, (&models.CallTraceMessage{}).FromDecoded(msg))
+		}
+		return c.JSON(http.StatusOK, ret)
+	}
+}
+
 func getCallTraceModel(c echo.Context) (*models.CallTrace, error) {
 	networkID, callTraceID, nerr := getNetworkIDAndCallTraceID(c)
 	if nerr != nil {
@@ -256,17 +328,6 @@ func getNetworkIDAndCallTraceID(c echo.Context) (string, string, *echo.HTTPError
 	return vals[0], vals[1], nil
 }
 
-func buildStartTraceRequest(cfg *models.CallTraceConfig) (*protos.StartTraceRequest, error) {
-	req := &protos.StartTraceRequest{
-		TraceId:        cfg.TraceID,
-		TraceType:      protos.StartTraceRequest_ALL,
-		Timeout:        cfg.Timeout,
-		CaptureFilters: cfg.CaptureFilters,
-		DisplayFilters: cfg.DisplayFilters,
-	}
-	return req, nil
-}
-
 func shouldEndTraceBeTriggered(callTrace *models.CallTrace, mutable *models.MutableCallTrace) bool {
 	if callTrace.State.CallTraceEnding {
 		return false
@@ -14,19 +14,25 @@
 package handlers_test
 
 import (
+	"bytes"
+	"io"
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/tests"
 	"magma/orc8r/cloud/go/serdes"
 	"magma/orc8r/cloud/go/services/configurator"
+	decoder_test_utils "magma/orc8r/cloud/go/services/ctraced/decoder/test_utils"
 	"magma/orc8r/cloud/go/services/ctraced/obsidian/handlers"
 	traceModels "magma/orc8r/cloud/go/services/ctraced/obsidian/models"
 	"magma/orc8r/cloud/go/services/ctraced/storage"
 	"magma/orc8r/cloud/go/test_utils"
 	"magma/orc8r/lib/go/protos"
 	"testing"
+	"time"
 
 	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/stretchr/testify/assert"
@@ -49,10 +55,18 @@ func (c MockGWCtracedClient) EndCallTrace(networkId string, gatewayId string, re
 	return resp, nil
 }
 
+func (c MockGWCtracedClient) EndCallTraceStream(networkId string, gatewayId string, req *protos.EndTraceRequest) (bool, io.Reader, error) {
+	return true, bytes.NewReader([]byte("abcdefghijklmnopqrstuvwxyz\n")), nil
+}
+
 func TestCtracedHandlersBasic(t *testing.T) {
 	configurator_test_init.StartTestService(t)
 	e := echo.New()
 
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
 	mockGWClient := MockGWCtracedClient{}
 	fact := test_utils.NewSQLBlobstore(t, "ctraced_handlers_test_blobstore")
 	blobstore := storage.NewCtracedBlobstore(fact)
@@ -95,6 +109,8 @@ func TestCtracedHandlersBasic(t *testing.T) {
 		State: &traceModels.CallTraceState{
 			CallTraceAvailable: false,
 			CallTraceEnding:    false,
+			TimeCreated:        strfmt.DateTime(now),
+			TimeStarted:        strfmt.DateTime(now),
 		},
 	}
 
@@ -157,6 +173,8 @@ func TestCtracedHandlersBasic(t *testing.T) {
 	testTrace.State = &traceModels.CallTraceState{
 		CallTraceAvailable: true,
 		CallTraceEnding:    true,
+		TimeCreated:        strfmt.DateTime(now),
+		TimeStarted:        strfmt.DateTime(now),
 	}
 	tc = tests.Test{
 		Method:         "GET",
@@ -210,4 +228,237 @@ func TestCtracedHandlersBasic(t *testing.T) {
 	tc.URL = "/magma/v1/networks/n1/tracing"
 	tc.ExpectedResult = tests.JSONMarshaler(map[string]*traceModels.CallTrace{})
 	tests.RunUnitTest(t, e, tc)
+
+	// Call trace data was deleted
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace1/download",
+		Payload:        nil,
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace1"},
+		Handler:        downloadTrace,
+		ExpectedStatus: 404,
+		ExpectedError:  "call trace data is not available",
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+func TestCtracedHandlersScheduled(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	e := echo.New()
+
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	fact := test_utils.NewSQLBlobstore(t, "ctraced_handlers_scheduled_test_blobstore")
+	blobstore := storage.NewCtracedBlobstore(fact)
+	obsidianHandlers := handlers.GetObsidianHandlers(MockGWCtracedClient{}, blobstore)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"}, serdes.Network)
+	assert.NoError(t, err)
+
+	createTrace := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tracing", obsidian.POST).HandlerFunc
+	getTrace := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tracing/:trace_id", obsidian.GET).HandlerFunc
+	updateTrace := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tracing/:trace_id", obsidian.PUT).HandlerFunc
+
+	testTraceCfg := &traceModels.CallTraceConfig{
+		TraceID:   "CallTrace1",
+		GatewayID: "test_gateway_id",
+		TraceType: traceModels.CallTraceConfigTraceTypeGATEWAY,
+		StartTrigger: &traceModels.CallTraceStartTrigger{
+			Type:      traceModels.CallTraceStartTriggerTypeTIMEWINDOW,
+			StartTime: strfmt.DateTime(now.Add(time.Hour)),
+			EndTime:   strfmt.DateTime(now.Add(2 * time.Hour)),
+		},
+	}
+
+	// Fail on invalid time window
+	testTraceCfg.StartTrigger.EndTime = testTraceCfg.StartTrigger.StartTime
+	tc := tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/tracing",
+		Payload:        testTraceCfg,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		Handler:        createTrace,
+		ExpectedStatus: 400,
+		ExpectedError:  "end_time must be after start_time",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Fail on attach trigger without IMSI
+	tc.Payload = &traceModels.CallTraceConfig{
+		TraceID:      "CallTrace1",
+		TraceType:    traceModels.CallTraceConfigTraceTypeGATEWAY,
+		StartTrigger: &traceModels.CallTraceStartTrigger{Type: traceModels.CallTraceStartTriggerTypeATTACH},
+	}
+	tc.ExpectedError = "imsi is required for an ATTACH start trigger"
+	tests.RunUnitTest(t, e, tc)
+
+	// Scheduled trace isn't started on creation
+	testTraceCfg.StartTrigger.EndTime = strfmt.DateTime(now.Add(2 * time.Hour))
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/tracing",
+		Payload:        testTraceCfg,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		Handler:        createTrace,
+		ExpectedStatus: 201,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	testTrace := &traceModels.CallTrace{
+		Config: testTraceCfg,
+		State: &traceModels.CallTraceState{
+			CallTraceScheduled: true,
+			TimeCreated:        strfmt.DateTime(now),
+		},
+	}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace1",
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace1"},
+		Handler:        getTrace,
+		ExpectedStatus: 200,
+		ExpectedResult: testTrace,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Cancel the scheduled trace
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace1",
+		Payload:        &traceModels.MutableCallTrace{RequestedEnd: swag.Bool(true)},
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace1"},
+		Handler:        updateTrace,
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	testTrace.State = &traceModels.CallTraceState{
+		CallTraceEnding: true,
+		TimeCreated:     strfmt.DateTime(now),
+	}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace1",
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace1"},
+		Handler:        getTrace,
+		ExpectedStatus: 200,
+		ExpectedResult: testTrace,
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+func TestCtracedHandlersMessages(t *testing.T) {
+	e := echo.New()
+
+	fact := test_utils.NewSQLBlobstore(t, "ctraced_handlers_messages_test_blobstore")
+	blobstore := storage.NewCtracedBlobstore(fact)
+	obsidianHandlers := handlers.GetObsidianHandlers(MockGWCtracedClient{}, blobstore)
+	getMessages := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tracing/:trace_id/messages", obsidian.GET).HandlerFunc
+
+	csReq := decoder_test_utils.GTPv2Message(32, 0, []decoder_test_utils.GTPv2IE{
+		{Type: 1, Value: decoder_test_utils.TBCD("001010000000001")},
+		{Type: 87, Value: []byte{0x8a, 0x00, 0x00, 0x11, 0x11, 10, 0, 0, 1}},
+	})
+	csResp := decoder_test_utils.GTPv2Message(33, 0x1111, []decoder_test_utils.GTPv2IE{
+		{Type: 2, Value: []byte{16, 0}},
+	})
+	cer := decoder_test_utils.DiameterMessage(257, true, nil)
+	pcap := decoder_test_utils.NewPcap(decoder_test_utils.LinkTypeEthernet, [][]byte{
+		decoder_test_utils.UDPFrame(decoder_test_utils.LinkTypeEthernet, 2123, 2123, csReq),
+		decoder_test_utils.TCPFrame(decoder_test_utils.LinkTypeEthernet, 40000, 3868, cer),
+		decoder_test_utils.UDPFrame(decoder_test_utils.LinkTypeEthernet, 2123, 2123, csResp),
+	})
+	assert.NoError(t, blobstore.StoreCallTrace("n1", "CallTrace1", pcap))
+	assert.NoError(t, blobstore.StoreCallTrace("n1", "CallTrace2", []byte("abcdefghijklmnopqrstuvwxyz\n")))
+
+	csReqMsg := &traceModels.CallTraceMessage{
+		Frame:       1,
+		Timestamp:   strfmt.DateTime(time.Unix(decoder_test_utils.FirstFrameTimestamp+1, 0).UTC()),
+		Source:      "192.168.60.142:2123",
+		Destination: "192.168.60.1:2123",
+		Protocol:    "GTPv2",
+		MessageType: "Create Session Request",
+		Imsi:        "001010000000001",
+	}
+	cerMsg := &traceModels.CallTraceMessage{
+		Frame:       2,
+		Timestamp:   strfmt.DateTime(time.Unix(decoder_test_utils.FirstFrameTimestamp+2, 0).UTC()),
+		Source:      "192.168.60.142:40000",
+		Destination: "192.168.60.1:3868",
+		Protocol:    "Diameter",
+		MessageType: "Capabilities-Exchange-Request",
+	}
+	csRespMsg := &traceModels.CallTraceMessage{
+		Frame:       3,
+		Timestamp:   strfmt.DateTime(time.Unix(decoder_test_utils.FirstFrameTimestamp+3, 0).UTC()),
+		Source:      "192.168.60.142:2123",
+		Destination: "192.168.60.1:2123",
+		Protocol:    "GTPv2",
+		MessageType: "Create Session Response",
+		Imsi:        "001010000000001",
+		Result:      "Cause 16",
+	}
+
+	// Full message ladder
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace1/messages",
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace1"},
+		Handler:        getMessages,
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*traceModels.CallTraceMessage{csReqMsg, cerMsg, csRespMsg}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Filter by IMSI
+	tc.URL = "/magma/v1/networks/n1/tracing/CallTrace1/messages?imsi=001010000000001"
+	tc.ExpectedResult = tests.JSONMarshaler([]*traceModels.CallTraceMessage{csReqMsg, csRespMsg})
+	tests.RunUnitTest(t, e, tc)
+
+	// Filter by IMSI and message type
+	tc.URL = "/magma/v1/networks/n1/tracing/CallTrace1/messages?imsi=001010000000001&message_type=Create%20Session%20Response"
+	tc.ExpectedResult = tests.JSONMarshaler([]*traceModels.CallTraceMessage{csRespMsg})
+	tests.RunUnitTest(t, e, tc)
+
+	// Filter by protocol
+	tc.URL = "/magma/v1/networks/n1/tracing/CallTrace1/messages?protocol=diameter"
+	tc.ExpectedResult = tests.JSONMarshaler([]*traceModels.CallTraceMessage{cerMsg})
+	tests.RunUnitTest(t, e, tc)
+
+	// No matches
+	tc.URL = "/magma/v1/networks/n1/tracing/CallTrace1/messages?protocol=S1AP"
+	tc.ExpectedResult = tests.JSONMarshaler([]*traceModels.CallTraceMessage{})
+	tests.RunUnitTest(t, e, tc)
+
+	// Stored trace isn't a capture
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace2/messages",
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace2"},
+		Handler:        getMessages,
+		ExpectedStatus: 500,
+		ExpectedError:  "failed to decode call trace: unrecognized capture file magic 0x61626364",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Trace data not stored
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/tracing/CallTrace3/messages",
+		ParamNames:     []string{"network_id", "trace_id"},
+		ParamValues:    []string{"n1", "CallTrace3"},
+		Handler:        getMessages,
+		ExpectedStatus: 404,
+		ExpectedError:  "call trace data is not available",
+	}
+	tests.RunUnitTest(t, e, tc)
 }
@@ -30,6 +30,17 @@ type CallTraceConfig struct {
 	// ID of gateway to run call tracing on
 	GatewayID string `json:"gateway_id,omitempty"`
 
+	// Maximum size of the capture in KiB. The gateway ends the trace once it is reached. 0 for the gateway's default limit.
+	//
+	MaxCaptureSize uint32 `json:"max_capture_size,omitempty"`
+
+	// Maximum duration of the call trace in seconds. Once it has passed, the trace is ended and its capture stored. 0 for no limit.
+	//
+	MaxDuration uint32 `json:"max_duration,omitempty"`
+
+	// start trigger
+	StartTrigger *CallTraceStartTrigger `json:"start_trigger,omitempty"`
+
 	// Timeout of call trace in seconds
 	Timeout uint32 `json:"timeout,omitempty"`
 
@@ -49,6 +60,10 @@ type CallTraceConfig struct {
 func (m *CallTraceConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateStartTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTraceID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -63,6 +78,24 @@ func (m *CallTraceConfig) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CallTraceConfig) validateStartTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StartTrigger) { // not required
+		return nil
+	}
+
+	if m.StartTrigger != nil {
+		if err := m.StartTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("start_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CallTraceConfig) validateTraceID(formats strfmt.Registry) error {
 
 	if err := validate.RequiredString("trace_id", "body", string(m.TraceID)); err != nil {
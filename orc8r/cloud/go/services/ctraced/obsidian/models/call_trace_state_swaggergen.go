@@ -8,7 +8,9 @@ package models
 import (
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // CallTraceState Full state object of a call trace
@@ -20,10 +22,60 @@ type CallTraceState struct {
 
 	// True if trace has been requested to end
 	CallTraceEnding bool `json:"call_trace_ending,omitempty"`
+
+	// True if the trace is waiting for its start trigger
+	CallTraceScheduled bool `json:"call_trace_scheduled,omitempty"`
+
+	// Time the trace was created
+	// Format: date-time
+	TimeCreated strfmt.DateTime `json:"time_created,omitempty"`
+
+	// Time the trace was started on the gateway
+	// Format: date-time
+	TimeStarted strfmt.DateTime `json:"time_started,omitempty"`
 }
 
 // Validate validates this call trace state
 func (m *CallTraceState) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTimeCreated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeStarted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CallTraceState) validateTimeCreated(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimeCreated) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time_created", "body", "date-time", m.TimeCreated.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CallTraceState) validateTimeStarted(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimeStarted) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time_started", "body", "date-time", m.TimeStarted.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -18,6 +18,10 @@ import (
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/ctraced/decoder"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/go-openapi/strfmt"
 )
 
 func (c *CallTrace) ToEntity() configurator.NetworkEntity {
@@ -59,3 +63,33 @@ func (c *MutableCallTrace) ToCallTrace(callTrace CallTrace) *CallTrace {
 	callTrace.State.CallTraceAvailable = *c.RequestedEnd
 	return &callTrace
 }
+
+// IsScheduled returns true if the call trace waits for a start trigger
+// rather than starting when it is created.
+func (c *CallTraceConfig) IsScheduled() bool {
+	return c.StartTrigger != nil && c.StartTrigger.Type != CallTraceStartTriggerTypeIMMEDIATE
+}
+
+func (c *CallTraceConfig) ToStartTraceRequest() *protos.StartTraceRequest {
+	return &protos.StartTraceRequest{
+		TraceId:        c.TraceID,
+		TraceType:      protos.StartTraceRequest_ALL,
+		Timeout:        c.Timeout,
+		CaptureFilters: c.CaptureFilters,
+		DisplayFilters: c.DisplayFilters,
+		MaxFilesize:    c.MaxCaptureSize,
+	}
+}
+
+func (m *CallTraceMessage) FromDecoded(msg *decoder.Message) *CallTraceMessage {
+	m.Frame = uint32(msg.Frame)
+	m.Timestamp = strfmt.DateTime(msg.Timestamp)
+	m.Source = msg.Source
+	m.Destination = msg.Destination
+	m.Protocol = msg.Protocol
+	m.MessageType = msg.MessageType
+	m.NasMessageType = msg.NASMessageType
+	m.Imsi = msg.IMSI
+	m.Result = msg.Result
+	return m
+}
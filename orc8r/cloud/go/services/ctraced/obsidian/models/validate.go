@@ -14,6 +14,9 @@
 package models
 
 import (
+	"errors"
+	"time"
+
 	"github.com/go-openapi/strfmt"
 )
 
@@ -21,7 +24,7 @@ func (m *CallTrace) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
-	return nil
+	return m.Config.validateTrigger()
 }
 
 func (m *MutableCallTrace) ValidateModel() error {
@@ -30,3 +33,27 @@ func (m *MutableCallTrace) ValidateModel() error {
 	}
 	return nil
 }
+
+func (m *CallTraceConfig) validateTrigger() error {
+	if m.StartTrigger == nil {
+		return nil
+	}
+	trigger := m.StartTrigger
+	switch trigger.Type {
+	case CallTraceStartTriggerTypeTIMEWINDOW:
+		if m.GatewayID == "" {
+			return errors.New("gateway_id is required for a TIME_WINDOW start trigger")
+		}
+		if time.Time(trigger.StartTime).IsZero() {
+			return errors.New("start_time is required for a TIME_WINDOW start trigger")
+		}
+		if !time.Time(trigger.EndTime).IsZero() && !time.Time(trigger.EndTime).After(time.Time(trigger.StartTime)) {
+			return errors.New("end_time must be after start_time")
+		}
+	case CallTraceStartTriggerTypeATTACH:
+		if trigger.Imsi == "" {
+			return errors.New("imsi is required for an ATTACH start trigger")
+		}
+	}
+	return nil
+}
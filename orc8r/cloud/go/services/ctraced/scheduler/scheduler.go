@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scheduler starts call traces once their start trigger fires and
+// ends them once they exceed their maximum duration.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/ctraced/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/ctraced/obsidian/models"
+	"magma/orc8r/cloud/go/services/ctraced/storage"
+	directoryd_types "magma/orc8r/cloud/go/services/directoryd/types"
+	"magma/orc8r/cloud/go/services/state"
+	merrors "magma/orc8r/lib/go/errors"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const imsiPrefix = "IMSI"
+
+// TraceScheduler drives the lifecycle of call traces which aren't started
+// and ended by hand.
+type TraceScheduler struct {
+	client  handlers.GwCtracedClient
+	storage storage.CtracedStorage
+}
+
+func NewTraceScheduler(client handlers.GwCtracedClient, storage storage.CtracedStorage) *TraceScheduler {
+	return &TraceScheduler{client: client, storage: storage}
+}
+
+// Run checks all call traces every interval. Run never returns.
+func (s *TraceScheduler) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		err := s.CheckCallTraces()
+		if err != nil {
+			glog.Errorf("Error checking call traces: %v", err)
+		}
+	}
+}
+
+// CheckCallTraces starts the scheduled call traces whose start trigger has
+// fired, and ends the running call traces which have reached their end.
+func (s *TraceScheduler) CheckCallTraces() error {
+	networks, err := configurator.ListNetworkIDs()
+	if err != nil {
+		return errors.Wrap(err, "failed to list networks")
+	}
+	for _, networkID := range networks {
+		callTraces, _, err := configurator.LoadAllEntitiesOfType(
+			networkID, orc8r.CallTraceEntityType,
+			configurator.EntityLoadCriteria{LoadConfig: true},
+			serdes.Entity,
+		)
+		if err != nil {
+			glog.Errorf("Error loading call traces for network %s: %v", networkID, err)
+			continue
+		}
+		for _, ent := range callTraces {
+			callTrace := &models.CallTrace{}
+			if err := callTrace.FromBackendModels(ent); err != nil {
+				glog.Errorf("Error loading call trace %s in network %s: %v", ent.Key, networkID, err)
+				continue
+			}
+			if err := s.checkCallTrace(networkID, callTrace); err != nil {
+				glog.Errorf("Error checking call trace %s in network %s: %v", ent.Key, networkID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *TraceScheduler) checkCallTrace(networkID string, callTrace *models.CallTrace) error {
+	if callTrace.State == nil || callTrace.State.CallTraceEnding {
+		return nil
+	}
+	now := clock.Now()
+	if callTrace.State.CallTraceScheduled {
+		return s.maybeStartCallTrace(networkID, callTrace, now)
+	}
+	return s.maybeEndCallTrace(networkID, callTrace, now)
+}
+
+func (s *TraceScheduler) maybeStartCallTrace(networkID string, callTrace *models.CallTrace, now time.Time) error {
+	cfg := callTrace.Config
+	switch cfg.StartTrigger.Type {
+	case models.CallTraceStartTriggerTypeTIMEWINDOW:
+		if now.Before(time.Time(cfg.StartTrigger.StartTime)) {
+			return nil
+		}
+		// The whole window passed without the trace being started, e.g.
+		// because ctraced was down
+		if hasTimeWindowEnded(cfg, now) {
+			glog.Warningf("Time window of call trace %s in network %s passed before it could be started", cfg.TraceID, networkID)
+			callTrace.State.CallTraceScheduled = false
+			callTrace.State.CallTraceEnding = true
+			return updateCallTrace(networkID, callTrace)
+		}
+	case models.CallTraceStartTriggerTypeATTACH:
+		gatewayID, attached, err := getGatewayOfAttach(networkID, cfg.StartTrigger.Imsi, time.Time(callTrace.State.TimeCreated))
+		if err != nil || !attached {
+			return err
+		}
+		cfg.GatewayID = gatewayID
+	}
+
+	resp, err := s.client.StartCallTrace(networkID, cfg.GatewayID, cfg.ToStartTraceRequest())
+	if err != nil {
+		return errors.Wrap(err, "failed to start call trace")
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to start call trace on gateway %s", cfg.GatewayID)
+	}
+	glog.Infof("Started scheduled call trace %s in network %s on gateway %s", cfg.TraceID, networkID, cfg.GatewayID)
+
+	callTrace.State.CallTraceScheduled = false
+	callTrace.State.TimeStarted = strfmt.DateTime(now)
+	return updateCallTrace(networkID, callTrace)
+}
+
+func (s *TraceScheduler) maybeEndCallTrace(networkID string, callTrace *models.CallTrace, now time.Time) error {
+	cfg := callTrace.Config
+	timeStarted := time.Time(callTrace.State.TimeStarted)
+	if timeStarted.IsZero() {
+		// Started before traces recorded their start time
+		return nil
+	}
+	maxDurationReached := cfg.MaxDuration != 0 && !now.Before(timeStarted.Add(time.Duration(cfg.MaxDuration)*time.Second))
+	if !maxDurationReached && !hasTimeWindowEnded(cfg, now) {
+		return nil
+	}
+
+	ended, trace, err := s.client.EndCallTraceStream(networkID, cfg.GatewayID, &protos.EndTraceRequest{TraceId: cfg.TraceID})
+	if err != nil {
+		return errors.Wrap(err, "failed to end call trace")
+	}
+	// The gateway may have ended the trace itself in the meantime, in which
+	// case it reports the capture through the CallTraceController
+	if ended {
+		err = s.storage.StoreCallTraceFrom(networkID, cfg.TraceID, trace)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to save call trace data, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, cfg.GatewayID, cfg.TraceID))
+		}
+		callTrace.State.CallTraceAvailable = true
+	}
+	glog.Infof("Ended call trace %s in network %s on gateway %s", cfg.TraceID, networkID, cfg.GatewayID)
+
+	callTrace.State.CallTraceEnding = true
+	return updateCallTrace(networkID, callTrace)
+}
+
+func hasTimeWindowEnded(cfg *models.CallTraceConfig, now time.Time) bool {
+	if cfg.StartTrigger == nil || cfg.StartTrigger.Type != models.CallTraceStartTriggerTypeTIMEWINDOW {
+		return false
+	}
+	endTime := time.Time(cfg.StartTrigger.EndTime)
+	return !endTime.IsZero() && !now.Before(endTime)
+}
+
+// getGatewayOfAttach returns the ID of the gateway the subscriber attached
+// to, if the subscriber's directory record was reported after the given time.
+func getGatewayOfAttach(networkID string, imsi string, after time.Time) (string, bool, error) {
+	// Directory records are keyed by the IMSI with its prefix
+	if !strings.HasPrefix(imsi, imsiPrefix) {
+		imsi = imsiPrefix + imsi
+	}
+	st, err := state.GetState(context.Background(), networkID, orc8r.DirectoryRecordType, imsi, serdes.State)
+	if err == merrors.ErrNotFound {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to get directory record")
+	}
+	if int64(st.TimeMs) < after.UnixNano()/int64(time.Millisecond) {
+		return "", false, nil
+	}
+
+	hwID := st.ReporterID
+	if record, ok := st.ReportedState.(*directoryd_types.DirectoryRecord); ok && len(record.LocationHistory) > 0 {
+		hwID = record.LocationHistory[0]
+	}
+	gateway, err := configurator.LoadEntityForPhysicalID(hwID, configurator.EntityLoadCriteria{}, serdes.Entity)
+	if err != nil {
+		return "", false, errors.Wrap(err, fmt.Sprintf("failed to load gateway with hardware ID %s", hwID))
+	}
+	return gateway.Key, true, nil
+}
+
+func updateCallTrace(networkID string, callTrace *models.CallTrace) error {
+	update := configurator.EntityUpdateCriteria{
+		Type:      orc8r.CallTraceEntityType,
+		Key:       callTrace.Config.TraceID,
+		NewConfig: callTrace,
+	}
+	_, err := configurator.UpdateEntity(networkID, update, serdes.Entity)
+	return err
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	configurator_test_utils "magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/ctraced/obsidian/models"
+	"magma/orc8r/cloud/go/services/ctraced/scheduler"
+	"magma/orc8r/cloud/go/services/ctraced/storage"
+	device_test_init "magma/orc8r/cloud/go/services/device/test_init"
+	directoryd_types "magma/orc8r/cloud/go/services/directoryd/types"
+	orchestrator_models "magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	state_test_init "magma/orc8r/cloud/go/services/state/test_init"
+	state_test_utils "magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	networkID = "n1"
+	gatewayID = "gw1"
+	hwID      = "hw1"
+	imsi      = "001010000000001"
+)
+
+var traceContent = []byte("abcdefghijklmnopqrstuvwxyz\n")
+
+type mockGWCtracedClient struct {
+	started []string
+	ended   []string
+}
+
+func (c *mockGWCtracedClient) StartCallTrace(networkId string, gatewayId string, req *protos.StartTraceRequest) (*protos.StartTraceResponse, error) {
+	c.started = append(c.started, gatewayId+"/"+req.TraceId)
+	return &protos.StartTraceResponse{Success: true}, nil
+}
+
+func (c *mockGWCtracedClient) EndCallTrace(networkId string, gatewayId string, req *protos.EndTraceRequest) (*protos.EndTraceResponse, error) {
+	c.ended = append(c.ended, gatewayId+"/"+req.TraceId)
+	return &protos.EndTraceResponse{Success: true, TraceContent: traceContent}, nil
+}
+
+func (c *mockGWCtracedClient) EndCallTraceStream(networkId string, gatewayId string, req *protos.EndTraceRequest) (bool, io.Reader, error) {
+	c.ended = append(c.ended, gatewayId+"/"+req.TraceId)
+	return true, bytes.NewReader(traceContent), nil
+}
+
+func TestTraceScheduler_TimeWindow(t *testing.T) {
+	client, blobstore, traceScheduler := setupTest(t, "ctraced_scheduler_time_window_test_blobstore")
+
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	createCallTrace(t, &models.CallTraceConfig{
+		TraceID:   "CallTrace1",
+		GatewayID: gatewayID,
+		TraceType: models.CallTraceConfigTraceTypeGATEWAY,
+		StartTrigger: &models.CallTraceStartTrigger{
+			Type:      models.CallTraceStartTriggerTypeTIMEWINDOW,
+			StartTime: strfmt.DateTime(now.Add(time.Minute)),
+			EndTime:   strfmt.DateTime(now.Add(time.Hour)),
+		},
+	}, now)
+
+	// Window hasn't started yet
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Empty(t, client.started)
+	assertState(t, "CallTrace1", &models.CallTraceState{CallTraceScheduled: true, TimeCreated: strfmt.DateTime(now)})
+
+	// Window started
+	clock.SetAndFreezeClock(t, now.Add(time.Minute))
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Equal(t, []string{"gw1/CallTrace1"}, client.started)
+	assert.Empty(t, client.ended)
+	assertState(t, "CallTrace1", &models.CallTraceState{
+		TimeCreated: strfmt.DateTime(now),
+		TimeStarted: strfmt.DateTime(now.Add(time.Minute)),
+	})
+
+	// Window ended
+	clock.SetAndFreezeClock(t, now.Add(time.Hour))
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Equal(t, []string{"gw1/CallTrace1"}, client.ended)
+	assertState(t, "CallTrace1", &models.CallTraceState{
+		CallTraceAvailable: true,
+		CallTraceEnding:    true,
+		TimeCreated:        strfmt.DateTime(now),
+		TimeStarted:        strfmt.DateTime(now.Add(time.Minute)),
+	})
+	data, err := blobstore.GetCallTrace(networkID, "CallTrace1")
+	assert.NoError(t, err)
+	assert.Equal(t, traceContent, data)
+
+	// Ended traces are left alone
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Len(t, client.started, 1)
+	assert.Len(t, client.ended, 1)
+
+	// Window passed before the trace could be started
+	createCallTrace(t, &models.CallTraceConfig{
+		TraceID:   "CallTrace2",
+		GatewayID: gatewayID,
+		TraceType: models.CallTraceConfigTraceTypeGATEWAY,
+		StartTrigger: &models.CallTraceStartTrigger{
+			Type:      models.CallTraceStartTriggerTypeTIMEWINDOW,
+			StartTime: strfmt.DateTime(now.Add(time.Minute)),
+			EndTime:   strfmt.DateTime(now.Add(time.Hour)),
+		},
+	}, now)
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Len(t, client.started, 1)
+	assertState(t, "CallTrace2", &models.CallTraceState{CallTraceEnding: true, TimeCreated: strfmt.DateTime(now)})
+}
+
+func TestTraceScheduler_MaxDuration(t *testing.T) {
+	client, _, traceScheduler := setupTest(t, "ctraced_scheduler_max_duration_test_blobstore")
+
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	trace := createCallTrace(t, &models.CallTraceConfig{
+		TraceID:     "CallTrace1",
+		GatewayID:   gatewayID,
+		TraceType:   models.CallTraceConfigTraceTypeGATEWAY,
+		MaxDuration: 60,
+	}, now)
+	trace.State.TimeStarted = strfmt.DateTime(now)
+	updateCallTrace(t, trace)
+
+	clock.SetAndFreezeClock(t, now.Add(59*time.Second))
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Empty(t, client.ended)
+
+	clock.SetAndFreezeClock(t, now.Add(60*time.Second))
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Equal(t, []string{"gw1/CallTrace1"}, client.ended)
+	assertState(t, "CallTrace1", &models.CallTraceState{
+		CallTraceAvailable: true,
+		CallTraceEnding:    true,
+		TimeCreated:        strfmt.DateTime(now),
+		TimeStarted:        strfmt.DateTime(now),
+	})
+}
+
+func TestTraceScheduler_Attach(t *testing.T) {
+	client, _, traceScheduler := setupTest(t, "ctraced_scheduler_attach_test_blobstore")
+	device_test_init.StartTestService(t)
+	state_test_init.StartTestService(t)
+	configurator_test_utils.RegisterGateway(t, networkID, gatewayID, &orchestrator_models.GatewayDevice{HardwareID: hwID})
+	ctx := state_test_utils.GetContextWithCertificate(t, hwID)
+	record := &directoryd_types.DirectoryRecord{LocationHistory: []string{hwID}}
+
+	// Gateway certificates are only valid from the current time on
+	now := time.Now().Truncate(time.Second).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	// Subscriber attached before the trace was created
+	state_test_utils.ReportState(t, ctx, orc8r.DirectoryRecordType, "IMSI"+imsi, record, serdes.State)
+
+	createCallTrace(t, &models.CallTraceConfig{
+		TraceID:   "CallTrace1",
+		TraceType: models.CallTraceConfigTraceTypeGATEWAY,
+		StartTrigger: &models.CallTraceStartTrigger{
+			Type: models.CallTraceStartTriggerTypeATTACH,
+			Imsi: imsi,
+		},
+	}, now.Add(time.Second))
+
+	clock.SetAndFreezeClock(t, now.Add(time.Minute))
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Empty(t, client.started)
+
+	// Subscriber attached again
+	state_test_utils.ReportState(t, ctx, orc8r.DirectoryRecordType, "IMSI"+imsi, record, serdes.State)
+	assert.NoError(t, traceScheduler.CheckCallTraces())
+	assert.Equal(t, []string{"gw1/CallTrace1"}, client.started)
+
+	ent, err := configurator.LoadEntity(networkID, orc8r.CallTraceEntityType, "CallTrace1", configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	assert.NoError(t, err)
+	trace := ent.Config.(*models.CallTrace)
+	assert.Equal(t, gatewayID, trace.Config.GatewayID)
+	assert.Equal(t, &models.CallTraceState{
+		TimeCreated: strfmt.DateTime(now.Add(time.Second)),
+		TimeStarted: strfmt.DateTime(now.Add(time.Minute)),
+	}, trace.State)
+}
+
+func setupTest(t *testing.T, tableName string) (*mockGWCtracedClient, storage.CtracedStorage, *scheduler.TraceScheduler) {
+	configurator_test_init.StartTestService(t)
+	configurator_test_utils.RegisterNetwork(t, networkID, "Trace Scheduler Test")
+
+	client := &mockGWCtracedClient{}
+	blobstore := storage.NewCtracedBlobstore(test_utils.NewSQLBlobstore(t, tableName))
+	return client, blobstore, scheduler.NewTraceScheduler(client, blobstore)
+}
+
+func createCallTrace(t *testing.T, cfg *models.CallTraceConfig, timeCreated time.Time) *models.CallTrace {
+	trace := &models.CallTrace{
+		Config: cfg,
+		State: &models.CallTraceState{
+			CallTraceScheduled: cfg.IsScheduled(),
+			TimeCreated:        strfmt.DateTime(timeCreated),
+		},
+	}
+	_, err := configurator.CreateEntity(networkID, trace.ToEntity(), serdes.Entity)
+	assert.NoError(t, err)
+	return trace
+}
+
+func updateCallTrace(t *testing.T, trace *models.CallTrace) {
+	update := configurator.EntityUpdateCriteria{
+		Type:      orc8r.CallTraceEntityType,
+		Key:       trace.Config.TraceID,
+		NewConfig: trace,
+	}
+	_, err := configurator.UpdateEntity(networkID, update, serdes.Entity)
+	assert.NoError(t, err)
+}
+
+func assertState(t *testing.T, traceID string, expected *models.CallTraceState) {
+	ent, err := configurator.LoadEntity(networkID, orc8r.CallTraceEntityType, traceID, configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ent.Config.(*models.CallTrace).State)
+}
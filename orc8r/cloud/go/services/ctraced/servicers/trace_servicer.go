@@ -14,8 +14,10 @@ limitations under the License.
 package servicers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/serdes"
@@ -38,34 +40,65 @@ func NewCallTraceServicer(storage storage.CtracedStorage) protos.CallTraceContro
 }
 
 func (srv *callTraceServicer) ReportEndedCallTrace(ctx context.Context, req *protos.ReportEndedTraceRequest) (*protos.ReportEndedTraceResponse, error) {
-	networkID, err := getNetworkID(ctx)
+	err := srv.reportEndedCallTrace(ctx, req.TraceId, req.Success, bytes.NewReader(req.TraceContent))
 	if err != nil {
 		return nil, err
 	}
-	callTrace, err := getCallTraceModel(networkID, req.TraceId)
+	return &protos.ReportEndedTraceResponse{}, nil
+}
+
+// ReportEndedCallTraceChunks stores the capture of an ended call trace as
+// its chunks are received, so that it isn't held in memory whole.
+func (srv *callTraceServicer) ReportEndedCallTraceChunks(stream protos.CallTraceController_ReportEndedCallTraceChunksServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	next := func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.TraceContent, nil
+	}
+	err = srv.reportEndedCallTrace(stream.Context(), first.TraceId, first.Success, storage.NewChunkReader(first.TraceContent, next))
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return stream.SendAndClose(&protos.ReportEndedTraceResponse{})
+}
 
-	err = srv.storage.StoreCallTrace(networkID, req.TraceId, req.TraceContent)
+func (srv *callTraceServicer) reportEndedCallTrace(ctx context.Context, traceID string, success bool, trace io.Reader) error {
+	networkID, err := getNetworkID(ctx)
+	if err != nil {
+		return err
+	}
+	callTrace, err := getCallTraceModel(networkID, traceID)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, fmt.Sprintf("failed to save call trace data, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, callTrace.Config.GatewayID, req.TraceId))
+		return err
 	}
 
-	callTrace.State.CallTraceEnding = req.Success
-	callTrace.State.CallTraceAvailable = req.Success
+	if success {
+		err = srv.storage.StoreCallTraceFrom(networkID, traceID, trace)
+		if err != nil {
+			return status.Errorf(codes.Aborted, fmt.Sprintf("failed to save call trace data, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, callTrace.Config.GatewayID, traceID))
+		}
+	}
+
+	callTrace.State.CallTraceEnding = success
+	callTrace.State.CallTraceAvailable = success
 
 	update := configurator.EntityUpdateCriteria{
 		Type:      orc8r.CallTraceEntityType,
-		Key:       req.TraceId,
+		Key:       traceID,
 		NewConfig: callTrace,
 	}
 
 	_, err = configurator.UpdateEntity(networkID, update, serdes.Entity)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, fmt.Sprintf("failed to update call trace, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, callTrace.Config.GatewayID, req.TraceId))
+		return status.Errorf(codes.Aborted, fmt.Sprintf("failed to update call trace, network-id: %s, gateway-id: %s, calltrace-id: %s", networkID, callTrace.Config.GatewayID, traceID))
 	}
-	return &protos.ReportEndedTraceResponse{}, nil
+	return nil
 }
 
 func getNetworkID(ctx context.Context) (string, error) {
@@ -14,6 +14,7 @@ limitations under the License.
 package servicers_test
 
 import (
+	"io"
 	"testing"
 
 	"magma/orc8r/cloud/go/orc8r"
@@ -25,10 +26,12 @@ import (
 	"magma/orc8r/cloud/go/services/ctraced/storage"
 	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
 	"magma/orc8r/cloud/go/test_utils"
+	merrors "magma/orc8r/lib/go/errors"
 	"magma/orc8r/lib/go/protos"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 func TestCallTraceServicer(t *testing.T) {
@@ -99,3 +102,80 @@ func TestCallTraceServicer(t *testing.T) {
 	assert.Equal(t, true, testCallTrace.State.CallTraceAvailable)
 	assert.Equal(t, true, testCallTrace.State.CallTraceEnding)
 }
+
+func TestCallTraceServicer_Chunks(t *testing.T) {
+	test_init.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"}, serdes.Network)
+	assert.NoError(t, err)
+	for _, traceID := range []string{"CallTrace1", "CallTrace2"} {
+		testTrace := &models.CallTrace{
+			Config: &models.CallTraceConfig{TraceID: traceID, GatewayID: "g1", Timeout: 300, TraceType: models.CallTraceConfigTraceTypeGATEWAY},
+			State:  &models.CallTraceState{},
+		}
+		_, err = configurator.CreateEntity("n1", testTrace.ToEntity(), serdes.Entity)
+		assert.NoError(t, err)
+	}
+
+	id := protos.Identity{}
+	id.SetGateway(&protos.Identity_Gateway{HardwareId: "hw1", NetworkId: "n1", LogicalId: "g1"})
+	ctx := id.NewContextWithIdentity(context.Background())
+
+	fact := test_utils.NewSQLBlobstore(t, "ctraced_trace_servicer_chunks_test_blobstore")
+	blobstore := storage.NewCtracedBlobstore(fact)
+	srv := servicers.NewCallTraceServicer(blobstore)
+
+	// The capture is stored from all chunks
+	stream := &mockReportChunksStream{ctx: ctx, reqs: []*protos.ReportEndedTraceRequest{
+		{TraceId: "CallTrace1", Success: true, TraceContent: []byte("abc")},
+		{TraceContent: []byte("def")},
+		{TraceContent: []byte("ghi")},
+	}}
+	err = srv.ReportEndedCallTraceChunks(stream)
+	assert.NoError(t, err)
+	assert.True(t, stream.closed)
+	data, err := blobstore.GetCallTrace("n1", "CallTrace1")
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdefghi", string(data))
+	assert.True(t, loadCallTrace(t, "n1", "CallTrace1").State.CallTraceAvailable)
+
+	// Failed call traces have no capture
+	stream = &mockReportChunksStream{ctx: ctx, reqs: []*protos.ReportEndedTraceRequest{{TraceId: "CallTrace2", Success: false}}}
+	err = srv.ReportEndedCallTraceChunks(stream)
+	assert.NoError(t, err)
+	_, err = blobstore.GetCallTrace("n1", "CallTrace2")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+	assert.False(t, loadCallTrace(t, "n1", "CallTrace2").State.CallTraceAvailable)
+}
+
+func loadCallTrace(t *testing.T, networkID string, callTraceID string) *models.CallTrace {
+	ent, err := configurator.LoadEntity(networkID, orc8r.CallTraceEntityType, callTraceID, configurator.FullEntityLoadCriteria(), serdes.Entity)
+	assert.NoError(t, err)
+	return (&models.CallTrace{}).FromEntity(ent)
+}
+
+type mockReportChunksStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	reqs   []*protos.ReportEndedTraceRequest
+	closed bool
+}
+
+func (s *mockReportChunksStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockReportChunksStream) Recv() (*protos.ReportEndedTraceRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *mockReportChunksStream) SendAndClose(*protos.ReportEndedTraceResponse) error {
+	s.closed = true
+	return nil
+}
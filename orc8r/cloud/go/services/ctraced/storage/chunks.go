@@ -0,0 +1,44 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"io"
+)
+
+// NewChunkReader returns a reader of a call trace file received in chunks,
+// e.g. over a gRPC stream. The reader starts with the first chunk, then
+// reads the chunks returned by next until it returns an error. io.EOF ends
+// the file.
+func NewChunkReader(first []byte, next func() ([]byte, error)) io.Reader {
+	return &chunkReader{chunk: first, next: next}
+}
+
+type chunkReader struct {
+	chunk []byte
+	next  func() ([]byte, error)
+	err   error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.chunk, r.err = r.next()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	cstorage "magma/orc8r/cloud/go/services/ctraced/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkReader(t *testing.T) {
+	chunks := [][]byte{[]byte("def"), {}, []byte("ghi")}
+	next := func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	}
+	data, err := ioutil.ReadAll(cstorage.NewChunkReader([]byte("abc"), next))
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdefghi", string(data))
+
+	// Errors other than io.EOF are returned once the chunks read are consumed
+	failing := func() ([]byte, error) { return nil, errors.New("stream broken") }
+	_, err = ioutil.ReadAll(cstorage.NewChunkReader([]byte("abc"), failing))
+	assert.EqualError(t, err, "stream broken")
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/ctraced/obsidian/models"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// RunGarbageCollector deletes the call trace files which have been stored
+// for longer than the retention period, every interval. It never returns.
+func RunGarbageCollector(store ExpiringCtracedStorage, retention time.Duration, interval time.Duration) {
+	for range time.Tick(interval) {
+		CollectGarbage(store, retention)
+	}
+}
+
+// CollectGarbage deletes the call trace files which have been stored for
+// longer than the retention period, and marks their call traces as no longer
+// available.
+func CollectGarbage(store ExpiringCtracedStorage, retention time.Duration) {
+	deleted, err := store.DeleteCallTracesOlderThan(clock.Now().Add(-retention))
+	if err != nil {
+		glog.Errorf("Error deleting expired call traces: %v", err)
+		return
+	}
+	if len(deleted) > 0 {
+		glog.Infof("Deleted %d expired call traces", len(deleted))
+	}
+	for _, id := range deleted {
+		err = markCallTraceUnavailable(id)
+		if err != nil {
+			glog.Errorf("Error marking expired call trace %s in network %s as unavailable: %v", id.CallTraceID, id.NetworkID, err)
+		}
+	}
+}
+
+func markCallTraceUnavailable(id CallTraceID) error {
+	ent, err := configurator.LoadEntity(
+		id.NetworkID, orc8r.CallTraceEntityType, id.CallTraceID,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	// The call trace was deleted since
+	if err == merrors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to load call trace")
+	}
+	callTrace := &models.CallTrace{}
+	err = callTrace.FromBackendModels(ent)
+	if err != nil {
+		return err
+	}
+	if callTrace.State == nil || !callTrace.State.CallTraceAvailable {
+		return nil
+	}
+
+	callTrace.State.CallTraceAvailable = false
+	update := configurator.EntityUpdateCriteria{
+		Type:      orc8r.CallTraceEntityType,
+		Key:       id.CallTraceID,
+		NewConfig: callTrace,
+	}
+	_, err = configurator.UpdateEntity(id.NetworkID, update, serdes.Entity)
+	return err
+}
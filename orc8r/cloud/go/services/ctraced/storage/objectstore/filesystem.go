@@ -0,0 +1,143 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstore
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/pkg/errors"
+)
+
+// tmpFilePrefix marks files which are still being written.
+const tmpFilePrefix = ".tmp-"
+
+// NewFilesystemStore returns an object store which keeps each object in a
+// file under the given directory, creating the directory if necessary.
+func NewFilesystemStore(directory string) (ObjectStore, error) {
+	if directory == "" {
+		return nil, errors.New("object store directory must be set")
+	}
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create object store directory")
+	}
+	return &filesystemStore{directory: directory}, nil
+}
+
+type filesystemStore struct {
+	directory string
+}
+
+func (f *filesystemStore) Put(key string, r io.Reader) error {
+	path, err := f.getPath(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to create directory for object %s", key))
+	}
+
+	// Write to a temporary file first, so that readers never see a
+	// partially written object
+	tmp, err := ioutil.TempFile(filepath.Dir(path), tmpFilePrefix)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to create file for object %s", key))
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return errors.Wrap(err, fmt.Sprintf("failed to write object %s", key))
+	}
+	err = tmp.Close()
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to write object %s", key))
+	}
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to store object %s", key))
+	}
+	return nil
+}
+
+func (f *filesystemStore) Get(key string) (io.ReadCloser, error) {
+	path, err := f.getPath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, merrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to open object %s", key))
+	}
+	return file, nil
+}
+
+func (f *filesystemStore) Delete(key string) error {
+	path, err := f.getPath(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, fmt.Sprintf("failed to delete object %s", key))
+	}
+	return nil
+}
+
+func (f *filesystemStore) List(prefix string) ([]ObjectInfo, error) {
+	var ret []ObjectInfo
+	err := filepath.Walk(f.directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), tmpFilePrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(f.directory, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			ret = append(ret, ObjectInfo{Key: key, Size: info.Size(), LastModified: info.ModTime()})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+	return ret, nil
+}
+
+// getPath returns the path of the file storing the object under key,
+// rejecting keys which would escape the store's directory.
+func (f *filesystemStore) getPath(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned != "/"+key || strings.HasPrefix(filepath.Base(cleaned), tmpFilePrefix) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(f.directory, filepath.FromSlash(key)), nil
+}
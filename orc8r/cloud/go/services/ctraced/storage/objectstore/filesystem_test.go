@@ -0,0 +1,84 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstore_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestFilesystemStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ctraced_objectstore_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := objectstore.NewFilesystemStore(filepath.Join(dir, "traces"))
+	assert.NoError(t, err)
+
+	// Missing object
+	_, err = store.Get("n1/trace1.pcap")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+	assert.NoError(t, store.Delete("n1/trace1.pcap"))
+
+	// Put, get and replace
+	assert.NoError(t, store.Put("n1/trace1.pcap", bytes.NewReader([]byte("abc"))))
+	assertObject(t, store, "n1/trace1.pcap", []byte("abc"))
+	data := getTestData(100000)
+	assert.NoError(t, store.Put("n1/trace1.pcap", bytes.NewReader(data)))
+	assertObject(t, store, "n1/trace1.pcap", data)
+	assert.NoError(t, store.Put("n2/trace2.pcap", bytes.NewReader([]byte("def"))))
+
+	// Failed write leaves the previous object in place
+	err = store.Put("n2/trace2.pcap", failingReader{})
+	assert.EqualError(t, err, "failed to write object n2/trace2.pcap: read failed")
+	assertObject(t, store, "n2/trace2.pcap", []byte("def"))
+
+	// List
+	objects, err := store.List("")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 2)
+	assert.Equal(t, "n1/trace1.pcap", objects[0].Key)
+	assert.Equal(t, int64(100000), objects[0].Size)
+	assert.Equal(t, "n2/trace2.pcap", objects[1].Key)
+
+	objects, err = store.List("n2/")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+
+	// Delete
+	assert.NoError(t, store.Delete("n1/trace1.pcap"))
+	_, err = store.Get("n1/trace1.pcap")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+
+	// Keys can't escape the directory
+	err = store.Put("../trace3.pcap", bytes.NewReader([]byte("abc")))
+	assert.EqualError(t, err, `invalid object key "../trace3.pcap"`)
+	_, err = store.Get("/n1/trace1.pcap")
+	assert.EqualError(t, err, `invalid object key "/n1/trace1.pcap"`)
+}
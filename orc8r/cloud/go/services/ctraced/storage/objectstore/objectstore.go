@@ -0,0 +1,48 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package objectstore provides streaming access to flat key-value object
+// stores, such as S3-compatible services or a local directory.
+package objectstore
+
+import (
+	"io"
+	"time"
+)
+
+// ObjectStore stores objects under slash-separated keys.
+// Objects are streamed in and out, so they needn't fit in memory.
+type ObjectStore interface {
+	// Put stores the object read from r under key, replacing any existing
+	// object. The object is only visible once r has been read completely.
+	Put(key string, r io.Reader) error
+
+	// Get returns a reader of the object stored under key, which the caller
+	// must close.
+	// Returns ErrNotFound if the object does not exist.
+	Get(key string) (io.ReadCloser, error)
+
+	// Delete deletes the object stored under key.
+	// Deleting an object which does not exist is not an error.
+	Delete(key string) error
+
+	// List returns all objects whose key starts with prefix.
+	List(prefix string) ([]ObjectInfo, error)
+}
+
+// ObjectInfo describes a stored object.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
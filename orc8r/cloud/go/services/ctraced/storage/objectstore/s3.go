@@ -0,0 +1,313 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultS3Region   = "us-east-1"
+	defaultS3PartSize = 16 << 20
+
+	// maxErrorBodySize limits how much of an error response is read
+	maxErrorBodySize = 1 << 16
+)
+
+// S3Config configures a connection to an S3-compatible object store, e.g.
+// AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint is the URL of the object store, e.g.
+	// https://s3.us-west-2.amazonaws.com or http://minio:9000.
+	// Buckets are addressed path-style, i.e. as <endpoint>/<bucket>.
+	Endpoint string `yaml:"endpoint"`
+	// Region is the region requests are signed for. Defaults to us-east-1.
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	AccessKeyID     string `yaml:"accessKeyId"`
+	SecretAccessKey string `yaml:"secretAccessKey"`
+	// PartSizeBytes is the size of the chunks objects are uploaded in.
+	// Objects larger than one chunk use a multipart upload, which S3 only
+	// accepts for chunks of at least 5 MiB. Defaults to 16 MiB.
+	PartSizeBytes int `yaml:"partSizeBytes"`
+}
+
+// NewS3Store returns an object store backed by a bucket of an S3-compatible
+// object store.
+// Objects are uploaded and downloaded in chunks, so at most one chunk of an
+// object is held in memory.
+func NewS3Store(config S3Config) (ObjectStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid S3 endpoint")
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q, expected an http or https URL", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("S3 bucket must be set")
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+	if config.PartSizeBytes <= 0 {
+		config.PartSizeBytes = defaultS3PartSize
+	}
+	return &s3Store{config: config, endpoint: endpoint, client: &http.Client{}}, nil
+}
+
+type s3Store struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Store) Put(key string, r io.Reader) error {
+	br := bufio.NewReader(r)
+	buf := make([]byte, s.config.PartSizeBytes)
+	n, err := io.ReadFull(br, buf)
+	if err == nil {
+		_, err = br.Peek(1)
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// Fits in a single chunk
+		resp, err := s.do(http.MethodPut, key, nil, buf[:n])
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to put object %s", key))
+		}
+		return resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to read object %s", key))
+	}
+
+	uploadID, err := s.createMultipartUpload(key)
+	if err != nil {
+		return err
+	}
+	err = s.uploadParts(key, uploadID, br, buf)
+	if err != nil {
+		abortErr := s.abortMultipartUpload(key, uploadID)
+		if abortErr != nil {
+			glog.Errorf("Failed to abort multipart upload %s of object %s: %v", uploadID, key, abortErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// uploadParts uploads the chunk in buf, followed by the rest of r, as the
+// parts of a multipart upload and completes the upload.
+func (s *s3Store) uploadParts(key string, uploadID string, r io.Reader, buf []byte) error {
+	var parts []completedPart
+	n := len(buf)
+	for partNumber := 1; ; partNumber++ {
+		etag, err := s.uploadPart(key, uploadID, partNumber, buf[:n])
+		if err != nil {
+			return err
+		}
+		parts = append(parts, completedPart{PartNumber: partNumber, ETag: etag})
+
+		n, err = io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return errors.Wrap(err, fmt.Sprintf("failed to read object %s", key))
+		}
+	}
+	return s.completeMultipartUpload(key, uploadID, parts)
+}
+
+func (s *s3Store) Get(key string) (io.ReadCloser, error) {
+	resp, err := s.do(http.MethodGet, key, nil, nil)
+	if err == merrors.ErrNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to get object %s", key))
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, key, nil, nil)
+	if err == merrors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to delete object %s", key))
+	}
+	return resp.Body.Close()
+}
+
+func (s *s3Store) List(prefix string) ([]ObjectInfo, error) {
+	var ret []ObjectInfo
+	query := map[string]string{"list-type": "2", "prefix": prefix}
+	for {
+		result := listBucketResult{}
+		err := s.doXML(http.MethodGet, "", query, nil, &result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list objects")
+		}
+		for _, obj := range result.Contents {
+			ret = append(ret, ObjectInfo{Key: obj.Key, Size: obj.Size, LastModified: obj.LastModified})
+		}
+		if !result.IsTruncated {
+			return ret, nil
+		}
+		query["continuation-token"] = result.NextContinuationToken
+	}
+}
+
+func (s *s3Store) createMultipartUpload(key string) (string, error) {
+	result := initiateMultipartUploadResult{}
+	err := s.doXML(http.MethodPost, key, map[string]string{"uploads": ""}, nil, &result)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to start multipart upload of object %s", key))
+	}
+	return result.UploadID, nil
+}
+
+func (s *s3Store) uploadPart(key string, uploadID string, partNumber int, data []byte) (string, error) {
+	query := map[string]string{"partNumber": strconv.Itoa(partNumber), "uploadId": uploadID}
+	resp, err := s.do(http.MethodPut, key, query, data)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to upload part %d of object %s", partNumber, key))
+	}
+	defer resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+func (s *s3Store) completeMultipartUpload(key string, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return err
+	}
+	// The upload may fail after the response status was sent, in which case
+	// the response body is an error
+	result := struct {
+		XMLName xml.Name
+		s3Error
+	}{}
+	err = s.doXML(http.MethodPost, key, map[string]string{"uploadId": uploadID}, body, &result)
+	if err == nil && result.XMLName.Local == "Error" {
+		err = fmt.Errorf("%s: %s", result.Code, result.Message)
+	}
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to complete multipart upload of object %s", key))
+	}
+	return nil
+}
+
+func (s *s3Store) abortMultipartUpload(key string, uploadID string) error {
+	resp, err := s.do(http.MethodDelete, key, map[string]string{"uploadId": uploadID}, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// doXML sends a request and decodes its XML response into result.
+func (s *s3Store) doXML(method string, key string, query map[string]string, body []byte, result interface{}) error {
+	resp, err := s.do(method, key, query, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return xml.NewDecoder(resp.Body).Decode(result)
+}
+
+// do sends a signed request for the object under key, or the bucket if key
+// is empty. Error responses are returned as errors, with ErrNotFound for
+// missing objects. The caller must close the body of a successful response.
+func (s *s3Store) do(method string, key string, query map[string]string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = uriEncodePath(u.Path)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	signV4(req, hashHex(body), s.config.AccessKeyID, s.config.SecretAccessKey, s.config.Region, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	s3Err := s3Error{}
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	_ = xml.Unmarshal(respBody, &s3Err)
+	if resp.StatusCode == http.StatusNotFound && (s3Err.Code == "NoSuchKey" || s3Err.Code == "NoSuchUpload") {
+		return nil, merrors.ErrNotFound
+	}
+	if s3Err.Code != "" {
+		return nil, fmt.Errorf("%s (status %d): %s", s3Err.Code, resp.StatusCode, s3Err.Message)
+	}
+	return nil, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstore_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore/test_utils"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testBucket      = "ctraced"
+	testAccessKeyID = "access_key"
+	testPartSize    = 1024
+)
+
+func TestS3Store(t *testing.T) {
+	fake := test_utils.NewFakeS3(testBucket, testAccessKeyID)
+	defer fake.Close()
+	store := newTestS3Store(t, fake.URL, testAccessKeyID)
+
+	// Missing object
+	_, err := store.Get("n1/trace1.pcap")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+	assert.NoError(t, store.Delete("n1/trace1.pcap"))
+
+	// Single chunk
+	assert.NoError(t, store.Put("n1/trace1.pcap", bytes.NewReader([]byte("abcdefghijklmnopqrstuvwxyz\n"))))
+	assertObject(t, store, "n1/trace1.pcap", []byte("abcdefghijklmnopqrstuvwxyz\n"))
+	assert.Equal(t, 0, fake.CompletedUploads)
+
+	// Multiple chunks, with a partial last chunk
+	data := getTestData(3*testPartSize + 100)
+	assert.NoError(t, store.Put("n1/trace 2.pcap", bytes.NewReader(data)))
+	assertObject(t, store, "n1/trace 2.pcap", data)
+	assert.Equal(t, 1, fake.CompletedUploads)
+
+	// Exactly one chunk is uploaded in a single request
+	assert.NoError(t, store.Put("n2/trace3.pcap", bytes.NewReader(getTestData(testPartSize))))
+	assert.Equal(t, 1, fake.CompletedUploads)
+
+	// Exact multiple of the chunk size
+	data = getTestData(2 * testPartSize)
+	assert.NoError(t, store.Put("n2/trace3.pcap", bytes.NewReader(data)))
+	assertObject(t, store, "n2/trace3.pcap", data)
+	assert.Equal(t, 2, fake.CompletedUploads)
+
+	// List, across multiple pages
+	fake.MaxKeys = 1
+	objects, err := store.List("")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 3)
+	assert.Equal(t, "n1/trace 2.pcap", objects[0].Key)
+	assert.Equal(t, int64(3*testPartSize+100), objects[0].Size)
+	assert.Equal(t, "n1/trace1.pcap", objects[1].Key)
+	assert.Equal(t, "n2/trace3.pcap", objects[2].Key)
+
+	objects, err = store.List("n2/")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.Equal(t, "n2/trace3.pcap", objects[0].Key)
+
+	// Delete
+	assert.NoError(t, store.Delete("n1/trace1.pcap"))
+	_, err = store.Get("n1/trace1.pcap")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+}
+
+func TestS3Store_LastModified(t *testing.T) {
+	fake := test_utils.NewFakeS3(testBucket, testAccessKeyID)
+	defer fake.Close()
+	store := newTestS3Store(t, fake.URL, testAccessKeyID)
+
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	assert.NoError(t, store.Put("n1/trace1.pcap", bytes.NewReader([]byte("abc"))))
+	objects, err := store.List("")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.True(t, now.Equal(objects[0].LastModified))
+}
+
+func TestS3Store_Errors(t *testing.T) {
+	fake := test_utils.NewFakeS3(testBucket, testAccessKeyID)
+	defer fake.Close()
+
+	// Failed part upload aborts the multipart upload
+	store := newTestS3Store(t, fake.URL, testAccessKeyID)
+	fake.FailPart = 2
+	err := store.Put("n1/trace1.pcap", bytes.NewReader(getTestData(3*testPartSize)))
+	assert.EqualError(t, err, "failed to upload part 2 of object n1/trace1.pcap: InternalError (status 500): Internal Server Error")
+	assert.Equal(t, 1, fake.AbortedUploads)
+	assert.Equal(t, 0, fake.PendingUploads())
+	_, ok := fake.GetObject("n1/trace1.pcap")
+	assert.False(t, ok)
+
+	// Wrong credentials
+	store = newTestS3Store(t, fake.URL, "wrong_key")
+	_, err = store.Get("n1/trace1.pcap")
+	assert.EqualError(t, err, "failed to get object n1/trace1.pcap: InvalidAccessKeyId (status 403): Forbidden")
+
+	// Invalid config
+	_, err = objectstore.NewS3Store(objectstore.S3Config{Endpoint: "minio:9000", Bucket: testBucket})
+	assert.EqualError(t, err, `invalid S3 endpoint "minio:9000", expected an http or https URL`)
+	_, err = objectstore.NewS3Store(objectstore.S3Config{Endpoint: fake.URL})
+	assert.EqualError(t, err, "S3 bucket must be set")
+}
+
+func newTestS3Store(t *testing.T, endpoint string, accessKeyID string) objectstore.ObjectStore {
+	store, err := objectstore.NewS3Store(objectstore.S3Config{
+		Endpoint:        endpoint,
+		Bucket:          testBucket,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: "secret_key",
+		PartSizeBytes:   testPartSize,
+	})
+	assert.NoError(t, err)
+	return store
+}
+
+func assertObject(t *testing.T, store objectstore.ObjectStore, key string, expected []byte) {
+	r, err := store.Get(key)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	actual, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func getTestData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
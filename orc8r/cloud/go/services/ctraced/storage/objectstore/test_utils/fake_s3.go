@@ -0,0 +1,279 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package test_utils provides an in-memory stand-in for an S3-compatible
+// object store.
+package test_utils
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+)
+
+const lastModifiedFormat = "2006-01-02T15:04:05.000Z"
+
+// FakeS3 serves the subset of the S3 API used by the S3 object store for a
+// single bucket, addressed path-style. Requests must carry a SigV4
+// authorization header for the configured access key, and a payload hash
+// matching their body.
+// Objects are timestamped with clock.Now.
+type FakeS3 struct {
+	*httptest.Server
+
+	Bucket      string
+	AccessKeyID string
+	// MaxKeys limits the number of objects per list response
+	MaxKeys int
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	uploads map[string]map[int][]byte
+	// CompletedUploads counts the completed multipart uploads
+	CompletedUploads int
+	// AbortedUploads counts the aborted multipart uploads
+	AbortedUploads int
+	// FailPart fails the upload of this part number of multipart uploads
+	FailPart int
+}
+
+type fakeObject struct {
+	data         []byte
+	lastModified time.Time
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name         `xml:"ListBucketResult"`
+	Contents              []listedContents `xml:"Contents"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+}
+
+type listedContents struct {
+	Key          string `xml:"Key"`
+	Size         int    `xml:"Size"`
+	LastModified string `xml:"LastModified"`
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+// NewFakeS3 starts a fake S3 server, which must be closed by the caller.
+func NewFakeS3(bucket string, accessKeyID string) *FakeS3 {
+	f := &FakeS3{
+		Bucket:      bucket,
+		AccessKeyID: accessKeyID,
+		MaxKeys:     1000,
+		objects:     map[string]fakeObject{},
+		uploads:     map[string]map[int][]byte{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// GetObject returns the data of a stored object.
+func (f *FakeS3) GetObject(key string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	obj, ok := f.objects[key]
+	return obj.data, ok
+}
+
+// PendingUploads returns the number of multipart uploads which were neither
+// completed nor aborted.
+func (f *FakeS3) PendingUploads() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.uploads)
+}
+
+func (f *FakeS3) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+f.AccessKeyID+"/") {
+		writeError(w, http.StatusForbidden, "InvalidAccessKeyId")
+		return
+	}
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		writeError(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path != f.Bucket && !strings.HasPrefix(path, f.Bucket+"/") {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(path, f.Bucket), "/")
+	query := r.URL.Query()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		f.list(w, query.Get("prefix"), query.Get("continuation-token"))
+	case r.Method == http.MethodPost && query["uploads"] != nil:
+		uploadID := strconv.Itoa(len(f.uploads) + f.CompletedUploads + f.AbortedUploads + 1)
+		f.uploads[uploadID] = map[int][]byte{}
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: f.Bucket, Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPut && query.Get("uploadId") != "":
+		f.uploadPart(w, query.Get("uploadId"), query.Get("partNumber"), body)
+	case r.Method == http.MethodPost && query.Get("uploadId") != "":
+		f.completeUpload(w, key, query.Get("uploadId"), body)
+	case r.Method == http.MethodDelete && query.Get("uploadId") != "":
+		if _, ok := f.uploads[query.Get("uploadId")]; !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		delete(f.uploads, query.Get("uploadId"))
+		f.AbortedUploads++
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		f.objects[key] = fakeObject{data: body, lastModified: clock.Now()}
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Write(obj.data)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (f *FakeS3) list(w http.ResponseWriter, prefix string, continuationToken string) {
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > continuationToken {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := listBucketResult{}
+	if len(keys) > f.MaxKeys {
+		keys = keys[:f.MaxKeys]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		obj := f.objects[key]
+		result.Contents = append(result.Contents, listedContents{
+			Key:          key,
+			Size:         len(obj.data),
+			LastModified: obj.lastModified.UTC().Format(lastModifiedFormat),
+		})
+	}
+	writeXML(w, result)
+}
+
+func (f *FakeS3) uploadPart(w http.ResponseWriter, uploadID string, partNumber string, body []byte) {
+	parts, ok := f.uploads[uploadID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	number, err := strconv.Atoi(partNumber)
+	if err != nil || number < 1 {
+		writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+	if number == f.FailPart {
+		writeError(w, http.StatusInternalServerError, "InternalError")
+		return
+	}
+	parts[number] = body
+	w.Header().Set("ETag", etag(body))
+}
+
+func (f *FakeS3) completeUpload(w http.ResponseWriter, key string, uploadID string, body []byte) {
+	parts, ok := f.uploads[uploadID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	req := completeMultipartUpload{}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	var data []byte
+	for i, part := range req.Parts {
+		partData, ok := parts[part.PartNumber]
+		if part.PartNumber != i+1 || !ok || part.ETag != etag(partData) {
+			writeError(w, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		data = append(data, partData...)
+	}
+	delete(f.uploads, uploadID)
+	f.objects[key] = fakeObject{data: data, lastModified: clock.Now()}
+	f.CompletedUploads++
+	writeXML(w, struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Bucket  string
+		Key     string
+	}{Bucket: f.Bucket, Key: key})
+}
+
+func etag(data []byte) string {
+	return fmt.Sprintf("%q", fmt.Sprintf("%x", md5.Sum(data)))
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(v); err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalError")
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write(buf.Bytes())
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "%s<Error><Code>%s</Code><Message>%s</Message></Error>", xml.Header, code, http.StatusText(status))
+}
@@ -13,6 +13,11 @@ limitations under the License.
 
 package storage
 
+import (
+	"io"
+	"time"
+)
+
 // CtracedStorage is the persistence service interface for call traces.
 // All call trace accesses from ctraced service must go through this interface.
 // Call traces should be stored as .pcap files
@@ -21,10 +26,37 @@ type CtracedStorage interface {
 	// StoreCallTrace stores the call trace file
 	StoreCallTrace(networkID string, callTraceID string, data []byte) error
 
+	// StoreCallTraceFrom stores the call trace file read from r.
+	// Backends which support it write the file in chunks, so that it isn't
+	// held in memory whole.
+	StoreCallTraceFrom(networkID string, callTraceID string, r io.Reader) error
+
 	// GetCallTrace returns the call trace file
 	GetCallTrace(networkID string, callTraceID string) ([]byte, error)
 
+	// OpenCallTrace returns a reader of the call trace file, which the
+	// caller must close.
+	// Returns ErrNotFound if the call trace does not exist
+	OpenCallTrace(networkID string, callTraceID string) (io.ReadCloser, error)
+
 	// DeleteCallTrace deletes the call trace file
 	// Returns an error if the call trace does not exist
 	DeleteCallTrace(networkID string, callTraceID string) error
 }
+
+// ExpiringCtracedStorage is implemented by CtracedStorage backends which
+// support retention-based garbage collection of call trace files.
+type ExpiringCtracedStorage interface {
+	CtracedStorage
+
+	// DeleteCallTracesOlderThan deletes all call trace files which were
+	// stored before the given time, and returns the IDs of the call traces
+	// whose files were deleted.
+	DeleteCallTracesOlderThan(t time.Time) ([]CallTraceID, error)
+}
+
+// CallTraceID identifies the call trace of a network.
+type CallTraceID struct {
+	NetworkID   string
+	CallTraceID string
+}
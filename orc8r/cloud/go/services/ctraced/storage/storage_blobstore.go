@@ -14,7 +14,10 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/storage"
@@ -62,6 +65,16 @@ func (c *ctracedBlobStore) StoreCallTrace(networkID string, callTraceID string,
 	return store.Commit()
 }
 
+// StoreCallTraceFrom
+// Blobs are stored whole, so the call trace file is read into memory.
+func (c *ctracedBlobStore) StoreCallTraceFrom(networkID string, callTraceID string, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to read call trace %s", callTraceID))
+	}
+	return c.StoreCallTrace(networkID, callTraceID, data)
+}
+
 // GetCallTrace
 func (c *ctracedBlobStore) GetCallTrace(networkID string, callTraceID string) ([]byte, error) {
 	store, err := c.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
@@ -84,6 +97,16 @@ func (c *ctracedBlobStore) GetCallTrace(networkID string, callTraceID string) ([
 	return blob.Value, store.Commit()
 }
 
+// OpenCallTrace
+// Blobs are read whole, so the call trace file is read into memory.
+func (c *ctracedBlobStore) OpenCallTrace(networkID string, callTraceID string) (io.ReadCloser, error) {
+	data, err := c.GetCallTrace(networkID, callTraceID)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
 // DeleteCallTrace
 func (c *ctracedBlobStore) DeleteCallTrace(networkID string, callTraceID string) error {
 	store, err := c.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// callTraceObjectSuffix is the suffix of the keys call trace files are
+// stored under.
+const callTraceObjectSuffix = ".pcap"
+
+// NewCtracedObjectStore returns a ctraced storage implementation backed by
+// the provided object store. Call trace files are stored under
+// <network ID>/<call trace ID>.pcap, and streamed in and out of the store.
+func NewCtracedObjectStore(store objectstore.ObjectStore) ExpiringCtracedStorage {
+	return &ctracedObjectStore{store: store}
+}
+
+type ctracedObjectStore struct {
+	store objectstore.ObjectStore
+}
+
+// StoreCallTrace
+func (c *ctracedObjectStore) StoreCallTrace(networkID string, callTraceID string, data []byte) error {
+	return c.StoreCallTraceFrom(networkID, callTraceID, bytes.NewReader(data))
+}
+
+// StoreCallTraceFrom
+func (c *ctracedObjectStore) StoreCallTraceFrom(networkID string, callTraceID string, r io.Reader) error {
+	err := c.store.Put(getCallTraceKey(networkID, callTraceID), r)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to store call trace %s", callTraceID))
+	}
+	return nil
+}
+
+// GetCallTrace
+func (c *ctracedObjectStore) GetCallTrace(networkID string, callTraceID string) ([]byte, error) {
+	r, err := c.OpenCallTrace(networkID, callTraceID)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to read call trace %s", callTraceID))
+	}
+	return data, nil
+}
+
+// OpenCallTrace
+func (c *ctracedObjectStore) OpenCallTrace(networkID string, callTraceID string) (io.ReadCloser, error) {
+	r, err := c.store.Get(getCallTraceKey(networkID, callTraceID))
+	if err == merrors.ErrNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to get call trace %s", callTraceID))
+	}
+	return r, nil
+}
+
+// DeleteCallTrace
+func (c *ctracedObjectStore) DeleteCallTrace(networkID string, callTraceID string) error {
+	err := c.store.Delete(getCallTraceKey(networkID, callTraceID))
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed to delete call trace %s", callTraceID))
+	}
+	return nil
+}
+
+// DeleteCallTracesOlderThan
+func (c *ctracedObjectStore) DeleteCallTracesOlderThan(t time.Time) ([]CallTraceID, error) {
+	objects, err := c.store.List("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list call traces")
+	}
+
+	var deleted []CallTraceID
+	for _, obj := range objects {
+		if !strings.HasSuffix(obj.Key, callTraceObjectSuffix) || !obj.LastModified.Before(t) {
+			continue
+		}
+		id, err := parseCallTraceKey(obj.Key)
+		if err != nil {
+			glog.Errorf("Failed to parse expired call trace key %s: %v", obj.Key, err)
+			continue
+		}
+		err = c.store.Delete(obj.Key)
+		if err != nil {
+			glog.Errorf("Failed to delete expired call trace %s: %v", obj.Key, err)
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+	return deleted, nil
+}
+
+func getCallTraceKey(networkID string, callTraceID string) string {
+	return url.PathEscape(networkID) + "/" + url.PathEscape(callTraceID) + callTraceObjectSuffix
+}
+
+func parseCallTraceKey(key string) (CallTraceID, error) {
+	parts := strings.Split(strings.TrimSuffix(key, callTraceObjectSuffix), "/")
+	if len(parts) != 2 {
+		return CallTraceID{}, fmt.Errorf("expected <network ID>/<call trace ID>%s", callTraceObjectSuffix)
+	}
+	networkID, err := url.PathUnescape(parts[0])
+	if err != nil {
+		return CallTraceID{}, err
+	}
+	callTraceID, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return CallTraceID{}, err
+	}
+	return CallTraceID{NetworkID: networkID, CallTraceID: callTraceID}, nil
+}
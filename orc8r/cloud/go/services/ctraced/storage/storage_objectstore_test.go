@@ -0,0 +1,158 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/ctraced/obsidian/models"
+	cstorage "magma/orc8r/cloud/go/services/ctraced/storage"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore"
+	"magma/orc8r/cloud/go/services/ctraced/storage/objectstore/test_utils"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCtracedObjectStore_S3(t *testing.T) {
+	fake := test_utils.NewFakeS3("ctraced", "access_key")
+	defer fake.Close()
+	store, err := objectstore.NewS3Store(objectstore.S3Config{
+		Endpoint:        fake.URL,
+		Bucket:          "ctraced",
+		AccessKeyID:     "access_key",
+		SecretAccessKey: "secret_key",
+		PartSizeBytes:   1024,
+	})
+	assert.NoError(t, err)
+	testCtracedObjectStore(t, cstorage.NewCtracedObjectStore(store))
+
+	// Large traces are uploaded in chunks
+	assert.Equal(t, 1, fake.CompletedUploads)
+	data, ok := fake.GetObject(placeholderNetworkID + "/some_call_trace.pcap")
+	assert.True(t, ok)
+	assert.Len(t, data, 10000)
+}
+
+func TestCtracedObjectStore_Filesystem(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ctraced_storage_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := objectstore.NewFilesystemStore(dir)
+	assert.NoError(t, err)
+	testCtracedObjectStore(t, cstorage.NewCtracedObjectStore(store))
+}
+
+func TestCtracedObjectStore_GarbageCollection(t *testing.T) {
+	fake := test_utils.NewFakeS3("ctraced", "access_key")
+	defer fake.Close()
+	store, err := objectstore.NewS3Store(objectstore.S3Config{Endpoint: fake.URL, Bucket: "ctraced", AccessKeyID: "access_key"})
+	assert.NoError(t, err)
+	ctracedStore := cstorage.NewCtracedObjectStore(store)
+
+	configurator_test_init.StartTestService(t)
+	createAvailableCallTrace(t, "n1", "trace1")
+	createAvailableCallTrace(t, "n2", "trace2")
+
+	now := time.Unix(1600000000, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	assert.NoError(t, ctracedStore.StoreCallTrace("n1", "trace1", []byte("abc")))
+	clock.SetAndFreezeClock(t, now.Add(time.Hour))
+	assert.NoError(t, ctracedStore.StoreCallTrace("n2", "trace2", []byte("def")))
+
+	// Nothing expired yet
+	clock.SetAndFreezeClock(t, now.Add(24*time.Hour))
+	cstorage.CollectGarbage(ctracedStore, 24*time.Hour)
+	_, err = ctracedStore.GetCallTrace("n1", "trace1")
+	assert.NoError(t, err)
+
+	// Only the older trace expired, and is no longer available
+	clock.SetAndFreezeClock(t, now.Add(24*time.Hour+time.Second))
+	cstorage.CollectGarbage(ctracedStore, 24*time.Hour)
+	_, err = ctracedStore.GetCallTrace("n1", "trace1")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+	_, err = ctracedStore.GetCallTrace("n2", "trace2")
+	assert.NoError(t, err)
+	assert.False(t, isCallTraceAvailable(t, "n1", "trace1"))
+	assert.True(t, isCallTraceAvailable(t, "n2", "trace2"))
+
+	deleted, err := ctracedStore.DeleteCallTracesOlderThan(now.Add(2 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, []cstorage.CallTraceID{{NetworkID: "n2", CallTraceID: "trace2"}}, deleted)
+}
+
+func createAvailableCallTrace(t *testing.T, networkID string, callTraceID string) {
+	err := configurator.CreateNetwork(configurator.Network{ID: networkID}, serdes.Network)
+	assert.NoError(t, err)
+	callTrace := &models.CallTrace{
+		Config: &models.CallTraceConfig{TraceID: callTraceID, GatewayID: "gw1", TraceType: models.CallTraceConfigTraceTypeGATEWAY},
+		State:  &models.CallTraceState{CallTraceAvailable: true, CallTraceEnding: true},
+	}
+	_, err = configurator.CreateEntity(networkID, callTrace.ToEntity(), serdes.Entity)
+	assert.NoError(t, err)
+}
+
+func isCallTraceAvailable(t *testing.T, networkID string, callTraceID string) bool {
+	ent, err := configurator.LoadEntity(networkID, orc8r.CallTraceEntityType, callTraceID, configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	assert.NoError(t, err)
+	return (&models.CallTrace{}).FromEntity(ent).State.CallTraceAvailable
+}
+
+func testCtracedObjectStore(t *testing.T, store cstorage.CtracedStorage) {
+	ctid := "some_call_trace"
+
+	// Not stored yet
+	_, err := store.GetCallTrace(placeholderNetworkID, ctid)
+	assert.Exactly(t, merrors.ErrNotFound, err)
+	_, err = store.OpenCallTrace(placeholderNetworkID, ctid)
+	assert.Exactly(t, merrors.ErrNotFound, err)
+
+	// Store and get
+	assert.NoError(t, store.StoreCallTrace(placeholderNetworkID, ctid, []byte("abcdefghijklmnopqrstuvwxyz")))
+	data, err := store.GetCallTrace(placeholderNetworkID, ctid)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abcdefghijklmnopqrstuvwxyz"), data)
+
+	// Stream in and out
+	large := bytes.Repeat([]byte("0123456789"), 1000)
+	assert.NoError(t, store.StoreCallTraceFrom(placeholderNetworkID, ctid, bytes.NewReader(large)))
+	r, err := store.OpenCallTrace(placeholderNetworkID, ctid)
+	assert.NoError(t, err)
+	data, err = ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, large, data)
+
+	// IDs are escaped
+	assert.NoError(t, store.StoreCallTrace(placeholderNetworkID, "../trace/2", []byte("abc")))
+	data, err = store.GetCallTrace(placeholderNetworkID, "../trace/2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc"), data)
+
+	// Delete
+	assert.NoError(t, store.DeleteCallTrace(placeholderNetworkID, "../trace/2"))
+	_, err = store.GetCallTrace(placeholderNetworkID, "../trace/2")
+	assert.Exactly(t, merrors.ErrNotFound, err)
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventd
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/lib/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// DefaultIngestPort is the default port of the internal ingest endpoint
+	DefaultIngestPort      = 10122
+	defaultRetentionPeriod = 7 * 24 * time.Hour
+	defaultPruneInterval   = time.Hour
+
+	// EventStorageBackendElastic queries the events fluentd writes to
+	// Elasticsearch
+	EventStorageBackendElastic = "elastic"
+	// EventStorageBackendSQL stores events in the orc8r SQL database. Events
+	// must be forwarded to eventd's internal ingest endpoint.
+	EventStorageBackendSQL = "sql"
+)
+
+type Config struct {
+	// EventStorageBackend is where events are queried from, one of elastic
+	// or sql. Defaults to elastic.
+	EventStorageBackend string `yaml:"eventStorageBackend"`
+	// IngestPort is the port of the internal endpoint which stores events
+	// forwarded by fluentd, for the sql backend. It's served separately
+	// from the REST API, so it shouldn't be exposed outside the cluster.
+	// Defaults to 10122.
+	IngestPort int `yaml:"ingestPort"`
+	// RetentionDays is how long events are kept by the sql backend before
+	// they're pruned. Defaults to 7 days.
+	RetentionDays uint `yaml:"retentionDays"`
+	// PruneIntervalSecs is the interval at which expired events are pruned
+	PruneIntervalSecs uint `yaml:"pruneIntervalSecs"`
+}
+
+func GetServiceConfig() Config {
+	var serviceConfig Config
+	_, _, err := config.GetStructuredServiceConfig(orc8r.ModuleName, ServiceName, &serviceConfig)
+	if err != nil {
+		glog.Fatalf("Failed parsing the eventd config file: %v ", err)
+	}
+	return serviceConfig
+}
+
+// GetEventStorageBackend returns the configured event storage backend, or
+// the default if it isn't set.
+func (c Config) GetEventStorageBackend() string {
+	if c.EventStorageBackend == "" {
+		return EventStorageBackendElastic
+	}
+	return c.EventStorageBackend
+}
+
+// GetIngestPort returns the configured ingest port, or the default if it
+// isn't set.
+func (c Config) GetIngestPort() int {
+	if c.IngestPort == 0 {
+		return DefaultIngestPort
+	}
+	return c.IngestPort
+}
+
+// GetRetention returns how long events are kept, or the default if it isn't
+// set.
+func (c Config) GetRetention() time.Duration {
+	if c.RetentionDays == 0 {
+		return defaultRetentionPeriod
+	}
+	return time.Duration(c.RetentionDays) * 24 * time.Hour
+}
+
+// GetPruneInterval returns the configured prune interval, or the default if
+// it isn't set.
+func (c Config) GetPruneInterval() time.Duration {
+	if c.PruneIntervalSecs == 0 {
+		return defaultPruneInterval
+	}
+	return time.Duration(c.PruneIntervalSecs) * time.Second
+}
@@ -14,15 +14,23 @@ limitations under the License.
 package main
 
 import (
+	"fmt"
+
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/swagger"
 	swagger_protos "magma/orc8r/cloud/go/obsidian/swagger/protos"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/eventd"
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/ingest"
 	"magma/orc8r/cloud/go/services/eventd/obsidian/handlers"
+	eventd_storage "magma/orc8r/cloud/go/services/eventd/storage"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 )
 
 func main() {
@@ -31,7 +39,20 @@ func main() {
 		glog.Fatalf("Error creating service: %+v", err)
 	}
 
-	obsidian.AttachHandlers(srv.EchoServer, handlers.GetObsidianHandlers())
+	serviceConfig := eventd.GetServiceConfig()
+	eventStorage, err := getEventStorage(serviceConfig)
+	if err != nil {
+		glog.Errorf("Error initializing event storage: %+v", err)
+		obsidian.AttachHandlers(srv.EchoServer, handlers.GetInitErrorHandlers(err))
+	} else {
+		obsidian.AttachHandlers(srv.EchoServer, handlers.GetObsidianHandlers(eventStorage))
+		if writable, ok := eventStorage.(eventd_storage.WritableEventStorage); ok {
+			go runIngestServer(serviceConfig.GetIngestPort(), writable)
+		}
+		if expiring, ok := eventStorage.(eventd_storage.ExpiringEventStorage); ok {
+			go eventd_storage.RunPruner(expiring, serviceConfig.GetRetention(), serviceConfig.GetPruneInterval())
+		}
+	}
 
 	swagger_protos.RegisterSwaggerSpecServer(srv.GrpcServer, swagger.NewSpecServicerFromFile(eventd.ServiceName))
 
@@ -40,3 +61,32 @@ func main() {
 		glog.Fatalf("Error running eventd service: %+v", err)
 	}
 }
+
+func runIngestServer(port int, store eventd_storage.WritableEventStorage) {
+	err := ingest.Run(port, store)
+	glog.Fatalf("Error running event ingest server: %+v", err)
+}
+
+func getEventStorage(config eventd.Config) (eventd_storage.EventStorage, error) {
+	switch config.GetEventStorageBackend() {
+	case eventd.EventStorageBackendElastic:
+		client, err := eventdC.GetElasticClient()
+		if err != nil {
+			return nil, err
+		}
+		return eventd_storage.NewElasticEventStorage(client), nil
+	case eventd.EventStorageBackendSQL:
+		db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open db connection")
+		}
+		eventStorage := eventd_storage.NewSQLEventStorage(db, sqorc.GetSqlBuilder())
+		err = eventStorage.Initialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize event storage")
+		}
+		return eventStorage, nil
+	default:
+		return nil, fmt.Errorf("unknown event storage backend %q", config.EventStorageBackend)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ingest receives events forwarded by fluentd, for event storage
+// backends which eventd writes to itself.
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/eventd/storage"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+)
+
+// Path is the internal endpoint events are posted to. It's served by its own
+// server, see Run, rather than eventd's REST API server.
+const Path = "/internal/events"
+
+// Record is an event record as forwarded by fluentd's http output, using
+// the same field names as the records written to Elasticsearch.
+type Record struct {
+	NetworkID  string `json:"network_id"`
+	StreamName string `json:"stream_name"`
+	EventType  string `json:"event_type"`
+	HardwareID string `json:"hw_id"`
+	Tag        string `json:"event_tag"`
+	// Value is the event, either as a JSON object or a string containing one
+	Value json.RawMessage `json:"value"`
+	// Timestamp is the time of the event in ISO 8601 format. Defaults to the
+	// time the event is received.
+	Timestamp string `json:"@timestamp"`
+}
+
+// Run serves the ingest endpoint on the given port, separately from the
+// REST API so it's only reachable by clients which can reach the port.
+// It blocks until the server fails.
+func Run(port int, store storage.WritableEventStorage) error {
+	e := echo.New()
+	e.HideBanner = true
+	RegisterHandler(e, store)
+	return e.Start(fmt.Sprintf(":%d", port))
+}
+
+// RegisterHandler registers the ingest endpoint on the echo server.
+func RegisterHandler(e *echo.Echo, store storage.WritableEventStorage) {
+	e.POST(Path, GetIngestHandler(store))
+}
+
+// GetIngestHandler returns a handler which stores a JSON array of event
+// records.
+func GetIngestHandler(store storage.WritableEventStorage) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var records []Record
+		if err := json.NewDecoder(c.Request().Body).Decode(&records); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid event records: %v", err))
+		}
+		events := make([]storage.Event, 0, len(records))
+		for i, record := range records {
+			event, err := record.toEvent()
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid event record %d: %v", i, err))
+			}
+			events = append(events, event)
+		}
+		if err := store.StoreEvents(events); err != nil {
+			glog.Errorf("Failed to store %d events: %v", len(events), err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to store events: %v", err))
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+}
+
+func (r Record) toEvent() (storage.Event, error) {
+	if r.NetworkID == "" {
+		return storage.Event{}, fmt.Errorf("network_id is required")
+	}
+	if r.StreamName == "" {
+		return storage.Event{}, fmt.Errorf("stream_name is required")
+	}
+
+	value, err := r.getValue()
+	if err != nil {
+		return storage.Event{}, err
+	}
+
+	timestamp := clock.Now()
+	if r.Timestamp != "" {
+		dt, err := strfmt.ParseDateTime(r.Timestamp)
+		if err != nil {
+			return storage.Event{}, fmt.Errorf("invalid @timestamp: %v", err)
+		}
+		timestamp = time.Time(dt)
+	}
+
+	return storage.Event{
+		NetworkID:  r.NetworkID,
+		StreamName: r.StreamName,
+		EventType:  r.EventType,
+		HardwareID: r.HardwareID,
+		Tag:        r.Tag,
+		Timestamp:  timestamp,
+		Value:      value,
+	}, nil
+}
+
+// getValue returns the record's value as a serialized JSON object.
+func (r Record) getValue() (string, error) {
+	value := []byte(r.Value)
+	var str string
+	if err := json.Unmarshal(value, &str); err == nil {
+		value = []byte(str)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(value, &obj); err != nil || obj == nil {
+		return "", fmt.Errorf("value must be a JSON object")
+	}
+	return string(value), nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/ingest"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+	"magma/orc8r/cloud/go/services/eventd/storage"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngestHandler(t *testing.T) {
+	clock.SetAndFreezeClock(t, time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	defer clock.UnfreezeClock(t)
+
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	store := storage.NewSQLEventStorage(db, sqorc.GetSqlBuilder())
+	require.NoError(t, store.Initialize())
+
+	e := echo.New()
+	ingest.RegisterHandler(e, store)
+
+	// Value as a string, as written by gateways, and as an object
+	status := post(e, `[
+		{"network_id": "n1", "stream_name": "mme", "event_type": "attach_success", "hw_id": "hw1", "event_tag": "imsi1", "value": "{\"imsi\": \"imsi1\"}", "@timestamp": "2020-10-01T11:00:00.000+00:00"},
+		{"network_id": "n1", "stream_name": "mme", "event_type": "detach_success", "hw_id": "hw1", "event_tag": "imsi1", "value": {"imsi": "imsi1"}}
+	]`)
+	assert.Equal(t, http.StatusNoContent, status)
+
+	events, err := store.GetMultiStreamEvents(context.Background(), eventdC.MultiStreamEventQueryParams{NetworkID: "n1"})
+	assert.NoError(t, err)
+	expected := []models.Event{
+		{StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T11:00:00.000Z", Value: map[string]interface{}{"imsi": "imsi1"}},
+		{StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:00:00.000Z", Value: map[string]interface{}{"imsi": "imsi1"}},
+	}
+	assert.Equal(t, expected, events)
+
+	// Invalid records are rejected without storing any of the batch
+	assert.Equal(t, http.StatusBadRequest, post(e, `{"network_id": "n1"}`))
+	assert.Equal(t, http.StatusBadRequest, post(e, `[{"network_id": "n1", "stream_name": "mme", "value": {}}, {"stream_name": "mme", "value": {}}]`))
+	assert.Equal(t, http.StatusBadRequest, post(e, `[{"network_id": "n1", "stream_name": "mme", "value": "not json"}]`))
+	assert.Equal(t, http.StatusBadRequest, post(e, `[{"network_id": "n1", "stream_name": "mme", "value": {}, "@timestamp": "yesterday"}]`))
+
+	count, err := store.GetEventCount(context.Background(), eventdC.MultiStreamEventQueryParams{NetworkID: "n1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func post(e *echo.Echo, body string) int {
+	req := httptest.NewRequest(http.MethodPost, ingest.Path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
@@ -23,11 +23,11 @@ import (
 	"magma/orc8r/cloud/go/obsidian"
 	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
 	logH "magma/orc8r/cloud/go/services/eventd/log/handlers"
+	"magma/orc8r/cloud/go/services/eventd/storage"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/glog"
 	"github.com/labstack/echo"
-	"github.com/olivere/elastic/v7"
 )
 
 const (
@@ -59,30 +59,46 @@ const (
 )
 
 // GetObsidianHandlers returns all the obsidian handlers for eventd.
-func GetObsidianHandlers() []obsidian.Handler {
+// Events are queried from the event storage, while logs are always queried
+// from Elasticsearch.
+func GetObsidianHandlers(eventStorage storage.EventStorage) []obsidian.Handler {
 	var ret []obsidian.Handler
 
 	client, err := eventdC.GetElasticClient()
 	if err != nil {
-		ret = append(ret, setInitErrorHandlers(err)...)
-		return ret
+		ret = append(ret, getLogInitErrorHandlers(err)...)
+	} else {
+		ret = append(ret, obsidian.Handler{Path: LogSearchQueryPath, Methods: obsidian.GET, HandlerFunc: logH.GetQueryLogHandler(client)})
+		ret = append(ret, obsidian.Handler{Path: LogCountQueryPath, Methods: obsidian.GET, HandlerFunc: logH.GetCountLogHandler(client)})
 	}
 
-	ret = append(ret, obsidian.Handler{Path: LogSearchQueryPath, Methods: obsidian.GET, HandlerFunc: logH.GetQueryLogHandler(client)})
-	ret = append(ret, obsidian.Handler{Path: LogCountQueryPath, Methods: obsidian.GET, HandlerFunc: logH.GetCountLogHandler(client)})
-	ret = append(ret, obsidian.Handler{Path: EventsRootPath, Methods: obsidian.GET, HandlerFunc: GetMultiStreamEventsHandler(client)})
-	ret = append(ret, obsidian.Handler{Path: EventsCountPath, Methods: obsidian.GET, HandlerFunc: GetEventCountHandler(client)})
-	ret = append(ret, obsidian.Handler{Path: EventsPath, Methods: obsidian.GET, HandlerFunc: GetEventsHandler(client)})
+	ret = append(ret, obsidian.Handler{Path: EventsRootPath, Methods: obsidian.GET, HandlerFunc: GetMultiStreamEventsHandler(eventStorage)})
+	ret = append(ret, obsidian.Handler{Path: EventsCountPath, Methods: obsidian.GET, HandlerFunc: GetEventCountHandler(eventStorage)})
+	ret = append(ret, obsidian.Handler{Path: EventsPath, Methods: obsidian.GET, HandlerFunc: GetEventsHandler(eventStorage)})
+	if streamable, ok := eventStorage.(storage.StreamableEventStorage); ok {
+		ret = append(ret, obsidian.Handler{Path: EventsSubscribePath, Methods: obsidian.GET, HandlerFunc: GetSubscribeEventsHandler(streamable, DefaultSubscribePollInterval)})
+	} else {
+		ret = append(ret, obsidian.Handler{Path: EventsSubscribePath, Methods: obsidian.GET, HandlerFunc: getSubscribeNotSupportedHandler()})
+	}
 	return ret
 }
 
-func setInitErrorHandlers(err error) []obsidian.Handler {
+// GetInitErrorHandlers returns handlers for all eventd endpoints which fail
+// with the error eventd failed to initialize with.
+func GetInitErrorHandlers(err error) []obsidian.Handler {
+	return append(
+		getLogInitErrorHandlers(err),
+		obsidian.Handler{Path: EventsRootPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
+		obsidian.Handler{Path: EventsPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
+		obsidian.Handler{Path: EventsCountPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
+		obsidian.Handler{Path: EventsSubscribePath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
+	)
+}
+
+func getLogInitErrorHandlers(err error) []obsidian.Handler {
 	return []obsidian.Handler{
 		{Path: LogSearchQueryPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
 		{Path: LogCountQueryPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
-		{Path: EventsRootPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
-		{Path: EventsPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
-		{Path: EventsCountPath, Methods: obsidian.GET, HandlerFunc: getInitErrorHandler(err)},
 	}
 }
 
@@ -92,37 +108,37 @@ func getInitErrorHandler(err error) func(c echo.Context) error {
 	}
 }
 
-// GetEventsHandler returns a Handler that uses the provided elastic client
-func GetEventsHandler(client *elastic.Client) func(c echo.Context) error {
+// GetEventsHandler returns a Handler that uses the provided event storage
+func GetEventsHandler(eventStorage storage.EventStorage) func(c echo.Context) error {
 	return func(c echo.Context) error {
-		return EventsHandler(c, client)
+		return EventsHandler(c, eventStorage)
 	}
 }
 
-// GetMultiStreamEventsHandler returns a handler for the multi-stream elastic
+// GetMultiStreamEventsHandler returns a handler for the multi-stream
 // event query endpoint.
-func GetMultiStreamEventsHandler(client *elastic.Client) func(c echo.Context) error {
+func GetMultiStreamEventsHandler(eventStorage storage.EventStorage) func(c echo.Context) error {
 	return func(c echo.Context) error {
-		return MultiStreamEventsHandler(c, client)
+		return MultiStreamEventsHandler(c, eventStorage)
 	}
 }
 
-// GetEventCountHandler returns a handler for multi-stream elastic
+// GetEventCountHandler returns a handler for multi-stream
 // event count query endpoint.
-func GetEventCountHandler(client *elastic.Client) func(c echo.Context) error {
+func GetEventCountHandler(eventStorage storage.EventStorage) func(c echo.Context) error {
 	return func(c echo.Context) error {
-		return EventCountHandler(c, client)
+		return EventCountHandler(c, eventStorage)
 	}
 }
 
-// EventsHandler handles event querying using ES
-func EventsHandler(c echo.Context, client *elastic.Client) error {
+// EventsHandler handles event querying
+func EventsHandler(c echo.Context, eventStorage storage.EventStorage) error {
 	queryParams, err := getQueryParameters(c)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	results, err := eventdC.GetEvents(c.Request().Context(), queryParams, client)
+	results, err := eventStorage.GetEvents(c.Request().Context(), queryParams)
 	if err != nil {
 		glog.Error(err)
 		return obsidian.HttpError(err, http.StatusInternalServerError)
@@ -134,13 +150,13 @@ func EventsHandler(c echo.Context, client *elastic.Client) error {
 // primarily the ability to query across multiple streams and tags.
 // This handler will also accept an optional query size limit and offset for
 // paginated queries.
-func MultiStreamEventsHandler(c echo.Context, client *elastic.Client) error {
+func MultiStreamEventsHandler(c echo.Context, eventStorage storage.EventStorage) error {
 	params, err := getMultiStreamQueryParameters(c)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	results, err := eventdC.GetMultiStreamEvents(c.Request().Context(), params, client)
+	results, err := eventStorage.GetMultiStreamEvents(c.Request().Context(), params)
 	if err != nil {
 		glog.Error(err)
 		return obsidian.HttpError(err, http.StatusInternalServerError)
@@ -148,14 +164,14 @@ func MultiStreamEventsHandler(c echo.Context, client *elastic.Client) error {
 	return c.JSON(http.StatusOK, results)
 }
 
-// EventCountHandler handles event counting queries using ES
-func EventCountHandler(c echo.Context, client *elastic.Client) error {
+// EventCountHandler handles event counting queries
+func EventCountHandler(c echo.Context, eventStorage storage.EventStorage) error {
 	params, err := getMultiStreamQueryParameters(c)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	result, err := eventdC.GetEventCount(c.Request().Context(), params, client)
+	result, err := eventStorage.GetEventCount(c.Request().Context(), params)
 	if err != nil {
 		glog.Error(err)
 		return obsidian.HttpError(err, http.StatusInternalServerError)
@@ -15,13 +15,20 @@ package handlers
 
 import (
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/obsidian/tests"
 	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+	"magma/orc8r/cloud/go/services/eventd/storage"
+	"magma/orc8r/cloud/go/sqorc"
 
 	"github.com/labstack/echo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type queryParamTestCase struct {
@@ -182,3 +189,64 @@ func runMultiStreamQueryParamTestCase(t *testing.T, tc queryMultiStreamParamTest
 		assert.Equal(t, tc.expectedParams, params)
 	}
 }
+
+func TestEventHandlers(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	eventStorage := storage.NewSQLEventStorage(db, sqorc.GetSqlBuilder())
+	require.NoError(t, eventStorage.Initialize())
+	t0 := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	err = eventStorage.StoreEvents([]storage.Event{
+		{NetworkID: "nw1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{"imsi": "imsi1"}`},
+		{NetworkID: "nw1", StreamName: "sessiond", EventType: "session_created", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(time.Minute), Value: `{"apn": "internet"}`},
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	attach := models.Event{StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:00:00.000Z", Value: map[string]interface{}{"imsi": "imsi1"}}
+	session := models.Event{StreamName: "sessiond", EventType: "session_created", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:01:00.000Z", Value: map[string]interface{}{"apn": "internet"}}
+
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/events/nw1/mme",
+		Handler:        GetEventsHandler(eventStorage),
+		ParamNames:     []string{"network_id", "stream_name"},
+		ParamValues:    []string{"nw1", "mme"},
+		ExpectedStatus: http.StatusOK,
+		ExpectedResult: tests.JSONMarshaler([]models.Event{attach}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/events/nw1?streams=mme,sessiond&start=2020-10-01T12:00:30Z",
+		Handler:        GetMultiStreamEventsHandler(eventStorage),
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"nw1"},
+		ExpectedStatus: http.StatusOK,
+		ExpectedResult: tests.JSONMarshaler([]models.Event{session}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/events/nw1/about/count?hw_ids=hw1",
+		Handler:        GetEventCountHandler(eventStorage),
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"nw1"},
+		ExpectedStatus: http.StatusOK,
+		ExpectedResult: tests.JSONMarshaler(2),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:                 "GET",
+		URL:                    "/magma/v1/events/nw1?size=foo",
+		Handler:                GetMultiStreamEventsHandler(eventStorage),
+		ParamNames:             []string{"network_id"},
+		ParamValues:            []string{"nw1"},
+		ExpectedStatus:         http.StatusBadRequest,
+		ExpectedErrorSubstring: "invalid syntax",
+	}
+	tests.RunUnitTest(t, e, tc)
+}
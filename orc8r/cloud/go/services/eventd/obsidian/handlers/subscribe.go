@@ -0,0 +1,155 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/eventd/storage"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const (
+	EventsSubscribePath = EventsPath + obsidian.UrlSep + "subscribe"
+
+	queryParamCursor = "cursor"
+	// headerLastEventID is set by SSE clients when they reconnect
+	headerLastEventID = "Last-Event-ID"
+
+	// DefaultSubscribePollInterval is the interval at which subscriptions
+	// check the event storage for new events
+	DefaultSubscribePollInterval = time.Second
+	// subscribeBatchSize limits the number of events read from the event
+	// storage at once
+	subscribeBatchSize = 100
+	// subscribeKeepAliveInterval is the longest a subscription stays silent,
+	// so that idle connections aren't closed by proxies
+	subscribeKeepAliveInterval = 15 * time.Second
+
+	sseContentType = "text/event-stream"
+	sseEventName   = "event"
+)
+
+// GetSubscribeEventsHandler returns a handler which streams new events of a
+// single stream as server-sent events.
+func GetSubscribeEventsHandler(eventStorage storage.StreamableEventStorage, pollInterval time.Duration) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		return SubscribeEventsHandler(c, eventStorage, pollInterval)
+	}
+}
+
+func getSubscribeNotSupportedHandler() func(c echo.Context) error {
+	return func(c echo.Context) error {
+		return obsidian.HttpError(errors.New("event subscriptions are not supported by the configured event storage"), http.StatusNotImplemented)
+	}
+}
+
+// SubscribeEventsHandler streams the events matching the same query
+// parameters as EventsHandler as they are stored, using server-sent events.
+//
+// Every message carries the cursor of its event as its ID. A client resumes
+// a subscription after the last event it received by passing that cursor in
+// the Last-Event-ID header, as SSE clients do when reconnecting, or the
+// cursor query parameter. Without a cursor, only events stored after the
+// subscription starts are sent.
+func SubscribeEventsHandler(c echo.Context, eventStorage storage.StreamableEventStorage, pollInterval time.Duration) error {
+	params, err := getQueryParameters(c)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	ctx := c.Request().Context()
+
+	cursor := c.Request().Header.Get(headerLastEventID)
+	if cursor == "" {
+		cursor = c.QueryParam(queryParamCursor)
+	}
+	if cursor == "" {
+		cursor, err = eventStorage.GetLatestCursor(ctx)
+		if err != nil {
+			glog.Error(err)
+			return obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+	}
+
+	// Read the first batch before starting the stream, so that errors can
+	// still be returned as a status code
+	events, err := eventStorage.GetEventsAfter(ctx, params, cursor, subscribeBatchSize)
+	if err == storage.ErrInvalidCursor {
+		return obsidian.HttpError(fmt.Errorf("invalid cursor %q", cursor), http.StatusBadRequest)
+	}
+	if err != nil {
+		glog.Error(err)
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, sseContentType)
+	res.Header().Set("Cache-Control", "no-cache")
+	// Disable response buffering by nginx
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	// Send the starting cursor, so that a client which receives no events
+	// before reconnecting still resumes from it
+	fmt.Fprintf(res, "id: %s\n\n", cursor)
+	res.Flush()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	lastWrite := time.Now()
+	for {
+		for _, event := range events {
+			data, err := json.Marshal(event.Event)
+			if err != nil {
+				glog.Errorf("Failed to marshal event %s: %v", event.Cursor, err)
+				return nil
+			}
+			fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", event.Cursor, sseEventName, data)
+		}
+		if len(events) > 0 {
+			res.Flush()
+			lastWrite = time.Now()
+			cursor = events[len(events)-1].Cursor
+		} else if time.Since(lastWrite) >= subscribeKeepAliveInterval {
+			fmt.Fprint(res, ": keepalive\n\n")
+			res.Flush()
+			lastWrite = time.Now()
+		}
+
+		// Catch up without waiting if the batch was full
+		if len(events) < subscribeBatchSize {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		events, err = eventStorage.GetEventsAfter(ctx, params, cursor, subscribeBatchSize)
+		if err != nil {
+			// The client resumes from the last event it received when it
+			// reconnects
+			if ctx.Err() == nil {
+				glog.Errorf("Failed to read events for subscription: %v", err)
+			}
+			return nil
+		}
+	}
+}
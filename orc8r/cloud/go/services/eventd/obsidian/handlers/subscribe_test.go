@@ -0,0 +1,145 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/eventd/storage"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseMessage is a message of a server-sent event stream
+type sseMessage struct {
+	id    string
+	event string
+	data  string
+}
+
+func TestSubscribeEventsHandler(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	eventStorage := storage.NewSQLEventStorage(db, sqorc.GetSqlBuilder())
+	require.NoError(t, eventStorage.Initialize())
+	t0 := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	storeEvent := func(stream string, eventType string) {
+		err := eventStorage.StoreEvents([]storage.Event{
+			{NetworkID: "nw1", StreamName: stream, EventType: eventType, HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{}`},
+		})
+		require.NoError(t, err)
+	}
+	storeEvent("mme", "attach_success")
+
+	e := echo.New()
+	e.GET(EventsSubscribePath, GetSubscribeEventsHandler(eventStorage, 10*time.Millisecond))
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	url := srv.URL + "/magma/v1/events/nw1/mme/subscribe"
+
+	// Without a cursor, the subscription starts after the existing events
+	resp, messages, cancel := subscribe(t, url+"?event_type=attach_success", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, sseMessage{id: "1"}, <-messages)
+
+	storeEvent("sessiond", "session_created")
+	storeEvent("mme", "detach_success")
+	storeEvent("mme", "attach_success")
+	expectedData := `{"event_type":"attach_success","hardware_id":"hw1","stream_name":"mme","tag":"imsi1","timestamp":"2020-10-01T12:00:00.000Z","value":{}}`
+	assert.Equal(t, sseMessage{id: "4", event: "event", data: expectedData}, <-messages)
+	cancel()
+
+	// Reconnecting with Last-Event-ID resumes after that event
+	storeEvent("mme", "attach_success")
+	_, messages, cancel = subscribe(t, url, "2")
+	assert.Equal(t, sseMessage{id: "2"}, <-messages)
+	assert.Equal(t, "3", (<-messages).id)
+	assert.Equal(t, "4", (<-messages).id)
+	assert.Equal(t, "5", (<-messages).id)
+	cancel()
+
+	// Or with the cursor query parameter
+	_, messages, cancel = subscribe(t, url+"?cursor=4", "")
+	assert.Equal(t, sseMessage{id: "4"}, <-messages)
+	assert.Equal(t, "5", (<-messages).id)
+	cancel()
+
+	resp, _, cancel = subscribe(t, url+"?cursor=foo", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	cancel()
+}
+
+func TestGetObsidianHandlers_SubscribeNotSupported(t *testing.T) {
+	e := echo.New()
+	e.GET(EventsSubscribePath, getSubscribeNotSupportedHandler())
+	req := httptest.NewRequest(http.MethodGet, "/magma/v1/events/nw1/mme/subscribe", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
+
+// subscribe opens a subscription and returns a channel of the messages
+// received, until the returned cancel function is called.
+func subscribe(t *testing.T, url string, lastEventID string) (*http.Response, <-chan sseMessage, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	messages := make(chan sseMessage, 10)
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		close(messages)
+		return resp, messages, cancel
+	}
+	go func() {
+		defer resp.Body.Close()
+		defer close(messages)
+		scanner := bufio.NewScanner(resp.Body)
+		msg := sseMessage{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				messages <- msg
+				msg = sseMessage{}
+			case strings.HasPrefix(line, "id: "):
+				msg.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				msg.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				msg.data = strings.TrimPrefix(line, "data: ")
+			case strings.HasPrefix(line, ":"):
+			default:
+				panic(fmt.Sprintf("unexpected line %q", line))
+			}
+		}
+	}()
+	return resp, messages, cancel
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/golang/glog"
+)
+
+// RunPruner deletes the events which happened longer than the retention
+// period ago, every interval. It never returns.
+func RunPruner(store ExpiringEventStorage, retention time.Duration, interval time.Duration) {
+	for range time.Tick(interval) {
+		PruneEvents(store, retention)
+	}
+}
+
+// PruneEvents deletes the events which happened longer than the retention
+// period ago.
+func PruneEvents(store ExpiringEventStorage, retention time.Duration) {
+	deleted, err := store.DeleteEventsOlderThan(clock.Now().Add(-retention))
+	if err != nil {
+		glog.Errorf("Error deleting expired events: %v", err)
+		return
+	}
+	if deleted > 0 {
+		glog.Infof("Deleted %d expired events", deleted)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+)
+
+// EventStorage is the query interface for events reported by gateways.
+// All event queries from the eventd service must go through this interface.
+type EventStorage interface {
+	// GetEvents returns the most recent events of a single stream, newest
+	// first.
+	GetEvents(ctx context.Context, params eventdC.EventQueryParams) ([]models.Event, error)
+
+	// GetMultiStreamEvents returns a page of events across streams, oldest
+	// first.
+	GetMultiStreamEvents(ctx context.Context, params eventdC.MultiStreamEventQueryParams) ([]models.Event, error)
+
+	// GetEventCount returns the number of events matching the query,
+	// ignoring its pagination.
+	GetEventCount(ctx context.Context, params eventdC.MultiStreamEventQueryParams) (int64, error)
+}
+
+// WritableEventStorage is implemented by EventStorage backends which events
+// are written to by eventd itself, rather than by an external log pipeline.
+type WritableEventStorage interface {
+	EventStorage
+
+	// StoreEvents stores the events.
+	StoreEvents(events []Event) error
+}
+
+// ExpiringEventStorage is implemented by WritableEventStorage backends which
+// have to prune expired events themselves.
+type ExpiringEventStorage interface {
+	WritableEventStorage
+
+	// DeleteEventsOlderThan deletes the events which happened before the
+	// given time, and returns the number of deleted events.
+	DeleteEventsOlderThan(t time.Time) (int64, error)
+}
+
+// StreamableEventStorage is implemented by EventStorage backends which
+// support subscribing to newly stored events.
+// Cursors are opaque strings which order events by the time they were
+// stored, so a subscriber resuming from a cursor neither misses nor repeats
+// events.
+type StreamableEventStorage interface {
+	EventStorage
+
+	// GetLatestCursor returns a cursor positioned after all events stored
+	// so far.
+	GetLatestCursor(ctx context.Context) (string, error)
+
+	// GetEventsAfter returns up to limit events of a single stream which
+	// were stored after the cursor, in the order they were stored.
+	// Returns ErrInvalidCursor if the cursor is malformed.
+	GetEventsAfter(ctx context.Context, params eventdC.EventQueryParams, cursor string, limit int) ([]CursorEvent, error)
+}
+
+// CursorEvent is an event along with the cursor positioned right after it.
+type CursorEvent struct {
+	Cursor string
+	Event  models.Event
+}
+
+// ErrInvalidCursor indicates a cursor wasn't issued by the event storage.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Event is an event as reported by a gateway.
+type Event struct {
+	NetworkID  string
+	StreamName string
+	EventType  string
+	HardwareID string
+	Tag        string
+	Timestamp  time.Time
+	// Value is the event serialized as JSON
+	Value string
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// NewElasticEventStorage returns an event storage implementation which
+// queries the events fluentd writes to Elasticsearch.
+func NewElasticEventStorage(client *elastic.Client) EventStorage {
+	return &elasticEventStorage{client: client}
+}
+
+type elasticEventStorage struct {
+	client *elastic.Client
+}
+
+func (e *elasticEventStorage) GetEvents(ctx context.Context, params eventdC.EventQueryParams) ([]models.Event, error) {
+	return eventdC.GetEvents(ctx, params, e.client)
+}
+
+func (e *elasticEventStorage) GetMultiStreamEvents(ctx context.Context, params eventdC.MultiStreamEventQueryParams) ([]models.Event, error) {
+	return eventdC.GetMultiStreamEvents(ctx, params, e.client)
+}
+
+func (e *elasticEventStorage) GetEventCount(ctx context.Context, params eventdC.MultiStreamEventQueryParams) (int64, error) {
+	return eventdC.GetEventCount(ctx, params, e.client)
+}
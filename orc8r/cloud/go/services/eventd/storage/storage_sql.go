@@ -0,0 +1,377 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+const (
+	eventsTableName     = "eventd_events"
+	eventsIndexName     = "eventd_events_network_time_idx"
+	eventsTimeIndexName = "eventd_events_time_idx"
+	// eventsSeqTableName holds the counter events' sequence numbers are
+	// assigned from
+	eventsSeqTableName = "eventd_events_seq"
+
+	seqCol        = "seq"
+	networkIDCol  = "network_id"
+	streamNameCol = "stream_name"
+	eventTypeCol  = "event_type"
+	hwIDCol       = "hw_id"
+	tagCol        = "tag"
+	timestampCol  = "timestamp_ms"
+	valueCol      = "value"
+	counterIDCol  = "id"
+
+	// counterID is the ID of the only row of the sequence counter table
+	counterID = 0
+
+	// defaultQuerySize is the number of events returned by single stream
+	// queries, matching the Elasticsearch query default
+	defaultQuerySize = 50
+)
+
+// NewSQLEventStorage returns an event storage implementation backed by a
+// single SQL table.
+// Timestamps are stored with millisecond precision. Each event is assigned
+// the next value of a sequence counter, which serves as its stream cursor.
+func NewSQLEventStorage(db *sql.DB, builder sqorc.StatementBuilder) *SQLEventStorage {
+	return &SQLEventStorage{db: db, builder: builder}
+}
+
+// SQLEventStorage is a WritableEventStorage and StreamableEventStorage
+// backed by a SQL table.
+type SQLEventStorage struct {
+	db      *sql.DB
+	builder sqorc.StatementBuilder
+}
+
+// Initialize creates the events table, its indexes and its sequence counter
+// if they don't exist.
+func (s *SQLEventStorage) Initialize() error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.CreateTable(eventsTableName).
+			IfNotExists().
+			Column(seqCol).Type(sqorc.ColumnTypeBigInt).NotNull().PrimaryKey().EndColumn().
+			Column(networkIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(streamNameCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(eventTypeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(hwIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(tagCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(timestampCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+			Column(valueCol).Type(sqorc.ColumnTypeBytes).NotNull().EndColumn().
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "initialize events table")
+		}
+		_, err = s.builder.CreateIndex(eventsIndexName).
+			IfNotExists().
+			On(eventsTableName).
+			Columns(networkIDCol, timestampCol).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "initialize events table index")
+		}
+		_, err = s.builder.CreateIndex(eventsTimeIndexName).
+			IfNotExists().
+			On(eventsTableName).
+			Columns(timestampCol).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "initialize events table time index")
+		}
+
+		_, err = s.builder.CreateTable(eventsSeqTableName).
+			IfNotExists().
+			Column(counterIDCol).Type(sqorc.ColumnTypeInt).NotNull().PrimaryKey().EndColumn().
+			Column(seqCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "initialize events sequence table")
+		}
+		// Start after any events stored before the counter was introduced
+		var maxSeq int64
+		err = s.builder.Select(fmt.Sprintf("COALESCE(MAX(%s), 0)", seqCol)).
+			From(eventsTableName).
+			RunWith(tx).
+			QueryRow().
+			Scan(&maxSeq)
+		if err != nil {
+			return nil, errors.Wrap(err, "get last event sequence number")
+		}
+		_, err = s.builder.Insert(eventsSeqTableName).
+			Columns(counterIDCol, seqCol).
+			Values(counterID, maxSeq).
+			OnConflict(nil, counterIDCol).
+			RunWith(tx).
+			Exec()
+		return nil, errors.Wrap(err, "initialize events sequence")
+	}
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+// StoreEvents inserts the events in a single transaction.
+// Incrementing the sequence counter locks its row until the transaction
+// completes, so concurrent calls are serialized and events become visible in
+// sequence order.
+func (s *SQLEventStorage) StoreEvents(events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.Update(eventsSeqTableName).
+			Set(seqCol, squirrel.Expr(fmt.Sprintf("%s + ?", seqCol), len(events))).
+			Where(squirrel.Eq{counterIDCol: counterID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "increment event sequence")
+		}
+		lastSeq, err := s.getLastSeq(context.Background(), tx)
+		if err != nil {
+			return nil, err
+		}
+
+		firstSeq := lastSeq - int64(len(events)) + 1
+		builder := s.builder.Insert(eventsTableName).
+			Columns(seqCol, networkIDCol, streamNameCol, eventTypeCol, hwIDCol, tagCol, timestampCol, valueCol)
+		for i, e := range events {
+			builder = builder.Values(firstSeq+int64(i), e.NetworkID, e.StreamName, e.EventType, e.HardwareID, e.Tag, toMillis(e.Timestamp), []byte(e.Value))
+		}
+		_, err = builder.RunWith(tx).Exec()
+		return nil, errors.Wrap(err, "insert events")
+	}
+	_, err := sqorc.ExecInTx(s.db, nil, nil, txFn)
+	return err
+}
+
+// DeleteEventsOlderThan deletes the events which happened before the given
+// time, and returns the number of deleted events.
+func (s *SQLEventStorage) DeleteEventsOlderThan(t time.Time) (int64, error) {
+	res, err := s.builder.Delete(eventsTableName).
+		Where(squirrel.Lt{timestampCol: toMillis(t)}).
+		RunWith(s.db).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "delete events")
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get number of deleted events")
+	}
+	return deleted, nil
+}
+
+func (s *SQLEventStorage) GetEvents(ctx context.Context, params eventdC.EventQueryParams) ([]models.Event, error) {
+	query := s.selectEvents().
+		Where(getSingleStreamFilter(params)).
+		OrderBy(timestampCol + " DESC").
+		Limit(defaultQuerySize)
+	return s.queryEvents(ctx, query)
+}
+
+func (s *SQLEventStorage) GetMultiStreamEvents(ctx context.Context, params eventdC.MultiStreamEventQueryParams) ([]models.Event, error) {
+	query := s.selectEvents().
+		Where(getMultiStreamFilter(params)).
+		OrderBy(timestampCol, streamNameCol, eventTypeCol, hwIDCol)
+	if params.From > 0 {
+		query = query.Offset(uint64(params.From))
+	}
+	if params.Size > 0 {
+		query = query.Limit(uint64(params.Size))
+	}
+	return s.queryEvents(ctx, query)
+}
+
+func (s *SQLEventStorage) GetEventCount(ctx context.Context, params eventdC.MultiStreamEventQueryParams) (int64, error) {
+	var count int64
+	err := s.builder.Select("COUNT(*)").
+		From(eventsTableName).
+		Where(getMultiStreamFilter(params)).
+		RunWith(s.db).
+		QueryRowContext(ctx).
+		Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "count events")
+	}
+	return count, nil
+}
+
+func (s *SQLEventStorage) GetLatestCursor(ctx context.Context) (string, error) {
+	lastSeq, err := s.getLastSeq(ctx, s.db)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(lastSeq, 10), nil
+}
+
+func (s *SQLEventStorage) GetEventsAfter(ctx context.Context, params eventdC.EventQueryParams, cursor string, limit int) ([]CursorEvent, error) {
+	afterSeq, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || afterSeq < 0 {
+		return nil, ErrInvalidCursor
+	}
+	rows, err := s.builder.Select(seqCol, streamNameCol, eventTypeCol, hwIDCol, tagCol, timestampCol, valueCol).
+		From(eventsTableName).
+		Where(squirrel.And{getSingleStreamFilter(params), squirrel.Gt{seqCol: afterSeq}}).
+		OrderBy(seqCol).
+		Limit(uint64(limit)).
+		RunWith(s.db).
+		QueryContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query events")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "GetEventsAfter")
+
+	results := []CursorEvent{}
+	for rows.Next() {
+		var seq int64
+		event, err := scanEvent(rows, &seq)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, CursorEvent{Cursor: strconv.FormatInt(seq, 10), Event: event})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate event rows")
+	}
+	return results, nil
+}
+
+// getLastSeq returns the sequence number of the last stored event, or 0 if
+// there are none.
+func (s *SQLEventStorage) getLastSeq(ctx context.Context, runner squirrel.BaseRunner) (int64, error) {
+	var lastSeq int64
+	err := s.builder.Select(seqCol).
+		From(eventsSeqTableName).
+		Where(squirrel.Eq{counterIDCol: counterID}).
+		RunWith(runner).
+		QueryRowContext(ctx).
+		Scan(&lastSeq)
+	if err != nil {
+		return 0, errors.Wrap(err, "get last event sequence number")
+	}
+	return lastSeq, nil
+}
+
+func (s *SQLEventStorage) selectEvents() squirrel.SelectBuilder {
+	return s.builder.Select(streamNameCol, eventTypeCol, hwIDCol, tagCol, timestampCol, valueCol).
+		From(eventsTableName)
+}
+
+func (s *SQLEventStorage) queryEvents(ctx context.Context, query squirrel.SelectBuilder) ([]models.Event, error) {
+	rows, err := query.RunWith(s.db).QueryContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query events")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "queryEvents")
+
+	results := []models.Event{}
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate event rows")
+	}
+	return results, nil
+}
+
+// scanEvent scans an event row selected by selectEvents, preceded by any
+// columns scanned into prefix.
+func scanEvent(rows *sql.Rows, prefix ...interface{}) (models.Event, error) {
+	var (
+		event       models.Event
+		timestampMs int64
+		value       []byte
+	)
+	dest := append(prefix, &event.StreamName, &event.EventType, &event.HardwareID, &event.Tag, &timestampMs, &value)
+	if err := rows.Scan(dest...); err != nil {
+		return models.Event{}, errors.Wrap(err, "scan event row")
+	}
+	var eventValue map[string]interface{}
+	if err := json.Unmarshal(value, &eventValue); err != nil {
+		return models.Event{}, fmt.Errorf("unable to unmarshal JSON from event value %s: %s", value, err)
+	}
+	event.Timestamp = strfmt.DateTime(fromMillis(timestampMs)).String()
+	event.Value = eventValue
+	return event, nil
+}
+
+func getSingleStreamFilter(params eventdC.EventQueryParams) squirrel.Eq {
+	where := squirrel.Eq{networkIDCol: params.NetworkID, streamNameCol: params.StreamName}
+	if params.EventType != "" {
+		where[eventTypeCol] = params.EventType
+	}
+	if params.HardwareID != "" {
+		where[hwIDCol] = params.HardwareID
+	}
+	if params.Tag != "" {
+		where[tagCol] = params.Tag
+	}
+	return where
+}
+
+func getMultiStreamFilter(params eventdC.MultiStreamEventQueryParams) squirrel.And {
+	where := squirrel.Eq{networkIDCol: params.NetworkID}
+	if len(params.Streams) > 0 {
+		where[streamNameCol] = params.Streams
+	}
+	if len(params.Events) > 0 {
+		where[eventTypeCol] = params.Events
+	}
+	if len(params.Tags) > 0 {
+		where[tagCol] = params.Tags
+	}
+	if len(params.HardwareIDs) > 0 {
+		where[hwIDCol] = params.HardwareIDs
+	}
+	ret := squirrel.And{where}
+	if params.Start != nil {
+		ret = append(ret, squirrel.GtOrEq{timestampCol: toMillis(*params.Start)})
+	}
+	if params.End != nil {
+		ret = append(ret, squirrel.LtOrEq{timestampCol: toMillis(*params.End)})
+	}
+	return ret
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventdC "magma/orc8r/cloud/go/services/eventd/eventd_client"
+	"magma/orc8r/cloud/go/services/eventd/obsidian/models"
+	"magma/orc8r/cloud/go/services/eventd/storage"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLEventStorage(t *testing.T) {
+	store := newTestStorage(t)
+	ctx := context.Background()
+	t0 := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err := store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{"imsi": "imsi1"}`},
+		{NetworkID: "n1", StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(time.Minute), Value: `{"imsi": "imsi1"}`},
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw2", Tag: "imsi2", Timestamp: t0.Add(2 * time.Minute), Value: `{"imsi": "imsi2"}`},
+		{NetworkID: "n1", StreamName: "sessiond", EventType: "session_created", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(3 * time.Minute), Value: `{"apn": "internet"}`},
+		{NetworkID: "n2", StreamName: "mme", EventType: "attach_success", HardwareID: "hw3", Tag: "imsi3", Timestamp: t0, Value: `{}`},
+	})
+	require.NoError(t, err)
+
+	attach1 := models.Event{StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:00:00.000Z", Value: map[string]interface{}{"imsi": "imsi1"}}
+	detach1 := models.Event{StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:01:00.000Z", Value: map[string]interface{}{"imsi": "imsi1"}}
+	attach2 := models.Event{StreamName: "mme", EventType: "attach_success", HardwareID: "hw2", Tag: "imsi2", Timestamp: "2020-10-01T12:02:00.000Z", Value: map[string]interface{}{"imsi": "imsi2"}}
+	session1 := models.Event{StreamName: "sessiond", EventType: "session_created", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:03:00.000Z", Value: map[string]interface{}{"apn": "internet"}}
+
+	// Single stream, newest first
+	events, err := store.GetEvents(ctx, eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{attach2, detach1, attach1}, events)
+
+	events, err = store.GetEvents(ctx, eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{attach1}, events)
+
+	events, err = store.GetEvents(ctx, eventdC.EventQueryParams{NetworkID: "n3", StreamName: "mme"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{}, events)
+
+	// Multiple streams, oldest first
+	events, err = store.GetMultiStreamEvents(ctx, eventdC.MultiStreamEventQueryParams{NetworkID: "n1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{attach1, detach1, attach2, session1}, events)
+
+	events, err = store.GetMultiStreamEvents(ctx, eventdC.MultiStreamEventQueryParams{
+		NetworkID:   "n1",
+		Streams:     []string{"mme", "sessiond"},
+		Events:      []string{"attach_success", "session_created"},
+		HardwareIDs: []string{"hw1"},
+		Tags:        []string{"imsi1", "imsi2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{attach1, session1}, events)
+
+	// Time range is inclusive
+	start, end := t0.Add(time.Minute), t0.Add(2*time.Minute)
+	params := eventdC.MultiStreamEventQueryParams{NetworkID: "n1", Start: &start, End: &end}
+	events, err = store.GetMultiStreamEvents(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{detach1, attach2}, events)
+	count, err := store.GetEventCount(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Pagination doesn't affect the count
+	params = eventdC.MultiStreamEventQueryParams{NetworkID: "n1", From: 1, Size: 2}
+	events, err = store.GetMultiStreamEvents(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Event{detach1, attach2}, events)
+	count, err = store.GetEventCount(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestSQLEventStorage_GetEventsAfter(t *testing.T) {
+	store := newTestStorage(t)
+	ctx := context.Background()
+	t0 := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	cursor, err := store.GetLatestCursor(ctx)
+	assert.NoError(t, err)
+	events, err := store.GetEventsAfter(ctx, eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme"}, cursor, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	// Events are ordered by when they were stored, not by their timestamp
+	err = store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(time.Minute), Value: `{}`},
+		{NetworkID: "n1", StreamName: "sessiond", EventType: "session_created", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{}`},
+	})
+	require.NoError(t, err)
+	err = store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{}`},
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw2", Tag: "imsi2", Timestamp: t0.Add(2 * time.Minute), Value: `{}`},
+	})
+	require.NoError(t, err)
+
+	attach1 := models.Event{StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:01:00.000Z", Value: map[string]interface{}{}}
+	detach1 := models.Event{StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:00:00.000Z", Value: map[string]interface{}{}}
+	attach2 := models.Event{StreamName: "mme", EventType: "attach_success", HardwareID: "hw2", Tag: "imsi2", Timestamp: "2020-10-01T12:02:00.000Z", Value: map[string]interface{}{}}
+
+	params := eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme"}
+	events, err = store.GetEventsAfter(ctx, params, cursor, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.CursorEvent{{Cursor: "1", Event: attach1}, {Cursor: "3", Event: detach1}, {Cursor: "4", Event: attach2}}, events)
+
+	// Resume from the middle, with a limit
+	events, err = store.GetEventsAfter(ctx, params, "1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.CursorEvent{{Cursor: "3", Event: detach1}}, events)
+
+	params.EventType = "attach_success"
+	events, err = store.GetEventsAfter(ctx, params, "1", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.CursorEvent{{Cursor: "4", Event: attach2}}, events)
+
+	cursor, err = store.GetLatestCursor(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "4", cursor)
+
+	_, err = store.GetEventsAfter(ctx, params, "foo", 10)
+	assert.Equal(t, storage.ErrInvalidCursor, err)
+	_, err = store.GetEventsAfter(ctx, params, "-1", 10)
+	assert.Equal(t, storage.ErrInvalidCursor, err)
+}
+
+func TestSQLEventStorage_DeleteEventsOlderThan(t *testing.T) {
+	store := newTestStorage(t)
+	ctx := context.Background()
+	t0 := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err := store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0, Value: `{}`},
+		{NetworkID: "n2", StreamName: "mme", EventType: "attach_success", HardwareID: "hw2", Tag: "imsi2", Timestamp: t0.Add(time.Minute), Value: `{}`},
+		{NetworkID: "n1", StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(2 * time.Minute), Value: `{}`},
+	})
+	require.NoError(t, err)
+
+	deleted, err := store.DeleteEventsOlderThan(t0.Add(2 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	events, err := store.GetEventsAfter(ctx, eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme"}, "0", 10)
+	assert.NoError(t, err)
+	detach1 := models.Event{StreamName: "mme", EventType: "detach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: "2020-10-01T12:02:00.000Z", Value: map[string]interface{}{}}
+	assert.Equal(t, []storage.CursorEvent{{Cursor: "3", Event: detach1}}, events)
+
+	// Sequence numbers of deleted events aren't reused
+	deleted, err = store.DeleteEventsOlderThan(t0.Add(3 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	err = store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: t0.Add(3 * time.Minute), Value: `{}`},
+	})
+	require.NoError(t, err)
+	cursor, err := store.GetLatestCursor(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "4", cursor)
+
+	deleted, err = store.DeleteEventsOlderThan(t0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
+
+func TestSQLEventStorage_InvalidValue(t *testing.T) {
+	store := newTestStorage(t)
+	err := store.StoreEvents([]storage.Event{
+		{NetworkID: "n1", StreamName: "mme", EventType: "attach_success", HardwareID: "hw1", Tag: "imsi1", Timestamp: time.Now(), Value: "not json"},
+	})
+	require.NoError(t, err)
+
+	_, err = store.GetEvents(context.Background(), eventdC.EventQueryParams{NetworkID: "n1", StreamName: "mme"})
+	assert.Error(t, err)
+}
+
+func newTestStorage(t *testing.T) *storage.SQLEventStorage {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	store := storage.NewSQLEventStorage(db, sqorc.GetSqlBuilder())
+	require.NoError(t, store.Initialize())
+	// Initialize is idempotent
+	require.NoError(t, store.Initialize())
+	return store
+}
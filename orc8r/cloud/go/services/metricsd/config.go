@@ -0,0 +1,41 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricsd
+
+import (
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	"magma/orc8r/lib/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+// Config holds the structured parts of the metricsd.yml config file.
+type Config struct {
+	// RemoteWrite configures the built-in remote write exporter, which is
+	// enabled when its URL is set
+	RemoteWrite exporters.RemoteWriteConfig `yaml:"remoteWrite"`
+	// OTLP configures the built-in OpenTelemetry exporter, which is enabled
+	// when its endpoint is set
+	OTLP exporters.OTLPConfig `yaml:"otlp"`
+}
+
+func GetServiceConfig() Config {
+	var serviceConfig Config
+	_, _, err := config.GetStructuredServiceConfig(orc8r.ModuleName, ServiceName, &serviceConfig)
+	if err != nil {
+		glog.Fatalf("Failed parsing the metricsd config file: %v ", err)
+	}
+	return serviceConfig
+}
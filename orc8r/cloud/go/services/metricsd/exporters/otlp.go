@@ -0,0 +1,373 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/lib/go/metrics"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	prometheus_models "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// OTLPProtocolGRPC exports to a collector's OTLP gRPC receiver
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP exports to a collector's OTLP HTTP receiver, with
+	// protobuf-encoded requests
+	OTLPProtocolHTTP = "http"
+
+	defaultOTLPTimeout = 10 * time.Second
+
+	// otlpScopeName is the instrumentation scope of exported metrics
+	otlpScopeName = "magma/metricsd"
+
+	// Resource attributes describing where metrics come from
+	OTLPAttributeServiceName = "service.name"
+	OTLPAttributeHostName    = "host.name"
+	OTLPAttributeNetworkID   = "magma.network.id"
+	OTLPAttributeGatewayID   = "magma.gateway.id"
+
+	// otlpCloudServiceName is the service name of cloud metrics
+	otlpCloudServiceName = "orc8r"
+	// maxOTLPErrorBodySize limits how much of an error response is read
+	maxOTLPErrorBodySize = 1 << 10
+)
+
+// OTLPConfig configures an exporter which sends metrics to an OpenTelemetry
+// collector with the OTLP protocol.
+type OTLPConfig struct {
+	// Endpoint is the collector's address: host:port for gRPC, e.g.
+	// otel-collector:4317, or the URL of its metrics endpoint for HTTP, e.g.
+	// http://otel-collector:4318/v1/metrics
+	Endpoint string `yaml:"endpoint"`
+	// Protocol is grpc or http. Defaults to grpc.
+	Protocol string `yaml:"protocol"`
+	// Insecure disables TLS for gRPC. HTTP uses TLS for https endpoints.
+	Insecure bool `yaml:"insecure"`
+	// Headers are sent with every export, e.g. for authorization
+	Headers map[string]string `yaml:"headers"`
+	// TimeoutSecs limits the duration of each export. Defaults to 10
+	// seconds.
+	TimeoutSecs uint `yaml:"timeoutSecs"`
+}
+
+// otlpExporter converts submitted metrics to OTLP and exports them to a
+// collector as they're submitted. Batching and retries are left to the
+// collector.
+type otlpExporter struct {
+	config  OTLPConfig
+	timeout time.Duration
+	// conn is the connection to the collector, for gRPC
+	conn *grpc.ClientConn
+	// client sends requests to the collector, for HTTP
+	client *http.Client
+}
+
+// NewOTLPExporter returns an exporter sending metrics to the configured
+// OpenTelemetry collector.
+// Each metric's context is mapped to the attributes of its OTLP resource:
+//   - cloud metrics have service.name orc8r and the host.name of the
+//     originating host
+//   - gateway metrics have the magma.network.id and magma.gateway.id of the
+//     originating gateway
+//   - pushed metrics have the magma.network.id they were pushed for, and the
+//     magma.gateway.id from their gatewayID label, if any
+func NewOTLPExporter(config OTLPConfig) (Exporter, error) {
+	e := &otlpExporter{config: config, timeout: defaultOTLPTimeout}
+	if config.TimeoutSecs > 0 {
+		e.timeout = time.Duration(config.TimeoutSecs) * time.Second
+	}
+
+	switch config.Protocol {
+	case OTLPProtocolGRPC, "":
+		if config.Endpoint == "" {
+			return nil, errors.New("OTLP endpoint must be set")
+		}
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))}
+		if config.Insecure {
+			opts = []grpc.DialOption{grpc.WithInsecure()}
+		}
+		conn, err := grpc.Dial(config.Endpoint, opts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to dial OTLP collector")
+		}
+		e.conn = conn
+	case OTLPProtocolHTTP:
+		u, err := url.Parse(config.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, errors.New("OTLP HTTP endpoint must be an http or https URL")
+		}
+		e.client = &http.Client{Timeout: e.timeout}
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, expected %s or %s", config.Protocol, OTLPProtocolGRPC, OTLPProtocolHTTP)
+	}
+	return e, nil
+}
+
+func (e *otlpExporter) Submit(metrics []MetricAndContext) error {
+	nowNano := uint64(clock.Now().UnixNano())
+	req := &otlpExportMetricsServiceRequest{ResourceMetrics: getOTLPResourceMetrics(metrics, nowNano)}
+	if len(req.ResourceMetrics) == 0 {
+		return nil
+	}
+	if e.conn != nil {
+		return e.exportGRPC(req)
+	}
+	return e.exportHTTP(req)
+}
+
+func (e *otlpExporter) exportGRPC(req *otlpExportMetricsServiceRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	if len(e.config.Headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(e.config.Headers))
+	}
+	err := e.conn.Invoke(ctx, otlpExportMethod, req, &otlpExportMetricsServiceResponse{})
+	if err != nil {
+		return errors.Wrap(err, "failed to export metrics to OTLP collector")
+	}
+	return nil
+}
+
+func (e *otlpExporter) exportHTTP(req *otlpExportMetricsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal OTLP export request")
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, e.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, value := range e.config.Headers {
+		httpReq.Header.Set(name, value)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := e.client.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to export metrics to OTLP collector")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxOTLPErrorBodySize))
+	return fmt.Errorf("OTLP export failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+}
+
+// otlpRequestBuilder groups data points by resource, and by metric within
+// each resource, in the order they're added.
+type otlpRequestBuilder struct {
+	resources      []*otlpResourceMetrics
+	resourcesByKey map[string]*otlpResourceMetrics
+	metricsByKey   map[string]*otlpMetric
+	nowNano        uint64
+}
+
+func getOTLPResourceMetrics(metrics []MetricAndContext, nowNano uint64) []*otlpResourceMetrics {
+	b := &otlpRequestBuilder{
+		resourcesByKey: map[string]*otlpResourceMetrics{},
+		metricsByKey:   map[string]*otlpMetric{},
+		nowNano:        nowNano,
+	}
+	for _, metric := range metrics {
+		b.add(metric)
+	}
+	return b.resources
+}
+
+func (b *otlpRequestBuilder) add(metricAndContext MetricAndContext) {
+	family := metricAndContext.Family
+	name := metricAndContext.Context.MetricName
+	for _, metric := range family.GetMetric() {
+		resourceAttrs, pointAttrs := getOTLPAttributes(metricAndContext.Context, metric.GetLabel())
+		otlpMetric := b.getMetric(resourceAttrs, name, family)
+		timeNano := b.nowNano
+		if metric.GetTimestampMs() != 0 {
+			timeNano = uint64(metric.GetTimestampMs()) * uint64(time.Millisecond)
+		}
+
+		switch family.GetType() {
+		case prometheus_models.MetricType_COUNTER:
+			if otlpMetric.Sum == nil {
+				otlpMetric.Sum = &otlpSum{AggregationTemporality: otlpAggregationTemporalityCumulative, IsMonotonic: true}
+			}
+			otlpMetric.Sum.DataPoints = append(otlpMetric.Sum.DataPoints, makeOTLPNumberDataPoint(pointAttrs, timeNano, metric.GetCounter().GetValue()))
+		case prometheus_models.MetricType_GAUGE, prometheus_models.MetricType_UNTYPED:
+			value := metric.GetGauge().GetValue()
+			if family.GetType() == prometheus_models.MetricType_UNTYPED {
+				value = metric.GetUntyped().GetValue()
+			}
+			if otlpMetric.Gauge == nil {
+				otlpMetric.Gauge = &otlpGauge{}
+			}
+			otlpMetric.Gauge.DataPoints = append(otlpMetric.Gauge.DataPoints, makeOTLPNumberDataPoint(pointAttrs, timeNano, value))
+		case prometheus_models.MetricType_HISTOGRAM:
+			if otlpMetric.Histogram == nil {
+				otlpMetric.Histogram = &otlpHistogram{AggregationTemporality: otlpAggregationTemporalityCumulative}
+			}
+			otlpMetric.Histogram.DataPoints = append(otlpMetric.Histogram.DataPoints, makeOTLPHistogramDataPoint(pointAttrs, timeNano, metric.GetHistogram()))
+		case prometheus_models.MetricType_SUMMARY:
+			if otlpMetric.Summary == nil {
+				otlpMetric.Summary = &otlpSummary{}
+			}
+			otlpMetric.Summary.DataPoints = append(otlpMetric.Summary.DataPoints, makeOTLPSummaryDataPoint(pointAttrs, timeNano, metric.GetSummary()))
+		}
+	}
+}
+
+// getMetric returns the metric of the resource with the passed attributes,
+// adding the resource and metric if they don't exist yet.
+func (b *otlpRequestBuilder) getMetric(resourceAttrs []*otlpKeyValue, name string, family *prometheus_models.MetricFamily) *otlpMetric {
+	resourceKey := getOTLPAttributesKey(resourceAttrs)
+	resource, ok := b.resourcesByKey[resourceKey]
+	if !ok {
+		resource = &otlpResourceMetrics{
+			Resource:     &otlpResource{Attributes: resourceAttrs},
+			ScopeMetrics: []*otlpScopeMetrics{{Scope: &otlpInstrumentationScope{Name: otlpScopeName}}},
+		}
+		b.resourcesByKey[resourceKey] = resource
+		b.resources = append(b.resources, resource)
+	}
+
+	// Families of different types may share a name, but not a metric
+	metricKey := fmt.Sprintf("%s\x00%s\x00%s", resourceKey, name, family.GetType())
+	metric, ok := b.metricsByKey[metricKey]
+	if !ok {
+		metric = &otlpMetric{Name: name, Description: family.GetHelp()}
+		b.metricsByKey[metricKey] = metric
+		scope := resource.ScopeMetrics[0]
+		scope.Metrics = append(scope.Metrics, metric)
+	}
+	return metric
+}
+
+// getOTLPAttributes returns the resource attributes identifying where a
+// metric comes from, and the attributes of its data point. Labels which
+// duplicate resource attributes aren't included in the data point's
+// attributes.
+func getOTLPAttributes(ctx MetricContext, labels []*prometheus_models.LabelPair) ([]*otlpKeyValue, []*otlpKeyValue) {
+	labelsByName := map[string]string{}
+	for _, l := range labels {
+		labelsByName[l.GetName()] = l.GetValue()
+	}
+
+	var resourceAttrs []*otlpKeyValue
+	var resourceLabels []string
+	switch additionalCtx := ctx.AdditionalContext.(type) {
+	case *CloudMetricContext:
+		resourceAttrs = []*otlpKeyValue{
+			makeOTLPKeyValue(OTLPAttributeServiceName, otlpCloudServiceName),
+			makeOTLPKeyValue(OTLPAttributeHostName, additionalCtx.CloudHost),
+		}
+		resourceLabels = []string{metrics.CloudHostLabelName}
+	case *GatewayMetricContext:
+		resourceAttrs = []*otlpKeyValue{
+			makeOTLPKeyValue(OTLPAttributeNetworkID, additionalCtx.NetworkID),
+			makeOTLPKeyValue(OTLPAttributeGatewayID, additionalCtx.GatewayID),
+		}
+		resourceLabels = []string{metrics.NetworkLabelName, metrics.GatewayLabelName}
+	case *PushedMetricContext:
+		resourceAttrs = []*otlpKeyValue{makeOTLPKeyValue(OTLPAttributeNetworkID, additionalCtx.NetworkID)}
+		if gatewayID, ok := labelsByName[metrics.GatewayLabelName]; ok {
+			resourceAttrs = append(resourceAttrs, makeOTLPKeyValue(OTLPAttributeGatewayID, gatewayID))
+		}
+		resourceLabels = []string{metrics.NetworkLabelName, metrics.GatewayLabelName}
+	}
+	for _, name := range resourceLabels {
+		delete(labelsByName, name)
+	}
+
+	names := make([]string, 0, len(labelsByName))
+	for name := range labelsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pointAttrs := make([]*otlpKeyValue, 0, len(names))
+	for _, name := range names {
+		pointAttrs = append(pointAttrs, makeOTLPKeyValue(name, labelsByName[name]))
+	}
+	return resourceAttrs, pointAttrs
+}
+
+func getOTLPAttributesKey(attrs []*otlpKeyValue) string {
+	parts := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		parts = append(parts, attr.Key+"="+attr.Value.GetStringValue())
+	}
+	return strings.Join(parts, "\x00")
+}
+
+func makeOTLPKeyValue(key string, value string) *otlpKeyValue {
+	return &otlpKeyValue{Key: key, Value: &otlpAnyValue{StringValue: proto.String(value)}}
+}
+
+func makeOTLPNumberDataPoint(attrs []*otlpKeyValue, timeNano uint64, value float64) *otlpNumberDataPoint {
+	return &otlpNumberDataPoint{Attributes: attrs, TimeUnixNano: timeNano, AsDouble: proto.Float64(value)}
+}
+
+// makeOTLPHistogramDataPoint converts a Prometheus histogram, whose buckets
+// hold cumulative counts, to an OTLP data point, whose buckets hold the
+// count of each bucket. OTLP's last bucket is unbounded, so a +Inf bucket
+// isn't converted to an explicit bound.
+func makeOTLPHistogramDataPoint(attrs []*otlpKeyValue, timeNano uint64, histogram *prometheus_models.Histogram) *otlpHistogramDataPoint {
+	point := &otlpHistogramDataPoint{
+		Attributes:   attrs,
+		TimeUnixNano: timeNano,
+		Count:        histogram.GetSampleCount(),
+		Sum:          proto.Float64(histogram.GetSampleSum()),
+	}
+	var previous uint64
+	for _, b := range histogram.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			break
+		}
+		point.ExplicitBounds = append(point.ExplicitBounds, b.GetUpperBound())
+		point.BucketCounts = append(point.BucketCounts, b.GetCumulativeCount()-previous)
+		previous = b.GetCumulativeCount()
+	}
+	point.BucketCounts = append(point.BucketCounts, histogram.GetSampleCount()-previous)
+	return point
+}
+
+func makeOTLPSummaryDataPoint(attrs []*otlpKeyValue, timeNano uint64, summary *prometheus_models.Summary) *otlpSummaryDataPoint {
+	point := &otlpSummaryDataPoint{
+		Attributes:   attrs,
+		TimeUnixNano: timeNano,
+		Count:        summary.GetSampleCount(),
+		Sum:          summary.GetSampleSum(),
+	}
+	for _, q := range summary.GetQuantile() {
+		point.QuantileValues = append(point.QuantileValues, &otlpValueAtQuantile{Quantile: q.GetQuantile(), Value: q.GetValue()})
+	}
+	return point
+}
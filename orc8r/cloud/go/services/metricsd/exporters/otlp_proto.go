@@ -0,0 +1,199 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+// The messages below mirror the subset of the OpenTelemetry metrics protocol
+// (opentelemetry-proto's collector/metrics/v1, metrics/v1, resource/v1 and
+// common/v1) used by the OTLP exporter. The generated Go packages require a
+// newer protobuf runtime than this module uses.
+// Field numbers and types must match opentelemetry-proto. Oneof members are
+// modeled as optional fields, of which at most one is set.
+
+const otlpExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+
+// otlpAggregationTemporalityCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE
+const otlpAggregationTemporalityCumulative = 2
+
+type otlpExportMetricsServiceRequest struct {
+	ResourceMetrics []*otlpResourceMetrics `protobuf:"bytes,1,rep,name=resource_metrics,json=resourceMetrics,proto3" json:"resource_metrics,omitempty"`
+}
+
+func (m *otlpExportMetricsServiceRequest) Reset()         { *m = otlpExportMetricsServiceRequest{} }
+func (m *otlpExportMetricsServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*otlpExportMetricsServiceRequest) ProtoMessage()    {}
+
+type otlpExportMetricsServiceResponse struct{}
+
+func (m *otlpExportMetricsServiceResponse) Reset()         { *m = otlpExportMetricsServiceResponse{} }
+func (m *otlpExportMetricsServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*otlpExportMetricsServiceResponse) ProtoMessage()    {}
+
+type otlpResourceMetrics struct {
+	Resource     *otlpResource       `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ScopeMetrics []*otlpScopeMetrics `protobuf:"bytes,2,rep,name=scope_metrics,json=scopeMetrics,proto3" json:"scope_metrics,omitempty"`
+}
+
+func (m *otlpResourceMetrics) Reset()         { *m = otlpResourceMetrics{} }
+func (m *otlpResourceMetrics) String() string { return proto.CompactTextString(m) }
+func (*otlpResourceMetrics) ProtoMessage()    {}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *otlpResource) Reset()         { *m = otlpResource{} }
+func (m *otlpResource) String() string { return proto.CompactTextString(m) }
+func (*otlpResource) ProtoMessage()    {}
+
+type otlpScopeMetrics struct {
+	Scope   *otlpInstrumentationScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Metrics []*otlpMetric             `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (m *otlpScopeMetrics) Reset()         { *m = otlpScopeMetrics{} }
+func (m *otlpScopeMetrics) String() string { return proto.CompactTextString(m) }
+func (*otlpScopeMetrics) ProtoMessage()    {}
+
+type otlpInstrumentationScope struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *otlpInstrumentationScope) Reset()         { *m = otlpInstrumentationScope{} }
+func (m *otlpInstrumentationScope) String() string { return proto.CompactTextString(m) }
+func (*otlpInstrumentationScope) ProtoMessage()    {}
+
+type otlpKeyValue struct {
+	Key   string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *otlpAnyValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *otlpKeyValue) Reset()         { *m = otlpKeyValue{} }
+func (m *otlpKeyValue) String() string { return proto.CompactTextString(m) }
+func (*otlpKeyValue) ProtoMessage()    {}
+
+type otlpAnyValue struct {
+	// StringValue is a member of the value oneof, so it's set even if empty
+	StringValue *string `protobuf:"bytes,1,opt,name=string_value,json=stringValue" json:"string_value,omitempty"`
+}
+
+func (m *otlpAnyValue) Reset()         { *m = otlpAnyValue{} }
+func (m *otlpAnyValue) String() string { return proto.CompactTextString(m) }
+func (*otlpAnyValue) ProtoMessage()    {}
+
+func (m *otlpAnyValue) GetStringValue() string {
+	if m == nil || m.StringValue == nil {
+		return ""
+	}
+	return *m.StringValue
+}
+
+type otlpMetric struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Unit        string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Members of the data oneof
+	Gauge     *otlpGauge     `protobuf:"bytes,5,opt,name=gauge" json:"gauge,omitempty"`
+	Sum       *otlpSum       `protobuf:"bytes,7,opt,name=sum" json:"sum,omitempty"`
+	Histogram *otlpHistogram `protobuf:"bytes,9,opt,name=histogram" json:"histogram,omitempty"`
+	Summary   *otlpSummary   `protobuf:"bytes,11,opt,name=summary" json:"summary,omitempty"`
+}
+
+func (m *otlpMetric) Reset()         { *m = otlpMetric{} }
+func (m *otlpMetric) String() string { return proto.CompactTextString(m) }
+func (*otlpMetric) ProtoMessage()    {}
+
+type otlpGauge struct {
+	DataPoints []*otlpNumberDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+}
+
+func (m *otlpGauge) Reset()         { *m = otlpGauge{} }
+func (m *otlpGauge) String() string { return proto.CompactTextString(m) }
+func (*otlpGauge) ProtoMessage()    {}
+
+type otlpSum struct {
+	DataPoints             []*otlpNumberDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	AggregationTemporality int32                  `protobuf:"varint,2,opt,name=aggregation_temporality,json=aggregationTemporality,proto3" json:"aggregation_temporality,omitempty"`
+	IsMonotonic            bool                   `protobuf:"varint,3,opt,name=is_monotonic,json=isMonotonic,proto3" json:"is_monotonic,omitempty"`
+}
+
+func (m *otlpSum) Reset()         { *m = otlpSum{} }
+func (m *otlpSum) String() string { return proto.CompactTextString(m) }
+func (*otlpSum) ProtoMessage()    {}
+
+type otlpHistogram struct {
+	DataPoints             []*otlpHistogramDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	AggregationTemporality int32                     `protobuf:"varint,2,opt,name=aggregation_temporality,json=aggregationTemporality,proto3" json:"aggregation_temporality,omitempty"`
+}
+
+func (m *otlpHistogram) Reset()         { *m = otlpHistogram{} }
+func (m *otlpHistogram) String() string { return proto.CompactTextString(m) }
+func (*otlpHistogram) ProtoMessage()    {}
+
+type otlpSummary struct {
+	DataPoints []*otlpSummaryDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+}
+
+func (m *otlpSummary) Reset()         { *m = otlpSummary{} }
+func (m *otlpSummary) String() string { return proto.CompactTextString(m) }
+func (*otlpSummary) ProtoMessage()    {}
+
+type otlpNumberDataPoint struct {
+	Attributes   []*otlpKeyValue `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	TimeUnixNano uint64          `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	// AsDouble is a member of the value oneof, so it's set even if 0
+	AsDouble *float64 `protobuf:"fixed64,4,opt,name=as_double,json=asDouble" json:"as_double,omitempty"`
+}
+
+func (m *otlpNumberDataPoint) Reset()         { *m = otlpNumberDataPoint{} }
+func (m *otlpNumberDataPoint) String() string { return proto.CompactTextString(m) }
+func (*otlpNumberDataPoint) ProtoMessage()    {}
+
+type otlpHistogramDataPoint struct {
+	Attributes     []*otlpKeyValue `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	TimeUnixNano   uint64          `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Count          uint64          `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+	Sum            *float64        `protobuf:"fixed64,5,opt,name=sum" json:"sum,omitempty"`
+	BucketCounts   []uint64        `protobuf:"fixed64,6,rep,packed,name=bucket_counts,json=bucketCounts,proto3" json:"bucket_counts,omitempty"`
+	ExplicitBounds []float64       `protobuf:"fixed64,7,rep,packed,name=explicit_bounds,json=explicitBounds,proto3" json:"explicit_bounds,omitempty"`
+}
+
+func (m *otlpHistogramDataPoint) Reset()         { *m = otlpHistogramDataPoint{} }
+func (m *otlpHistogramDataPoint) String() string { return proto.CompactTextString(m) }
+func (*otlpHistogramDataPoint) ProtoMessage()    {}
+
+type otlpSummaryDataPoint struct {
+	Attributes     []*otlpKeyValue        `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	TimeUnixNano   uint64                 `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Count          uint64                 `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+	Sum            float64                `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	QuantileValues []*otlpValueAtQuantile `protobuf:"bytes,6,rep,name=quantile_values,json=quantileValues,proto3" json:"quantile_values,omitempty"`
+}
+
+func (m *otlpSummaryDataPoint) Reset()         { *m = otlpSummaryDataPoint{} }
+func (m *otlpSummaryDataPoint) String() string { return proto.CompactTextString(m) }
+func (*otlpSummaryDataPoint) ProtoMessage()    {}
+
+type otlpValueAtQuantile struct {
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *otlpValueAtQuantile) Reset()         { *m = otlpValueAtQuantile{} }
+func (m *otlpValueAtQuantile) String() string { return proto.CompactTextString(m) }
+func (*otlpValueAtQuantile) ProtoMessage()    {}
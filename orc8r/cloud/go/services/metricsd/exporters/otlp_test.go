@@ -0,0 +1,318 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	prometheus_models "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// otlpReceiver stands in for an OTLP collector, recording the export
+// requests it receives over gRPC or HTTP.
+type otlpReceiver struct {
+	mu       sync.Mutex
+	requests []*otlpExportMetricsServiceRequest
+	headers  []map[string]string
+}
+
+func (r *otlpReceiver) record(req *otlpExportMetricsServiceRequest, headers map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	r.headers = append(r.headers, headers)
+}
+
+func (r *otlpReceiver) getRequests() []*otlpExportMetricsServiceRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests
+}
+
+func (r *otlpReceiver) serveGRPC(t *testing.T) (string, func()) {
+	desc := &grpc.ServiceDesc{
+		ServiceName: "opentelemetry.proto.collector.metrics.v1.MetricsService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Export",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := &otlpExportMetricsServiceRequest{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				headers := map[string]string{}
+				md, _ := metadata.FromIncomingContext(ctx)
+				for k, v := range md {
+					headers[k] = v[0]
+				}
+				r.record(req, headers)
+				return &otlpExportMetricsServiceResponse{}, nil
+			},
+		}},
+	}
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	srv.RegisterService(desc, r)
+	go srv.Serve(lis)
+	return lis.Addr().String(), srv.Stop
+}
+
+func (r *otlpReceiver) serveHTTP(t *testing.T) (string, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		exportReq := &otlpExportMetricsServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, exportReq))
+		r.record(exportReq, map[string]string{
+			"content-type":  req.Header.Get("Content-Type"),
+			"authorization": req.Header.Get("Authorization"),
+		})
+		w.WriteHeader(http.StatusOK)
+	}))
+	return srv.URL + "/v1/metrics", srv.Close
+}
+
+func TestGetOTLPResourceMetrics(t *testing.T) {
+	metrics := []MetricAndContext{
+		{
+			Family: &prometheus_models.MetricFamily{
+				Help: proto.String("requests served"),
+				Type: prometheus_models.MetricType_COUNTER.Enum(),
+				Metric: []*prometheus_models.Metric{
+					{
+						Label:       []*prometheus_models.LabelPair{makeLabelPair("cloudHost", "host1"), makeLabelPair("code", "200")},
+						Counter:     &prometheus_models.Counter{Value: proto.Float64(10)},
+						TimestampMs: proto.Int64(1000),
+					},
+					{
+						Label:   []*prometheus_models.LabelPair{makeLabelPair("code", "500")},
+						Counter: &prometheus_models.Counter{Value: proto.Float64(0)},
+					},
+				},
+			},
+			Context: MetricContext{MetricName: "requests", AdditionalContext: &CloudMetricContext{CloudHost: "host1"}},
+		},
+		{
+			Family: &prometheus_models.MetricFamily{
+				Type: prometheus_models.MetricType_HISTOGRAM.Enum(),
+				Metric: []*prometheus_models.Metric{{
+					Label: []*prometheus_models.LabelPair{makeLabelPair("networkID", "nw1"), makeLabelPair("gatewayID", "gw1")},
+					Histogram: &prometheus_models.Histogram{
+						SampleCount: proto.Uint64(10),
+						SampleSum:   proto.Float64(42),
+						Bucket: []*prometheus_models.Bucket{
+							{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(2)},
+							{UpperBound: proto.Float64(5), CumulativeCount: proto.Uint64(7)},
+							{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(10)},
+						},
+					},
+					TimestampMs: proto.Int64(2000),
+				}},
+			},
+			Context: MetricContext{MetricName: "latency", AdditionalContext: &GatewayMetricContext{NetworkID: "nw1", GatewayID: "gw1"}},
+		},
+		{
+			Family: &prometheus_models.MetricFamily{
+				Type: prometheus_models.MetricType_SUMMARY.Enum(),
+				Metric: []*prometheus_models.Metric{{
+					Label: []*prometheus_models.LabelPair{makeLabelPair("gatewayID", "gw2")},
+					Summary: &prometheus_models.Summary{
+						SampleCount: proto.Uint64(4),
+						SampleSum:   proto.Float64(8),
+						Quantile:    []*prometheus_models.Quantile{{Quantile: proto.Float64(0.5), Value: proto.Float64(1.5)}},
+					},
+					TimestampMs: proto.Int64(3000),
+				}},
+			},
+			Context: MetricContext{MetricName: "sizes", AdditionalContext: &PushedMetricContext{NetworkID: "nw1"}},
+		},
+		makeGaugeMetric("temperature", 21.5, &GatewayMetricContext{NetworkID: "nw1", GatewayID: "gw1"}),
+	}
+
+	expected := []*otlpResourceMetrics{
+		{
+			Resource: &otlpResource{Attributes: []*otlpKeyValue{
+				makeOTLPKeyValue("service.name", "orc8r"),
+				makeOTLPKeyValue("host.name", "host1"),
+			}},
+			ScopeMetrics: []*otlpScopeMetrics{{
+				Scope: &otlpInstrumentationScope{Name: "magma/metricsd"},
+				Metrics: []*otlpMetric{{
+					Name:        "requests",
+					Description: "requests served",
+					Sum: &otlpSum{
+						DataPoints: []*otlpNumberDataPoint{
+							makeOTLPNumberDataPoint([]*otlpKeyValue{makeOTLPKeyValue("code", "200")}, 1e9, 10),
+							makeOTLPNumberDataPoint([]*otlpKeyValue{makeOTLPKeyValue("code", "500")}, 5e9, 0),
+						},
+						AggregationTemporality: otlpAggregationTemporalityCumulative,
+						IsMonotonic:            true,
+					},
+				}},
+			}},
+		},
+		{
+			Resource: &otlpResource{Attributes: []*otlpKeyValue{
+				makeOTLPKeyValue("magma.network.id", "nw1"),
+				makeOTLPKeyValue("magma.gateway.id", "gw1"),
+			}},
+			ScopeMetrics: []*otlpScopeMetrics{{
+				Scope: &otlpInstrumentationScope{Name: "magma/metricsd"},
+				Metrics: []*otlpMetric{
+					{
+						Name: "latency",
+						Histogram: &otlpHistogram{
+							DataPoints: []*otlpHistogramDataPoint{{
+								Attributes:     []*otlpKeyValue{},
+								TimeUnixNano:   2e9,
+								Count:          10,
+								Sum:            proto.Float64(42),
+								BucketCounts:   []uint64{2, 5, 3},
+								ExplicitBounds: []float64{1, 5},
+							}},
+							AggregationTemporality: otlpAggregationTemporalityCumulative,
+						},
+					},
+					{
+						Name: "temperature",
+						Gauge: &otlpGauge{DataPoints: []*otlpNumberDataPoint{
+							makeOTLPNumberDataPoint([]*otlpKeyValue{}, 1e9, 21.5),
+						}},
+					},
+				},
+			}},
+		},
+		{
+			Resource: &otlpResource{Attributes: []*otlpKeyValue{
+				makeOTLPKeyValue("magma.network.id", "nw1"),
+				makeOTLPKeyValue("magma.gateway.id", "gw2"),
+			}},
+			ScopeMetrics: []*otlpScopeMetrics{{
+				Scope: &otlpInstrumentationScope{Name: "magma/metricsd"},
+				Metrics: []*otlpMetric{{
+					Name: "sizes",
+					Summary: &otlpSummary{DataPoints: []*otlpSummaryDataPoint{{
+						Attributes:     []*otlpKeyValue{},
+						TimeUnixNano:   3e9,
+						Count:          4,
+						Sum:            8,
+						QuantileValues: []*otlpValueAtQuantile{{Quantile: 0.5, Value: 1.5}},
+					}}},
+				}},
+			}},
+		},
+	}
+	assert.Equal(t, expected, getOTLPResourceMetrics(metrics, 5e9))
+}
+
+func TestOTLPExporter_GRPC(t *testing.T) {
+	receiver := &otlpReceiver{}
+	endpoint, stop := receiver.serveGRPC(t)
+	defer stop()
+	exporter, err := NewOTLPExporter(OTLPConfig{
+		Endpoint: endpoint,
+		Insecure: true,
+		Headers:  map[string]string{"authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+
+	err = exporter.Submit([]MetricAndContext{makeGaugeMetric("temperature", 21.5, &GatewayMetricContext{NetworkID: "nw1", GatewayID: "gw1"})})
+	require.NoError(t, err)
+
+	requests := receiver.getRequests()
+	require.Len(t, requests, 1)
+	assertOTLPRequestEqual(t, requests[0], "temperature", 21.5)
+	assert.Equal(t, "Bearer token", receiver.headers[0]["authorization"])
+
+	// Nothing to export
+	require.NoError(t, exporter.Submit(nil))
+	assert.Len(t, receiver.getRequests(), 1)
+}
+
+func TestOTLPExporter_HTTP(t *testing.T) {
+	receiver := &otlpReceiver{}
+	endpoint, stop := receiver.serveHTTP(t)
+	defer stop()
+	exporter, err := NewOTLPExporter(OTLPConfig{
+		Endpoint: endpoint,
+		Protocol: OTLPProtocolHTTP,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+
+	err = exporter.Submit([]MetricAndContext{makeGaugeMetric("temperature", 0, &GatewayMetricContext{NetworkID: "nw1", GatewayID: "gw1"})})
+	require.NoError(t, err)
+
+	requests := receiver.getRequests()
+	require.Len(t, requests, 1)
+	assertOTLPRequestEqual(t, requests[0], "temperature", 0)
+	assert.Equal(t, "application/x-protobuf", receiver.headers[0]["content-type"])
+	assert.Equal(t, "Bearer token", receiver.headers[0]["authorization"])
+}
+
+func TestOTLPExporter_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+	exporter, err := NewOTLPExporter(OTLPConfig{Endpoint: srv.URL, Protocol: OTLPProtocolHTTP})
+	require.NoError(t, err)
+
+	err = exporter.Submit([]MetricAndContext{makeGaugeMetric("temperature", 1, &CloudMetricContext{CloudHost: "host1"})})
+	assert.EqualError(t, err, "OTLP export failed with status 400: bad request")
+}
+
+func TestNewOTLPExporter_InvalidConfig(t *testing.T) {
+	_, err := NewOTLPExporter(OTLPConfig{})
+	assert.EqualError(t, err, "OTLP endpoint must be set")
+	_, err = NewOTLPExporter(OTLPConfig{Endpoint: "collector:4318", Protocol: OTLPProtocolHTTP})
+	assert.EqualError(t, err, "OTLP HTTP endpoint must be an http or https URL")
+	_, err = NewOTLPExporter(OTLPConfig{Endpoint: "collector:4317", Protocol: "udp"})
+	assert.EqualError(t, err, `unsupported OTLP protocol "udp", expected grpc or http`)
+}
+
+// assertOTLPRequestEqual asserts the request holds the gauge created by
+// makeGaugeMetric for gateway gw1 of network nw1.
+func assertOTLPRequestEqual(t *testing.T, req *otlpExportMetricsServiceRequest, name string, value float64) {
+	require.Len(t, req.ResourceMetrics, 1)
+	resource := req.ResourceMetrics[0]
+	assert.True(t, proto.Equal(
+		&otlpResource{Attributes: []*otlpKeyValue{makeOTLPKeyValue("magma.network.id", "nw1"), makeOTLPKeyValue("magma.gateway.id", "gw1")}},
+		resource.Resource,
+	))
+	require.Len(t, resource.ScopeMetrics, 1)
+	require.Len(t, resource.ScopeMetrics[0].Metrics, 1)
+	metric := resource.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, name, metric.Name)
+	require.NotNil(t, metric.Gauge)
+	require.Len(t, metric.Gauge.DataPoints, 1)
+	point := metric.Gauge.DataPoints[0]
+	assert.Equal(t, uint64(1e9), point.TimeUnixNano)
+	// Oneof members are sent even when zero
+	require.NotNil(t, point.AsDouble)
+	assert.Equal(t, value, *point.AsDouble)
+}
@@ -0,0 +1,438 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	prometheus_models "github.com/prometheus/client_model/go"
+)
+
+const (
+	defaultRemoteWriteMaxSamplesPerSend = 500
+	defaultRemoteWriteMaxQueuedSamples  = 100000
+	defaultRemoteWriteFlushInterval     = 5 * time.Second
+	defaultRemoteWriteMaxRetries        = 3
+	defaultRemoteWriteMinBackoff        = 100 * time.Millisecond
+	defaultRemoteWriteMaxBackoff        = 5 * time.Second
+	defaultRemoteWriteTimeout           = 30 * time.Second
+
+	remoteWriteVersion = "0.1.0"
+	metricNameLabel    = "__name__"
+
+	// maxRemoteWriteErrorBodySize limits how much of an error response is
+	// read
+	maxRemoteWriteErrorBodySize = 1 << 10
+)
+
+var (
+	promNameRegex     = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	nonPromNameChars  = regexp.MustCompile(`[^a-zA-Z\d_]`)
+	errRemoteWriteURL = errors.New("remote write URL must be an http or https URL")
+)
+
+// RemoteWriteConfig configures an exporter which sends metrics to a
+// Prometheus-compatible TSDB, e.g. Cortex, Thanos or VictoriaMetrics, with
+// the Prometheus remote write protocol.
+type RemoteWriteConfig struct {
+	// URL is the remote write endpoint, e.g.
+	// http://cortex-distributor:8080/api/v1/push
+	URL string `yaml:"url"`
+	// Headers are sent with every request, e.g. for authorization
+	Headers map[string]string `yaml:"headers"`
+	// TenantHeader is the header identifying the tenant metrics are written
+	// for, e.g. X-Scope-OrgID for Cortex. No tenant header is sent if empty.
+	TenantHeader string `yaml:"tenantHeader"`
+	// NetworkTenants maps network IDs to the tenant their metrics are
+	// written for
+	NetworkTenants map[string]string `yaml:"networkTenants"`
+	// DefaultTenant is the tenant of metrics of networks which aren't in
+	// NetworkTenants, and of cloud metrics
+	DefaultTenant string `yaml:"defaultTenant"`
+
+	// MaxSamplesPerSend limits the number of samples per request. Defaults
+	// to 500.
+	MaxSamplesPerSend int `yaml:"maxSamplesPerSend"`
+	// MaxQueuedSamples limits the number of samples waiting to be sent.
+	// Submitted metrics are dropped while the queue is full. Defaults to
+	// 100000.
+	MaxQueuedSamples int `yaml:"maxQueuedSamples"`
+	// FlushIntervalSecs is the interval at which queued samples are sent,
+	// even if they don't fill a request. Defaults to 5 seconds.
+	FlushIntervalSecs uint `yaml:"flushIntervalSecs"`
+	// MaxRetries is the number of times a request which failed with a
+	// network error, a 5xx or a 429 response is retried before its samples
+	// are dropped. Defaults to 3.
+	MaxRetries int `yaml:"maxRetries"`
+	// MinBackoffMs is the delay before the first retry, which doubles with
+	// each further retry up to MaxBackoffMs. Default to 100ms and 5s.
+	MinBackoffMs uint `yaml:"minBackoffMs"`
+	MaxBackoffMs uint `yaml:"maxBackoffMs"`
+	// TimeoutSecs limits the duration of each request. Defaults to 30
+	// seconds.
+	TimeoutSecs uint `yaml:"timeoutSecs"`
+}
+
+// RemoteWriteExporter queues submitted metrics and sends them in batches to
+// a remote write endpoint, separately for each tenant.
+type RemoteWriteExporter struct {
+	config        RemoteWriteConfig
+	flushInterval time.Duration
+	minBackoff    time.Duration
+	maxBackoff    time.Duration
+	client        *http.Client
+	// flushCh is signaled when a tenant's queue fills a request
+	flushCh chan struct{}
+
+	mu sync.Mutex
+	// queues holds the series waiting to be sent, by tenant
+	queues map[string][]*timeSeries
+	queued int
+
+	// sendMu serializes sends, so each tenant's series are sent in order
+	sendMu sync.Mutex
+}
+
+// NewRemoteWriteExporter returns an exporter sending metrics to the
+// configured remote write endpoint, and starts sending queued metrics in the
+// background.
+func NewRemoteWriteExporter(config RemoteWriteConfig) (*RemoteWriteExporter, error) {
+	e, err := newRemoteWriteExporter(config)
+	if err != nil {
+		return nil, err
+	}
+	go e.run()
+	return e, nil
+}
+
+func newRemoteWriteExporter(config RemoteWriteConfig) (*RemoteWriteExporter, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, errRemoteWriteURL
+	}
+	if config.MaxSamplesPerSend <= 0 {
+		config.MaxSamplesPerSend = defaultRemoteWriteMaxSamplesPerSend
+	}
+	if config.MaxQueuedSamples <= 0 {
+		config.MaxQueuedSamples = defaultRemoteWriteMaxQueuedSamples
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = defaultRemoteWriteMaxRetries
+	}
+
+	timeout := defaultRemoteWriteTimeout
+	if config.TimeoutSecs > 0 {
+		timeout = time.Duration(config.TimeoutSecs) * time.Second
+	}
+	e := &RemoteWriteExporter{
+		config:        config,
+		flushInterval: defaultRemoteWriteFlushInterval,
+		minBackoff:    defaultRemoteWriteMinBackoff,
+		maxBackoff:    defaultRemoteWriteMaxBackoff,
+		client:        &http.Client{Timeout: timeout},
+		flushCh:       make(chan struct{}, 1),
+		queues:        map[string][]*timeSeries{},
+	}
+	if config.FlushIntervalSecs > 0 {
+		e.flushInterval = time.Duration(config.FlushIntervalSecs) * time.Second
+	}
+	if config.MinBackoffMs > 0 {
+		e.minBackoff = time.Duration(config.MinBackoffMs) * time.Millisecond
+	}
+	if config.MaxBackoffMs > 0 {
+		e.maxBackoff = time.Duration(config.MaxBackoffMs) * time.Millisecond
+	}
+	return e, nil
+}
+
+// Submit queues the metrics to be sent. Returns an error if the queue is
+// full, in which case the metrics are dropped.
+func (e *RemoteWriteExporter) Submit(metrics []MetricAndContext) error {
+	nowMs := clock.Now().UnixNano() / int64(time.Millisecond)
+	seriesByTenant := map[string][]*timeSeries{}
+	count := 0
+	for _, metric := range metrics {
+		tenant := e.getTenant(metric.Context)
+		series := getTimeSeries(metric, nowMs)
+		seriesByTenant[tenant] = append(seriesByTenant[tenant], series...)
+		count += len(series)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	e.mu.Lock()
+	if e.queued+count > e.config.MaxQueuedSamples {
+		e.mu.Unlock()
+		return fmt.Errorf("remote write queue is full, dropping %d samples", count)
+	}
+	full := false
+	for tenant, series := range seriesByTenant {
+		e.queues[tenant] = append(e.queues[tenant], series...)
+		full = full || len(e.queues[tenant]) >= e.config.MaxSamplesPerSend
+	}
+	e.queued += count
+	e.mu.Unlock()
+
+	if full {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Flush sends all queued samples. Samples which couldn't be sent are
+// dropped, and the errors returned.
+func (e *RemoteWriteExporter) Flush() error {
+	e.sendMu.Lock()
+	defer e.sendMu.Unlock()
+
+	e.mu.Lock()
+	queues := e.queues
+	e.queues = map[string][]*timeSeries{}
+	e.queued = 0
+	e.mu.Unlock()
+
+	tenants := make([]string, 0, len(queues))
+	for tenant := range queues {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+
+	var errs *multierror.Error
+	for _, tenant := range tenants {
+		series := queues[tenant]
+		for start := 0; start < len(series); start += e.config.MaxSamplesPerSend {
+			end := start + e.config.MaxSamplesPerSend
+			if end > len(series) {
+				end = len(series)
+			}
+			err := e.send(tenant, series[start:end])
+			if err != nil {
+				errs = multierror.Append(errs, errors.Wrapf(err, "dropping %d samples of tenant %q", end-start, tenant))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func (e *RemoteWriteExporter) run() {
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.flushCh:
+		}
+		err := e.Flush()
+		if err != nil {
+			glog.Errorf("Error sending metrics to remote write endpoint %s: %v", e.config.URL, err)
+		}
+	}
+}
+
+// send writes the series to the endpoint, retrying with exponential backoff
+// on errors which may be transient.
+func (e *RemoteWriteExporter) send(tenant string, series []*timeSeries) error {
+	data, err := proto.Marshal(&writeRequest{Timeseries: series})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal write request")
+	}
+	body := snappy.Encode(nil, data)
+
+	backoff := e.minBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := e.post(tenant, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= e.config.MaxRetries {
+			return err
+		}
+		glog.V(2).Infof("Retrying remote write after error: %v", err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > e.maxBackoff {
+			backoff = e.maxBackoff
+		}
+	}
+}
+
+// post sends a single write request. On failure, returns whether the
+// request may succeed if retried.
+func (e *RemoteWriteExporter) post(tenant string, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, e.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for name, value := range e.config.Headers {
+		req.Header.Set(name, value)
+	}
+	if e.config.TenantHeader != "" && tenant != "" {
+		req.Header.Set(e.config.TenantHeader, tenant)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return false, nil
+	}
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxRemoteWriteErrorBodySize))
+	retryable := resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("remote write failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+}
+
+func (e *RemoteWriteExporter) getTenant(ctx MetricContext) string {
+	var networkID string
+	switch additionalCtx := ctx.AdditionalContext.(type) {
+	case *GatewayMetricContext:
+		networkID = additionalCtx.NetworkID
+	case *PushedMetricContext:
+		networkID = additionalCtx.NetworkID
+	}
+	if tenant, ok := e.config.NetworkTenants[networkID]; ok && networkID != "" {
+		return tenant
+	}
+	return e.config.DefaultTenant
+}
+
+// getTimeSeries converts a metric family to one series per sample, named
+// as Prometheus would expose it, e.g. with _sum and _count series for
+// summaries and histograms.
+// Metrics with invalid label names are dropped.
+func getTimeSeries(metricAndContext MetricAndContext, nowMs int64) []*timeSeries {
+	name := sanitizeMetricName(metricAndContext.Context.MetricName)
+	family := metricAndContext.Family
+	var ret []*timeSeries
+	for _, metric := range family.GetMetric() {
+		labels, err := getLabels(metric)
+		if err != nil {
+			glog.Errorf("Dropping metric %s because of invalid label: %v", name, err)
+			continue
+		}
+		timestampMs := metric.GetTimestampMs()
+		if timestampMs == 0 {
+			timestampMs = nowMs
+		}
+		newSeries := func(name string, value float64, extraLabels ...*label) *timeSeries {
+			return makeTimeSeries(name, labels, extraLabels, value, timestampMs)
+		}
+
+		switch family.GetType() {
+		case prometheus_models.MetricType_COUNTER:
+			ret = append(ret, newSeries(name, metric.GetCounter().GetValue()))
+		case prometheus_models.MetricType_GAUGE:
+			ret = append(ret, newSeries(name, metric.GetGauge().GetValue()))
+		case prometheus_models.MetricType_UNTYPED:
+			ret = append(ret, newSeries(name, metric.GetUntyped().GetValue()))
+		case prometheus_models.MetricType_SUMMARY:
+			summary := metric.GetSummary()
+			for _, q := range summary.GetQuantile() {
+				ret = append(ret, newSeries(name, q.GetValue(), &label{Name: "quantile", Value: formatFloat(q.GetQuantile())}))
+			}
+			ret = append(ret,
+				newSeries(name+"_sum", summary.GetSampleSum()),
+				newSeries(name+"_count", float64(summary.GetSampleCount())),
+			)
+		case prometheus_models.MetricType_HISTOGRAM:
+			histogram := metric.GetHistogram()
+			hasInf := false
+			for _, b := range histogram.GetBucket() {
+				hasInf = hasInf || math.IsInf(b.GetUpperBound(), 1)
+				ret = append(ret, newSeries(name+"_bucket", float64(b.GetCumulativeCount()), &label{Name: "le", Value: formatFloat(b.GetUpperBound())}))
+			}
+			if !hasInf {
+				ret = append(ret, newSeries(name+"_bucket", float64(histogram.GetSampleCount()), &label{Name: "le", Value: formatFloat(math.Inf(1))}))
+			}
+			ret = append(ret,
+				newSeries(name+"_sum", histogram.GetSampleSum()),
+				newSeries(name+"_count", float64(histogram.GetSampleCount())),
+			)
+		}
+	}
+	return ret
+}
+
+func getLabels(metric *prometheus_models.Metric) ([]*label, error) {
+	labels := make([]*label, 0, len(metric.GetLabel()))
+	for _, l := range metric.GetLabel() {
+		if !promNameRegex.MatchString(l.GetName()) {
+			return nil, fmt.Errorf("label %s invalid", l.GetName())
+		}
+		labels = append(labels, &label{Name: l.GetName(), Value: l.GetValue()})
+	}
+	return labels, nil
+}
+
+// makeTimeSeries returns a series with a single sample. Its labels are
+// sorted by name, as required by the remote write protocol.
+func makeTimeSeries(name string, labels []*label, extraLabels []*label, value float64, timestampMs int64) *timeSeries {
+	allLabels := make([]*label, 0, len(labels)+len(extraLabels)+1)
+	allLabels = append(allLabels, &label{Name: metricNameLabel, Value: name})
+	allLabels = append(allLabels, labels...)
+	allLabels = append(allLabels, extraLabels...)
+	sort.Slice(allLabels, func(i, j int) bool { return allLabels[i].Name < allLabels[j].Name })
+	return &timeSeries{
+		Labels:  allLabels,
+		Samples: []*sample{{Value: value, Timestamp: timestampMs}},
+	}
+}
+
+func sanitizeMetricName(name string) string {
+	sanitized := nonPromNameChars.ReplaceAllString(name, "_")
+	// If still doesn't match, must be because digit is first character
+	if !promNameRegex.MatchString(sanitized) {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
+
+// formatFloat formats quantiles and bucket bounds the way Prometheus
+// exposes them
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+// The messages below mirror the WriteRequest message of the Prometheus
+// remote write protocol (prometheus/prompb), which can't be imported
+// without pulling in the Prometheus server's gRPC gateway dependencies.
+// Field numbers and types must match prompb's remote.proto and types.proto.
+
+type writeRequest struct {
+	Timeseries []*timeSeries `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries,omitempty"`
+}
+
+func (m *writeRequest) Reset()         { *m = writeRequest{} }
+func (m *writeRequest) String() string { return proto.CompactTextString(m) }
+func (*writeRequest) ProtoMessage()    {}
+
+type timeSeries struct {
+	Labels  []*label  `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	Samples []*sample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *timeSeries) Reset()         { *m = timeSeries{} }
+func (m *timeSeries) String() string { return proto.CompactTextString(m) }
+func (*timeSeries) ProtoMessage()    {}
+
+type label struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *label) Reset()         { *m = label{} }
+func (m *label) String() string { return proto.CompactTextString(m) }
+func (*label) ProtoMessage()    {}
+
+type sample struct {
+	Value     float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *sample) Reset()         { *m = sample{} }
+func (m *sample) String() string { return proto.CompactTextString(m) }
+func (*sample) ProtoMessage()    {}
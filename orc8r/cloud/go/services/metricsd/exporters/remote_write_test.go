@@ -0,0 +1,284 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporters
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	prometheus_models "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteWriteReceiver stands in for a remote write endpoint, recording the
+// requests it receives.
+type remoteWriteReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []receivedWriteRequest
+	// statuses are returned for the next requests, then 204
+	statuses []int
+}
+
+type receivedWriteRequest struct {
+	header http.Header
+	series []*timeSeries
+}
+
+func newRemoteWriteReceiver(t *testing.T) *remoteWriteReceiver {
+	r := &remoteWriteReceiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		compressed, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		data, err := snappy.Decode(nil, compressed)
+		require.NoError(t, err)
+		writeReq := &writeRequest{}
+		require.NoError(t, proto.Unmarshal(data, writeReq))
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, receivedWriteRequest{header: req.Header, series: writeReq.Timeseries})
+		status := http.StatusNoContent
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	return r
+}
+
+func (r *remoteWriteReceiver) getRequests() []receivedWriteRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests
+}
+
+func TestGetTimeSeries(t *testing.T) {
+	metric := MetricAndContext{
+		Family: &prometheus_models.MetricFamily{
+			Type: prometheus_models.MetricType_COUNTER.Enum(),
+			Metric: []*prometheus_models.Metric{
+				{
+					Label:       []*prometheus_models.LabelPair{makeLabelPair("networkID", "n1"), makeLabelPair("gatewayID", "g1")},
+					Counter:     &prometheus_models.Counter{Value: proto.Float64(3)},
+					TimestampMs: proto.Int64(1000),
+				},
+				// Invalid label names are dropped
+				{
+					Label:   []*prometheus_models.LabelPair{makeLabelPair("invalid-label", "v")},
+					Counter: &prometheus_models.Counter{Value: proto.Float64(1)},
+				},
+			},
+		},
+		Context: MetricContext{MetricName: "rx.bytes"},
+	}
+	expected := []*timeSeries{
+		{
+			Labels:  []*label{{Name: "__name__", Value: "rx_bytes"}, {Name: "gatewayID", Value: "g1"}, {Name: "networkID", Value: "n1"}},
+			Samples: []*sample{{Value: 3, Timestamp: 1000}},
+		},
+	}
+	assert.Equal(t, expected, getTimeSeries(metric, 2000))
+
+	// Summaries and histograms are exposed as Prometheus would, defaulting
+	// to the current time
+	metric = MetricAndContext{
+		Family: &prometheus_models.MetricFamily{
+			Type: prometheus_models.MetricType_SUMMARY.Enum(),
+			Metric: []*prometheus_models.Metric{{
+				Summary: &prometheus_models.Summary{
+					SampleCount: proto.Uint64(4),
+					SampleSum:   proto.Float64(10),
+					Quantile:    []*prometheus_models.Quantile{{Quantile: proto.Float64(0.5), Value: proto.Float64(2)}},
+				},
+			}},
+		},
+		Context: MetricContext{MetricName: "latency"},
+	}
+	assert.Equal(t, []*timeSeries{
+		makeTestSeries("latency", 2, 2000, "quantile", "0.5"),
+		makeTestSeries("latency_sum", 10, 2000),
+		makeTestSeries("latency_count", 4, 2000),
+	}, getTimeSeries(metric, 2000))
+
+	metric = MetricAndContext{
+		Family: &prometheus_models.MetricFamily{
+			Type: prometheus_models.MetricType_HISTOGRAM.Enum(),
+			Metric: []*prometheus_models.Metric{{
+				Histogram: &prometheus_models.Histogram{
+					SampleCount: proto.Uint64(4),
+					SampleSum:   proto.Float64(10),
+					Bucket: []*prometheus_models.Bucket{
+						{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(1)},
+						{UpperBound: proto.Float64(2.5), CumulativeCount: proto.Uint64(3)},
+					},
+				},
+				TimestampMs: proto.Int64(1000),
+			}},
+		},
+		Context: MetricContext{MetricName: "1size"},
+	}
+	assert.Equal(t, []*timeSeries{
+		makeTestSeries("_1size_bucket", 1, 1000, "le", "1"),
+		makeTestSeries("_1size_bucket", 3, 1000, "le", "2.5"),
+		makeTestSeries("_1size_bucket", 4, 1000, "le", "+Inf"),
+		makeTestSeries("_1size_sum", 10, 1000),
+		makeTestSeries("_1size_count", 4, 1000),
+	}, getTimeSeries(metric, 2000))
+}
+
+func TestRemoteWriteExporter(t *testing.T) {
+	receiver := newRemoteWriteReceiver(t)
+	defer receiver.Close()
+
+	exporter, err := newRemoteWriteExporter(RemoteWriteConfig{
+		URL:               receiver.URL,
+		Headers:           map[string]string{"Authorization": "Bearer token"},
+		TenantHeader:      "X-Scope-OrgID",
+		NetworkTenants:    map[string]string{"n1": "tenant1"},
+		DefaultTenant:     "default",
+		MaxSamplesPerSend: 2,
+		MaxQueuedSamples:  5,
+	})
+	require.NoError(t, err)
+
+	err = exporter.Submit([]MetricAndContext{
+		makeGaugeMetric("g1", 1, &GatewayMetricContext{NetworkID: "n1", GatewayID: "gw1"}),
+		makeGaugeMetric("g2", 2, &PushedMetricContext{NetworkID: "n1"}),
+		makeGaugeMetric("g3", 3, &GatewayMetricContext{NetworkID: "n1", GatewayID: "gw1"}),
+		makeGaugeMetric("g4", 4, &GatewayMetricContext{NetworkID: "n2", GatewayID: "gw2"}),
+		makeGaugeMetric("g5", 5, &CloudMetricContext{CloudHost: "host"}),
+	})
+	require.NoError(t, err)
+	// The queue is full
+	err = exporter.Submit([]MetricAndContext{makeGaugeMetric("g6", 6, &CloudMetricContext{CloudHost: "host"})})
+	assert.EqualError(t, err, "remote write queue is full, dropping 1 samples")
+
+	require.NoError(t, exporter.Flush())
+	requests := receiver.getRequests()
+	require.Len(t, requests, 3)
+	for _, req := range requests {
+		assert.Equal(t, "snappy", req.header.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", req.header.Get("Content-Type"))
+		assert.Equal(t, "0.1.0", req.header.Get("X-Prometheus-Remote-Write-Version"))
+		assert.Equal(t, "Bearer token", req.header.Get("Authorization"))
+	}
+	// Tenants are sent in order, in batches of at most 2 samples
+	assert.Equal(t, "default", requests[0].header.Get("X-Scope-OrgID"))
+	assert.Equal(t, []string{"g4", "g5"}, getSeriesNames(requests[0].series))
+	assert.Equal(t, "tenant1", requests[1].header.Get("X-Scope-OrgID"))
+	assert.Equal(t, []string{"g1", "g2"}, getSeriesNames(requests[1].series))
+	assert.Equal(t, "tenant1", requests[2].header.Get("X-Scope-OrgID"))
+	assert.Equal(t, []string{"g3"}, getSeriesNames(requests[2].series))
+	assert.Equal(t, []*sample{{Value: 3, Timestamp: 1000}}, requests[2].series[0].Samples)
+
+	// Flushing an empty queue sends nothing, and the queue has room again
+	require.NoError(t, exporter.Flush())
+	assert.Len(t, receiver.getRequests(), 3)
+	require.NoError(t, exporter.Submit([]MetricAndContext{makeGaugeMetric("g6", 6, &CloudMetricContext{CloudHost: "host"})}))
+}
+
+func TestRemoteWriteExporter_Retries(t *testing.T) {
+	receiver := newRemoteWriteReceiver(t)
+	defer receiver.Close()
+
+	exporter, err := newRemoteWriteExporter(RemoteWriteConfig{URL: receiver.URL, MaxRetries: 2, MinBackoffMs: 1})
+	require.NoError(t, err)
+	metrics := []MetricAndContext{makeGaugeMetric("g1", 1, &CloudMetricContext{CloudHost: "host"})}
+
+	// Transient errors are retried
+	receiver.statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	require.NoError(t, exporter.Submit(metrics))
+	require.NoError(t, exporter.Flush())
+	assert.Len(t, receiver.getRequests(), 3)
+
+	// Until the retries are exhausted
+	receiver.statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+	require.NoError(t, exporter.Submit(metrics))
+	err = exporter.Flush()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "remote write failed with status 500")
+	assert.Len(t, receiver.getRequests(), 6)
+
+	// Other errors aren't retried
+	receiver.statuses = []int{http.StatusBadRequest}
+	require.NoError(t, exporter.Submit(metrics))
+	err = exporter.Flush()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "remote write failed with status 400")
+	assert.Len(t, receiver.getRequests(), 7)
+
+	// Dropped samples aren't resent
+	require.NoError(t, exporter.Flush())
+	assert.Len(t, receiver.getRequests(), 7)
+}
+
+func TestRemoteWriteExporter_FlushWhenFull(t *testing.T) {
+	receiver := newRemoteWriteReceiver(t)
+	defer receiver.Close()
+
+	exporter, err := NewRemoteWriteExporter(RemoteWriteConfig{URL: receiver.URL, MaxSamplesPerSend: 2, FlushIntervalSecs: 3600})
+	require.NoError(t, err)
+	require.NoError(t, exporter.Submit([]MetricAndContext{
+		makeGaugeMetric("g1", 1, &CloudMetricContext{CloudHost: "host"}),
+		makeGaugeMetric("g2", 2, &CloudMetricContext{CloudHost: "host"}),
+	}))
+	assert.Eventually(t, func() bool { return len(receiver.getRequests()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewRemoteWriteExporter_InvalidURL(t *testing.T) {
+	_, err := NewRemoteWriteExporter(RemoteWriteConfig{URL: "cortex:9009"})
+	assert.EqualError(t, err, "remote write URL must be an http or https URL")
+}
+
+func makeGaugeMetric(name string, value float64, ctx AdditionalMetricContext) MetricAndContext {
+	return MetricAndContext{
+		Family: &prometheus_models.MetricFamily{
+			Type: prometheus_models.MetricType_GAUGE.Enum(),
+			Metric: []*prometheus_models.Metric{{
+				Gauge:       &prometheus_models.Gauge{Value: proto.Float64(value)},
+				TimestampMs: proto.Int64(1000),
+			}},
+		},
+		Context: MetricContext{MetricName: name, AdditionalContext: ctx},
+	}
+}
+
+func makeTestSeries(name string, value float64, timestampMs int64, labelNameAndValue ...string) *timeSeries {
+	labels := []*label{{Name: "__name__", Value: name}}
+	if len(labelNameAndValue) == 2 {
+		labels = append(labels, &label{Name: labelNameAndValue[0], Value: labelNameAndValue[1]})
+	}
+	return &timeSeries{Labels: labels, Samples: []*sample{{Value: value, Timestamp: timestampMs}}}
+}
+
+func makeLabelPair(name, value string) *prometheus_models.LabelPair {
+	return &prometheus_models.LabelPair{Name: proto.String(name), Value: proto.String(value)}
+}
+
+func getSeriesNames(series []*timeSeries) []string {
+	var ret []string
+	for _, s := range series {
+		ret = append(ret, s.Labels[0].Value)
+	}
+	return ret
+}
@@ -23,6 +23,7 @@ import (
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/metricsd"
 	"magma/orc8r/cloud/go/services/metricsd/collection"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
 	"magma/orc8r/cloud/go/services/metricsd/obsidian/handlers"
 	"magma/orc8r/cloud/go/services/metricsd/servicers"
 	"magma/orc8r/lib/go/protos"
@@ -48,6 +49,21 @@ func main() {
 	}
 
 	controllerServicer := servicers.NewMetricsControllerServer()
+	serviceConfig := metricsd.GetServiceConfig()
+	if serviceConfig.RemoteWrite.URL != "" {
+		remoteWriteExporter, err := exporters.NewRemoteWriteExporter(serviceConfig.RemoteWrite)
+		if err != nil {
+			glog.Fatalf("Error creating remote write exporter: %s", err)
+		}
+		controllerServicer.RegisterExporter(remoteWriteExporter)
+	}
+	if serviceConfig.OTLP.Endpoint != "" {
+		otlpExporter, err := exporters.NewOTLPExporter(serviceConfig.OTLP)
+		if err != nil {
+			glog.Fatalf("Error creating OTLP exporter: %s", err)
+		}
+		controllerServicer.RegisterExporter(otlpExporter)
+	}
 	protos.RegisterMetricsControllerServer(srv.GrpcServer, controllerServicer)
 
 	swagger_protos.RegisterSwaggerSpecServer(srv.GrpcServer, swagger.NewSpecServicerFromFile(metricsd.ServiceName))
@@ -45,7 +45,7 @@ func (srv *MetricsControllerServer) Push(ctx context.Context, in *protos.PushedM
 		return new(protos.Void), nil
 	}
 
-	metricsExporters, err := metricsd.GetMetricsExporters()
+	metricsExporters, err := srv.getExporters()
 	if err != nil {
 		return &protos.Void{}, err
 	}
@@ -80,7 +80,7 @@ func (srv *MetricsControllerServer) Collect(ctx context.Context, in *protos.Metr
 	glog.V(2).Infof("collecting %v metrics from gateway %v\n", len(in.Family), in.GatewayId)
 
 	metricsToSubmit := metricsContainerToMetricAndContexts(in, networkID, gatewayID)
-	metricsExporters, err := metricsd.GetMetricsExporters()
+	metricsExporters, err := srv.getExporters()
 	if err != nil {
 		return &protos.Void{}, err
 	}
@@ -99,7 +99,7 @@ func (srv *MetricsControllerServer) Collect(ctx context.Context, in *protos.Metr
 func (srv *MetricsControllerServer) ConsumeCloudMetrics(inputChan chan *prom_proto.MetricFamily, hostName string) {
 	for family := range inputChan {
 		metricsToSubmit := preprocessCloudMetrics(family, hostName)
-		metricsExporters, err := metricsd.GetMetricsExporters()
+		metricsExporters, err := srv.getExporters()
 		if err != nil {
 			glog.Error(err)
 			continue
@@ -133,6 +133,18 @@ func (srv *MetricsControllerServer) RegisterExporter(e exporters.Exporter) []exp
 	return srv.exporters
 }
 
+// getExporters returns the exporters registered with the servicer, followed
+// by the remote exporter services.
+func (srv *MetricsControllerServer) getExporters() ([]exporters.Exporter, error) {
+	remoteExporters, err := metricsd.GetMetricsExporters()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]exporters.Exporter, 0, len(srv.exporters)+len(remoteExporters))
+	ret = append(ret, srv.exporters...)
+	return append(ret, remoteExporters...), nil
+}
+
 func metricsContainerToMetricAndContexts(
 	in *protos.MetricsContainer,
 	networkID, gatewayID string,
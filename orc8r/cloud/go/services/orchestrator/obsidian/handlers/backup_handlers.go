@@ -0,0 +1,111 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/configurator/backup"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const (
+	ParamDryRun           = "dry_run"
+	ParamClearPhysicalIDs = "clear_physical_ids"
+	ParamRewriteID        = "rewrite_id"
+)
+
+// exportNetworkHandler returns an archive of the network, its entities, and
+// its tenants, which can be imported back through importNetworkHandler.
+func exportNetworkHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	archive, err := backup.Export(c.Request().Context(), networkID)
+	if err == merrors.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, archive)
+}
+
+// importNetworkHandler imports a network archive into the network of the
+// path, creating the network if it doesn't exist.
+//
+// Set the dry_run query parameter to validate the import without applying
+// it, clear_physical_ids to import the entities without their physical IDs,
+// and rewrite_id, any number of times, to import archived IDs under new IDs.
+func importNetworkHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	dryRun, nerr := getBoolQueryParam(c, ParamDryRun)
+	if nerr != nil {
+		return nerr
+	}
+	clearPhysicalIDs, nerr := getBoolQueryParam(c, ParamClearPhysicalIDs)
+	if nerr != nil {
+		return nerr
+	}
+	idRewrites := map[string]string{}
+	for _, rewrite := range c.QueryParams()[ParamRewriteID] {
+		archivedID, newID, err := backup.ParseIDRewrite(rewrite)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		idRewrites[archivedID] = newID
+	}
+	archive := &backup.Archive{}
+	if err := c.Bind(archive); err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+
+	res, err := backup.Import(
+		c.Request().Context(),
+		archive,
+		backup.ImportOptions{NetworkID: networkID, IDRewrites: idRewrites, ClearPhysicalIDs: clearPhysicalIDs, DryRun: dryRun},
+	)
+	if errors.Cause(err) == backup.ErrInvalidArchive {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	if dryRun {
+		return c.JSON(http.StatusOK, res)
+	}
+	return c.JSON(http.StatusCreated, res)
+}
+
+func getBoolQueryParam(c echo.Context, param string) (bool, *echo.HTTPError) {
+	valStr := c.QueryParam(param)
+	if valStr == "" {
+		return false, nil
+	}
+	val, err := strconv.ParseBool(valStr)
+	if err != nil {
+		return false, obsidian.HttpError(fmt.Errorf("invalid %s parameter: %s", param, err), http.StatusBadRequest)
+	}
+	return val, nil
+}
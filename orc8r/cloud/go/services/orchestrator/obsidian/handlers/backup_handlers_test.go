@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/backup"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/tenants"
+	tenantsTestInit "magma/orc8r/cloud/go/services/tenants/test_init"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupHandlers(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	tenantsTestInit.StartTestService(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	exportNetwork := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/export", obsidian.GET).HandlerFunc
+	importNetwork := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/import", obsidian.POST).HandlerFunc
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Type: "t", Name: "lab", Description: "lab network"}, serdes.Network)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntity(
+		"n1",
+		configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "tier1", Name: "tier", Config: &models.Tier{Version: "1.0.0"}},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	_, err = tenants.CreateTenant(context.Background(), 1, &protos.Tenant{Name: "lab_tenant", Networks: []string{"n1"}})
+	require.NoError(t, err)
+
+	// Export
+	rec := runBackupRequest(e, exportNetwork, "GET", "/magma/v1/networks/n1/export", "n1", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	archive := &backup.Archive{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), archive))
+	assert.Equal(t, backup.ArchiveVersion, archive.Version)
+	assert.Equal(t, backup.Network{ID: "n1", Type: "t", Name: "lab", Description: "lab network"}, archive.Network)
+	require.Len(t, archive.Entities, 1)
+	assert.Equal(t, orc8r.UpgradeTierEntityType, archive.Entities[0].Type)
+	assert.Equal(t, "tier1", archive.Entities[0].Key)
+	assert.Equal(t, []backup.Tenant{{ID: 1, Name: "lab_tenant"}}, archive.Tenants)
+
+	rec = runBackupRequest(e, exportNetwork, "GET", "/magma/v1/networks/n2/export", "n2", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Dry run
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n2/import?dry_run=true", "n2", archive)
+	require.Equal(t, http.StatusOK, rec.Code)
+	res := backup.ImportResult{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, backup.ImportResult{NetworkID: "n2", CreatedNetwork: true, Entities: 1, Tenants: []int64{}, DryRun: true}, res)
+	exists, err := configurator.DoesNetworkExist("n2")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Clone
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n2/import", "n2", archive)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	res = backup.ImportResult{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, backup.ImportResult{NetworkID: "n2", CreatedNetwork: true, Entities: 1, Tenants: []int64{}}, res)
+
+	network, err := configurator.LoadNetwork("n2", true, true, serdes.Network)
+	require.NoError(t, err)
+	assert.Equal(t, "lab", network.Name)
+	tier, err := configurator.LoadEntity("n2", orc8r.UpgradeTierEntityType, "tier1", configurator.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true}, serdes.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, "tier", tier.Name)
+	assert.Equal(t, models.TierVersion("1.0.0"), tier.Config.(*models.Tier).Version)
+	tenant, err := tenants.GetTenant(context.Background(), 1)
+	require.NoError(t, err)
+	// Tenant memberships aren't restored over REST
+	assert.Equal(t, []string{"n1"}, tenant.Networks)
+
+	// Entities already exist
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n2/import", "n2", archive)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Rewrite the entity IDs which already exist
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n2/import?rewrite_id=tier1:tier2", "n2", archive)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	tier, err = configurator.LoadEntity("n2", orc8r.UpgradeTierEntityType, "tier2", configurator.EntityLoadCriteria{LoadConfig: true}, serdes.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, models.TierVersion("1.0.0"), tier.Config.(*models.Tier).Version)
+
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n3/import?rewrite_id=tier1", "n3", archive)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Bad archive version
+	archive.Version = 0
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n3/import", "n3", archive)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = runBackupRequest(e, importNetwork, "POST", "/magma/v1/networks/n3/import?dry_run=maybe", "n3", archive)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func runBackupRequest(e *echo.Echo, handler echo.HandlerFunc, method, url, networkID string, payload interface{}) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	if payload != nil {
+		_ = json.NewEncoder(&body).Encode(payload)
+	}
+	req := httptest.NewRequest(method, url, &body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues(networkID)
+	if err := handler(c); err != nil {
+		c.Error(err)
+	}
+	return rec
+}
@@ -86,6 +86,13 @@ func GetPartialReadGatewayHandler(path string, model PartialGatewayModel, serdes
 			} else if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
+			mdGw, err := configurator.LoadEntity(networkID, orc8r.MagmadGatewayType, gatewayID, configurator.EntityLoadCriteria{}, serdes)
+			if err == merrors.ErrNotFound {
+				return obsidian.HttpError(err, http.StatusNotFound)
+			} else if err != nil {
+				return obsidian.HttpError(err, http.StatusInternalServerError)
+			}
+			obsidian.SetETag(c, mdGw.Version)
 			return c.JSON(http.StatusOK, model)
 		},
 	}
@@ -120,14 +127,23 @@ func GetPartialUpdateGatewayHandler(path string, model PartialGatewayModel, serd
 			if nerr != nil {
 				return nerr
 			}
+			expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+			if nerr != nil {
+				return nerr
+			}
 
 			updates, err := requestedUpdate.(PartialGatewayModel).ToUpdateCriteria(networkID, gatewayID)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			_, err = configurator.UpdateEntities(networkID, updates, serdes)
+			writes := make([]configurator.EntityWriteOperation, 0, len(updates))
+			for _, update := range updates {
+				writes = append(writes, update)
+			}
+			writes = withGatewayVersionCheck(gatewayID, expectedVersion, writes)
+			err = configurator.WriteEntitiesWithContext(c.Request().Context(), networkID, writes, serdes)
 			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
+				return obsidian.WriteHttpError(err)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
@@ -203,7 +203,7 @@ func CreateGateway(c echo.Context, model MagmadEncompassingGateway, entitySerdes
 		writes = append(writes, subGateway.GetAdditionalWritesOnCreate()...)
 	}
 
-	if err = configurator.WriteEntities(nid, writes, entitySerdes); err != nil {
+	if err = configurator.WriteEntitiesWithContext(c.Request().Context(), nid, writes, entitySerdes); err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "error creating gateway"), http.StatusInternalServerError)
 	}
 	return nil
@@ -214,14 +214,23 @@ func getGatewayHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	ret, nerr := LoadMagmadGateway(c.Request().Context(), nid, gid)
+	ret, version, nerr := LoadMagmadGatewayWithVersion(c.Request().Context(), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, ret)
 }
 
 func LoadMagmadGateway(ctx context.Context, networkID string, gatewayID string) (*models.MagmadGateway, *echo.HTTPError) {
+	ret, _, nerr := LoadMagmadGatewayWithVersion(ctx, networkID, gatewayID)
+	return ret, nerr
+}
+
+// LoadMagmadGatewayWithVersion loads a magmad gateway along with the version
+// of its entity, which versions the gateway as a whole.
+// See withGatewayVersionCheck.
+func LoadMagmadGatewayWithVersion(ctx context.Context, networkID string, gatewayID string) (*models.MagmadGateway, uint64, *echo.HTTPError) {
 	ent, err := configurator.LoadEntity(
 		networkID, orc8r.MagmadGatewayType, gatewayID,
 		configurator.EntityLoadCriteria{
@@ -233,19 +242,19 @@ func LoadMagmadGateway(ctx context.Context, networkID string, gatewayID string)
 		serdes.Entity,
 	)
 	if err == merrors.ErrNotFound {
-		return nil, echo.ErrNotFound
+		return nil, 0, echo.ErrNotFound
 	}
 	if err != nil {
-		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+		return nil, 0, obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
 	dev, err := device.GetDevice(ctx, networkID, orc8r.AccessGatewayRecordType, ent.PhysicalID, serdes.Device)
 	if err != nil && err != merrors.ErrNotFound {
-		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+		return nil, 0, obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 	status, err := wrappers.GetGatewayStatus(ctx, networkID, ent.PhysicalID)
 	if err != nil && err != merrors.ErrNotFound {
-		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+		return nil, 0, obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
 	// If the gateway/network is malformed, we could get no corresponding
@@ -254,7 +263,7 @@ func LoadMagmadGateway(ctx context.Context, networkID string, gatewayID string)
 	if dev != nil {
 		devCasted = dev.(*models.GatewayDevice)
 	}
-	return (&models.MagmadGateway{}).FromBackendModels(ent, devCasted, status), nil
+	return (&models.MagmadGateway{}).FromBackendModels(ent, devCasted, status), ent.Version, nil
 }
 
 func updateGatewayHandler(c echo.Context) error {
@@ -281,6 +290,10 @@ func UpdateGateway(c echo.Context, nid string, gid string, model MagmadEncompass
 		err := fmt.Errorf("gateway ID cannot be updated: gateway ID from parameter (%s) and payload (%s) must match", gid, mdGateway.ID)
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 
 	var entsToLoad []storage.TypeAndKey
 	entsToLoad = append(entsToLoad, mdGateway.GetAdditionalLoadsOnUpdate()...)
@@ -307,10 +320,11 @@ func UpdateGateway(c echo.Context, nid string, gid string, model MagmadEncompass
 	if nerr != nil {
 		return nerr
 	}
+	writes = withGatewayVersionCheck(gid, expectedVersion, writes)
 
-	err = configurator.WriteEntities(nid, writes, entitySerdes)
+	err = configurator.WriteEntitiesWithContext(c.Request().Context(), nid, writes, entitySerdes)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 
 	// Device info is cheap to update, so just do it all the time if
@@ -359,7 +373,11 @@ func deleteGatewayHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := DeleteMagmadGateway(c.Request().Context(), nid, gid, nil)
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := DeleteMagmadGatewayIfMatch(c.Request().Context(), nid, gid, expectedVersion, nil)
 	if err != nil {
 		return makeErr(err)
 	}
@@ -367,16 +385,33 @@ func deleteGatewayHandler(c echo.Context) error {
 }
 
 func DeleteMagmadGateway(ctx context.Context, networkID, gatewayID string, additionalDeletes storage.TKs) error {
+	return DeleteMagmadGatewayIfMatch(ctx, networkID, gatewayID, nil, additionalDeletes)
+}
+
+// DeleteMagmadGatewayIfMatch deletes a magmad gateway and the additional
+// entities. If expectedVersion is set, nothing is deleted unless the version
+// of the magmad gateway matches.
+func DeleteMagmadGatewayIfMatch(ctx context.Context, networkID, gatewayID string, expectedVersion *uint64, additionalDeletes storage.TKs) error {
 	mdGw, err := configurator.LoadEntity(networkID, orc8r.MagmadGatewayType, gatewayID, configurator.EntityLoadCriteria{}, serdes.Entity)
 	if err != nil {
 		return err
 	}
 
-	var deletes storage.TKs
-	deletes = append(deletes, storage.TypeAndKey{Type: orc8r.MagmadGatewayType, Key: gatewayID})
-	deletes = append(deletes, additionalDeletes...)
+	var deletes []configurator.EntityWriteOperation
+	deletes = append(deletes, configurator.EntityUpdateCriteria{
+		Type:            orc8r.MagmadGatewayType,
+		Key:             gatewayID,
+		DeleteEntity:    true,
+		ExpectedVersion: expectedVersion,
+	})
+	for _, tk := range additionalDeletes {
+		deletes = append(deletes, configurator.EntityUpdateCriteria{Type: tk.Type, Key: tk.Key, DeleteEntity: true})
+	}
 
-	err = configurator.DeleteEntities(networkID, deletes)
+	err = configurator.WriteEntitiesWithContext(ctx, networkID, deletes, serdes.Entity)
+	if err == merrors.ErrVersionConflict {
+		return obsidian.WriteHttpError(err)
+	}
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "error deleting gateway"), http.StatusInternalServerError)
 	}
@@ -419,6 +454,29 @@ func GetStateHandler(c echo.Context) error {
 	return c.JSON(http.StatusOK, st)
 }
 
+// withGatewayVersionCheck conditions the writes to a gateway on the version
+// of its magmad gateway, failing unless the current version matches if
+// expectedVersion is set.
+// The magmad gateway's version is the version of the gateway as a whole, so
+// it's bumped by any write to the gateway's entities: if the writes don't
+// update the magmad gateway, an empty update to it is prepended.
+func withGatewayVersionCheck(gatewayID string, expectedVersion *uint64, writes []configurator.EntityWriteOperation) []configurator.EntityWriteOperation {
+	for i, write := range writes {
+		update, ok := write.(configurator.EntityUpdateCriteria)
+		if ok && update.Type == orc8r.MagmadGatewayType && update.Key == gatewayID {
+			update.ExpectedVersion = expectedVersion
+			writes[i] = update
+			return writes
+		}
+	}
+	versionCheck := configurator.EntityUpdateCriteria{
+		Type:            orc8r.MagmadGatewayType,
+		Key:             gatewayID,
+		ExpectedVersion: expectedVersion,
+	}
+	return append([]configurator.EntityWriteOperation{versionCheck}, writes...)
+}
+
 func makeGateways(
 	entsByTK configurator.NetworkEntitiesByTK,
 	devicesByID map[string]interface{},
@@ -437,6 +495,9 @@ func makeGateways(
 }
 
 func makeErr(err error) *echo.HTTPError {
+	if nerr, ok := err.(*echo.HTTPError); ok {
+		return nerr
+	}
 	if err == merrors.ErrNotFound {
 		return echo.ErrNotFound
 	}
@@ -641,6 +641,110 @@ func TestDeleteGateway(t *testing.T) {
 	assert.Equal(t, expectedEnts, actualEnts)
 }
 
+func TestGatewayHandlers_IfMatch(t *testing.T) {
+	test_init.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"}, serdes.Network)
+	assert.NoError(t, err)
+
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{
+				Type: orc8r.MagmadGatewayType, Key: "g1",
+				Name: "foobar", Description: "foo bar",
+				PhysicalID: "hw1",
+			},
+			{
+				Type: orc8r.UpgradeTierEntityType, Key: "t1",
+				Associations: []storage.TypeAndKey{{Type: orc8r.MagmadGatewayType, Key: "g1"}},
+			},
+		},
+		serdes.Entity,
+	)
+	assert.NoError(t, err)
+	err = device.RegisterDevice(context.Background(), "n1", orc8r.AccessGatewayRecordType, "hw1", &models.GatewayDevice{HardwareID: "hw1", Key: &models.ChallengeKey{KeyType: "ECHO"}}, serdes.Device)
+	assert.NoError(t, err)
+	ent, err := configurator.LoadEntity("n1", orc8r.MagmadGatewayType, "g1", configurator.EntityLoadCriteria{}, serdes.Entity)
+	assert.NoError(t, err)
+	version := ent.Version
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/n1/gateways"
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	getGateway := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id", obsidian.GET).HandlerFunc
+	deleteGateway := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id", obsidian.DELETE).HandlerFunc
+	getName := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/name", obsidian.GET).HandlerFunc
+	updateName := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/name", obsidian.PUT).HandlerFunc
+
+	// Both the gateway and its parts are tagged with the magmad gateway's version
+	tc := tests.Test{
+		Method:          "GET",
+		URL:             testURLRoot + "/g1",
+		Handler:         getGateway,
+		ParamNames:      []string{"network_id", "gateway_id"},
+		ParamValues:     []string{"n1", "g1"},
+		ExpectedStatus:  200,
+		ExpectedHeaders: map[string]string{obsidian.ETagHeader: obsidian.VersionETag(version)},
+	}
+	tests.RunUnitTest(t, e, tc)
+	tc.URL, tc.Handler = testURLRoot+"/g1/name", getName
+	tests.RunUnitTest(t, e, tc)
+
+	// Stale partial update
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            testURLRoot + "/g1/name",
+		Handler:        updateName,
+		Payload:        tests.JSONMarshaler("barbaz"),
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version + 1)},
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	ent, err = configurator.LoadEntity("n1", orc8r.MagmadGatewayType, "g1", configurator.EntityLoadCriteria{LoadMetadata: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, "foobar", ent.Name)
+	assert.Equal(t, version, ent.Version)
+
+	// Partial update at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	ent, err = configurator.LoadEntity("n1", orc8r.MagmadGatewayType, "g1", configurator.EntityLoadCriteria{LoadMetadata: true}, serdes.Entity)
+	assert.NoError(t, err)
+	assert.Equal(t, "barbaz", ent.Name)
+	assert.NotEqual(t, version, ent.Version)
+
+	// Stale delete
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            testURLRoot + "/g1",
+		Handler:        deleteGateway,
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)},
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	exists, err := configurator.DoesEntityExist("n1", orc8r.MagmadGatewayType, "g1")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// Delete at the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(ent.Version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	exists, err = configurator.DoesEntityExist("n1", orc8r.MagmadGatewayType, "g1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestGetPartialReadHandlers(t *testing.T) {
 	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
 	defer clock.UnfreezeClock(t)
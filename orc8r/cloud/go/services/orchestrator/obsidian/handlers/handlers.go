@@ -38,6 +38,15 @@ const (
 	ManageNetworkDNSPath               = ManageNetworkPath + obsidian.UrlSep + "dns"
 	ManageNetworkDNSRecordsPath        = ManageNetworkDNSPath + obsidian.UrlSep + "records"
 	ManageNetworkDNSRecordByDomainPath = ManageNetworkDNSRecordsPath + obsidian.UrlSep + ":domain"
+	NetworkHistoryPath                 = ManageNetworkPath + obsidian.UrlSep + "history"
+	EntityHistoryPath                  = NetworkHistoryPath + obsidian.UrlSep + "entities" + obsidian.UrlSep + ":entity_type" + obsidian.UrlSep + ":entity_key"
+	RevertToRevisionPath               = NetworkHistoryPath + obsidian.UrlSep + "revisions" + obsidian.UrlSep + ":revision_id" + obsidian.UrlSep + "revert"
+	ExportNetworkPath                  = ManageNetworkPath + obsidian.UrlSep + "export"
+	ImportNetworkPath                  = ManageNetworkPath + obsidian.UrlSep + "import"
+	ManageMconfigRolloutPath           = ManageNetworkPath + obsidian.UrlSep + "mconfig_rollout"
+	AdvanceMconfigRolloutPath          = ManageMconfigRolloutPath + obsidian.UrlSep + "advance"
+	HaltMconfigRolloutPath             = ManageMconfigRolloutPath + obsidian.UrlSep + "halt"
+	ResumeMconfigRolloutPath           = ManageMconfigRolloutPath + obsidian.UrlSep + "resume"
 
 	Gateways                     = "gateways"
 	ListGatewaysPath             = ManageNetworkPath + obsidian.UrlSep + Gateways
@@ -48,6 +57,8 @@ const (
 	ManageGatewayDevicePath      = ManageGatewayPath + obsidian.UrlSep + "device"
 	ManageGatewayStatePath       = ManageGatewayPath + obsidian.UrlSep + "status"
 	ManageGatewayTierPath        = ManageGatewayPath + obsidian.UrlSep + "tier"
+	ManageGatewayMconfigPath     = ManageGatewayPath + obsidian.UrlSep + "mconfig"
+	PreviewGatewayMconfigPath    = ManageGatewayMconfigPath + obsidian.UrlSep + "preview"
 
 	Channels               = "channels"
 	ListChannelsPath       = obsidian.V1Root + Channels
@@ -77,6 +88,17 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageNetworkDNSRecordByDomainPath, Methods: obsidian.GET, HandlerFunc: ReadDNSRecord},
 		{Path: ManageNetworkDNSRecordByDomainPath, Methods: obsidian.PUT, HandlerFunc: UpdateDNSRecord},
 		{Path: ManageNetworkDNSRecordByDomainPath, Methods: obsidian.DELETE, HandlerFunc: DeleteDNSRecord},
+		{Path: NetworkHistoryPath, Methods: obsidian.GET, HandlerFunc: listNetworkHistoryHandler},
+		{Path: EntityHistoryPath, Methods: obsidian.GET, HandlerFunc: listEntityHistoryHandler},
+		{Path: RevertToRevisionPath, Methods: obsidian.POST, HandlerFunc: revertToRevisionHandler},
+		{Path: ExportNetworkPath, Methods: obsidian.GET, HandlerFunc: exportNetworkHandler},
+		{Path: ImportNetworkPath, Methods: obsidian.POST, HandlerFunc: importNetworkHandler},
+		{Path: ManageMconfigRolloutPath, Methods: obsidian.GET, HandlerFunc: getMconfigRolloutHandler},
+		{Path: ManageMconfigRolloutPath, Methods: obsidian.POST, HandlerFunc: startMconfigRolloutHandler},
+		{Path: ManageMconfigRolloutPath, Methods: obsidian.DELETE, HandlerFunc: cancelMconfigRolloutHandler},
+		{Path: AdvanceMconfigRolloutPath, Methods: obsidian.POST, HandlerFunc: advanceMconfigRolloutHandler},
+		{Path: HaltMconfigRolloutPath, Methods: obsidian.POST, HandlerFunc: haltMconfigRolloutHandler},
+		{Path: ResumeMconfigRolloutPath, Methods: obsidian.POST, HandlerFunc: resumeMconfigRolloutHandler},
 
 		// Magma V1 Gateways
 		{Path: ListGatewaysPath, Methods: obsidian.GET, HandlerFunc: listGatewaysHandler},
@@ -85,6 +107,7 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageGatewayPath, Methods: obsidian.PUT, HandlerFunc: updateGatewayHandler},
 		{Path: ManageGatewayPath, Methods: obsidian.DELETE, HandlerFunc: deleteGatewayHandler},
 		{Path: ManageGatewayStatePath, Methods: obsidian.GET, HandlerFunc: GetStateHandler},
+		{Path: ManageGatewayMconfigPath, Methods: obsidian.GET, HandlerFunc: getGatewayMconfigHandler},
 
 		// Upgrades
 		{Path: ListChannelsPath, Methods: obsidian.GET, HandlerFunc: listChannelsHandler},
@@ -122,6 +145,7 @@ func GetObsidianHandlers() []obsidian.Handler {
 	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayConfigPath, &models2.MagmadGatewayConfigs{}, serdes.Entity)...)
 	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayTierPath, new(models2.TierID), serdes.Entity)...)
 	ret = append(ret, GetGatewayDeviceHandlers(ManageGatewayDevicePath, serdes.Device)...)
+	ret = append(ret, GetPreviewGatewayMconfigHandler(PreviewGatewayMconfigPath, serdes.Network, serdes.Entity))
 
 	ret = append(ret, GetPartialEntityHandlers(ManageTierNamePath, "tier_id", new(models2.TierName), serdes.Entity)...)
 	ret = append(ret, GetPartialEntityHandlers(ManageTierVersionPath, "tier_id", new(models2.TierVersion), serdes.Entity)...)
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	ParamPageSize  = "page_size"
+	ParamPageToken = "page_token"
+)
+
+// listNetworkHistoryHandler lists the configuration changes of a network and
+// all of its entities, most recent first.
+//
+// The returned revisions can be paginated using the page_size and page_token
+// query parameters.
+func listNetworkHistoryHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	return listHistory(c, networkID, configurator.RevisionLoadFilter{})
+}
+
+// listEntityHistoryHandler lists the configuration changes of a single
+// network entity, most recent first. Changes of entities which were deleted
+// are still listed.
+func listEntityHistoryHandler(c echo.Context) error {
+	params, nerr := obsidian.GetParamValues(c, "network_id", "entity_type", "entity_key")
+	if nerr != nil {
+		return nerr
+	}
+	filter := configurator.RevisionLoadFilter{Type: &params[1], Key: &params[2]}
+	return listHistory(c, params[0], filter)
+}
+
+func revertToRevisionHandler(c echo.Context) error {
+	params, nerr := obsidian.GetParamValues(c, "network_id", "revision_id")
+	if nerr != nil {
+		return nerr
+	}
+	err := configurator.RevertToRevision(c.Request().Context(), params[0], params[1])
+	if err == merrors.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		return obsidian.WriteHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func listHistory(c echo.Context, networkID string, filter configurator.RevisionLoadFilter) error {
+	criteria := configurator.RevisionLoadCriteria{PageToken: c.QueryParam(ParamPageToken)}
+	if pageSizeParam := c.QueryParam(ParamPageSize); pageSizeParam != "" {
+		pageSize, err := strconv.ParseUint(pageSizeParam, 10, 32)
+		if err != nil {
+			err := fmt.Errorf("invalid page size parameter: %s", err)
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		criteria.PageSize = uint32(pageSize)
+	}
+
+	// Configs are left serialized, so the history also covers config types
+	// of other modules
+	revisions, nextPageToken, err := configurator.LoadRevisions(networkID, filter, criteria, serde.NewRegistry())
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	ret := models.PaginatedConfigRevisions{
+		NextPageToken: nextPageToken,
+		Revisions:     make([]*models.ConfigRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		ret.Revisions = append(ret.Revisions, (&models.ConfigRevision{}).FromBackendModel(revision))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
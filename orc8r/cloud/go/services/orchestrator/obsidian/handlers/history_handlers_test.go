@@ -0,0 +1,173 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryHandlers(t *testing.T) {
+	test_init.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listNetworkHistory := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/history", obsidian.GET).HandlerFunc
+	listEntityHistory := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/history/entities/:entity_type/:entity_key", obsidian.GET).HandlerFunc
+	revert := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/history/revisions/:revision_id/revert", obsidian.POST).HandlerFunc
+
+	aliceCtx := configurator.WithOperator(context.Background(), "alice")
+	bobCtx := configurator.WithOperator(context.Background(), "bob")
+	err := configurator.CreateNetworkWithContext(aliceCtx, configurator.Network{ID: "n1", Type: "t", Name: "net"}, serdes.Network)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntityWithContext(
+		aliceCtx, "n1",
+		configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "tier1", Name: "tier", Config: &models.Tier{Version: "1.0.0"}},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	_, err = configurator.UpdateEntityWithContext(
+		bobCtx, "n1",
+		configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: "tier1", NewName: swag.String("renamed")},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+
+	// Network history, paginated
+	var page models.PaginatedConfigRevisions
+	rec := runHistoryRequest(t, e, listNetworkHistory, "GET", "/magma/v1/networks/n1/history?page_size=2", []string{"network_id"}, []string{"n1"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.Len(t, page.Revisions, 2)
+	assert.NotEmpty(t, page.NextPageToken)
+
+	rename := page.Revisions[0]
+	assert.Equal(t, models.ConfigRevisionOperationUpdate, rename.Operation)
+	assert.Equal(t, "bob", rename.Operator)
+	assert.Equal(t, orc8r.UpgradeTierEntityType, rename.EntityType)
+	assert.Equal(t, "tier1", rename.EntityKey)
+	assert.Equal(t, strfmt.DateTime(time.Unix(1000, 0)).String(), rename.Timestamp.String())
+	assert.Equal(t, "tier", rename.Before.Name)
+	assert.Equal(t, "renamed", rename.After.Name)
+	assert.Equal(t, "1.0.0", rename.After.Config.(map[string]interface{})["version"])
+
+	creation := page.Revisions[1]
+	assert.Equal(t, models.ConfigRevisionOperationCreate, creation.Operation)
+	assert.Equal(t, "alice", creation.Operator)
+	assert.Nil(t, creation.Before)
+
+	rec = runHistoryRequest(t, e, listNetworkHistory, "GET", "/magma/v1/networks/n1/history?page_size=2&page_token="+page.NextPageToken, []string{"network_id"}, []string{"n1"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	page = models.PaginatedConfigRevisions{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.Len(t, page.Revisions, 1)
+	assert.Empty(t, page.NextPageToken)
+	assert.Empty(t, page.Revisions[0].EntityType)
+	assert.Equal(t, "net", page.Revisions[0].After.Name)
+
+	rec = runHistoryRequest(t, e, listNetworkHistory, "GET", "/magma/v1/networks/n1/history?page_size=foo", []string{"network_id"}, []string{"n1"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Entity history
+	rec = runHistoryRequest(
+		t, e, listEntityHistory, "GET", "/magma/v1/networks/n1/history/entities/upgrade_tier/tier1",
+		[]string{"network_id", "entity_type", "entity_key"}, []string{"n1", orc8r.UpgradeTierEntityType, "tier1"},
+	)
+	require.Equal(t, http.StatusOK, rec.Code)
+	page = models.PaginatedConfigRevisions{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.Len(t, page.Revisions, 2)
+	assert.Equal(t, rename.ID, page.Revisions[0].ID)
+	assert.Equal(t, creation.ID, page.Revisions[1].ID)
+
+	// Revert the rename
+	rec = runHistoryRequest(
+		t, e, revert, "POST", "/magma/v1/networks/n1/history/revisions/"+creation.ID+"/revert",
+		[]string{"network_id", "revision_id"}, []string{"n1", creation.ID},
+	)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	tier, err := configurator.LoadEntity("n1", orc8r.UpgradeTierEntityType, "tier1", configurator.EntityLoadCriteria{LoadMetadata: true}, serdes.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, "tier", tier.Name)
+
+	// Reverting an entity of a gateway bumps the version of its magmad
+	// gateway, which the gateway's ETag is derived from
+	_, err = configurator.CreateEntities(
+		"n1",
+		configurator.NetworkEntities{
+			{Type: "test_gateway", Key: "gw1", Name: "child"},
+			{Type: orc8r.MagmadGatewayType, Key: "gw1", Associations: storage.TKs{{Type: "test_gateway", Key: "gw1"}}},
+		},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	_, err = configurator.UpdateEntity("n1", configurator.EntityUpdateCriteria{Type: "test_gateway", Key: "gw1", NewName: swag.String("renamed")}, serdes.Entity)
+	require.NoError(t, err)
+	gateway, err := configurator.LoadEntity("n1", orc8r.MagmadGatewayType, "gw1", configurator.EntityLoadCriteria{}, serdes.Entity)
+	require.NoError(t, err)
+	childRevisions, _, err := configurator.LoadRevisions(
+		"n1", configurator.RevisionLoadFilter{Type: swag.String("test_gateway"), Key: swag.String("gw1")}, configurator.RevisionLoadCriteria{}, serdes.Entity)
+	require.NoError(t, err)
+	require.Len(t, childRevisions, 2)
+	childCreation := childRevisions[1].ID
+	rec = runHistoryRequest(
+		t, e, revert, "POST", "/magma/v1/networks/n1/history/revisions/"+childCreation+"/revert",
+		[]string{"network_id", "revision_id"}, []string{"n1", childCreation},
+	)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	revertedGateway, err := configurator.LoadEntity("n1", orc8r.MagmadGatewayType, "gw1", configurator.EntityLoadCriteria{}, serdes.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, gateway.Version+1, revertedGateway.Version)
+
+	rec = runHistoryRequest(
+		t, e, revert, "POST", "/magma/v1/networks/n1/history/revisions/nope/revert",
+		[]string{"network_id", "revision_id"}, []string{"n1", "nope"},
+	)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func runHistoryRequest(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, method, url string, paramNames, paramValues []string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames(paramNames...)
+	c.SetParamValues(paramValues...)
+	if err := handler(c); err != nil {
+		c.Error(err)
+	}
+	return rec
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	merrors "magma/orc8r/lib/go/errors"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+// GatewayMconfig is the mconfig of a gateway, with each config in its JSON
+// representation.
+type GatewayMconfig struct {
+	ConfigsByKey map[string]json.RawMessage `json:"configs_by_key"`
+}
+
+// MconfigPreview holds the configuration changes to preview a gateway's
+// mconfig against. Configs are given in their REST representation, and are
+// deserialized and validated with the serdes of the previewing handler.
+// Only config changes can be previewed: entities can't be created, deleted,
+// or have their associations changed.
+type MconfigPreview struct {
+	// NetworkConfigs maps network config types to the configs to set
+	NetworkConfigs         map[string]json.RawMessage `json:"network_configs,omitempty"`
+	NetworkConfigsToDelete []string                   `json:"network_configs_to_delete,omitempty"`
+	EntityConfigs          []PreviewedEntityConfig    `json:"entity_configs,omitempty"`
+}
+
+// PreviewedEntityConfig is the config to set on an existing network entity.
+type PreviewedEntityConfig struct {
+	Type   string          `json:"type"`
+	Key    string          `json:"key"`
+	Config json.RawMessage `json:"config"`
+}
+
+// getGatewayMconfigHandler returns the mconfig the gateway currently gets,
+// taking the network's mconfig rollout into account.
+func getGatewayMconfigHandler(c echo.Context) error {
+	hwID, nerr := getGatewayHardwareID(c)
+	if nerr != nil {
+		return nerr
+	}
+	res, err := configurator.GetMconfigFor(hwID)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return writeGatewayMconfig(c, res.Configs)
+}
+
+// GetPreviewGatewayMconfigHandler returns a handler which returns the mconfig
+// the gateway would get once the configuration changes of the request body
+// are applied, without applying them.
+// Previewed configs are deserialized and validated with the passed serdes, so
+// each network type previews the configs of its own models.
+func GetPreviewGatewayMconfigHandler(path string, networkSerdes, entitySerdes serde.Registry) obsidian.Handler {
+	return obsidian.Handler{
+		Path:    path,
+		Methods: obsidian.POST,
+		HandlerFunc: func(c echo.Context) error {
+			hwID, nerr := getGatewayHardwareID(c)
+			if nerr != nil {
+				return nerr
+			}
+			payload := &MconfigPreview{}
+			if err := c.Bind(payload); err != nil {
+				return obsidian.HttpError(err, http.StatusBadRequest)
+			}
+			preview, err := payload.toPreview(networkSerdes, entitySerdes)
+			if err != nil {
+				return obsidian.HttpError(err, http.StatusBadRequest)
+			}
+
+			res, err := configurator.PreviewMconfig(c.Request().Context(), hwID, preview, networkSerdes, entitySerdes)
+			if errors.Cause(err) == configurator.ErrInvalidMconfigPreview {
+				return obsidian.HttpError(err, http.StatusBadRequest)
+			}
+			if err != nil {
+				return obsidian.HttpError(err, http.StatusInternalServerError)
+			}
+			return writeGatewayMconfig(c, res.Configs)
+		},
+	}
+}
+
+// toPreview deserializes and validates the previewed configs.
+func (m *MconfigPreview) toPreview(networkSerdes, entitySerdes serde.Registry) (configurator.MconfigPreview, error) {
+	ret := configurator.MconfigPreview{}
+	if len(m.NetworkConfigs) > 0 || len(m.NetworkConfigsToDelete) > 0 {
+		update := configurator.NetworkUpdateCriteria{
+			ConfigsToAddOrUpdate: map[string]interface{}{},
+			ConfigsToDelete:      m.NetworkConfigsToDelete,
+		}
+		for typ, config := range m.NetworkConfigs {
+			model, err := deserializePreviewedConfig(config, typ, networkSerdes)
+			if err != nil {
+				return ret, errors.Wrapf(err, "invalid network config %s", typ)
+			}
+			update.ConfigsToAddOrUpdate[typ] = model
+		}
+		ret.NetworkUpdates = append(ret.NetworkUpdates, update)
+	}
+	for _, ent := range m.EntityConfigs {
+		if len(ent.Config) == 0 {
+			return ret, fmt.Errorf("missing config for entity %s:%s", ent.Type, ent.Key)
+		}
+		model, err := deserializePreviewedConfig(ent.Config, ent.Type, entitySerdes)
+		if err != nil {
+			return ret, errors.Wrapf(err, "invalid config for entity %s:%s", ent.Type, ent.Key)
+		}
+		ret.EntityWrites = append(ret.EntityWrites, configurator.EntityUpdateCriteria{Type: ent.Type, Key: ent.Key, NewConfig: model})
+	}
+	return ret, nil
+}
+
+// deserializePreviewedConfig deserializes a previewed config into its model
+// and validates the model.
+func deserializePreviewedConfig(config []byte, typ string, serdes serde.Registry) (interface{}, error) {
+	if !serde.HasSerde(serdes, typ) {
+		return nil, fmt.Errorf("config type %s isn't known to this endpoint, preview it through the endpoint of its network type", typ)
+	}
+	model, err := serde.Deserialize(config, typ, serdes)
+	if err != nil {
+		return nil, err
+	}
+	if validatable, ok := model.(serde.ValidatableModel); ok {
+		if err := validatable.ValidateModel(); err != nil {
+			return nil, err
+		}
+	}
+	return model, nil
+}
+
+func getGatewayHardwareID(c echo.Context) (string, *echo.HTTPError) {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return "", nerr
+	}
+	hwID, err := configurator.GetPhysicalIDOfEntity(networkID, orc8r.MagmadGatewayType, gatewayID)
+	if err == merrors.ErrNotFound {
+		return "", echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		return "", obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	if hwID == "" {
+		return "", obsidian.HttpError(fmt.Errorf("gateway %s has no hardware ID", gatewayID), http.StatusNotFound)
+	}
+	return hwID, nil
+}
+
+func writeGatewayMconfig(c echo.Context, configs *protos.GatewayConfigs) error {
+	ret := GatewayMconfig{ConfigsByKey: map[string]json.RawMessage{}}
+	for key, config := range configs.GetConfigsByKey() {
+		// Configurator wraps each JSON-serialized config in a BytesValue
+		bytesVal := &wrappers.BytesValue{}
+		if err := ptypes.UnmarshalAny(config, bytesVal); err != nil {
+			return obsidian.HttpError(errors.Wrapf(err, "failed to unmarshal config %s", key), http.StatusInternalServerError)
+		}
+		ret.ConfigsByKey[key] = bytesVal.Value
+	}
+	return c.JSON(http.StatusOK, ret)
+}
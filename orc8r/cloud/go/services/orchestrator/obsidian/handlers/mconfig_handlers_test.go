@@ -0,0 +1,167 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/mconfig"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	storage2 "magma/orc8r/cloud/go/storage"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMconfigHandlers(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	configuratorTestInit.StartNewTestBuilder(t, tierBuilder{})
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	getMconfig := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/mconfig", obsidian.GET).HandlerFunc
+	previewMconfig := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/mconfig/preview", obsidian.POST).HandlerFunc
+
+	err := configurator.CreateNetwork(
+		configurator.Network{ID: "n1", Configs: map[string]interface{}{orc8r.NetworkFeaturesConfig: &models.NetworkFeatures{Features: map[string]string{"foo": "bar"}}}},
+		serdes.Network,
+	)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"},
+			{Type: orc8r.MagmadGatewayType, Key: "g2"},
+			{Type: orc8r.UpgradeTierEntityType, Key: "t1", Config: &models.Tier{Version: "1.0.0"}, Associations: storage2.TKs{{Type: orc8r.MagmadGatewayType, Key: "g1"}}},
+		},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+
+	current := handlers.GatewayMconfig{ConfigsByKey: map[string]json.RawMessage{
+		"features": json.RawMessage(`{"features":{"foo":"bar"}}`),
+		"tier":     json.RawMessage(`{"gateways":null,"id":"","images":null,"version":"1.0.0"}`),
+	}}
+	rec := runMconfigRequest(e, getMconfig, "GET", "/magma/v1/networks/n1/gateways/g1/mconfig", []string{"n1", "g1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertMconfig(t, current, rec)
+
+	rec = runMconfigRequest(e, getMconfig, "GET", "/magma/v1/networks/n1/gateways/g3/mconfig", []string{"n1", "g3"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = runMconfigRequest(e, getMconfig, "GET", "/magma/v1/networks/n1/gateways/g2/mconfig", []string{"n1", "g2"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Preview network and entity config changes
+	preview := handlers.MconfigPreview{
+		NetworkConfigs: map[string]json.RawMessage{orc8r.NetworkFeaturesConfig: json.RawMessage(`{"features":{"foo":"baz"}}`)},
+		EntityConfigs:  []handlers.PreviewedEntityConfig{{Type: orc8r.UpgradeTierEntityType, Key: "t1", Config: json.RawMessage(`{"id":"t1","version":"2.0.0","images":[],"gateways":[]}`)}},
+	}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertMconfig(t, handlers.GatewayMconfig{ConfigsByKey: map[string]json.RawMessage{
+		"features": json.RawMessage(`{"features":{"foo":"baz"}}`),
+		"tier":     json.RawMessage(`{"gateways":[],"id":"t1","images":[],"version":"2.0.0"}`),
+	}}, rec)
+
+	preview = handlers.MconfigPreview{NetworkConfigsToDelete: []string{orc8r.NetworkFeaturesConfig}}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertMconfig(t, handlers.GatewayMconfig{ConfigsByKey: map[string]json.RawMessage{
+		"tier": json.RawMessage(`{"gateways":null,"id":"","images":null,"version":"1.0.0"}`),
+	}}, rec)
+
+	// Previewed changes aren't applied
+	rec = runMconfigRequest(e, getMconfig, "GET", "/magma/v1/networks/n1/gateways/g1/mconfig", []string{"n1", "g1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertMconfig(t, current, rec)
+
+	// Changes which can't be applied
+	preview = handlers.MconfigPreview{EntityConfigs: []handlers.PreviewedEntityConfig{{Type: orc8r.UpgradeTierEntityType, Key: "t2", Config: json.RawMessage(`{"id":"t2","version":"2.0.0","images":[],"gateways":[]}`)}}}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, json.RawMessage(`{"entity_configs":[{"type":"upgrade_tier","key":"t1"}]}`))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Configs which fail validation
+	preview = handlers.MconfigPreview{EntityConfigs: []handlers.PreviewedEntityConfig{{Type: orc8r.UpgradeTierEntityType, Key: "t1", Config: json.RawMessage(`{"version":"2.0.0"}`)}}}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	preview = handlers.MconfigPreview{NetworkConfigs: map[string]json.RawMessage{orc8r.NetworkFeaturesConfig: json.RawMessage(`{"features":"foo"}`)}}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Config types without serdes in the handler's registry
+	preview = handlers.MconfigPreview{NetworkConfigs: map[string]json.RawMessage{"cellular_network": json.RawMessage(`{}`)}}
+	rec = runMconfigRequest(e, previewMconfig, "POST", "/magma/v1/networks/n1/gateways/g1/mconfig/preview", []string{"n1", "g1"}, preview)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "isn't known to this endpoint")
+}
+
+// tierBuilder builds the network's features and the gateway's tier into the
+// mconfig
+type tierBuilder struct{}
+
+func (tierBuilder) Build(network *storage.Network, graph *storage.EntityGraph, gatewayID string) (mconfig.ConfigsByKey, error) {
+	ret := mconfig.ConfigsByKey{}
+	if features, ok := network.Configs[orc8r.NetworkFeaturesConfig]; ok {
+		ret["features"] = features
+	}
+	for _, ent := range graph.Entities {
+		if ent.Type == orc8r.UpgradeTierEntityType {
+			ret["tier"] = ent.Config
+		}
+	}
+	return ret, nil
+}
+
+func assertMconfig(t *testing.T, expected handlers.GatewayMconfig, rec *httptest.ResponseRecorder) {
+	actual := handlers.GatewayMconfig{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	require.Equal(t, len(expected.ConfigsByKey), len(actual.ConfigsByKey))
+	for key, config := range expected.ConfigsByKey {
+		assert.JSONEq(t, string(config), string(actual.ConfigsByKey[key]), key)
+	}
+}
+
+// runMconfigRequest runs the handler with the network_id and gateway_id
+// path parameters set to the passed values, in order.
+func runMconfigRequest(e *echo.Echo, handler echo.HandlerFunc, method, url string, paramValues []string, payload interface{}) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	if payload != nil {
+		_ = json.NewEncoder(&body).Encode(payload)
+	}
+	req := httptest.NewRequest(method, url, &body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames([]string{"network_id", "gateway_id"}[:len(paramValues)]...)
+	c.SetParamValues(paramValues...)
+	if err := handler(c); err != nil {
+		c.Error(err)
+	}
+	return rec
+}
@@ -14,6 +14,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -99,6 +100,7 @@ func GetPartialReadNetworkHandler(path string, model PartialNetworkModel, serdes
 			if ret == nil {
 				return obsidian.HttpError(fmt.Errorf("Not found"), http.StatusNotFound)
 			}
+			obsidian.SetETag(c, network.Version)
 			return c.JSON(http.StatusOK, ret)
 		},
 	}
@@ -130,6 +132,10 @@ func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel, serd
 			if nerr != nil {
 				return nerr
 			}
+			expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+			if nerr != nil {
+				return nerr
+			}
 
 			network, err := configurator.LoadNetwork(networkID, true, true, serdes)
 			if err == merrors.ErrNotFound {
@@ -142,9 +148,10 @@ func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel, serd
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria}, serdes)
+			updateCriteria.ExpectedVersion = expectedVersion
+			err = configurator.UpdateNetworksWithContext(c.Request().Context(), []configurator.NetworkUpdateCriteria{updateCriteria}, serdes)
 			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
+				return obsidian.WriteHttpError(err)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
@@ -166,13 +173,18 @@ func GetPartialDeleteNetworkHandler(path string, key string, serdes serde.Regist
 			if nerr != nil {
 				return nerr
 			}
+			expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+			if nerr != nil {
+				return nerr
+			}
 			update := configurator.NetworkUpdateCriteria{
 				ID:              networkID,
 				ConfigsToDelete: []string{key},
+				ExpectedVersion: expectedVersion,
 			}
-			err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update}, serdes)
+			err := configurator.UpdateNetworksWithContext(c.Request().Context(), []configurator.NetworkUpdateCriteria{update}, serdes)
 			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
+				return obsidian.WriteHttpError(err)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
@@ -213,7 +225,7 @@ func getCreateTypedNetworkHandler(path string, networkType string, network Netwo
 			if err != nil {
 				return err
 			}
-			err = configurator.CreateNetwork(payload.ToConfiguratorNetwork(), serdes)
+			err = configurator.CreateNetworkWithContext(c.Request().Context(), payload.ToConfiguratorNetwork(), serdes)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -245,6 +257,7 @@ func getGetTypedNetworkHandler(path string, networkType string, networkModel Net
 			}
 
 			ret := (networkModel.GetEmptyNetwork()).FromConfiguratorNetwork(network)
+			obsidian.SetETag(c, network.Version)
 			return c.JSON(http.StatusOK, ret)
 		},
 	}
@@ -264,6 +277,10 @@ func getUpdateTypedNetworkHandler(path string, networkType string, networkModel
 			if err != nil {
 				return err
 			}
+			expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+			if nerr != nil {
+				return nerr
+			}
 
 			network, err := configurator.LoadNetwork(nid, false, false, serdes)
 			if err == merrors.ErrNotFound {
@@ -276,9 +293,11 @@ func getUpdateTypedNetworkHandler(path string, networkType string, networkModel
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()}, serdes)
+			update := payload.ToUpdateCriteria()
+			update.ExpectedVersion = expectedVersion
+			err = configurator.UpdateNetworksWithContext(c.Request().Context(), []configurator.NetworkUpdateCriteria{update}, serdes)
 			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
+				return obsidian.WriteHttpError(err)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
@@ -294,6 +313,10 @@ func getDeleteTypedNetworkHandler(path string, networkType string, serdes serde.
 			if nerr != nil {
 				return nerr
 			}
+			expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+			if nerr != nil {
+				return nerr
+			}
 
 			network, err := configurator.LoadNetwork(nid, false, false, serdes)
 			if err == merrors.ErrNotFound {
@@ -306,15 +329,29 @@ func getDeleteTypedNetworkHandler(path string, networkType string, serdes serde.
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.DeleteNetwork(nid)
+			err = deleteNetworkIfMatch(c.Request().Context(), nid, expectedVersion)
 			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
+				return obsidian.WriteHttpError(err)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
 	}
 }
 
+// deleteNetworkIfMatch deletes a network. If expectedVersion is set, the
+// network is only deleted if its current version matches.
+func deleteNetworkIfMatch(ctx context.Context, networkID string, expectedVersion *uint64) error {
+	if expectedVersion == nil {
+		return configurator.DeleteNetworkWithContext(ctx, networkID)
+	}
+	update := configurator.NetworkUpdateCriteria{
+		ID:              networkID,
+		DeleteNetwork:   true,
+		ExpectedVersion: expectedVersion,
+	}
+	return configurator.UpdateNetworksWithContext(ctx, []configurator.NetworkUpdateCriteria{update}, nil)
+}
+
 // getAndValidateNetwork can be used by any model that implements NetworkModel
 func getAndValidateNetwork(c echo.Context, network interface{}) (NetworkModel, error) {
 	iModel := reflect.New(reflect.TypeOf(network).Elem()).Interface().(NetworkModel)
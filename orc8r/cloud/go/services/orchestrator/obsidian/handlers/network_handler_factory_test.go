@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"testing"
 
+	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/tests"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -240,6 +241,96 @@ func TestGetDeleteNetworkConfigHandler(t *testing.T) {
 	assert.EqualError(t, err, errors.ErrNotFound.Error())
 }
 
+func TestPartialNetworkHandlers_IfMatch(t *testing.T) {
+	networkSerdes := serde.NewRegistry(configurator.NewNetworkConfigSerde("test", &TestFeature1{}))
+	configuratorTestInit.StartTestService(t)
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks"
+
+	// register a network
+	networkID := "test-network"
+	network := configurator.Network{
+		ID:          networkID,
+		Type:        "lte",
+		Name:        "Test Network 1",
+		Description: "Test Network 1",
+		Configs:     map[string]interface{}{"test": &TestFeature1{ID: &ID{Name: "hello!"}, Desc: "goodbye!"}},
+	}
+	assert.NoError(t, configurator.CreateNetwork(network, networkSerdes))
+	loaded, err := configurator.LoadNetwork(networkID, true, true, networkSerdes)
+	assert.NoError(t, err)
+	version := loaded.Version
+
+	networkURL := fmt.Sprintf("%s/%s", testURLRoot, networkID)
+	getHandler := handlers.GetPartialReadNetworkHandler(networkURL, &TestFeature1{}, networkSerdes)
+	updateHandler := handlers.GetPartialUpdateNetworkHandler(networkURL, &TestFeature1{}, networkSerdes)
+	deleteHandler := handlers.GetPartialDeleteNetworkHandler(networkURL, "test", networkSerdes)
+
+	// GET returns the network's version as ETag
+	tc := tests.Test{
+		Method:          "GET",
+		URL:             networkURL,
+		ParamNames:      []string{"network_id"},
+		ParamValues:     []string{networkID},
+		Handler:         getHandler.HandlerFunc,
+		ExpectedStatus:  200,
+		ExpectedHeaders: map[string]string{obsidian.ETagHeader: obsidian.VersionETag(version)},
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// PUT with a stale version
+	newConfig := &TestFeature1{ID: &ID{Name: "hello world!"}, Desc: "goodbye world!"}
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            networkURL,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{networkID},
+		Payload:        tests.JSONMarshaler(newConfig),
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version + 1)},
+		Handler:        updateHandler.HandlerFunc,
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	config, err := configurator.LoadNetworkConfig(networkID, "test", networkSerdes)
+	assert.NoError(t, err)
+	assert.Equal(t, network.Configs["test"], config)
+
+	// PUT with the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	config, err = configurator.LoadNetworkConfig(networkID, "test", networkSerdes)
+	assert.NoError(t, err)
+	assert.Equal(t, newConfig, config)
+
+	loaded, err = configurator.LoadNetwork(networkID, true, true, networkSerdes)
+	assert.NoError(t, err)
+	assert.NotEqual(t, version, loaded.Version)
+
+	// DELETE with the now stale version
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            networkURL,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{networkID},
+		Headers:        map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(version)},
+		Handler:        deleteHandler.HandlerFunc,
+		ExpectedStatus: 412,
+		ExpectedError:  "resource was modified, If-Match precondition failed",
+	}
+	tests.RunUnitTest(t, e, tc)
+	_, err = configurator.LoadNetworkConfig(networkID, "test", networkSerdes)
+	assert.NoError(t, err)
+
+	// DELETE with the current version
+	tc.Headers = map[string]string{obsidian.IfMatchHeader: obsidian.VersionETag(loaded.Version)}
+	tc.ExpectedStatus, tc.ExpectedError = 204, ""
+	tests.RunUnitTest(t, e, tc)
+	_, err = configurator.LoadNetworkConfig(networkID, "test", networkSerdes)
+	assert.EqualError(t, err, errors.ErrNotFound.Error())
+}
+
 func (m *ID) Validate(_ strfmt.Registry) error {
 	if m == nil {
 		return fmt.Errorf("Cannot be nil")
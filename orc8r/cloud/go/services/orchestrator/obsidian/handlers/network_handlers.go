@@ -14,6 +14,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -44,7 +45,7 @@ func registerNetwork(c echo.Context) error {
 		return nerr
 	}
 	network := payload.(*models.Network).ToConfiguratorNetwork()
-	createdNetworks, err := configurator.CreateNetworks([]configurator.Network{network}, serdes.Network)
+	createdNetworks, err := configurator.CreateNetworksWithContext(c.Request().Context(), []configurator.Network{network}, serdes.Network)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
@@ -64,6 +65,7 @@ func getNetwork(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 	ret := (&models.Network{}).FromConfiguratorNetwork(network)
+	obsidian.SetETag(c, network.Version)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -72,10 +74,15 @@ func updateNetwork(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 	update := network.(*models.Network).ToUpdateCriteria()
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update}, serdes.Network)
+	update.ExpectedVersion = expectedVersion
+	err := configurator.UpdateNetworksWithContext(c.Request().Context(), []configurator.NetworkUpdateCriteria{update}, serdes.Network)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -85,9 +92,13 @@ func deleteNetwork(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteNetwork(networkID)
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := deleteNetworkIfMatch(c.Request().Context(), networkID, expectedVersion)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.WriteHttpError(err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -116,7 +127,7 @@ func CreateDNSRecord(c echo.Context) error {
 	}
 
 	dnsConfig.Records = append(dnsConfig.Records, record)
-	nerr = updateDNSConfig(networkID, dnsConfig)
+	nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 	if nerr != nil {
 		return nerr
 	}
@@ -142,7 +153,7 @@ func UpdateDNSRecord(c echo.Context) error {
 	for i, existingRecord := range dnsConfig.Records {
 		if existingRecord.Domain == domain {
 			dnsConfig.Records[i] = record
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -188,7 +199,7 @@ func DeleteDNSRecord(c echo.Context) error {
 			} else {
 				dnsConfig.Records = append(dnsConfig.Records[:i], dnsConfig.Records[i+1:]...)
 			}
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -198,8 +209,9 @@ func DeleteDNSRecord(c echo.Context) error {
 	return echo.NewHTTPError(http.StatusNotFound)
 }
 
-func updateDNSConfig(networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
-	err := configurator.UpdateNetworks(
+func updateDNSConfig(ctx context.Context, networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
+	err := configurator.UpdateNetworksWithContext(
+		ctx,
 		[]configurator.NetworkUpdateCriteria{
 			{
 				ID:                   networkID,
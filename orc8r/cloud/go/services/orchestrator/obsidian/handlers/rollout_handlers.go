@@ -0,0 +1,140 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/configurator/rollout"
+	"magma/orc8r/cloud/go/services/configurator/rollout/types"
+	merrors "magma/orc8r/lib/go/errors"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const ParamHaltReason = "reason"
+
+// StartRolloutRequest is the body of startMconfigRolloutHandler.
+type StartRolloutRequest struct {
+	Stages []types.Stage      `json:"stages"`
+	Health types.HealthPolicy `json:"health"`
+}
+
+// getMconfigRolloutHandler returns the network's mconfig rollout.
+func getMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	ret, err := rollout.Get(networkID)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// startMconfigRolloutHandler starts a rollout on the network. Configuration
+// changes made afterwards only reach the gateways of released stages.
+func startMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	payload := &StartRolloutRequest{}
+	if err := c.Bind(payload); err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	ret, err := rollout.Start(c.Request().Context(), networkID, payload.Stages, payload.Health)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusCreated, ret)
+}
+
+// cancelMconfigRolloutHandler deletes the network's rollout, releasing the
+// current mconfig to every gateway.
+func cancelMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := rollout.Cancel(c.Request().Context(), networkID)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// advanceMconfigRolloutHandler releases the next stage of the network's
+// rollout, or completes it. The rollout is halted instead if released
+// gateways regressed.
+func advanceMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	ret, err := rollout.Advance(c.Request().Context(), networkID)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// haltMconfigRolloutHandler halts the network's rollout, pinning every
+// gateway to its baseline mconfig. The reason query parameter is recorded
+// on the rollout.
+func haltMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	reason := c.QueryParam(ParamHaltReason)
+	if reason == "" {
+		reason = "halted by operator"
+	}
+	ret, err := rollout.Halt(c.Request().Context(), networkID, reason)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// resumeMconfigRolloutHandler resumes the network's halted rollout.
+func resumeMconfigRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	ret, err := rollout.Resume(c.Request().Context(), networkID)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func rolloutHttpError(err error) *echo.HTTPError {
+	switch {
+	case err == merrors.ErrNotFound:
+		return echo.NewHTTPError(http.StatusNotFound)
+	case err == merrors.ErrVersionConflict:
+		return obsidian.HttpError(fmt.Errorf("rollout was modified concurrently, retry the request"), http.StatusConflict)
+	case errors.Cause(err) == rollout.ErrInvalidRollout:
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	default:
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+}
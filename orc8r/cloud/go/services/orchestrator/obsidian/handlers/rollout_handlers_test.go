@@ -0,0 +1,132 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/rollout/types"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	stateTestInit "magma/orc8r/cloud/go/services/state/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMconfigRolloutHandlers(t *testing.T) {
+	configuratorTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	getRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout", obsidian.GET).HandlerFunc
+	startRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout", obsidian.POST).HandlerFunc
+	cancelRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout", obsidian.DELETE).HandlerFunc
+	advanceRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout/advance", obsidian.POST).HandlerFunc
+	haltRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout/halt", obsidian.POST).HandlerFunc
+	resumeRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/mconfig_rollout/resume", obsidian.POST).HandlerFunc
+
+	const url = "/magma/v1/networks/n1/mconfig_rollout"
+	request := handlers.StartRolloutRequest{
+		Stages: []types.Stage{{Gateways: []string{"g1"}}, {Percentage: 100}},
+		Health: types.HealthPolicy{MaxRegressions: 1},
+	}
+	rec := runMconfigRequest(e, startRollout, "POST", url, []string{"n1"}, request)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"}, serdes.Network)
+	require.NoError(t, err)
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"}, serdes.Entity)
+	require.NoError(t, err)
+
+	rec = runMconfigRequest(e, getRollout, "GET", url, []string{"n1"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = runMconfigRequest(e, startRollout, "POST", url, []string{"n1"}, handlers.StartRolloutRequest{})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Start
+	rec = runMconfigRequest(e, startRollout, "POST", url, []string{"n1"}, request)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	expected := types.Rollout{
+		Stages:            request.Stages,
+		State:             types.StateInProgress,
+		Health:            request.Health,
+		BaselineUnhealthy: []string{"g1"},
+		StartedAt:         clock.Now().UTC(),
+		UpdatedAt:         clock.Now().UTC(),
+	}
+	assertRollout(t, expected, rec.Body.Bytes())
+	rec = runMconfigRequest(e, getRollout, "GET", url, []string{"n1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assertRollout(t, expected, rec.Body.Bytes())
+	rec = runMconfigRequest(e, startRollout, "POST", url, []string{"n1"}, request)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Halt and resume
+	rec = runMconfigRequest(e, resumeRollout, "POST", url+"/resume", []string{"n1"}, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = runMconfigRequest(e, haltRollout, "POST", url+"/halt?reason=alarms", []string{"n1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	expected.State = types.StateHalted
+	expected.HaltReason = "alarms"
+	assertRollout(t, expected, rec.Body.Bytes())
+	rec = runMconfigRequest(e, advanceRollout, "POST", url+"/advance", []string{"n1"}, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = runMconfigRequest(e, resumeRollout, "POST", url+"/resume", []string{"n1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	expected.State = types.StateInProgress
+	expected.HaltReason = ""
+	assertRollout(t, expected, rec.Body.Bytes())
+
+	// Advance until completion
+	rec = runMconfigRequest(e, advanceRollout, "POST", url+"/advance", []string{"n1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	expected.CurrentStage = 1
+	assertRollout(t, expected, rec.Body.Bytes())
+	rec = runMconfigRequest(e, advanceRollout, "POST", url+"/advance", []string{"n1"}, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	expected.State = types.StateCompleted
+	assertRollout(t, expected, rec.Body.Bytes())
+	rec = runMconfigRequest(e, getRollout, "GET", url, []string{"n1"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Cancel
+	rec = runMconfigRequest(e, cancelRollout, "DELETE", url, []string{"n1"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = runMconfigRequest(e, startRollout, "POST", url, []string{"n1"}, request)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	rec = runMconfigRequest(e, cancelRollout, "DELETE", url, []string{"n1"}, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = runMconfigRequest(e, getRollout, "GET", url, []string{"n1"}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func assertRollout(t *testing.T, expected types.Rollout, body []byte) {
+	actual := types.Rollout{}
+	require.NoError(t, json.Unmarshal(body, &actual))
+	assert.Equal(t, expected, actual)
+}
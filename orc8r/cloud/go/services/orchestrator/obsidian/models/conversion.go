@@ -14,7 +14,9 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"magma/orc8r/cloud/go/models"
 	"magma/orc8r/cloud/go/orc8r"
@@ -22,6 +24,7 @@ import (
 	"magma/orc8r/cloud/go/storage"
 	merrors "magma/orc8r/lib/go/errors"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
@@ -552,6 +555,60 @@ func (m *GatewayVpnConfigs) FromBackendModels(networkID string, gatewayID string
 	return nil
 }
 
+func (m *ConfigRevision) FromBackendModel(revision configurator.Revision) *ConfigRevision {
+	m.ID = revision.ID
+	m.EntityType = revision.Type
+	m.EntityKey = revision.Key
+	m.Operation = strings.ToLower(revision.Operation.String())
+	m.Operator = revision.Operator
+	m.Timestamp = strfmt.DateTime(revision.Timestamp)
+	if revision.IsNetworkRevision() {
+		m.Before = (&ConfigRevisionState{}).fromNetwork(revision.NetworkBefore)
+		m.After = (&ConfigRevisionState{}).fromNetwork(revision.NetworkAfter)
+	} else {
+		m.Before = (&ConfigRevisionState{}).fromEntity(revision.EntityBefore)
+		m.After = (&ConfigRevisionState{}).fromEntity(revision.EntityAfter)
+	}
+	return m
+}
+
+func (m *ConfigRevisionState) fromNetwork(network *configurator.Network) *ConfigRevisionState {
+	if network == nil {
+		return nil
+	}
+	m.Name = network.Name
+	m.Description = network.Description
+	m.Configs = map[string]interface{}{}
+	for configType, config := range network.Configs {
+		m.Configs[configType] = getRevisionConfigJSON(config)
+	}
+	return m
+}
+
+func (m *ConfigRevisionState) fromEntity(ent *configurator.NetworkEntity) *ConfigRevisionState {
+	if ent == nil {
+		return nil
+	}
+	m.Name = ent.Name
+	m.Description = ent.Description
+	m.PhysicalID = ent.PhysicalID
+	m.Config = getRevisionConfigJSON(ent.Config)
+	for _, tk := range ent.Associations {
+		m.Associations = append(m.Associations, fmt.Sprintf("%s/%s", tk.Type, tk.Key))
+	}
+	return m
+}
+
+// getRevisionConfigJSON returns configs left serialized by configurator as
+// raw JSON, since the serdes of REST models serialize them to JSON.
+func getRevisionConfigJSON(config interface{}) interface{} {
+	serialized, ok := config.([]byte)
+	if !ok || !json.Valid(serialized) {
+		return config
+	}
+	return json.RawMessage(serialized)
+}
+
 func getGatewayTKs(gateways []models.GatewayID) []storage.TypeAndKey {
 	return funk.Map(
 		gateways,
@@ -14,6 +14,8 @@
 package main
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/swagger"
 	swagger_protos "magma/orc8r/cloud/go/obsidian/swagger/protos"
@@ -22,6 +24,7 @@ import (
 	"magma/orc8r/cloud/go/services/analytics"
 	analytics_protos "magma/orc8r/cloud/go/services/analytics/protos"
 	builder_protos "magma/orc8r/cloud/go/services/configurator/mconfig/protos"
+	"magma/orc8r/cloud/go/services/configurator/rollout"
 	exporter_protos "magma/orc8r/cloud/go/services/metricsd/protos"
 	"magma/orc8r/cloud/go/services/orchestrator"
 	analytics_service "magma/orc8r/cloud/go/services/orchestrator/analytics"
@@ -38,6 +41,9 @@ import (
 const (
 	// Set max msg received to 50MB
 	DefaultMaxGRPCMsgRecvSize = 50 * 1024 * 1024
+
+	// how often to check the health of mconfig rollouts
+	mconfigRolloutCheckInterval = time.Second * 60
 )
 
 func main() {
@@ -83,6 +89,8 @@ func main() {
 	)
 	analytics_protos.RegisterAnalyticsCollectorServer(srv.GrpcServer, collectorServicer)
 
+	go rollout.PeriodicallyCheckHealth(mconfigRolloutCheckInterval)
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error while running service and echo server: %s", err)
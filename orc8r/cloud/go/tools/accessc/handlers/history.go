@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers implements individual accessc commands as well as common
+// across multiple commands functionality
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/services/accessd"
+	"magma/orc8r/cloud/go/tools/commands"
+)
+
+// History command - prints out the previous versions of an Operator's ACL
+func init() {
+	cmd := CommandRegistry.Add(
+		"history",
+		"List the previous versions of given Operator's ACL, which can be restored",
+		history,
+	)
+	cmd.Flags().Usage = func() {
+		fmt.Fprintf(os.Stderr, "\tUsage: %s %s <Operator ID>\n", os.Args[0], cmd.Name())
+	}
+}
+
+func history(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	oid := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(oid) == 0 {
+		f.Usage()
+		log.Fatalf("A single Operator Id must be specified.")
+	}
+
+	operator := identity.NewOperator(oid)
+	versions, err := accessd.ListOperatorACLHistory(context.Background(), operator)
+	if err != nil {
+		log.Fatalf("List ACL History Error: %s", err)
+	}
+	fmt.Printf("Previous ACL versions of %s:\n", oid)
+	for _, version := range versions {
+		change := "replaced"
+		if version.Deleted {
+			change = "deleted"
+		}
+		replacedAt := time.Unix(0, version.ReplacedAtMs*int64(time.Millisecond))
+		fmt.Printf("Version %d, %s at %s:\n", version.Version, change, replacedAt.Format(time.RFC3339))
+		PrintACL(version.Acl, nil)
+	}
+	return 0
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers implements individual accessc commands as well as common
+// across multiple commands functionality
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/services/accessd"
+	"magma/orc8r/cloud/go/tools/commands"
+)
+
+// Restore command - restores an Operator's ACL to a previous version
+func init() {
+	cmd := CommandRegistry.Add(
+		"restore",
+		"Restore given Operator's ACL to a previous version, see history",
+		restore,
+	)
+	cmd.Flags().Usage = func() {
+		fmt.Fprintf(os.Stderr, "\tUsage: %s %s <Operator ID> <ACL Version>\n", os.Args[0], cmd.Name())
+	}
+}
+
+func restore(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	oid := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 2 || len(oid) == 0 {
+		f.Usage()
+		log.Fatalf("An Operator Id and an ACL version must be specified.")
+	}
+	version, err := strconv.ParseUint(f.Arg(1), 10, 64)
+	if err != nil {
+		f.Usage()
+		log.Fatalf("Invalid ACL version %s: %s", f.Arg(1), err)
+	}
+
+	operator := identity.NewOperator(oid)
+	err = accessd.RestoreOperatorACL(context.Background(), operator, version)
+	if err != nil {
+		log.Fatalf("Restore ACL Error: %s", err)
+	}
+	fmt.Printf("Restored ACL of %s to version %d\n", oid, version)
+	return 0
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"magma/orc8r/cloud/go/services/configurator/backup"
+
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <network_id>",
+	Short: "Export a network, its entities, and its tenants to an archive",
+	Args:  cobra.ExactArgs(1),
+	Run:   runExport,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "write the archive to this file instead of stdout")
+}
+
+func runExport(cmd *cobra.Command, args []string) {
+	archive, err := backup.Export(context.Background(), args[0])
+	if err != nil {
+		log.Fatalf("Export network %s: %s", args[0], err)
+	}
+	marshaled, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	marshaled = append(marshaled, '\n')
+
+	if exportOutput == "" {
+		_, err = os.Stdout.Write(marshaled)
+	} else {
+		err = ioutil.WriteFile(exportOutput, marshaled, 0600)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/backup"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive_file>",
+	Short: "Import a network archive into a new or existing network",
+	Args:  cobra.ExactArgs(1),
+	Run:   runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVarP(&importNetworkID, "network", "n", "", "import into this network instead of the archived network's ID")
+	importCmd.Flags().StringSliceVar(&importIDRewrites, "rewrite-id", nil, "import an archived ID under a new ID, as <archived ID>:<new ID>")
+	importCmd.Flags().BoolVarP(&importDryRun, "dry-run", "d", false, "validate the import without applying it")
+	importCmd.Flags().BoolVar(&importClearPhysicalIDs, "clear-physical-ids", false, "import the entities without their physical IDs, e.g. to clone a network within the same deployment")
+	importCmd.Flags().BoolVar(&importRestoreTenants, "restore-tenants", false, "add the network to the archived tenants, creating the tenants which don't exist")
+	importCmd.Flags().StringVar(&importOperator, "operator", "", "operator to attribute the configuration changes to")
+}
+
+func runImport(cmd *cobra.Command, args []string) {
+	marshaled, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	archive := &backup.Archive{}
+	err = json.Unmarshal(marshaled, archive)
+	if err != nil {
+		log.Fatalf("Parse archive %s: %s", args[0], err)
+	}
+
+	idRewrites := map[string]string{}
+	for _, rewrite := range importIDRewrites {
+		archivedID, newID, err := backup.ParseIDRewrite(rewrite)
+		if err != nil {
+			log.Fatal(err)
+		}
+		idRewrites[archivedID] = newID
+	}
+
+	ctx := context.Background()
+	if importOperator != "" {
+		ctx = configurator.WithOperator(ctx, importOperator)
+	}
+	res, err := backup.Import(ctx, archive, backup.ImportOptions{
+		NetworkID:        importNetworkID,
+		IDRewrites:       idRewrites,
+		ClearPhysicalIDs: importClearPhysicalIDs,
+		DryRun:           importDryRun,
+		RestoreTenants:   importRestoreTenants,
+	})
+	if err != nil {
+		log.Fatalf("Import archive %s: %s", args[0], err)
+	}
+
+	verb := "Imported"
+	if res.DryRun {
+		verb = "Dry run: would import"
+	}
+	fmt.Printf("%s %d entities into network %s (created: %t, tenants: %v)\n", verb, res.Entities, res.NetworkID, res.CreatedNetwork, res.Tenants)
+}
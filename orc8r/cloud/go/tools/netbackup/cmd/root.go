@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"magma/orc8r/lib/go/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Global flag vars
+	rootSilent             bool
+	exportOutput           string
+	importNetworkID        string
+	importIDRewrites       []string
+	importDryRun           bool
+	importClearPhysicalIDs bool
+	importRestoreTenants   bool
+	importOperator         string
+)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVarP(&rootSilent, "silent", "s", false, "silence log output from loading Magma plugins")
+}
+
+var rootCmd = &cobra.Command{
+	Use:              "netbackup",
+	Short:            "netbackup CLI exports networks to archives, and imports archives into new or existing networks",
+	PersistentPreRun: globalPre,
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func globalPre(cmd *cobra.Command, args []string) {
+	if rootSilent {
+		log.SetOutput(ioutil.Discard)
+		defer log.SetOutput(os.Stderr)
+	}
+	registry.MustPopulateServices()
+}
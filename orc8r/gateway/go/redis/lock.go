@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	// DefaultLockRetryInterval is the interval between lock acquisition attempts
+	DefaultLockRetryInterval = time.Millisecond * 5
+)
+
+// unlockScript deletes the lock key only if it's still owned by the caller
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
+// refreshScript extends the lock's expiration only if it's still owned by the caller
+var refreshScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// Lock is a distributed mutex stored in redis. The lock expires after its TTL
+// to guarantee progress if the owner dies while holding it.
+// A Lock instance is not safe for concurrent use, every owner must use its own instance.
+type Lock struct {
+	client *redis.Client
+	key    string
+	ttl    time.Duration
+	token  string
+}
+
+// NewLock returns a new, unlocked distributed lock for the given key
+func NewLock(client *redis.Client, key string, ttl time.Duration) *Lock {
+	return &Lock{client: client, key: key, ttl: ttl}
+}
+
+// TryLock attempts to acquire the lock once, it returns true if the lock was acquired
+func (l *Lock) TryLock() (bool, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return false, err
+	}
+	ok, err := l.client.SetNX(l.key, token, l.ttl).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+	l.token = token
+	return true, nil
+}
+
+// Lock blocks until the lock is acquired or the wait timeout expires
+func (l *Lock) Lock(wait time.Duration) error {
+	deadline := time.Now().Add(wait)
+	for {
+		ok, err := l.TryLock()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for lock %s", l.key)
+		}
+		time.Sleep(DefaultLockRetryInterval)
+	}
+}
+
+// Unlock releases the lock if it's still owned by the caller. It returns an error
+// if the lock was not held or was taken over by another owner after TTL expiration.
+func (l *Lock) Unlock() error {
+	if len(l.token) == 0 {
+		return fmt.Errorf("lock %s is not held", l.key)
+	}
+	token := l.token
+	l.token = ""
+	res, err := unlockScript.Run(l.client, []string{l.key}, token).Int64()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return fmt.Errorf("lock %s expired before unlock", l.key)
+	}
+	return nil
+}
+
+// Refresh resets the TTL of the held lock
+func (l *Lock) Refresh() error {
+	if len(l.token) == 0 {
+		return fmt.Errorf("lock %s is not held", l.key)
+	}
+	res, err := refreshScript.Run(l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return fmt.Errorf("lock %s expired before refresh", l.key)
+	}
+	return nil
+}
+
+func newLockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+	client := redis.NewClient(&redis.Options{Addr: mockRedis.Addr()})
+
+	l1 := NewLock(client, "lock1", time.Second)
+	l2 := NewLock(client, "lock1", time.Second)
+
+	ok, err := l1.TryLock()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = l2.TryLock()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Error(t, l2.Lock(time.Millisecond*20))
+	assert.Error(t, l2.Unlock())
+
+	assert.NoError(t, l1.Refresh())
+	assert.NoError(t, l1.Unlock())
+	assert.Error(t, l1.Unlock())
+
+	assert.NoError(t, l2.Lock(time.Millisecond*20))
+
+	// lock expiration, l2's lock is taken over by l1 & l2 must fail to unlock
+	mockRedis.FastForward(time.Second * 2)
+	ok, err = l1.TryLock()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Error(t, l2.Unlock())
+	assert.NoError(t, l1.Unlock())
+}
@@ -11,6 +11,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 """
 
+from typing import Iterator
+
 from magma.ctraced.trace_manager import TraceManager
 from orc8r.protos.ctraced_pb2 import (
     EndTraceRequest,
@@ -49,6 +51,7 @@ class CtraceDRpcServicer(CallTraceServiceServicer):
             request.timeout,
             request.capture_filters,
             request.display_filters,
+            request.max_filesize,
         )
         response = StartTraceResponse(success=success)
         return response
@@ -64,3 +67,13 @@ class CtraceDRpcServicer(CallTraceServiceServicer):
             trace_content=res.data,
         )
         return response
+
+    def EndCallTraceChunks(
+        self,
+        _request: EndTraceRequest,
+        _context,
+    ) -> Iterator[EndTraceResponse]:
+        res = self._trace_mgr.end_trace_chunks()  # type: EndTraceChunksResult
+        yield EndTraceResponse(success=res.success)
+        for chunk in res.chunks:
+            yield EndTraceResponse(success=True, trace_content=chunk)
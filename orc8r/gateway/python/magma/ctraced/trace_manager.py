@@ -20,7 +20,7 @@ import threading
 import time
 from collections import namedtuple
 from subprocess import SubprocessError
-from typing import List
+from typing import Iterator, List
 
 import grpc
 from magma.ctraced.command_builder import get_trace_builder
@@ -31,10 +31,12 @@ _TRACE_FILE_NAME = "call_trace"
 _TRACE_FILE_NAME_POSTPROCESSED = "call_trace_postprocessed"
 _TRACE_FILE_EXT = "pcapng"
 _TRACE_FILE_WRITE_TIMEOUT = 20  # 20 seconds for TShark to write a trace to disk
-_MAX_FILESIZE = 4000  # ~ 4 MiB for a trace
+_MAX_FILESIZE = 4000  # ~ 4 MiB for a trace, unless configured otherwise
 _POSTPROCESSING_TIMEOUT = 10  # 10 seconds for TShark to apply display filters
+_TRACE_CHUNK_SIZE = 1024 * 1024  # 1 MiB per streamed chunk of a trace
 
 EndTraceResult = namedtuple('EndTraceResult', ['success', 'data'])
+EndTraceChunksResult = namedtuple('EndTraceChunksResult', ['success', 'chunks'])
 
 
 class TraceManager:
@@ -67,6 +69,13 @@ class TraceManager:
         # TShark display filters are saved to postprocess packet capture files
         self._display_filters = ""  # type: str
 
+        # Traces are streamed to the orchestrator in chunks, so their size is
+        # only bounded by the gateway's disk
+        self._max_filesize = config.get(
+            "max_trace_filesize",
+            _MAX_FILESIZE,
+        )  # type: int
+
         self._tool_name = config.get("trace_tool", "tshark")  # type: str
         self._trace_builder = get_trace_builder(self._tool_name)
 
@@ -78,6 +87,7 @@ class TraceManager:
         timeout: int,
         capture_filters: str,
         display_filters: str,
+        max_filesize: int = 0,
     ) -> bool:
         """Start a call trace.
 
@@ -91,6 +101,9 @@ class TraceManager:
               Syntax based on BPF (Berkeley Packet Filter)
             display_filters: Display filters for running TShark.
               Equivalent to the -Y option of TShark.
+            max_filesize: Max capture filesize in KiB. The trace stops once
+              it is reached. 0 or a value above the gateway's limit uses
+              the gateway's limit.
 
         Returns:
             True if successfully started call trace
@@ -105,9 +118,12 @@ class TraceManager:
         self._trace_id = trace_id
         self._build_trace_filename()
 
+        if max_filesize <= 0 or max_filesize > self._max_filesize:
+            max_filesize = self._max_filesize
+
         command = self._trace_builder.build_trace_command(
             self._trace_interfaces,
-            _MAX_FILESIZE,
+            max_filesize,
             timeout,
             self._trace_filename,
             capture_filters,
@@ -124,17 +140,7 @@ class TraceManager:
             success: True if call trace finished without issue
             data: Call trace file in bytes
         """
-        # If trace is active, then stop it
-        if self._is_active:
-            self._is_stopping_trace = True
-            stopped = self._stop_trace()
-            if not stopped:
-                return EndTraceResult(False, None)
-            self._wait_until_trace_file_exists()
-
-        # Perform postprocessing of capture file with TShark display filters
-        succeeded = self._conditionally_postprocess_trace()
-        if not succeeded:
+        if not self._stop_and_postprocess_trace():
             return EndTraceResult(False, None)
 
         data = self._get_final_trace_data()  # type: bytes
@@ -145,6 +151,31 @@ class TraceManager:
         # Everything cleaned up, return bytes
         return EndTraceResult(True, data)
 
+    def end_trace_chunks(self) -> EndTraceChunksResult:
+        """Ends call trace, if currently active, without reading the call
+        trace file into memory.
+
+        Returns:
+            success: True if call trace finished without issue
+            chunks: Iterator over the call trace file in chunks of bytes.
+              The call trace is cleaned up once the chunks are consumed.
+        """
+        if not self._stop_and_postprocess_trace():
+            return EndTraceChunksResult(False, iter(()))
+        return EndTraceChunksResult(True, self._read_final_trace_chunks())
+
+    def _stop_and_postprocess_trace(self) -> bool:
+        # If trace is active, then stop it
+        if self._is_active:
+            self._is_stopping_trace = True
+            stopped = self._stop_trace()
+            if not stopped:
+                return False
+            self._wait_until_trace_file_exists()
+
+        # Perform postprocessing of capture file with TShark display filters
+        return self._conditionally_postprocess_trace()
+
     def _execute_start_trace_command(self, command: List[str]) -> bool:
         """Executes a command to start a call trace
 
@@ -227,26 +258,28 @@ class TraceManager:
             self._report_trace_failure()
             return
 
-        data = self._get_final_trace_data()  # type: bytes
-
-        self._cleanup_trace()
         logging.info("TraceManager: Reporting call trace timeout")
+        self._report_trace_success()
 
-        self._report_trace_success(data)
+    def _report_trace_success(self):
+        trace_id = self._trace_id
+        chunks = self._read_final_trace_chunks()
+
+        def requests() -> Iterator[ReportEndedTraceRequest]:
+            yield ReportEndedTraceRequest(trace_id=trace_id, success=True)
+            for chunk in chunks:
+                yield ReportEndedTraceRequest(trace_content=chunk)
 
-    def _report_trace_success(self, data: bytes):
         try:
-            req = ReportEndedTraceRequest(
-                trace_id=self._trace_id,
-                success=True,
-                trace_content=data,
-            )
-            self._ctraced_stub.ReportEndedCallTrace(req)
+            self._ctraced_stub.ReportEndedCallTraceChunks(requests())
         except grpc.RpcError:
             logging.error(
                 'Unable to report successful call trace for %s. ',
-                self._trace_id,
+                trace_id,
             )
+        finally:
+            # Cleans up the call trace if reporting stopped early
+            chunks.close()
 
     def _report_trace_failure(self):
         try:
@@ -334,6 +367,23 @@ class TraceManager:
             data = trace_file.read()  # type: bytes
         return data
 
+    def _read_final_trace_chunks(self) -> Iterator[bytes]:
+        if self._should_postprocess():
+            filename = self._trace_filename_postprocessed
+        else:
+            filename = self._trace_filename
+
+        try:
+            with open(filename, "rb") as trace_file:
+                while True:
+                    chunk = trace_file.read(_TRACE_CHUNK_SIZE)  # type: bytes
+                    if not chunk:
+                        break
+                    yield chunk
+        finally:
+            self._cleanup_trace()
+            logging.info("TraceManager: Call trace has ended")
+
     def _cleanup_trace(self):
         self._ensure_tmp_file_deleted(self._trace_filename)
         self._ensure_tmp_file_deleted(self._trace_filename_postprocessed)
@@ -23,6 +23,10 @@ import (
 var ErrNotFound = errors.New("Not found")
 var ErrAlreadyExists = errors.New("Already exists")
 
+// ErrVersionConflict is returned when a write conditioned on a resource's
+// version is attempted against a different version of that resource.
+var ErrVersionConflict = errors.New("Version conflict")
+
 func NewInitError(err error, service string) error {
 	return ClientInitError{Err: err, Service: service}
 }
@@ -145,7 +145,11 @@ type StartTraceRequest struct {
 	//    passed through the display filters and saved again, so the final
 	//    capture received has been processed by both the capture and display
 	//    filters.
-	DisplayFilters       string   `protobuf:"bytes,7,opt,name=display_filters,json=displayFilters,proto3" json:"display_filters,omitempty"`
+	DisplayFilters string `protobuf:"bytes,7,opt,name=display_filters,json=displayFilters,proto3" json:"display_filters,omitempty"`
+	// SPECIFIED FOR ALL
+	// After the capture file reaches this size, the call trace stops
+	// automatically. Specified in KiB, 0 uses the gateway's default limit.
+	MaxFilesize          uint32   `protobuf:"varint,9,opt,name=max_filesize,json=maxFilesize,proto3" json:"max_filesize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -232,6 +236,13 @@ func (m *StartTraceRequest) GetDisplayFilters() string {
 	return ""
 }
 
+func (m *StartTraceRequest) GetMaxFilesize() uint32 {
+	if m != nil {
+		return m.MaxFilesize
+	}
+	return 0
+}
+
 type StartTraceResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -458,43 +469,47 @@ func init() {
 func init() { proto.RegisterFile("orc8r/protos/ctraced.proto", fileDescriptor_74b70534723ed60d) }
 
 var fileDescriptor_74b70534723ed60d = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5b, 0x8f, 0xd2, 0x40,
-	0x14, 0xc7, 0x29, 0x20, 0x94, 0x23, 0xb0, 0x75, 0x34, 0xb1, 0xd4, 0x1b, 0xa9, 0x37, 0x8c, 0x9b,
-	0x12, 0xf1, 0xc5, 0x57, 0xa8, 0x5d, 0x82, 0x81, 0x05, 0xa7, 0xdd, 0xc4, 0xf8, 0xb2, 0xe9, 0xb6,
-	0xb3, 0x9b, 0x26, 0x6d, 0xa7, 0x4e, 0x07, 0x13, 0x12, 0x3f, 0x93, 0x8f, 0x7e, 0x3e, 0xd3, 0x29,
-	0xe5, 0xe2, 0x8a, 0xf8, 0xd4, 0x9e, 0x33, 0xff, 0xf3, 0xfb, 0xf7, 0x9c, 0x9e, 0x0c, 0x68, 0x94,
-	0x79, 0x1f, 0x58, 0x3f, 0x61, 0x94, 0xd3, 0xb4, 0xef, 0x71, 0xe6, 0x7a, 0xc4, 0x37, 0x44, 0x88,
-	0xee, 0x46, 0xee, 0x4d, 0xe4, 0x1a, 0x42, 0xa1, 0x75, 0xf6, 0x85, 0x34, 0x8a, 0x68, 0x9c, 0xeb,
-	0xf4, 0x9f, 0x55, 0xb8, 0x67, 0x73, 0x97, 0x71, 0x27, 0xab, 0xc6, 0xe4, 0xdb, 0x92, 0xa4, 0x1c,
-	0x75, 0x40, 0x16, 0xb4, 0xcb, 0xc0, 0x57, 0xe5, 0xae, 0xd4, 0x6b, 0xe0, 0xba, 0x88, 0x27, 0x3e,
-	0x1a, 0x03, 0xe4, 0x47, 0x7c, 0x95, 0x10, 0x55, 0xea, 0x4a, 0xbd, 0xf6, 0xa0, 0x67, 0xec, 0xb8,
-	0x19, 0xb7, 0x70, 0x86, 0x08, 0x9c, 0x55, 0x42, 0x70, 0x83, 0x17, 0xaf, 0x08, 0x41, 0x35, 0x88,
-	0xd2, 0x40, 0x2d, 0x0b, 0xbe, 0x78, 0x47, 0x63, 0x90, 0xc5, 0x67, 0x79, 0x34, 0x54, 0x2b, 0x02,
-	0xfd, 0xf6, 0x08, 0x7a, 0xb1, 0x96, 0x9f, 0xbb, 0x11, 0xc1, 0x9b, 0x62, 0xf4, 0x09, 0x1a, 0x41,
-	0xcc, 0x09, 0xbb, 0x76, 0x3d, 0xa2, 0x56, 0x05, 0xe9, 0xf4, 0x08, 0x69, 0x52, 0xe8, 0x05, 0x6a,
-	0x5b, 0x8e, 0x54, 0xa8, 0xf3, 0x20, 0x22, 0x74, 0xc9, 0xd5, 0x3b, 0x5d, 0xa9, 0xd7, 0xc2, 0x45,
-	0x88, 0x5e, 0xc3, 0x89, 0xe7, 0x26, 0x7c, 0xc9, 0xc8, 0xe5, 0x75, 0x10, 0x72, 0xc2, 0x52, 0xb5,
-	0x26, 0xba, 0x69, 0xaf, 0xd3, 0x67, 0x79, 0x36, 0x13, 0xfa, 0x41, 0x9a, 0x84, 0xee, 0x6a, 0x23,
-	0xac, 0xe7, 0xc2, 0x75, 0x7a, 0x2d, 0xd4, 0x67, 0xd0, 0xd8, 0x0c, 0x0b, 0xd5, 0xa1, 0x32, 0x9c,
-	0x4e, 0x95, 0x12, 0x6a, 0x03, 0xd8, 0x17, 0x23, 0xdb, 0xc4, 0x93, 0x91, 0x85, 0x15, 0x09, 0x35,
-	0x41, 0x5e, 0xe0, 0xb9, 0x33, 0x37, 0xe7, 0x53, 0xa5, 0x8c, 0x5a, 0xd0, 0x98, 0x9c, 0x3b, 0x16,
-	0x3e, 0x1b, 0x9a, 0x96, 0x52, 0x41, 0x00, 0x35, 0xf3, 0xc2, 0x76, 0xe6, 0x33, 0xa5, 0xaa, 0xbf,
-	0x82, 0xe6, 0xee, 0x80, 0x90, 0x0c, 0x55, 0xdb, 0x74, 0x16, 0x4a, 0x29, 0x43, 0x7c, 0x9c, 0x0c,
-	0x67, 0x96, 0x93, 0x01, 0xf5, 0x37, 0xd0, 0xda, 0x6b, 0x5f, 0x08, 0xdf, 0x0d, 0x33, 0x61, 0x0d,
-	0xca, 0xe3, 0x2f, 0x8a, 0x24, 0x9e, 0x8e, 0x52, 0xd6, 0x0d, 0x40, 0xbb, 0xb3, 0x4b, 0x13, 0x1a,
-	0xa7, 0x62, 0x46, 0xe9, 0xd2, 0xf3, 0x48, 0x9a, 0x8a, 0x95, 0x90, 0x71, 0x11, 0xea, 0xa7, 0x70,
-	0x62, 0xc5, 0xfe, 0xc1, 0xed, 0x92, 0xf6, 0xb6, 0x4b, 0xff, 0x0c, 0xca, 0x56, 0x7d, 0x8c, 0x8d,
-	0x9e, 0x43, 0x2b, 0x07, 0x79, 0x34, 0xe6, 0x24, 0xe6, 0x62, 0x97, 0x9a, 0xb8, 0x29, 0x92, 0x66,
-	0x9e, 0xd3, 0x53, 0x78, 0x88, 0x49, 0x42, 0x19, 0xb7, 0x62, 0x9f, 0xfc, 0xef, 0x87, 0xec, 0x9a,
-	0x96, 0x8f, 0x98, 0x56, 0xfe, 0x62, 0xaa, 0x81, 0x7a, 0xdb, 0x34, 0xef, 0x67, 0xf0, 0x4b, 0x02,
-	0xc5, 0x74, 0xc3, 0x50, 0x64, 0x6d, 0xc2, 0xbe, 0x07, 0x1e, 0x41, 0x36, 0xb4, 0xc5, 0x58, 0x37,
-	0x07, 0xe8, 0xe9, 0xbf, 0xf7, 0x55, 0x7b, 0x76, 0xf0, 0x3c, 0xf7, 0xd1, 0x4b, 0x68, 0x06, 0x4d,
-	0x2b, 0xf6, 0xb7, 0xc8, 0xc7, 0x7b, 0x25, 0x7f, 0xfc, 0x16, 0xed, 0xc9, 0x81, 0xd3, 0x02, 0x37,
-	0xf8, 0x01, 0xf7, 0x37, 0xac, 0xac, 0x51, 0x46, 0xc3, 0x90, 0x30, 0x44, 0xe0, 0xc1, 0x4e, 0xaf,
-	0x5b, 0xb7, 0x17, 0x7b, 0xbc, 0x03, 0xff, 0x40, 0x7b, 0x79, 0x44, 0x55, 0xb8, 0x8f, 0x1e, 0x7d,
-	0xed, 0x08, 0x65, 0x3f, 0xbf, 0xcc, 0xc2, 0xe0, 0xaa, 0x7f, 0x43, 0xd7, 0x77, 0xda, 0x55, 0x4d,
-	0x3c, 0xdf, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x99, 0x9c, 0x1a, 0xed, 0x13, 0x05, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xdb, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xeb, 0x24, 0x5f, 0x0e, 0xd3, 0x24, 0xf5, 0xb7, 0x20, 0xe1, 0x9a, 0x53, 0x31, 0xa7,
+	0x20, 0xaa, 0x04, 0xca, 0x0d, 0xb7, 0xa9, 0x71, 0xab, 0xa0, 0xa6, 0x29, 0x6b, 0x57, 0x42, 0xdc,
+	0x54, 0xae, 0xbd, 0x2d, 0x16, 0xb6, 0xd7, 0xec, 0x6e, 0x50, 0xcb, 0x3b, 0xf0, 0x70, 0x3c, 0x07,
+	0x2f, 0x81, 0xbc, 0x76, 0x1c, 0x87, 0x36, 0x04, 0x09, 0xae, 0xec, 0x99, 0xfd, 0xcf, 0xef, 0xef,
+	0x19, 0x8f, 0x16, 0x74, 0xca, 0xbc, 0xd7, 0x6c, 0x90, 0x30, 0x2a, 0x28, 0x1f, 0x78, 0x82, 0xb9,
+	0x1e, 0xf1, 0xfb, 0x32, 0x44, 0xeb, 0x91, 0x7b, 0x1e, 0xb9, 0x7d, 0xa9, 0xd0, 0x37, 0x17, 0x85,
+	0x34, 0x8a, 0x68, 0x9c, 0xe9, 0x8c, 0xef, 0x35, 0xf8, 0xdf, 0x16, 0x2e, 0x13, 0x4e, 0x5a, 0x8d,
+	0xc9, 0xe7, 0x29, 0xe1, 0x02, 0x6d, 0x42, 0x53, 0xd2, 0x4e, 0x02, 0x5f, 0x6b, 0x6e, 0x29, 0xbd,
+	0x16, 0x6e, 0xc8, 0x78, 0xe4, 0xa3, 0x7d, 0x80, 0xec, 0x48, 0x5c, 0x26, 0x44, 0x53, 0xb6, 0x94,
+	0x5e, 0x77, 0xa7, 0xd7, 0x2f, 0xb9, 0xf5, 0xaf, 0xe0, 0xfa, 0x32, 0x70, 0x2e, 0x13, 0x82, 0x5b,
+	0x62, 0xf6, 0x8a, 0x10, 0xd4, 0x82, 0x88, 0x07, 0x5a, 0x45, 0xf2, 0xe5, 0x3b, 0xda, 0x87, 0xa6,
+	0xfc, 0x2c, 0x8f, 0x86, 0x5a, 0x55, 0xa2, 0x9f, 0xaf, 0x40, 0x1f, 0xe5, 0xf2, 0x43, 0x37, 0x22,
+	0xb8, 0x28, 0x46, 0x6f, 0xa1, 0x15, 0xc4, 0x82, 0xb0, 0x33, 0xd7, 0x23, 0x5a, 0x4d, 0x92, 0xb6,
+	0x57, 0x90, 0x46, 0x33, 0xbd, 0x44, 0xcd, 0xcb, 0x91, 0x06, 0x0d, 0x11, 0x44, 0x84, 0x4e, 0x85,
+	0xf6, 0xdf, 0x96, 0xd2, 0xeb, 0xe0, 0x59, 0x88, 0x9e, 0xc2, 0x86, 0xe7, 0x26, 0x62, 0xca, 0xc8,
+	0xc9, 0x59, 0x10, 0x0a, 0xc2, 0xb8, 0x56, 0x97, 0xdd, 0x74, 0xf3, 0xf4, 0x5e, 0x96, 0x4d, 0x85,
+	0x7e, 0xc0, 0x93, 0xd0, 0xbd, 0x2c, 0x84, 0x8d, 0x4c, 0x98, 0xa7, 0x67, 0xc2, 0x07, 0xd0, 0x8e,
+	0xdc, 0x8b, 0x54, 0x44, 0x78, 0xf0, 0x95, 0x68, 0x2d, 0x69, 0xb8, 0x1e, 0xb9, 0x17, 0x7b, 0x79,
+	0xca, 0x18, 0x43, 0xab, 0x98, 0x27, 0x6a, 0x40, 0x75, 0x78, 0x70, 0xa0, 0xae, 0xa1, 0x2e, 0x80,
+	0x7d, 0xbc, 0x6b, 0x9b, 0x78, 0xb4, 0x6b, 0x61, 0x55, 0x41, 0x6d, 0x68, 0x1e, 0xe1, 0x89, 0x33,
+	0x31, 0x27, 0x07, 0x6a, 0x05, 0x75, 0xa0, 0x35, 0x3a, 0x74, 0x2c, 0xbc, 0x37, 0x34, 0x2d, 0xb5,
+	0x8a, 0x00, 0xea, 0xe6, 0xb1, 0xed, 0x4c, 0xc6, 0x6a, 0xcd, 0x78, 0x02, 0xed, 0xf2, 0x0c, 0x51,
+	0x13, 0x6a, 0xb6, 0xe9, 0x1c, 0xa9, 0x6b, 0x29, 0xe2, 0xcd, 0x68, 0x38, 0xb6, 0x9c, 0x14, 0x68,
+	0x3c, 0x83, 0xce, 0xc2, 0x84, 0xa4, 0xf0, 0xe5, 0x30, 0x15, 0xd6, 0xa1, 0xb2, 0xff, 0x5e, 0x55,
+	0xe4, 0xd3, 0x51, 0x2b, 0x46, 0x1f, 0x50, 0x79, 0xbc, 0x3c, 0xa1, 0x31, 0x97, 0x63, 0xe4, 0x53,
+	0xcf, 0x23, 0x9c, 0xcb, 0xad, 0x69, 0xe2, 0x59, 0x68, 0x6c, 0xc3, 0x86, 0x15, 0xfb, 0x4b, 0x17,
+	0x50, 0x59, 0x58, 0x40, 0xe3, 0x1d, 0xa8, 0x73, 0xf5, 0x2a, 0x36, 0x7a, 0x08, 0x9d, 0x0c, 0xe4,
+	0xd1, 0x58, 0x90, 0x58, 0xc8, 0x75, 0x6b, 0xe3, 0xb6, 0x4c, 0x9a, 0x59, 0xce, 0xe0, 0x70, 0x0b,
+	0x93, 0x84, 0x32, 0x61, 0xc5, 0x3e, 0xf9, 0xd3, 0x0f, 0x29, 0x9b, 0x56, 0x56, 0x98, 0x56, 0xaf,
+	0x31, 0xd5, 0x41, 0xbb, 0x6a, 0x9a, 0xf5, 0xb3, 0xf3, 0xad, 0x02, 0xaa, 0xe9, 0x86, 0xa1, 0xcc,
+	0xda, 0x84, 0x7d, 0x09, 0x3c, 0x82, 0x6c, 0xe8, 0xca, 0xb1, 0x16, 0x07, 0xe8, 0xde, 0xef, 0x57,
+	0x5a, 0xbf, 0xbf, 0xf4, 0x3c, 0xf3, 0x31, 0xd6, 0xd0, 0x18, 0xda, 0x56, 0xec, 0xcf, 0x91, 0x77,
+	0x16, 0x4a, 0x7e, 0xf9, 0x2d, 0xfa, 0xdd, 0x25, 0xa7, 0x05, 0xee, 0x18, 0x50, 0x19, 0x67, 0x7e,
+	0x9c, 0xc6, 0x9f, 0xf8, 0x5f, 0x42, 0x5f, 0x28, 0x3b, 0x3f, 0x14, 0xb8, 0x31, 0x87, 0xd2, 0x58,
+	0x30, 0x1a, 0x86, 0x84, 0x21, 0x02, 0x37, 0x4b, 0x33, 0x9c, 0x77, 0xf1, 0x68, 0x01, 0xb9, 0xe4,
+	0xdf, 0xea, 0x8f, 0x57, 0xa8, 0x8a, 0xae, 0x22, 0xd0, 0xaf, 0xb3, 0xc9, 0xbb, 0xfb, 0xb7, 0x66,
+	0x3d, 0x65, 0xf7, 0xf6, 0x87, 0x4d, 0xa9, 0x1d, 0x64, 0xd7, 0x76, 0x18, 0x9c, 0x0e, 0xce, 0x69,
+	0x7e, 0x7b, 0x9f, 0xd6, 0xe5, 0xf3, 0xd5, 0xcf, 0x01, 0x00, 0x7a, 0x08, 0x33, 0xd8, 0xfd, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +531,11 @@ type CallTraceServiceClient interface {
 	// End the call trace that is currently active on the gateway.
 	//
 	EndCallTrace(ctx context.Context, in *EndTraceRequest, opts ...grpc.CallOption) (*EndTraceResponse, error)
+	// End the call trace that is currently active on the gateway, streaming
+	// the capture back in chunks. The first response holds whether the call
+	// trace ended, each response a chunk of the capture.
+	//
+	EndCallTraceChunks(ctx context.Context, in *EndTraceRequest, opts ...grpc.CallOption) (CallTraceService_EndCallTraceChunksClient, error)
 }
 
 type callTraceServiceClient struct {
@@ -544,6 +564,38 @@ func (c *callTraceServiceClient) EndCallTrace(ctx context.Context, in *EndTraceR
 	return out, nil
 }
 
+func (c *callTraceServiceClient) EndCallTraceChunks(ctx context.Context, in *EndTraceRequest, opts ...grpc.CallOption) (CallTraceService_EndCallTraceChunksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CallTraceService_serviceDesc.Streams[0], "/magma.orc8r.CallTraceService/EndCallTraceChunks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &callTraceServiceEndCallTraceChunksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CallTraceService_EndCallTraceChunksClient interface {
+	Recv() (*EndTraceResponse, error)
+	grpc.ClientStream
+}
+
+type callTraceServiceEndCallTraceChunksClient struct {
+	grpc.ClientStream
+}
+
+func (x *callTraceServiceEndCallTraceChunksClient) Recv() (*EndTraceResponse, error) {
+	m := new(EndTraceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CallTraceServiceServer is the server API for CallTraceService service.
 type CallTraceServiceServer interface {
 	// Start a call trace on the gateway with the specified options.
@@ -553,6 +605,11 @@ type CallTraceServiceServer interface {
 	// End the call trace that is currently active on the gateway.
 	//
 	EndCallTrace(context.Context, *EndTraceRequest) (*EndTraceResponse, error)
+	// End the call trace that is currently active on the gateway, streaming
+	// the capture back in chunks. The first response holds whether the call
+	// trace ended, each response a chunk of the capture.
+	//
+	EndCallTraceChunks(*EndTraceRequest, CallTraceService_EndCallTraceChunksServer) error
 }
 
 // UnimplementedCallTraceServiceServer can be embedded to have forward compatible implementations.
@@ -565,6 +622,9 @@ func (*UnimplementedCallTraceServiceServer) StartCallTrace(ctx context.Context,
 func (*UnimplementedCallTraceServiceServer) EndCallTrace(ctx context.Context, req *EndTraceRequest) (*EndTraceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndCallTrace not implemented")
 }
+func (*UnimplementedCallTraceServiceServer) EndCallTraceChunks(req *EndTraceRequest, srv CallTraceService_EndCallTraceChunksServer) error {
+	return status.Errorf(codes.Unimplemented, "method EndCallTraceChunks not implemented")
+}
 
 func RegisterCallTraceServiceServer(s *grpc.Server, srv CallTraceServiceServer) {
 	s.RegisterService(&_CallTraceService_serviceDesc, srv)
@@ -606,6 +666,27 @@ func _CallTraceService_EndCallTrace_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallTraceService_EndCallTraceChunks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EndTraceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CallTraceServiceServer).EndCallTraceChunks(m, &callTraceServiceEndCallTraceChunksServer{stream})
+}
+
+type CallTraceService_EndCallTraceChunksServer interface {
+	Send(*EndTraceResponse) error
+	grpc.ServerStream
+}
+
+type callTraceServiceEndCallTraceChunksServer struct {
+	grpc.ServerStream
+}
+
+func (x *callTraceServiceEndCallTraceChunksServer) Send(m *EndTraceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _CallTraceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.CallTraceService",
 	HandlerType: (*CallTraceServiceServer)(nil),
@@ -619,7 +700,13 @@ var _CallTraceService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _CallTraceService_EndCallTrace_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EndCallTraceChunks",
+			Handler:       _CallTraceService_EndCallTraceChunks_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "orc8r/protos/ctraced.proto",
 }
 
@@ -630,6 +717,11 @@ type CallTraceControllerClient interface {
 	// Report that a call trace has ended
 	//
 	ReportEndedCallTrace(ctx context.Context, in *ReportEndedTraceRequest, opts ...grpc.CallOption) (*ReportEndedTraceResponse, error)
+	// Report that a call trace has ended, streaming the capture in chunks.
+	// The first request holds the trace ID and whether the call trace
+	// succeeded, each request a chunk of the capture.
+	//
+	ReportEndedCallTraceChunks(ctx context.Context, opts ...grpc.CallOption) (CallTraceController_ReportEndedCallTraceChunksClient, error)
 }
 
 type callTraceControllerClient struct {
@@ -649,11 +741,50 @@ func (c *callTraceControllerClient) ReportEndedCallTrace(ctx context.Context, in
 	return out, nil
 }
 
+func (c *callTraceControllerClient) ReportEndedCallTraceChunks(ctx context.Context, opts ...grpc.CallOption) (CallTraceController_ReportEndedCallTraceChunksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CallTraceController_serviceDesc.Streams[0], "/magma.orc8r.CallTraceController/ReportEndedCallTraceChunks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &callTraceControllerReportEndedCallTraceChunksClient{stream}
+	return x, nil
+}
+
+type CallTraceController_ReportEndedCallTraceChunksClient interface {
+	Send(*ReportEndedTraceRequest) error
+	CloseAndRecv() (*ReportEndedTraceResponse, error)
+	grpc.ClientStream
+}
+
+type callTraceControllerReportEndedCallTraceChunksClient struct {
+	grpc.ClientStream
+}
+
+func (x *callTraceControllerReportEndedCallTraceChunksClient) Send(m *ReportEndedTraceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *callTraceControllerReportEndedCallTraceChunksClient) CloseAndRecv() (*ReportEndedTraceResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportEndedTraceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CallTraceControllerServer is the server API for CallTraceController service.
 type CallTraceControllerServer interface {
 	// Report that a call trace has ended
 	//
 	ReportEndedCallTrace(context.Context, *ReportEndedTraceRequest) (*ReportEndedTraceResponse, error)
+	// Report that a call trace has ended, streaming the capture in chunks.
+	// The first request holds the trace ID and whether the call trace
+	// succeeded, each request a chunk of the capture.
+	//
+	ReportEndedCallTraceChunks(CallTraceController_ReportEndedCallTraceChunksServer) error
 }
 
 // UnimplementedCallTraceControllerServer can be embedded to have forward compatible implementations.
@@ -663,6 +794,9 @@ type UnimplementedCallTraceControllerServer struct {
 func (*UnimplementedCallTraceControllerServer) ReportEndedCallTrace(ctx context.Context, req *ReportEndedTraceRequest) (*ReportEndedTraceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportEndedCallTrace not implemented")
 }
+func (*UnimplementedCallTraceControllerServer) ReportEndedCallTraceChunks(srv CallTraceController_ReportEndedCallTraceChunksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportEndedCallTraceChunks not implemented")
+}
 
 func RegisterCallTraceControllerServer(s *grpc.Server, srv CallTraceControllerServer) {
 	s.RegisterService(&_CallTraceController_serviceDesc, srv)
@@ -686,6 +820,32 @@ func _CallTraceController_ReportEndedCallTrace_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallTraceController_ReportEndedCallTraceChunks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CallTraceControllerServer).ReportEndedCallTraceChunks(&callTraceControllerReportEndedCallTraceChunksServer{stream})
+}
+
+type CallTraceController_ReportEndedCallTraceChunksServer interface {
+	SendAndClose(*ReportEndedTraceResponse) error
+	Recv() (*ReportEndedTraceRequest, error)
+	grpc.ServerStream
+}
+
+type callTraceControllerReportEndedCallTraceChunksServer struct {
+	grpc.ServerStream
+}
+
+func (x *callTraceControllerReportEndedCallTraceChunksServer) SendAndClose(m *ReportEndedTraceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *callTraceControllerReportEndedCallTraceChunksServer) Recv() (*ReportEndedTraceRequest, error) {
+	m := new(ReportEndedTraceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _CallTraceController_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.CallTraceController",
 	HandlerType: (*CallTraceControllerServer)(nil),
@@ -695,6 +855,12 @@ var _CallTraceController_serviceDesc = grpc.ServiceDesc{
 			Handler:    _CallTraceController_ReportEndedCallTrace_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportEndedCallTraceChunks",
+			Handler:       _CallTraceController_ReportEndedCallTraceChunks_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "orc8r/protos/ctraced.proto",
 }
@@ -145,7 +145,7 @@ func getGateway(c echo.Context) error {
 		return nerr
 	}
 
-	magmadModel, nerr := handlers.LoadMagmadGateway(c.Request().Context(), nid, gid)
+	magmadModel, version, nerr := handlers.LoadMagmadGatewayWithVersion(c.Request().Context(), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
@@ -172,6 +172,7 @@ func getGateway(c echo.Context) error {
 		ret.Wifi = ent.Config.(*wifimodels.GatewayWifiConfigs)
 	}
 
+	obsidian.SetETag(c, version)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -191,21 +192,27 @@ func deleteGateway(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
+	expectedVersion, nerr := obsidian.GetIfMatchVersion(c)
+	if nerr != nil {
+		return nerr
+	}
 	gwEnt, err := configurator.LoadEntity(nid, orc8r.MagmadGatewayType, gid, configurator.EntityLoadCriteria{}, serdes.Entity)
 	if err != nil && err != merrors.ErrNotFound {
 		return obsidian.HttpError(err)
 	}
 
 	reqCtx := c.Request().Context()
-	err = configurator.DeleteEntities(
+	err = configurator.WriteEntitiesWithContext(
+		reqCtx,
 		nid,
-		[]storage.TypeAndKey{
-			{Type: orc8r.MagmadGatewayType, Key: gid},
-			{Type: wifi.WifiGatewayType, Key: gid},
+		[]configurator.EntityWriteOperation{
+			configurator.EntityUpdateCriteria{Type: orc8r.MagmadGatewayType, Key: gid, DeleteEntity: true, ExpectedVersion: expectedVersion},
+			configurator.EntityUpdateCriteria{Type: wifi.WifiGatewayType, Key: gid, DeleteEntity: true},
 		},
+		serdes.Entity,
 	)
 	if err != nil {
-		return obsidian.HttpError(err)
+		return obsidian.WriteHttpError(err)
 	}
 
 	if gwEnt.PhysicalID != "" {
@@ -254,7 +261,8 @@ func createMesh(c echo.Context) error {
 		gwIDs = append(gwIDs, storage.TypeAndKey{Key: string(gwID), Type: orc8r.MagmadGatewayType})
 	}
 
-	_, err := configurator.CreateEntity(
+	_, err := configurator.CreateEntityWithContext(
+		c.Request().Context(),
 		nid,
 		configurator.NetworkEntity{
 			Type:         wifi.MeshEntityType,
@@ -328,7 +336,7 @@ func updateMesh(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "can't update gateways here! please update the individual gateways instead.")
 	}
 
-	_, err = configurator.UpdateEntities(nid, payload.ToUpdateCriteria(), serdes.Entity)
+	_, err = configurator.UpdateEntitiesWithContext(c.Request().Context(), nid, payload.ToUpdateCriteria(), serdes.Entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -354,7 +362,7 @@ func deleteMesh(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "can't delete a mesh with gateways!")
 	}
 
-	err = configurator.DeleteEntity(nid, wifi.MeshEntityType, mid)
+	err = configurator.DeleteEntityWithContext(c.Request().Context(), nid, wifi.MeshEntityType, mid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
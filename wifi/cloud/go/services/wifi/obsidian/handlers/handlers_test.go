@@ -1316,6 +1316,8 @@ func TestPartialUpdateAndGetGateway(t *testing.T) {
 	expectedEnts["tier2"].Associations = []storage.TypeAndKey{{Type: orc8r.MagmadGatewayType, Key: gID}}
 	expectedEnts["tier2"].GraphID = "10"
 	expectedEnts["tier2"].Version++
+	// Partial updates bump the version of the gateway as a whole
+	expectedEnts["magmad"].Version++
 	expectedEntsVals = make(configurator.NetworkEntities, 0, len(expectedEnts))
 	for _, v := range key_order {
 		expectedEntsVals = append(expectedEntsVals, *expectedEnts[v])
@@ -1433,6 +1435,7 @@ func TestPartialUpdateAndGetGateway(t *testing.T) {
 	}
 	expectedEnts["gateway"].Config = updatedWifi
 	expectedEnts["gateway"].Version++
+	expectedEnts["magmad"].Version++
 	expectedEnts["magmad"].ParentAssociations = []storage.TypeAndKey{{Type: wifi.MeshEntityType, Key: nmID}, {Type: orc8r.UpgradeTierEntityType, Key: updatedGatewayTier}}
 	expectedEnts["mesh"].Associations = nil
 	expectedEnts["mesh"].GraphID = "15"
@@ -2022,6 +2025,7 @@ func TestDeleteMesh(t *testing.T) {
 			},
 			Associations:       []storage.TypeAndKey{{Type: wifi.WifiGatewayType, Key: gID}},
 			ParentAssociations: []storage.TypeAndKey{{Type: wifi.MeshEntityType, Key: nmID}, {Type: orc8r.UpgradeTierEntityType, Key: "t1"}},
+			Version:            1,
 		},
 		{
 			NetworkID: nID,